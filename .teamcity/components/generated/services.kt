@@ -21,11 +21,13 @@ var services = mapOf(
         "communication" to "Communication",
         "compute" to "Compute",
         "consumption" to "Consumption",
+        "containerapps" to "Container Apps",
         "containers" to "Container Services",
         "cosmos" to "CosmosDB",
         "costmanagement" to "Cost Management",
         "customproviders" to "Custom Providers",
         "dns" to "DNS",
+        "dnsresolver" to "Private DNS Resolver",
         "datafactory" to "Data Factory",
         "datalake" to "Data Lake",
         "datashare" to "Data Share",
@@ -38,9 +40,12 @@ var services = mapOf(
         "digitaltwins" to "Digital Twins",
         "disks" to "Disks",
         "domainservices" to "DomainServices",
+        "elasticsan" to "Elastic SAN",
         "eventgrid" to "EventGrid",
         "eventhub" to "EventHub",
+        "fabric" to "Fabric",
         "firewall" to "Firewall",
+        "fleet" to "Fleet Manager",
         "frontdoor" to "FrontDoor",
         "hdinsight" to "HDInsight",
         "hpccache" to "HPC Cache",
@@ -59,6 +64,7 @@ var services = mapOf(
         "machinelearning" to "Machine Learning",
         "maintenance" to "Maintenance",
         "managedapplications" to "Managed Applications",
+        "managedredis" to "Managed Redis",
         "msi" to "Managed Service Identities",
         "managementgroup" to "Management Group",
         "maps" to "Maps",
@@ -66,6 +72,7 @@ var services = mapOf(
         "media" to "Media",
         "mssql" to "Microsoft SQL Server / Azure SQL",
         "mixedreality" to "Mixed Reality",
+        "mongocluster" to "Mongo Cluster",
         "monitor" to "Monitor",
         "mysql" to "MySQL",
         "netapp" to "NetApp",
@@ -91,12 +98,16 @@ var services = mapOf(
         "servicebus" to "ServiceBus",
         "signalr" to "SignalR",
         "springcloud" to "Spring Cloud",
+        "standbypool" to "Standby Pool",
         "storage" to "Storage",
+        "storageactions" to "Storage Actions",
+        "storagemover" to "Storage Mover",
         "streamanalytics" to "Stream Analytics",
         "subscription" to "Subscription",
         "synapse" to "Synapse",
         "iottimeseriesinsights" to "Time Series Insights",
         "trafficmanager" to "Traffic Manager",
+        "trustedsigning" to "Trusted Signing",
         "vmware" to "VMware",
         "videoanalyzer" to "Video Analyzer",
         "web" to "Web"
@@ -0,0 +1,77 @@
+package clients
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// ClientCertificateConfig contains the configuration used to authenticate as a Service Principal using a
+// Client Certificate - which is used to obtain tokens for any auxiliary tenants, since these aren't
+// supported by the authentication Builder when authenticating using a Client Certificate
+type ClientCertificateConfig struct {
+	Path     string
+	Password string
+}
+
+// Authorizer returns a multi-tenant Authorizer for the specified endpoint using the Client Certificate
+func (c ClientCertificateConfig) Authorizer(sender autorest.Sender, oauthConfig *authentication.OAuthConfig, clientId, endpoint string) (autorest.Authorizer, error) {
+	contents, err := ioutil.ReadFile(c.Path)
+	if err != nil {
+		return nil, fmt.Errorf("reading Client Certificate %q: %+v", c.Path, err)
+	}
+
+	privateKey, certificate, err := pkcs12.Decode(contents, c.Password)
+	if err != nil {
+		return nil, fmt.Errorf("decoding Client Certificate %q: %+v", c.Path, err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the Client Certificate %q must contain an RSA private key", c.Path)
+	}
+
+	return multiTenantAuthorizer(sender, oauthConfig, func(config adal.OAuthConfig) (*adal.ServicePrincipalToken, error) {
+		return adal.NewServicePrincipalTokenWithSecret(config, clientId, endpoint, &adal.ServicePrincipalCertificateSecret{
+			Certificate: certificate,
+			PrivateKey:  rsaPrivateKey,
+		})
+	})
+}
+
+// multiTenantAuthorizer returns an Authorizer which authenticates against the primary tenant, and which sends
+// a token for each of the auxiliary tenants in the `x-ms-authorization-auxiliary` header - allowing resources
+// to reference resources within the auxiliary tenants (e.g. Virtual Network Peerings)
+func multiTenantAuthorizer(sender autorest.Sender, oauthConfig *authentication.OAuthConfig, newToken func(config adal.OAuthConfig) (*adal.ServicePrincipalToken, error)) (autorest.Authorizer, error) {
+	if oauthConfig == nil || oauthConfig.MultiTenantOauth == nil {
+		return nil, fmt.Errorf("a Multi Tenant OAuth Config is required to authenticate using auxiliary tenants")
+	}
+	multiTenantConfig := *oauthConfig.MultiTenantOauth
+
+	primary, err := newToken(*multiTenantConfig.PrimaryTenant())
+	if err != nil {
+		return nil, fmt.Errorf("building token for the primary tenant: %+v", err)
+	}
+	primary.SetSender(sender)
+
+	auxiliaryTenants := multiTenantConfig.AuxiliaryTenants()
+	token := &adal.MultiTenantServicePrincipalToken{
+		PrimaryToken:    primary,
+		AuxiliaryTokens: make([]*adal.ServicePrincipalToken, len(auxiliaryTenants)),
+	}
+	for i, tenant := range auxiliaryTenants {
+		auxiliary, err := newToken(*tenant)
+		if err != nil {
+			return nil, fmt.Errorf("building token for auxiliary tenant %q: %+v", tenant.TokenEndpoint.String(), err)
+		}
+		auxiliary.SetSender(sender)
+		token.AuxiliaryTokens[i] = auxiliary
+	}
+
+	return autorest.NewMultiTenantServicePrincipalTokenAuthorizer(token), nil
+}
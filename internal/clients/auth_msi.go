@@ -0,0 +1,178 @@
+package clients
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+const (
+	// azureArcApiVersion is the API version supported by the Identity Endpoint on Azure Arc-enabled servers
+	azureArcApiVersion = "2019-11-01"
+
+	// azureArcMaxKeySize is the maximum size of the key file returned in the Azure Arc challenge
+	azureArcMaxKeySize = 4096
+)
+
+// ManagedIdentityConfig contains the configuration used to authenticate using a Managed Identity
+type ManagedIdentityConfig struct {
+	// Endpoint is a custom endpoint for the Managed Identity token service (for example a proxied IMDS endpoint),
+	// when unset this is detected automatically
+	Endpoint string
+
+	// ClientID is the Client ID of the User Assigned Identity which should be used
+	ClientID string
+
+	// IdentityResourceID is the Resource ID of the User Assigned Identity which should be used
+	IdentityResourceID string
+}
+
+// ManagedIdentityIsSupported returns whether authenticating using a Managed Identity is supported in the current
+// environment - App Service and Function Apps (which expose both `MSI_ENDPOINT` and `MSI_SECRET`) are intentionally
+// unsupported, matching the authentication Builder, so that the next authentication method is used instead
+func ManagedIdentityIsSupported() bool {
+	return os.Getenv("MSI_ENDPOINT") == "" || os.Getenv("MSI_SECRET") == ""
+}
+
+func (c ManagedIdentityConfig) Validate() error {
+	if c.ClientID != "" && c.IdentityResourceID != "" {
+		return fmt.Errorf("only one of `client_id` or `msi_identity_resource_id` can be specified when authenticating using Managed Identity")
+	}
+
+	return nil
+}
+
+// Authorizer returns an Authorizer for the specified endpoint using a token obtained from the Managed Identity endpoint
+func (c ManagedIdentityConfig) Authorizer(sender autorest.Sender, endpoint string) (autorest.Authorizer, error) {
+	msiEndpoint, isAzureArc, err := c.msiEndpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Obtaining a Managed Identity token for %q from %q", endpoint, msiEndpoint)
+
+	var spt *adal.ServicePrincipalToken
+	switch {
+	case c.IdentityResourceID != "":
+		//nolint:SA1019
+		spt, err = adal.NewServicePrincipalTokenFromMSIWithIdentityResourceID(msiEndpoint, endpoint, c.IdentityResourceID)
+	case c.ClientID != "":
+		//nolint:SA1019
+		spt, err = adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(msiEndpoint, endpoint, c.ClientID)
+	default:
+		//nolint:SA1019
+		spt, err = adal.NewServicePrincipalTokenFromMSI(msiEndpoint, endpoint)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Identity token from %q for %q: %+v", msiEndpoint, endpoint, err)
+	}
+
+	if isAzureArc {
+		sender = autorest.DecorateSender(sender, withAzureArcChallenge())
+	}
+	spt.SetSender(sender)
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// BearerAuthorizerCallback returns a BearerAuthorizerCallback which authenticates using the Managed Identity
+func (c ManagedIdentityConfig) BearerAuthorizerCallback(sender autorest.Sender) *autorest.BearerAuthorizerCallback {
+	return autorest.NewBearerAuthorizerCallback(sender, func(_, resource string) (*autorest.BearerAuthorizer, error) {
+		authorizer, err := c.Authorizer(sender, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		cast, ok := authorizer.(*autorest.BearerAuthorizer)
+		if !ok {
+			return nil, fmt.Errorf("converting %+v to a BearerAuthorizer", authorizer)
+		}
+
+		return cast, nil
+	})
+}
+
+// msiEndpoint returns the Managed Identity endpoint which should be used and whether this is an Azure Arc-enabled server
+func (c ManagedIdentityConfig) msiEndpoint() (string, bool, error) {
+	// Azure Arc-enabled servers expose the Identity Endpoint via these Environment Variables
+	identityEndpoint := os.Getenv("IDENTITY_ENDPOINT")
+	isAzureArc := identityEndpoint != "" && os.Getenv("IMDS_ENDPOINT") != ""
+
+	if c.Endpoint != "" {
+		return c.Endpoint, isAzureArc && strings.EqualFold(c.Endpoint, identityEndpoint), nil
+	}
+
+	if isAzureArc {
+		return identityEndpoint, true, nil
+	}
+
+	//nolint:SA1019
+	endpoint, err := adal.GetMSIVMEndpoint()
+	if err != nil {
+		return "", false, fmt.Errorf("determining the Managed Identity endpoint: ensure the VM has Managed Identity enabled, or configure the `msi_endpoint`: %+v", err)
+	}
+
+	return endpoint, false, nil
+}
+
+// withAzureArcChallenge returns a SendDecorator which responds to the challenge issued by the Identity Endpoint
+// on Azure Arc-enabled servers - which returns the path to a key file (only readable by privileged users) which
+// must be sent in a subsequent request to prove the caller has access to the machine
+func withAzureArcChallenge() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// the Azure Arc Identity Endpoint doesn't support the API Version used for IMDS
+			query := r.URL.Query()
+			query.Set("api-version", azureArcApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			resp, err := s.Do(r)
+			if err != nil || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+				return resp, err
+			}
+
+			challenge := resp.Header.Get("WWW-Authenticate")
+			if !strings.HasPrefix(challenge, "Basic realm=") {
+				return resp, err
+			}
+
+			key, err := readAzureArcKey(strings.TrimPrefix(challenge, "Basic realm="))
+			if err != nil {
+				return resp, err
+			}
+			autorest.DrainResponseBody(resp)
+
+			req := r.Clone(r.Context())
+			req.Header.Set("Authorization", fmt.Sprintf("Basic %s", key))
+			return s.Do(req)
+		})
+	}
+}
+
+func readAzureArcKey(path string) (string, error) {
+	if filepath.Ext(path) != ".key" {
+		return "", fmt.Errorf("the Azure Arc challenge returned an unexpected key file %q", path)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("retrieving the Azure Arc key file %q: %+v", path, err)
+	}
+	if info.Size() > azureArcMaxKeySize {
+		return "", fmt.Errorf("the Azure Arc key file %q is larger than expected", path)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading the Azure Arc key file %q: %+v", path, err)
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
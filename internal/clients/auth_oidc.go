@@ -0,0 +1,187 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// oidcTokenExchangeAudience is the audience which Azure Active Directory expects federated tokens to be issued for
+const oidcTokenExchangeAudience = "api://AzureADTokenExchange"
+
+// OIDCConfig contains the configuration used to authenticate as a Service Principal
+// using an OpenID Connect token issued by a federated identity provider
+type OIDCConfig struct {
+	// Token is an ID token issued by the identity provider
+	Token string
+
+	// TokenFilePath is the path to a file containing an ID token issued by the identity provider
+	TokenFilePath string
+
+	// RequestURL and RequestToken are used to request an ID token from the identity provider
+	// (for example the `ACTIONS_ID_TOKEN_REQUEST_URL` and `ACTIONS_ID_TOKEN_REQUEST_TOKEN` in GitHub Actions)
+	RequestURL   string
+	RequestToken string
+}
+
+func (c OIDCConfig) Validate(config authentication.Config) error {
+	if config.ClientID == "" {
+		return fmt.Errorf("a `client_id` must be specified when authenticating using OIDC")
+	}
+	if config.TenantID == "" {
+		return fmt.Errorf("a `tenant_id` must be specified when authenticating using OIDC")
+	}
+	if c.Token == "" && c.TokenFilePath == "" && (c.RequestURL == "" || c.RequestToken == "") {
+		return fmt.Errorf("one of `oidc_token`, `oidc_token_file_path` or both `oidc_request_url` and `oidc_request_token` must be specified when authenticating using OIDC")
+	}
+
+	return nil
+}
+
+// Authorizer returns an Authorizer for the specified endpoint which exchanges the OIDC token for an access token
+// NOTE: the Context is used when requesting an ID token, which happens each time the access token is refreshed
+func (c OIDCConfig) Authorizer(ctx context.Context, sender autorest.Sender, oauthConfig *authentication.OAuthConfig, clientId, endpoint string) (autorest.Authorizer, error) {
+	if oauthConfig == nil || oauthConfig.OAuth == nil {
+		return nil, fmt.Errorf("an OAuth Config is required to authenticate using OIDC")
+	}
+
+	assertion := &oidcClientAssertion{
+		ctx:    ctx,
+		config: c,
+		sender: sender,
+	}
+
+	if oauthConfig.MultiTenantOauth != nil {
+		return multiTenantAuthorizer(sender, oauthConfig, func(config adal.OAuthConfig) (*adal.ServicePrincipalToken, error) {
+			return adal.NewServicePrincipalTokenWithSecret(config, clientId, endpoint, assertion)
+		})
+	}
+
+	spt, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig.OAuth, clientId, endpoint, assertion)
+	if err != nil {
+		return nil, fmt.Errorf("building Service Principal Token for OIDC: %+v", err)
+	}
+	spt.SetSender(sender)
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// BearerAuthorizerCallback returns a BearerAuthorizerCallback which authenticates against the primary tenant using OIDC
+func (c OIDCConfig) BearerAuthorizerCallback(ctx context.Context, sender autorest.Sender, oauthConfig *authentication.OAuthConfig, clientId string) *autorest.BearerAuthorizerCallback {
+	return autorest.NewBearerAuthorizerCallback(sender, func(_, resource string) (*autorest.BearerAuthorizer, error) {
+		// a BearerAuthorizer is only valid for the primary tenant
+		primaryOAuthConfig := &authentication.OAuthConfig{
+			OAuth: oauthConfig.OAuth,
+		}
+
+		authorizer, err := c.Authorizer(ctx, sender, primaryOAuthConfig, clientId, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		cast, ok := authorizer.(*autorest.BearerAuthorizer)
+		if !ok {
+			return nil, fmt.Errorf("converting %+v to a BearerAuthorizer", authorizer)
+		}
+
+		return cast, nil
+	})
+}
+
+var _ adal.ServicePrincipalSecret = &oidcClientAssertion{}
+
+// oidcClientAssertion is a ServicePrincipalSecret which authenticates using an ID Token as a Client Assertion
+type oidcClientAssertion struct {
+	ctx    context.Context
+	config OIDCConfig
+	sender autorest.Sender
+}
+
+func (a *oidcClientAssertion) SetAuthenticationValues(_ *adal.ServicePrincipalToken, values *url.Values) error {
+	// the ID Token is retrieved every time the access token is refreshed, since these are short-lived
+	token, err := a.token()
+	if err != nil {
+		return err
+	}
+
+	values.Set("client_assertion", token)
+	values.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
+
+func (a *oidcClientAssertion) MarshalJSON() ([]byte, error) {
+	return nil, fmt.Errorf("marshalling an OIDC Client Assertion is not supported")
+}
+
+func (a *oidcClientAssertion) token() (string, error) {
+	if a.config.Token != "" {
+		return a.config.Token, nil
+	}
+
+	if a.config.TokenFilePath != "" {
+		log.Printf("[DEBUG] Reading the OIDC Token from %q", a.config.TokenFilePath)
+		contents, err := ioutil.ReadFile(a.config.TokenFilePath)
+		if err != nil {
+			return "", fmt.Errorf("reading the OIDC Token from %q: %+v", a.config.TokenFilePath, err)
+		}
+		return strings.TrimSpace(string(contents)), nil
+	}
+
+	return a.requestToken()
+}
+
+func (a *oidcClientAssertion) requestToken() (string, error) {
+	requestUrl, err := url.Parse(a.config.RequestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing `oidc_request_url`: %+v", err)
+	}
+	query := requestUrl.Query()
+	query.Set("audience", oidcTokenExchangeAudience)
+	requestUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(a.ctx, http.MethodGet, requestUrl.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("building request for the OIDC Token: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.config.RequestToken))
+
+	log.Printf("[DEBUG] Requesting an OIDC Token from %q", requestUrl.Host)
+	resp, err := a.sender.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting the OIDC Token: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading the OIDC Token response: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("requesting the OIDC Token: received HTTP %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tokenResponse struct {
+		Count *int    `json:"count"`
+		Value *string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", fmt.Errorf("parsing the OIDC Token response: %+v", err)
+	}
+
+	if tokenResponse.Value == nil || *tokenResponse.Value == "" {
+		return "", fmt.Errorf("the OIDC Token response did not contain a token")
+	}
+
+	return *tokenResponse.Value, nil
+}
@@ -18,6 +18,10 @@ import (
 
 type ClientBuilder struct {
 	AuthConfig                  *authentication.Config
+	OIDC                        *OIDCConfig
+	ClientCertificate           *ClientCertificateConfig
+	ManagedIdentity             *ManagedIdentityConfig
+	CustomEnvironment           *CustomEnvironmentConfig
 	DisableCorrelationRequestID bool
 	CustomCorrelationRequestID  string
 	DisableTerraformPartnerID   bool
@@ -26,6 +30,9 @@ type ClientBuilder struct {
 	StorageUseAzureAD           bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	DefaultTags                 map[string]string
+	Retry                       *common.RetryOptions
+	KeyVaultDataPlane           *common.KeyVaultDataPlaneOptions
 }
 
 const azureStackEnvironmentError = `
@@ -38,62 +45,104 @@ and API's available in Azure Stack via Azure Stack Profiles.
 `
 
 func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
-	// point folks towards the separate Azure Stack Provider when using Azure Stack
-	if strings.EqualFold(builder.AuthConfig.Environment, "AZURESTACKCLOUD") {
-		return nil, fmt.Errorf(azureStackEnvironmentError)
+	env, err := environmentForBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
 	}
 
-	isAzureStack, err := authentication.IsEnvironmentAzureStack(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
+	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
 	if err != nil {
-		return nil, fmt.Errorf("unable to determine if environment is Azure Stack: %+v", err)
+		return nil, fmt.Errorf("building OAuth Config: %+v", err)
 	}
-	if isAzureStack {
-		return nil, fmt.Errorf(azureStackEnvironmentError)
+
+	// OAuthConfigForTenant returns a pointer, which can be nil.
+	if oauthConfig == nil {
+		return nil, fmt.Errorf("unable to configure OAuthConfig for tenant %s", builder.AuthConfig.TenantID)
 	}
 
-	env, err := authentication.AzureEnvironmentByNameFromEndpoint(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
-	if err != nil {
-		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
+	sender := sender.BuildSender("AzureRM")
+
+	getADALToken := builder.AuthConfig.GetADALToken
+	if builder.OIDC != nil {
+		if err := builder.OIDC.Validate(*builder.AuthConfig); err != nil {
+			return nil, err
+		}
+
+		getADALToken = func(ctx context.Context, sender autorest.Sender, oauthConfig *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+			return builder.OIDC.Authorizer(ctx, sender, oauthConfig, builder.AuthConfig.ClientID, endpoint)
+		}
 	}
 
-	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
-	if err != nil {
-		return nil, fmt.Errorf("building account: %+v", err)
+	if builder.ManagedIdentity != nil {
+		if err := builder.ManagedIdentity.Validate(); err != nil {
+			return nil, err
+		}
+
+		getADALToken = func(_ context.Context, sender autorest.Sender, _ *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+			return builder.ManagedIdentity.Authorizer(sender, endpoint)
+		}
 	}
 
-	client := Client{
-		Account: account,
+	// the authentication Builder ignores any auxiliary tenants when authenticating using a Client Certificate
+	if builder.OIDC == nil && builder.ClientCertificate != nil && len(builder.AuthConfig.AuxiliaryTenantIDs) > 0 {
+		getADALToken = func(_ context.Context, sender autorest.Sender, oauthConfig *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+			return builder.ClientCertificate.Authorizer(sender, oauthConfig, builder.AuthConfig.ClientID, endpoint)
+		}
 	}
 
-	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("building OAuth Config: %+v", err)
+	authConfig := *builder.AuthConfig
+	// the authentication Builder looks up the Object ID of the Service Principal by the name of the Environment,
+	// which isn't possible for a Custom Environment - and doesn't support OIDC (where the Config is built by hand)
+	// so in both cases we look this up using the Graph endpoint ourselves
+	lookupObjectId := builder.CustomEnvironment != nil && authConfig.AuthenticatedAsAServicePrincipal && authConfig.GetAuthenticatedObjectID != nil
+	if builder.OIDC != nil {
+		lookupObjectId = true
+	}
+	if lookupObjectId {
+		authConfig.GetAuthenticatedObjectID = nil
+		if env.GraphEndpoint != azure.NotAvailable {
+			graphAuth, err := getADALToken(ctx, sender, oauthConfig, env.GraphEndpoint)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get authorization token for graph endpoints: %+v", err)
+			}
+			authConfig.GetAuthenticatedObjectID = servicePrincipalObjectIDFunc(sender, graphAuth, env.GraphEndpoint, authConfig.TenantID, authConfig.ClientID)
+		} else {
+			log.Printf("[DEBUG] Skipping looking up the Object ID of the Service Principal since Graph is not supported in the current Azure Environment")
+		}
 	}
 
-	// OAuthConfigForTenant returns a pointer, which can be nil.
-	if oauthConfig == nil {
-		return nil, fmt.Errorf("unable to configure OAuthConfig for tenant %s", builder.AuthConfig.TenantID)
+	// client declarations:
+	account, err := NewResourceManagerAccount(ctx, authConfig, *env, builder.SkipProviderRegistration)
+	if err != nil {
+		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
-	sender := sender.BuildSender("AzureRM")
+	client := Client{
+		Account:     account,
+		DefaultTags: builder.DefaultTags,
+	}
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.TokenAudience)
+	auth, err := getADALToken(ctx, sender, oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get authorization token for resource manager: %+v", err)
 	}
 
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, graphEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get authorization token for graph endpoints: %+v", err)
+	var graphAuth autorest.Authorizer = nil
+	if graphEndpoint != azure.NotAvailable {
+		graphAuth, err = getADALToken(ctx, sender, oauthConfig, graphEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get authorization token for graph endpoints: %+v", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Skipping building the Graph Authorizer since this is not supported in the current Azure Environment")
 	}
 
 	// Storage Endpoints
-	storageAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.ResourceIdentifiers.Storage)
+	storageAuth, err := getADALToken(ctx, sender, oauthConfig, env.ResourceIdentifiers.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get authorization token for storage endpoints: %+v", err)
 	}
@@ -101,7 +150,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	// Synapse Endpoints
 	var synapseAuth autorest.Authorizer = nil
 	if env.ResourceIdentifiers.Synapse != azure.NotAvailable {
-		synapseAuth, err = builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.ResourceIdentifiers.Synapse)
+		synapseAuth, err = getADALToken(ctx, sender, oauthConfig, env.ResourceIdentifiers.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get authorization token for synapse endpoints: %+v", err)
 		}
@@ -110,12 +159,25 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	// Key Vault Endpoints
-	keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(ctx, sender, oauthConfig)
+	// NOTE: the Bearer Challenge is sent to the Key Vault, so this needs to use the same Sender as the Data Plane
+	keyVaultSender := builder.KeyVaultDataPlane.Sender()
+	keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(ctx, keyVaultSender, oauthConfig)
+	if builder.OIDC != nil {
+		keyVaultAuth = builder.OIDC.BearerAuthorizerCallback(ctx, keyVaultSender, oauthConfig, builder.AuthConfig.ClientID)
+	}
+	if builder.ManagedIdentity != nil {
+		keyVaultAuth = builder.ManagedIdentity.BearerAuthorizerCallback(keyVaultSender)
+	}
 
 	// Batch Management Endpoints
-	batchManagementAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.BatchManagementEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get authorization token for batch management endpoint: %+v", err)
+	var batchManagementAuth autorest.Authorizer = nil
+	if env.BatchManagementEndpoint != azure.NotAvailable {
+		batchManagementAuth, err = getADALToken(ctx, sender, oauthConfig, env.BatchManagementEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get authorization token for batch management endpoint: %+v", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Skipping building the Batch Management Authorizer since this is not supported in the current Azure Environment")
 	}
 
 	o := &common.ClientOptions{
@@ -138,8 +200,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Environment:                 *env,
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		Retry:                       builder.Retry,
+		KeyVaultDataPlane:           builder.KeyVaultDataPlane,
 		TokenFunc: func(endpoint string) (autorest.Authorizer, error) {
-			authorizer, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, endpoint)
+			authorizer, err := getADALToken(ctx, sender, oauthConfig, endpoint)
 			if err != nil {
 				return nil, fmt.Errorf("getting authorization token for endpoint %s: %+v", endpoint, err)
 			}
@@ -158,3 +222,37 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	return &client, nil
 }
+
+// environmentForBuilder returns the Azure Environment which should be used, either from the endpoints of the
+// Custom Environment or by looking the Environment up by name from the Metadata Service
+func environmentForBuilder(ctx context.Context, builder ClientBuilder) (*azure.Environment, error) {
+	if builder.CustomEnvironment != nil {
+		if err := builder.CustomEnvironment.Validate(); err != nil {
+			return nil, err
+		}
+
+		env := builder.CustomEnvironment.Environment()
+		log.Printf("[DEBUG] Using the Custom Environment %q with the Resource Manager endpoint %q", env.Name, env.ResourceManagerEndpoint)
+		return &env, nil
+	}
+
+	// point folks towards the separate Azure Stack Provider when using Azure Stack
+	if strings.EqualFold(builder.AuthConfig.Environment, "AZURESTACKCLOUD") {
+		return nil, fmt.Errorf(azureStackEnvironmentError)
+	}
+
+	isAzureStack, err := authentication.IsEnvironmentAzureStack(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine if environment is Azure Stack: %+v", err)
+	}
+	if isAzureStack {
+		return nil, fmt.Errorf(azureStackEnvironmentError)
+	}
+
+	env, err := authentication.AzureEnvironmentByNameFromEndpoint(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
+	}
+
+	return env, nil
+}
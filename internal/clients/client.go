@@ -26,6 +26,7 @@ import (
 	communication "github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/client"
 	compute "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
 	consumption "github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/client"
+	containerapps "github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/client"
 	containerServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	cosmosdb "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/client"
 	costmanagement "github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/client"
@@ -43,10 +44,14 @@ import (
 	digitaltwins "github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/client"
 	disks "github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/client"
 	dns "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/client"
+	dnsresolver "github.com/hashicorp/terraform-provider-azurerm/internal/services/dnsresolver/client"
 	domainservices "github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/client"
+	elasticsan "github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/client"
 	eventgrid "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/client"
 	eventhub "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/client"
+	fabric "github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/client"
 	firewall "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/client"
+	fleet "github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/client"
 	frontdoor "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/client"
 	hdinsight "github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/client"
 	healthcare "github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/client"
@@ -66,11 +71,13 @@ import (
 	machinelearning "github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/client"
 	maintenance "github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/client"
 	managedapplication "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/client"
+	managedredis "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/client"
 	managementgroup "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/client"
 	maps "github.com/hashicorp/terraform-provider-azurerm/internal/services/maps/client"
 	mariadb "github.com/hashicorp/terraform-provider-azurerm/internal/services/mariadb/client"
 	media "github.com/hashicorp/terraform-provider-azurerm/internal/services/media/client"
 	mixedreality "github.com/hashicorp/terraform-provider-azurerm/internal/services/mixedreality/client"
+	mongocluster "github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/client"
 	monitor "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/client"
 	msi "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/client"
 	mssql "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/client"
@@ -99,11 +106,15 @@ import (
 	signalr "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/client"
 	appPlatform "github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/client"
 	sql "github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/client"
+	standbypool "github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/client"
 	storage "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	storageActions "github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/client"
+	storageMover "github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/client"
 	streamAnalytics "github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/client"
 	subscription "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/client"
 	synapse "github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/client"
 	trafficManager "github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/client"
+	trustedSigning "github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/client"
 	videoAnalyzer "github.com/hashicorp/terraform-provider-azurerm/internal/services/videoanalyzer/client"
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
@@ -116,6 +127,9 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// DefaultTags are the tags which should be applied to all resources which support tags
+	DefaultTags map[string]string
+
 	AadB2c                *aadb2c.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
@@ -136,6 +150,7 @@ type Client struct {
 	Communication         *communication.Client
 	Compute               *compute.Client
 	Consumption           *consumption.Client
+	ContainerApps         *containerapps.Client
 	Containers            *containerServices.Client
 	Cosmos                *cosmosdb.Client
 	CostManagement        *costmanagement.Client
@@ -153,10 +168,14 @@ type Client struct {
 	DigitalTwins          *digitaltwins.Client
 	Disks                 *disks.Client
 	Dns                   *dns.Client
+	DnsResolver           *dnsresolver.Client
 	DomainServices        *domainservices.Client
+	ElasticSan            *elasticsan.Client
 	EventGrid             *eventgrid.Client
 	Eventhub              *eventhub.Client
+	Fabric                *fabric.Client
 	Firewall              *firewall.Client
+	Fleet                 *fleet.Client
 	Frontdoor             *frontdoor.Client
 	HPCCache              *hpccache.Client
 	HSM                   *hsm.Client
@@ -176,11 +195,13 @@ type Client struct {
 	MachineLearning       *machinelearning.Client
 	Maintenance           *maintenance.Client
 	ManagedApplication    *managedapplication.Client
+	ManagedRedis          *managedredis.Client
 	ManagementGroups      *managementgroup.Client
 	Maps                  *maps.Client
 	MariaDB               *mariadb.Client
 	Media                 *media.Client
 	MixedReality          *mixedreality.Client
+	MongoCluster          *mongocluster.Client
 	Monitor               *monitor.Client
 	MSI                   *msi.Client
 	MSSQL                 *mssql.Client
@@ -207,12 +228,16 @@ type Client struct {
 	ServiceFabricMesh     *serviceFabricMesh.Client
 	ServiceFabricManaged  *serviceFabricManaged.Client
 	SignalR               *signalr.Client
+	StandbyPool           *standbypool.Client
 	Storage               *storage.Client
+	StorageActions        *storageActions.Client
+	StorageMover          *storageMover.Client
 	StreamAnalytics       *streamAnalytics.Client
 	Subscription          *subscription.Client
 	Sql                   *sql.Client
 	Synapse               *synapse.Client
 	TrafficManager        *trafficManager.Client
+	TrustedSigning        *trustedSigning.Client
 	VideoAnalyzer         *videoAnalyzer.Client
 	Vmware                *vmware.Client
 	Web                   *web.Client
@@ -248,6 +273,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Communication = communication.NewClient(o)
 	client.Compute = compute.NewClient(o)
 	client.Consumption = consumption.NewClient(o)
+	client.ContainerApps = containerapps.NewClient(o)
 	client.Containers = containerServices.NewClient(o)
 	client.Cosmos = cosmosdb.NewClient(o)
 	client.CostManagement = costmanagement.NewClient(o)
@@ -265,10 +291,14 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.DigitalTwins = digitaltwins.NewClient(o)
 	client.Disks = disks.NewClient(o)
 	client.Dns = dns.NewClient(o)
+	client.DnsResolver = dnsresolver.NewClient(o)
 	client.DomainServices = domainservices.NewClient(o)
+	client.ElasticSan = elasticsan.NewClient(o)
 	client.EventGrid = eventgrid.NewClient(o)
 	client.Eventhub = eventhub.NewClient(o)
+	client.Fabric = fabric.NewClient(o)
 	client.Firewall = firewall.NewClient(o)
+	client.Fleet = fleet.NewClient(o)
 	client.Frontdoor = frontdoor.NewClient(o)
 	client.HPCCache = hpccache.NewClient(o)
 	client.HSM = hsm.NewClient(o)
@@ -288,11 +318,13 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.MachineLearning = machinelearning.NewClient(o)
 	client.Maintenance = maintenance.NewClient(o)
 	client.ManagedApplication = managedapplication.NewClient(o)
+	client.ManagedRedis = managedredis.NewClient(o)
 	client.ManagementGroups = managementgroup.NewClient(o)
 	client.Maps = maps.NewClient(o)
 	client.MariaDB = mariadb.NewClient(o)
 	client.Media = media.NewClient(o)
 	client.MixedReality = mixedreality.NewClient(o)
+	client.MongoCluster = mongocluster.NewClient(o)
 	client.Monitor = monitor.NewClient(o)
 	client.MSI = msi.NewClient(o)
 	client.MSSQL = mssql.NewClient(o)
@@ -320,11 +352,15 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.ServiceFabricMesh = serviceFabricMesh.NewClient(o)
 	client.SignalR = signalr.NewClient(o)
 	client.Sql = sql.NewClient(o)
+	client.StandbyPool = standbypool.NewClient(o)
 	client.Storage = storage.NewClient(o)
+	client.StorageActions = storageActions.NewClient(o)
+	client.StorageMover = storageMover.NewClient(o)
 	client.StreamAnalytics = streamAnalytics.NewClient(o)
 	client.Subscription = subscription.NewClient(o)
 	client.Synapse = synapse.NewClient(o)
 	client.TrafficManager = trafficManager.NewClient(o)
+	client.TrustedSigning = trustedSigning.NewClient(o)
 	client.VideoAnalyzer = videoAnalyzer.NewClient(o)
 	client.Vmware = vmware.NewClient(o)
 	client.Web = web.NewClient(o)
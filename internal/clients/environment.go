@@ -0,0 +1,152 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// storageResourceIdentifier is the resource used to obtain tokens for the Storage Data Plane, which is the
+// same across Cloud Environments
+const storageResourceIdentifier = "https://storage.azure.com/"
+
+// CustomEnvironmentConfig contains the endpoints for a Cloud Environment which isn't known to the Azure SDK
+// (for example Azure Stack Hub or a disconnected sovereign cloud), which are used instead of looking the
+// Environment up by name from the Metadata Service
+type CustomEnvironmentConfig struct {
+	// Name is the name of the Cloud Environment
+	Name string
+
+	// ResourceManagerEndpoint is the Azure Resource Manager endpoint (e.g. `https://management.local.azurestack.external/`)
+	ResourceManagerEndpoint string
+
+	// ResourceManagerAudience is the audience used to obtain tokens for Azure Resource Manager,
+	// when unset this defaults to the ResourceManagerEndpoint
+	ResourceManagerAudience string
+
+	// ActiveDirectoryEndpoint is the authority used to obtain tokens (e.g. `https://login.local.azurestack.external/`)
+	ActiveDirectoryEndpoint string
+
+	// GraphEndpoint is the Graph endpoint, when unset the resources which require Graph are unavailable
+	GraphEndpoint string
+
+	// KeyVaultDNSSuffix is the DNS suffix used for Key Vaults (e.g. `vault.local.azurestack.external`)
+	KeyVaultDNSSuffix string
+
+	// StorageEndpointSuffix is the DNS suffix used for Storage Accounts (e.g. `local.azurestack.external`)
+	StorageEndpointSuffix string
+}
+
+func (c CustomEnvironmentConfig) Validate() error {
+	if c.ResourceManagerEndpoint == "" {
+		return fmt.Errorf("`resource_manager_endpoint` must be specified when using a Custom Environment")
+	}
+	if c.ActiveDirectoryEndpoint == "" {
+		return fmt.Errorf("`active_directory_endpoint` must be specified when using a Custom Environment")
+	}
+
+	return nil
+}
+
+// Environment returns the Azure Environment for the Custom Environment, any endpoints which aren't configured
+// are marked as Not Available so that the clients depending on them are skipped
+func (c CustomEnvironmentConfig) Environment() azure.Environment {
+	resourceManagerEndpoint := withTrailingSlash(c.ResourceManagerEndpoint)
+	audience := resourceManagerEndpoint
+	if c.ResourceManagerAudience != "" {
+		audience = c.ResourceManagerAudience
+	}
+
+	graphEndpoint := azure.NotAvailable
+	if c.GraphEndpoint != "" {
+		graphEndpoint = withTrailingSlash(c.GraphEndpoint)
+	}
+
+	keyVaultEndpoint := azure.NotAvailable
+	keyVaultDNSSuffix := azure.NotAvailable
+	if c.KeyVaultDNSSuffix != "" {
+		keyVaultDNSSuffix = strings.TrimPrefix(c.KeyVaultDNSSuffix, ".")
+		keyVaultEndpoint = fmt.Sprintf("https://%s/", keyVaultDNSSuffix)
+	}
+
+	storageEndpointSuffix := azure.NotAvailable
+	if c.StorageEndpointSuffix != "" {
+		storageEndpointSuffix = strings.TrimPrefix(c.StorageEndpointSuffix, ".")
+	}
+
+	return azure.Environment{
+		Name:                         c.Name,
+		ManagementPortalURL:          azure.NotAvailable,
+		PublishSettingsURL:           azure.NotAvailable,
+		ServiceManagementEndpoint:    azure.NotAvailable,
+		ResourceManagerEndpoint:      resourceManagerEndpoint,
+		ActiveDirectoryEndpoint:      withTrailingSlash(c.ActiveDirectoryEndpoint),
+		GalleryEndpoint:              azure.NotAvailable,
+		KeyVaultEndpoint:             keyVaultEndpoint,
+		GraphEndpoint:                graphEndpoint,
+		ServiceBusEndpoint:           azure.NotAvailable,
+		BatchManagementEndpoint:      azure.NotAvailable,
+		StorageEndpointSuffix:        storageEndpointSuffix,
+		CosmosDBDNSSuffix:            azure.NotAvailable,
+		MariaDBDNSSuffix:             azure.NotAvailable,
+		MySQLDatabaseDNSSuffix:       azure.NotAvailable,
+		PostgresqlDatabaseDNSSuffix:  azure.NotAvailable,
+		SQLDatabaseDNSSuffix:         azure.NotAvailable,
+		TrafficManagerDNSSuffix:      azure.NotAvailable,
+		KeyVaultDNSSuffix:            keyVaultDNSSuffix,
+		ServiceBusEndpointSuffix:     azure.NotAvailable,
+		ServiceManagementVMDNSSuffix: azure.NotAvailable,
+		ResourceManagerVMDNSSuffix:   azure.NotAvailable,
+		ContainerRegistryDNSSuffix:   azure.NotAvailable,
+		TokenAudience:                audience,
+		APIManagementHostNameSuffix:  azure.NotAvailable,
+		SynapseEndpointSuffix:        azure.NotAvailable,
+		ResourceIdentifiers: azure.ResourceIdentifier{
+			Graph:               graphEndpoint,
+			KeyVault:            keyVaultEndpoint,
+			Datalake:            azure.NotAvailable,
+			Batch:               azure.NotAvailable,
+			OperationalInsights: azure.NotAvailable,
+			OSSRDBMS:            azure.NotAvailable,
+			Storage:             storageResourceIdentifier,
+			Synapse:             azure.NotAvailable,
+			ServiceBus:          azure.NotAvailable,
+			SQLDatabase:         azure.NotAvailable,
+		},
+	}
+}
+
+// servicePrincipalObjectIDFunc returns a function which looks up the Object ID of the Service Principal using the
+// specified Graph endpoint, for use where the authentication Builder is unable to look this up
+func servicePrincipalObjectIDFunc(sender autorest.Sender, authorizer autorest.Authorizer, graphEndpoint, tenantId, clientId string) func(ctx context.Context) (*string, error) {
+	return func(ctx context.Context) (*string, error) {
+		client := graphrbac.NewServicePrincipalsClientWithBaseURI(withTrailingSlash(graphEndpoint), tenantId)
+		client.Authorizer = authorizer
+		client.Sender = sender
+
+		log.Printf("[DEBUG] Looking up the Object ID for the Service Principal %q..", clientId)
+		result, err := client.List(ctx, fmt.Sprintf("appId eq '%s'", clientId))
+		if err != nil {
+			return nil, fmt.Errorf("listing Service Principals: %+v", err)
+		}
+
+		if len(result.Values()) != 1 || result.Values()[0].ObjectID == nil {
+			return nil, fmt.Errorf("expected a single Service Principal with the Client ID %q but got %d", clientId, len(result.Values()))
+		}
+
+		return result.Values()[0].ObjectID, nil
+	}
+}
+
+func withTrailingSlash(input string) string {
+	if strings.HasSuffix(input, "/") {
+		return input
+	}
+
+	return input + "/"
+}
@@ -36,6 +36,12 @@ type ClientOptions struct {
 	Features                    features.UserFeatures
 	StorageUseAzureAD           bool
 
+	// Retry overrides the default retry behaviour for all clients, when specified
+	Retry *RetryOptions
+
+	// KeyVaultDataPlane configures how the Key Vault Data Plane is reached, when specified
+	KeyVaultDataPlane *KeyVaultDataPlaneOptions
+
 	// Some Dataplane APIs require a token scoped for a specific endpoint
 	TokenFunc func(endpoint string) (autorest.Authorizer, error)
 }
@@ -53,6 +59,22 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 		}
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
+	if o.Retry != nil {
+		// NOTE: this replaces the default retry behaviour, which also registers any Resource Providers which
+		// aren't registered - as such this is chained with the registration, which (unless skipped) is still
+		// required for Resource Providers which aren't registered when the Provider is configured
+		c.RetryAttempts = o.Retry.MaxAttempts
+		c.SendDecorators = []autorest.SendDecorator{
+			o.Retry.SendDecorator(),
+			o.Retry.withResourceProviderRegistration(*c),
+		}
+	}
+}
+
+// ConfigureKeyVaultDataPlaneClient configures a client used to access the Key Vault Data Plane
+func (o ClientOptions) ConfigureKeyVaultDataPlaneClient(c *autorest.Client) {
+	o.ConfigureClient(c, o.KeyVaultAuthorizer)
+	c.Sender = o.KeyVaultDataPlane.Sender()
 }
 
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
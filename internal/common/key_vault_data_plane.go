@@ -0,0 +1,223 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/http/httputil"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/sender"
+)
+
+// keyVaultDataPlaneConnectionRetryMaxBackoff is the maximum delay between two attempts to connect to the Data Plane
+const keyVaultDataPlaneConnectionRetryMaxBackoff = 30 * time.Second
+
+// KeyVaultDataPlaneOptions configures how requests to the Key Vault Data Plane (used to manage Keys, Secrets and
+// Certificates) are sent, which allows a Key Vault which is only reachable through a Private Endpoint to be managed
+// from a host which would otherwise resolve the public DNS records for the Key Vault
+type KeyVaultDataPlaneOptions struct {
+	// DNSServers is a list of DNS Servers (in the form `host:port`) which should be used to resolve hostnames,
+	// these are tried in order
+	DNSServers []string
+
+	// HostOverrides is a map of hostnames to the IP Address which should be used to connect to them,
+	// which takes precedence over DNSServers
+	HostOverrides map[string]string
+
+	// ConnectionRetryTimeout is the maximum amount of time spent retrying a request which fails to connect,
+	// for example whilst the DNS records for a newly created Private Endpoint propagate
+	ConnectionRetryTimeout time.Duration
+}
+
+// Sender returns a Sender which should be used for requests to the Key Vault Data Plane, this is safe to call
+// on a nil KeyVaultDataPlaneOptions - in which case the default resolution behaviour is used
+func (o *KeyVaultDataPlaneOptions) Sender() autorest.Sender {
+	if o == nil || (len(o.DNSServers) == 0 && len(o.HostOverrides) == 0 && o.ConnectionRetryTimeout == 0) {
+		return autorest.DecorateSender(sender.BuildSender("AzureRM"), withKeyVaultDataPlaneDiagnostics())
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if len(o.DNSServers) > 0 {
+		dialer.Resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var err error
+				for _, server := range o.DNSServers {
+					var conn net.Conn
+					if conn, err = dialer.DialContext(ctx, network, server); err == nil {
+						return conn, nil
+					}
+				}
+				return nil, err
+			},
+		}
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				host, port, err := net.SplitHostPort(address)
+				if err != nil {
+					return nil, err
+				}
+				if ip, ok := o.HostOverrides[strings.ToLower(host)]; ok {
+					log.Printf("[DEBUG] Connecting to %q using the overridden IP Address %q", host, ip)
+					address = net.JoinHostPort(ip, port)
+				}
+				return dialer.DialContext(ctx, network, address)
+			},
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+	}
+
+	return autorest.DecorateSender(
+		client,
+		withRequestLogging(),
+		withKeyVaultDataPlaneConnectionRetries(o.ConnectionRetryTimeout),
+		withKeyVaultDataPlaneDiagnostics(),
+	)
+}
+
+// withRequestLogging logs requests and responses in the same manner as the Sender returned from `sender.BuildSender`,
+// which can't be used here since it doesn't allow the underlying http.Client to be configured
+func withRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// strip the authorization header prior to printing
+			auth := r.Header.Get("Authorization")
+			if auth != "" {
+				r.Header.Del("Authorization")
+			}
+			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+			} else {
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
+			}
+			if auth != "" {
+				r.Header.Add("Authorization", auth)
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+				} else {
+					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+				}
+			} else if err != nil {
+				log.Printf("[DEBUG] AzureRM Response Error: %s for %s\n", err, r.URL)
+			}
+			return resp, err
+		})
+	}
+}
+
+// withKeyVaultDataPlaneConnectionRetries retries requests which fail to connect until the timeout is reached
+func withKeyVaultDataPlaneConnectionRetries(timeout time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if timeout <= 0 {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			rr := autorest.NewRetriableRequest(r)
+			start := time.Now()
+			delay := autorest.DefaultPollingDelay
+
+			for attempt := 1; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				if err == nil || !isConnectionError(err) {
+					return resp, err
+				}
+
+				if time.Since(start)+delay > timeout {
+					log.Printf("[DEBUG] Not retrying %s %s since the connection retry timeout (%s) would be exceeded", r.Method, r.URL, timeout)
+					return resp, err
+				}
+
+				log.Printf("[DEBUG] Unable to connect to %q (attempt %d), retrying in %s: %+v", r.URL.Host, attempt, delay, err)
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+
+				if delay *= 2; delay > keyVaultDataPlaneConnectionRetryMaxBackoff {
+					delay = keyVaultDataPlaneConnectionRetryMaxBackoff
+				}
+			}
+		})
+	}
+}
+
+// withKeyVaultDataPlaneDiagnostics surfaces the likely cause when a request to the Data Plane fails because
+// the Key Vault isn't reachable from the host running Terraform (e.g. when Public Network Access is disabled)
+func withKeyVaultDataPlaneDiagnostics() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			var remoteAddress string
+			trace := &httptrace.ClientTrace{
+				GotConn: func(info httptrace.GotConnInfo) {
+					// NOTE: the connection used when dumping the request for logging purposes has no address
+					if info.Conn != nil && info.Conn.RemoteAddr() != nil {
+						remoteAddress = info.Conn.RemoteAddr().String()
+					}
+				},
+			}
+
+			resp, err := s.Do(r.WithContext(httptrace.WithClientTrace(r.Context(), trace)))
+			if err != nil {
+				if isConnectionError(err) {
+					return resp, fmt.Errorf("connecting to the Key Vault Data Plane at %q: %w\n\n%s", r.URL.Host, err, keyVaultDataPlaneConnectivityHint)
+				}
+				return resp, err
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusForbidden && resp.Body != nil {
+				body, readErr := ioutil.ReadAll(resp.Body)
+				resp.Body.Close()
+				resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+				if readErr == nil && bytes.Contains(body, []byte("ForbiddenByConnection")) {
+					log.Printf("[WARN] The request to the Key Vault Data Plane at %q was sent to %q, which was rejected since it wasn't received through a Private Endpoint.\n\n%s", r.URL.Host, remoteAddress, keyVaultDataPlaneConnectivityHint)
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
+
+const keyVaultDataPlaneConnectivityHint = `This can happen when Public Network Access is disabled for the Key Vault and the host running
+Terraform is unable to resolve or reach the Private Endpoint for the Key Vault. The host running
+Terraform must resolve the hostname of the Key Vault to the IP Address of the Private Endpoint -
+alternatively the "key_vault_data_plane" block within the Provider block can be used to specify
+the DNS Servers, or an IP Address for this hostname, which should be used instead.`
+
+// isConnectionError returns whether the error means a connection to the remote host couldn't be established
+func isConnectionError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,115 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestIsConnectionError(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    error
+		Expected bool
+	}{
+		{
+			Name:     "DNS Error",
+			Input:    &net.DNSError{Err: "no such host", Name: "example.vault.azure.net"},
+			Expected: true,
+		},
+		{
+			Name:     "Wrapped DNS Error",
+			Input:    fmt.Errorf("sending request: %w", &net.DNSError{Err: "no such host", Name: "example.vault.azure.net"}),
+			Expected: true,
+		},
+		{
+			Name:     "Dial Error",
+			Input:    &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			Expected: true,
+		},
+		{
+			Name:     "Read Error",
+			Input:    &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")},
+			Expected: false,
+		},
+		{
+			Name:     "Other Error",
+			Input:    errors.New("some other error"),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+		if actual := isConnectionError(v.Input); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestKeyVaultDataPlaneOptionsHostOverrides(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", server.URL, err)
+	}
+	_, port, err := net.SplitHostPort(serverUrl.Host)
+	if err != nil {
+		t.Fatalf("splitting %q: %+v", serverUrl.Host, err)
+	}
+
+	options := &KeyVaultDataPlaneOptions{
+		HostOverrides: map[string]string{
+			"example.vault.azure.net": "127.0.0.1",
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://example.vault.azure.net:%s/secrets", port), nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := options.Sender().Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a status of %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestKeyVaultDataPlaneOptionsDiagnostics(t *testing.T) {
+	options := &KeyVaultDataPlaneOptions{
+		HostOverrides: map[string]string{
+			// nothing listens on the discard port, so connecting will fail
+			"example.vault.azure.net": "127.0.0.1",
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.vault.azure.net:9/secrets", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	_, err = options.Sender().Do(req)
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !isConnectionError(err) {
+		t.Fatalf("expected a connection error but got: %+v", err)
+	}
+	if !strings.Contains(err.Error(), "key_vault_data_plane") {
+		t.Fatalf("expected the error to contain the connectivity hint but got: %+v", err)
+	}
+}
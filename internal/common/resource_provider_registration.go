@@ -0,0 +1,136 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// withResourceProviderRegistration returns a SendDecorator which registers the Resource Provider (and then retries
+// the request) when a request fails since the Resource Provider isn't registered. This matches the behaviour of
+// `azure.DoRetryWithRegistration` - which can't be combined with the RetryOptions since it retries throttled
+// requests indefinitely - and as such any requests sent when registering the Resource Provider use these RetryOptions
+func (o RetryOptions) withResourceProviderRegistration(client autorest.Client) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(rr.Request())
+			if err != nil || client.SkipResourceProviderRegistration || resp.StatusCode != http.StatusConflict {
+				return resp, err
+			}
+
+			providerName, err := unregisteredResourceProviderName(resp)
+			if err != nil || providerName == "" {
+				return resp, err
+			}
+
+			subscriptionId := subscriptionIdFromPath(r.URL.Path)
+			if subscriptionId == "" {
+				return resp, nil
+			}
+
+			baseUri := url.URL{
+				Scheme: r.URL.Scheme,
+				Host:   r.URL.Host,
+			}
+			providersClient := resources.NewProvidersClientWithBaseURI(baseUri.String(), subscriptionId)
+			providersClient.Client = client
+			providersClient.SendDecorators = []autorest.SendDecorator{o.SendDecorator()}
+
+			if err := registerResourceProvider(r, providersClient, providerName); err != nil {
+				return resp, fmt.Errorf("registering the Resource Provider %q: %+v", providerName, err)
+			}
+
+			if err := rr.Prepare(); err != nil {
+				return resp, err
+			}
+			autorest.DrainResponseBody(resp)
+			return s.Do(rr.Request())
+		})
+	}
+}
+
+// unregisteredResourceProviderName returns the name of the Resource Provider which needs to be registered, when the
+// response contains a `MissingSubscriptionRegistration` error - the response body remains readable afterwards
+func unregisteredResourceProviderName(resp *http.Response) (string, error) {
+	if resp.Body == nil {
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	var requestError azure.RequestError
+	if err := json.Unmarshal(body, &requestError); err != nil {
+		// the error isn't in the expected format, so this isn't an unregistered Resource Provider
+		return "", nil
+	}
+
+	serviceError := requestError.ServiceError
+	if serviceError == nil || serviceError.Code != "MissingSubscriptionRegistration" || len(serviceError.Details) == 0 {
+		return "", nil
+	}
+
+	target, _ := serviceError.Details[0]["target"].(string)
+	return target, nil
+}
+
+// registerResourceProvider registers the Resource Provider and waits for the registration to complete
+func registerResourceProvider(r *http.Request, client resources.ProvidersClient, providerName string) error {
+	ctx := r.Context()
+
+	log.Printf("[DEBUG] Registering the Resource Provider %q..", providerName)
+	provider, err := client.Register(ctx, providerName)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	for provider.RegistrationState == nil || !strings.EqualFold(*provider.RegistrationState, "Registered") {
+		if client.PollingDuration != 0 && time.Since(start) > client.PollingDuration {
+			return fmt.Errorf("timed out waiting for the Resource Provider %q to be registered", providerName)
+		}
+
+		if !autorest.DelayForBackoff(client.PollingDelay, 0, ctx.Done()) {
+			return ctx.Err()
+		}
+
+		log.Printf("[DEBUG] Waiting for the Resource Provider %q to be registered..", providerName)
+		provider, err = client.Get(ctx, providerName, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// subscriptionIdFromPath returns the Subscription ID from the path of a Resource Manager request
+func subscriptionIdFromPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, v := range segments {
+		if strings.EqualFold(v, "subscriptions") && i+1 < len(segments) {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,128 @@
+package common
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryOptionsWithResourceProviderRegistration(t *testing.T) {
+	const unregistered = `{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.Example'.","details":[{"code":"MissingSubscriptionRegistration","target":"Microsoft.Example","message":"The subscription is not registered to use namespace 'Microsoft.Example'."}]}}`
+	const conflict = `{"error":{"code":"Conflict","message":"Another operation is in progress."}}`
+
+	testData := []struct {
+		Name                     string
+		SkipRegistration         bool
+		Responses                []string
+		ExpectedRegistrations    int
+		ExpectedRequests         int
+		ExpectedStatus           int
+		ExpectedRegistrationPoll int
+	}{
+		{
+			Name:             "Success",
+			Responses:        []string{""},
+			ExpectedRequests: 1,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:                     "Unregistered then Success",
+			Responses:                []string{unregistered, ""},
+			ExpectedRegistrations:    1,
+			ExpectedRegistrationPoll: 1,
+			ExpectedRequests:         2,
+			ExpectedStatus:           http.StatusOK,
+		},
+		{
+			Name:             "Unregistered with Registration Skipped",
+			SkipRegistration: true,
+			Responses:        []string{unregistered, ""},
+			ExpectedRequests: 1,
+			ExpectedStatus:   http.StatusConflict,
+		},
+		{
+			Name:             "Other Conflict",
+			Responses:        []string{conflict, ""},
+			ExpectedRequests: 1,
+			ExpectedStatus:   http.StatusConflict,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		requests := 0
+		registrations := 0
+		polls := 0
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			statusCode := http.StatusOK
+			body := ""
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Example/register"):
+				registrations++
+				body = `{"namespace":"Microsoft.Example","registrationState":"Registering"}`
+			case strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Example"):
+				polls++
+				body = `{"namespace":"Microsoft.Example","registrationState":"Registered"}`
+			default:
+				body = v.Responses[requests]
+				requests++
+				if body != "" {
+					statusCode = http.StatusConflict
+				}
+			}
+			return &http.Response{
+				StatusCode: statusCode,
+				Header: http.Header{
+					"Content-Type": []string{"application/json"},
+				},
+				Body:    ioutil.NopCloser(bytes.NewReader([]byte(body))),
+				Request: r,
+			}, nil
+		})
+
+		client := autorest.NewClientWithUserAgent("")
+		client.Sender = sender
+		client.PollingDelay = 0
+		client.SkipResourceProviderRegistration = v.SkipRegistration
+
+		options := RetryOptions{MaxAttempts: 3}
+		req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Example/things/thing1", nil)
+		resp, err := autorest.SendWithSender(sender, req, options.SendDecorator(), options.withResourceProviderRegistration(client))
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if requests != v.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.ExpectedRequests, requests)
+		}
+		if registrations != v.ExpectedRegistrations {
+			t.Fatalf("expected %d registrations but got %d", v.ExpectedRegistrations, registrations)
+		}
+		if polls != v.ExpectedRegistrationPoll {
+			t.Fatalf("expected %d registration polls but got %d", v.ExpectedRegistrationPoll, polls)
+		}
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("expected the status code %d but got %d", v.ExpectedStatus, resp.StatusCode)
+		}
+	}
+}
+
+func TestConfigureClientWithRetryKeepsResourceProviderRegistration(t *testing.T) {
+	client := autorest.NewClientWithUserAgent("")
+	options := ClientOptions{
+		Retry: &RetryOptions{MaxAttempts: 2},
+	}
+	options.ConfigureClient(&client, nil)
+
+	if client.RetryAttempts != 2 {
+		t.Fatalf("expected 2 retry attempts but got %d", client.RetryAttempts)
+	}
+	if len(client.SendDecorators) != 2 {
+		t.Fatalf("expected the retry and Resource Provider registration SendDecorators but got %d", len(client.SendDecorators))
+	}
+}
@@ -0,0 +1,117 @@
+package common
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// retryMaxBackoff is the maximum delay between two attempts when no `Retry-After` header is used
+const retryMaxBackoff = 5 * time.Minute
+
+// RetryOptions configures how requests which fail due to throttling (HTTP 429) or a transient
+// error (HTTP 408 or 5xx) are retried
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of times a request will be retried
+	MaxAttempts int
+
+	// MaxElapsedTime is the maximum amount of time spent retrying a request, a zero value means unlimited
+	MaxElapsedTime time.Duration
+
+	// HonorRetryAfter specifies whether the delay returned in the `Retry-After` header should be
+	// used, rather than an exponential backoff
+	HonorRetryAfter bool
+}
+
+// DefaultRetryOptions returns the RetryOptions matching the default behaviour of the Azure SDK
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts:     autorest.DefaultRetryAttempts,
+		HonorRetryAfter: true,
+	}
+}
+
+// WithContext returns a Context which overrides the retry behaviour for all requests sent using it,
+// which allows the retry behaviour to be customised for a specific (e.g. long-running) operation
+func (o RetryOptions) WithContext(ctx context.Context) context.Context {
+	return autorest.WithSendDecorators(ctx, []autorest.SendDecorator{o.SendDecorator()})
+}
+
+// SendDecorator returns a SendDecorator which retries requests using these RetryOptions
+func (o RetryOptions) SendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			rr := autorest.NewRetriableRequest(r)
+			start := time.Now()
+
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				autorest.DrainResponseBody(resp)
+				resp, err = s.Do(rr.Request())
+
+				// a failure to obtain a token will never succeed, so there's no point retrying
+				if err == nil && !autorest.ResponseHasStatusCode(resp, autorest.StatusCodesForRetry...) || autorest.IsTokenRefreshError(err) {
+					return resp, err
+				}
+
+				if attempt >= o.MaxAttempts {
+					log.Printf("[DEBUG] Not retrying %s %s since the maximum number of attempts (%d) has been reached", r.Method, r.URL, o.MaxAttempts)
+					return resp, err
+				}
+
+				delay := o.delay(resp, attempt)
+				if o.MaxElapsedTime > 0 && time.Since(start)+delay > o.MaxElapsedTime {
+					log.Printf("[DEBUG] Not retrying %s %s since the maximum elapsed time (%s) would be exceeded", r.Method, r.URL, o.MaxElapsedTime)
+					return resp, err
+				}
+
+				log.Printf("[DEBUG] Retrying %s %s in %s (attempt %d of %d)", r.Method, r.URL, delay, attempt+1, o.MaxAttempts)
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// delay returns the duration to wait before the next attempt
+func (o RetryOptions) delay(resp *http.Response, attempt int) time.Duration {
+	if o.HonorRetryAfter {
+		if delay := retryAfter(resp); delay > 0 {
+			return delay
+		}
+	}
+
+	delay := time.Duration(autorest.DefaultRetryDuration.Seconds()*math.Pow(2, float64(attempt))) * time.Second
+	if delay > retryMaxBackoff {
+		delay = retryMaxBackoff
+	}
+	return delay
+}
+
+// retryAfter parses the `Retry-After` header, which is either a number of seconds or a date in RFC1123 format
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := time.Parse(time.RFC1123, v); err == nil {
+		return time.Until(t)
+	}
+
+	return 0
+}
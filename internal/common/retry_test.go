@@ -0,0 +1,136 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryOptionsDelay(t *testing.T) {
+	throttled := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After": []string{"7"},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Options  RetryOptions
+		Response *http.Response
+		Attempt  int
+		Expected time.Duration
+	}{
+		{
+			Name:     "Retry-After honored",
+			Options:  RetryOptions{HonorRetryAfter: true},
+			Response: throttled,
+			Expected: 7 * time.Second,
+		},
+		{
+			Name:     "Retry-After ignored",
+			Options:  RetryOptions{HonorRetryAfter: false},
+			Response: throttled,
+			Attempt:  1,
+			Expected: 2 * autorest.DefaultRetryDuration,
+		},
+		{
+			Name:     "No Retry-After header",
+			Options:  RetryOptions{HonorRetryAfter: true},
+			Response: &http.Response{StatusCode: http.StatusInternalServerError},
+			Expected: autorest.DefaultRetryDuration,
+		},
+		{
+			Name:     "Backoff is capped",
+			Options:  RetryOptions{},
+			Response: nil,
+			Attempt:  10,
+			Expected: retryMaxBackoff,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+		if actual := v.Options.delay(v.Response, v.Attempt); actual != v.Expected {
+			t.Fatalf("expected a delay of %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestRetryOptionsSendDecorator(t *testing.T) {
+	testData := []struct {
+		Name             string
+		Options          RetryOptions
+		StatusCodes      []int
+		ExpectedAttempts int
+		ExpectedStatus   int
+	}{
+		{
+			Name:             "Success",
+			Options:          RetryOptions{MaxAttempts: 3},
+			StatusCodes:      []int{http.StatusOK},
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Throttled then Success",
+			Options:          RetryOptions{MaxAttempts: 3, HonorRetryAfter: true},
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			ExpectedAttempts: 3,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Maximum Attempts Reached",
+			Options:          RetryOptions{MaxAttempts: 1, HonorRetryAfter: true},
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			ExpectedAttempts: 2,
+			ExpectedStatus:   http.StatusTooManyRequests,
+		},
+		{
+			Name:             "Maximum Elapsed Time Reached",
+			Options:          RetryOptions{MaxAttempts: 3, MaxElapsedTime: 500 * time.Millisecond, HonorRetryAfter: true},
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusTooManyRequests,
+		},
+		{
+			Name:             "Not Retryable",
+			Options:          RetryOptions{MaxAttempts: 3},
+			StatusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusBadRequest,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		attempts := 0
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			statusCode := v.StatusCodes[attempts]
+			attempts++
+			return &http.Response{
+				StatusCode: statusCode,
+				Header: http.Header{
+					"Retry-After": []string{"1"},
+				},
+				Request: r,
+			}, nil
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+		resp, err := autorest.SendWithSender(sender, req, v.Options.SendDecorator())
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("expected %d attempts but got %d", v.ExpectedAttempts, attempts)
+		}
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("expected the status code %d but got %d", v.ExpectedStatus, resp.StatusCode)
+		}
+	}
+}
@@ -6,6 +6,10 @@ func Default() UserFeatures {
 		ApiManagement: ApiManagementFeatures{
 			PurgeSoftDeleteOnDestroy: false,
 		},
+		AppConfiguration: AppConfigurationFeatures{
+			PurgeOnDestroy:     true,
+			RecoverSoftDeleted: true,
+		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
@@ -22,12 +26,19 @@ func Default() UserFeatures {
 		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
 			PermanentlyDeleteOnDestroy: false,
 		},
+		ManagedHSM: ManagedHSMFeatures{
+			PurgeOnDestroy:     true,
+			RecoverSoftDeleted: true,
+		},
 		Network: NetworkFeatures{
 			RelaxedLocking: false,
 		},
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: false,
 		},
+		Storage: StorageFeatures{
+			DataPlaneAvailable: true,
+		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
 		},
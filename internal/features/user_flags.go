@@ -2,14 +2,17 @@ package features
 
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
+	AppConfiguration       AppConfigurationFeatures
 	CognitiveAccount       CognitiveAccountFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
+	ManagedHSM             ManagedHSMFeatures
 	Network                NetworkFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
+	Storage                StorageFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -43,6 +46,10 @@ type NetworkFeatures struct {
 	RelaxedLocking bool
 }
 
+type StorageFeatures struct {
+	DataPlaneAvailable bool
+}
+
 type TemplateDeploymentFeatures struct {
 	DeleteNestedItemsDuringDeletion bool
 }
@@ -58,3 +65,13 @@ type ResourceGroupFeatures struct {
 type ApiManagementFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
+
+type AppConfigurationFeatures struct {
+	PurgeOnDestroy     bool
+	RecoverSoftDeleted bool
+}
+
+type ManagedHSMFeatures struct {
+	PurgeOnDestroy     bool
+	RecoverSoftDeleted bool
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaCustomEnvironment() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The endpoints of a Cloud Environment which isn't available from the Metadata Service, such as Azure Stack Hub or a disconnected sovereign cloud. When specified, the `environment` and `metadata_host` fields are ignored.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "custom",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Cloud Environment.",
+				},
+
+				"resource_manager_endpoint": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The Azure Resource Manager endpoint for the Cloud Environment.",
+				},
+
+				"resource_manager_audience": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The audience used to obtain tokens for Azure Resource Manager. Defaults to the `resource_manager_endpoint`.",
+				},
+
+				"active_directory_endpoint": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The Azure Active Directory authority used to obtain tokens for the Cloud Environment.",
+				},
+
+				"graph_endpoint": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The Graph endpoint for the Cloud Environment, used to look up the Object ID of the Service Principal and by resources which query Azure Active Directory.",
+				},
+
+				"key_vault_dns_suffix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The DNS suffix used for Key Vaults in the Cloud Environment.",
+				},
+
+				"storage_endpoint_suffix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The DNS suffix used for Storage Accounts in the Cloud Environment.",
+				},
+			},
+		},
+	}
+}
+
+func expandCustomEnvironment(input []interface{}) *clients.CustomEnvironmentConfig {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &clients.CustomEnvironmentConfig{
+		Name:                    raw["name"].(string),
+		ResourceManagerEndpoint: raw["resource_manager_endpoint"].(string),
+		ResourceManagerAudience: raw["resource_manager_audience"].(string),
+		ActiveDirectoryEndpoint: raw["active_directory_endpoint"].(string),
+		GraphEndpoint:           raw["graph_endpoint"].(string),
+		KeyVaultDNSSuffix:       raw["key_vault_dns_suffix"].(string),
+		StorageEndpointSuffix:   raw["storage_endpoint_suffix"].(string),
+	}
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+func schemaDefaultTags() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags which should be applied to all resources which support tags. Tags specified on a resource take precedence over these.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": tags.Schema(),
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	for k, v := range raw["tags"].(map[string]interface{}) {
+		output[k] = v.(string)
+	}
+
+	return output
+}
+
+// supportsDefaultTags returns whether the specified Resource exposes a user-configurable `tags` map
+func supportsDefaultTags(resource *schema.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	if !ok {
+		return false
+	}
+
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return false
+	}
+
+	return v.Type == schema.TypeMap && (v.Optional || v.Required)
+}
+
+// addDefaultTagsToResource wraps the Create, Read and Update functions of the specified Resource so that
+// the `default_tags` configured in the Provider block are merged into the tags sent to Azure, and then
+// removed from the tags persisted into the State (unless they're explicitly configured on the Resource),
+// to avoid a perpetual diff between the Configuration and the State.
+//
+// The merged tags are exposed in the computed `tags_all` attribute, which is planned during the diff so
+// that any change to the `default_tags` (such as adding a new key) results in the Resource being updated.
+func addDefaultTagsToResource(resource *schema.Resource) {
+	resource.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	// tags which can't be updated in-place can't be assigned to existing resources, rather than
+	// replacing these resources the `default_tags` are only assigned when the resource is created
+	updatable := !resource.Schema["tags"].ForceNew
+	if customizeDiff := resource.CustomizeDiff; customizeDiff != nil {
+		resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+			return planDefaultTags(d, meta, updatable)
+		}
+	} else {
+		resource.CustomizeDiff = func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return planDefaultTags(d, meta, updatable)
+		}
+	}
+
+	if resource.Create != nil {
+		create := resource.Create
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			configured, err := applyDefaultTags(d, meta)
+			if err != nil {
+				return err
+			}
+			if err := create(d, meta); err != nil {
+				return err
+			}
+			return removeDefaultTags(d, meta, configured)
+		}
+	}
+	if resource.CreateContext != nil {
+		create := resource.CreateContext
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured, err := applyDefaultTags(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			diags := create(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, diag.FromErr(removeDefaultTags(d, meta, configured))...)
+		}
+	}
+
+	if resource.Read != nil {
+		read := resource.Read
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			configured := configuredTagKeys(d)
+			if err := read(d, meta); err != nil {
+				return err
+			}
+			return removeDefaultTags(d, meta, configured)
+		}
+	}
+	if resource.ReadContext != nil {
+		read := resource.ReadContext
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := configuredTagKeys(d)
+			diags := read(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, diag.FromErr(removeDefaultTags(d, meta, configured))...)
+		}
+	}
+
+	if resource.Update != nil {
+		update := resource.Update
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			configured, err := applyDefaultTags(d, meta)
+			if err != nil {
+				return err
+			}
+			if err := update(d, meta); err != nil {
+				return err
+			}
+			return removeDefaultTags(d, meta, configured)
+		}
+	}
+	if resource.UpdateContext != nil {
+		update := resource.UpdateContext
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured, err := applyDefaultTags(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			diags := update(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, diag.FromErr(removeDefaultTags(d, meta, configured))...)
+		}
+	}
+}
+
+func defaultTagsFromMeta(meta interface{}) map[string]string {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	return client.DefaultTags
+}
+
+// applyDefaultTags merges the default tags into the `tags` for this resource, returning the
+// tag keys which were explicitly configured on the resource
+func applyDefaultTags(d *schema.ResourceData, meta interface{}) (map[string]struct{}, error) {
+	configured := configuredTagKeys(d)
+
+	defaultTags := defaultTagsFromMeta(meta)
+	if len(defaultTags) == 0 {
+		return configured, nil
+	}
+
+	resourceTags := d.Get("tags").(map[string]interface{})
+	if err := d.Set("tags", mergeDefaultTags(defaultTags, resourceTags)); err != nil {
+		return nil, fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return configured, nil
+}
+
+// planDefaultTags plans the `tags_all` for this resource, being the default tags merged with the configured `tags`
+func planDefaultTags(d *schema.ResourceDiff, meta interface{}, updatable bool) error {
+	if d.Id() != "" && !updatable {
+		return nil
+	}
+
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	resourceTags, _ := d.Get("tags").(map[string]interface{})
+	expected := mergeDefaultTags(defaultTagsFromMeta(meta), resourceTags)
+
+	existing, _ := d.GetChange("tags_all")
+	if existingTags, ok := existing.(map[string]interface{}); ok && d.Id() != "" && reflect.DeepEqual(existingTags, expected) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", expected)
+}
+
+// removeDefaultTags persists all of the tags assigned to this resource into `tags_all` and then removes any
+// default tags which haven't been explicitly configured on the resource from the `tags` persisted into the state
+func removeDefaultTags(d *schema.ResourceData, meta interface{}, configured map[string]struct{}) error {
+	// the resource has been removed
+	if d.Id() == "" {
+		return nil
+	}
+
+	resourceTags, _ := d.Get("tags").(map[string]interface{})
+	if err := d.Set("tags_all", resourceTags); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	defaultTags := defaultTagsFromMeta(meta)
+	if len(defaultTags) == 0 {
+		return nil
+	}
+
+	if err := d.Set("tags", filterDefaultTags(defaultTags, resourceTags, configured)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// configuredTagKeys returns the tag keys which are explicitly configured for this resource - when
+// no configuration is available (e.g. during a refresh) the keys within the existing state are used
+func configuredTagKeys(d *schema.ResourceData) map[string]struct{} {
+	keys := make(map[string]struct{})
+
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		if v := config.GetAttr("tags"); !v.IsNull() && v.IsKnown() {
+			for it := v.ElementIterator(); it.Next(); {
+				key, _ := it.Element()
+				keys[key.AsString()] = struct{}{}
+			}
+		}
+		return keys
+	}
+
+	if v, ok := d.Get("tags").(map[string]interface{}); ok {
+		for key := range v {
+			keys[key] = struct{}{}
+		}
+	}
+
+	return keys
+}
+
+// mergeDefaultTags merges the default tags with those configured on the resource, where
+// the tags configured on the resource take precedence
+func mergeDefaultTags(defaultTags map[string]string, resourceTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(resourceTags))
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+	for k, v := range resourceTags {
+		output[k] = v
+	}
+	return output
+}
+
+// filterDefaultTags removes the default tags which aren't configured on the resource - tags whose value
+// differs from the default value are retained so that the drift shows up in the plan and is corrected
+func filterDefaultTags(defaultTags map[string]string, resourceTags map[string]interface{}, configured map[string]struct{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(resourceTags))
+	for k, v := range resourceTags {
+		if _, isConfigured := configured[k]; !isConfigured {
+			if defaultValue, isDefault := defaultTags[k]; isDefault && defaultValue == v {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+	return output
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestExpandDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "Tags",
+			Input: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"environment": "production",
+						"team":        "platform",
+					},
+				},
+			},
+			Expected: map[string]string{
+				"environment": "production",
+				"team":        "platform",
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandDefaultTags(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name         string
+		DefaultTags  map[string]string
+		ResourceTags map[string]interface{}
+		Expected     map[string]interface{}
+	}{
+		{
+			Name:         "No Resource Tags",
+			DefaultTags:  map[string]string{"environment": "production"},
+			ResourceTags: map[string]interface{}{},
+			Expected:     map[string]interface{}{"environment": "production"},
+		},
+		{
+			Name:         "Additional Resource Tags",
+			DefaultTags:  map[string]string{"environment": "production"},
+			ResourceTags: map[string]interface{}{"team": "platform"},
+			Expected: map[string]interface{}{
+				"environment": "production",
+				"team":        "platform",
+			},
+		},
+		{
+			Name:         "Resource Tags take precedence",
+			DefaultTags:  map[string]string{"environment": "production"},
+			ResourceTags: map[string]interface{}{"environment": "staging"},
+			Expected:     map[string]interface{}{"environment": "staging"},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := mergeDefaultTags(testCase.DefaultTags, testCase.ResourceTags)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
+
+func TestFilterDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name         string
+		DefaultTags  map[string]string
+		ResourceTags map[string]interface{}
+		Configured   []string
+		Expected     map[string]interface{}
+	}{
+		{
+			Name:         "Default Tags are removed",
+			DefaultTags:  map[string]string{"environment": "production"},
+			ResourceTags: map[string]interface{}{"environment": "production", "team": "platform"},
+			Configured:   []string{"team"},
+			Expected:     map[string]interface{}{"team": "platform"},
+		},
+		{
+			Name:         "Configured Default Tags are retained",
+			DefaultTags:  map[string]string{"environment": "production"},
+			ResourceTags: map[string]interface{}{"environment": "production"},
+			Configured:   []string{"environment"},
+			Expected:     map[string]interface{}{"environment": "production"},
+		},
+		{
+			Name:         "Default Tags with a different value are retained",
+			DefaultTags:  map[string]string{"environment": "production"},
+			ResourceTags: map[string]interface{}{"environment": "staging"},
+			Configured:   []string{},
+			Expected:     map[string]interface{}{"environment": "staging"},
+		},
+		{
+			Name:         "Tags applied outside of Terraform are retained",
+			DefaultTags:  map[string]string{"environment": "production"},
+			ResourceTags: map[string]interface{}{"owner": "someone"},
+			Configured:   []string{},
+			Expected:     map[string]interface{}{"owner": "someone"},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		configured := make(map[string]struct{})
+		for _, key := range testCase.Configured {
+			configured[key] = struct{}{}
+		}
+
+		result := filterDefaultTags(testCase.DefaultTags, testCase.ResourceTags, configured)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
+
+func TestDefaultTagsAddedAfterCreate(t *testing.T) {
+	remoteTags := make(map[string]interface{})
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			remoteTags = d.Get("tags").(map[string]interface{})
+			d.SetId("example")
+			return d.Set("tags", remoteTags)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", remoteTags)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			if d.HasChanges("tags", "tags_all") {
+				remoteTags = d.Get("tags").(map[string]interface{})
+			}
+			return d.Set("tags", remoteTags)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	addDefaultTagsToResource(resource)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"team": "platform",
+		},
+	})
+
+	// the resource is created using the initial default tags
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"environment": "production",
+		},
+	}
+	state := applyDefaultTagsTestStep(t, resource, nil, config, meta)
+	expected := map[string]interface{}{
+		"environment": "production",
+		"team":        "platform",
+	}
+	if !reflect.DeepEqual(remoteTags, expected) {
+		t.Fatalf("expected the tags %+v to be assigned during Create but got %+v", expected, remoteTags)
+	}
+	if v := state.Attributes["tags.%"]; v != "1" {
+		t.Fatalf("expected the default tags not to be persisted into `tags` but got %s tags", v)
+	}
+
+	// without any changes no diff should be planned
+	diff, err := resource.SimpleDiff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no changes to be planned but got %+v", diff.Attributes)
+	}
+
+	// a new default tag is then added to the provider, which should be planned and assigned to the resource
+	meta = &clients.Client{
+		DefaultTags: map[string]string{
+			"cost_center": "finance",
+			"environment": "production",
+		},
+	}
+	state = applyDefaultTagsTestStep(t, resource, state, config, meta)
+	expected = map[string]interface{}{
+		"cost_center": "finance",
+		"environment": "production",
+		"team":        "platform",
+	}
+	if !reflect.DeepEqual(remoteTags, expected) {
+		t.Fatalf("expected the tags %+v to be assigned during Update but got %+v", expected, remoteTags)
+	}
+	if v := state.Attributes["tags_all.cost_center"]; v != "finance" {
+		t.Fatalf("expected `tags_all.cost_center` to be %q but got %q", "finance", v)
+	}
+}
+
+func applyDefaultTagsTestStep(t *testing.T, resource *schema.Resource, state *terraform.InstanceState, config *terraform.ResourceConfig, meta interface{}) *terraform.InstanceState {
+	diff, err := resource.SimpleDiff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if diff == nil || len(diff.Attributes) == 0 {
+		t.Fatalf("expected changes to be planned")
+	}
+	if state != nil && diff.RequiresNew() {
+		t.Fatalf("expected an in-place update to be planned")
+	}
+
+	newState, diags := resource.Apply(context.TODO(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("applying: %+v", diags)
+	}
+
+	return newState
+}
@@ -25,6 +25,26 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"app_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"purge_on_destroy": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"recover_soft_deleted": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
 		"cognitive_account": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -72,6 +92,26 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"managed_hardware_security_module": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"purge_on_destroy": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"recover_soft_deleted": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
 		"network": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -86,6 +126,21 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"storage": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"data_plane_available": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
 		"template_deployment": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -254,6 +309,19 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["app_configuration"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			appConfigurationRaw := items[0].(map[string]interface{})
+			if v, ok := appConfigurationRaw["purge_on_destroy"]; ok {
+				featuresMap.AppConfiguration.PurgeOnDestroy = v.(bool)
+			}
+			if v, ok := appConfigurationRaw["recover_soft_deleted"]; ok {
+				featuresMap.AppConfiguration.RecoverSoftDeleted = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["cognitive_account"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -317,6 +385,19 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["managed_hardware_security_module"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			managedHSMRaw := items[0].(map[string]interface{})
+			if v, ok := managedHSMRaw["purge_on_destroy"]; ok {
+				featuresMap.ManagedHSM.PurgeOnDestroy = v.(bool)
+			}
+			if v, ok := managedHSMRaw["recover_soft_deleted"]; ok {
+				featuresMap.ManagedHSM.RecoverSoftDeleted = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["network"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -327,6 +408,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["storage"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			storageRaw := items[0].(map[string]interface{})
+			if v, ok := storageRaw["data_plane_available"]; ok {
+				featuresMap.Storage.DataPlaneAvailable = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["template_deployment"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
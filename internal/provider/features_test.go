@@ -21,6 +21,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -37,6 +41,10 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
@@ -56,6 +64,9 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
 			},
 		},
 		{
@@ -67,6 +78,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     true,
+							"recover_soft_deleted": true,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
@@ -89,6 +106,12 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": true,
 						},
 					},
+					"managed_hardware_security_module": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     true,
+							"recover_soft_deleted": true,
+						},
+					},
 					"network": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": true,
@@ -99,6 +122,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -124,6 +152,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -140,12 +172,19 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: true,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -170,6 +209,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     false,
+							"recover_soft_deleted": false,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
@@ -192,6 +237,12 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": false,
 						},
 					},
+					"managed_hardware_security_module": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     false,
+							"recover_soft_deleted": false,
+						},
+					},
 					"network_locking": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": false,
@@ -202,6 +253,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -227,6 +283,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeOnDestroy:     false,
+					RecoverSoftDeleted: false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
@@ -243,12 +303,19 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeOnDestroy:     false,
+					RecoverSoftDeleted: false,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -340,6 +407,76 @@ func TestExpandFeaturesApiManagement(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesAppConfiguration(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
+			},
+		},
+		{
+			Name: "Purge On Destroy and Recover Soft Deleted Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     true,
+							"recover_soft_deleted": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
+			},
+		},
+		{
+			Name: "Purge On Destroy and Recover Soft Deleted Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     false,
+							"recover_soft_deleted": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeOnDestroy:     false,
+					RecoverSoftDeleted: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.AppConfiguration, testCase.Expected.AppConfiguration) {
+			t.Fatalf("Expected %+v but got %+v", result.AppConfiguration, testCase.Expected.AppConfiguration)
+		}
+	}
+}
+
 func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -570,6 +707,71 @@ func TestExpandFeaturesNetwork(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesStorage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Available Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Available Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Storage, testCase.Expected.Storage) {
+			t.Fatalf("Expected %+v but got %+v", result.Storage, testCase.Expected.Storage)
+		}
+	}
+}
+
 func TestExpandFeaturesTemplateDeployment(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -995,3 +1197,73 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesManagedHSM(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"managed_hardware_security_module": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
+			},
+		},
+		{
+			Name: "Purge On Destroy and Recover Soft Deleted Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"managed_hardware_security_module": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     true,
+							"recover_soft_deleted": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeOnDestroy:     true,
+					RecoverSoftDeleted: true,
+				},
+			},
+		},
+		{
+			Name: "Purge On Destroy and Recover Soft Deleted Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"managed_hardware_security_module": []interface{}{
+						map[string]interface{}{
+							"purge_on_destroy":     false,
+							"recover_soft_deleted": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeOnDestroy:     false,
+					RecoverSoftDeleted: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ManagedHSM, testCase.Expected.ManagedHSM) {
+			t.Fatalf("Expected %+v but got %+v", result.ManagedHSM, testCase.Expected.ManagedHSM)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaKeyVaultDataPlane() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures how the Key Vault Data Plane is reached, for example when a Key Vault is only accessible through a Private Endpoint.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dns_servers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsIPAddress,
+					},
+					Description: "A list of DNS Servers which should be used to resolve the hostnames used to access the Key Vault Data Plane.",
+				},
+
+				"host_overrides": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsIPAddress,
+					},
+					Description: "A mapping of Key Vault hostnames to the IP Address (e.g. of the Private Endpoint) which should be used to connect to them.",
+				},
+
+				"connection_retry_timeout_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 3600),
+					Description:  "The maximum amount of time which should be spent retrying requests to the Key Vault Data Plane which fail to connect, for example whilst the DNS records for a new Private Endpoint propagate.",
+				},
+			},
+		},
+	}
+}
+
+func expandKeyVaultDataPlane(input []interface{}) *common.KeyVaultDataPlaneOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	dnsServers := make([]string, 0)
+	for _, v := range raw["dns_servers"].([]interface{}) {
+		dnsServers = append(dnsServers, net.JoinHostPort(v.(string), "53"))
+	}
+
+	hostOverrides := make(map[string]string)
+	for k, v := range raw["host_overrides"].(map[string]interface{}) {
+		hostOverrides[strings.ToLower(k)] = v.(string)
+	}
+
+	return &common.KeyVaultDataPlaneOptions{
+		DNSServers:             dnsServers,
+		HostOverrides:          hostOverrides,
+		ConnectionRetryTimeout: time.Duration(raw["connection_retry_timeout_in_seconds"].(int)) * time.Second,
+	}
+}
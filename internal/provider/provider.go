@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -98,6 +99,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// finally wire up the Provider level `default_tags` for all Resources which support tags
+	for _, resource := range resources {
+		if supportsDefaultTags(resource) {
+			addDefaultTagsToResource(resource)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -144,6 +152,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The Hostname which should be used for the Azure Metadata Service.",
 			},
 
+			"custom_environment": schemaCustomEnvironment(),
+
 			"metadata_url": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -188,6 +198,45 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
 				Description: "The path to a custom endpoint for Managed Service Identity - in most circumstances this should be detected automatically. ",
 			},
+			"msi_identity_resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MSI_IDENTITY_RESOURCE_ID", ""),
+				ValidateFunc: validation.Any(msiValidate.UserAssignedIdentityID, validation.StringIsEmpty),
+				Description:  "The Resource ID of the User Assigned Identity which should be used for Managed Service Identity authentication, as an alternative to the `client_id`.",
+			},
+
+			// OIDC specific fields
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+				Description: "Allow OpenID Connect to be used for authentication",
+			},
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Description: "The OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN_FILE_PATH", ""),
+				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_request_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL"}, ""),
+				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_request_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, ""),
+				Description: "The bearer token for the request to the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
 
 			// Managed Tracking GUID for User-agent
 			"partner_id": {
@@ -214,6 +263,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"retry": schemaRetry(),
+
+			"key_vault_data_plane": schemaKeyVaultDataPlane(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -293,9 +348,62 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			ClientSecretDocsLink: "https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/service_principal_client_secret",
 		}
 
-		config, err := builder.Build()
-		if err != nil {
-			return nil, diag.FromErr(fmt.Errorf("building AzureRM Client: %s", err))
+		var config *authentication.Config
+		var oidcConfig *clients.OIDCConfig
+		var clientCertificate *clients.ClientCertificateConfig
+		var managedIdentityConfig *clients.ManagedIdentityConfig
+		if d.Get("use_oidc").(bool) {
+			// OIDC isn't supported by the authentication Builder, so we build up the Config and
+			// source the Authorizers from the federated token ourselves - the Object ID of the
+			// Service Principal is looked up when building the Clients, once the Environment is known
+			config = &authentication.Config{
+				ClientID:                         builder.ClientID,
+				SubscriptionID:                   builder.SubscriptionID,
+				TenantID:                         builder.TenantID,
+				AuxiliaryTenantIDs:               builder.AuxiliaryTenantIDs,
+				Environment:                      builder.Environment,
+				MetadataHost:                     builder.MetadataHost,
+				AuthenticatedAsAServicePrincipal: true,
+			}
+			oidcConfig = &clients.OIDCConfig{
+				Token:         d.Get("oidc_token").(string),
+				TokenFilePath: d.Get("oidc_token_file_path").(string),
+				RequestURL:    d.Get("oidc_request_url").(string),
+				RequestToken:  d.Get("oidc_request_token").(string),
+			}
+		} else if builder.SupportsManagedServiceIdentity && builder.ClientSecret == "" && builder.ClientCertPath == "" && clients.ManagedIdentityIsSupported() {
+			// Managed Service Identity is unable to obtain tokens for other tenants
+			if len(auxTenants) > 0 {
+				return nil, diag.FromErr(fmt.Errorf("`auxiliary_tenant_ids` are not supported when authenticating using Managed Service Identity"))
+			}
+
+			// the authentication Builder only supports User Assigned Identities by Client ID and doesn't support
+			// Azure Arc-enabled servers, so we build up the Config and source the Authorizers ourselves
+			config = &authentication.Config{
+				ClientID:       builder.ClientID,
+				SubscriptionID: builder.SubscriptionID,
+				TenantID:       builder.TenantID,
+				Environment:    builder.Environment,
+				MetadataHost:   builder.MetadataHost,
+			}
+			managedIdentityConfig = &clients.ManagedIdentityConfig{
+				Endpoint:           builder.MsiEndpoint,
+				ClientID:           builder.ClientID,
+				IdentityResourceID: d.Get("msi_identity_resource_id").(string),
+			}
+		} else {
+			var err error
+			config, err = builder.Build()
+			if err != nil {
+				return nil, diag.FromErr(fmt.Errorf("building AzureRM Client: %s", err))
+			}
+
+			if builder.ClientCertPath != "" {
+				clientCertificate = &clients.ClientCertificateConfig{
+					Path:     builder.ClientCertPath,
+					Password: builder.ClientCertPassword,
+				}
+			}
 		}
 
 		terraformVersion := p.TerraformVersion
@@ -308,12 +416,19 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
+			OIDC:                        oidcConfig,
+			ClientCertificate:           clientCertificate,
+			ManagedIdentity:             managedIdentityConfig,
+			CustomEnvironment:           expandCustomEnvironment(d.Get("custom_environment").([]interface{})),
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
+			DefaultTags:                 expandDefaultTags(d.Get("default_tags").([]interface{})),
+			Retry:                       expandRetry(d.Get("retry").([]interface{})),
+			KeyVaultDataPlane:           expandKeyVaultDataPlane(d.Get("key_vault_data_plane").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
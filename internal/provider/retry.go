@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaRetry() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures how requests to Azure which are throttled, or fail with a transient error, are retried.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      common.DefaultRetryOptions().MaxAttempts,
+					ValidateFunc: validation.IntBetween(0, 100),
+					Description:  "The maximum number of times a request should be retried.",
+				},
+
+				"max_elapsed_time_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum amount of time which should be spent retrying a request. Defaults to unlimited.",
+				},
+
+				"honor_retry_after": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     common.DefaultRetryOptions().HonorRetryAfter,
+					Description: "Should the delay specified in the `Retry-After` header returned by Azure be used before retrying a request?",
+				},
+			},
+		},
+	}
+}
+
+func expandRetry(input []interface{}) *common.RetryOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &common.RetryOptions{
+		MaxAttempts:     raw["max_attempts"].(int),
+		MaxElapsedTime:  time.Duration(raw["max_elapsed_time_in_seconds"].(int)) * time.Second,
+		HonorRetryAfter: raw["honor_retry_after"].(bool),
+	}
+}
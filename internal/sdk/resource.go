@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -122,6 +123,19 @@ type ResourceWithDeprecationAndNoReplacement interface {
 	DeprecationMessage() string
 }
 
+// ResourceWithSubscriptionIdOverride is an optional interface
+//
+// Resources implementing this interface expose an Optional `subscription_id` argument which
+// allows the resource to be provisioned into a different Subscription to the one configured in
+// the Provider block - the Subscription ID to use should be retrieved via `metadata.SubscriptionId()`
+type ResourceWithSubscriptionIdOverride interface {
+	Resource
+
+	// SupportsSubscriptionIdOverride is a marker denoting that this Resource can be provisioned
+	// into a Subscription other than the one configured in the Provider block
+	SupportsSubscriptionIdOverride()
+}
+
 // ResourceWithCustomizeDiff is an optional interface
 type ResourceWithCustomizeDiff interface {
 	Resource
@@ -130,6 +144,25 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceWithResumableCreate is an optional interface
+//
+// Resources implementing this interface must set the Resource ID (via `metadata.SetID`) prior to polling
+// for the creation to complete. When the Create times out once the ID has been set the resource is kept
+// in the State (rather than being tainted and recreated) and the creation is resumed during the next apply.
+type ResourceWithResumableCreate interface {
+	ResourceWithUpdate
+
+	// CreationInProgress returns whether a previously timed out creation of this resource is still in progress
+	// NOTE: this is called during each plan for existing resources (with `metadata.ResourceDiff` populated) and
+	// as such should only retrieve the resource, rather than waiting for the creation to complete
+	CreationInProgress(ctx context.Context, metadata ResourceMetaData) (bool, error)
+
+	// ResumeCreate waits for a previously timed out creation of this resource to complete and then performs
+	// the remaining steps of the Create. This is called prior to each Update (using the Update timeout) and
+	// as such should return immediately when the resource is not being provisioned
+	ResumeCreate() ResourceRunFunc
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
@@ -145,6 +178,11 @@ type ResourceFunc struct {
 	// Timeout is the default timeout, which can be overridden by users
 	// for this method - in-turn used for the Azure API
 	Timeout time.Duration
+
+	// Retry optionally overrides the retry behaviour configured in the Provider block
+	// for requests sent by this method - for example for long-running operations which
+	// are commonly throttled
+	Retry *common.RetryOptions
 }
 
 type ResourceMetaData struct {
@@ -164,6 +202,24 @@ type ResourceMetaData struct {
 
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
+
+	// supportsSubscriptionIdOverride specifies whether the `subscription_id` field is available
+	// for this Resource, which is used to determine the Subscription ID to use
+	supportsSubscriptionIdOverride bool
+}
+
+// SubscriptionId returns the ID of the Subscription this resource should be provisioned into
+//
+// This is the `subscription_id` specified for this resource (when supported and specified),
+// otherwise the Subscription ID configured in the Provider block
+func (rmd ResourceMetaData) SubscriptionId() string {
+	if rmd.supportsSubscriptionIdOverride && rmd.ResourceData != nil {
+		if v, ok := rmd.ResourceData.GetOk(subscriptionIdOverrideFieldName); ok && v.(string) != "" {
+			return v.(string)
+		}
+	}
+
+	return rmd.Client.Account.SubscriptionId
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
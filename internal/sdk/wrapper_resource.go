@@ -33,6 +33,17 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		return nil, fmt.Errorf("building Schema: %+v", err)
 	}
 
+	if _, ok := rw.resource.(ResourceWithSubscriptionIdOverride); ok {
+		if err := addSubscriptionIdOverrideToSchema(rw.resource.ResourceType(), *resourceSchema); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := rw.resource.(ResourceWithResumableCreate); ok && len(rw.resource.Attributes()) == 0 {
+		// an update is planned by marking the computed attributes as changed, so at least one is required
+		return nil, fmt.Errorf("Resource %q must define at least one Attribute if implementing ResourceWithResumableCreate", rw.resource.ResourceType())
+	}
+
 	modelObj := rw.resource.ModelObject()
 	if modelObj != nil {
 		if err := ValidateModelObject(modelObj); err != nil {
@@ -48,24 +59,34 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := rw.runArgs(d, meta)
+			ctx = withRetryOptions(ctx, rw.resource.Create())
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
+				if _, ok := rw.resource.(ResourceWithResumableCreate); ok && createTimedOut(ctx, metaData) {
+					// the resource exists in Azure but is still being provisioned, rather than returning an error
+					// (which taints the resource, causing it to be recreated) keep it in the state and resume
+					// the creation during the next apply
+					rw.logger.Warnf("timed out waiting for the creation of %q to complete, this will be resumed during the next apply: %+v", metaData.ResourceData.Id(), err)
+					return nil
+				}
 				return err
 			}
 			// NOTE: whilst this may look like we should use the Read
 			// functions timeout here, we're still /technically/ in the
 			// Create function so reusing that timeout should be sufficient
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		}),
 
 		// looks like these could be reused, easiest if they're not
 		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Read().Func(ctx, metaData)
+			metaData := rw.runArgs(d, meta)
+			ctx = withRetryOptions(ctx, rw.resource.Read())
+			return rw.read(ctx, metaData)
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := rw.runArgs(d, meta)
+			ctx = withRetryOptions(ctx, rw.resource.Delete())
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -93,7 +114,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+				metaData := rw.runArgs(d, meta)
 
 				err := v.CustomImporter()(ctx, metaData)
 				if err != nil {
@@ -111,7 +132,14 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := rw.runArgs(d, meta)
+			ctx = withRetryOptions(ctx, v.Update())
+
+			if r, ok := rw.resource.(ResourceWithResumableCreate); ok {
+				if err := r.ResumeCreate()(ctx, metaData); err != nil {
+					return fmt.Errorf("resuming the creation of %q: %+v", d.Id(), err)
+				}
+			}
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
@@ -120,7 +148,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			// whilst this may look like we should use the Update timeout here
 			// we're still "technically" in the update method, so reusing the
 			// Update's timeout should be fine
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		})
 		resource.Timeouts.Update = d(v.Update().Timeout)
 	}
@@ -139,6 +167,20 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}
 	}
 
+	if v, ok := rw.resource.(ResourceWithResumableCreate); ok {
+		resumableCreateDiff := rw.resumableCreateCustomizeDiff(v)
+		if existing := resource.CustomizeDiff; existing != nil {
+			resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if err := existing(ctx, d, meta); err != nil {
+					return err
+				}
+				return resumableCreateDiff(ctx, d, meta)
+			}
+		} else {
+			resource.CustomizeDiff = resumableCreateDiff
+		}
+	}
+
 	if v, ok := rw.resource.(ResourceWithDeprecationAndNoReplacement); ok {
 		message := v.DeprecationMessage()
 		if message == "" {
@@ -175,6 +217,35 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
+func (rw *ResourceWrapper) runArgs(d *schema.ResourceData, meta interface{}) ResourceMetaData {
+	metaData := runArgs(d, meta, rw.logger)
+	if _, ok := rw.resource.(ResourceWithSubscriptionIdOverride); ok {
+		metaData.supportsSubscriptionIdOverride = true
+	}
+	return metaData
+}
+
+func (rw *ResourceWrapper) read(ctx context.Context, metaData ResourceMetaData) error {
+	if err := rw.resource.Read().Func(ctx, metaData); err != nil {
+		return err
+	}
+
+	if metaData.supportsSubscriptionIdOverride {
+		return setSubscriptionIdOverride(metaData.ResourceData)
+	}
+
+	return nil
+}
+
+// withRetryOptions returns a Context which uses the retry behaviour defined for this ResourceFunc (if any)
+func withRetryOptions(ctx context.Context, fn ResourceFunc) context.Context {
+	if fn.Retry == nil {
+		return ctx
+	}
+
+	return fn.Retry.WithContext(ctx)
+}
+
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return diagnosticsWrapper(in, rw.logger)
 }
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// createTimedOut returns whether the Create timed out once the Resource ID had been set - in which case
+// the resource exists in Azure and can be kept in the State, so that the creation can be resumed
+func createTimedOut(ctx context.Context, metadata ResourceMetaData) bool {
+	if metadata.ResourceData == nil || metadata.ResourceData.Id() == "" {
+		return false
+	}
+
+	return errors.Is(ctx.Err(), context.DeadlineExceeded)
+}
+
+// resumableCreateCustomizeDiff returns a CustomizeDiff function which plans an in-place update when a previously
+// timed out creation of this resource is still in progress, so that the creation is resumed during the next apply
+func (rw *ResourceWrapper) resumableCreateCustomizeDiff(v ResourceWithResumableCreate) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		metaData := ResourceMetaData{
+			Client:                   meta.(*clients.Client),
+			Logger:                   rw.logger,
+			ResourceDiff:             d,
+			serializationDebugLogger: NullLogger{},
+		}
+		inProgress, err := v.CreationInProgress(ctx, metaData)
+		if err != nil {
+			return fmt.Errorf("checking whether the creation of %q is still in progress: %+v", d.Id(), err)
+		}
+		if !inProgress {
+			return nil
+		}
+
+		rw.logger.Infof("the creation of %q is still in progress, this will be resumed during the next apply", d.Id())
+
+		// the computed attributes will be populated once the creation has completed
+		for k := range rw.resource.Attributes() {
+			if err := d.SetNewComputed(k); err != nil {
+				return fmt.Errorf("setting %q as computed: %+v", k, err)
+			}
+		}
+
+		return nil
+	}
+}
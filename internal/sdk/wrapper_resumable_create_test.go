@@ -0,0 +1,240 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestCreateTimedOut(t *testing.T) {
+	expired, cancel := context.WithTimeout(context.TODO(), time.Nanosecond)
+	defer cancel()
+	<-expired.Done()
+
+	cancelled, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	testData := []struct {
+		name     string
+		ctx      context.Context
+		id       string
+		expected bool
+	}{
+		{
+			name:     "not timed out without an id",
+			ctx:      context.TODO(),
+			id:       "",
+			expected: false,
+		},
+		{
+			name:     "not timed out with an id",
+			ctx:      context.TODO(),
+			id:       "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expected: false,
+		},
+		{
+			name:     "timed out without an id",
+			ctx:      expired,
+			id:       "",
+			expected: false,
+		},
+		{
+			name:     "timed out with an id",
+			ctx:      expired,
+			id:       "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expected: true,
+		},
+		{
+			name:     "cancelled with an id",
+			ctx:      cancelled,
+			id:       "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId(v.id)
+
+		actual := createTimedOut(v.ctx, ResourceMetaData{ResourceData: d})
+		if actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestResumableCreateTimedOutThenResumed(t *testing.T) {
+	r := &resumableCreateResource{
+		inProgress: true,
+	}
+	wrapper := NewResourceWrapper(r)
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+	meta := &clients.Client{}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+	})
+
+	// the Create times out once the ID has been set, so this should be kept in the State
+	createCtx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	if diags := resource.CreateContext(createCtx, d, meta); diags.HasError() {
+		t.Fatalf("expected the timed out Create to succeed but got: %+v", diags)
+	}
+	if d.Id() == "" {
+		t.Fatalf("expected the ID to be kept in the State")
+	}
+
+	// a refresh should only read the resource, rather than waiting for the creation to complete
+	if diags := resource.ReadContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+	if r.resumed {
+		t.Fatalf("expected the creation not to be resumed during Read")
+	}
+
+	// whilst the creation is in progress an update should be planned
+	diff, err := resource.SimpleDiff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+	}), meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if diff == nil || diff.Attributes["status"] == nil || !diff.Attributes["status"].NewComputed {
+		t.Fatalf("expected `status` to be marked as computed in the plan but got: %+v", diff)
+	}
+
+	// the creation should then be resumed (and completed) during the Update
+	if diags := resource.UpdateContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("updating: %+v", diags)
+	}
+	if !r.resumed {
+		t.Fatalf("expected the creation to be resumed during Update")
+	}
+	if !r.completed {
+		t.Fatalf("expected the remaining steps of the creation to be completed during Update")
+	}
+	if actual := d.Get("status").(string); actual != "Succeeded" {
+		t.Fatalf("expected `status` to be %q but got %q", "Succeeded", actual)
+	}
+
+	// once the creation has completed no update should be planned
+	diff, err = resource.SimpleDiff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+	}), meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no changes to be planned but got: %+v", diff)
+	}
+}
+
+type resumableCreateResource struct {
+	inProgress bool
+	resumed    bool
+	completed  bool
+}
+
+var _ ResourceWithResumableCreate = &resumableCreateResource{}
+
+func (r *resumableCreateResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func (r *resumableCreateResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r *resumableCreateResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r *resumableCreateResource) ResourceType() string {
+	return "azurerm_resumable_create"
+}
+
+func (r *resumableCreateResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Timeout: time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			metadata.ResourceData.SetId("/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1")
+
+			// the creation takes longer than the timeout
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+}
+
+func (r *resumableCreateResource) CreationInProgress(_ context.Context, _ ResourceMetaData) (bool, error) {
+	return r.inProgress, nil
+}
+
+func (r *resumableCreateResource) ResumeCreate() ResourceRunFunc {
+	return func(_ context.Context, _ ResourceMetaData) error {
+		if !r.inProgress {
+			return nil
+		}
+
+		r.resumed = true
+		r.inProgress = false
+		r.completed = true
+		return nil
+	}
+}
+
+func (r *resumableCreateResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Timeout: time.Minute,
+		Func: func(_ context.Context, metadata ResourceMetaData) error {
+			status := "Succeeded"
+			if r.inProgress {
+				status = "InProgress"
+			}
+			return metadata.ResourceData.Set("status", status)
+		},
+	}
+}
+
+func (r *resumableCreateResource) Update() ResourceFunc {
+	return ResourceFunc{
+		Timeout: time.Minute,
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r *resumableCreateResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Timeout: time.Minute,
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r *resumableCreateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const subscriptionIdOverrideFieldName = "subscription_id"
+
+// subscriptionIdOverrideSchema returns the schema for the `subscription_id` field which is
+// injected into Resources implementing the ResourceWithSubscriptionIdOverride interface
+func subscriptionIdOverrideSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+}
+
+// addSubscriptionIdOverrideToSchema adds the `subscription_id` field into the specified schema
+func addSubscriptionIdOverrideToSchema(resourceType string, input map[string]*schema.Schema) error {
+	if _, exists := input[subscriptionIdOverrideFieldName]; exists {
+		return fmt.Errorf("Resource %q implements ResourceWithSubscriptionIdOverride and so cannot define the %q field", resourceType, subscriptionIdOverrideFieldName)
+	}
+
+	input[subscriptionIdOverrideFieldName] = subscriptionIdOverrideSchema()
+	return nil
+}
+
+// subscriptionIdFromResourceId returns the Subscription ID component of the specified Resource ID
+// or an empty string if the Resource ID isn't scoped to a Subscription
+func subscriptionIdFromResourceId(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "subscriptions") {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
+
+// setSubscriptionIdOverride sets the `subscription_id` field into the state using the Subscription
+// within the Resource ID, so that this is populated for imported resources
+func setSubscriptionIdOverride(d *schema.ResourceData) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if subscriptionId := subscriptionIdFromResourceId(d.Id()); subscriptionId != "" {
+		if err := d.Set(subscriptionIdOverrideFieldName, subscriptionId); err != nil {
+			return fmt.Errorf("setting %q: %+v", subscriptionIdOverrideFieldName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSubscriptionIdFromResourceId(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "",
+			expected: "",
+		},
+		{
+			input:    "/providers/Microsoft.Management/managementGroups/group1",
+			expected: "",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111",
+			expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.LoadTestService/loadTests/test1",
+			expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			input:    "/Subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expected: "11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual := subscriptionIdFromResourceId(v.input)
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestAddSubscriptionIdOverrideToSchema(t *testing.T) {
+	input := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	if err := addSubscriptionIdOverrideToSchema("azurerm_example", input); err != nil {
+		t.Fatalf("adding the `subscription_id` field: %+v", err)
+	}
+
+	v, ok := input["subscription_id"]
+	if !ok {
+		t.Fatalf("expected the `subscription_id` field to be added to the schema")
+	}
+	if !v.Optional || !v.Computed || !v.ForceNew {
+		t.Fatalf("expected the `subscription_id` field to be Optional, Computed and ForceNew")
+	}
+
+	if err := addSubscriptionIdOverrideToSchema("azurerm_example", input); err == nil {
+		t.Fatalf("expected an error when the `subscription_id` field already exists")
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -46,6 +48,16 @@ var (
 	apimTlsRsaWithAes128CbcShaCiphers        = "Microsoft.WindowsAzure.ApiManagement.Gateway.Security.Ciphers.TLS_RSA_WITH_AES_128_CBC_SHA"
 )
 
+const (
+	apimSkuTypeBasicV2    apimanagement.SkuType = "BasicV2"
+	apimSkuTypeStandardV2 apimanagement.SkuType = "StandardV2"
+	apimSkuTypePremiumV2  apimanagement.SkuType = "PremiumV2"
+
+	// apimV2SkuApiVersion is the API version used when creating or updating an API Management Service using one of
+	// the v2 SKUs, which aren't available in the API version used for the rest of the API Management Service
+	apimV2SkuApiVersion = "2024-05-01"
+)
+
 func resourceApiManagementService() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementServiceCreateUpdate,
@@ -612,6 +624,13 @@ func resourceApiManagementService() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("virtual_network_configuration", func(ctx context.Context, old, new, meta interface{}) bool {
 				return !(len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0)
 			}),
+
+			// an existing API Management Service can't be moved between the v1 and v2 tiers
+			pluginsdk.ForceNewIfChange("sku_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && apiManagementSkuNameIsV2(old.(string)) != apiManagementSkuNameIsV2(new.(string))
+			}),
+
+			apiManagementServiceV2SkuCustomizeDiff,
 		),
 	}
 }
@@ -623,6 +642,7 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 	defer cancel()
 
 	sku := expandAzureRmApiManagementSkuName(d)
+	skuIsV2 := apiManagementSkuNameIsV2(d.Get("sku_name").(string))
 
 	log.Printf("[INFO] preparing arguments for API Management Service creation.")
 
@@ -730,12 +750,27 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 		properties.Zones = azure.ExpandZones(v)
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, properties)
+	req, err := client.CreateOrUpdatePreparer(ctx, id.ResourceGroup, id.ServiceName, properties)
+	if err != nil {
+		return fmt.Errorf("preparing request to create/update %s: %+v", id, err)
+	}
+
+	pollingClient := client.Client
+	if skuIsV2 {
+		if req, err = autorest.Prepare(req, withApiManagementV2SkuApiVersion()); err != nil {
+			return fmt.Errorf("preparing `sku_name` to create/update %s: %+v", id, err)
+		}
+
+		// the v2 tiers are provisioned in minutes rather than the best part of an hour, so poll more frequently
+		pollingClient.PollingDelay = 15 * time.Second
+	}
+
+	future, err := client.CreateOrUpdateSender(req)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, pollingClient); err != nil {
 		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
 	}
 
@@ -792,7 +827,7 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 	if sku.Name == apimanagement.SkuTypeConsumption && len(tenantAccessRaw) > 0 {
 		return fmt.Errorf("`tenant_access` is not supported for sku tier `Consumption`")
 	}
-	if sku.Name != apimanagement.SkuTypeConsumption && d.HasChange("tenant_access") {
+	if sku.Name != apimanagement.SkuTypeConsumption && !skuIsV2 && d.HasChange("tenant_access") {
 		tenantAccessInformationParametersRaw := d.Get("tenant_access").([]interface{})
 		tenantAccessInformationParameters := expandApiManagementTenantAccessSettings(tenantAccessInformationParametersRaw)
 		tenantAccessClient := meta.(*clients.Client).ApiManagement.TenantAccessClient
@@ -933,7 +968,8 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		d.Set("sign_up", []interface{}{})
 	}
 
-	if resp.Sku.Name != apimanagement.SkuTypeConsumption {
+	// the direct management API (and therefore tenant access) isn't available in the v2 tiers
+	if resp.Sku.Name != apimanagement.SkuTypeConsumption && !apiManagementSkuTypeIsV2(resp.Sku.Name) {
 		tenantAccessInformationContract, err := tenantAccessClient.ListSecrets(ctx, id.ResourceGroup, id.ServiceName, "access")
 		if err != nil {
 			return fmt.Errorf("retrieving tenant access properties for %s: %+v", *id, err)
@@ -1373,6 +1409,87 @@ func flattenAzureRmApiManagementMachineIdentity(identity *apimanagement.ServiceI
 	return []interface{}{result}, nil
 }
 
+func apiManagementServiceV2SkuCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	skuName, _, err := azure.SplitSku(d.Get("sku_name").(string))
+	if err != nil {
+		// `sku_name` may not be known until apply
+		return nil
+	}
+
+	skuType := apimanagement.SkuType(skuName)
+	if !apiManagementSkuTypeIsV2(skuType) {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	for _, block := range []string{"additional_location", "tenant_access"} {
+		if raw := rawConfig.GetAttr(block); !raw.IsNull() && raw.LengthInt() > 0 {
+			return fmt.Errorf("`%s` is not supported for sku tier `%s`", block, skuName)
+		}
+	}
+
+	if v := d.Get("zones").([]interface{}); len(v) > 0 {
+		return fmt.Errorf("`zones` is not supported for sku tier `%s`", skuName)
+	}
+
+	if v := d.Get("hostname_configuration").([]interface{}); len(v) > 0 && v[0] != nil {
+		hostnameConfiguration := v[0].(map[string]interface{})
+		for _, hostnameType := range []string{"management", "portal", "scm"} {
+			if configs := hostnameConfiguration[hostnameType].([]interface{}); len(configs) > 0 {
+				return fmt.Errorf("`hostname_configuration.0.%s` is not supported for sku tier `%s`", hostnameType, skuName)
+			}
+		}
+	}
+
+	// the v2 tiers use Virtual Network Integration (outbound only, which is exposed as `External`) for `StandardV2` and
+	// Virtual Network Injection (`External` or `Internal`) for `PremiumV2`, whereas `BasicV2` doesn't support either
+	virtualNetworkType := apimanagement.VirtualNetworkType(d.Get("virtual_network_type").(string))
+	switch skuType {
+	case apimSkuTypeBasicV2:
+		if virtualNetworkType != apimanagement.VirtualNetworkTypeNone {
+			return fmt.Errorf("`virtual_network_type` must be `%s` for sku tier `%s`", apimanagement.VirtualNetworkTypeNone, skuName)
+		}
+	case apimSkuTypeStandardV2:
+		if virtualNetworkType == apimanagement.VirtualNetworkTypeInternal {
+			return fmt.Errorf("`virtual_network_type` must be `%s` or `%s` for sku tier `%s`", apimanagement.VirtualNetworkTypeNone, apimanagement.VirtualNetworkTypeExternal, skuName)
+		}
+	}
+
+	return nil
+}
+
+// withApiManagementV2SkuApiVersion bumps the API version of the request used to create/update the API Management
+// Service, since the v2 SKUs aren't available in the API version used for the rest of the API Management Service
+func withApiManagementV2SkuApiVersion() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", apimV2SkuApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
+
+func apiManagementSkuNameIsV2(input string) bool {
+	name, _, err := azure.SplitSku(input)
+	if err != nil {
+		return false
+	}
+
+	return apiManagementSkuTypeIsV2(apimanagement.SkuType(name))
+}
+
+func apiManagementSkuTypeIsV2(input apimanagement.SkuType) bool {
+	return input == apimSkuTypeBasicV2 || input == apimSkuTypeStandardV2 || input == apimSkuTypePremiumV2
+}
+
 func expandAzureRmApiManagementSkuName(d *pluginsdk.ResourceData) *apimanagement.ServiceSkuProperties {
 	vs := d.Get("sku_name").(string)
 
@@ -0,0 +1,312 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceApiModel struct {
+	Name                     string   `tfschema:"name"`
+	ApiManagementWorkspaceId string   `tfschema:"api_management_workspace_id"`
+	DisplayName              string   `tfschema:"display_name"`
+	Path                     string   `tfschema:"path"`
+	Protocols                []string `tfschema:"protocols"`
+	Revision                 string   `tfschema:"revision"`
+	ApiType                  string   `tfschema:"api_type"`
+	Description              string   `tfschema:"description"`
+	RevisionDescription      string   `tfschema:"revision_description"`
+	ServiceUrl               string   `tfschema:"service_url"`
+	SubscriptionRequired     bool     `tfschema:"subscription_required"`
+	IsCurrent                bool     `tfschema:"is_current"`
+	IsOnline                 bool     `tfschema:"is_online"`
+}
+
+type ApiManagementWorkspaceApiResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceApiResource{}
+
+func (r ApiManagementWorkspaceApiResource) ResourceType() string {
+	return "azurerm_api_management_workspace_api"
+}
+
+func (r ApiManagementWorkspaceApiResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceApiModel{}
+}
+
+func (r ApiManagementWorkspaceApiResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspaceapi.ValidateWorkspaceApiID
+}
+
+func (r ApiManagementWorkspaceApiResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementApiName,
+		},
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ApiManagementApiPath,
+		},
+
+		"protocols": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(workspaceapi.PossibleValuesForProtocol(), false),
+			},
+		},
+
+		"revision": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"api_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(workspaceapi.ApiTypeHttp),
+			ValidateFunc: validation.StringInSlice(workspaceapi.PossibleValuesForApiType(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"revision_description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"is_current": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"is_online": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspaceapi.NewWorkspaceApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApiManagementWorkspaceApi(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := workspaceapi.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceApiModel{
+				Name:                     id.ApiId,
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					state.IsCurrent = utils.NormaliseNilableBool(props.IsCurrent)
+					state.IsOnline = utils.NormaliseNilableBool(props.IsOnline)
+					state.Path = props.Path
+					state.Revision = utils.NormalizeNilableString(props.ApiRevision)
+					state.RevisionDescription = utils.NormalizeNilableString(props.ApiRevisionDescription)
+					state.ServiceUrl = utils.NormalizeNilableString(props.ServiceUrl)
+					state.SubscriptionRequired = utils.NormaliseNilableBool(props.SubscriptionRequired)
+
+					state.ApiType = string(workspaceapi.ApiTypeHttp)
+					if props.Type != nil {
+						state.ApiType = string(*props.Type)
+					}
+
+					protocols := make([]string, 0)
+					if props.Protocols != nil {
+						for _, protocol := range *props.Protocols {
+							protocols = append(protocols, string(protocol))
+						}
+					}
+					state.Protocols = protocols
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := workspaceapi.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandApiManagementWorkspaceApi(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := workspaceapi.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspaceapi.DeleteOperationOptions{
+				DeleteRevisions: utils.Bool(true),
+				IfMatch:         utils.String("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceApi(input ApiManagementWorkspaceApiModel) workspaceapi.ApiContract {
+	apiType := workspaceapi.ApiType(input.ApiType)
+
+	protocols := make([]workspaceapi.Protocol, 0)
+	for _, protocol := range input.Protocols {
+		protocols = append(protocols, workspaceapi.Protocol(protocol))
+	}
+
+	output := workspaceapi.ApiContract{
+		Properties: &workspaceapi.ApiContractProperties{
+			ApiRevision:          utils.String(input.Revision),
+			Description:          utils.String(input.Description),
+			DisplayName:          utils.String(input.DisplayName),
+			Path:                 input.Path,
+			Protocols:            &protocols,
+			SubscriptionRequired: utils.Bool(input.SubscriptionRequired),
+			Type:                 &apiType,
+		},
+	}
+
+	if input.RevisionDescription != "" {
+		output.Properties.ApiRevisionDescription = utils.String(input.RevisionDescription)
+	}
+
+	if input.ServiceUrl != "" {
+		output.Properties.ServiceUrl = utils.String(input.ServiceUrl)
+	}
+
+	return output
+}
@@ -0,0 +1,198 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/apigateway"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/apigatewayconfigconnection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceGatewayConfigConnectionModel struct {
+	Name                     string   `tfschema:"name"`
+	GatewayId                string   `tfschema:"gateway_id"`
+	ApiManagementWorkspaceId string   `tfschema:"api_management_workspace_id"`
+	Hostnames                []string `tfschema:"hostnames"`
+	DefaultHostname          string   `tfschema:"default_hostname"`
+}
+
+type ApiManagementWorkspaceGatewayConfigConnectionResource struct{}
+
+var _ sdk.Resource = ApiManagementWorkspaceGatewayConfigConnectionResource{}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) ResourceType() string {
+	return "azurerm_api_management_workspace_gateway_config_connection"
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceGatewayConfigConnectionModel{}
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apigatewayconfigconnection.ValidateConfigConnectionID
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 30),
+		},
+
+		"gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: apigateway.ValidateGatewayID,
+		},
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"hostnames": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ApiGatewayConfigConnectionClient
+
+			var model ApiManagementWorkspaceGatewayConfigConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId, err := apigateway.ParseGatewayID(model.GatewayId)
+			if err != nil {
+				return err
+			}
+
+			id := apigatewayconfigconnection.NewConfigConnectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.GatewayName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := apigatewayconfigconnection.ApiManagementGatewayConfigConnectionResource{
+				Properties: apigatewayconfigconnection.ApiManagementGatewayConfigConnectionProperties{
+					SourceId: utils.String(model.ApiManagementWorkspaceId),
+				},
+			}
+			if len(model.Hostnames) > 0 {
+				parameters.Properties.Hostnames = &model.Hostnames
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ApiGatewayConfigConnectionClient
+
+			id, err := apigatewayconfigconnection.ParseConfigConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceGatewayConfigConnectionModel{
+				Name:      id.ConfigConnectionName,
+				GatewayId: apigateway.NewGatewayID(id.SubscriptionId, id.ResourceGroupName, id.GatewayName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DefaultHostname = utils.NormalizeNilableString(props.DefaultHostname)
+
+				if props.SourceId != nil {
+					workspaceId, err := workspace.ParseWorkspaceIDInsensitively(*props.SourceId)
+					if err != nil {
+						return err
+					}
+					state.ApiManagementWorkspaceId = workspaceId.ID()
+				}
+
+				if props.Hostnames != nil {
+					state.Hostnames = *props.Hostnames
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ApiGatewayConfigConnectionClient
+
+			id, err := apigatewayconfigconnection.ParseConfigConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := apigatewayconfigconnection.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if err := client.DeleteThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
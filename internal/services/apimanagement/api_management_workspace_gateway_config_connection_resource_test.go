@@ -0,0 +1,96 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/apigatewayconfigconnection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceGatewayConfigConnectionResource struct{}
+
+func TestAccApiManagementWorkspaceGatewayConfigConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_gateway_config_connection", "test")
+	r := ApiManagementWorkspaceGatewayConfigConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_hostname").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceGatewayConfigConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_gateway_config_connection", "test")
+	r := ApiManagementWorkspaceGatewayConfigConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ApiManagementWorkspaceGatewayConfigConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := apigatewayconfigconnection.ParseConfigConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.ApiGatewayConfigConnectionClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementWorkspaceGatewayConfigConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_gateway" "test" {
+  name                = "acctestamwg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "WorkspaceGatewayPremium"
+}
+
+resource "azurerm_api_management_workspace_gateway_config_connection" "test" {
+  name                        = "acctestcc-%d"
+  gateway_id                  = azurerm_api_management_workspace_gateway.test.id
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceGatewayConfigConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_gateway_config_connection" "import" {
+  name                        = azurerm_api_management_workspace_gateway_config_connection.test.name
+  gateway_id                  = azurerm_api_management_workspace_gateway_config_connection.test.gateway_id
+  api_management_workspace_id = azurerm_api_management_workspace_gateway_config_connection.test.api_management_workspace_id
+}
+`, r.basic(data))
+}
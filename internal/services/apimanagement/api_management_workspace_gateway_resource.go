@@ -0,0 +1,281 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/apigateway"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceGatewayModel struct {
+	Name                     string            `tfschema:"name"`
+	ResourceGroupName        string            `tfschema:"resource_group_name"`
+	Location                 string            `tfschema:"location"`
+	SkuName                  string            `tfschema:"sku_name"`
+	Capacity                 int64             `tfschema:"capacity"`
+	VirtualNetworkType       string            `tfschema:"virtual_network_type"`
+	BackendSubnetId          string            `tfschema:"backend_subnet_id"`
+	Tags                     map[string]string `tfschema:"tags"`
+	ConfigurationApiHostname string            `tfschema:"configuration_api_hostname"`
+	DefaultHostname          string            `tfschema:"default_hostname"`
+}
+
+type ApiManagementWorkspaceGatewayResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceGatewayResource{}
+
+func (r ApiManagementWorkspaceGatewayResource) ResourceType() string {
+	return "azurerm_api_management_workspace_gateway"
+}
+
+func (r ApiManagementWorkspaceGatewayResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceGatewayModel{}
+}
+
+func (r ApiManagementWorkspaceGatewayResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apigateway.ValidateGatewayID
+}
+
+func (r ApiManagementWorkspaceGatewayResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 45),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(apigateway.ApiGatewaySkuTypeWorkspaceGatewayPremium),
+				string(apigateway.ApiGatewaySkuTypeWorkspaceGatewayStandard),
+			}, false),
+		},
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 30),
+		},
+
+		"virtual_network_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(apigateway.VirtualNetworkTypeNone),
+			ValidateFunc: validation.StringInSlice(apigateway.PossibleValuesForVirtualNetworkType(), false),
+		},
+
+		"backend_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_api_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ApiGatewayClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ApiManagementWorkspaceGatewayModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := apigateway.NewGatewayID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if model.VirtualNetworkType != string(apigateway.VirtualNetworkTypeNone) && model.BackendSubnetId == "" {
+				return fmt.Errorf("`backend_subnet_id` must be specified when `virtual_network_type` is `%s`", model.VirtualNetworkType)
+			}
+
+			virtualNetworkType := apigateway.VirtualNetworkType(model.VirtualNetworkType)
+			parameters := apigateway.ApiManagementGatewayResource{
+				Location: location.Normalize(model.Location),
+				Properties: apigateway.ApiManagementGatewayBaseProperties{
+					VirtualNetworkType: &virtualNetworkType,
+				},
+				Sku: apigateway.ApiManagementGatewaySkuProperties{
+					Capacity: utils.Int64(model.Capacity),
+					Name:     apigateway.ApiGatewaySkuType(model.SkuName),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.BackendSubnetId != "" {
+				parameters.Properties.Backend = &apigateway.BackendConfiguration{
+					Subnet: &apigateway.BackendSubnetConfiguration{
+						Id: utils.String(model.BackendSubnetId),
+					},
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ApiGatewayClient
+
+			id, err := apigateway.ParseGatewayID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceGatewayModel{
+				Name:              id.GatewayName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.SkuName = string(model.Sku.Name)
+				state.Capacity = utils.NormaliseNilableInt64(model.Sku.Capacity)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.VirtualNetworkType = string(apigateway.VirtualNetworkTypeNone)
+				if props.VirtualNetworkType != nil {
+					state.VirtualNetworkType = string(*props.VirtualNetworkType)
+				}
+				if backend := props.Backend; backend != nil && backend.Subnet != nil {
+					state.BackendSubnetId = utils.NormalizeNilableString(backend.Subnet.Id)
+				}
+				if props.ConfigurationApi != nil {
+					state.ConfigurationApiHostname = props.ConfigurationApi.Hostname
+				}
+				if props.Frontend != nil {
+					state.DefaultHostname = utils.NormalizeNilableString(props.Frontend.DefaultHostname)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ApiGatewayClient
+
+			id, err := apigateway.ParseGatewayID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceGatewayModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("capacity") {
+				payload.Sku.Capacity = utils.Int64(model.Capacity)
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceGatewayResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ApiGatewayClient
+
+			id, err := apigateway.ParseGatewayID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspacePolicyModel struct {
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	XmlContent               string `tfschema:"xml_content"`
+	XmlLink                  string `tfschema:"xml_link"`
+}
+
+type ApiManagementWorkspacePolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyResource{}
+
+func (r ApiManagementWorkspacePolicyResource) ResourceType() string {
+	return "azurerm_api_management_workspace_policy"
+}
+
+func (r ApiManagementWorkspacePolicyResource) ModelObject() interface{} {
+	return &ApiManagementWorkspacePolicyModel{}
+}
+
+func (r ApiManagementWorkspacePolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacepolicy.ValidateWorkspacePolicyID
+}
+
+func (r ApiManagementWorkspacePolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"xml_content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ExactlyOneOf:     []string{"xml_link", "xml_content"},
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+
+		"xml_link": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"xml_link", "xml_content"},
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			var model ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspacepolicy.NewWorkspacePolicyID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementWorkspacePolicy(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspacePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspacePolicyModel{
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					// the API returns the policy XML content rather than the link it was loaded from
+					state.XmlContent = props.Value
+				}
+			}
+
+			var config ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.XmlLink = config.XmlLink
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspacePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementWorkspacePolicy(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspacePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspacepolicy.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspacePolicy(input ApiManagementWorkspacePolicyModel) workspacepolicy.PolicyContract {
+	format := workspacepolicy.PolicyContentFormatXml
+	value := input.XmlContent
+	if input.XmlLink != "" {
+		format = workspacepolicy.PolicyContentFormatXmlNegativelink
+		value = input.XmlLink
+	}
+
+	return workspacepolicy.PolicyContract{
+		Properties: &workspacepolicy.PolicyContractProperties{
+			Format: &format,
+			Value:  value,
+		},
+	}
+}
@@ -0,0 +1,273 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceProductModel struct {
+	Name                     string `tfschema:"name"`
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	DisplayName              string `tfschema:"display_name"`
+	Published                bool   `tfschema:"published"`
+	ApprovalRequired         bool   `tfschema:"approval_required"`
+	Description              string `tfschema:"description"`
+	SubscriptionRequired     bool   `tfschema:"subscription_required"`
+	SubscriptionsLimit       int64  `tfschema:"subscriptions_limit"`
+	Terms                    string `tfschema:"terms"`
+}
+
+type ApiManagementWorkspaceProductResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceProductResource{}
+
+func (r ApiManagementWorkspaceProductResource) ResourceType() string {
+	return "azurerm_api_management_workspace_product"
+}
+
+func (r ApiManagementWorkspaceProductResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceProductModel{}
+}
+
+func (r ApiManagementWorkspaceProductResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspaceproduct.ValidateWorkspaceProductID
+}
+
+func (r ApiManagementWorkspaceProductResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"published": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"approval_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"subscriptions_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"terms": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceProductResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspaceproduct.NewWorkspaceProductID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := expandApiManagementWorkspaceProduct(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := workspaceproduct.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceProductModel{
+				Name:                     id.ProductId,
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ApprovalRequired = utils.NormaliseNilableBool(props.ApprovalRequired)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.DisplayName = props.DisplayName
+					state.Published = props.State != nil && *props.State == workspaceproduct.ProductStatePublished
+					state.SubscriptionRequired = utils.NormaliseNilableBool(props.SubscriptionRequired)
+					state.SubscriptionsLimit = utils.NormaliseNilableInt64(props.SubscriptionsLimit)
+					state.Terms = utils.NormalizeNilableString(props.Terms)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := workspaceproduct.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters, err := expandApiManagementWorkspaceProduct(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := workspaceproduct.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspaceproduct.DeleteOperationOptions{
+				DeleteSubscriptions: utils.Bool(true),
+				IfMatch:             utils.String("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceProduct(input ApiManagementWorkspaceProductModel) (*workspaceproduct.ProductContract, error) {
+	if input.ApprovalRequired && !input.SubscriptionRequired {
+		return nil, fmt.Errorf("`subscription_required` must be true when `approval_required` is true")
+	}
+
+	state := workspaceproduct.ProductStateNotPublished
+	if input.Published {
+		state = workspaceproduct.ProductStatePublished
+	}
+
+	output := workspaceproduct.ProductContract{
+		Properties: &workspaceproduct.ProductContractProperties{
+			Description:          utils.String(input.Description),
+			DisplayName:          input.DisplayName,
+			State:                &state,
+			SubscriptionRequired: utils.Bool(input.SubscriptionRequired),
+			Terms:                utils.String(input.Terms),
+		},
+	}
+
+	// approvalRequired and subscriptionsLimit can only be set when subscriptionRequired is true
+	if input.SubscriptionRequired {
+		output.Properties.ApprovalRequired = utils.Bool(input.ApprovalRequired)
+		if input.SubscriptionsLimit > 0 {
+			output.Properties.SubscriptionsLimit = utils.Int64(input.SubscriptionsLimit)
+		}
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,138 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceProductResource struct{}
+
+func TestAccApiManagementWorkspaceProduct_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceProduct_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceProduct_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscriptions_limit").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceProductResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspaceproduct.ParseWorkspaceProductID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.WorkspaceProductClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementWorkspaceProductResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "test" {
+  name                        = "acctestproduct-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Product"
+  published                   = false
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceProductResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "import" {
+  name                        = azurerm_api_management_workspace_product.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_product.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_product.test.display_name
+  published                   = azurerm_api_management_workspace_product.test.published
+}
+`, r.basic(data))
+}
+
+func (ApiManagementWorkspaceProductResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "test" {
+  name                        = "acctestproduct-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Product Updated"
+  published                   = true
+  approval_required           = true
+  subscription_required       = true
+  subscriptions_limit         = 2
+  description                 = "This is an example description"
+  terms                       = "These are some example terms and conditions"
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,300 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspacesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceSubscriptionModel struct {
+	Name                     string `tfschema:"name"`
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	DisplayName              string `tfschema:"display_name"`
+	ApiId                    string `tfschema:"api_id"`
+	ProductId                string `tfschema:"product_id"`
+	AllowTracing             bool   `tfschema:"allow_tracing"`
+	State                    string `tfschema:"state"`
+	PrimaryKey               string `tfschema:"primary_key"`
+	SecondaryKey             string `tfschema:"secondary_key"`
+}
+
+type ApiManagementWorkspaceSubscriptionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceSubscriptionResource{}
+
+func (r ApiManagementWorkspaceSubscriptionResource) ResourceType() string {
+	return "azurerm_api_management_workspace_subscription"
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceSubscriptionModel{}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacesubscription.ValidateWorkspaceSubscriptionID
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"api_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  workspaceapi.ValidateWorkspaceApiID,
+			ConflictsWith: []string{"product_id"},
+		},
+
+		"product_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  workspaceproduct.ValidateWorkspaceProductID,
+			ConflictsWith: []string{"api_id"},
+		},
+
+		"allow_tracing": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(workspacesubscription.SubscriptionStateSubmitted),
+			ValidateFunc: validation.StringInSlice(workspacesubscription.PossibleValuesForSubscriptionState(), false),
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			var model ApiManagementWorkspaceSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspacesubscription.NewWorkspaceSubscriptionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// a subscription without an API or Product is scoped to all APIs within the Workspace
+			scope := workspaceId.ID() + "/apis"
+			if model.ApiId != "" {
+				scope = model.ApiId
+			}
+			if model.ProductId != "" {
+				scope = model.ProductId
+			}
+
+			state := workspacesubscription.SubscriptionState(model.State)
+			parameters := workspacesubscription.SubscriptionCreateParameters{
+				Properties: &workspacesubscription.SubscriptionCreateParameterProperties{
+					AllowTracing: utils.Bool(model.AllowTracing),
+					DisplayName:  model.DisplayName,
+					Scope:        scope,
+					State:        &state,
+				},
+			}
+			if model.PrimaryKey != "" {
+				parameters.Properties.PrimaryKey = utils.String(model.PrimaryKey)
+			}
+			if model.SecondaryKey != "" {
+				parameters.Properties.SecondaryKey = utils.String(model.SecondaryKey)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := workspacesubscription.ParseWorkspaceSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceSubscriptionModel{
+				Name:                     id.SubscriptionName,
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AllowTracing = utils.NormaliseNilableBool(props.AllowTracing)
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					state.State = string(props.State)
+
+					if apiId, err := workspaceapi.ParseWorkspaceApiIDInsensitively(props.Scope); err == nil {
+						state.ApiId = apiId.ID()
+					}
+					if productId, err := workspaceproduct.ParseWorkspaceProductIDInsensitively(props.Scope); err == nil {
+						state.ProductId = productId.ID()
+					}
+				}
+			}
+
+			keys, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+			}
+			if model := keys.Model; model != nil {
+				state.PrimaryKey = utils.NormalizeNilableString(model.PrimaryKey)
+				state.SecondaryKey = utils.NormalizeNilableString(model.SecondaryKey)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := workspacesubscription.ParseWorkspaceSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			state := workspacesubscription.SubscriptionState(model.State)
+			parameters := workspacesubscription.SubscriptionCreateParameters{
+				Properties: &workspacesubscription.SubscriptionCreateParameterProperties{
+					AllowTracing: utils.Bool(model.AllowTracing),
+					DisplayName:  model.DisplayName,
+					OwnerId:      existing.Model.Properties.OwnerId,
+					PrimaryKey:   utils.String(model.PrimaryKey),
+					Scope:        existing.Model.Properties.Scope,
+					SecondaryKey: utils.String(model.SecondaryKey),
+					State:        &state,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := workspacesubscription.ParseWorkspaceSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspacesubscription.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
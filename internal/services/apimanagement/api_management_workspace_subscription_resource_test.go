@@ -0,0 +1,158 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspacesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceSubscriptionResource struct{}
+
+func TestAccApiManagementWorkspaceSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_api(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.api(data, "submitted"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.api(data, "active"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("active"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_product(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.product(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceSubscriptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspacesubscription.ParseWorkspaceSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.WorkspaceSubscriptionClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementWorkspaceSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  name                        = "acctestsub-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Subscription"
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "import" {
+  name                        = azurerm_api_management_workspace_subscription.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_subscription.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_subscription.test.display_name
+}
+`, r.basic(data))
+}
+
+func (ApiManagementWorkspaceSubscriptionResource) api(data acceptance.TestData, state string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  name                        = "acctestsub-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Subscription"
+  api_id                      = azurerm_api_management_workspace_api.test.id
+  allow_tracing               = false
+  state                       = "%s"
+}
+`, ApiManagementWorkspaceApiResource{}.basic(data), data.RandomInteger, state)
+}
+
+func (ApiManagementWorkspaceSubscriptionResource) product(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  name                        = "acctestsub-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Subscription"
+  product_id                  = azurerm_api_management_workspace_product.test.id
+  primary_key                 = "d0aa4ea7b35b4e1f8e0fc4a2e1d3c9a2"
+  secondary_key               = "5c2f3d4e1a0b4c8d9e7f6a5b4c3d2e1f"
+}
+`, ApiManagementWorkspaceProductResource{}.basic(data), data.RandomInteger)
+}
@@ -3,11 +3,20 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/apigateway"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/apigatewayconfigconnection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/workspacesubscription"
 )
 
 type Client struct {
 	ApiClient                        *apimanagement.APIClient
 	ApiDiagnosticClient              *apimanagement.APIDiagnosticClient
+	ApiGatewayClient                 *apigateway.ApiGatewayClient
+	ApiGatewayConfigConnectionClient *apigatewayconfigconnection.ApiGatewayConfigConnectionClient
 	ApiPoliciesClient                *apimanagement.APIPolicyClient
 	ApiOperationsClient              *apimanagement.APIOperationClient
 	ApiOperationPoliciesClient       *apimanagement.APIOperationPolicyClient
@@ -43,6 +52,11 @@ type Client struct {
 	TagClient                        *apimanagement.TagClient
 	TenantAccessClient               *apimanagement.TenantAccessClient
 	UsersClient                      *apimanagement.UserClient
+	WorkspaceClient                  *workspace.WorkspaceClient
+	WorkspaceApiClient               *workspaceapi.WorkspaceApiClient
+	WorkspacePolicyClient            *workspacepolicy.WorkspacePolicyClient
+	WorkspaceProductClient           *workspaceproduct.WorkspaceProductClient
+	WorkspaceSubscriptionClient      *workspacesubscription.WorkspaceSubscriptionClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -157,9 +171,32 @@ func NewClient(o *common.ClientOptions) *Client {
 	usersClient := apimanagement.NewUserClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&usersClient.Client, o.ResourceManagerAuthorizer)
 
+	apiGatewayClient := apigateway.NewApiGatewayClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&apiGatewayClient.Client, o.ResourceManagerAuthorizer)
+
+	apiGatewayConfigConnectionClient := apigatewayconfigconnection.NewApiGatewayConfigConnectionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&apiGatewayConfigConnectionClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceClient := workspace.NewWorkspaceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceApiClient := workspaceapi.NewWorkspaceApiClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceApiClient.Client, o.ResourceManagerAuthorizer)
+
+	workspacePolicyClient := workspacepolicy.NewWorkspacePolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspacePolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceProductClient := workspaceproduct.NewWorkspaceProductClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceProductClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceSubscriptionClient := workspacesubscription.NewWorkspaceSubscriptionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceSubscriptionClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ApiClient:                        &apiClient,
 		ApiDiagnosticClient:              &apiDiagnosticClient,
+		ApiGatewayClient:                 &apiGatewayClient,
+		ApiGatewayConfigConnectionClient: &apiGatewayConfigConnectionClient,
 		ApiPoliciesClient:                &apiPoliciesClient,
 		ApiOperationsClient:              &apiOperationsClient,
 		ApiOperationPoliciesClient:       &apiOperationPoliciesClient,
@@ -195,5 +232,10 @@ func NewClient(o *common.ClientOptions) *Client {
 		TagClient:                        &tagClient,
 		TenantAccessClient:               &tenantAccessClient,
 		UsersClient:                      &usersClient,
+		WorkspaceClient:                  &workspaceClient,
+		WorkspaceApiClient:               &workspaceApiClient,
+		WorkspacePolicyClient:            &workspacePolicyClient,
+		WorkspaceProductClient:           &workspaceProductClient,
+		WorkspaceSubscriptionClient:      &workspaceSubscriptionClient,
 	}
 }
@@ -85,5 +85,12 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ApiManagementNotificationRecipientEmailResource{},
 		ApiManagementNotificationRecipientUserResource{},
+		ApiManagementWorkspaceResource{},
+		ApiManagementWorkspaceApiResource{},
+		ApiManagementWorkspaceGatewayResource{},
+		ApiManagementWorkspaceGatewayConfigConnectionResource{},
+		ApiManagementWorkspacePolicyResource{},
+		ApiManagementWorkspaceProductResource{},
+		ApiManagementWorkspaceSubscriptionResource{},
 	}
 }
@@ -0,0 +1,15 @@
+package apigateway
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ApiGatewayClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewApiGatewayClientWithBaseURI(endpoint string) ApiGatewayClient {
+	return ApiGatewayClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,12 @@
+package apigateway
+
+type ApiManagementGatewayResource struct {
+	Etag       *string                            `json:"etag,omitempty"`
+	Id         *string                            `json:"id,omitempty"`
+	Location   string                             `json:"location"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties ApiManagementGatewayBaseProperties `json:"properties"`
+	Sku        ApiManagementGatewaySkuProperties  `json:"sku"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package apigateway
+
+type ApiManagementGatewaySkuProperties struct {
+	Capacity *int64            `json:"capacity,omitempty"`
+	Name     ApiGatewaySkuType `json:"name"`
+}
@@ -0,0 +1,5 @@
+package apigateway
+
+type BackendConfiguration struct {
+	Subnet *BackendSubnetConfiguration `json:"subnet,omitempty"`
+}
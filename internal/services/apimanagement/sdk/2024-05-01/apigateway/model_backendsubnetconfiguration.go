@@ -0,0 +1,5 @@
+package apigateway
+
+type BackendSubnetConfiguration struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package apigateway
+
+type FrontendConfiguration struct {
+	DefaultHostname *string `json:"defaultHostname,omitempty"`
+}
@@ -0,0 +1,5 @@
+package apigateway
+
+type GatewayConfigurationApi struct {
+	Hostname string `json:"hostname"`
+}
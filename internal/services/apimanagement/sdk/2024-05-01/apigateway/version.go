@@ -0,0 +1,9 @@
+package apigateway
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/apigateway/%s", defaultApiVersion)
+}
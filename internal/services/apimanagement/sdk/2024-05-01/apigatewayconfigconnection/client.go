@@ -0,0 +1,15 @@
+package apigatewayconfigconnection
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ApiGatewayConfigConnectionClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewApiGatewayConfigConnectionClientWithBaseURI(endpoint string) ApiGatewayConfigConnectionClient {
+	return ApiGatewayConfigConnectionClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
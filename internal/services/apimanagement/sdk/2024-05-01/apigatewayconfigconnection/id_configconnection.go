@@ -0,0 +1,137 @@
+package apigatewayconfigconnection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ConfigConnectionId{}
+
+// ConfigConnectionId is a struct representing the Resource ID for a Config Connection
+type ConfigConnectionId struct {
+	SubscriptionId       string
+	ResourceGroupName    string
+	GatewayName          string
+	ConfigConnectionName string
+}
+
+// NewConfigConnectionID returns a new ConfigConnectionId struct
+func NewConfigConnectionID(subscriptionId string, resourceGroupName string, gatewayName string, configConnectionName string) ConfigConnectionId {
+	return ConfigConnectionId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroupName:    resourceGroupName,
+		GatewayName:          gatewayName,
+		ConfigConnectionName: configConnectionName,
+	}
+}
+
+// ParseConfigConnectionID parses 'input' into a ConfigConnectionId
+func ParseConfigConnectionID(input string) (*ConfigConnectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ConfigConnectionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ConfigConnectionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.GatewayName, ok = parsed.Parsed["gatewayName"]; !ok {
+		return nil, fmt.Errorf("the segment 'gatewayName' was not found in the resource id %q", input)
+	}
+
+	if id.ConfigConnectionName, ok = parsed.Parsed["configConnectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'configConnectionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseConfigConnectionIDInsensitively parses 'input' case-insensitively into a ConfigConnectionId
+// note: this method should only be used for API response data and not user input
+func ParseConfigConnectionIDInsensitively(input string) (*ConfigConnectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ConfigConnectionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ConfigConnectionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.GatewayName, ok = parsed.Parsed["gatewayName"]; !ok {
+		return nil, fmt.Errorf("the segment 'gatewayName' was not found in the resource id %q", input)
+	}
+
+	if id.ConfigConnectionName, ok = parsed.Parsed["configConnectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'configConnectionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateConfigConnectionID checks that 'input' can be parsed as a Config Connection ID
+func ValidateConfigConnectionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseConfigConnectionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Config Connection ID
+func (id ConfigConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/gateways/%s/configConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.GatewayName, id.ConfigConnectionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Config Connection ID
+func (id ConfigConnectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticGateways", "gateways", "gateways"),
+		resourceids.UserSpecifiedSegment("gatewayName", "gatewayValue"),
+		resourceids.StaticSegment("staticConfigConnections", "configConnections", "configConnections"),
+		resourceids.UserSpecifiedSegment("configConnectionName", "configConnectionValue"),
+	}
+}
+
+// String returns a human-readable description of this Config Connection ID
+func (id ConfigConnectionId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Gateway Name: %q", id.GatewayName),
+		fmt.Sprintf("Config Connection Name: %q", id.ConfigConnectionName),
+	}
+	return fmt.Sprintf("Config Connection (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package apigatewayconfigconnection
+
+type ApiManagementGatewayConfigConnectionProperties struct {
+	DefaultHostname   *string   `json:"defaultHostname,omitempty"`
+	Hostnames         *[]string `json:"hostnames,omitempty"`
+	ProvisioningState *string   `json:"provisioningState,omitempty"`
+	SourceId          *string   `json:"sourceId,omitempty"`
+}
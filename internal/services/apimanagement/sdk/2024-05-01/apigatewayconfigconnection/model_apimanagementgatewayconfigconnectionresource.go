@@ -0,0 +1,9 @@
+package apigatewayconfigconnection
+
+type ApiManagementGatewayConfigConnectionResource struct {
+	Etag       *string                                        `json:"etag,omitempty"`
+	Id         *string                                        `json:"id,omitempty"`
+	Name       *string                                        `json:"name,omitempty"`
+	Properties ApiManagementGatewayConfigConnectionProperties `json:"properties"`
+	Type       *string                                        `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package apigatewayconfigconnection
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/apigatewayconfigconnection/%s", defaultApiVersion)
+}
@@ -0,0 +1,8 @@
+package workspace
+
+type WorkspaceContract struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *WorkspaceContractProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
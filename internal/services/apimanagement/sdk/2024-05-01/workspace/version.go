@@ -0,0 +1,9 @@
+package workspace
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspace/%s", defaultApiVersion)
+}
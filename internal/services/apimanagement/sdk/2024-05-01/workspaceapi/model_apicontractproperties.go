@@ -0,0 +1,16 @@
+package workspaceapi
+
+type ApiContractProperties struct {
+	ApiRevision            *string     `json:"apiRevision,omitempty"`
+	ApiRevisionDescription *string     `json:"apiRevisionDescription,omitempty"`
+	ApiVersion             *string     `json:"apiVersion,omitempty"`
+	Description            *string     `json:"description,omitempty"`
+	DisplayName            *string     `json:"displayName,omitempty"`
+	IsCurrent              *bool       `json:"isCurrent,omitempty"`
+	IsOnline               *bool       `json:"isOnline,omitempty"`
+	Path                   string      `json:"path"`
+	Protocols              *[]Protocol `json:"protocols,omitempty"`
+	ServiceUrl             *string     `json:"serviceUrl,omitempty"`
+	SubscriptionRequired   *bool       `json:"subscriptionRequired,omitempty"`
+	Type                   *ApiType    `json:"type,omitempty"`
+}
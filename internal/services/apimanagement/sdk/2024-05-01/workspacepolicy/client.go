@@ -0,0 +1,15 @@
+package workspacepolicy
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspacePolicyClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspacePolicyClientWithBaseURI(endpoint string) WorkspacePolicyClient {
+	return WorkspacePolicyClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
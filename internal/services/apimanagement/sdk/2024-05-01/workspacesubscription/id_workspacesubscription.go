@@ -0,0 +1,150 @@
+package workspacesubscription
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = WorkspaceSubscriptionId{}
+
+// WorkspaceSubscriptionId is a struct representing the Resource ID for a Workspace Subscription
+type WorkspaceSubscriptionId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ServiceName       string
+	WorkspaceId       string
+	SubscriptionName  string
+}
+
+// NewWorkspaceSubscriptionID returns a new WorkspaceSubscriptionId struct
+func NewWorkspaceSubscriptionID(subscriptionId string, resourceGroupName string, serviceName string, workspaceId string, subscriptionName string) WorkspaceSubscriptionId {
+	return WorkspaceSubscriptionId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ServiceName:       serviceName,
+		WorkspaceId:       workspaceId,
+		SubscriptionName:  subscriptionName,
+	}
+}
+
+// ParseWorkspaceSubscriptionID parses 'input' into a WorkspaceSubscriptionId
+func ParseWorkspaceSubscriptionID(input string) (*WorkspaceSubscriptionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(WorkspaceSubscriptionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := WorkspaceSubscriptionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceId, ok = parsed.Parsed["workspaceId"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceId' was not found in the resource id %q", input)
+	}
+
+	if id.SubscriptionName, ok = parsed.Parsed["subscriptionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseWorkspaceSubscriptionIDInsensitively parses 'input' case-insensitively into a WorkspaceSubscriptionId
+// note: this method should only be used for API response data and not user input
+func ParseWorkspaceSubscriptionIDInsensitively(input string) (*WorkspaceSubscriptionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(WorkspaceSubscriptionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := WorkspaceSubscriptionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceId, ok = parsed.Parsed["workspaceId"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceId' was not found in the resource id %q", input)
+	}
+
+	if id.SubscriptionName, ok = parsed.Parsed["subscriptionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateWorkspaceSubscriptionID checks that 'input' can be parsed as a Workspace Subscription ID
+func ValidateWorkspaceSubscriptionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseWorkspaceSubscriptionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Workspace Subscription ID
+func (id WorkspaceSubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/subscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId, id.SubscriptionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Workspace Subscription ID
+func (id WorkspaceSubscriptionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "serviceValue"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceId", "workspaceIdValue"),
+		resourceids.StaticSegment("staticSubscriptions2", "subscriptions", "subscriptions"),
+		resourceids.UserSpecifiedSegment("subscriptionName", "subscriptionValue"),
+	}
+}
+
+// String returns a human-readable description of this Workspace Subscription ID
+func (id WorkspaceSubscriptionId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Service Name: %q", id.ServiceName),
+		fmt.Sprintf("Workspace Id: %q", id.WorkspaceId),
+		fmt.Sprintf("Subscription Name: %q", id.SubscriptionName),
+	}
+	return fmt.Sprintf("Workspace Subscription (%s)", strings.Join(components, "\n"))
+}
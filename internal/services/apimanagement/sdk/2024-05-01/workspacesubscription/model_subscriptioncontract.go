@@ -0,0 +1,8 @@
+package workspacesubscription
+
+type SubscriptionContract struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *SubscriptionContractProperties `json:"properties,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+}
@@ -0,0 +1,28 @@
+package workspacesubscription
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type SubscriptionContractProperties struct {
+	AllowTracing *bool             `json:"allowTracing,omitempty"`
+	CreatedDate  *string           `json:"createdDate,omitempty"`
+	DisplayName  *string           `json:"displayName,omitempty"`
+	OwnerId      *string           `json:"ownerId,omitempty"`
+	Scope        string            `json:"scope"`
+	State        SubscriptionState `json:"state"`
+}
+
+func (o SubscriptionContractProperties) GetCreatedDateAsTime() (*time.Time, error) {
+	if o.CreatedDate == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.CreatedDate, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o SubscriptionContractProperties) SetCreatedDateAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.CreatedDate = &formatted
+}
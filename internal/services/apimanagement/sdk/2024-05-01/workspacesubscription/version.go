@@ -0,0 +1,9 @@
+package workspacesubscription
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspacesubscription/%s", defaultApiVersion)
+}
@@ -9,7 +9,7 @@ import (
 
 func ApimSkuName() pluginsdk.SchemaValidateFunc {
 	return validation.StringMatch(
-		regexp.MustCompile(`^Consumption_0$|^Basic_(1|2)$|^Developer_1$|^Premium_([1-9]|10)$|^Standard_[1-4]$`),
+		regexp.MustCompile(`^Consumption_0$|^Basic_(1|2)$|^Developer_1$|^Premium_([1-9]|10)$|^Standard_[1-4]$|^(BasicV2|StandardV2)_([1-9]|10)$|^PremiumV2_([1-9]|[1-2][0-9]|30)$`),
 		`This is not a valid Api Management sku name.`,
 	)
 }
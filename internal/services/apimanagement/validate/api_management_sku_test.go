@@ -53,6 +53,36 @@ func TestApimSkuName(t *testing.T) {
 			input: "Standard_7",
 			valid: false,
 		},
+		{
+			name:  "BasicV2_1",
+			input: "BasicV2_1",
+			valid: true,
+		},
+		{
+			name:  "BasicV2_11",
+			input: "BasicV2_11",
+			valid: false,
+		},
+		{
+			name:  "StandardV2_10",
+			input: "StandardV2_10",
+			valid: true,
+		},
+		{
+			name:  "StandardV2_0",
+			input: "StandardV2_0",
+			valid: false,
+		},
+		{
+			name:  "PremiumV2_30",
+			input: "PremiumV2_30",
+			valid: true,
+		},
+		{
+			name:  "PremiumV2_31",
+			input: "PremiumV2_31",
+			valid: false,
+		},
 		{
 			name:  "standard_2",
 			input: "standard_2",
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -13,12 +14,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2022-05-01/configurationstores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type appConfigurationIdentityType = identity.SystemAssignedUserAssigned
@@ -195,14 +197,39 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_app_configuration", resourceId.ID())
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if the App Configuration exists in the soft delete state
+	deletedConfigurationStoreId := configurationstores.NewDeletedConfigurationStoreID(subscriptionId, location, name)
+	deleted, err := client.GetDeleted(ctx, deletedConfigurationStoreId)
+
+	// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+	softDeleted := !response.WasNotFound(deleted.HttpResponse) && !utils.ResponseWasForbidden(autorest.Response{Response: deleted.HttpResponse})
+	if err != nil && softDeleted {
+		return fmt.Errorf("checking for presence of an existing %s: %+v", deletedConfigurationStoreId, err)
+	}
+
+	// if so, does the user want us to recover it?
+	if softDeleted && !meta.(*clients.Client).Features.AppConfiguration.RecoverSoftDeleted {
+		// this exists but the users opted out so they must import this it out-of-band
+		return fmt.Errorf(optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location))
+	}
+
 	parameters := configurationstores.ConfigurationStore{
-		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Location: location,
 		Sku: configurationstores.Sku{
 			Name: d.Get("sku").(string),
 		},
 		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if softDeleted {
+		createMode := configurationstores.CreateModeRecover
+		parameters.Properties = &configurationstores.ConfigurationStoreProperties{
+			CreateMode: &createMode,
+		}
+	}
+
 	identity, err := expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("expanding `identity`: %+v", err)
@@ -312,13 +339,67 @@ func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	// first we need to retrieve it, since we need the location to be able to purge it
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
+	if !meta.(*clients.Client).Features.AppConfiguration.PurgeOnDestroy {
+		log.Printf("[DEBUG] Skipping Purge of %s", *id)
+		return nil
+	}
+
+	// App Configurations with Purge Protection Enabled cannot be purged unless done by Azure
+	if props := existing.Model.Properties; props != nil && props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+		log.Printf("[DEBUG] %s has Purge Protection Enabled and will be purged automatically by Azure", *id)
+		return nil
+	}
+
+	deletedConfigurationStoreId := configurationstores.NewDeletedConfigurationStoreID(id.SubscriptionId, location.Normalize(existing.Model.Location), id.ConfigStoreName)
+	deleted, err := client.GetDeleted(ctx, deletedConfigurationStoreId)
+	if err != nil {
+		// only App Configurations using the `standard` SKU are soft-deleted
+		if response.WasNotFound(deleted.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", deletedConfigurationStoreId, err)
+	}
+
+	log.Printf("[DEBUG] Purging %s..", deletedConfigurationStoreId)
+	if err := client.PurgeDeletedThenPoll(ctx, deletedConfigurationStoreId); err != nil {
+		return fmt.Errorf("purging %s: %+v", deletedConfigurationStoreId, err)
+	}
+
 	return nil
 }
 
+func optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted App Configuration exists with the Name %q in the location %q, however
+automatically recovering this App Configuration has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted App Configuration when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`, name, location)
+}
+
 type flattenedAccessKeys struct {
 	primaryReadKey    []interface{}
 	primaryWriteKey   []interface{}
@@ -3,12 +3,13 @@ package appconfiguration_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2022-05-01/configurationstores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -158,6 +159,59 @@ func TestAccAppConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccAppConfiguration_softDeleteRecovery(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration", "test")
+	r := AppConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// create it regularly
+			Config: r.softDelete(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// delete the app configuration without purging it
+			Config: r.softDeleteAbsent(data),
+		},
+		{
+			// attempting to re-create it requires recovery, which is enabled
+			Config: r.softDelete(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfiguration_softDeleteRecoveryDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration", "test")
+	r := AppConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// create it regularly
+			Config: r.softDelete(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// delete the app configuration without purging it
+			Config: r.softDeleteAbsent(data),
+		},
+		{
+			// attempting to re-create it requires recovery, which is disabled
+			Config:      r.softDelete(data, false),
+			ExpectError: regexp.MustCompile("An existing soft-deleted App Configuration exists with the Name"),
+		},
+	})
+}
+
 func (t AppConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := configurationstores.ParseConfigurationStoreID(state.ID)
 	if err != nil {
@@ -338,3 +392,45 @@ resource "azurerm_app_configuration" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (AppConfigurationResource) softDelete(data acceptance.TestData, recoverSoftDeleted bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    app_configuration {
+      purge_on_destroy     = false
+      recover_soft_deleted = %t
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testaccappconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+`, recoverSoftDeleted, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (AppConfigurationResource) softDeleteAbsent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    app_configuration {
+      purge_on_destroy = false
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,304 @@
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2023-03-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SnapshotResource struct{}
+
+var _ sdk.Resource = SnapshotResource{}
+
+type SnapshotResourceModel struct {
+	Name                     string            `tfschema:"name"`
+	ConfigurationStoreId     string            `tfschema:"configuration_store_id"`
+	Filters                  []SnapshotFilter  `tfschema:"filter"`
+	CompositionType          string            `tfschema:"composition_type"`
+	RetentionPeriodInSeconds int64             `tfschema:"retention_period_in_seconds"`
+	Tags                     map[string]string `tfschema:"tags"`
+	CreatedAt                string            `tfschema:"created_at"`
+	ExpiresAt                string            `tfschema:"expires_at"`
+	ItemsCount               int64             `tfschema:"items_count"`
+	SizeInBytes              int64             `tfschema:"size_in_bytes"`
+	Status                   string            `tfschema:"status"`
+}
+
+type SnapshotFilter struct {
+	Key   string `tfschema:"key"`
+	Label string `tfschema:"label"`
+}
+
+func (r SnapshotResource) ResourceType() string {
+	return "azurerm_app_configuration_snapshot"
+}
+
+func (r SnapshotResource) ModelObject() interface{} {
+	return &SnapshotResourceModel{}
+}
+
+func (r SnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return snapshots.ValidateSnapshotID
+}
+
+func (r SnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: snapshots.ValidateConfigurationStoreID,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			MaxItems: 3,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"label": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"composition_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(snapshots.CompositionTypeKey),
+			ValidateFunc: validation.StringInSlice(snapshots.PossibleValuesForCompositionType(), false),
+		},
+
+		// the API defaults this to 30 days, but the upper bound depends on the SKU of the Configuration Store
+		"retention_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(3600, 7776000),
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r SnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"created_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expires_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"items_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.SnapshotsClient
+
+			var model SnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			configurationStoreId, err := snapshots.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			id := snapshots.NewSnapshotID(configurationStoreId.SubscriptionId, configurationStoreId.ResourceGroupName, configurationStoreId.ConfigurationStoreName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			compositionType := snapshots.CompositionType(model.CompositionType)
+			parameters := snapshots.Snapshot{
+				Properties: &snapshots.SnapshotProperties{
+					CompositionType: &compositionType,
+					Filters:         expandSnapshotFilters(model.Filters),
+					Tags:            &model.Tags,
+				},
+			}
+
+			if model.RetentionPeriodInSeconds != 0 {
+				parameters.Properties.RetentionPeriod = utils.Int64(model.RetentionPeriodInSeconds)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.SnapshotsClient
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SnapshotResourceModel{
+				Name:                 id.SnapshotName,
+				ConfigurationStoreId: snapshots.NewConfigurationStoreID(id.SubscriptionId, id.ResourceGroupName, id.ConfigurationStoreName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Filters = flattenSnapshotFilters(props.Filters)
+					state.CreatedAt = utils.NormalizeNilableString(props.Created)
+					state.ExpiresAt = utils.NormalizeNilableString(props.Expires)
+
+					state.CompositionType = string(snapshots.CompositionTypeKey)
+					if props.CompositionType != nil {
+						state.CompositionType = string(*props.CompositionType)
+					}
+
+					if props.RetentionPeriod != nil {
+						state.RetentionPeriodInSeconds = *props.RetentionPeriod
+					}
+
+					if props.ItemsCount != nil {
+						state.ItemsCount = *props.ItemsCount
+					}
+
+					if props.Size != nil {
+						state.SizeInBytes = *props.Size
+					}
+
+					if props.Status != nil {
+						state.Status = string(*props.Status)
+					}
+
+					if props.Tags != nil {
+						state.Tags = *props.Tags
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// Snapshots are immutable and can't be deleted - they're archived once the retention period
+			// has elapsed and are then purged by the service, so this only removes the Snapshot from the state
+			log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+
+			return nil
+		},
+	}
+}
+
+func expandSnapshotFilters(input []SnapshotFilter) []snapshots.KeyValueFilter {
+	output := make([]snapshots.KeyValueFilter, 0)
+	for _, v := range input {
+		filter := snapshots.KeyValueFilter{
+			Key: v.Key,
+		}
+		if v.Label != "" {
+			filter.Label = utils.String(v.Label)
+		}
+		output = append(output, filter)
+	}
+
+	return output
+}
+
+func flattenSnapshotFilters(input []snapshots.KeyValueFilter) []SnapshotFilter {
+	output := make([]SnapshotFilter, 0)
+	for _, v := range input {
+		output = append(output, SnapshotFilter{
+			Key:   v.Key,
+			Label: utils.NormalizeNilableString(v.Label),
+		})
+	}
+
+	return output
+}
@@ -7,11 +7,15 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2022-05-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2023-03-01/replicas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2023-03-01/snapshots"
 )
 
 type Client struct {
 	ConfigurationStoresClient *configurationstores.ConfigurationStoresClient
+	ReplicasClient            *replicas.ReplicasClient
+	SnapshotsClient           *snapshots.SnapshotsClient
 	tokenFunc                 func(endpoint string) (autorest.Authorizer, error)
 	configureClientFunc       func(c *autorest.Client, authorizer autorest.Authorizer)
 }
@@ -48,8 +52,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	configurationStores := configurationstores.NewConfigurationStoresClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&configurationStores.Client, o.ResourceManagerAuthorizer)
 
+	replicasClient := replicas.NewReplicasClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&replicasClient.Client, o.ResourceManagerAuthorizer)
+
+	snapshotsClient := snapshots.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ConfigurationStoresClient: &configurationStores,
+		ReplicasClient:            &replicasClient,
+		SnapshotsClient:           &snapshotsClient,
 		tokenFunc:                 o.TokenFunc,
 		configureClientFunc:       o.ConfigureClient,
 	}
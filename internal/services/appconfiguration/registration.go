@@ -20,6 +20,8 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyResource{},
 		FeatureResource{},
+		ReplicaResource{},
+		SnapshotResource{},
 	}
 }
 
@@ -1,141 +0,0 @@
-package configurationstores
-
-import "strings"
-
-type ActionsRequired string
-
-const (
-	ActionsRequiredNone     ActionsRequired = "None"
-	ActionsRequiredRecreate ActionsRequired = "Recreate"
-)
-
-func PossibleValuesForActionsRequired() []string {
-	return []string{
-		"None",
-		"Recreate",
-	}
-}
-
-func parseActionsRequired(input string) (*ActionsRequired, error) {
-	vals := map[string]ActionsRequired{
-		"none":     "None",
-		"recreate": "Recreate",
-	}
-	if v, ok := vals[strings.ToLower(input)]; ok {
-		return &v, nil
-	}
-
-	// it could be a new value - best effort convert this
-	v := input
-
-	out := ActionsRequired(v)
-	return &out, nil
-}
-
-type ConnectionStatus string
-
-const (
-	ConnectionStatusApproved     ConnectionStatus = "Approved"
-	ConnectionStatusDisconnected ConnectionStatus = "Disconnected"
-	ConnectionStatusPending      ConnectionStatus = "Pending"
-	ConnectionStatusRejected     ConnectionStatus = "Rejected"
-)
-
-func PossibleValuesForConnectionStatus() []string {
-	return []string{
-		"Approved",
-		"Disconnected",
-		"Pending",
-		"Rejected",
-	}
-}
-
-func parseConnectionStatus(input string) (*ConnectionStatus, error) {
-	vals := map[string]ConnectionStatus{
-		"approved":     "Approved",
-		"disconnected": "Disconnected",
-		"pending":      "Pending",
-		"rejected":     "Rejected",
-	}
-	if v, ok := vals[strings.ToLower(input)]; ok {
-		return &v, nil
-	}
-
-	// it could be a new value - best effort convert this
-	v := input
-
-	out := ConnectionStatus(v)
-	return &out, nil
-}
-
-type ProvisioningState string
-
-const (
-	ProvisioningStateCanceled  ProvisioningState = "Canceled"
-	ProvisioningStateCreating  ProvisioningState = "Creating"
-	ProvisioningStateDeleting  ProvisioningState = "Deleting"
-	ProvisioningStateFailed    ProvisioningState = "Failed"
-	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
-	ProvisioningStateUpdating  ProvisioningState = "Updating"
-)
-
-func PossibleValuesForProvisioningState() []string {
-	return []string{
-		"Canceled",
-		"Creating",
-		"Deleting",
-		"Failed",
-		"Succeeded",
-		"Updating",
-	}
-}
-
-func parseProvisioningState(input string) (*ProvisioningState, error) {
-	vals := map[string]ProvisioningState{
-		"canceled":  "Canceled",
-		"creating":  "Creating",
-		"deleting":  "Deleting",
-		"failed":    "Failed",
-		"succeeded": "Succeeded",
-		"updating":  "Updating",
-	}
-	if v, ok := vals[strings.ToLower(input)]; ok {
-		return &v, nil
-	}
-
-	// it could be a new value - best effort convert this
-	v := input
-
-	out := ProvisioningState(v)
-	return &out, nil
-}
-
-type PublicNetworkAccess string
-
-const (
-	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
-	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
-)
-
-func PossibleValuesForPublicNetworkAccess() []string {
-	return []string{
-		"Disabled",
-		"Enabled",
-	}
-}
-
-func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
-	vals := map[string]PublicNetworkAccess{
-		"disabled": "Disabled",
-		"enabled":  "Enabled",
-	}
-	if v, ok := vals[strings.ToLower(input)]; ok {
-		return &v, nil
-	}
-
-	// it could be a new value - best effort convert this
-	v := input
-
-	out := PublicNetworkAccess(v)
-	return &out, nil
-}
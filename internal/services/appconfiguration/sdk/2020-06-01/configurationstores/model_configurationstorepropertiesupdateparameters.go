@@ -1,6 +0,0 @@
-package configurationstores
-
-type ConfigurationStorePropertiesUpdateParameters struct {
-	Encryption          *EncryptionProperties `json:"encryption,omitempty"`
-	PublicNetworkAccess *PublicNetworkAccess  `json:"publicNetworkAccess,omitempty"`
-}
@@ -0,0 +1,195 @@
+package configurationstores
+
+import "strings"
+
+type ActionsRequired string
+
+const (
+	ActionsRequiredNone     ActionsRequired = "None"
+	ActionsRequiredRecreate ActionsRequired = "Recreate"
+)
+
+func PossibleValuesForActionsRequired() []string {
+	return []string{
+		string(ActionsRequiredNone),
+		string(ActionsRequiredRecreate),
+	}
+}
+
+func parseActionsRequired(input string) (*ActionsRequired, error) {
+	vals := map[string]ActionsRequired{
+		"none":     ActionsRequiredNone,
+		"recreate": ActionsRequiredRecreate,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ActionsRequired(input)
+	return &out, nil
+}
+
+type ConnectionStatus string
+
+const (
+	ConnectionStatusApproved     ConnectionStatus = "Approved"
+	ConnectionStatusDisconnected ConnectionStatus = "Disconnected"
+	ConnectionStatusPending      ConnectionStatus = "Pending"
+	ConnectionStatusRejected     ConnectionStatus = "Rejected"
+)
+
+func PossibleValuesForConnectionStatus() []string {
+	return []string{
+		string(ConnectionStatusApproved),
+		string(ConnectionStatusDisconnected),
+		string(ConnectionStatusPending),
+		string(ConnectionStatusRejected),
+	}
+}
+
+func parseConnectionStatus(input string) (*ConnectionStatus, error) {
+	vals := map[string]ConnectionStatus{
+		"approved":     ConnectionStatusApproved,
+		"disconnected": ConnectionStatusDisconnected,
+		"pending":      ConnectionStatusPending,
+		"rejected":     ConnectionStatusRejected,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConnectionStatus(input)
+	return &out, nil
+}
+
+type CreateMode string
+
+const (
+	CreateModeDefault CreateMode = "Default"
+	CreateModeRecover CreateMode = "Recover"
+)
+
+func PossibleValuesForCreateMode() []string {
+	return []string{
+		string(CreateModeDefault),
+		string(CreateModeRecover),
+	}
+}
+
+func parseCreateMode(input string) (*CreateMode, error) {
+	vals := map[string]CreateMode{
+		"default": CreateModeDefault,
+		"recover": CreateModeRecover,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreateMode(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
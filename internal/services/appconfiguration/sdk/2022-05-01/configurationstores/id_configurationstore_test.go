@@ -28,7 +28,7 @@ func TestFormatConfigurationStoreID(t *testing.T) {
 	actual := NewConfigurationStoreID("12345678-1234-9876-4563-123456789012", "example-resource-group", "configStoreValue").ID()
 	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.AppConfiguration/configurationStores/configStoreValue"
 	if actual != expected {
-		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
 	}
 }
 
@@ -262,3 +262,18 @@ func TestParseConfigurationStoreIDInsensitively(t *testing.T) {
 
 	}
 }
+
+func TestSegmentsForConfigurationStoreId(t *testing.T) {
+	segments := ConfigurationStoreId{}.Segments()
+	if len(segments) == 0 {
+		t.Fatalf("ConfigurationStoreId has no segments")
+	}
+
+	uniqueNames := make(map[string]struct{}, 0)
+	for _, segment := range segments {
+		uniqueNames[segment.Name] = struct{}{}
+	}
+	if len(uniqueNames) != len(segments) {
+		t.Fatalf("Expected the Segments to be unique but got %q unique segments and %d total segments", len(uniqueNames), len(segments))
+	}
+}
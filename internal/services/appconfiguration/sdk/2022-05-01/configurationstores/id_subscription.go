@@ -82,7 +82,7 @@ func (id SubscriptionId) ID() string {
 // Segments returns a slice of Resource ID Segments which comprise this Subscription ID
 func (id SubscriptionId) Segments() []resourceids.Segment {
 	return []resourceids.Segment{
-		resourceids.StaticSegment("subscriptions", "subscriptions", "subscriptions"),
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
 		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
 	}
 }
@@ -20,7 +20,7 @@ func TestFormatSubscriptionID(t *testing.T) {
 	actual := NewSubscriptionID("12345678-1234-9876-4563-123456789012").ID()
 	expected := "/subscriptions/12345678-1234-9876-4563-123456789012"
 	if actual != expected {
-		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
 	}
 }
 
@@ -142,3 +142,18 @@ func TestParseSubscriptionIDInsensitively(t *testing.T) {
 
 	}
 }
+
+func TestSegmentsForSubscriptionId(t *testing.T) {
+	segments := SubscriptionId{}.Segments()
+	if len(segments) == 0 {
+		t.Fatalf("SubscriptionId has no segments")
+	}
+
+	uniqueNames := make(map[string]struct{}, 0)
+	for _, segment := range segments {
+		uniqueNames[segment.Name] = struct{}{}
+	}
+	if len(uniqueNames) != len(segments) {
+		t.Fatalf("Expected the Segments to be unique but got %q unique segments and %d total segments", len(uniqueNames), len(segments))
+	}
+}
@@ -11,6 +11,7 @@ type ConfigurationStore struct {
 	Name       *string                                 `json:"name,omitempty"`
 	Properties *ConfigurationStoreProperties           `json:"properties,omitempty"`
 	Sku        Sku                                     `json:"sku"`
+	SystemData *SystemData                             `json:"systemData,omitempty"`
 	Tags       *map[string]string                      `json:"tags,omitempty"`
 	Type       *string                                 `json:"type,omitempty"`
 }
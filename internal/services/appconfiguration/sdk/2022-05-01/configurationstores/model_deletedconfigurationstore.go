@@ -0,0 +1,8 @@
+package configurationstores
+
+type DeletedConfigurationStore struct {
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *DeletedConfigurationStoreProperties `json:"properties,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
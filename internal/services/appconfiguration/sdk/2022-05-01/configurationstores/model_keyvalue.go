@@ -1,5 +1,11 @@
 package configurationstores
 
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
 type KeyValue struct {
 	ContentType  *string            `json:"contentType,omitempty"`
 	ETag         *string            `json:"eTag,omitempty"`
@@ -10,3 +16,15 @@ type KeyValue struct {
 	Tags         *map[string]string `json:"tags,omitempty"`
 	Value        *string            `json:"value,omitempty"`
 }
+
+func (o KeyValue) GetLastModifiedAsTime() (*time.Time, error) {
+	if o.LastModified == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.LastModified, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o KeyValue) SetLastModifiedAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.LastModified = &formatted
+}
@@ -1,25 +1,19 @@
 package configurationstores
 
-type ApiKeyPredicate struct {
-	ConnectionString *string
-	Id               *string
-	LastModified     *string
-	Name             *string
-	ReadOnly         *bool
-	Value            *string
+type ConfigurationStorePredicate struct {
+	Id       *string
+	Location *string
+	Name     *string
+	Type     *string
 }
 
-func (p ApiKeyPredicate) Matches(input ApiKey) bool {
-
-	if p.ConnectionString != nil && (input.ConnectionString == nil && *p.ConnectionString != *input.ConnectionString) {
-		return false
-	}
+func (p ConfigurationStorePredicate) Matches(input ConfigurationStore) bool {
 
 	if p.Id != nil && (input.Id == nil && *p.Id != *input.Id) {
 		return false
 	}
 
-	if p.LastModified != nil && (input.LastModified == nil && *p.LastModified != *input.LastModified) {
+	if p.Location != nil && *p.Location != input.Location {
 		return false
 	}
 
@@ -27,39 +21,48 @@ func (p ApiKeyPredicate) Matches(input ApiKey) bool {
 		return false
 	}
 
-	if p.ReadOnly != nil && (input.ReadOnly == nil && *p.ReadOnly != *input.ReadOnly) {
-		return false
-	}
-
-	if p.Value != nil && (input.Value == nil && *p.Value != *input.Value) {
+	if p.Type != nil && (input.Type == nil && *p.Type != *input.Type) {
 		return false
 	}
 
 	return true
 }
 
-type ConfigurationStorePredicate struct {
-	Id       *string
-	Location *string
-	Name     *string
-	Type     *string
+type DeletedConfigurationStorePredicate struct {
+	Id   *string
+	Name *string
+	Type *string
 }
 
-func (p ConfigurationStorePredicate) Matches(input ConfigurationStore) bool {
+func (p DeletedConfigurationStorePredicate) Matches(input DeletedConfigurationStore) bool {
 
 	if p.Id != nil && (input.Id == nil && *p.Id != *input.Id) {
 		return false
 	}
 
-	if p.Location != nil && *p.Location != input.Location {
+	if p.Name != nil && (input.Name == nil && *p.Name != *input.Name) {
 		return false
 	}
 
-	if p.Name != nil && (input.Name == nil && *p.Name != *input.Name) {
+	if p.Type != nil && (input.Type == nil && *p.Type != *input.Type) {
 		return false
 	}
 
-	if p.Type != nil && (input.Type == nil && *p.Type != *input.Type) {
+	return true
+}
+
+type ApiKeyPredicate struct {
+	Id   *string
+	Name *string
+}
+
+func (p ApiKeyPredicate) Matches(input ApiKey) bool {
+
+	if p.Id != nil && (input.Id == nil && *p.Id != *input.Id) {
+		return false
+	}
+
+	if p.Name != nil && (input.Name == nil && *p.Name != *input.Name) {
 		return false
 	}
 
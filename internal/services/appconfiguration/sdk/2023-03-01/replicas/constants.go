@@ -0,0 +1,74 @@
+package replicas
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type ReplicaProvisioningState string
+
+const (
+	ReplicaProvisioningStateCanceled  ReplicaProvisioningState = "Canceled"
+	ReplicaProvisioningStateCreating  ReplicaProvisioningState = "Creating"
+	ReplicaProvisioningStateDeleting  ReplicaProvisioningState = "Deleting"
+	ReplicaProvisioningStateFailed    ReplicaProvisioningState = "Failed"
+	ReplicaProvisioningStateSucceeded ReplicaProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForReplicaProvisioningState() []string {
+	return []string{
+		string(ReplicaProvisioningStateCanceled),
+		string(ReplicaProvisioningStateCreating),
+		string(ReplicaProvisioningStateDeleting),
+		string(ReplicaProvisioningStateFailed),
+		string(ReplicaProvisioningStateSucceeded),
+	}
+}
+
+func parseReplicaProvisioningState(input string) (*ReplicaProvisioningState, error) {
+	vals := map[string]ReplicaProvisioningState{
+		"canceled":  ReplicaProvisioningStateCanceled,
+		"creating":  ReplicaProvisioningStateCreating,
+		"deleting":  ReplicaProvisioningStateDeleting,
+		"failed":    ReplicaProvisioningStateFailed,
+		"succeeded": ReplicaProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReplicaProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,15 @@
+package snapshots
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SnapshotsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSnapshotsClientWithBaseURI(endpoint string) SnapshotsClient {
+	return SnapshotsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package snapshots
+
+type Snapshot struct {
+	Id         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *SnapshotProperties `json:"properties,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
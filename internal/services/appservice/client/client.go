@@ -3,13 +3,17 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/sdk/2024-04-01/sitecontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/sdk/2024-04-01/webapps"
 )
 
 type Client struct {
 	AppServiceEnvironmentClient *web.AppServiceEnvironmentsClient
 	BaseClient                  *web.BaseClient
 	ServicePlanClient           *web.AppServicePlansClient
+	SiteContainersClient        *sitecontainers.SiteContainersClient
 	WebAppsClient               *web.AppsClient
+	WebAppsLatestClient         *webapps.WebAppsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -25,10 +29,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	servicePlanClient := web.NewAppServicePlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicePlanClient.Client, o.ResourceManagerAuthorizer)
 
+	siteContainersClient := sitecontainers.NewSiteContainersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&siteContainersClient.Client, o.ResourceManagerAuthorizer)
+
+	webAppsLatestClient := webapps.NewWebAppsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&webAppsLatestClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AppServiceEnvironmentClient: &appServiceEnvironmentClient,
 		BaseClient:                  &baseClient,
 		ServicePlanClient:           &servicePlanClient,
+		SiteContainersClient:        &siteContainersClient,
 		WebAppsClient:               &webAppServiceClient,
+		WebAppsLatestClient:         &webAppsLatestClient,
 	}
 }
@@ -0,0 +1,688 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/sdk/2024-04-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	// flexConsumptionDeploymentStorageSetting is the App Setting holding the connection string used to access the
+	// deployment storage container when `storage_authentication_type` is `StorageAccountConnectionString`
+	flexConsumptionDeploymentStorageSetting = "DEPLOYMENT_STORAGE_CONNECTION_STRING"
+	flexConsumptionWebJobsStorageSetting    = "AzureWebJobsStorage"
+	flexConsumptionWebJobsStorageMSISetting = "AzureWebJobsStorage__accountName"
+)
+
+type FunctionAppFlexConsumptionResource struct{}
+
+type FunctionAppFlexConsumptionModel struct {
+	Name          string `tfschema:"name"`
+	ResourceGroup string `tfschema:"resource_group_name"`
+	Location      string `tfschema:"location"`
+	ServicePlanId string `tfschema:"service_plan_id"`
+
+	StorageContainerType          string `tfschema:"storage_container_type"`
+	StorageContainerEndpoint      string `tfschema:"storage_container_endpoint"`
+	StorageAuthenticationType     string `tfschema:"storage_authentication_type"`
+	StorageAccessKey              string `tfschema:"storage_access_key"`
+	StorageUserAssignedIdentityId string `tfschema:"storage_user_assigned_identity_id"`
+
+	RuntimeName          string                                  `tfschema:"runtime_name"`
+	RuntimeVersion       string                                  `tfschema:"runtime_version"`
+	InstanceMemoryInMB   int64                                   `tfschema:"instance_memory_in_mb"`
+	MaximumInstanceCount int64                                   `tfschema:"maximum_instance_count"`
+	AlwaysReady          []FunctionAppFlexConsumptionAlwaysReady `tfschema:"always_ready"`
+	HttpConcurrency      int64                                   `tfschema:"http_concurrency"`
+
+	AppSettings            map[string]string `tfschema:"app_settings"`
+	Enabled                bool              `tfschema:"enabled"`
+	HttpsOnly              bool              `tfschema:"https_only"`
+	VirtualNetworkSubnetId string            `tfschema:"virtual_network_subnet_id"`
+	Tags                   map[string]string `tfschema:"tags"`
+
+	// Computed
+	CustomDomainVerificationId  string `tfschema:"custom_domain_verification_id"`
+	DefaultHostname             string `tfschema:"default_hostname"`
+	Kind                        string `tfschema:"kind"`
+	OutboundIPAddresses         string `tfschema:"outbound_ip_addresses"`
+	PossibleOutboundIPAddresses string `tfschema:"possible_outbound_ip_addresses"`
+}
+
+type FunctionAppFlexConsumptionAlwaysReady struct {
+	Name          string `tfschema:"name"`
+	InstanceCount int64  `tfschema:"instance_count"`
+}
+
+var _ sdk.ResourceWithUpdate = FunctionAppFlexConsumptionResource{}
+
+func (r FunctionAppFlexConsumptionResource) ModelObject() interface{} {
+	return &FunctionAppFlexConsumptionModel{}
+}
+
+func (r FunctionAppFlexConsumptionResource) ResourceType() string {
+	return "azurerm_function_app_flex_consumption"
+}
+
+func (r FunctionAppFlexConsumptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FunctionAppID
+}
+
+func (r FunctionAppFlexConsumptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppName,
+			Description:  "Specifies the name of the Function App.",
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"service_plan_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ServicePlanID,
+			Description:  "The ID of the Flex Consumption App Service Plan within which to create this Function App.",
+		},
+
+		"storage_container_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(webapps.PossibleValuesForFunctionsDeploymentStorageType(), false),
+			Description:  "The type of the storage container where the deployment package is located.",
+		},
+
+		"storage_container_endpoint": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+			Description:  "The URL of the storage container where the deployment package is located.",
+		},
+
+		"storage_authentication_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(webapps.PossibleValuesForAuthenticationType(), false),
+			Description:  "The method used to authenticate with the deployment storage account.",
+		},
+
+		"storage_access_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "The access key of the deployment storage account, required when `storage_authentication_type` is `StorageAccountConnectionString`.",
+		},
+
+		"storage_user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			Description:  "The ID of the User Assigned Identity used to access the deployment storage account, required when `storage_authentication_type` is `UserAssignedIdentity`.",
+		},
+
+		"runtime_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(webapps.PossibleValuesForRuntimeName(), false),
+			Description:  "The runtime of the Function App.",
+		},
+
+		"runtime_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The version of the runtime of the Function App.",
+		},
+
+		"instance_memory_in_mb": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2048,
+			ValidateFunc: validation.IntInSlice([]int{512, 2048, 4096}),
+			Description:  "The amount of memory in MB allocated to each instance of the Function App.",
+		},
+
+		"maximum_instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(40, 1000),
+			Description:  "The maximum number of instances the Function App can scale out to.",
+		},
+
+		"always_ready": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the function or function group, e.g. `http`, `blob`, `durable` or `function:<name>`.",
+					},
+
+					"instance_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The number of instances which are always ready for the function or function group.",
+					},
+				},
+			},
+		},
+
+		"http_concurrency": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 1000),
+			Description:  "The maximum number of concurrent HTTP trigger invocations per instance.",
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Description: "A map of key-value pairs for [App Settings](https://docs.microsoft.com/en-us/azure/azure-functions/functions-app-settings) and custom values.",
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+		"virtual_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"possible_outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsLatestClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var functionApp FunctionAppFlexConsumptionModel
+			if err := metadata.Decode(&functionApp); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewFunctionAppID(subscriptionId, functionApp.ResourceGroup, functionApp.Name)
+			siteId := webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			existing, err := client.Get(ctx, siteId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing Flex Consumption %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			functionAppConfig, err := expandFunctionAppFlexConsumptionConfig(functionApp)
+			if err != nil {
+				return err
+			}
+
+			appSettings, err := functionApp.managedAppSettings(metadata.Client.Account.Environment.StorageEndpointSuffix)
+			if err != nil {
+				return err
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := webapps.Site{
+				Identity: expandedIdentity,
+				Kind:     utils.String("functionapp,linux"),
+				Location: location.Normalize(functionApp.Location),
+				Properties: &webapps.SiteProperties{
+					Enabled:           utils.Bool(functionApp.Enabled),
+					FunctionAppConfig: functionAppConfig,
+					HTTPSOnly:         utils.Bool(functionApp.HttpsOnly),
+					ServerFarmId:      utils.String(functionApp.ServicePlanId),
+					SiteConfig: &webapps.SiteConfig{
+						AppSettings: expandFunctionAppFlexConsumptionAppSettings(appSettings),
+					},
+				},
+				Tags: &functionApp.Tags,
+			}
+
+			if functionApp.VirtualNetworkSubnetId != "" {
+				payload.Properties.VirtualNetworkSubnetId = utils.String(functionApp.VirtualNetworkSubnetId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, siteId, payload); err != nil {
+				return fmt.Errorf("creating Flex Consumption %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsLatestClient
+
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			siteId := webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			resp, err := client.Get(ctx, siteId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading Flex Consumption %s: %+v", id, err)
+			}
+
+			appSettingsResp, err := client.ListApplicationSettings(ctx, siteId)
+			if err != nil {
+				return fmt.Errorf("reading App Settings for Flex Consumption %s: %+v", id, err)
+			}
+
+			state := FunctionAppFlexConsumptionModel{
+				Name:          id.SiteName,
+				ResourceGroup: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Kind = utils.NormalizeNilableString(model.Kind)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					if props.ServerFarmId != nil {
+						servicePlanId, err := parse.ServicePlanID(*props.ServerFarmId)
+						if err != nil {
+							return err
+						}
+						state.ServicePlanId = servicePlanId.ID()
+					}
+
+					state.CustomDomainVerificationId = utils.NormalizeNilableString(props.CustomDomainVerificationId)
+					state.DefaultHostname = utils.NormalizeNilableString(props.DefaultHostName)
+					state.Enabled = utils.NormaliseNilableBool(props.Enabled)
+					state.HttpsOnly = utils.NormaliseNilableBool(props.HTTPSOnly)
+					state.OutboundIPAddresses = utils.NormalizeNilableString(props.OutboundIPAddresses)
+					state.PossibleOutboundIPAddresses = utils.NormalizeNilableString(props.PossibleOutboundIPAddresses)
+					state.VirtualNetworkSubnetId = utils.NormalizeNilableString(props.VirtualNetworkSubnetId)
+
+					state.flattenFunctionAppConfig(props.FunctionAppConfig)
+				}
+			}
+
+			state.unpackFunctionAppFlexConsumptionSettings(appSettingsResp.Model)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsLatestClient
+
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			siteId := webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			var state FunctionAppFlexConsumptionModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, siteId)
+			if err != nil {
+				return fmt.Errorf("reading Flex Consumption %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("reading Flex Consumption %s: `model` or `properties` was nil", id)
+			}
+			payload := *existing.Model
+
+			// the Site Config isn't returned by the Get, and any App Settings in it would overwrite those set below
+			payload.Properties.SiteConfig = nil
+
+			if metadata.ResourceData.HasChanges("storage_container_type", "storage_container_endpoint", "storage_authentication_type", "storage_access_key", "storage_user_assigned_identity_id", "runtime_name", "runtime_version", "instance_memory_in_mb", "maximum_instance_count", "always_ready", "http_concurrency") {
+				functionAppConfig, err := expandFunctionAppFlexConsumptionConfig(state)
+				if err != nil {
+					return err
+				}
+				payload.Properties.FunctionAppConfig = functionAppConfig
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.Enabled = utils.Bool(state.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("https_only") {
+				payload.Properties.HTTPSOnly = utils.Bool(state.HttpsOnly)
+			}
+
+			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
+				payload.Properties.VirtualNetworkSubnetId = nil
+				if state.VirtualNetworkSubnetId != "" {
+					payload.Properties.VirtualNetworkSubnetId = utils.String(state.VirtualNetworkSubnetId)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &state.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, siteId, payload); err != nil {
+				return fmt.Errorf("updating Flex Consumption %s: %+v", id, err)
+			}
+
+			if metadata.ResourceData.HasChanges("app_settings", "storage_container_endpoint", "storage_authentication_type", "storage_access_key") {
+				appSettings, err := state.managedAppSettings(metadata.Client.Account.Environment.StorageEndpointSuffix)
+				if err != nil {
+					return err
+				}
+
+				if _, err := client.UpdateApplicationSettings(ctx, siteId, webapps.StringDictionary{Properties: &appSettings}); err != nil {
+					return fmt.Errorf("updating App Settings for Flex Consumption %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsLatestClient
+
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting Flex Consumption %s", *id)
+
+			options := webapps.DeleteOperationOptions{
+				DeleteEmptyServerFarm: utils.Bool(false),
+				DeleteMetrics:         utils.Bool(true),
+			}
+			if _, err := client.Delete(ctx, webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName), options); err != nil {
+				return fmt.Errorf("deleting Flex Consumption %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandFunctionAppFlexConsumptionConfig(input FunctionAppFlexConsumptionModel) (*webapps.FunctionAppConfig, error) {
+	authType := webapps.AuthenticationType(input.StorageAuthenticationType)
+	authentication := webapps.FunctionsDeploymentStorageAuthentication{
+		Type: &authType,
+	}
+
+	switch authType {
+	case webapps.AuthenticationTypeStorageAccountConnectionString:
+		if input.StorageAccessKey == "" {
+			return nil, fmt.Errorf("`storage_access_key` must be specified when `storage_authentication_type` is `%s`", authType)
+		}
+		authentication.StorageAccountConnectionStringName = utils.String(flexConsumptionDeploymentStorageSetting)
+	case webapps.AuthenticationTypeUserAssignedIdentity:
+		if input.StorageUserAssignedIdentityId == "" {
+			return nil, fmt.Errorf("`storage_user_assigned_identity_id` must be specified when `storage_authentication_type` is `%s`", authType)
+		}
+		authentication.UserAssignedIdentityResourceId = utils.String(input.StorageUserAssignedIdentityId)
+	}
+
+	storageType := webapps.FunctionsDeploymentStorageType(input.StorageContainerType)
+	runtimeName := webapps.RuntimeName(input.RuntimeName)
+
+	alwaysReady := make([]webapps.FunctionsAlwaysReadyConfig, 0)
+	for _, v := range input.AlwaysReady {
+		alwaysReady = append(alwaysReady, webapps.FunctionsAlwaysReadyConfig{
+			InstanceCount: utils.Int64(v.InstanceCount),
+			Name:          utils.String(v.Name),
+		})
+	}
+
+	scaleAndConcurrency := webapps.FunctionsScaleAndConcurrency{
+		AlwaysReady:      &alwaysReady,
+		InstanceMemoryMB: utils.Int64(input.InstanceMemoryInMB),
+	}
+
+	if input.MaximumInstanceCount > 0 {
+		scaleAndConcurrency.MaximumInstanceCount = utils.Int64(input.MaximumInstanceCount)
+	}
+
+	if input.HttpConcurrency > 0 {
+		scaleAndConcurrency.Triggers = &webapps.FunctionsScaleAndConcurrencyTriggers{
+			HTTP: &webapps.FunctionsScaleAndConcurrencyTriggersHTTP{
+				PerInstanceConcurrency: utils.Int64(input.HttpConcurrency),
+			},
+		}
+	}
+
+	return &webapps.FunctionAppConfig{
+		Deployment: &webapps.FunctionsDeployment{
+			Storage: &webapps.FunctionsDeploymentStorage{
+				Authentication: &authentication,
+				Type:           &storageType,
+				Value:          utils.String(input.StorageContainerEndpoint),
+			},
+		},
+		Runtime: &webapps.FunctionsRuntime{
+			Name:    &runtimeName,
+			Version: utils.String(input.RuntimeVersion),
+		},
+		ScaleAndConcurrency: &scaleAndConcurrency,
+	}, nil
+}
+
+func (m *FunctionAppFlexConsumptionModel) flattenFunctionAppConfig(input *webapps.FunctionAppConfig) {
+	if input == nil {
+		return
+	}
+
+	if deployment := input.Deployment; deployment != nil && deployment.Storage != nil {
+		storage := deployment.Storage
+		if storage.Type != nil {
+			m.StorageContainerType = string(*storage.Type)
+		}
+		m.StorageContainerEndpoint = utils.NormalizeNilableString(storage.Value)
+
+		if auth := storage.Authentication; auth != nil {
+			if auth.Type != nil {
+				m.StorageAuthenticationType = string(*auth.Type)
+			}
+			m.StorageUserAssignedIdentityId = utils.NormalizeNilableString(auth.UserAssignedIdentityResourceId)
+		}
+	}
+
+	if runtime := input.Runtime; runtime != nil {
+		if runtime.Name != nil {
+			m.RuntimeName = string(*runtime.Name)
+		}
+		m.RuntimeVersion = utils.NormalizeNilableString(runtime.Version)
+	}
+
+	if scale := input.ScaleAndConcurrency; scale != nil {
+		m.InstanceMemoryInMB = utils.NormaliseNilableInt64(scale.InstanceMemoryMB)
+		m.MaximumInstanceCount = utils.NormaliseNilableInt64(scale.MaximumInstanceCount)
+
+		if scale.AlwaysReady != nil {
+			for _, v := range *scale.AlwaysReady {
+				m.AlwaysReady = append(m.AlwaysReady, FunctionAppFlexConsumptionAlwaysReady{
+					Name:          utils.NormalizeNilableString(v.Name),
+					InstanceCount: utils.NormaliseNilableInt64(v.InstanceCount),
+				})
+			}
+		}
+
+		if triggers := scale.Triggers; triggers != nil && triggers.HTTP != nil {
+			m.HttpConcurrency = utils.NormaliseNilableInt64(triggers.HTTP.PerInstanceConcurrency)
+		}
+	}
+}
+
+// managedAppSettings returns the user-defined App Settings along with those required by the Functions host to access
+// the deployment storage account
+func (m FunctionAppFlexConsumptionModel) managedAppSettings(storageEndpointSuffix string) (map[string]string, error) {
+	endpoint, err := url.Parse(m.StorageContainerEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `storage_container_endpoint`: %+v", err)
+	}
+	storageAccountName := strings.Split(endpoint.Host, ".")[0]
+
+	appSettings := make(map[string]string)
+	for k, v := range m.AppSettings {
+		appSettings[k] = v
+	}
+
+	if m.StorageAuthenticationType == string(webapps.AuthenticationTypeStorageAccountConnectionString) {
+		connectionString := fmt.Sprintf(helpers.StorageStringFmt, storageAccountName, m.StorageAccessKey, storageEndpointSuffix)
+		appSettings[flexConsumptionDeploymentStorageSetting] = connectionString
+		appSettings[flexConsumptionWebJobsStorageSetting] = connectionString
+	} else {
+		appSettings[flexConsumptionWebJobsStorageMSISetting] = storageAccountName
+	}
+
+	return appSettings, nil
+}
+
+func (m *FunctionAppFlexConsumptionModel) unpackFunctionAppFlexConsumptionSettings(input *webapps.StringDictionary) {
+	if input == nil || input.Properties == nil {
+		return
+	}
+
+	appSettings := make(map[string]string)
+	for k, v := range *input.Properties {
+		switch k {
+		case flexConsumptionDeploymentStorageSetting:
+			_, m.StorageAccessKey = helpers.ParseWebJobsStorageString(utils.String(v))
+
+		case flexConsumptionWebJobsStorageSetting, flexConsumptionWebJobsStorageMSISetting:
+
+		default:
+			appSettings[k] = v
+		}
+	}
+
+	m.AppSettings = appSettings
+}
+
+func expandFunctionAppFlexConsumptionAppSettings(input map[string]string) *[]webapps.NameValuePair {
+	output := make([]webapps.NameValuePair, 0)
+	for k, v := range input {
+		output = append(output, webapps.NameValuePair{
+			Name:  utils.String(k),
+			Value: utils.String(v),
+		})
+	}
+
+	return &output
+}
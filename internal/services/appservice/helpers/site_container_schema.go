@@ -0,0 +1,333 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/sdk/2024-04-01/sitecontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// LinuxFxVersionSiteContainers is the `linuxFxVersion` used by a Linux Web App whose containers are defined through
+// the Site Containers API, rather than through an `application_stack` or the deprecated Docker Compose configuration
+const LinuxFxVersionSiteContainers = "sitecontainers"
+
+type SiteContainer struct {
+	Name                        string                             `tfschema:"name"`
+	Image                       string                             `tfschema:"image"`
+	IsMain                      bool                               `tfschema:"is_main"`
+	TargetPort                  string                             `tfschema:"target_port"`
+	StartUpCommand              string                             `tfschema:"start_up_command"`
+	AuthenticationType          string                             `tfschema:"authentication_type"`
+	UserName                    string                             `tfschema:"user_name"`
+	PasswordSecret              string                             `tfschema:"password_secret"`
+	UserManagedIdentityClientId string                             `tfschema:"user_managed_identity_client_id"`
+	EnvironmentVariables        []SiteContainerEnvironmentVariable `tfschema:"environment_variable"`
+	VolumeMounts                []SiteContainerVolumeMount         `tfschema:"volume_mount"`
+}
+
+type SiteContainerEnvironmentVariable struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type SiteContainerVolumeMount struct {
+	VolumeSubPath      string `tfschema:"volume_sub_path"`
+	ContainerMountPath string `tfschema:"container_mount_path"`
+	Data               string `tfschema:"data"`
+	ReadOnly           bool   `tfschema:"read_only"`
+}
+
+func SiteContainerSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"image": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"is_main": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"target_port": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"start_up_command": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"authentication_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(sitecontainers.AuthTypeAnonymous),
+					ValidateFunc: validation.StringInSlice(sitecontainers.PossibleValuesForAuthType(), false),
+				},
+
+				"user_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"password_secret": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"user_managed_identity_client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+
+				"environment_variable": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							// the value is the name of the App Setting containing the value of the variable
+							"value": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"volume_mount": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"volume_sub_path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"container_mount_path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"data": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"read_only": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ValidateSiteContainers(input []SiteContainer) error {
+	if len(input) == 0 {
+		return nil
+	}
+
+	names := make(map[string]struct{})
+	mainContainers := 0
+	for _, v := range input {
+		if _, ok := names[v.Name]; ok {
+			return fmt.Errorf("the `site_container` name %q is used more than once", v.Name)
+		}
+		names[v.Name] = struct{}{}
+
+		if v.IsMain {
+			mainContainers++
+		}
+
+		switch sitecontainers.AuthType(v.AuthenticationType) {
+		case sitecontainers.AuthTypeUserCredentials:
+			if v.UserName == "" || v.PasswordSecret == "" {
+				return fmt.Errorf("`user_name` and `password_secret` must be specified for the `site_container` %q when `authentication_type` is `%s`", v.Name, sitecontainers.AuthTypeUserCredentials)
+			}
+		case sitecontainers.AuthTypeUserAssigned:
+			if v.UserManagedIdentityClientId == "" {
+				return fmt.Errorf("`user_managed_identity_client_id` must be specified for the `site_container` %q when `authentication_type` is `%s`", v.Name, sitecontainers.AuthTypeUserAssigned)
+			}
+		}
+	}
+
+	if mainContainers != 1 {
+		return fmt.Errorf("exactly one `site_container` must have `is_main` set to `true`, got %d", mainContainers)
+	}
+
+	return nil
+}
+
+func ExpandSiteContainer(input SiteContainer) sitecontainers.SiteContainer {
+	authType := sitecontainers.AuthType(input.AuthenticationType)
+	props := sitecontainers.SiteContainerProperties{
+		AuthType: &authType,
+		Image:    input.Image,
+		IsMain:   input.IsMain,
+	}
+
+	if input.TargetPort != "" {
+		props.TargetPort = utils.String(input.TargetPort)
+	}
+
+	if input.StartUpCommand != "" {
+		props.StartUpCommand = utils.String(input.StartUpCommand)
+	}
+
+	if input.UserName != "" {
+		props.UserName = utils.String(input.UserName)
+	}
+
+	if input.PasswordSecret != "" {
+		props.PasswordSecret = utils.String(input.PasswordSecret)
+	}
+
+	if input.UserManagedIdentityClientId != "" {
+		props.UserManagedIdentityClientId = utils.String(input.UserManagedIdentityClientId)
+	}
+
+	environmentVariables := make([]sitecontainers.EnvironmentVariable, 0)
+	for _, v := range input.EnvironmentVariables {
+		environmentVariables = append(environmentVariables, sitecontainers.EnvironmentVariable{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+	props.EnvironmentVariables = &environmentVariables
+
+	volumeMounts := make([]sitecontainers.VolumeMount, 0)
+	for _, v := range input.VolumeMounts {
+		volumeMount := sitecontainers.VolumeMount{
+			ContainerMountPath: v.ContainerMountPath,
+			ReadOnly:           utils.Bool(v.ReadOnly),
+			VolumeSubPath:      v.VolumeSubPath,
+		}
+		if v.Data != "" {
+			volumeMount.Data = utils.String(v.Data)
+		}
+		volumeMounts = append(volumeMounts, volumeMount)
+	}
+	props.VolumeMounts = &volumeMounts
+
+	return sitecontainers.SiteContainer{
+		Properties: &props,
+	}
+}
+
+// FlattenSiteContainers flattens the Site Containers returned from the API, ordered to match the existing
+// configuration (since the API doesn't guarantee an order) and retaining the `password_secret` which isn't returned
+func FlattenSiteContainers(input []sitecontainers.SiteContainer, existing []SiteContainer) []SiteContainer {
+	flattened := make(map[string]SiteContainer)
+	for _, v := range input {
+		if v.Name == nil || v.Properties == nil {
+			continue
+		}
+
+		name := siteContainerName(*v.Name)
+		props := v.Properties
+		container := SiteContainer{
+			Name:                        name,
+			Image:                       props.Image,
+			IsMain:                      props.IsMain,
+			TargetPort:                  utils.NormalizeNilableString(props.TargetPort),
+			StartUpCommand:              utils.NormalizeNilableString(props.StartUpCommand),
+			AuthenticationType:          string(sitecontainers.AuthTypeAnonymous),
+			UserName:                    utils.NormalizeNilableString(props.UserName),
+			UserManagedIdentityClientId: utils.NormalizeNilableString(props.UserManagedIdentityClientId),
+		}
+
+		if props.AuthType != nil {
+			container.AuthenticationType = string(*props.AuthType)
+		}
+
+		if props.EnvironmentVariables != nil {
+			for _, e := range *props.EnvironmentVariables {
+				container.EnvironmentVariables = append(container.EnvironmentVariables, SiteContainerEnvironmentVariable{
+					Name:  e.Name,
+					Value: e.Value,
+				})
+			}
+		}
+
+		if props.VolumeMounts != nil {
+			for _, m := range *props.VolumeMounts {
+				container.VolumeMounts = append(container.VolumeMounts, SiteContainerVolumeMount{
+					VolumeSubPath:      m.VolumeSubPath,
+					ContainerMountPath: m.ContainerMountPath,
+					Data:               utils.NormalizeNilableString(m.Data),
+					ReadOnly:           utils.NormaliseNilableBool(m.ReadOnly),
+				})
+			}
+		}
+
+		flattened[name] = container
+	}
+
+	result := make([]SiteContainer, 0)
+	for _, v := range existing {
+		container, ok := flattened[v.Name]
+		if !ok {
+			continue
+		}
+		container.PasswordSecret = v.PasswordSecret
+		result = append(result, container)
+		delete(flattened, v.Name)
+	}
+
+	// any containers which aren't in the existing configuration are appended in the order returned by the API
+	for _, v := range input {
+		if v.Name == nil {
+			continue
+		}
+		name := siteContainerName(*v.Name)
+		if container, ok := flattened[name]; ok {
+			result = append(result, container)
+			delete(flattened, name)
+		}
+	}
+
+	return result
+}
+
+// siteContainerName returns the name of the Site Container, which may be returned in the form `siteName/containerName`
+func siteContainerName(input string) string {
+	parts := strings.Split(input, "/")
+	return parts[len(parts)-1]
+}
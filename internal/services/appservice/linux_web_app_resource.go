@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/sdk/2024-04-01/sitecontainers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -41,6 +42,7 @@ type LinuxWebAppModel struct {
 	LogsConfig                    []helpers.LogsConfig       `tfschema:"logs"`
 	MetaData                      map[string]string          `tfschema:"app_metadata"`
 	SiteConfig                    []helpers.SiteConfigLinux  `tfschema:"site_config"`
+	SiteContainers                []helpers.SiteContainer    `tfschema:"site_container"`
 	StorageAccounts               []helpers.StorageAccount   `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString `tfschema:"connection_string"`
 	Tags                          map[string]string          `tfschema:"tags"`
@@ -140,6 +142,8 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"site_config": helpers.SiteConfigSchemaLinux(),
 
+		"site_container": helpers.SiteContainerSchema(),
+
 		"storage_account": helpers.StorageAccountSchema(),
 
 		"tags": tags.Schema(),
@@ -288,6 +292,16 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if len(webApp.SiteContainers) > 0 {
+				if err := helpers.ValidateSiteContainers(webApp.SiteContainers); err != nil {
+					return err
+				}
+				if len(webApp.SiteConfig[0].ApplicationStack) > 0 {
+					return fmt.Errorf("`site_container` cannot be specified with `site_config.0.application_stack`")
+				}
+				siteConfig.LinuxFxVersion = utils.String(helpers.LinuxFxVersionSiteContainers)
+			}
+
 			siteEnvelope := web.Site{
 				Location: utils.String(webApp.Location),
 				Identity: helpers.ExpandIdentity(webApp.Identity),
@@ -318,6 +332,14 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			siteContainersClient := metadata.Client.AppService.SiteContainersClient
+			for _, v := range webApp.SiteContainers {
+				containerId := sitecontainers.NewSiteContainerID(id.SubscriptionId, id.ResourceGroup, id.SiteName, v.Name)
+				if _, err := siteContainersClient.CreateOrUpdate(ctx, containerId, helpers.ExpandSiteContainer(v)); err != nil {
+					return fmt.Errorf("creating %s: %+v", containerId, err)
+				}
+			}
+
 			appSettings := helpers.ExpandAppSettings(webApp.AppSettings)
 			if metadata.ResourceData.HasChange("site_config.0.health_check_eviction_time_in_min") {
 				appSettings.Properties["WEBSITE_HEALTHCHECK_MAXPINGFAILURE"] = utils.String(strconv.Itoa(webApp.SiteConfig[0].HealthCheckEvictionTime))
@@ -488,6 +510,23 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 
 			state.SiteConfig = helpers.FlattenSiteConfigLinux(webAppSiteConfig.SiteConfig, healthCheckCount)
 
+			if siteConfig := webAppSiteConfig.SiteConfig; siteConfig != nil && strings.EqualFold(utils.NormalizeNilableString(siteConfig.LinuxFxVersion), helpers.LinuxFxVersionSiteContainers) {
+				var existing LinuxWebAppModel
+				if err := metadata.Decode(&existing); err != nil {
+					return fmt.Errorf("decoding: %+v", err)
+				}
+
+				siteId := sitecontainers.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+				siteContainers, err := metadata.Client.AppService.SiteContainersClient.ListBySiteComplete(ctx, siteId)
+				if err != nil {
+					return fmt.Errorf("listing Site Containers for Linux %s: %+v", id, err)
+				}
+
+				state.SiteContainers = helpers.FlattenSiteContainers(siteContainers.Items, existing.SiteContainers)
+				// the application stack is replaced by the Site Containers
+				state.SiteConfig[0].ApplicationStack = nil
+			}
+
 			state.StorageAccounts = helpers.FlattenStorageAccounts(storageAccounts)
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -575,7 +614,7 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
 
@@ -587,6 +626,24 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				existing.SiteConfig = siteConfig
 			}
 
+			if metadata.ResourceData.HasChange("site_container") && len(state.SiteContainers) > 0 {
+				if err := helpers.ValidateSiteContainers(state.SiteContainers); err != nil {
+					return err
+				}
+				if len(state.SiteConfig[0].ApplicationStack) > 0 {
+					return fmt.Errorf("`site_container` cannot be specified with `site_config.0.application_stack`")
+				}
+
+				if existing.SiteConfig == nil {
+					siteConfig, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						return fmt.Errorf("reading Site Config for Linux %s: %+v", id, err)
+					}
+					existing.SiteConfig = siteConfig.SiteConfig
+				}
+				existing.SiteConfig.LinuxFxVersion = utils.String(helpers.LinuxFxVersionSiteContainers)
+			}
+
 			updateFuture, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, existing)
 			if err != nil {
 				return fmt.Errorf("updating Linux %s: %+v", id, err)
@@ -595,6 +652,33 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("waiting to update %s: %+v", id, err)
 			}
 
+			if metadata.ResourceData.HasChange("site_container") {
+				siteContainersClient := metadata.Client.AppService.SiteContainersClient
+
+				oldRaw, _ := metadata.ResourceData.GetChange("site_container")
+				configured := make(map[string]struct{})
+				for _, v := range state.SiteContainers {
+					configured[v.Name] = struct{}{}
+				}
+				for _, v := range oldRaw.([]interface{}) {
+					name := v.(map[string]interface{})["name"].(string)
+					if _, ok := configured[name]; ok {
+						continue
+					}
+					containerId := sitecontainers.NewSiteContainerID(id.SubscriptionId, id.ResourceGroup, id.SiteName, name)
+					if _, err := siteContainersClient.Delete(ctx, containerId); err != nil {
+						return fmt.Errorf("deleting %s: %+v", containerId, err)
+					}
+				}
+
+				for _, v := range state.SiteContainers {
+					containerId := sitecontainers.NewSiteContainerID(id.SubscriptionId, id.ResourceGroup, id.SiteName, v.Name)
+					if _, err := siteContainersClient.CreateOrUpdate(ctx, containerId, helpers.ExpandSiteContainer(v)); err != nil {
+						return fmt.Errorf("updating %s: %+v", containerId, err)
+					}
+				}
+			}
+
 			// (@jackofallops) - App Settings can clobber logs configuration so must be updated before we send any Log updates
 			if metadata.ResourceData.HasChange("app_settings") {
 				appSettingsUpdate := helpers.ExpandAppSettings(state.AppSettings)
@@ -905,6 +905,30 @@ func TestAccLinuxWebApp_withDocker(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebApp_siteContainers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.siteContainers(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_container.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.siteContainersUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_container.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 // Change Application stack of an app?
 
 func TestAccLinuxWebApp_updateAppStack(t *testing.T) {
@@ -2109,6 +2133,80 @@ resource "azurerm_linux_web_app" "test" {
 `, r.baseTemplate(data), data.RandomInteger, containerImage, containerTag)
 }
 
+func (r LinuxWebAppResource) siteContainers(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    "SIDECAR_MODE" = "telemetry"
+  }
+
+  site_config {}
+
+  site_container {
+    name        = "main"
+    image       = "mcr.microsoft.com/appsvc/staticsite:latest"
+    is_main     = true
+    target_port = "80"
+  }
+
+  site_container {
+    name        = "sidecar"
+    image       = "mcr.microsoft.com/appsvc/staticsite:latest"
+    target_port = "8080"
+
+    environment_variable {
+      name  = "MODE"
+      value = "SIDECAR_MODE"
+    }
+
+    volume_mount {
+      volume_sub_path      = "/shared"
+      container_mount_path = "/var/shared"
+      read_only            = true
+    }
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
+func (r LinuxWebAppResource) siteContainersUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  site_container {
+    name             = "main"
+    image            = "mcr.microsoft.com/appsvc/staticsite:latest"
+    is_main          = true
+    target_port      = "80"
+    start_up_command = "/opt/startup/init_container.sh"
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
 func (r LinuxWebAppResource) autoHealRules(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -38,6 +38,7 @@ func (r Registration) Resources() []sdk.Resource {
 		return []sdk.Resource{
 			AppServiceSourceControlResource{},
 			AppServiceSourceControlTokenResource{},
+			FunctionAppFlexConsumptionResource{},
 			LinuxFunctionAppResource{},
 			LinuxWebAppResource{},
 			LinuxWebAppSlotResource{},
@@ -0,0 +1,15 @@
+package sitecontainers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SiteContainersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSiteContainersClientWithBaseURI(endpoint string) SiteContainersClient {
+	return SiteContainersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
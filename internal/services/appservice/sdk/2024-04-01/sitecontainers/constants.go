@@ -0,0 +1,37 @@
+package sitecontainers
+
+import "strings"
+
+type AuthType string
+
+const (
+	AuthTypeAnonymous       AuthType = "Anonymous"
+	AuthTypeSystemIdentity  AuthType = "SystemIdentity"
+	AuthTypeUserAssigned    AuthType = "UserAssigned"
+	AuthTypeUserCredentials AuthType = "UserCredentials"
+)
+
+func PossibleValuesForAuthType() []string {
+	return []string{
+		string(AuthTypeAnonymous),
+		string(AuthTypeSystemIdentity),
+		string(AuthTypeUserAssigned),
+		string(AuthTypeUserCredentials),
+	}
+}
+
+func parseAuthType(input string) (*AuthType, error) {
+	vals := map[string]AuthType{
+		"anonymous":       AuthTypeAnonymous,
+		"systemidentity":  AuthTypeSystemIdentity,
+		"userassigned":    AuthTypeUserAssigned,
+		"usercredentials": AuthTypeUserCredentials,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AuthType(input)
+	return &out, nil
+}
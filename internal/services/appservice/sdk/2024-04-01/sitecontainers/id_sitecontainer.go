@@ -0,0 +1,137 @@
+package sitecontainers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SiteContainerId{}
+
+// SiteContainerId is a struct representing the Resource ID for a Site Container
+type SiteContainerId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	SiteName          string
+	SiteContainerName string
+}
+
+// NewSiteContainerID returns a new SiteContainerId struct
+func NewSiteContainerID(subscriptionId string, resourceGroupName string, siteName string, siteContainerName string) SiteContainerId {
+	return SiteContainerId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		SiteName:          siteName,
+		SiteContainerName: siteContainerName,
+	}
+}
+
+// ParseSiteContainerID parses 'input' into a SiteContainerId
+func ParseSiteContainerID(input string) (*SiteContainerId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SiteContainerId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SiteContainerId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.SiteName, ok = parsed.Parsed["siteName"]; !ok {
+		return nil, fmt.Errorf("the segment 'siteName' was not found in the resource id %q", input)
+	}
+
+	if id.SiteContainerName, ok = parsed.Parsed["siteContainerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'siteContainerName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseSiteContainerIDInsensitively parses 'input' case-insensitively into a SiteContainerId
+// note: this method should only be used for API response data and not user input
+func ParseSiteContainerIDInsensitively(input string) (*SiteContainerId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SiteContainerId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SiteContainerId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.SiteName, ok = parsed.Parsed["siteName"]; !ok {
+		return nil, fmt.Errorf("the segment 'siteName' was not found in the resource id %q", input)
+	}
+
+	if id.SiteContainerName, ok = parsed.Parsed["siteContainerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'siteContainerName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateSiteContainerID checks that 'input' can be parsed as a Site Container ID
+func ValidateSiteContainerID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSiteContainerID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Site Container ID
+func (id SiteContainerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/sitecontainers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.SiteContainerName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Site Container ID
+func (id SiteContainerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticSites", "sites", "sites"),
+		resourceids.UserSpecifiedSegment("siteName", "siteValue"),
+		resourceids.StaticSegment("staticSiteContainers", "sitecontainers", "sitecontainers"),
+		resourceids.UserSpecifiedSegment("siteContainerName", "siteContainerValue"),
+	}
+}
+
+// String returns a human-readable description of this Site Container ID
+func (id SiteContainerId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Site Name: %q", id.SiteName),
+		fmt.Sprintf("Site Container Name: %q", id.SiteContainerName),
+	}
+	return fmt.Sprintf("Site Container (%s)", strings.Join(components, "\n"))
+}
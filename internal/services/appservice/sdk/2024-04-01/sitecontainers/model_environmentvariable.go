@@ -0,0 +1,6 @@
+package sitecontainers
+
+type EnvironmentVariable struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,9 @@
+package sitecontainers
+
+type SiteContainer struct {
+	Id         *string                  `json:"id,omitempty"`
+	Kind       *string                  `json:"kind,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *SiteContainerProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
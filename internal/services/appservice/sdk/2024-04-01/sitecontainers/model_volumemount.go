@@ -0,0 +1,8 @@
+package sitecontainers
+
+type VolumeMount struct {
+	ContainerMountPath string  `json:"containerMountPath"`
+	Data               *string `json:"data,omitempty"`
+	ReadOnly           *bool   `json:"readOnly,omitempty"`
+	VolumeSubPath      string  `json:"volumeSubPath"`
+}
@@ -0,0 +1,99 @@
+package webapps
+
+import "strings"
+
+type AuthenticationType string
+
+const (
+	AuthenticationTypeStorageAccountConnectionString AuthenticationType = "StorageAccountConnectionString"
+	AuthenticationTypeSystemAssignedIdentity         AuthenticationType = "SystemAssignedIdentity"
+	AuthenticationTypeUserAssignedIdentity           AuthenticationType = "UserAssignedIdentity"
+)
+
+func PossibleValuesForAuthenticationType() []string {
+	return []string{
+		string(AuthenticationTypeStorageAccountConnectionString),
+		string(AuthenticationTypeSystemAssignedIdentity),
+		string(AuthenticationTypeUserAssignedIdentity),
+	}
+}
+
+func parseAuthenticationType(input string) (*AuthenticationType, error) {
+	vals := map[string]AuthenticationType{
+		"storageaccountconnectionstring": AuthenticationTypeStorageAccountConnectionString,
+		"systemassignedidentity":         AuthenticationTypeSystemAssignedIdentity,
+		"userassignedidentity":           AuthenticationTypeUserAssignedIdentity,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AuthenticationType(input)
+	return &out, nil
+}
+
+type FunctionsDeploymentStorageType string
+
+const (
+	FunctionsDeploymentStorageTypeBlobContainer FunctionsDeploymentStorageType = "blobContainer"
+)
+
+func PossibleValuesForFunctionsDeploymentStorageType() []string {
+	return []string{
+		string(FunctionsDeploymentStorageTypeBlobContainer),
+	}
+}
+
+func parseFunctionsDeploymentStorageType(input string) (*FunctionsDeploymentStorageType, error) {
+	vals := map[string]FunctionsDeploymentStorageType{
+		"blobcontainer": FunctionsDeploymentStorageTypeBlobContainer,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FunctionsDeploymentStorageType(input)
+	return &out, nil
+}
+
+type RuntimeName string
+
+const (
+	RuntimeNameCustom                 RuntimeName = "custom"
+	RuntimeNameDotnetNegativeisolated RuntimeName = "dotnet-isolated"
+	RuntimeNameJava                   RuntimeName = "java"
+	RuntimeNameNode                   RuntimeName = "node"
+	RuntimeNamePowershell             RuntimeName = "powershell"
+	RuntimeNamePython                 RuntimeName = "python"
+)
+
+func PossibleValuesForRuntimeName() []string {
+	return []string{
+		string(RuntimeNameCustom),
+		string(RuntimeNameDotnetNegativeisolated),
+		string(RuntimeNameJava),
+		string(RuntimeNameNode),
+		string(RuntimeNamePowershell),
+		string(RuntimeNamePython),
+	}
+}
+
+func parseRuntimeName(input string) (*RuntimeName, error) {
+	vals := map[string]RuntimeName{
+		"custom":          RuntimeNameCustom,
+		"dotnet-isolated": RuntimeNameDotnetNegativeisolated,
+		"java":            RuntimeNameJava,
+		"node":            RuntimeNameNode,
+		"powershell":      RuntimeNamePowershell,
+		"python":          RuntimeNamePython,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RuntimeName(input)
+	return &out, nil
+}
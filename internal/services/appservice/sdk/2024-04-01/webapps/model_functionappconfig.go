@@ -0,0 +1,7 @@
+package webapps
+
+type FunctionAppConfig struct {
+	Deployment          *FunctionsDeployment          `json:"deployment,omitempty"`
+	Runtime             *FunctionsRuntime             `json:"runtime,omitempty"`
+	ScaleAndConcurrency *FunctionsScaleAndConcurrency `json:"scaleAndConcurrency,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webapps
+
+type FunctionsAlwaysReadyConfig struct {
+	InstanceCount *int64  `json:"instanceCount,omitempty"`
+	Name          *string `json:"name,omitempty"`
+}
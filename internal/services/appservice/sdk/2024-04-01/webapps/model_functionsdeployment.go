@@ -0,0 +1,5 @@
+package webapps
+
+type FunctionsDeployment struct {
+	Storage *FunctionsDeploymentStorage `json:"storage,omitempty"`
+}
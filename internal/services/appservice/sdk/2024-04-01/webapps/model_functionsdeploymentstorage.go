@@ -0,0 +1,7 @@
+package webapps
+
+type FunctionsDeploymentStorage struct {
+	Authentication *FunctionsDeploymentStorageAuthentication `json:"authentication,omitempty"`
+	Type           *FunctionsDeploymentStorageType           `json:"type,omitempty"`
+	Value          *string                                   `json:"value,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webapps
+
+type FunctionsDeploymentStorageAuthentication struct {
+	StorageAccountConnectionStringName *string             `json:"storageAccountConnectionStringName,omitempty"`
+	Type                               *AuthenticationType `json:"type,omitempty"`
+	UserAssignedIdentityResourceId     *string             `json:"userAssignedIdentityResourceId,omitempty"`
+}
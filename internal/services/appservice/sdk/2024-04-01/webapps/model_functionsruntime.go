@@ -0,0 +1,6 @@
+package webapps
+
+type FunctionsRuntime struct {
+	Name    *RuntimeName `json:"name,omitempty"`
+	Version *string      `json:"version,omitempty"`
+}
@@ -0,0 +1,8 @@
+package webapps
+
+type FunctionsScaleAndConcurrency struct {
+	AlwaysReady          *[]FunctionsAlwaysReadyConfig         `json:"alwaysReady,omitempty"`
+	InstanceMemoryMB     *int64                                `json:"instanceMemoryMB,omitempty"`
+	MaximumInstanceCount *int64                                `json:"maximumInstanceCount,omitempty"`
+	Triggers             *FunctionsScaleAndConcurrencyTriggers `json:"triggers,omitempty"`
+}
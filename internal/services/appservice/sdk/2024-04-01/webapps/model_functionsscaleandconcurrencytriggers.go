@@ -0,0 +1,5 @@
+package webapps
+
+type FunctionsScaleAndConcurrencyTriggers struct {
+	HTTP *FunctionsScaleAndConcurrencyTriggersHTTP `json:"http,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webapps
+
+type FunctionsScaleAndConcurrencyTriggersHTTP struct {
+	PerInstanceConcurrency *int64 `json:"perInstanceConcurrency,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webapps
+
+type NameValuePair struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webapps
+
+type SiteConfig struct {
+	AppSettings *[]NameValuePair `json:"appSettings,omitempty"`
+}
@@ -0,0 +1,14 @@
+package webapps
+
+type SiteProperties struct {
+	CustomDomainVerificationId  *string            `json:"customDomainVerificationId,omitempty"`
+	DefaultHostName             *string            `json:"defaultHostName,omitempty"`
+	Enabled                     *bool              `json:"enabled,omitempty"`
+	FunctionAppConfig           *FunctionAppConfig `json:"functionAppConfig,omitempty"`
+	HTTPSOnly                   *bool              `json:"httpsOnly,omitempty"`
+	OutboundIPAddresses         *string            `json:"outboundIpAddresses,omitempty"`
+	PossibleOutboundIPAddresses *string            `json:"possibleOutboundIpAddresses,omitempty"`
+	ServerFarmId                *string            `json:"serverFarmId,omitempty"`
+	SiteConfig                  *SiteConfig        `json:"siteConfig,omitempty"`
+	VirtualNetworkSubnetId      *string            `json:"virtualNetworkSubnetId,omitempty"`
+}
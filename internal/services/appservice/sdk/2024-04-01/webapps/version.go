@@ -0,0 +1,9 @@
+package webapps
+
+import "fmt"
+
+const defaultApiVersion = "2024-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/webapps/%s", defaultApiVersion)
+}
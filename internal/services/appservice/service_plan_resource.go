@@ -81,6 +81,7 @@ func (r ServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
 				"SHARED",
 				"PC2", "PC3", "PC4", "Y1", // Consumption Plans - Function Apps
 				"EP1", "EP2", "EP3", // Elastic Premium Plans - Function Apps
+				"FC1",               // Flex Consumption Plans - Function Apps
 				"WS1", "WS2", "WS3", // Workflow plans - Logic Apps
 			}, false),
 		},
@@ -337,7 +338,7 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("sku_name") {
 				existing.Sku.Name = utils.String(state.Sku)
 			}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
 
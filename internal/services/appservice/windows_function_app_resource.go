@@ -628,7 +628,7 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				existing.Identity = helpers.ExpandIdentity(state.Identity)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
 
@@ -172,7 +172,7 @@ func resourceAttestationProviderUpdate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	updateParams := attestationproviders.AttestationServicePatchParams{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateParams.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
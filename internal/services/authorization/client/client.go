@@ -4,32 +4,68 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedulerequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleeligibilityschedulerequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleeligibilityschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicyassignments"
 )
 
 type Client struct {
-	GroupsClient            *graphrbac.GroupsClient
-	RoleAssignmentsClient   *authorization.RoleAssignmentsClient
-	RoleDefinitionsClient   *authorization.RoleDefinitionsClient
-	ServicePrincipalsClient *graphrbac.ServicePrincipalsClient
+	GroupsClient                          *graphrbac.GroupsClient
+	RoleAssignmentScheduleRequestsClient  *roleassignmentschedulerequests.RoleAssignmentScheduleRequestsClient
+	RoleAssignmentSchedulesClient         *roleassignmentschedules.RoleAssignmentSchedulesClient
+	RoleAssignmentsClient                 *authorization.RoleAssignmentsClient
+	RoleDefinitionsClient                 *authorization.RoleDefinitionsClient
+	RoleEligibilityScheduleRequestsClient *roleeligibilityschedulerequests.RoleEligibilityScheduleRequestsClient
+	RoleEligibilitySchedulesClient        *roleeligibilityschedules.RoleEligibilitySchedulesClient
+	RoleManagementPoliciesClient          *rolemanagementpolicies.RoleManagementPoliciesClient
+	RoleManagementPolicyAssignmentsClient *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient
+	ServicePrincipalsClient               *graphrbac.ServicePrincipalsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	groupsClient := graphrbac.NewGroupsClientWithBaseURI(o.GraphEndpoint, o.TenantID)
 	o.ConfigureClient(&groupsClient.Client, o.GraphAuthorizer)
 
+	roleAssignmentScheduleRequestsClient := roleassignmentschedulerequests.NewRoleAssignmentScheduleRequestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleAssignmentScheduleRequestsClient.Client, o.ResourceManagerAuthorizer)
+
+	roleAssignmentSchedulesClient := roleassignmentschedules.NewRoleAssignmentSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleAssignmentSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
 	roleAssignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&roleAssignmentsClient.Client, o.ResourceManagerAuthorizer)
 
 	roleDefinitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&roleDefinitionsClient.Client, o.ResourceManagerAuthorizer)
 
+	roleEligibilityScheduleRequestsClient := roleeligibilityschedulerequests.NewRoleEligibilityScheduleRequestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleEligibilityScheduleRequestsClient.Client, o.ResourceManagerAuthorizer)
+
+	roleEligibilitySchedulesClient := roleeligibilityschedules.NewRoleEligibilitySchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleEligibilitySchedulesClient.Client, o.ResourceManagerAuthorizer)
+
+	roleManagementPoliciesClient := rolemanagementpolicies.NewRoleManagementPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleManagementPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	roleManagementPolicyAssignmentsClient := rolemanagementpolicyassignments.NewRoleManagementPolicyAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleManagementPolicyAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(o.GraphEndpoint, o.TenantID)
 	o.ConfigureClient(&servicePrincipalsClient.Client, o.GraphAuthorizer)
 
 	return &Client{
-		GroupsClient:            &groupsClient,
-		RoleAssignmentsClient:   &roleAssignmentsClient,
-		RoleDefinitionsClient:   &roleDefinitionsClient,
-		ServicePrincipalsClient: &servicePrincipalsClient,
+		GroupsClient:                          &groupsClient,
+		RoleAssignmentScheduleRequestsClient:  &roleAssignmentScheduleRequestsClient,
+		RoleAssignmentSchedulesClient:         &roleAssignmentSchedulesClient,
+		RoleAssignmentsClient:                 &roleAssignmentsClient,
+		RoleDefinitionsClient:                 &roleDefinitionsClient,
+		RoleEligibilityScheduleRequestsClient: &roleEligibilityScheduleRequestsClient,
+		RoleEligibilitySchedulesClient:        &roleEligibilitySchedulesClient,
+		RoleManagementPoliciesClient:          &roleManagementPoliciesClient,
+		RoleManagementPolicyAssignmentsClient: &roleManagementPolicyAssignmentsClient,
+		ServicePrincipalsClient:               &servicePrincipalsClient,
 	}
 }
@@ -0,0 +1,48 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PimRoleAssignmentId struct {
+	Scope            string
+	RoleDefinitionId string
+	PrincipalId      string
+}
+
+func NewPimRoleAssignmentID(scope, roleDefinitionId, principalId string) PimRoleAssignmentId {
+	return PimRoleAssignmentId{
+		Scope:            scope,
+		RoleDefinitionId: roleDefinitionId,
+		PrincipalId:      principalId,
+	}
+}
+
+// ID returns a pseudo ID for a PIM Role Assignment, since the Schedule Requests used to manage these
+// are immutable - and the Schedules which are created as a result are assigned an ID by Azure
+func (id PimRoleAssignmentId) ID() string {
+	return fmt.Sprintf("%s|%s|%s", id.Scope, id.RoleDefinitionId, id.PrincipalId)
+}
+
+// PimRoleAssignmentID parses the pseudo ID of a PIM Role Assignment, which is in the format
+// `{scope}|{roleDefinitionId}|{principalId}`
+func PimRoleAssignmentID(input string) (*PimRoleAssignmentId, error) {
+	parts := strings.Split(input, "|")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected the PIM Role Assignment ID to be in the format `{scope}|{roleDefinitionId}|{principalId}` but got %q", input)
+	}
+
+	for i, name := range []string{"scope", "roleDefinitionId", "principalId"} {
+		if parts[i] == "" {
+			return nil, fmt.Errorf("the segment %q was empty in the PIM Role Assignment ID %q", name, input)
+		}
+	}
+
+	if !strings.HasPrefix(parts[0], "/") {
+		return nil, fmt.Errorf("expected the scope %q to begin with `/` in the PIM Role Assignment ID %q", parts[0], input)
+	}
+
+	id := NewPimRoleAssignmentID(parts[0], parts[1], parts[2])
+	return &id, nil
+}
@@ -0,0 +1,78 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestPimRoleAssignmentIDFormatter(t *testing.T) {
+	actual := NewPimRoleAssignmentID("/subscriptions/12345678-1234-9876-4563-123456789012", "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7", "11111111-2222-3333-4444-555555555555").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012|/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|11111111-2222-3333-4444-555555555555"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPimRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *PimRoleAssignmentId
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|",
+		},
+		{
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012|/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|11111111-2222-3333-4444-555555555555",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|11111111-2222-3333-4444-555555555555",
+			Expected: &PimRoleAssignmentId{
+				Scope:            "/subscriptions/12345678-1234-9876-4563-123456789012",
+				RoleDefinitionId: "/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+				PrincipalId:      "11111111-2222-3333-4444-555555555555",
+			},
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1|/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|11111111-2222-3333-4444-555555555555",
+			Expected: &PimRoleAssignmentId{
+				Scope:            "/providers/Microsoft.Management/managementGroups/group1",
+				RoleDefinitionId: "/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+				PrincipalId:      "11111111-2222-3333-4444-555555555555",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PimRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.RoleDefinitionId != v.Expected.RoleDefinitionId {
+			t.Fatalf("Expected %q but got %q for RoleDefinitionId", v.Expected.RoleDefinitionId, actual.RoleDefinitionId)
+		}
+		if actual.PrincipalId != v.Expected.PrincipalId {
+			t.Fatalf("Expected %q but got %q for PrincipalId", v.Expected.PrincipalId, actual.PrincipalId)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedulerequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePimActiveRoleAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePimActiveRoleAssignmentCreate,
+		Read:   resourcePimActiveRoleAssignmentRead,
+		Delete: resourcePimActiveRoleAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PimRoleAssignmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scope": schemaPimScope(),
+
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"justification": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"schedule": schemaPimRoleAssignmentSchedule(),
+
+			"ticket": schemaPimRoleAssignmentTicket(),
+
+			"principal_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePimActiveRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentScheduleRequestsClient
+	schedulesClient := meta.(*clients.Client).Authorization.RoleAssignmentSchedulesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPimRoleAssignmentID(d.Get("scope").(string), d.Get("role_definition_id").(string), d.Get("principal_id").(string))
+
+	existing, err := findPimActiveRoleAssignmentSchedule(ctx, schedulesClient, id)
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing PIM Active Role Assignment %q: %+v", id.ID(), err)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsError("azurerm_pim_active_role_assignment", id.ID())
+	}
+
+	requestName, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating UUID for the PIM Active Role Assignment Request: %+v", err)
+	}
+	requestId := roleassignmentschedulerequests.NewRoleAssignmentScheduleRequestID(id.Scope, requestName)
+
+	payload := roleassignmentschedulerequests.RoleAssignmentScheduleRequest{
+		Properties: &roleassignmentschedulerequests.RoleAssignmentScheduleRequestProperties{
+			PrincipalId:      id.PrincipalId,
+			RoleDefinitionId: id.RoleDefinitionId,
+			RequestType:      roleassignmentschedulerequests.RequestTypeAdminAssign,
+			ScheduleInfo:     expandPimActiveRoleAssignmentScheduleInfo(d.Get("schedule").([]interface{})),
+			TicketInfo:       expandPimActiveRoleAssignmentTicketInfo(d.Get("ticket").([]interface{})),
+		},
+	}
+	if v := d.Get("justification").(string); v != "" {
+		payload.Properties.Justification = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, requestId, payload); err != nil {
+		return fmt.Errorf("creating %s for PIM Active Role Assignment %q: %+v", requestId, id.ID(), err)
+	}
+
+	// the Schedule is created asynchronously once the Request has been provisioned
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{"Found"},
+		Refresh:    pimActiveRoleAssignmentStateRefreshFunc(ctx, schedulesClient, id),
+		MinTimeout: 5 * time.Second,
+		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for PIM Active Role Assignment %q to be provisioned: %+v", id.ID(), err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePimActiveRoleAssignmentRead(d, meta)
+}
+
+func resourcePimActiveRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentScheduleRequestsClient
+	schedulesClient := meta.(*clients.Client).Authorization.RoleAssignmentSchedulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PimRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	schedule, err := findPimActiveRoleAssignmentSchedule(ctx, schedulesClient, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving PIM Active Role Assignment %q: %+v", id.ID(), err)
+	}
+	if schedule == nil {
+		log.Printf("[DEBUG] PIM Active Role Assignment %q was not found - removing from state", id.ID())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("scope", id.Scope)
+	d.Set("role_definition_id", id.RoleDefinitionId)
+	d.Set("principal_id", id.PrincipalId)
+
+	props := schedule.Properties
+	principalType := ""
+	if props.PrincipalType != nil {
+		principalType = string(*props.PrincipalType)
+	}
+	d.Set("principal_type", principalType)
+
+	// the Schedule only exposes when it starts and ends, so the remaining details are retrieved
+	// from the Request which created it - which may have since been removed
+	scheduleInfo := pimRoleAssignmentSchedule{
+		StartDateTime:  props.StartDateTime,
+		ExpirationType: pimExpirationTypeNoExpiration,
+		EndDateTime:    props.EndDateTime,
+	}
+	if props.EndDateTime != nil {
+		scheduleInfo.ExpirationType = pimExpirationTypeAfterDateTime
+	}
+	justification := ""
+	ticket := make([]interface{}, 0)
+
+	if props.RoleAssignmentScheduleRequestId != nil {
+		requestId, err := roleassignmentschedulerequests.ParseRoleAssignmentScheduleRequestIDInsensitively(*props.RoleAssignmentScheduleRequestId)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, *requestId)
+		if err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("retrieving %s: %+v", *requestId, err)
+		}
+
+		if model := resp.Model; model != nil && model.Properties != nil {
+			requestProps := model.Properties
+			justification = utils.NormalizeNilableString(requestProps.Justification)
+			if info := requestProps.ScheduleInfo; info != nil {
+				scheduleInfo = flattenPimActiveRoleAssignmentScheduleInfo(info)
+			}
+			if info := requestProps.TicketInfo; info != nil {
+				ticket = flattenPimRoleAssignmentTicket(info.TicketNumber, info.TicketSystem)
+			}
+		}
+	}
+
+	d.Set("justification", justification)
+	if err := d.Set("schedule", flattenPimRoleAssignmentSchedule(scheduleInfo)); err != nil {
+		return fmt.Errorf("setting `schedule`: %+v", err)
+	}
+	if err := d.Set("ticket", ticket); err != nil {
+		return fmt.Errorf("setting `ticket`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourcePimActiveRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentScheduleRequestsClient
+	schedulesClient := meta.(*clients.Client).Authorization.RoleAssignmentSchedulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PimRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	requestName, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating UUID for the PIM Active Role Assignment Request: %+v", err)
+	}
+	requestId := roleassignmentschedulerequests.NewRoleAssignmentScheduleRequestID(id.Scope, requestName)
+
+	payload := roleassignmentschedulerequests.RoleAssignmentScheduleRequest{
+		Properties: &roleassignmentschedulerequests.RoleAssignmentScheduleRequestProperties{
+			PrincipalId:      id.PrincipalId,
+			RoleDefinitionId: id.RoleDefinitionId,
+			RequestType:      roleassignmentschedulerequests.RequestTypeAdminRemove,
+			Justification:    utils.String("Removed by Terraform"),
+		},
+	}
+
+	if resp, err := client.Create(ctx, requestId, payload); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("creating %s to remove PIM Active Role Assignment %q: %+v", requestId, id.ID(), err)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Found"},
+		Target:     []string{"NotFound"},
+		Refresh:    pimActiveRoleAssignmentStateRefreshFunc(ctx, schedulesClient, *id),
+		MinTimeout: 5 * time.Second,
+		Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for PIM Active Role Assignment %q to be removed: %+v", id.ID(), err)
+	}
+
+	return nil
+}
+
+func pimActiveRoleAssignmentStateRefreshFunc(ctx context.Context, client *roleassignmentschedules.RoleAssignmentSchedulesClient, id parse.PimRoleAssignmentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		schedule, err := findPimActiveRoleAssignmentSchedule(ctx, client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if schedule == nil {
+			// returning a nil result would be treated as not found when waiting for the Target
+			return "NotFound", "NotFound", nil
+		}
+		return *schedule, "Found", nil
+	}
+}
+
+// findPimActiveRoleAssignmentSchedule returns the Role Assignment Schedule assigned directly at the Scope
+// for this Principal and Role Definition, if one exists - ignoring any which are the result of activating
+// an Eligible Role Assignment
+func findPimActiveRoleAssignmentSchedule(ctx context.Context, client *roleassignmentschedules.RoleAssignmentSchedulesClient, id parse.PimRoleAssignmentId) (*roleassignmentschedules.RoleAssignmentSchedule, error) {
+	scopeId := roleassignmentschedules.NewScopeID(id.Scope)
+	options := roleassignmentschedules.ListForScopeOptions{
+		Filter: utils.String(fmt.Sprintf("(principalId eq '%s')", id.PrincipalId)),
+	}
+	resp, err := client.ListForScopeComplete(ctx, scopeId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Assignment Schedules for %s: %+v", scopeId, err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || props.RoleDefinitionId == nil || props.Scope == nil {
+			continue
+		}
+		if props.AssignmentType != nil && *props.AssignmentType != roleassignmentschedules.AssignmentTypeAssigned {
+			continue
+		}
+
+		if pimRoleDefinitionIdsMatch(*props.RoleDefinitionId, id.RoleDefinitionId) && strings.EqualFold(*props.Scope, id.Scope) {
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func expandPimActiveRoleAssignmentScheduleInfo(input []interface{}) *roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesScheduleInfo {
+	schedule := expandPimRoleAssignmentSchedule(input)
+	expirationType := roleassignmentschedulerequests.Type(schedule.ExpirationType)
+
+	return &roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesScheduleInfo{
+		StartDateTime: schedule.StartDateTime,
+		Expiration: &roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesScheduleInfoExpiration{
+			Type:        &expirationType,
+			Duration:    schedule.Duration,
+			EndDateTime: schedule.EndDateTime,
+		},
+	}
+}
+
+func flattenPimActiveRoleAssignmentScheduleInfo(input *roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesScheduleInfo) pimRoleAssignmentSchedule {
+	output := pimRoleAssignmentSchedule{
+		StartDateTime:  input.StartDateTime,
+		ExpirationType: pimExpirationTypeNoExpiration,
+	}
+
+	if expiration := input.Expiration; expiration != nil {
+		if expiration.Type != nil {
+			output.ExpirationType = string(*expiration.Type)
+		}
+		output.Duration = expiration.Duration
+		output.EndDateTime = expiration.EndDateTime
+	}
+
+	return output
+}
+
+func expandPimActiveRoleAssignmentTicketInfo(input []interface{}) *roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesTicketInfo {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesTicketInfo{}
+	if v := raw["number"].(string); v != "" {
+		output.TicketNumber = utils.String(v)
+	}
+	if v := raw["system"].(string); v != "" {
+		output.TicketSystem = utils.String(v)
+	}
+	return &output
+}
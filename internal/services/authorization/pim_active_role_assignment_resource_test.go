@@ -0,0 +1,161 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PimActiveRoleAssignmentResource struct{}
+
+func TestAccPimActiveRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_type").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPimActiveRoleAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPimActiveRoleAssignment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.expiration.0.duration_hours").HasValue("8"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PimActiveRoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PimRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := roleassignmentschedules.ListForScopeOptions{
+		Filter: utils.String(fmt.Sprintf("(principalId eq '%s')", id.PrincipalId)),
+	}
+	resp, err := client.Authorization.RoleAssignmentSchedulesClient.ListForScopeComplete(ctx, roleassignmentschedules.NewScopeID(id.Scope), options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Assignment Schedules for PIM Active Role Assignment %q: %+v", id.ID(), err)
+	}
+
+	for _, item := range resp.Items {
+		if props := item.Properties; props != nil && props.RoleDefinitionId != nil && props.Scope != nil {
+			if strings.EqualFold(*props.Scope, id.Scope) && strings.HasSuffix(strings.ToLower(id.RoleDefinitionId), strings.ToLower(*props.RoleDefinitionId)) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (PimActiveRoleAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_client_config" "test" {}
+
+data "azurerm_role_definition" "test" {
+  name = "Reader"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pim-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r PimActiveRoleAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = data.azurerm_client_config.test.object_id
+  justification      = "Acceptance Test"
+}
+`, r.template(data))
+}
+
+func (r PimActiveRoleAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "import" {
+  scope              = azurerm_pim_active_role_assignment.test.scope
+  role_definition_id = azurerm_pim_active_role_assignment.test.role_definition_id
+  principal_id       = azurerm_pim_active_role_assignment.test.principal_id
+  justification      = azurerm_pim_active_role_assignment.test.justification
+}
+`, r.basic(data))
+}
+
+func (r PimActiveRoleAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = data.azurerm_client_config.test.object_id
+  justification      = "Acceptance Test"
+
+  schedule {
+    expiration {
+      duration_hours = 8
+    }
+  }
+
+  ticket {
+    number = "1"
+    system = "example ticket system"
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,243 @@
+package authorization
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	pimExpirationTypeAfterDateTime = "AfterDateTime"
+	pimExpirationTypeAfterDuration = "AfterDuration"
+	pimExpirationTypeNoExpiration  = "NoExpiration"
+)
+
+var pimDurationRegex = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?)?$`)
+
+// pimRoleAssignmentSchedule is an API-version agnostic representation of the Schedule for an
+// Eligible or Active Role Assignment, since each is modelled using distinct (but identical) types
+type pimRoleAssignmentSchedule struct {
+	StartDateTime  *string
+	ExpirationType string
+	Duration       *string
+	EndDateTime    *string
+}
+
+func schemaPimScope() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.Any(
+			commonids.ValidateManagementGroupID,
+			commonids.ValidateSubscriptionID,
+			commonids.ValidateResourceGroupID,
+		),
+	}
+}
+
+func schemaPimRoleAssignmentSchedule() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"start_date_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+
+				"expiration": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"duration_days": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								ConflictsWith: []string{
+									"schedule.0.expiration.0.duration_hours",
+									"schedule.0.expiration.0.end_date_time",
+								},
+							},
+
+							"duration_hours": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								ConflictsWith: []string{
+									"schedule.0.expiration.0.duration_days",
+									"schedule.0.expiration.0.end_date_time",
+								},
+							},
+
+							"end_date_time": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+								ConflictsWith: []string{
+									"schedule.0.expiration.0.duration_days",
+									"schedule.0.expiration.0.duration_hours",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaPimRoleAssignmentTicket() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"number": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"system": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandPimRoleAssignmentSchedule(input []interface{}) pimRoleAssignmentSchedule {
+	output := pimRoleAssignmentSchedule{
+		ExpirationType: pimExpirationTypeNoExpiration,
+	}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v := raw["start_date_time"].(string); v != "" {
+		output.StartDateTime = utils.String(v)
+	}
+
+	expirations := raw["expiration"].([]interface{})
+	if len(expirations) == 0 || expirations[0] == nil {
+		return output
+	}
+
+	expiration := expirations[0].(map[string]interface{})
+	if v := expiration["duration_days"].(int); v > 0 {
+		output.ExpirationType = pimExpirationTypeAfterDuration
+		output.Duration = utils.String(fmt.Sprintf("P%dD", v))
+	} else if v := expiration["duration_hours"].(int); v > 0 {
+		output.ExpirationType = pimExpirationTypeAfterDuration
+		output.Duration = utils.String(fmt.Sprintf("PT%dH", v))
+	} else if v := expiration["end_date_time"].(string); v != "" {
+		output.ExpirationType = pimExpirationTypeAfterDateTime
+		output.EndDateTime = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenPimRoleAssignmentSchedule(input pimRoleAssignmentSchedule) []interface{} {
+	durationDays := 0
+	durationHours := 0
+	if input.Duration != nil {
+		durationDays, durationHours = parsePimDuration(*input.Duration)
+	}
+
+	endDateTime := ""
+	if input.EndDateTime != nil && !strings.EqualFold(input.ExpirationType, pimExpirationTypeAfterDuration) {
+		endDateTime = *input.EndDateTime
+	}
+
+	startDateTime := ""
+	if input.StartDateTime != nil {
+		startDateTime = *input.StartDateTime
+	}
+
+	expiration := make([]interface{}, 0)
+	if !strings.EqualFold(input.ExpirationType, pimExpirationTypeNoExpiration) {
+		expiration = append(expiration, map[string]interface{}{
+			"duration_days":  durationDays,
+			"duration_hours": durationHours,
+			"end_date_time":  endDateTime,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"start_date_time": startDateTime,
+			"expiration":      expiration,
+		},
+	}
+}
+
+// parsePimDuration parses the subset of ISO8601 Durations returned from the API (e.g. `P30D` or `PT8H`)
+// into the number of days and hours
+func parsePimDuration(input string) (days int, hours int) {
+	matches := pimDurationRegex.FindStringSubmatch(input)
+	if len(matches) != 3 {
+		return 0, 0
+	}
+
+	if matches[1] != "" {
+		days, _ = strconv.Atoi(matches[1])
+	}
+	if matches[2] != "" {
+		hours, _ = strconv.Atoi(matches[2])
+	}
+	return days, hours
+}
+
+func flattenPimRoleAssignmentTicket(number *string, system *string) []interface{} {
+	if number == nil && system == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"number": utils.NormalizeNilableString(number),
+			"system": utils.NormalizeNilableString(system),
+		},
+	}
+}
+
+// pimRoleDefinitionIdsMatch compares two Role Definition IDs, since the API returns these scoped to the
+// Subscription (or not at all, for a Management Group) regardless of which form was specified
+func pimRoleDefinitionIdsMatch(first string, second string) bool {
+	name := func(input string) string {
+		return input[strings.LastIndex(input, "/")+1:]
+	}
+	return strings.EqualFold(name(first), name(second))
+}
@@ -29,7 +29,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_role_assignment": resourceArmRoleAssignment(),
-		"azurerm_role_definition": resourceArmRoleDefinition(),
+		"azurerm_pim_active_role_assignment":   resourcePimActiveRoleAssignment(),
+		"azurerm_pim_eligible_role_assignment": resourcePimEligibleRoleAssignment(),
+		"azurerm_role_assignment":              resourceArmRoleAssignment(),
+		"azurerm_role_definition":              resourceArmRoleDefinition(),
+		"azurerm_role_management_policy":       resourceRoleManagementPolicy(),
 	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -27,6 +28,7 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmRoleAssignmentCreate,
 		Read:   resourceArmRoleAssignmentRead,
+		Update: resourceArmRoleAssignmentUpdate,
 		Delete: resourceArmRoleAssignmentDelete,
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
@@ -114,13 +116,13 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				RequiredWith: []string{"condition_version"},
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validate.RoleAssignmentCondition,
 			},
 
 			"condition_version": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				RequiredWith: []string{"condition"},
 				ValidateFunc: validation.StringInSlice([]string{
@@ -128,6 +130,20 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 					"2.0",
 				}, false),
 			},
+
+			// the following fields only control how the creation of the Role Assignment is retried whilst
+			// the Principal and/or Role Assignment replicate across Azure Active Directory and Azure Resource Manager
+			"retry_on_principal_not_found": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"wait_for_replication": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -205,22 +221,27 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		properties.RoleAssignmentProperties.DelegatedManagedIdentityResourceID = utils.String(delegatedManagedIdentityResourceID)
 	}
 
-	condition := d.Get("condition").(string)
-	conditionVersion := d.Get("condition_version").(string)
-
-	if condition != "" && conditionVersion != "" {
+	if condition := d.Get("condition").(string); condition != "" {
+		// conditions using version `1.0` are only supported for backwards compatibility
+		conditionVersion := "2.0"
+		if v := d.Get("condition_version").(string); v != "" {
+			conditionVersion = v
+		}
 		properties.RoleAssignmentProperties.Condition = utils.String(condition)
 		properties.RoleAssignmentProperties.ConditionVersion = utils.String(conditionVersion)
-	} else if condition != "" || conditionVersion != "" {
-		return fmt.Errorf("`condition` and `conditionVersion` should be both set or unset")
 	}
 
+	// a Delegated Managed Identity lives in the managing tenant, so can't be looked up in Azure Active Directory
 	skipPrincipalCheck := d.Get("skip_service_principal_aad_check").(bool)
-	if skipPrincipalCheck {
+	if skipPrincipalCheck || len(delegatedManagedIdentityResourceID) > 0 {
 		properties.RoleAssignmentProperties.PrincipalType = authorization.ServicePrincipal
 	}
 
-	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, meta, tenantId)); err != nil {
+	retryOptions := roleAssignmentRetryOptions{
+		retryOnPrincipalNotFound: d.Get("retry_on_principal_not_found").(bool),
+		waitForReplication:       d.Get("wait_for_replication").(bool),
+	}
+	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, meta, tenantId, retryOptions)); err != nil {
 		return err
 	}
 
@@ -282,9 +303,22 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	// these fields aren't returned from the API and are only used during creation, so default them when importing
+	for _, field := range []string{"retry_on_principal_not_found", "wait_for_replication"} {
+		if _, ok := d.GetOkExists(field); !ok { // nolint:staticcheck
+			d.Set(field, true)
+		}
+	}
+
 	return nil
 }
 
+func resourceArmRoleAssignmentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	// only the fields controlling how the creation of the Role Assignment is retried can be updated,
+	// which don't require any changes in Azure
+	return resourceArmRoleAssignmentRead(d, meta)
+}
+
 func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -305,7 +339,15 @@ func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}
 	return nil
 }
 
-func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}, tenantId string) func() *pluginsdk.RetryError {
+type roleAssignmentRetryOptions struct {
+	// retryOnPrincipalNotFound specifies whether creation is retried whilst the Principal replicates
+	retryOnPrincipalNotFound bool
+
+	// waitForReplication specifies whether to wait for the Role Assignment to be consistently available
+	waitForReplication bool
+}
+
+func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}, tenantId string, options roleAssignmentRetryOptions) func() *pluginsdk.RetryError {
 	return func() *pluginsdk.RetryError {
 		roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
 		ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -315,7 +357,7 @@ func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name st
 		if err != nil {
 			if utils.ResponseErrorIsRetryable(err) {
 				return pluginsdk.RetryableError(err)
-			} else if utils.ResponseWasStatusCode(resp.Response, 400) && strings.Contains(err.Error(), "PrincipalNotFound") {
+			} else if options.retryOnPrincipalNotFound && utils.ResponseWasStatusCode(resp.Response, 400) && strings.Contains(err.Error(), "PrincipalNotFound") {
 				// When waiting for service principal to become available
 				return pluginsdk.RetryableError(err)
 			}
@@ -327,6 +369,10 @@ func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name st
 			return pluginsdk.NonRetryableError(fmt.Errorf("creation of Role Assignment %q did not return an id value", name))
 		}
 
+		if !options.waitForReplication {
+			return nil
+		}
+
 		stateConf := &pluginsdk.StateChangeConf{
 			Pending: []string{
 				"pending",
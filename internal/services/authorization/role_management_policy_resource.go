@@ -0,0 +1,815 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicyassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	roleManagementPolicyRuleActivationApproval      = "Approval_EndUser_Assignment"
+	roleManagementPolicyRuleActivationEnablement    = "Enablement_EndUser_Assignment"
+	roleManagementPolicyRuleActivationExpiration    = "Expiration_EndUser_Assignment"
+	roleManagementPolicyRuleActiveEnablement        = "Enablement_Admin_Assignment"
+	roleManagementPolicyRuleActiveExpiration        = "Expiration_Admin_Assignment"
+	roleManagementPolicyRuleEligibleExpiration      = "Expiration_Admin_Eligibility"
+	roleManagementPolicyNotificationRuleIdFormatter = "Notification_%s_%s"
+)
+
+// roleManagementPolicyNotificationTargets maps each block within `notification_rules` to the suffix of the
+// ID of the Notification Rules for that type of assignment
+var roleManagementPolicyNotificationTargets = map[string]string{
+	"active_assignments":   "Admin_Assignment",
+	"eligible_activations": "EndUser_Assignment",
+	"eligible_assignments": "Admin_Eligibility",
+}
+
+// roleManagementPolicyNotificationRecipients maps each block within a Notification Target to the
+// Recipient Type used in the ID of the Notification Rule
+var roleManagementPolicyNotificationRecipients = map[string]string{
+	"admin_notifications":    string(rolemanagementpolicies.RecipientTypeAdmin),
+	"approver_notifications": string(rolemanagementpolicies.RecipientTypeApprover),
+	"assignee_notifications": string(rolemanagementpolicies.RecipientTypeRequestor),
+}
+
+func resourceRoleManagementPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRoleManagementPolicyCreate,
+		Read:   resourceRoleManagementPolicyRead,
+		Update: resourceRoleManagementPolicyUpdate,
+		Delete: resourceRoleManagementPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := rolemanagementpolicies.ParseRoleManagementPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scope": schemaPimScope(),
+
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"activation_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"maximum_duration": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"require_approval": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"approval_stage": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"primary_approver": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"object_id": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.IsUUID,
+												},
+
+												"type": {
+													Type:     pluginsdk.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(rolemanagementpolicies.UserTypeGroup),
+														string(rolemanagementpolicies.UserTypeUser),
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"require_justification": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_multifactor_authentication": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_ticket_info": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"active_assignment_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiration_required": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"require_justification": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_multifactor_authentication": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_ticket_info": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"eligible_assignment_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiration_required": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+					},
+				},
+			},
+
+			"notification_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"active_assignments":   schemaRoleManagementPolicyNotificationTarget(),
+						"eligible_activations": schemaRoleManagementPolicyNotificationTarget(),
+						"eligible_assignments": schemaRoleManagementPolicyNotificationTarget(),
+					},
+				},
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaRoleManagementPolicyNotificationTarget() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"admin_notifications":    schemaRoleManagementPolicyNotificationSettings(),
+				"approver_notifications": schemaRoleManagementPolicyNotificationSettings(),
+				"assignee_notifications": schemaRoleManagementPolicyNotificationSettings(),
+			},
+		},
+	}
+}
+
+func schemaRoleManagementPolicyNotificationSettings() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"notification_level": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(rolemanagementpolicies.NotificationLevelAll),
+						string(rolemanagementpolicies.NotificationLevelCritical),
+					}, false),
+				},
+
+				"default_recipients": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+
+				"additional_recipients": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRoleManagementPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	assignmentsClient := meta.(*clients.Client).Authorization.RoleManagementPolicyAssignmentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope := d.Get("scope").(string)
+	roleDefinitionId := d.Get("role_definition_id").(string)
+
+	// a Role Management Policy exists for every Role Definition at each Scope and can't be created or deleted,
+	// as such this resource manages the Rules within the existing Policy
+	assignment, err := findRoleManagementPolicyAssignment(ctx, assignmentsClient, scope, func(props rolemanagementpolicyassignments.RoleManagementPolicyAssignmentProperties) bool {
+		return props.RoleDefinitionId != nil && pimRoleDefinitionIdsMatch(*props.RoleDefinitionId, roleDefinitionId)
+	})
+	if err != nil {
+		return err
+	}
+	if assignment == nil || assignment.Properties.PolicyId == nil {
+		return fmt.Errorf("no Role Management Policy was found for the Role Definition %q at the Scope %q", roleDefinitionId, scope)
+	}
+
+	id, err := rolemanagementpolicies.ParseRoleManagementPolicyIDInsensitively(*assignment.Properties.PolicyId)
+	if err != nil {
+		return err
+	}
+
+	if err := updateRoleManagementPolicyRules(ctx, d, meta, *id); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceRoleManagementPolicyRead(d, meta)
+}
+
+func resourceRoleManagementPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleManagementPoliciesClient
+	assignmentsClient := meta.(*clients.Client).Authorization.RoleManagementPolicyAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rolemanagementpolicies.ParseRoleManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the Role Definition isn't exposed on the Policy, so is retrieved from the Assignment of this Policy
+	assignment, err := findRoleManagementPolicyAssignment(ctx, assignmentsClient, id.Scope, func(props rolemanagementpolicyassignments.RoleManagementPolicyAssignmentProperties) bool {
+		return props.PolicyId != nil && strings.EqualFold(*props.PolicyId, id.ID())
+	})
+	if err != nil {
+		return err
+	}
+	if assignment == nil {
+		log.Printf("[DEBUG] no Assignment was found for %s - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("scope", id.Scope)
+	d.Set("name", id.RoleManagementPolicyName)
+
+	roleDefinitionId := utils.NormalizeNilableString(assignment.Properties.RoleDefinitionId)
+	if existing := d.Get("role_definition_id").(string); existing != "" && pimRoleDefinitionIdsMatch(existing, roleDefinitionId) {
+		// retain the format of the Role Definition ID specified in the configuration
+		roleDefinitionId = existing
+	}
+	d.Set("role_definition_id", roleDefinitionId)
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		d.Set("description", props.Description)
+
+		rules := make([]rolemanagementpolicies.RoleManagementPolicyRule, 0)
+		if props.Rules != nil {
+			rules = *props.Rules
+		}
+
+		if err := d.Set("activation_rules", flattenRoleManagementPolicyActivationRules(rules)); err != nil {
+			return fmt.Errorf("setting `activation_rules`: %+v", err)
+		}
+		if err := d.Set("active_assignment_rules", flattenRoleManagementPolicyActiveAssignmentRules(rules)); err != nil {
+			return fmt.Errorf("setting `active_assignment_rules`: %+v", err)
+		}
+		if err := d.Set("eligible_assignment_rules", flattenRoleManagementPolicyEligibleAssignmentRules(rules)); err != nil {
+			return fmt.Errorf("setting `eligible_assignment_rules`: %+v", err)
+		}
+		if err := d.Set("notification_rules", flattenRoleManagementPolicyNotificationRules(rules)); err != nil {
+			return fmt.Errorf("setting `notification_rules`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceRoleManagementPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rolemanagementpolicies.ParseRoleManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := updateRoleManagementPolicyRules(ctx, d, meta, *id); err != nil {
+		return err
+	}
+
+	return resourceRoleManagementPolicyRead(d, meta)
+}
+
+func resourceRoleManagementPolicyDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	id, err := rolemanagementpolicies.ParseRoleManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Role Management Policies can't be deleted, so the Rules are left as-is
+	log.Printf("[DEBUG] %s can't be deleted - removing from state only", *id)
+	return nil
+}
+
+func findRoleManagementPolicyAssignment(ctx context.Context, client *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient, scope string, matches func(props rolemanagementpolicyassignments.RoleManagementPolicyAssignmentProperties) bool) (*rolemanagementpolicyassignments.RoleManagementPolicyAssignment, error) {
+	scopeId := rolemanagementpolicyassignments.NewScopeID(scope)
+	resp, err := client.ListForScopeComplete(ctx, scopeId, rolemanagementpolicyassignments.DefaultListForScopeOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Management Policy Assignments for %s: %+v", scopeId, err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || props.Scope == nil || !strings.EqualFold(*props.Scope, scope) {
+			continue
+		}
+
+		if matches(*props) {
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// updateRoleManagementPolicyRules updates the Rules within the Role Management Policy which have changed,
+// since the API requires that the existing Target of each Rule is sent
+func updateRoleManagementPolicyRules(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id rolemanagementpolicies.RoleManagementPolicyId) error {
+	client := meta.(*clients.Client).Authorization.RoleManagementPoliciesClient
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.Rules == nil {
+		return fmt.Errorf("retrieving %s: `properties.rules` was nil", id)
+	}
+
+	changed := make([]rolemanagementpolicies.RoleManagementPolicyRule, 0)
+	for _, rule := range *existing.Model.Properties.Rules {
+		var updated rolemanagementpolicies.RoleManagementPolicyRule
+		switch v := rule.(type) {
+		case rolemanagementpolicies.RoleManagementPolicyApprovalRule:
+			updated = expandRoleManagementPolicyApprovalRule(d, v)
+		case rolemanagementpolicies.RoleManagementPolicyEnablementRule:
+			updated = expandRoleManagementPolicyEnablementRule(d, v)
+		case rolemanagementpolicies.RoleManagementPolicyExpirationRule:
+			updated = expandRoleManagementPolicyExpirationRule(d, v)
+		case rolemanagementpolicies.RoleManagementPolicyNotificationRule:
+			updated = expandRoleManagementPolicyNotificationRule(d, v)
+		}
+
+		if updated != nil {
+			changed = append(changed, updated)
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	payload := rolemanagementpolicies.RoleManagementPolicy{
+		Properties: &rolemanagementpolicies.RoleManagementPolicyProperties{
+			Rules: &changed,
+		},
+	}
+	if _, err := client.Update(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandRoleManagementPolicyApprovalRule(d *pluginsdk.ResourceData, rule rolemanagementpolicies.RoleManagementPolicyApprovalRule) rolemanagementpolicies.RoleManagementPolicyRule {
+	if utils.NormalizeNilableString(rule.Id) != roleManagementPolicyRuleActivationApproval {
+		return nil
+	}
+	if !d.HasChanges("activation_rules.0.require_approval", "activation_rules.0.approval_stage") {
+		return nil
+	}
+
+	if rule.Setting == nil {
+		rule.Setting = &rolemanagementpolicies.ApprovalSettings{}
+	}
+	rule.Setting.IsApprovalRequired = utils.Bool(d.Get("activation_rules.0.require_approval").(bool))
+
+	stages := d.Get("activation_rules.0.approval_stage").([]interface{})
+	if len(stages) == 0 || stages[0] == nil {
+		rule.Setting.ApprovalStages = &[]rolemanagementpolicies.ApprovalStage{}
+		return rule
+	}
+
+	// the remaining settings for the Approval Stage aren't exposed, so any existing values are retained
+	stage := rolemanagementpolicies.ApprovalStage{
+		ApprovalStageTimeOutInDays:      utils.Int64(1),
+		IsApproverJustificationRequired: utils.Bool(true),
+		EscalationTimeInMinutes:         utils.Int64(0),
+		IsEscalationEnabled:             utils.Bool(false),
+	}
+	if rule.Setting.ApprovalStages != nil && len(*rule.Setting.ApprovalStages) > 0 {
+		stage = (*rule.Setting.ApprovalStages)[0]
+	}
+
+	approvers := make([]rolemanagementpolicies.UserSet, 0)
+	for _, raw := range stages[0].(map[string]interface{})["primary_approver"].(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		userType := rolemanagementpolicies.UserType(v["type"].(string))
+		approvers = append(approvers, rolemanagementpolicies.UserSet{
+			Id:       utils.String(v["object_id"].(string)),
+			IsBackup: utils.Bool(false),
+			UserType: &userType,
+		})
+	}
+	stage.PrimaryApprovers = &approvers
+
+	rule.Setting.ApprovalStages = &[]rolemanagementpolicies.ApprovalStage{stage}
+	mode := rolemanagementpolicies.ApprovalModeSingleStage
+	rule.Setting.ApprovalMode = &mode
+
+	return rule
+}
+
+func expandRoleManagementPolicyEnablementRule(d *pluginsdk.ResourceData, rule rolemanagementpolicies.RoleManagementPolicyEnablementRule) rolemanagementpolicies.RoleManagementPolicyRule {
+	var block string
+	switch utils.NormalizeNilableString(rule.Id) {
+	case roleManagementPolicyRuleActivationEnablement:
+		block = "activation_rules"
+	case roleManagementPolicyRuleActiveEnablement:
+		block = "active_assignment_rules"
+	default:
+		return nil
+	}
+
+	fields := map[string]rolemanagementpolicies.EnablementRules{
+		"require_justification":              rolemanagementpolicies.EnablementRulesJustification,
+		"require_multifactor_authentication": rolemanagementpolicies.EnablementRulesMultiFactorAuthentication,
+		"require_ticket_info":                rolemanagementpolicies.EnablementRulesTicketing,
+	}
+	if !d.HasChanges(fmt.Sprintf("%s.0.require_justification", block), fmt.Sprintf("%s.0.require_multifactor_authentication", block), fmt.Sprintf("%s.0.require_ticket_info", block)) {
+		return nil
+	}
+
+	enabled := make([]rolemanagementpolicies.EnablementRules, 0)
+	for _, field := range []string{"require_justification", "require_multifactor_authentication", "require_ticket_info"} {
+		if d.Get(fmt.Sprintf("%s.0.%s", block, field)).(bool) {
+			enabled = append(enabled, fields[field])
+		}
+	}
+	rule.EnabledRules = &enabled
+
+	return rule
+}
+
+func expandRoleManagementPolicyExpirationRule(d *pluginsdk.ResourceData, rule rolemanagementpolicies.RoleManagementPolicyExpirationRule) rolemanagementpolicies.RoleManagementPolicyRule {
+	switch utils.NormalizeNilableString(rule.Id) {
+	case roleManagementPolicyRuleActivationExpiration:
+		if !d.HasChange("activation_rules.0.maximum_duration") {
+			return nil
+		}
+		rule.MaximumDuration = utils.String(d.Get("activation_rules.0.maximum_duration").(string))
+		return rule
+
+	case roleManagementPolicyRuleActiveExpiration, roleManagementPolicyRuleEligibleExpiration:
+		block := "active_assignment_rules"
+		if utils.NormalizeNilableString(rule.Id) == roleManagementPolicyRuleEligibleExpiration {
+			block = "eligible_assignment_rules"
+		}
+		if !d.HasChanges(fmt.Sprintf("%s.0.expiration_required", block), fmt.Sprintf("%s.0.expire_after", block)) {
+			return nil
+		}
+
+		rule.IsExpirationRequired = utils.Bool(d.Get(fmt.Sprintf("%s.0.expiration_required", block)).(bool))
+		if v := d.Get(fmt.Sprintf("%s.0.expire_after", block)).(string); v != "" {
+			rule.MaximumDuration = utils.String(v)
+		}
+		return rule
+	}
+
+	return nil
+}
+
+func expandRoleManagementPolicyNotificationRule(d *pluginsdk.ResourceData, rule rolemanagementpolicies.RoleManagementPolicyNotificationRule) rolemanagementpolicies.RoleManagementPolicyRule {
+	for target, targetSuffix := range roleManagementPolicyNotificationTargets {
+		for recipient, recipientType := range roleManagementPolicyNotificationRecipients {
+			if utils.NormalizeNilableString(rule.Id) != fmt.Sprintf(roleManagementPolicyNotificationRuleIdFormatter, recipientType, targetSuffix) {
+				continue
+			}
+
+			path := fmt.Sprintf("notification_rules.0.%s.0.%s", target, recipient)
+			if !d.HasChange(path) {
+				return nil
+			}
+
+			settings := d.Get(path).([]interface{})
+			if len(settings) == 0 || settings[0] == nil {
+				return nil
+			}
+			raw := settings[0].(map[string]interface{})
+
+			level := rolemanagementpolicies.NotificationLevel(raw["notification_level"].(string))
+			rule.NotificationLevel = &level
+			rule.IsDefaultRecipientsEnabled = utils.Bool(raw["default_recipients"].(bool))
+			rule.NotificationRecipients = utils.ExpandStringSlice(raw["additional_recipients"].(*pluginsdk.Set).List())
+
+			return rule
+		}
+	}
+
+	return nil
+}
+
+func flattenRoleManagementPolicyActivationRules(rules []rolemanagementpolicies.RoleManagementPolicyRule) []interface{} {
+	output := map[string]interface{}{
+		"maximum_duration":                   "",
+		"require_approval":                   false,
+		"approval_stage":                     []interface{}{},
+		"require_justification":              false,
+		"require_multifactor_authentication": false,
+		"require_ticket_info":                false,
+	}
+
+	for _, rule := range rules {
+		switch v := rule.(type) {
+		case rolemanagementpolicies.RoleManagementPolicyApprovalRule:
+			if utils.NormalizeNilableString(v.Id) != roleManagementPolicyRuleActivationApproval || v.Setting == nil {
+				continue
+			}
+			if v.Setting.IsApprovalRequired != nil {
+				output["require_approval"] = *v.Setting.IsApprovalRequired
+			}
+			output["approval_stage"] = flattenRoleManagementPolicyApprovalStages(v.Setting.ApprovalStages)
+
+		case rolemanagementpolicies.RoleManagementPolicyEnablementRule:
+			if utils.NormalizeNilableString(v.Id) != roleManagementPolicyRuleActivationEnablement {
+				continue
+			}
+			for field, value := range flattenRoleManagementPolicyEnablementRules(v.EnabledRules) {
+				output[field] = value
+			}
+
+		case rolemanagementpolicies.RoleManagementPolicyExpirationRule:
+			if utils.NormalizeNilableString(v.Id) != roleManagementPolicyRuleActivationExpiration {
+				continue
+			}
+			output["maximum_duration"] = utils.NormalizeNilableString(v.MaximumDuration)
+		}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenRoleManagementPolicyActiveAssignmentRules(rules []rolemanagementpolicies.RoleManagementPolicyRule) []interface{} {
+	output := map[string]interface{}{
+		"expiration_required":                false,
+		"expire_after":                       "",
+		"require_justification":              false,
+		"require_multifactor_authentication": false,
+		"require_ticket_info":                false,
+	}
+
+	for _, rule := range rules {
+		switch v := rule.(type) {
+		case rolemanagementpolicies.RoleManagementPolicyEnablementRule:
+			if utils.NormalizeNilableString(v.Id) != roleManagementPolicyRuleActiveEnablement {
+				continue
+			}
+			for field, value := range flattenRoleManagementPolicyEnablementRules(v.EnabledRules) {
+				output[field] = value
+			}
+
+		case rolemanagementpolicies.RoleManagementPolicyExpirationRule:
+			if utils.NormalizeNilableString(v.Id) != roleManagementPolicyRuleActiveExpiration {
+				continue
+			}
+			if v.IsExpirationRequired != nil {
+				output["expiration_required"] = *v.IsExpirationRequired
+			}
+			output["expire_after"] = utils.NormalizeNilableString(v.MaximumDuration)
+		}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenRoleManagementPolicyEligibleAssignmentRules(rules []rolemanagementpolicies.RoleManagementPolicyRule) []interface{} {
+	output := map[string]interface{}{
+		"expiration_required": false,
+		"expire_after":        "",
+	}
+
+	for _, rule := range rules {
+		if v, ok := rule.(rolemanagementpolicies.RoleManagementPolicyExpirationRule); ok && utils.NormalizeNilableString(v.Id) == roleManagementPolicyRuleEligibleExpiration {
+			if v.IsExpirationRequired != nil {
+				output["expiration_required"] = *v.IsExpirationRequired
+			}
+			output["expire_after"] = utils.NormalizeNilableString(v.MaximumDuration)
+		}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenRoleManagementPolicyNotificationRules(rules []rolemanagementpolicies.RoleManagementPolicyRule) []interface{} {
+	notificationRules := make(map[string]rolemanagementpolicies.RoleManagementPolicyNotificationRule)
+	for _, rule := range rules {
+		if v, ok := rule.(rolemanagementpolicies.RoleManagementPolicyNotificationRule); ok && v.Id != nil {
+			notificationRules[*v.Id] = v
+		}
+	}
+
+	output := make(map[string]interface{})
+	for target, targetSuffix := range roleManagementPolicyNotificationTargets {
+		recipients := make(map[string]interface{})
+		for recipient, recipientType := range roleManagementPolicyNotificationRecipients {
+			rule, ok := notificationRules[fmt.Sprintf(roleManagementPolicyNotificationRuleIdFormatter, recipientType, targetSuffix)]
+			if !ok {
+				recipients[recipient] = []interface{}{}
+				continue
+			}
+
+			level := ""
+			if rule.NotificationLevel != nil {
+				level = string(*rule.NotificationLevel)
+			}
+			defaultRecipients := false
+			if rule.IsDefaultRecipientsEnabled != nil {
+				defaultRecipients = *rule.IsDefaultRecipientsEnabled
+			}
+
+			recipients[recipient] = []interface{}{
+				map[string]interface{}{
+					"notification_level":    level,
+					"default_recipients":    defaultRecipients,
+					"additional_recipients": utils.FlattenStringSlice(rule.NotificationRecipients),
+				},
+			}
+		}
+		output[target] = []interface{}{recipients}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenRoleManagementPolicyApprovalStages(input *[]rolemanagementpolicies.ApprovalStage) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}
+	}
+
+	approvers := make([]interface{}, 0)
+	if stage := (*input)[0]; stage.PrimaryApprovers != nil {
+		for _, approver := range *stage.PrimaryApprovers {
+			userType := ""
+			if approver.UserType != nil {
+				userType = string(*approver.UserType)
+			}
+			approvers = append(approvers, map[string]interface{}{
+				"object_id": utils.NormalizeNilableString(approver.Id),
+				"type":      userType,
+			})
+		}
+	}
+	if len(approvers) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"primary_approver": approvers,
+		},
+	}
+}
+
+func flattenRoleManagementPolicyEnablementRules(input *[]rolemanagementpolicies.EnablementRules) map[string]interface{} {
+	output := map[string]interface{}{
+		"require_justification":              false,
+		"require_multifactor_authentication": false,
+		"require_ticket_info":                false,
+	}
+	if input == nil {
+		return output
+	}
+
+	for _, rule := range *input {
+		switch rule {
+		case rolemanagementpolicies.EnablementRulesJustification:
+			output["require_justification"] = true
+		case rolemanagementpolicies.EnablementRulesMultiFactorAuthentication:
+			output["require_multifactor_authentication"] = true
+		case rolemanagementpolicies.EnablementRulesTicketing:
+			output["require_ticket_info"] = true
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,15 @@
+package roleassignmentschedulerequests
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleAssignmentScheduleRequestsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoleAssignmentScheduleRequestsClientWithBaseURI(endpoint string) RoleAssignmentScheduleRequestsClient {
+	return RoleAssignmentScheduleRequestsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,110 @@
+package roleassignmentschedulerequests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RoleAssignmentScheduleRequestId{}
+
+// RoleAssignmentScheduleRequestId is a struct representing the Resource ID for a Role Assignment Schedule Request
+type RoleAssignmentScheduleRequestId struct {
+	Scope                             string
+	RoleAssignmentScheduleRequestName string
+}
+
+// NewRoleAssignmentScheduleRequestID returns a new RoleAssignmentScheduleRequestId struct
+func NewRoleAssignmentScheduleRequestID(scope string, roleAssignmentScheduleRequestName string) RoleAssignmentScheduleRequestId {
+	return RoleAssignmentScheduleRequestId{
+		Scope:                             scope,
+		RoleAssignmentScheduleRequestName: roleAssignmentScheduleRequestName,
+	}
+}
+
+// ParseRoleAssignmentScheduleRequestID parses 'input' into a RoleAssignmentScheduleRequestId
+func ParseRoleAssignmentScheduleRequestID(input string) (*RoleAssignmentScheduleRequestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleAssignmentScheduleRequestId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleAssignmentScheduleRequestId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleAssignmentScheduleRequestName, ok = parsed.Parsed["roleAssignmentScheduleRequestName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleAssignmentScheduleRequestName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRoleAssignmentScheduleRequestIDInsensitively parses 'input' case-insensitively into a RoleAssignmentScheduleRequestId
+// note: this method should only be used for API response data and not user input
+func ParseRoleAssignmentScheduleRequestIDInsensitively(input string) (*RoleAssignmentScheduleRequestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleAssignmentScheduleRequestId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleAssignmentScheduleRequestId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleAssignmentScheduleRequestName, ok = parsed.Parsed["roleAssignmentScheduleRequestName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleAssignmentScheduleRequestName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRoleAssignmentScheduleRequestID checks that 'input' can be parsed as a Role Assignment Schedule Request ID
+func ValidateRoleAssignmentScheduleRequestID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRoleAssignmentScheduleRequestID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Role Assignment Schedule Request ID
+func (id RoleAssignmentScheduleRequestId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/roleAssignmentScheduleRequests/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.RoleAssignmentScheduleRequestName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Role Assignment Schedule Request ID
+func (id RoleAssignmentScheduleRequestId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticRoleAssignmentScheduleRequests", "roleAssignmentScheduleRequests", "roleAssignmentScheduleRequests"),
+		resourceids.UserSpecifiedSegment("roleAssignmentScheduleRequestName", "roleAssignmentScheduleRequestValue"),
+	}
+}
+
+// String returns a human-readable description of this Role Assignment Schedule Request ID
+func (id RoleAssignmentScheduleRequestId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Role Assignment Schedule Request Name: %q", id.RoleAssignmentScheduleRequestName),
+	}
+	return fmt.Sprintf("Role Assignment Schedule Request (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,6 @@
+package roleassignmentschedulerequests
+
+type RoleAssignmentScheduleRequestPropertiesTicketInfo struct {
+	TicketNumber *string `json:"ticketNumber,omitempty"`
+	TicketSystem *string `json:"ticketSystem,omitempty"`
+}
@@ -0,0 +1,15 @@
+package roleassignmentschedules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleAssignmentSchedulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoleAssignmentSchedulesClientWithBaseURI(endpoint string) RoleAssignmentSchedulesClient {
+	return RoleAssignmentSchedulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,110 @@
+package roleassignmentschedules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RoleAssignmentScheduleId{}
+
+// RoleAssignmentScheduleId is a struct representing the Resource ID for a Role Assignment Schedule
+type RoleAssignmentScheduleId struct {
+	Scope                      string
+	RoleAssignmentScheduleName string
+}
+
+// NewRoleAssignmentScheduleID returns a new RoleAssignmentScheduleId struct
+func NewRoleAssignmentScheduleID(scope string, roleAssignmentScheduleName string) RoleAssignmentScheduleId {
+	return RoleAssignmentScheduleId{
+		Scope:                      scope,
+		RoleAssignmentScheduleName: roleAssignmentScheduleName,
+	}
+}
+
+// ParseRoleAssignmentScheduleID parses 'input' into a RoleAssignmentScheduleId
+func ParseRoleAssignmentScheduleID(input string) (*RoleAssignmentScheduleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleAssignmentScheduleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleAssignmentScheduleId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleAssignmentScheduleName, ok = parsed.Parsed["roleAssignmentScheduleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleAssignmentScheduleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRoleAssignmentScheduleIDInsensitively parses 'input' case-insensitively into a RoleAssignmentScheduleId
+// note: this method should only be used for API response data and not user input
+func ParseRoleAssignmentScheduleIDInsensitively(input string) (*RoleAssignmentScheduleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleAssignmentScheduleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleAssignmentScheduleId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleAssignmentScheduleName, ok = parsed.Parsed["roleAssignmentScheduleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleAssignmentScheduleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRoleAssignmentScheduleID checks that 'input' can be parsed as a Role Assignment Schedule ID
+func ValidateRoleAssignmentScheduleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRoleAssignmentScheduleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Role Assignment Schedule ID
+func (id RoleAssignmentScheduleId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/roleAssignmentSchedules/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.RoleAssignmentScheduleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Role Assignment Schedule ID
+func (id RoleAssignmentScheduleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticRoleAssignmentSchedules", "roleAssignmentSchedules", "roleAssignmentSchedules"),
+		resourceids.UserSpecifiedSegment("roleAssignmentScheduleName", "roleAssignmentScheduleValue"),
+	}
+}
+
+// String returns a human-readable description of this Role Assignment Schedule ID
+func (id RoleAssignmentScheduleId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Role Assignment Schedule Name: %q", id.RoleAssignmentScheduleName),
+	}
+	return fmt.Sprintf("Role Assignment Schedule (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,205 @@
+package roleassignmentschedules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListForScopeResponse struct {
+	HttpResponse *http.Response
+	Model        *[]RoleAssignmentSchedule
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ListForScopeResponse, error)
+}
+
+type ListForScopeCompleteResult struct {
+	Items []RoleAssignmentSchedule
+}
+
+func (r ListForScopeResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ListForScopeResponse) LoadMore(ctx context.Context) (resp ListForScopeResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+type ListForScopeOptions struct {
+	Filter *string
+}
+
+func DefaultListForScopeOptions() ListForScopeOptions {
+	return ListForScopeOptions{}
+}
+
+func (o ListForScopeOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.Filter != nil {
+		out["$filter"] = *o.Filter
+	}
+
+	return out
+}
+
+// ListForScope ...
+func (c RoleAssignmentSchedulesClient) ListForScope(ctx context.Context, id ScopeId, options ListForScopeOptions) (resp ListForScopeResponse, err error) {
+	req, err := c.preparerForListForScope(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignmentschedules.RoleAssignmentSchedulesClient", "ListForScope", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignmentschedules.RoleAssignmentSchedulesClient", "ListForScope", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForListForScope(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignmentschedules.RoleAssignmentSchedulesClient", "ListForScope", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ListForScopeComplete retrieves all of the results into a single object
+func (c RoleAssignmentSchedulesClient) ListForScopeComplete(ctx context.Context, id ScopeId, options ListForScopeOptions) (ListForScopeCompleteResult, error) {
+	return c.ListForScopeCompleteMatchingPredicate(ctx, id, options, RoleAssignmentSchedulePredicate{})
+}
+
+// ListForScopeCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c RoleAssignmentSchedulesClient) ListForScopeCompleteMatchingPredicate(ctx context.Context, id ScopeId, options ListForScopeOptions, predicate RoleAssignmentSchedulePredicate) (resp ListForScopeCompleteResult, err error) {
+	items := make([]RoleAssignmentSchedule, 0)
+
+	page, err := c.ListForScope(ctx, id, options)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ListForScopeCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForListForScope prepares the ListForScope request.
+func (c RoleAssignmentSchedulesClient) preparerForListForScope(ctx context.Context, id ScopeId, options ListForScopeOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/providers/Microsoft.Authorization/roleAssignmentSchedules", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListForScopeWithNextLink prepares the ListForScope request with the given nextLink token.
+func (c RoleAssignmentSchedulesClient) preparerForListForScopeWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListForScope handles the response to the ListForScope request. The method always
+// closes the http.Response Body.
+func (c RoleAssignmentSchedulesClient) responderForListForScope(resp *http.Response) (result ListForScopeResponse, err error) {
+	type page struct {
+		Values   []RoleAssignmentSchedule `json:"value"`
+		NextLink *string                  `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ListForScopeResponse, err error) {
+			req, err := c.preparerForListForScopeWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "roleassignmentschedules.RoleAssignmentSchedulesClient", "ListForScope", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "roleassignmentschedules.RoleAssignmentSchedulesClient", "ListForScope", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForListForScope(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "roleassignmentschedules.RoleAssignmentSchedulesClient", "ListForScope", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,110 @@
+package roleeligibilityschedulerequests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RoleEligibilityScheduleRequestId{}
+
+// RoleEligibilityScheduleRequestId is a struct representing the Resource ID for a Role Eligibility Schedule Request
+type RoleEligibilityScheduleRequestId struct {
+	Scope                              string
+	RoleEligibilityScheduleRequestName string
+}
+
+// NewRoleEligibilityScheduleRequestID returns a new RoleEligibilityScheduleRequestId struct
+func NewRoleEligibilityScheduleRequestID(scope string, roleEligibilityScheduleRequestName string) RoleEligibilityScheduleRequestId {
+	return RoleEligibilityScheduleRequestId{
+		Scope:                              scope,
+		RoleEligibilityScheduleRequestName: roleEligibilityScheduleRequestName,
+	}
+}
+
+// ParseRoleEligibilityScheduleRequestID parses 'input' into a RoleEligibilityScheduleRequestId
+func ParseRoleEligibilityScheduleRequestID(input string) (*RoleEligibilityScheduleRequestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleEligibilityScheduleRequestId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleEligibilityScheduleRequestId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleEligibilityScheduleRequestName, ok = parsed.Parsed["roleEligibilityScheduleRequestName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleEligibilityScheduleRequestName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRoleEligibilityScheduleRequestIDInsensitively parses 'input' case-insensitively into a RoleEligibilityScheduleRequestId
+// note: this method should only be used for API response data and not user input
+func ParseRoleEligibilityScheduleRequestIDInsensitively(input string) (*RoleEligibilityScheduleRequestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleEligibilityScheduleRequestId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleEligibilityScheduleRequestId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleEligibilityScheduleRequestName, ok = parsed.Parsed["roleEligibilityScheduleRequestName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleEligibilityScheduleRequestName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRoleEligibilityScheduleRequestID checks that 'input' can be parsed as a Role Eligibility Schedule Request ID
+func ValidateRoleEligibilityScheduleRequestID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRoleEligibilityScheduleRequestID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Role Eligibility Schedule Request ID
+func (id RoleEligibilityScheduleRequestId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/roleEligibilityScheduleRequests/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.RoleEligibilityScheduleRequestName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Role Eligibility Schedule Request ID
+func (id RoleEligibilityScheduleRequestId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticRoleEligibilityScheduleRequests", "roleEligibilityScheduleRequests", "roleEligibilityScheduleRequests"),
+		resourceids.UserSpecifiedSegment("roleEligibilityScheduleRequestName", "roleEligibilityScheduleRequestValue"),
+	}
+}
+
+// String returns a human-readable description of this Role Eligibility Schedule Request ID
+func (id RoleEligibilityScheduleRequestId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Role Eligibility Schedule Request Name: %q", id.RoleEligibilityScheduleRequestName),
+	}
+	return fmt.Sprintf("Role Eligibility Schedule Request (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package roleeligibilityschedulerequests
+
+type RoleEligibilityScheduleRequest struct {
+	Id         *string                                   `json:"id,omitempty"`
+	Name       *string                                   `json:"name,omitempty"`
+	Properties *RoleEligibilityScheduleRequestProperties `json:"properties,omitempty"`
+	Type       *string                                   `json:"type,omitempty"`
+}
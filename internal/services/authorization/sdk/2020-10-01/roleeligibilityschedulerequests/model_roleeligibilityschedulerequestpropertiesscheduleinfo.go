@@ -0,0 +1,24 @@
+package roleeligibilityschedulerequests
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type RoleEligibilityScheduleRequestPropertiesScheduleInfo struct {
+	Expiration    *RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration `json:"expiration,omitempty"`
+	StartDateTime *string                                                         `json:"startDateTime,omitempty"`
+}
+
+func (o RoleEligibilityScheduleRequestPropertiesScheduleInfo) GetStartDateTimeAsTime() (*time.Time, error) {
+	if o.StartDateTime == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.StartDateTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o RoleEligibilityScheduleRequestPropertiesScheduleInfo) SetStartDateTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.StartDateTime = &formatted
+}
@@ -0,0 +1,25 @@
+package roleeligibilityschedulerequests
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration struct {
+	Duration    *string `json:"duration,omitempty"`
+	EndDateTime *string `json:"endDateTime,omitempty"`
+	Type        *Type   `json:"type,omitempty"`
+}
+
+func (o RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration) GetEndDateTimeAsTime() (*time.Time, error) {
+	if o.EndDateTime == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.EndDateTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration) SetEndDateTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.EndDateTime = &formatted
+}
@@ -0,0 +1,9 @@
+package roleeligibilityschedulerequests
+
+import "fmt"
+
+const defaultApiVersion = "2020-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/roleeligibilityschedulerequests/%s", defaultApiVersion)
+}
@@ -0,0 +1,110 @@
+package roleeligibilityschedules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RoleEligibilityScheduleId{}
+
+// RoleEligibilityScheduleId is a struct representing the Resource ID for a Role Eligibility Schedule
+type RoleEligibilityScheduleId struct {
+	Scope                       string
+	RoleEligibilityScheduleName string
+}
+
+// NewRoleEligibilityScheduleID returns a new RoleEligibilityScheduleId struct
+func NewRoleEligibilityScheduleID(scope string, roleEligibilityScheduleName string) RoleEligibilityScheduleId {
+	return RoleEligibilityScheduleId{
+		Scope:                       scope,
+		RoleEligibilityScheduleName: roleEligibilityScheduleName,
+	}
+}
+
+// ParseRoleEligibilityScheduleID parses 'input' into a RoleEligibilityScheduleId
+func ParseRoleEligibilityScheduleID(input string) (*RoleEligibilityScheduleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleEligibilityScheduleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleEligibilityScheduleId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleEligibilityScheduleName, ok = parsed.Parsed["roleEligibilityScheduleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleEligibilityScheduleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRoleEligibilityScheduleIDInsensitively parses 'input' case-insensitively into a RoleEligibilityScheduleId
+// note: this method should only be used for API response data and not user input
+func ParseRoleEligibilityScheduleIDInsensitively(input string) (*RoleEligibilityScheduleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleEligibilityScheduleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleEligibilityScheduleId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleEligibilityScheduleName, ok = parsed.Parsed["roleEligibilityScheduleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleEligibilityScheduleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRoleEligibilityScheduleID checks that 'input' can be parsed as a Role Eligibility Schedule ID
+func ValidateRoleEligibilityScheduleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRoleEligibilityScheduleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Role Eligibility Schedule ID
+func (id RoleEligibilityScheduleId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/roleEligibilitySchedules/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.RoleEligibilityScheduleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Role Eligibility Schedule ID
+func (id RoleEligibilityScheduleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticRoleEligibilitySchedules", "roleEligibilitySchedules", "roleEligibilitySchedules"),
+		resourceids.UserSpecifiedSegment("roleEligibilityScheduleName", "roleEligibilityScheduleValue"),
+	}
+}
+
+// String returns a human-readable description of this Role Eligibility Schedule ID
+func (id RoleEligibilityScheduleId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Role Eligibility Schedule Name: %q", id.RoleEligibilityScheduleName),
+	}
+	return fmt.Sprintf("Role Eligibility Schedule (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package roleeligibilityschedules
+
+type RoleEligibilitySchedule struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *RoleEligibilityScheduleProperties `json:"properties,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
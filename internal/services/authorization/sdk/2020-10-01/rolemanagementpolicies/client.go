@@ -0,0 +1,15 @@
+package rolemanagementpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleManagementPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoleManagementPoliciesClientWithBaseURI(endpoint string) RoleManagementPoliciesClient {
+	return RoleManagementPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,220 @@
+package rolemanagementpolicies
+
+import "strings"
+
+type ApprovalMode string
+
+const (
+	ApprovalModeNoApproval  ApprovalMode = "NoApproval"
+	ApprovalModeParallel    ApprovalMode = "Parallel"
+	ApprovalModeSerial      ApprovalMode = "Serial"
+	ApprovalModeSingleStage ApprovalMode = "SingleStage"
+)
+
+func PossibleValuesForApprovalMode() []string {
+	return []string{
+		string(ApprovalModeNoApproval),
+		string(ApprovalModeParallel),
+		string(ApprovalModeSerial),
+		string(ApprovalModeSingleStage),
+	}
+}
+
+func parseApprovalMode(input string) (*ApprovalMode, error) {
+	vals := map[string]ApprovalMode{
+		"noapproval":  ApprovalModeNoApproval,
+		"parallel":    ApprovalModeParallel,
+		"serial":      ApprovalModeSerial,
+		"singlestage": ApprovalModeSingleStage,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ApprovalMode(input)
+	return &out, nil
+}
+
+type EnablementRules string
+
+const (
+	EnablementRulesJustification             EnablementRules = "Justification"
+	EnablementRulesMultiFactorAuthentication EnablementRules = "MultiFactorAuthentication"
+	EnablementRulesTicketing                 EnablementRules = "Ticketing"
+)
+
+func PossibleValuesForEnablementRules() []string {
+	return []string{
+		string(EnablementRulesJustification),
+		string(EnablementRulesMultiFactorAuthentication),
+		string(EnablementRulesTicketing),
+	}
+}
+
+func parseEnablementRules(input string) (*EnablementRules, error) {
+	vals := map[string]EnablementRules{
+		"justification":             EnablementRulesJustification,
+		"multifactorauthentication": EnablementRulesMultiFactorAuthentication,
+		"ticketing":                 EnablementRulesTicketing,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EnablementRules(input)
+	return &out, nil
+}
+
+type NotificationDeliveryMechanism string
+
+const (
+	NotificationDeliveryMechanismEmail NotificationDeliveryMechanism = "Email"
+)
+
+func PossibleValuesForNotificationDeliveryMechanism() []string {
+	return []string{
+		string(NotificationDeliveryMechanismEmail),
+	}
+}
+
+func parseNotificationDeliveryMechanism(input string) (*NotificationDeliveryMechanism, error) {
+	vals := map[string]NotificationDeliveryMechanism{
+		"email": NotificationDeliveryMechanismEmail,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NotificationDeliveryMechanism(input)
+	return &out, nil
+}
+
+type NotificationLevel string
+
+const (
+	NotificationLevelAll      NotificationLevel = "All"
+	NotificationLevelCritical NotificationLevel = "Critical"
+	NotificationLevelNone     NotificationLevel = "None"
+)
+
+func PossibleValuesForNotificationLevel() []string {
+	return []string{
+		string(NotificationLevelAll),
+		string(NotificationLevelCritical),
+		string(NotificationLevelNone),
+	}
+}
+
+func parseNotificationLevel(input string) (*NotificationLevel, error) {
+	vals := map[string]NotificationLevel{
+		"all":      NotificationLevelAll,
+		"critical": NotificationLevelCritical,
+		"none":     NotificationLevelNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NotificationLevel(input)
+	return &out, nil
+}
+
+type RecipientType string
+
+const (
+	RecipientTypeAdmin     RecipientType = "Admin"
+	RecipientTypeApprover  RecipientType = "Approver"
+	RecipientTypeRequestor RecipientType = "Requestor"
+)
+
+func PossibleValuesForRecipientType() []string {
+	return []string{
+		string(RecipientTypeAdmin),
+		string(RecipientTypeApprover),
+		string(RecipientTypeRequestor),
+	}
+}
+
+func parseRecipientType(input string) (*RecipientType, error) {
+	vals := map[string]RecipientType{
+		"admin":     RecipientTypeAdmin,
+		"approver":  RecipientTypeApprover,
+		"requestor": RecipientTypeRequestor,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RecipientType(input)
+	return &out, nil
+}
+
+type RoleManagementPolicyRuleType string
+
+const (
+	RoleManagementPolicyRuleTypeRoleManagementPolicyApprovalRule              RoleManagementPolicyRuleType = "RoleManagementPolicyApprovalRule"
+	RoleManagementPolicyRuleTypeRoleManagementPolicyAuthenticationContextRule RoleManagementPolicyRuleType = "RoleManagementPolicyAuthenticationContextRule"
+	RoleManagementPolicyRuleTypeRoleManagementPolicyEnablementRule            RoleManagementPolicyRuleType = "RoleManagementPolicyEnablementRule"
+	RoleManagementPolicyRuleTypeRoleManagementPolicyExpirationRule            RoleManagementPolicyRuleType = "RoleManagementPolicyExpirationRule"
+	RoleManagementPolicyRuleTypeRoleManagementPolicyNotificationRule          RoleManagementPolicyRuleType = "RoleManagementPolicyNotificationRule"
+)
+
+func PossibleValuesForRoleManagementPolicyRuleType() []string {
+	return []string{
+		string(RoleManagementPolicyRuleTypeRoleManagementPolicyApprovalRule),
+		string(RoleManagementPolicyRuleTypeRoleManagementPolicyAuthenticationContextRule),
+		string(RoleManagementPolicyRuleTypeRoleManagementPolicyEnablementRule),
+		string(RoleManagementPolicyRuleTypeRoleManagementPolicyExpirationRule),
+		string(RoleManagementPolicyRuleTypeRoleManagementPolicyNotificationRule),
+	}
+}
+
+func parseRoleManagementPolicyRuleType(input string) (*RoleManagementPolicyRuleType, error) {
+	vals := map[string]RoleManagementPolicyRuleType{
+		"rolemanagementpolicyapprovalrule":              RoleManagementPolicyRuleTypeRoleManagementPolicyApprovalRule,
+		"rolemanagementpolicyauthenticationcontextrule": RoleManagementPolicyRuleTypeRoleManagementPolicyAuthenticationContextRule,
+		"rolemanagementpolicyenablementrule":            RoleManagementPolicyRuleTypeRoleManagementPolicyEnablementRule,
+		"rolemanagementpolicyexpirationrule":            RoleManagementPolicyRuleTypeRoleManagementPolicyExpirationRule,
+		"rolemanagementpolicynotificationrule":          RoleManagementPolicyRuleTypeRoleManagementPolicyNotificationRule,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RoleManagementPolicyRuleType(input)
+	return &out, nil
+}
+
+type UserType string
+
+const (
+	UserTypeGroup UserType = "Group"
+	UserTypeUser  UserType = "User"
+)
+
+func PossibleValuesForUserType() []string {
+	return []string{
+		string(UserTypeGroup),
+		string(UserTypeUser),
+	}
+}
+
+func parseUserType(input string) (*UserType, error) {
+	vals := map[string]UserType{
+		"group": UserTypeGroup,
+		"user":  UserTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := UserType(input)
+	return &out, nil
+}
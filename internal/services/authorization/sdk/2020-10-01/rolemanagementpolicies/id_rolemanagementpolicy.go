@@ -0,0 +1,110 @@
+package rolemanagementpolicies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RoleManagementPolicyId{}
+
+// RoleManagementPolicyId is a struct representing the Resource ID for a Role Management Policy
+type RoleManagementPolicyId struct {
+	Scope                    string
+	RoleManagementPolicyName string
+}
+
+// NewRoleManagementPolicyID returns a new RoleManagementPolicyId struct
+func NewRoleManagementPolicyID(scope string, roleManagementPolicyName string) RoleManagementPolicyId {
+	return RoleManagementPolicyId{
+		Scope:                    scope,
+		RoleManagementPolicyName: roleManagementPolicyName,
+	}
+}
+
+// ParseRoleManagementPolicyID parses 'input' into a RoleManagementPolicyId
+func ParseRoleManagementPolicyID(input string) (*RoleManagementPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleManagementPolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleManagementPolicyId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleManagementPolicyName, ok = parsed.Parsed["roleManagementPolicyName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleManagementPolicyName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRoleManagementPolicyIDInsensitively parses 'input' case-insensitively into a RoleManagementPolicyId
+// note: this method should only be used for API response data and not user input
+func ParseRoleManagementPolicyIDInsensitively(input string) (*RoleManagementPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RoleManagementPolicyId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RoleManagementPolicyId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.RoleManagementPolicyName, ok = parsed.Parsed["roleManagementPolicyName"]; !ok {
+		return nil, fmt.Errorf("the segment 'roleManagementPolicyName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRoleManagementPolicyID checks that 'input' can be parsed as a Role Management Policy ID
+func ValidateRoleManagementPolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRoleManagementPolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Role Management Policy ID
+func (id RoleManagementPolicyId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/roleManagementPolicies/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.RoleManagementPolicyName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Role Management Policy ID
+func (id RoleManagementPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticRoleManagementPolicies", "roleManagementPolicies", "roleManagementPolicies"),
+		resourceids.UserSpecifiedSegment("roleManagementPolicyName", "roleManagementPolicyValue"),
+	}
+}
+
+// String returns a human-readable description of this Role Management Policy ID
+func (id RoleManagementPolicyId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Role Management Policy Name: %q", id.RoleManagementPolicyName),
+	}
+	return fmt.Sprintf("Role Management Policy (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package rolemanagementpolicies
+
+type RoleManagementPolicy struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *RoleManagementPolicyProperties `json:"properties,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+}
@@ -0,0 +1,40 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ RoleManagementPolicyRule = RoleManagementPolicyApprovalRule{}
+
+type RoleManagementPolicyApprovalRule struct {
+	Setting *ApprovalSettings `json:"setting,omitempty"`
+
+	// Fields inherited from RoleManagementPolicyRule
+	Id     *string                         `json:"id,omitempty"`
+	Target *RoleManagementPolicyRuleTarget `json:"target,omitempty"`
+}
+
+var _ json.Marshaler = RoleManagementPolicyApprovalRule{}
+
+func (s RoleManagementPolicyApprovalRule) MarshalJSON() ([]byte, error) {
+	type wrapper RoleManagementPolicyApprovalRule
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling RoleManagementPolicyApprovalRule: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling RoleManagementPolicyApprovalRule: %+v", err)
+	}
+	decoded["ruleType"] = "RoleManagementPolicyApprovalRule"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling RoleManagementPolicyApprovalRule: %+v", err)
+	}
+
+	return encoded, nil
+}
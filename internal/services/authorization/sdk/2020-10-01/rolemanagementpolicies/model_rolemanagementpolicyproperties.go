@@ -0,0 +1,89 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type RoleManagementPolicyProperties struct {
+	Description           *string                     `json:"description,omitempty"`
+	DisplayName           *string                     `json:"displayName,omitempty"`
+	EffectiveRules        *[]RoleManagementPolicyRule `json:"effectiveRules,omitempty"`
+	IsOrganizationDefault *bool                       `json:"isOrganizationDefault,omitempty"`
+	LastModifiedDateTime  *string                     `json:"lastModifiedDateTime,omitempty"`
+	Rules                 *[]RoleManagementPolicyRule `json:"rules,omitempty"`
+	Scope                 *string                     `json:"scope,omitempty"`
+}
+
+func (o RoleManagementPolicyProperties) GetLastModifiedDateTimeAsTime() (*time.Time, error) {
+	if o.LastModifiedDateTime == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.LastModifiedDateTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o RoleManagementPolicyProperties) SetLastModifiedDateTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.LastModifiedDateTime = &formatted
+}
+
+var _ json.Unmarshaler = &RoleManagementPolicyProperties{}
+
+func (s *RoleManagementPolicyProperties) UnmarshalJSON(bytes []byte) error {
+	type alias RoleManagementPolicyProperties
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into RoleManagementPolicyProperties: %+v", err)
+	}
+
+	s.Description = decoded.Description
+	s.DisplayName = decoded.DisplayName
+	s.IsOrganizationDefault = decoded.IsOrganizationDefault
+	s.LastModifiedDateTime = decoded.LastModifiedDateTime
+	s.Scope = decoded.Scope
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling RoleManagementPolicyProperties into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["effectiveRules"]; ok {
+		impls, err := unmarshalRoleManagementPolicyRuleImplementations(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'EffectiveRules' for 'RoleManagementPolicyProperties': %+v", err)
+		}
+		s.EffectiveRules = impls
+	}
+
+	if v, ok := temp["rules"]; ok {
+		impls, err := unmarshalRoleManagementPolicyRuleImplementations(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Rules' for 'RoleManagementPolicyProperties': %+v", err)
+		}
+		s.Rules = impls
+	}
+	return nil
+}
+
+func unmarshalRoleManagementPolicyRuleImplementations(input json.RawMessage) (*[]RoleManagementPolicyRule, error) {
+	var listTemp []json.RawMessage
+	if err := json.Unmarshal(input, &listTemp); err != nil {
+		return nil, fmt.Errorf("unmarshaling into []json.RawMessage: %+v", err)
+	}
+	if listTemp == nil {
+		return nil, nil
+	}
+
+	output := make([]RoleManagementPolicyRule, 0)
+	for i, val := range listTemp {
+		impl, err := unmarshalRoleManagementPolicyRuleImplementation(val)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshaling index %d: %+v", i, err)
+		}
+		output = append(output, impl)
+	}
+	return &output, nil
+}
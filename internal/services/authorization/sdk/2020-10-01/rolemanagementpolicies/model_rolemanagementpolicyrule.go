@@ -0,0 +1,77 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type RoleManagementPolicyRule interface {
+}
+
+func unmarshalRoleManagementPolicyRuleImplementation(input []byte) (RoleManagementPolicyRule, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling RoleManagementPolicyRule into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["ruleType"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "RoleManagementPolicyApprovalRule") {
+		var out RoleManagementPolicyApprovalRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyApprovalRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "RoleManagementPolicyAuthenticationContextRule") {
+		var out RoleManagementPolicyAuthenticationContextRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyAuthenticationContextRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "RoleManagementPolicyEnablementRule") {
+		var out RoleManagementPolicyEnablementRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyEnablementRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "RoleManagementPolicyExpirationRule") {
+		var out RoleManagementPolicyExpirationRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyExpirationRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "RoleManagementPolicyNotificationRule") {
+		var out RoleManagementPolicyNotificationRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyNotificationRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawRoleManagementPolicyRuleImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawRoleManagementPolicyRuleImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
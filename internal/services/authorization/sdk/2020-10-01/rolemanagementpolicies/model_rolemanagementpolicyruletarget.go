@@ -0,0 +1,10 @@
+package rolemanagementpolicies
+
+type RoleManagementPolicyRuleTarget struct {
+	Caller              *string   `json:"caller,omitempty"`
+	EnforcedSettings    *[]string `json:"enforcedSettings,omitempty"`
+	InheritableSettings *[]string `json:"inheritableSettings,omitempty"`
+	Level               *string   `json:"level,omitempty"`
+	Operations          *[]string `json:"operations,omitempty"`
+	TargetObjects       *[]string `json:"targetObjects,omitempty"`
+}
@@ -0,0 +1,15 @@
+package rolemanagementpolicyassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleManagementPolicyAssignmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoleManagementPolicyAssignmentsClientWithBaseURI(endpoint string) RoleManagementPolicyAssignmentsClient {
+	return RoleManagementPolicyAssignmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package rolemanagementpolicyassignments
+
+type RoleManagementPolicyAssignment struct {
+	Id         *string                                   `json:"id,omitempty"`
+	Name       *string                                   `json:"name,omitempty"`
+	Properties *RoleManagementPolicyAssignmentProperties `json:"properties,omitempty"`
+	Type       *string                                   `json:"type,omitempty"`
+}
@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	roleAssignmentConditionAttributeRegex = regexp.MustCompile(`@([A-Za-z]*)\[`)
+	roleAssignmentConditionFunctionRegex  = regexp.MustCompile(`([A-Za-z]+)\s*\{`)
+)
+
+// RoleAssignmentCondition validates the syntax of an Attribute Based Access Control (ABAC) condition, for example:
+// `((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'example'))`
+func RoleAssignmentCondition(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	// string literals are removed prior to checking the remainder of the condition, since they can contain anything
+	unquoted, err := removeRoleAssignmentConditionStringLiterals(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid condition: %+v", k, err))
+		return
+	}
+
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	stack := make([]rune, 0)
+	for pos, c := range unquoted {
+		switch c {
+		case '(', '[', '{':
+			stack = append(stack, c)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != closing[c] {
+				errors = append(errors, fmt.Errorf("%q is not a valid condition: unexpected %q at position %d", k, c, pos))
+				return
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if len(stack) > 0 {
+		errors = append(errors, fmt.Errorf("%q is not a valid condition: %q is not closed", k, stack[len(stack)-1]))
+		return
+	}
+
+	for _, match := range roleAssignmentConditionAttributeRegex.FindAllStringSubmatch(unquoted, -1) {
+		switch match[1] {
+		case "Environment", "Principal", "Request", "Resource":
+		default:
+			errors = append(errors, fmt.Errorf("%q is not a valid condition: the attribute source %q must be one of `@Environment`, `@Principal`, `@Request` or `@Resource`", k, "@"+match[1]))
+		}
+	}
+
+	for _, match := range roleAssignmentConditionFunctionRegex.FindAllStringSubmatch(unquoted, -1) {
+		switch match[1] {
+		case "ActionMatches", "SubOperationMatches":
+		default:
+			errors = append(errors, fmt.Errorf("%q is not a valid condition: %q must be either `ActionMatches` or `SubOperationMatches`", k, match[1]))
+		}
+	}
+
+	return warnings, errors
+}
+
+// removeRoleAssignmentConditionStringLiterals replaces each string literal (which is surrounded by single quotes)
+// with an empty string literal, returning an error if a string literal isn't terminated
+func removeRoleAssignmentConditionStringLiterals(input string) (string, error) {
+	var sb strings.Builder
+	inLiteral := false
+	start := 0
+	for pos, c := range input {
+		if c != '\'' {
+			if !inLiteral {
+				sb.WriteRune(c)
+			}
+			continue
+		}
+
+		if inLiteral {
+			sb.WriteString("''")
+		} else {
+			start = pos
+		}
+		inLiteral = !inLiteral
+	}
+
+	if inLiteral {
+		return "", fmt.Errorf("the string literal starting at position %d is not terminated", start)
+	}
+
+	return sb.String(), nil
+}
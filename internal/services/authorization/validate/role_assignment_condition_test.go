@@ -0,0 +1,76 @@
+package validate
+
+import "testing"
+
+func TestRoleAssignmentCondition(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// whitespace
+			Input: "   ",
+			Valid: false,
+		},
+		{
+			// attribute condition
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:ContainerName] StringEqualsIgnoreCase 'foo_storage_container'",
+			Valid: true,
+		},
+		{
+			// action and attribute condition
+			Input: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'example'))",
+			Valid: true,
+		},
+		{
+			// sub operation
+			Input: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'} AND NOT SubOperationMatches{'Blob.List'})) OR (@Request[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:prefix] StringStartsWith 'logs/'))",
+			Valid: true,
+		},
+		{
+			// delimiters within string literals are ignored
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'a(b]c{'",
+			Valid: true,
+		},
+		{
+			// unbalanced parenthesis
+			Input: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'example')",
+			Valid: false,
+		},
+		{
+			// mismatched delimiters
+			Input: "(@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name) StringEquals 'example']",
+			Valid: false,
+		},
+		{
+			// unterminated string literal
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'example",
+			Valid: false,
+		},
+		{
+			// unknown attribute source
+			Input: "@Resources[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'example'",
+			Valid: false,
+		},
+		{
+			// unknown function
+			Input: "(!(ActionMatch{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'}))",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := RoleAssignmentCondition(tc.Input, "condition")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q: %+v", tc.Valid, valid, tc.Input, errors)
+		}
+	}
+}
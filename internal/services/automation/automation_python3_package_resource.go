@@ -0,0 +1,253 @@
+package automation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/python3package"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAutomationPython3Package() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAutomationPython3PackageCreateUpdate,
+		Read:   resourceAutomationPython3PackageRead,
+		Update: resourceAutomationPython3PackageCreateUpdate,
+		Delete: resourceAutomationPython3PackageDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := python3package.ParsePython3PackageID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"automation_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AutomationAccount(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"content_uri": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"content_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"hash_algorithm": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"hash_value"},
+			},
+
+			"hash_value": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"hash_algorithm"},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceAutomationPython3PackageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Automation.Python3PackageClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Python3 Package creation.")
+
+	id := python3package.NewPython3PackageID(subscriptionId, d.Get("resource_group_name").(string), d.Get("automation_account_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_automation_python3_package", id.ID())
+		}
+	}
+
+	contentLink := python3package.ContentLink{
+		Uri: utils.String(d.Get("content_uri").(string)),
+	}
+	if v := d.Get("content_version").(string); v != "" {
+		contentLink.Version = utils.String(v)
+	}
+	if v := d.Get("hash_algorithm").(string); v != "" {
+		contentLink.ContentHash = &python3package.ContentHash{
+			Algorithm: v,
+			Value:     d.Get("hash_value").(string),
+		}
+	}
+
+	parameters := python3package.PythonPackageCreateParameters{
+		Properties: python3package.PythonPackageCreateProperties{
+			ContentLink: contentLink,
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	// the package is imported asynchronously, so we need to wait for it to finish provisioning
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(python3package.ModuleProvisioningStateActivitiesStored),
+			string(python3package.ModuleProvisioningStateConnectionTypeImported),
+			string(python3package.ModuleProvisioningStateContentDownloaded),
+			string(python3package.ModuleProvisioningStateContentRetrieved),
+			string(python3package.ModuleProvisioningStateContentStored),
+			string(python3package.ModuleProvisioningStateContentValidated),
+			string(python3package.ModuleProvisioningStateCreated),
+			string(python3package.ModuleProvisioningStateCreating),
+			string(python3package.ModuleProvisioningStateModuleDataStored),
+			string(python3package.ModuleProvisioningStateModuleImportRunbookComplete),
+			string(python3package.ModuleProvisioningStateRunningImportModuleRunbook),
+			string(python3package.ModuleProvisioningStateStartingImportModuleRunbook),
+			string(python3package.ModuleProvisioningStateUpdating),
+		},
+		Target: []string{
+			string(python3package.ModuleProvisioningStateSucceeded),
+		},
+		MinTimeout: 30 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				if props.Error != nil && props.Error.Message != nil && *props.Error.Message != "" {
+					return resp, "Error", fmt.Errorf("%s", *props.Error.Message)
+				}
+				if props.ProvisioningState != nil {
+					return resp, string(*props.ProvisioningState), nil
+				}
+			}
+
+			return resp, "Unknown", nil
+		},
+	}
+	if d.IsNewResource() {
+		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	} else {
+		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish provisioning: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAutomationPython3PackageRead(d, meta)
+}
+
+func resourceAutomationPython3PackageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.Python3PackageClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := python3package.ParsePython3PackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Python3PackageName)
+	d.Set("automation_account_name", id.AutomationAccountName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil && props.ContentLink != nil {
+			link := props.ContentLink
+			d.Set("content_uri", link.Uri)
+			d.Set("content_version", link.Version)
+
+			if hash := link.ContentHash; hash != nil {
+				d.Set("hash_algorithm", hash.Algorithm)
+				d.Set("hash_value", hash.Value)
+			}
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceAutomationPython3PackageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.Python3PackageClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := python3package.ParsePython3PackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/python3package"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationPython3PackageResource struct{}
+
+func TestAccAutomationPython3Package_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_python3_package", "test")
+	r := AutomationPython3PackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationPython3Package_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_python3_package", "test")
+	r := AutomationPython3PackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAutomationPython3Package_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_python3_package", "test")
+	r := AutomationPython3PackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AutomationPython3PackageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := python3package.ParsePython3PackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Automation.Python3PackageClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (AutomationPython3PackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "test" {
+  name                    = "requests"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  content_uri             = "https://files.pythonhosted.org/packages/py3/r/requests/requests-2.31.0-py3-none-any.whl"
+}
+`, AutomationRuntimeEnvironmentResource{}.template(data))
+}
+
+func (r AutomationPython3PackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "import" {
+  name                    = azurerm_automation_python3_package.test.name
+  resource_group_name     = azurerm_automation_python3_package.test.resource_group_name
+  automation_account_name = azurerm_automation_python3_package.test.automation_account_name
+  content_uri             = azurerm_automation_python3_package.test.content_uri
+}
+`, r.basic(data))
+}
+
+func (AutomationPython3PackageResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "test" {
+  name                    = "requests"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  content_uri             = "https://files.pythonhosted.org/packages/py3/r/requests/requests-2.31.0-py3-none-any.whl"
+  content_version         = "2.31.0"
+
+  tags = {
+    ENV = "test"
+  }
+}
+`, AutomationRuntimeEnvironmentResource{}.template(data))
+}
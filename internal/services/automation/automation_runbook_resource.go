@@ -9,11 +9,15 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
 	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runbook"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -66,14 +70,14 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 				Required:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(automation.Graph),
-					string(automation.GraphPowerShell),
-					string(automation.GraphPowerShellWorkflow),
-					string(automation.PowerShell),
-					string(automation.PowerShellWorkflow),
-					string(automation.Script),
-				}, true),
+				ValidateFunc:     validation.StringInSlice(runbook.PossibleValuesForRunbookTypeEnum(), true),
+			},
+
+			"runtime_environment_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"log_progress": {
@@ -146,6 +150,7 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 
 func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Automation.RunbookClient
+	previewClient := meta.(*clients.Client).Automation.RunbookPreviewClient
 	jsClient := meta.(*clients.Client).Automation.JobScheduleClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -153,48 +158,45 @@ func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta inter
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Runbook creation.")
 
 	id := parse.NewRunbookID(client.SubscriptionID, d.Get("resource_group_name").(string), d.Get("automation_account_name").(string), d.Get("name").(string))
+	runbookId := runbook.NewRunbookID(id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+		existing, err := previewClient.Get(ctx, runbookId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_automation_runbook", id.ID())
 		}
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	t := d.Get("tags").(map[string]interface{})
-
-	runbookType := automation.RunbookTypeEnum(d.Get("runbook_type").(string))
-	logProgress := d.Get("log_progress").(bool)
-	logVerbose := d.Get("log_verbose").(bool)
-	description := d.Get("description").(string)
-
-	parameters := automation.RunbookCreateOrUpdateParameters{
-		RunbookCreateOrUpdateProperties: &automation.RunbookCreateOrUpdateProperties{
-			LogVerbose:  &logVerbose,
-			LogProgress: &logProgress,
-			RunbookType: runbookType,
-			Description: &description,
+	parameters := runbook.RunbookCreateOrUpdateParameters{
+		Properties: runbook.RunbookCreateOrUpdateProperties{
+			LogVerbose:  utils.Bool(d.Get("log_verbose").(bool)),
+			LogProgress: utils.Bool(d.Get("log_progress").(bool)),
+			RunbookType: runbook.RunbookTypeEnum(d.Get("runbook_type").(string)),
+			Description: utils.String(d.Get("description").(string)),
 		},
 
-		Location: &location,
-		Tags:     tags.Expand(t),
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Tags:     tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("runtime_environment_name").(string); v != "" {
+		parameters.Properties.RuntimeEnvironment = utils.String(v)
 	}
 
 	contentLink := expandContentLink(d.Get("publish_content_link").([]interface{}))
 	if contentLink != nil {
-		parameters.RunbookCreateOrUpdateProperties.PublishContentLink = contentLink
+		parameters.Properties.PublishContentLink = contentLink
 	} else {
-		parameters.RunbookCreateOrUpdateProperties.Draft = &automation.RunbookDraft{}
+		parameters.Properties.Draft = &runbook.RunbookDraft{}
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, parameters); err != nil {
+	if _, err := previewClient.CreateOrUpdate(ctx, runbookId, parameters); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
@@ -251,6 +253,7 @@ func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta inter
 
 func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Automation.RunbookClient
+	previewClient := meta.(*clients.Client).Automation.RunbookPreviewClient
 	jsClient := meta.(*clients.Client).Automation.JobScheduleClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -260,9 +263,9 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	resp, err := previewClient.Get(ctx, runbook.NewRunbookID(id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -272,16 +275,25 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-
 	d.Set("automation_account_name", id.AutomationAccountName)
-	if props := resp.RunbookProperties; props != nil {
-		d.Set("log_verbose", props.LogVerbose)
-		d.Set("log_progress", props.LogProgress)
-		d.Set("runbook_type", props.RunbookType)
-		d.Set("description", props.Description)
+
+	var runbookTags *map[string]string
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+		runbookTags = model.Tags
+
+		if props := model.Properties; props != nil {
+			d.Set("log_verbose", props.LogVerbose)
+			d.Set("log_progress", props.LogProgress)
+			d.Set("description", props.Description)
+			d.Set("runtime_environment_name", props.RuntimeEnvironment)
+
+			runbookType := ""
+			if props.RunbookType != nil {
+				runbookType = string(*props.RunbookType)
+			}
+			d.Set("runbook_type", runbookType)
+		}
 	}
 
 	response, err := client.GetContent(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
@@ -328,11 +340,7 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `job_schedule`: %+v", err)
 	}
 
-	if t := resp.Tags; t != nil {
-		return tags.FlattenAndSet(d, t)
-	}
-
-	return nil
+	return tags.FlattenAndSet(d, tagsHelper.Flatten(runbookTags))
 }
 
 func resourceAutomationRunbookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -357,7 +365,7 @@ func resourceAutomationRunbookDelete(d *pluginsdk.ResourceData, meta interface{}
 	return nil
 }
 
-func expandContentLink(inputs []interface{}) *automation.ContentLink {
+func expandContentLink(inputs []interface{}) *runbook.ContentLink {
 	if len(inputs) == 0 || inputs[0] == nil {
 		return nil
 	}
@@ -372,18 +380,18 @@ func expandContentLink(inputs []interface{}) *automation.ContentLink {
 		hashValue := hash["value"].(string)
 		hashAlgorithm := hash["algorithm"].(string)
 
-		return &automation.ContentLink{
-			URI:     &uri,
+		return &runbook.ContentLink{
+			Uri:     &uri,
 			Version: &version,
-			ContentHash: &automation.ContentHash{
-				Algorithm: &hashAlgorithm,
-				Value:     &hashValue,
+			ContentHash: &runbook.ContentHash{
+				Algorithm: hashAlgorithm,
+				Value:     hashValue,
 			},
 		}
 	}
 
-	return &automation.ContentLink{
-		URI:     &uri,
+	return &runbook.ContentLink{
+		Uri:     &uri,
 		Version: &version,
 	}
 }
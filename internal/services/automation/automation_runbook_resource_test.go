@@ -130,6 +130,22 @@ func TestAccAutomationRunbook_withJobSchedule(t *testing.T) {
 	})
 }
 
+func TestAccAutomationRunbook_withRuntimeEnvironment(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runbook", "test")
+	r := AutomationRunbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withRuntimeEnvironment(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("runtime_environment_name").HasValue(fmt.Sprintf("acctest-re-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("publish_content_link"),
+	})
+}
+
 func (t AutomationRunbookResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RunbookID(state.ID)
 	if err != nil {
@@ -477,3 +493,49 @@ CONTENT
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (AutomationRunbookResource) withRuntimeEnvironment(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_runtime_environment" "test" {
+  name                    = "acctest-re-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  runtime_language        = "PowerShell"
+  runtime_version         = "7.2"
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                     = "Get-AzureVMTutorial"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  automation_account_name  = azurerm_automation_account.test.name
+  runtime_environment_name = azurerm_automation_runtime_environment.test.name
+
+  log_verbose  = "true"
+  log_progress = "true"
+  description  = "This is a test runbook for terraform acceptance test"
+  runbook_type = "PowerShell"
+
+  publish_content_link {
+    uri = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/c4935ffb69246a6058eb24f54640f53f69d3ac9f/101-automation-runbook-getvms/Runbooks/Get-AzureVMTutorial.ps1"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,253 @@
+package automation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/packageresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAutomationRuntimeEnvironmentPackage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAutomationRuntimeEnvironmentPackageCreateUpdate,
+		Read:   resourceAutomationRuntimeEnvironmentPackageRead,
+		Update: resourceAutomationRuntimeEnvironmentPackageCreateUpdate,
+		Delete: resourceAutomationRuntimeEnvironmentPackageDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := packageresource.ParsePackageID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"automation_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AutomationAccount(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"runtime_environment_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"content_uri": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"content_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"hash_algorithm": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"hash_value"},
+			},
+
+			"hash_value": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"hash_algorithm"},
+			},
+		},
+	}
+}
+
+func resourceAutomationRuntimeEnvironmentPackageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Automation.PackageClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Runtime Environment Package creation.")
+
+	id := packageresource.NewPackageID(subscriptionId, d.Get("resource_group_name").(string), d.Get("automation_account_name").(string), d.Get("runtime_environment_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_automation_runtime_environment_package", id.ID())
+		}
+	}
+
+	contentLink := packageresource.ContentLink{
+		Uri: utils.String(d.Get("content_uri").(string)),
+	}
+	if v := d.Get("content_version").(string); v != "" {
+		contentLink.Version = utils.String(v)
+	}
+	if v := d.Get("hash_algorithm").(string); v != "" {
+		contentLink.ContentHash = &packageresource.ContentHash{
+			Algorithm: v,
+			Value:     d.Get("hash_value").(string),
+		}
+	}
+
+	parameters := packageresource.PackageCreateOrUpdateParameters{
+		Properties: packageresource.PackageCreateOrUpdateProperties{
+			ContentLink: contentLink,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	// the package is imported asynchronously, so we need to wait for it to finish provisioning
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(packageresource.PackageProvisioningStateActivitiesStored),
+			string(packageresource.PackageProvisioningStateConnectionTypeImported),
+			string(packageresource.PackageProvisioningStateContentDownloaded),
+			string(packageresource.PackageProvisioningStateContentRetrieved),
+			string(packageresource.PackageProvisioningStateContentStored),
+			string(packageresource.PackageProvisioningStateContentValidated),
+			string(packageresource.PackageProvisioningStateCreated),
+			string(packageresource.PackageProvisioningStateCreating),
+			string(packageresource.PackageProvisioningStateModuleDataStored),
+			string(packageresource.PackageProvisioningStateModuleImportRunbookComplete),
+			string(packageresource.PackageProvisioningStateRunningImportModuleRunbook),
+			string(packageresource.PackageProvisioningStateStartingImportModuleRunbook),
+			string(packageresource.PackageProvisioningStateUpdating),
+		},
+		Target: []string{
+			string(packageresource.PackageProvisioningStateSucceeded),
+		},
+		MinTimeout: 30 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				if props.Error != nil && props.Error.Message != nil && *props.Error.Message != "" {
+					return resp, "Error", fmt.Errorf("%s", *props.Error.Message)
+				}
+				if props.ProvisioningState != nil {
+					return resp, string(*props.ProvisioningState), nil
+				}
+			}
+
+			return resp, "Unknown", nil
+		},
+	}
+	if d.IsNewResource() {
+		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	} else {
+		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish provisioning: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAutomationRuntimeEnvironmentPackageRead(d, meta)
+}
+
+func resourceAutomationRuntimeEnvironmentPackageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.PackageClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := packageresource.ParsePackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PackageName)
+	d.Set("automation_account_name", id.AutomationAccountName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("runtime_environment_name", id.RuntimeEnvironmentName)
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if link := model.Properties.ContentLink; link != nil {
+			d.Set("content_uri", link.Uri)
+			d.Set("content_version", link.Version)
+
+			if hash := link.ContentHash; hash != nil {
+				d.Set("hash_algorithm", hash.Algorithm)
+				d.Set("hash_value", hash.Value)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAutomationRuntimeEnvironmentPackageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.PackageClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := packageresource.ParsePackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
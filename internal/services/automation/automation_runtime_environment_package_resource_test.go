@@ -0,0 +1,88 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/packageresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationRuntimeEnvironmentPackageResource struct{}
+
+func TestAccAutomationRuntimeEnvironmentPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runtime_environment_package", "test")
+	r := AutomationRuntimeEnvironmentPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationRuntimeEnvironmentPackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runtime_environment_package", "test")
+	r := AutomationRuntimeEnvironmentPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t AutomationRuntimeEnvironmentPackageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := packageresource.ParsePackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Automation.PackageClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (AutomationRuntimeEnvironmentPackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment_package" "test" {
+  name                     = "xActiveDirectory"
+  resource_group_name      = azurerm_resource_group.test.name
+  automation_account_name  = azurerm_automation_account.test.name
+  runtime_environment_name = azurerm_automation_runtime_environment.test.name
+  content_uri              = "https://devopsgallerystorage.blob.core.windows.net/packages/xactivedirectory.2.19.0.nupkg"
+}
+`, AutomationRuntimeEnvironmentResource{}.basic(data))
+}
+
+func (r AutomationRuntimeEnvironmentPackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_runtime_environment_package" "import" {
+  name                     = azurerm_automation_runtime_environment_package.test.name
+  resource_group_name      = azurerm_automation_runtime_environment_package.test.resource_group_name
+  automation_account_name  = azurerm_automation_runtime_environment_package.test.automation_account_name
+  runtime_environment_name = azurerm_automation_runtime_environment_package.test.runtime_environment_name
+  content_uri              = azurerm_automation_runtime_environment_package.test.content_uri
+}
+`, r.basic(data))
+}
@@ -3,6 +3,10 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/packageresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/python3package"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runbook"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2023-05-15-preview/runtimeenvironment"
 )
 
 type Client struct {
@@ -16,8 +20,12 @@ type Client struct {
 	DscNodeConfigurationClient  *automation.DscNodeConfigurationClient
 	JobScheduleClient           *automation.JobScheduleClient
 	ModuleClient                *automation.ModuleClient
+	PackageClient               *packageresource.PackageResourceClient
+	Python3PackageClient        *python3package.Python3PackageClient
 	RunbookClient               *automation.RunbookClient
 	RunbookDraftClient          *automation.RunbookDraftClient
+	RunbookPreviewClient        *runbook.RunbookClient
+	RuntimeEnvironmentClient    *runtimeenvironment.RuntimeEnvironmentClient
 	ScheduleClient              *automation.ScheduleClient
 	VariableClient              *automation.VariableClient
 	WebhookClient               *automation.WebhookClient
@@ -54,12 +62,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	moduleClient := automation.NewModuleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&moduleClient.Client, o.ResourceManagerAuthorizer)
 
+	packageClient := packageresource.NewPackageResourceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&packageClient.Client, o.ResourceManagerAuthorizer)
+
+	python3PackageClient := python3package.NewPython3PackageClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&python3PackageClient.Client, o.ResourceManagerAuthorizer)
+
 	runbookClient := automation.NewRunbookClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&runbookClient.Client, o.ResourceManagerAuthorizer)
 
 	runbookDraftClient := automation.NewRunbookDraftClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&runbookDraftClient.Client, o.ResourceManagerAuthorizer)
 
+	runbookPreviewClient := runbook.NewRunbookClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&runbookPreviewClient.Client, o.ResourceManagerAuthorizer)
+
+	runtimeEnvironmentClient := runtimeenvironment.NewRuntimeEnvironmentClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&runtimeEnvironmentClient.Client, o.ResourceManagerAuthorizer)
+
 	scheduleClient := automation.NewScheduleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&scheduleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -80,8 +100,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		DscNodeConfigurationClient:  &dscNodeConfigurationClient,
 		JobScheduleClient:           &jobScheduleClient,
 		ModuleClient:                &moduleClient,
+		PackageClient:               &packageClient,
+		Python3PackageClient:        &python3PackageClient,
 		RunbookClient:               &runbookClient,
 		RunbookDraftClient:          &runbookDraftClient,
+		RunbookPreviewClient:        &runbookPreviewClient,
+		RuntimeEnvironmentClient:    &runtimeEnvironmentClient,
 		ScheduleClient:              &scheduleClient,
 		VariableClient:              &variableClient,
 		WebhookClient:               &webhookClient,
@@ -0,0 +1,15 @@
+package packageresource
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PackageResourceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPackageResourceClientWithBaseURI(endpoint string) PackageResourceClient {
+	return PackageResourceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package packageresource
+
+type ContentHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
@@ -0,0 +1,7 @@
+package packageresource
+
+type ContentLink struct {
+	ContentHash *ContentHash `json:"contentHash,omitempty"`
+	Uri         *string      `json:"uri,omitempty"`
+	Version     *string      `json:"version,omitempty"`
+}
@@ -0,0 +1,8 @@
+package packageresource
+
+type Package struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *PackageProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package packageresource
+
+type PackageCreateOrUpdateParameters struct {
+	Properties PackageCreateOrUpdateProperties `json:"properties"`
+}
@@ -0,0 +1,5 @@
+package packageresource
+
+type PackageCreateOrUpdateProperties struct {
+	ContentLink ContentLink `json:"contentLink"`
+}
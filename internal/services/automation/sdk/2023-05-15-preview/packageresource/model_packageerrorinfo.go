@@ -0,0 +1,6 @@
+package packageresource
+
+type PackageErrorInfo struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
@@ -0,0 +1,10 @@
+package packageresource
+
+type PackageProperties struct {
+	ContentLink       *ContentLink              `json:"contentLink,omitempty"`
+	Default           *bool                     `json:"default,omitempty"`
+	Error             *PackageErrorInfo         `json:"error,omitempty"`
+	ProvisioningState *PackageProvisioningState `json:"provisioningState,omitempty"`
+	SizeInBytes       *int64                    `json:"sizeInBytes,omitempty"`
+	Version           *string                   `json:"version,omitempty"`
+}
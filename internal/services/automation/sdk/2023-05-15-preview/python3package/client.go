@@ -0,0 +1,15 @@
+package python3package
+
+import "github.com/Azure/go-autorest/autorest"
+
+type Python3PackageClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPython3PackageClientWithBaseURI(endpoint string) Python3PackageClient {
+	return Python3PackageClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package python3package
+
+type ContentHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
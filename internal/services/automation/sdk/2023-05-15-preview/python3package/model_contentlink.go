@@ -0,0 +1,7 @@
+package python3package
+
+type ContentLink struct {
+	ContentHash *ContentHash `json:"contentHash,omitempty"`
+	Uri         *string      `json:"uri,omitempty"`
+	Version     *string      `json:"version,omitempty"`
+}
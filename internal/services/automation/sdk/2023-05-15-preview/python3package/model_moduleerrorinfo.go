@@ -0,0 +1,6 @@
+package python3package
+
+type ModuleErrorInfo struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
@@ -0,0 +1,11 @@
+package python3package
+
+type ModuleProperties struct {
+	ContentLink       *ContentLink             `json:"contentLink,omitempty"`
+	Description       *string                  `json:"description,omitempty"`
+	Error             *ModuleErrorInfo         `json:"error,omitempty"`
+	IsGlobal          *bool                    `json:"isGlobal,omitempty"`
+	ProvisioningState *ModuleProvisioningState `json:"provisioningState,omitempty"`
+	SizeInBytes       *int64                   `json:"sizeInBytes,omitempty"`
+	Version           *string                  `json:"version,omitempty"`
+}
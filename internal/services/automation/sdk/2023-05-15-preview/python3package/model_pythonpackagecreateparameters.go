@@ -0,0 +1,6 @@
+package python3package
+
+type PythonPackageCreateParameters struct {
+	Properties PythonPackageCreateProperties `json:"properties"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package python3package
+
+type PythonPackageCreateProperties struct {
+	ContentLink ContentLink `json:"contentLink"`
+}
@@ -0,0 +1,9 @@
+package python3package
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-15-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/python3package/%s", defaultApiVersion)
+}
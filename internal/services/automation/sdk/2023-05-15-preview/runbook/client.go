@@ -0,0 +1,15 @@
+package runbook
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RunbookClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRunbookClientWithBaseURI(endpoint string) RunbookClient {
+	return RunbookClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,55 @@
+package runbook
+
+import "strings"
+
+type RunbookTypeEnum string
+
+const (
+	RunbookTypeEnumGraph                   RunbookTypeEnum = "Graph"
+	RunbookTypeEnumGraphPowerShell         RunbookTypeEnum = "GraphPowerShell"
+	RunbookTypeEnumGraphPowerShellWorkflow RunbookTypeEnum = "GraphPowerShellWorkflow"
+	RunbookTypeEnumPowerShell              RunbookTypeEnum = "PowerShell"
+	RunbookTypeEnumPowerShell72            RunbookTypeEnum = "PowerShell72"
+	RunbookTypeEnumPowerShellWorkflow      RunbookTypeEnum = "PowerShellWorkflow"
+	RunbookTypeEnumPython                  RunbookTypeEnum = "Python"
+	RunbookTypeEnumPython2                 RunbookTypeEnum = "Python2"
+	RunbookTypeEnumPython3                 RunbookTypeEnum = "Python3"
+	RunbookTypeEnumScript                  RunbookTypeEnum = "Script"
+)
+
+func PossibleValuesForRunbookTypeEnum() []string {
+	return []string{
+		string(RunbookTypeEnumGraph),
+		string(RunbookTypeEnumGraphPowerShell),
+		string(RunbookTypeEnumGraphPowerShellWorkflow),
+		string(RunbookTypeEnumPowerShell),
+		string(RunbookTypeEnumPowerShell72),
+		string(RunbookTypeEnumPowerShellWorkflow),
+		string(RunbookTypeEnumPython),
+		string(RunbookTypeEnumPython2),
+		string(RunbookTypeEnumPython3),
+		string(RunbookTypeEnumScript),
+	}
+}
+
+func parseRunbookTypeEnum(input string) (*RunbookTypeEnum, error) {
+	vals := map[string]RunbookTypeEnum{
+		"graph":                   RunbookTypeEnumGraph,
+		"graphpowershell":         RunbookTypeEnumGraphPowerShell,
+		"graphpowershellworkflow": RunbookTypeEnumGraphPowerShellWorkflow,
+		"powershell":              RunbookTypeEnumPowerShell,
+		"powershell72":            RunbookTypeEnumPowerShell72,
+		"powershellworkflow":      RunbookTypeEnumPowerShellWorkflow,
+		"python":                  RunbookTypeEnumPython,
+		"python2":                 RunbookTypeEnumPython2,
+		"python3":                 RunbookTypeEnumPython3,
+		"script":                  RunbookTypeEnumScript,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RunbookTypeEnum(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package runbook
+
+type ContentHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
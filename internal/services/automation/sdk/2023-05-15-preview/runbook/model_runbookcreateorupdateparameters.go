@@ -0,0 +1,8 @@
+package runbook
+
+type RunbookCreateOrUpdateParameters struct {
+	Location   *string                         `json:"location,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties RunbookCreateOrUpdateProperties `json:"properties"`
+	Tags       *map[string]string              `json:"tags,omitempty"`
+}
@@ -0,0 +1,12 @@
+package runbook
+
+type RunbookCreateOrUpdateProperties struct {
+	Description        *string         `json:"description,omitempty"`
+	Draft              *RunbookDraft   `json:"draft,omitempty"`
+	LogActivityTrace   *int64          `json:"logActivityTrace,omitempty"`
+	LogProgress        *bool           `json:"logProgress,omitempty"`
+	LogVerbose         *bool           `json:"logVerbose,omitempty"`
+	PublishContentLink *ContentLink    `json:"publishContentLink,omitempty"`
+	RunbookType        RunbookTypeEnum `json:"runbookType"`
+	RuntimeEnvironment *string         `json:"runtimeEnvironment,omitempty"`
+}
@@ -0,0 +1,6 @@
+package runbook
+
+type RunbookDraft struct {
+	DraftContentLink *ContentLink `json:"draftContentLink,omitempty"`
+	InEdit           *bool        `json:"inEdit,omitempty"`
+}
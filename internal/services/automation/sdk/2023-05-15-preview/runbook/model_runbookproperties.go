@@ -0,0 +1,11 @@
+package runbook
+
+type RunbookProperties struct {
+	Description        *string          `json:"description,omitempty"`
+	LogActivityTrace   *int64           `json:"logActivityTrace,omitempty"`
+	LogProgress        *bool            `json:"logProgress,omitempty"`
+	LogVerbose         *bool            `json:"logVerbose,omitempty"`
+	PublishContentLink *ContentLink     `json:"publishContentLink,omitempty"`
+	RunbookType        *RunbookTypeEnum `json:"runbookType,omitempty"`
+	RuntimeEnvironment *string          `json:"runtimeEnvironment,omitempty"`
+}
@@ -0,0 +1,9 @@
+package runbook
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-15-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/runbook/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package runtimeenvironment
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RuntimeEnvironmentClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRuntimeEnvironmentClientWithBaseURI(endpoint string) RuntimeEnvironmentClient {
+	return RuntimeEnvironmentClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,31 @@
+package runtimeenvironment
+
+import "strings"
+
+type RuntimeLanguage string
+
+const (
+	RuntimeLanguagePowerShell RuntimeLanguage = "PowerShell"
+	RuntimeLanguagePython     RuntimeLanguage = "Python"
+)
+
+func PossibleValuesForRuntimeLanguage() []string {
+	return []string{
+		string(RuntimeLanguagePowerShell),
+		string(RuntimeLanguagePython),
+	}
+}
+
+func parseRuntimeLanguage(input string) (*RuntimeLanguage, error) {
+	vals := map[string]RuntimeLanguage{
+		"powershell": RuntimeLanguagePowerShell,
+		"python":     RuntimeLanguagePython,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RuntimeLanguage(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package runtimeenvironment
+
+type RuntimeEnvironmentProperties struct {
+	DefaultPackages *map[string]string `json:"defaultPackages,omitempty"`
+	Description     *string            `json:"description,omitempty"`
+	Runtime         *RuntimeProperties `json:"runtime,omitempty"`
+}
@@ -0,0 +1,6 @@
+package runtimeenvironment
+
+type RuntimeProperties struct {
+	Language *string `json:"language,omitempty"`
+	Version  *string `json:"version,omitempty"`
+}
@@ -0,0 +1,9 @@
+package runtimeenvironment
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-15-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/runtimeenvironment/%s", defaultApiVersion)
+}
@@ -0,0 +1,301 @@
+package cognitive
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2024-10-01/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCognitiveAccountRaiPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCognitiveAccountRaiPolicyCreate,
+		Read:   resourceCognitiveAccountRaiPolicyRead,
+		Update: resourceCognitiveAccountRaiPolicyUpdate,
+		Delete: resourceCognitiveAccountRaiPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := raipolicies.ParseRaiPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cognitive_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: raipolicies.ValidateAccountID,
+			},
+
+			"base_policy_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"content_filter": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"filter_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"block_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"severity_threshold": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(raipolicies.ContentLevelHigh),
+								string(raipolicies.ContentLevelLow),
+								string(raipolicies.ContentLevelMedium),
+							}, false),
+						},
+
+						"source": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(raipolicies.RaiPolicyContentSourceCompletion),
+								string(raipolicies.RaiPolicyContentSourcePrompt),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(raipolicies.RaiPolicyModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(raipolicies.RaiPolicyModeAsynchronousFilter),
+					string(raipolicies.RaiPolicyModeBlocking),
+					string(raipolicies.RaiPolicyModeDefault),
+					string(raipolicies.RaiPolicyModeDeferred),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceCognitiveAccountRaiPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := raipolicies.ParseAccountID(d.Get("cognitive_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := raipolicies.NewRaiPolicyID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cognitive_account_rai_policy", id.ID())
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	if _, err := client.CreateOrUpdate(ctx, id, expandCognitiveAccountRaiPolicy(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCognitiveAccountRaiPolicyRead(d, meta)
+}
+
+func resourceCognitiveAccountRaiPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := raipolicies.ParseRaiPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	if _, err := client.CreateOrUpdate(ctx, *id, expandCognitiveAccountRaiPolicy(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCognitiveAccountRaiPolicyRead(d, meta)
+}
+
+func resourceCognitiveAccountRaiPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := raipolicies.ParseRaiPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RaiPolicyName)
+	d.Set("cognitive_account_id", raipolicies.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("base_policy_name", props.BasePolicyName)
+
+			mode := ""
+			if props.Mode != nil {
+				mode = string(*props.Mode)
+			}
+			d.Set("mode", mode)
+
+			if err := d.Set("content_filter", flattenCognitiveAccountRaiPolicyContentFilters(props.ContentFilters)); err != nil {
+				return fmt.Errorf("setting `content_filter`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCognitiveAccountRaiPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := raipolicies.ParseRaiPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCognitiveAccountRaiPolicy(d *pluginsdk.ResourceData) raipolicies.RaiPolicy {
+	mode := raipolicies.RaiPolicyMode(d.Get("mode").(string))
+
+	return raipolicies.RaiPolicy{
+		Properties: &raipolicies.RaiPolicyProperties{
+			BasePolicyName: utils.String(d.Get("base_policy_name").(string)),
+			ContentFilters: expandCognitiveAccountRaiPolicyContentFilters(d.Get("content_filter").([]interface{})),
+			Mode:           &mode,
+		},
+	}
+}
+
+func expandCognitiveAccountRaiPolicyContentFilters(input []interface{}) *[]raipolicies.RaiPolicyContentFilter {
+	results := make([]raipolicies.RaiPolicyContentFilter, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		severityThreshold := raipolicies.ContentLevel(v["severity_threshold"].(string))
+		source := raipolicies.RaiPolicyContentSource(v["source"].(string))
+		results = append(results, raipolicies.RaiPolicyContentFilter{
+			Name:              utils.String(v["name"].(string)),
+			Enabled:           utils.Bool(v["filter_enabled"].(bool)),
+			Blocking:          utils.Bool(v["block_enabled"].(bool)),
+			SeverityThreshold: &severityThreshold,
+			Source:            &source,
+		})
+	}
+
+	return &results
+}
+
+func flattenCognitiveAccountRaiPolicyContentFilters(input *[]raipolicies.RaiPolicyContentFilter) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		severityThreshold := ""
+		if item.SeverityThreshold != nil {
+			severityThreshold = string(*item.SeverityThreshold)
+		}
+
+		source := ""
+		if item.Source != nil {
+			source = string(*item.Source)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"filter_enabled":     utils.NormaliseNilableBool(item.Enabled),
+			"block_enabled":      utils.NormaliseNilableBool(item.Blocking),
+			"severity_threshold": severityThreshold,
+			"source":             source,
+		})
+	}
+
+	return results
+}
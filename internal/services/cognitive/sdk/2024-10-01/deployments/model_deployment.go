@@ -0,0 +1,10 @@
+package deployments
+
+type Deployment struct {
+	Etag       *string               `json:"etag,omitempty"`
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *DeploymentProperties `json:"properties,omitempty"`
+	Sku        *Sku                  `json:"sku,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
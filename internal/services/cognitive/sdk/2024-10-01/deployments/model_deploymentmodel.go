@@ -0,0 +1,8 @@
+package deployments
+
+type DeploymentModel struct {
+	Format  *string `json:"format,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Source  *string `json:"source,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
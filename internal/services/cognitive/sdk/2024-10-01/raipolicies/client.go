@@ -0,0 +1,15 @@
+package raipolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RaiPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRaiPoliciesClientWithBaseURI(endpoint string) RaiPoliciesClient {
+	return RaiPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,137 @@
+package raipolicies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RaiPolicyId{}
+
+// RaiPolicyId is a struct representing the Resource ID for a Rai Policy
+type RaiPolicyId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	AccountName       string
+	RaiPolicyName     string
+}
+
+// NewRaiPolicyID returns a new RaiPolicyId struct
+func NewRaiPolicyID(subscriptionId string, resourceGroupName string, accountName string, raiPolicyName string) RaiPolicyId {
+	return RaiPolicyId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		AccountName:       accountName,
+		RaiPolicyName:     raiPolicyName,
+	}
+}
+
+// ParseRaiPolicyID parses 'input' into a RaiPolicyId
+func ParseRaiPolicyID(input string) (*RaiPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RaiPolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RaiPolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AccountName, ok = parsed.Parsed["accountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'accountName' was not found in the resource id %q", input)
+	}
+
+	if id.RaiPolicyName, ok = parsed.Parsed["raiPolicyName"]; !ok {
+		return nil, fmt.Errorf("the segment 'raiPolicyName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRaiPolicyIDInsensitively parses 'input' case-insensitively into a RaiPolicyId
+// note: this method should only be used for API response data and not user input
+func ParseRaiPolicyIDInsensitively(input string) (*RaiPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RaiPolicyId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RaiPolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AccountName, ok = parsed.Parsed["accountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'accountName' was not found in the resource id %q", input)
+	}
+
+	if id.RaiPolicyName, ok = parsed.Parsed["raiPolicyName"]; !ok {
+		return nil, fmt.Errorf("the segment 'raiPolicyName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRaiPolicyID checks that 'input' can be parsed as a Rai Policy ID
+func ValidateRaiPolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRaiPolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Rai Policy ID
+func (id RaiPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CognitiveServices/accounts/%s/raiPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.RaiPolicyName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Rai Policy ID
+func (id RaiPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCognitiveServices", "Microsoft.CognitiveServices", "Microsoft.CognitiveServices"),
+		resourceids.StaticSegment("staticAccounts", "accounts", "accounts"),
+		resourceids.UserSpecifiedSegment("accountName", "accountValue"),
+		resourceids.StaticSegment("staticRaiPolicies", "raiPolicies", "raiPolicies"),
+		resourceids.UserSpecifiedSegment("raiPolicyName", "raiPolicyValue"),
+	}
+}
+
+// String returns a human-readable description of this Rai Policy ID
+func (id RaiPolicyId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Account Name: %q", id.AccountName),
+		fmt.Sprintf("Rai Policy Name: %q", id.RaiPolicyName),
+	}
+	return fmt.Sprintf("Rai Policy (%s)", strings.Join(components, "\n"))
+}
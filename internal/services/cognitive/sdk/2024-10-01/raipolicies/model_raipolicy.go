@@ -0,0 +1,10 @@
+package raipolicies
+
+type RaiPolicy struct {
+	Etag       *string              `json:"etag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *RaiPolicyProperties `json:"properties,omitempty"`
+	Tags       *map[string]string   `json:"tags,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
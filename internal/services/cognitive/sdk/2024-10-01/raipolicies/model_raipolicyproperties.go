@@ -0,0 +1,8 @@
+package raipolicies
+
+type RaiPolicyProperties struct {
+	BasePolicyName *string                   `json:"basePolicyName,omitempty"`
+	ContentFilters *[]RaiPolicyContentFilter `json:"contentFilters,omitempty"`
+	Mode           *RaiPolicyMode            `json:"mode,omitempty"`
+	Type           *RaiPolicyType            `json:"type,omitempty"`
+}
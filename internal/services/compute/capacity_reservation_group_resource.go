@@ -0,0 +1,383 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2023-09-01/capacityreservationgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = CapacityReservationGroupResource{}
+
+type CapacityReservationGroupResource struct{}
+
+type CapacityReservationGroupModel struct {
+	Name                           string                                        `tfschema:"name"`
+	ResourceGroupName              string                                        `tfschema:"resource_group_name"`
+	Location                       string                                        `tfschema:"location"`
+	Zones                          []string                                      `tfschema:"zones"`
+	SharingProfile                 []CapacityReservationGroupSharingProfileModel `tfschema:"sharing_profile"`
+	Tags                           map[string]string                             `tfschema:"tags"`
+	CapacityReservationIds         []string                                      `tfschema:"capacity_reservation_ids"`
+	VirtualMachineIds              []string                                      `tfschema:"virtual_machine_ids"`
+	CapacityReservationUtilization []CapacityReservationGroupUtilizationModel    `tfschema:"capacity_reservation_utilization"`
+}
+
+type CapacityReservationGroupSharingProfileModel struct {
+	SubscriptionIds []string `tfschema:"subscription_ids"`
+}
+
+type CapacityReservationGroupUtilizationModel struct {
+	Name              string   `tfschema:"name"`
+	CurrentCapacity   int      `tfschema:"current_capacity"`
+	VirtualMachineIds []string `tfschema:"virtual_machine_ids"`
+}
+
+func (r CapacityReservationGroupResource) ResourceType() string {
+	return "azurerm_capacity_reservation_group"
+}
+
+func (r CapacityReservationGroupResource) ModelObject() interface{} {
+	return &CapacityReservationGroupModel{}
+}
+
+func (r CapacityReservationGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return capacityreservationgroups.ValidateCapacityReservationGroupID
+}
+
+func (r CapacityReservationGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"zones": azure.SchemaZones(),
+
+		"sharing_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subscription_ids": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateSubscriptionID,
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r CapacityReservationGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"capacity_reservation_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"virtual_machine_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"capacity_reservation_utilization": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"current_capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"virtual_machine_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CapacityReservationGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.CapacityReservationGroupsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model CapacityReservationGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := capacityreservationgroups.NewCapacityReservationGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id, capacityreservationgroups.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := capacityreservationgroups.CapacityReservationGroup{
+				Location: location.Normalize(model.Location),
+				Properties: &capacityreservationgroups.CapacityReservationGroupProperties{
+					SharingProfile: expandCapacityReservationGroupSharingProfile(model.SharingProfile),
+				},
+				Tags: &model.Tags,
+			}
+
+			if len(model.Zones) > 0 {
+				payload.Zones = &model.Zones
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CapacityReservationGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.CapacityReservationGroupsClient
+
+			id, err := capacityreservationgroups.ParseCapacityReservationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := capacityreservationgroups.DefaultGetOperationOptions()
+			expand := capacityreservationgroups.CapacityReservationGroupInstanceViewTypesInstanceView
+			options.Expand = &expand
+
+			resp, err := client.Get(ctx, *id, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CapacityReservationGroupModel{
+				Name:              id.CapacityReservationGroupName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Zones != nil {
+					state.Zones = *model.Zones
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.SharingProfile = flattenCapacityReservationGroupSharingProfile(props.SharingProfile)
+					state.CapacityReservationIds = flattenCapacityReservationGroupSubResourceIds(props.CapacityReservations)
+					state.VirtualMachineIds = flattenCapacityReservationGroupSubResourceIds(props.VirtualMachinesAssociated)
+					state.CapacityReservationUtilization = flattenCapacityReservationGroupUtilization(props.InstanceView)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CapacityReservationGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.CapacityReservationGroupsClient
+
+			id, err := capacityreservationgroups.ParseCapacityReservationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CapacityReservationGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := capacityreservationgroups.CapacityReservationGroupUpdate{}
+
+			if metadata.ResourceData.HasChange("sharing_profile") {
+				sharingProfile := expandCapacityReservationGroupSharingProfile(model.SharingProfile)
+				if sharingProfile == nil {
+					// an empty list of Subscription IDs is required to stop sharing the Capacity Reservation Group
+					sharingProfile = &capacityreservationgroups.ResourceSharingProfile{
+						SubscriptionIds: &[]capacityreservationgroups.SubResource{},
+					}
+				}
+				payload.Properties = &capacityreservationgroups.CapacityReservationGroupProperties{
+					SharingProfile: sharingProfile,
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CapacityReservationGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.CapacityReservationGroupsClient
+
+			id, err := capacityreservationgroups.ParseCapacityReservationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCapacityReservationGroupSharingProfile(input []CapacityReservationGroupSharingProfileModel) *capacityreservationgroups.ResourceSharingProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	subscriptionIds := make([]capacityreservationgroups.SubResource, 0)
+	for _, v := range input[0].SubscriptionIds {
+		subscriptionIds = append(subscriptionIds, capacityreservationgroups.SubResource{
+			Id: utils.String(v),
+		})
+	}
+
+	return &capacityreservationgroups.ResourceSharingProfile{
+		SubscriptionIds: &subscriptionIds,
+	}
+}
+
+func flattenCapacityReservationGroupSharingProfile(input *capacityreservationgroups.ResourceSharingProfile) []CapacityReservationGroupSharingProfileModel {
+	if input == nil || input.SubscriptionIds == nil || len(*input.SubscriptionIds) == 0 {
+		return []CapacityReservationGroupSharingProfileModel{}
+	}
+
+	subscriptionIds := make([]string, 0)
+	for _, v := range *input.SubscriptionIds {
+		if v.Id == nil {
+			continue
+		}
+
+		subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(*v.Id)
+		if err != nil {
+			subscriptionIds = append(subscriptionIds, *v.Id)
+			continue
+		}
+		subscriptionIds = append(subscriptionIds, subscriptionId.ID())
+	}
+
+	return []CapacityReservationGroupSharingProfileModel{
+		{
+			SubscriptionIds: subscriptionIds,
+		},
+	}
+}
+
+func flattenCapacityReservationGroupSubResourceIds(input *[]capacityreservationgroups.SubResourceReadOnly) []string {
+	ids := make([]string, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.Id != nil {
+			ids = append(ids, *v.Id)
+		}
+	}
+
+	return ids
+}
+
+func flattenCapacityReservationGroupUtilization(input *capacityreservationgroups.CapacityReservationGroupInstanceView) []CapacityReservationGroupUtilizationModel {
+	utilization := make([]CapacityReservationGroupUtilizationModel, 0)
+	if input == nil || input.CapacityReservations == nil {
+		return utilization
+	}
+
+	for _, v := range *input.CapacityReservations {
+		item := CapacityReservationGroupUtilizationModel{
+			Name:              utils.NormalizeNilableString(v.Name),
+			VirtualMachineIds: make([]string, 0),
+		}
+
+		if info := v.UtilizationInfo; info != nil {
+			if info.CurrentCapacity != nil {
+				item.CurrentCapacity = int(*info.CurrentCapacity)
+			}
+			item.VirtualMachineIds = flattenCapacityReservationGroupSubResourceIds(info.VirtualMachinesAllocated)
+		}
+
+		utilization = append(utilization, item)
+	}
+
+	return utilization
+}
@@ -4,38 +4,61 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-01/restorepointcollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-01/restorepoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-02/diskencryptionsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/communitygalleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/communitygalleryimageversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/galleries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/gallerysharingupdate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-08-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2023-09-01/capacityreservationgroups"
 )
 
 type Client struct {
-	AvailabilitySetsClient          *compute.AvailabilitySetsClient
-	DedicatedHostsClient            *compute.DedicatedHostsClient
-	DedicatedHostGroupsClient       *compute.DedicatedHostGroupsClient
-	DisksClient                     *compute.DisksClient
-	DiskAccessClient                *compute.DiskAccessesClient
-	DiskEncryptionSetsClient        *compute.DiskEncryptionSetsClient
-	GalleriesClient                 *compute.GalleriesClient
-	GalleryImagesClient             *compute.GalleryImagesClient
-	GalleryImageVersionsClient      *compute.GalleryImageVersionsClient
-	ProximityPlacementGroupsClient  *compute.ProximityPlacementGroupsClient
-	MarketplaceAgreementsClient     *marketplaceordering.MarketplaceAgreementsClient
-	ImagesClient                    *compute.ImagesClient
-	SnapshotsClient                 *compute.SnapshotsClient
-	UsageClient                     *compute.UsageClient
-	VMExtensionImageClient          *compute.VirtualMachineExtensionImagesClient
-	VMExtensionClient               *compute.VirtualMachineExtensionsClient
-	VMScaleSetClient                *compute.VirtualMachineScaleSetsClient
-	VMScaleSetExtensionsClient      *compute.VirtualMachineScaleSetExtensionsClient
-	VMScaleSetRollingUpgradesClient *compute.VirtualMachineScaleSetRollingUpgradesClient
-	VMScaleSetVMsClient             *compute.VirtualMachineScaleSetVMsClient
-	VMClient                        *compute.VirtualMachinesClient
-	VMImageClient                   *compute.VirtualMachineImagesClient
-	SSHPublicKeysClient             *compute.SSHPublicKeysClient
+	AvailabilitySetsClient              *compute.AvailabilitySetsClient
+	CapacityReservationGroupsClient     *capacityreservationgroups.CapacityReservationGroupsClient
+	CommunityGalleryImagesClient        *communitygalleryimages.CommunityGalleryImagesClient
+	CommunityGalleryImageVersionsClient *communitygalleryimageversions.CommunityGalleryImageVersionsClient
+	DedicatedHostsClient                *compute.DedicatedHostsClient
+	DedicatedHostGroupsClient           *compute.DedicatedHostGroupsClient
+	DisksClient                         *compute.DisksClient
+	DiskAccessClient                    *compute.DiskAccessesClient
+	DiskEncryptionSetsClient            *diskencryptionsets.DiskEncryptionSetsClient
+	GalleriesClient                     *galleries.GalleriesClient
+	GalleryApplicationsClient           *compute.GalleryApplicationsClient
+	GalleryApplicationVersionsClient    *compute.GalleryApplicationVersionsClient
+	GalleryImagesClient                 *compute.GalleryImagesClient
+	GalleryImageVersionsClient          *compute.GalleryImageVersionsClient
+	GallerySharingUpdateClient          *gallerysharingupdate.GallerySharingUpdateClient
+	ProximityPlacementGroupsClient      *compute.ProximityPlacementGroupsClient
+	MarketplaceAgreementsClient         *marketplaceordering.MarketplaceAgreementsClient
+	ImagesClient                        *compute.ImagesClient
+	RestorePointCollectionsClient       *restorepointcollections.RestorePointCollectionsClient
+	RestorePointsClient                 *restorepoints.RestorePointsClient
+	SnapshotsClient                     *compute.SnapshotsClient
+	UsageClient                         *compute.UsageClient
+	VMExtensionImageClient              *compute.VirtualMachineExtensionImagesClient
+	VMExtensionClient                   *compute.VirtualMachineExtensionsClient
+	VMRunCommandsClient                 *compute.VirtualMachineRunCommandsClient
+	VMScaleSetClient                    *compute.VirtualMachineScaleSetsClient
+	VMScaleSetExtensionsClient          *compute.VirtualMachineScaleSetExtensionsClient
+	VMScaleSetRollingUpgradesClient     *compute.VirtualMachineScaleSetRollingUpgradesClient
+	VMScaleSetVMsClient                 *compute.VirtualMachineScaleSetVMsClient
+	VMScaleSetVMRunCommandsClient       *compute.VirtualMachineScaleSetVMRunCommandsClient
+	VirtualMachineScaleSetsClient       *virtualmachinescalesets.VirtualMachineScaleSetsClient
+	VMClient                            *compute.VirtualMachinesClient
+	VMImageClient                       *compute.VirtualMachineImagesClient
+	SSHPublicKeysClient                 *compute.SSHPublicKeysClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&availabilitySetsClient.Client, o.ResourceManagerAuthorizer)
 
+	capacityReservationGroupsClient := capacityreservationgroups.NewCapacityReservationGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&capacityReservationGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	dedicatedHostsClient := compute.NewDedicatedHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dedicatedHostsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -48,18 +71,33 @@ func NewClient(o *common.ClientOptions) *Client {
 	diskAccessClient := compute.NewDiskAccessesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&diskAccessClient.Client, o.ResourceManagerAuthorizer)
 
-	diskEncryptionSetsClient := compute.NewDiskEncryptionSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	diskEncryptionSetsClient := diskencryptionsets.NewDiskEncryptionSetsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&diskEncryptionSetsClient.Client, o.ResourceManagerAuthorizer)
 
-	galleriesClient := compute.NewGalleriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	communityGalleryImagesClient := communitygalleryimages.NewCommunityGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&communityGalleryImagesClient.Client, o.ResourceManagerAuthorizer)
+
+	communityGalleryImageVersionsClient := communitygalleryimageversions.NewCommunityGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&communityGalleryImageVersionsClient.Client, o.ResourceManagerAuthorizer)
+
+	galleriesClient := galleries.NewGalleriesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&galleriesClient.Client, o.ResourceManagerAuthorizer)
 
+	galleryApplicationsClient := compute.NewGalleryApplicationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&galleryApplicationsClient.Client, o.ResourceManagerAuthorizer)
+
+	galleryApplicationVersionsClient := compute.NewGalleryApplicationVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&galleryApplicationVersionsClient.Client, o.ResourceManagerAuthorizer)
+
 	galleryImagesClient := compute.NewGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleryImagesClient.Client, o.ResourceManagerAuthorizer)
 
 	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleryImageVersionsClient.Client, o.ResourceManagerAuthorizer)
 
+	gallerySharingUpdateClient := gallerysharingupdate.NewGallerySharingUpdateClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&gallerySharingUpdateClient.Client, o.ResourceManagerAuthorizer)
+
 	imagesClient := compute.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -69,6 +107,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	proximityPlacementGroupsClient := compute.NewProximityPlacementGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&proximityPlacementGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	restorePointCollectionsClient := restorepointcollections.NewRestorePointCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&restorePointCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	restorePointsClient := restorepoints.NewRestorePointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&restorePointsClient.Client, o.ResourceManagerAuthorizer)
+
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -81,6 +125,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmExtensionClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmExtensionClient.Client, o.ResourceManagerAuthorizer)
 
+	vmRunCommandsClient := compute.NewVirtualMachineRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmImageClient := compute.NewVirtualMachineImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmImageClient.Client, o.ResourceManagerAuthorizer)
 
@@ -96,6 +143,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmScaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetVMsClient.Client, o.ResourceManagerAuthorizer)
 
+	vmScaleSetVMRunCommandsClient := compute.NewVirtualMachineScaleSetVMRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetVMRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
+	virtualMachineScaleSetsClient := virtualmachinescalesets.NewVirtualMachineScaleSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualMachineScaleSetsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
@@ -103,28 +156,39 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&sshPublicKeysClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AvailabilitySetsClient:          &availabilitySetsClient,
-		DedicatedHostsClient:            &dedicatedHostsClient,
-		DedicatedHostGroupsClient:       &dedicatedHostGroupsClient,
-		DisksClient:                     &disksClient,
-		DiskAccessClient:                &diskAccessClient,
-		DiskEncryptionSetsClient:        &diskEncryptionSetsClient,
-		GalleriesClient:                 &galleriesClient,
-		GalleryImagesClient:             &galleryImagesClient,
-		GalleryImageVersionsClient:      &galleryImageVersionsClient,
-		ImagesClient:                    &imagesClient,
-		MarketplaceAgreementsClient:     &marketplaceAgreementsClient,
-		ProximityPlacementGroupsClient:  &proximityPlacementGroupsClient,
-		SnapshotsClient:                 &snapshotsClient,
-		UsageClient:                     &usageClient,
-		VMExtensionImageClient:          &vmExtensionImageClient,
-		VMExtensionClient:               &vmExtensionClient,
-		VMScaleSetClient:                &vmScaleSetClient,
-		VMScaleSetExtensionsClient:      &vmScaleSetExtensionsClient,
-		VMScaleSetRollingUpgradesClient: &vmScaleSetRollingUpgradesClient,
-		VMScaleSetVMsClient:             &vmScaleSetVMsClient,
-		VMClient:                        &vmClient,
-		VMImageClient:                   &vmImageClient,
-		SSHPublicKeysClient:             &sshPublicKeysClient,
+		AvailabilitySetsClient:              &availabilitySetsClient,
+		CapacityReservationGroupsClient:     &capacityReservationGroupsClient,
+		CommunityGalleryImagesClient:        &communityGalleryImagesClient,
+		CommunityGalleryImageVersionsClient: &communityGalleryImageVersionsClient,
+		DedicatedHostsClient:                &dedicatedHostsClient,
+		DedicatedHostGroupsClient:           &dedicatedHostGroupsClient,
+		DisksClient:                         &disksClient,
+		DiskAccessClient:                    &diskAccessClient,
+		DiskEncryptionSetsClient:            &diskEncryptionSetsClient,
+		GalleriesClient:                     &galleriesClient,
+		GalleryApplicationsClient:           &galleryApplicationsClient,
+		GalleryApplicationVersionsClient:    &galleryApplicationVersionsClient,
+		GalleryImagesClient:                 &galleryImagesClient,
+		GalleryImageVersionsClient:          &galleryImageVersionsClient,
+		GallerySharingUpdateClient:          &gallerySharingUpdateClient,
+		ImagesClient:                        &imagesClient,
+		MarketplaceAgreementsClient:         &marketplaceAgreementsClient,
+		ProximityPlacementGroupsClient:      &proximityPlacementGroupsClient,
+		RestorePointCollectionsClient:       &restorePointCollectionsClient,
+		RestorePointsClient:                 &restorePointsClient,
+		SnapshotsClient:                     &snapshotsClient,
+		UsageClient:                         &usageClient,
+		VMExtensionImageClient:              &vmExtensionImageClient,
+		VMExtensionClient:                   &vmExtensionClient,
+		VMRunCommandsClient:                 &vmRunCommandsClient,
+		VMScaleSetClient:                    &vmScaleSetClient,
+		VMScaleSetExtensionsClient:          &vmScaleSetExtensionsClient,
+		VMScaleSetRollingUpgradesClient:     &vmScaleSetRollingUpgradesClient,
+		VMScaleSetVMsClient:                 &vmScaleSetVMsClient,
+		VMScaleSetVMRunCommandsClient:       &vmScaleSetVMRunCommandsClient,
+		VirtualMachineScaleSetsClient:       &virtualMachineScaleSetsClient,
+		VMClient:                            &vmClient,
+		VMImageClient:                       &vmImageClient,
+		SSHPublicKeysClient:                 &sshPublicKeysClient,
 	}
 }
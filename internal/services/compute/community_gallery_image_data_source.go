@@ -0,0 +1,178 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/communitygalleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.DataSource = CommunityGalleryImageDataSource{}
+
+type CommunityGalleryImageDataSource struct{}
+
+type CommunityGalleryImageDataSourceModel struct {
+	Name                string                                 `tfschema:"name"`
+	GalleryName         string                                 `tfschema:"gallery_name"`
+	Location            string                                 `tfschema:"location"`
+	Architecture        string                                 `tfschema:"architecture"`
+	EndOfLifeDate       string                                 `tfschema:"end_of_life_date"`
+	HyperVGeneration    string                                 `tfschema:"hyper_v_generation"`
+	Identifier          []CommunityGalleryImageIdentifierModel `tfschema:"identifier"`
+	OSState             string                                 `tfschema:"os_state"`
+	OSType              string                                 `tfschema:"os_type"`
+	PrivacyStatementURI string                                 `tfschema:"privacy_statement_uri"`
+}
+
+type CommunityGalleryImageIdentifierModel struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Sku       string `tfschema:"sku"`
+}
+
+func (d CommunityGalleryImageDataSource) ResourceType() string {
+	return "azurerm_community_gallery_image"
+}
+
+func (d CommunityGalleryImageDataSource) ModelObject() interface{} {
+	return &CommunityGalleryImageDataSourceModel{}
+}
+
+func (d CommunityGalleryImageDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"gallery_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+	}
+}
+
+func (d CommunityGalleryImageDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"architecture": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"end_of_life_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"hyper_v_generation": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"identifier": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"offer": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sku": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"os_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"privacy_statement_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d CommunityGalleryImageDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.CommunityGalleryImagesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state CommunityGalleryImageDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := communitygalleryimages.NewImageID(subscriptionId, location.Normalize(state.Location), state.GalleryName, state.Name)
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.Location = location.Normalize(state.Location)
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.Architecture != nil {
+						state.Architecture = string(*props.Architecture)
+					}
+					if props.HyperVGeneration != nil {
+						state.HyperVGeneration = string(*props.HyperVGeneration)
+					}
+					state.EndOfLifeDate = utils.NormalizeNilableString(props.EndOfLifeDate)
+					state.OSState = string(props.OsState)
+					state.OSType = string(props.OsType)
+					state.PrivacyStatementURI = utils.NormalizeNilableString(props.PrivacyStatementUri)
+					state.Identifier = []CommunityGalleryImageIdentifierModel{
+						{
+							Publisher: props.Identifier.Publisher,
+							Offer:     props.Identifier.Offer,
+							Sku:       props.Identifier.Sku,
+						},
+					}
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/communitygalleryimageversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.DataSource = CommunityGalleryImageVersionDataSource{}
+
+type CommunityGalleryImageVersionDataSource struct{}
+
+type CommunityGalleryImageVersionDataSourceModel struct {
+	Name              string `tfschema:"name"`
+	ImageName         string `tfschema:"image_name"`
+	GalleryName       string `tfschema:"gallery_name"`
+	Location          string `tfschema:"location"`
+	EndOfLifeDate     string `tfschema:"end_of_life_date"`
+	ExcludeFromLatest bool   `tfschema:"exclude_from_latest"`
+	PublishedDate     string `tfschema:"published_date"`
+}
+
+func (d CommunityGalleryImageVersionDataSource) ResourceType() string {
+	return "azurerm_community_gallery_image_version"
+}
+
+func (d CommunityGalleryImageVersionDataSource) ModelObject() interface{} {
+	return &CommunityGalleryImageVersionDataSourceModel{}
+}
+
+func (d CommunityGalleryImageVersionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"image_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"gallery_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+	}
+}
+
+func (d CommunityGalleryImageVersionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"end_of_life_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"exclude_from_latest": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"published_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d CommunityGalleryImageVersionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.CommunityGalleryImageVersionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state CommunityGalleryImageVersionDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := communitygalleryimageversions.NewVersionID(subscriptionId, location.Normalize(state.Location), state.GalleryName, state.ImageName, state.Name)
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.Location = location.Normalize(state.Location)
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EndOfLifeDate = utils.NormalizeNilableString(props.EndOfLifeDate)
+					state.PublishedDate = utils.NormalizeNilableString(props.PublishedDate)
+					if props.ExcludeFromLatest != nil {
+						state.ExcludeFromLatest = *props.ExcludeFromLatest
+					}
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -5,29 +5,29 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-02/diskencryptionsets"
 )
 
 // retrieveDiskEncryptionSetEncryptionType returns encryption type of the disk encryption set
-func retrieveDiskEncryptionSetEncryptionType(ctx context.Context, client *compute.DiskEncryptionSetsClient, diskEncryptionSetId string) (*compute.EncryptionType, error) {
-	diskEncryptionSet, err := parse.DiskEncryptionSetID(diskEncryptionSetId)
+func retrieveDiskEncryptionSetEncryptionType(ctx context.Context, client *diskencryptionsets.DiskEncryptionSetsClient, diskEncryptionSetId string) (*compute.EncryptionType, error) {
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(diskEncryptionSetId)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := client.Get(ctx, diskEncryptionSet.ResourceGroup, diskEncryptionSet.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %s: %+v", *diskEncryptionSet, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	var encryptionType *compute.EncryptionType
-	if props := resp.EncryptionSetProperties; props != nil && string(props.EncryptionType) != "" {
-		v := compute.EncryptionType(props.EncryptionType)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.EncryptionType != nil && *model.Properties.EncryptionType != "" {
+		v := compute.EncryptionType(*model.Properties.EncryptionType)
 		encryptionType = &v
 	}
 
 	if encryptionType == nil {
-		return nil, fmt.Errorf("retrieving %s: EncryptionType was nil", *diskEncryptionSet)
+		return nil, fmt.Errorf("retrieving %s: EncryptionType was nil", *id)
 	}
 
 	return encryptionType, nil
@@ -6,11 +6,15 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	resourceManagerTags "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-02/diskencryptionsets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
@@ -38,7 +42,7 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DiskEncryptionSetID(id)
+			_, err := diskencryptionsets.ParseDiskEncryptionSetID(id)
 			return err
 		}),
 
@@ -69,13 +73,19 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
+				Default:  string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
-					string(compute.DiskEncryptionSetTypeEncryptionAtRestWithPlatformAndCustomerKeys),
+					string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
+					string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithPlatformAndCustomerKeys),
 				}, false),
 			},
 
+			"federated_client_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"identity": {
 				Type: pluginsdk.TypeList,
 				// whilst the API Documentation shows optional - attempting to send nothing returns:
@@ -89,9 +99,19 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(compute.DiskEncryptionSetIdentityTypeSystemAssigned),
+								string(identity.TypeSystemAssigned),
+								string(identity.TypeUserAssigned),
+								string(identity.TypeSystemAssignedUserAssigned),
 							}, false),
 						},
+						"identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+							},
+						},
 						"principal_id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -113,20 +133,19 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	resourcesClient := meta.(*clients.Client).Resource
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
-
-	existing, err := client.Get(ctx, resourceGroup, name)
+	id := diskencryptionsets.NewDiskEncryptionSetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for present of existing Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
-	if existing.ID != nil && *existing.ID != "" {
-		return tf.ImportAsExistsError("azurerm_disk_encryption_set", *existing.ID)
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_disk_encryption_set", id.ID())
 	}
 
 	keyVaultKeyId := d.Get("key_vault_key_id").(string)
@@ -141,44 +160,40 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("validating Key Vault %q (Resource Group %q) for Disk Encryption Set: Purge Protection must be enabled but it isn't!", keyVaultDetails.keyVaultName, keyVaultDetails.resourceGroupName)
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	rotationToLatestKeyVersionEnabled := d.Get("auto_key_rotation_enabled").(bool)
-	encryptionType := d.Get("encryption_type").(string)
-	identityRaw := d.Get("identity").([]interface{})
-	t := d.Get("tags").(map[string]interface{})
-
-	params := compute.DiskEncryptionSet{
-		Location: utils.String(location),
-		EncryptionSetProperties: &compute.EncryptionSetProperties{
-			ActiveKey: &compute.KeyForDiskEncryptionSet{
-				KeyURL: utils.String(keyVaultKeyId),
-				SourceVault: &compute.SourceVault{
-					ID: utils.String(keyVaultDetails.keyVaultId),
+	expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	encryptionType := diskencryptionsets.DiskEncryptionSetType(d.Get("encryption_type").(string))
+	params := diskencryptionsets.DiskEncryptionSet{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &diskencryptionsets.EncryptionSetProperties{
+			ActiveKey: &diskencryptionsets.KeyForDiskEncryptionSet{
+				KeyUrl: keyVaultKeyId,
+				SourceVault: &diskencryptionsets.SourceVault{
+					Id: utils.String(keyVaultDetails.keyVaultId),
 				},
 			},
-			RotationToLatestKeyVersionEnabled: utils.Bool(rotationToLatestKeyVersionEnabled),
-			EncryptionType:                    compute.DiskEncryptionSetType(encryptionType),
+			RotationToLatestKeyVersionEnabled: utils.Bool(d.Get("auto_key_rotation_enabled").(bool)),
+			EncryptionType:                    &encryptionType,
 		},
-		Identity: expandDiskEncryptionSetIdentity(identityRaw),
-		Tags:     tags.Expand(t),
+		Identity: expandedIdentity,
+		Tags:     resourceManagerTags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
-	if err != nil {
-		return fmt.Errorf("creating Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if v, ok := d.GetOk("federated_client_id"); ok {
+		if expandedIdentity.Type != identity.TypeUserAssigned && expandedIdentity.Type != identity.TypeSystemAssignedUserAssigned {
+			return fmt.Errorf("`federated_client_id` can only be specified when `identity.0.type` is set to %q or %q", string(identity.TypeUserAssigned), string(identity.TypeSystemAssignedUserAssigned))
+		}
+		params.Properties.FederatedClientId = utils.String(v.(string))
 	}
 
-	resp, err := client.Get(ctx, resourceGroup, name)
-	if err != nil {
-		return fmt.Errorf("retrieving Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-	if resp.ID == nil {
-		return fmt.Errorf("Cannot read Disk Encryption Set %q (Resource Group %q) ID", name, resourceGroup)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, params); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
-	d.SetId(*resp.ID)
+
+	d.SetId(id.ID())
 
 	return resourceDiskEncryptionSetRead(d, meta)
 }
@@ -188,47 +203,67 @@ func resourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[INFO] Disk Encryption Set %q does not exist - removing from state", d.Id())
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("reading Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-
-	if props := resp.EncryptionSetProperties; props != nil {
-		keyVaultKeyId := ""
-		if props.ActiveKey != nil && props.ActiveKey.KeyURL != nil {
-			keyVaultKeyId = *props.ActiveKey.KeyURL
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DiskEncryptionSetName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			keyVaultKeyId := ""
+			if props.ActiveKey != nil {
+				keyVaultKeyId = props.ActiveKey.KeyUrl
+			}
+			d.Set("key_vault_key_id", keyVaultKeyId)
+
+			autoKeyRotationEnabled := false
+			if props.RotationToLatestKeyVersionEnabled != nil {
+				autoKeyRotationEnabled = *props.RotationToLatestKeyVersionEnabled
+			}
+			d.Set("auto_key_rotation_enabled", autoKeyRotationEnabled)
+
+			encryptionType := string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey)
+			if props.EncryptionType != nil && *props.EncryptionType != "" {
+				encryptionType = string(*props.EncryptionType)
+			}
+			d.Set("encryption_type", encryptionType)
+
+			federatedClientId := ""
+			if props.FederatedClientId != nil && *props.FederatedClientId != "None" {
+				federatedClientId = *props.FederatedClientId
+			}
+			d.Set("federated_client_id", federatedClientId)
 		}
-		d.Set("key_vault_key_id", keyVaultKeyId)
-		d.Set("auto_key_rotation_enabled", props.RotationToLatestKeyVersionEnabled)
 
-		encryptionType := string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey)
-		if props.EncryptionType != "" {
-			encryptionType = string(props.EncryptionType)
+		flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
 		}
-		d.Set("encryption_type", encryptionType)
-	}
 
-	if err := d.Set("identity", flattenDiskEncryptionSetIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("setting `identity`: %+v", err)
+		if err := tags.FlattenAndSet(d, resourceManagerTags.Flatten(model.Tags)); err != nil {
+			return err
+		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -238,14 +273,24 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	update := compute.DiskEncryptionSetUpdate{}
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	update := diskencryptionsets.DiskEncryptionSetUpdate{
+		Properties: &diskencryptionsets.DiskEncryptionSetUpdateProperties{},
+	}
+	if d.HasChanges("tags", "tags_all") {
+		update.Tags = resourceManagerTags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+	if d.HasChange("identity") {
+		update.Identity = expandedIdentity
 	}
 
 	if d.HasChange("key_vault_key_id") {
@@ -260,30 +305,32 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 		if !keyVaultDetails.purgeProtectionEnabled {
 			return fmt.Errorf("validating Key Vault %q (Resource Group %q) for Disk Encryption Set: Purge Protection must be enabled but it isn't!", keyVaultDetails.keyVaultName, keyVaultDetails.resourceGroupName)
 		}
-		update.DiskEncryptionSetUpdateProperties = &compute.DiskEncryptionSetUpdateProperties{
-			ActiveKey: &compute.KeyForDiskEncryptionSet{
-				KeyURL: utils.String(keyVaultKeyId),
-				SourceVault: &compute.SourceVault{
-					ID: utils.String(keyVaultDetails.keyVaultId),
-				},
+		update.Properties.ActiveKey = &diskencryptionsets.KeyForDiskEncryptionSet{
+			KeyUrl: keyVaultKeyId,
+			SourceVault: &diskencryptionsets.SourceVault{
+				Id: utils.String(keyVaultDetails.keyVaultId),
 			},
 		}
 	}
 
 	if d.HasChange("auto_key_rotation_enabled") {
-		if update.DiskEncryptionSetUpdateProperties == nil {
-			update.DiskEncryptionSetUpdateProperties = &compute.DiskEncryptionSetUpdateProperties{}
-		}
-
-		update.DiskEncryptionSetUpdateProperties.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("auto_key_rotation_enabled").(bool))
+		update.Properties.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("auto_key_rotation_enabled").(bool))
 	}
 
-	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
-	if err != nil {
-		return fmt.Errorf("updating Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if d.HasChange("federated_client_id") {
+		// the API requires the literal value `None` to remove a previously configured Federated Client ID
+		federatedClientId := "None"
+		if v := d.Get("federated_client_id").(string); v != "" {
+			if expandedIdentity.Type != identity.TypeUserAssigned && expandedIdentity.Type != identity.TypeSystemAssignedUserAssigned {
+				return fmt.Errorf("`federated_client_id` can only be specified when `identity.0.type` is set to %q or %q", string(identity.TypeUserAssigned), string(identity.TypeSystemAssignedUserAssigned))
+			}
+			federatedClientId = v
+		}
+		update.Properties.FederatedClientId = utils.String(federatedClientId)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+
+	if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
 	return resourceDiskEncryptionSetRead(d, meta)
@@ -294,54 +341,18 @@ func resourceDiskEncryptionSetDelete(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
 
-func expandDiskEncryptionSetIdentity(input []interface{}) *compute.EncryptionSetIdentity {
-	val := input[0].(map[string]interface{})
-	return &compute.EncryptionSetIdentity{
-		Type: compute.DiskEncryptionSetIdentityType(val["type"].(string)),
-	}
-}
-
-func flattenDiskEncryptionSetIdentity(input *compute.EncryptionSetIdentity) []interface{} {
-	if input == nil {
-		return make([]interface{}, 0)
-	}
-
-	identityType := string(input.Type)
-	principalId := ""
-	if input.PrincipalID != nil {
-		principalId = *input.PrincipalID
-	}
-	tenantId := ""
-	if input.TenantID != nil {
-		tenantId = *input.TenantID
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"type":         identityType,
-			"principal_id": principalId,
-			"tenant_id":    tenantId,
-		},
-	}
-}
-
 type diskEncryptionSetKeyVault struct {
 	keyVaultId             string
 	resourceGroupName      string
@@ -0,0 +1,300 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = GalleryApplicationResource{}
+
+type GalleryApplicationResource struct{}
+
+type GalleryApplicationModel struct {
+	Name                string            `tfschema:"name"`
+	GalleryId           string            `tfschema:"gallery_id"`
+	Location            string            `tfschema:"location"`
+	SupportedOSType     string            `tfschema:"supported_os_type"`
+	Description         string            `tfschema:"description"`
+	EndOfLifeDate       string            `tfschema:"end_of_life_date"`
+	Eula                string            `tfschema:"eula"`
+	PrivacyStatementURI string            `tfschema:"privacy_statement_uri"`
+	ReleaseNoteURI      string            `tfschema:"release_note_uri"`
+	Tags                map[string]string `tfschema:"tags"`
+}
+
+func (r GalleryApplicationResource) ResourceType() string {
+	return "azurerm_gallery_application"
+}
+
+func (r GalleryApplicationResource) ModelObject() interface{} {
+	return &GalleryApplicationModel{}
+}
+
+func (r GalleryApplicationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.GalleryApplicationID
+}
+
+func (r GalleryApplicationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GalleryApplicationName,
+		},
+
+		"gallery_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SharedImageGalleryID,
+		},
+
+		"location": commonschema.Location(),
+
+		"supported_os_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(compute.OperatingSystemTypesLinux),
+				string(compute.OperatingSystemTypesWindows),
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"end_of_life_date": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"eula": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"privacy_statement_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"release_note_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r GalleryApplicationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GalleryApplicationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationsClient
+
+			var model GalleryApplicationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			galleryId, err := parse.SharedImageGalleryID(model.GalleryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewGalleryApplicationID(galleryId.SubscriptionId, galleryId.ResourceGroup, galleryId.GalleryName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandGalleryApplication(model)
+			if err != nil {
+				return err
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, *payload)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r GalleryApplicationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationsClient
+
+			id, err := parse.GalleryApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := GalleryApplicationModel{
+				Name:      id.ApplicationName,
+				GalleryId: parse.NewSharedImageGalleryID(id.SubscriptionId, id.ResourceGroup, id.GalleryName).ID(),
+				Tags:      tags.ToTypedObject(resp.Tags),
+			}
+
+			if resp.Location != nil {
+				model.Location = location.Normalize(*resp.Location)
+			}
+
+			if props := resp.GalleryApplicationProperties; props != nil {
+				model.SupportedOSType = string(props.SupportedOSType)
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.Eula = utils.NormalizeNilableString(props.Eula)
+				model.PrivacyStatementURI = utils.NormalizeNilableString(props.PrivacyStatementURI)
+				model.ReleaseNoteURI = utils.NormalizeNilableString(props.ReleaseNoteURI)
+
+				if props.EndOfLifeDate != nil {
+					model.EndOfLifeDate = props.EndOfLifeDate.Format(time.RFC3339)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r GalleryApplicationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationsClient
+
+			id, err := parse.GalleryApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GalleryApplicationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandGalleryApplication(model)
+			if err != nil {
+				return err
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, *payload)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GalleryApplicationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationsClient
+
+			id, err := parse.GalleryApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandGalleryApplication(model GalleryApplicationModel) (*compute.GalleryApplication, error) {
+	props := &compute.GalleryApplicationProperties{
+		SupportedOSType: compute.OperatingSystemTypes(model.SupportedOSType),
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+
+	if model.EndOfLifeDate != "" {
+		endOfLifeDate, err := time.Parse(time.RFC3339, model.EndOfLifeDate)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `end_of_life_date`: %+v", err)
+		}
+		props.EndOfLifeDate = &date.Time{Time: endOfLifeDate}
+	}
+
+	if model.Eula != "" {
+		props.Eula = utils.String(model.Eula)
+	}
+
+	if model.PrivacyStatementURI != "" {
+		props.PrivacyStatementURI = utils.String(model.PrivacyStatementURI)
+	}
+
+	if model.ReleaseNoteURI != "" {
+		props.ReleaseNoteURI = utils.String(model.ReleaseNoteURI)
+	}
+
+	return &compute.GalleryApplication{
+		Location:                     utils.String(location.Normalize(model.Location)),
+		GalleryApplicationProperties: props,
+		Tags:                         tags.FromTypedObject(model.Tags),
+	}, nil
+}
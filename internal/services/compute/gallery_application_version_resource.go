@@ -0,0 +1,461 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = GalleryApplicationVersionResource{}
+
+type GalleryApplicationVersionResource struct{}
+
+type GalleryApplicationVersionModel struct {
+	Name                 string                                  `tfschema:"name"`
+	GalleryApplicationId string                                  `tfschema:"gallery_application_id"`
+	Location             string                                  `tfschema:"location"`
+	ManageAction         []GalleryApplicationVersionManageAction `tfschema:"manage_action"`
+	Source               []GalleryApplicationVersionSource       `tfschema:"source"`
+	TargetRegion         []GalleryApplicationVersionTargetRegion `tfschema:"target_region"`
+	EnableHealthCheck    bool                                    `tfschema:"enable_health_check"`
+	EndOfLifeDate        string                                  `tfschema:"end_of_life_date"`
+	ExcludeFromLatest    bool                                    `tfschema:"exclude_from_latest"`
+	Tags                 map[string]string                       `tfschema:"tags"`
+}
+
+type GalleryApplicationVersionManageAction struct {
+	Install string `tfschema:"install"`
+	Remove  string `tfschema:"remove"`
+	Update  string `tfschema:"update"`
+}
+
+type GalleryApplicationVersionSource struct {
+	MediaLink                string `tfschema:"media_link"`
+	DefaultConfigurationLink string `tfschema:"default_configuration_link"`
+}
+
+type GalleryApplicationVersionTargetRegion struct {
+	Name                 string `tfschema:"name"`
+	RegionalReplicaCount int    `tfschema:"regional_replica_count"`
+	StorageAccountType   string `tfschema:"storage_account_type"`
+}
+
+func (r GalleryApplicationVersionResource) ResourceType() string {
+	return "azurerm_gallery_application_version"
+}
+
+func (r GalleryApplicationVersionResource) ModelObject() interface{} {
+	return &GalleryApplicationVersionModel{}
+}
+
+func (r GalleryApplicationVersionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.GalleryApplicationVersionID
+}
+
+func (r GalleryApplicationVersionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GalleryApplicationVersionName,
+		},
+
+		"gallery_application_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GalleryApplicationID,
+		},
+
+		"location": commonschema.Location(),
+
+		"manage_action": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"install": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 4096),
+					},
+
+					"remove": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 4096),
+					},
+
+					"update": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 4096),
+					},
+				},
+			},
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"media_link": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"default_configuration_link": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+
+		"target_region": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateFunc:     location.EnhancedValidate,
+						StateFunc:        location.StateFunc,
+						DiffSuppressFunc: location.DiffSuppressFunc,
+					},
+
+					"regional_replica_count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"storage_account_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(compute.StorageAccountTypeStandardLRS),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(compute.StorageAccountTypePremiumLRS),
+							string(compute.StorageAccountTypeStandardLRS),
+							string(compute.StorageAccountTypeStandardZRS),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"enable_health_check": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"end_of_life_date": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"exclude_from_latest": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r GalleryApplicationVersionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GalleryApplicationVersionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationVersionsClient
+
+			var model GalleryApplicationVersionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := parse.GalleryApplicationID(model.GalleryApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewGalleryApplicationVersionID(applicationId.SubscriptionId, applicationId.ResourceGroup, applicationId.GalleryName, applicationId.ApplicationName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandGalleryApplicationVersion(model)
+			if err != nil {
+				return err
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, *payload)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r GalleryApplicationVersionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationVersionsClient
+
+			id, err := parse.GalleryApplicationVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := GalleryApplicationVersionModel{
+				Name:                 id.VersionName,
+				GalleryApplicationId: parse.NewGalleryApplicationID(id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ApplicationName).ID(),
+				Tags:                 tags.ToTypedObject(resp.Tags),
+			}
+
+			if resp.Location != nil {
+				model.Location = location.Normalize(*resp.Location)
+			}
+
+			if props := resp.GalleryApplicationVersionProperties; props != nil {
+				if profile := props.PublishingProfile; profile != nil {
+					model.ManageAction = flattenGalleryApplicationVersionManageAction(profile.ManageActions)
+					model.Source = flattenGalleryApplicationVersionSource(profile.Source)
+					model.TargetRegion = flattenGalleryApplicationVersionTargetRegions(profile.TargetRegions)
+
+					if profile.EnableHealthCheck != nil {
+						model.EnableHealthCheck = *profile.EnableHealthCheck
+					}
+
+					if profile.EndOfLifeDate != nil {
+						model.EndOfLifeDate = profile.EndOfLifeDate.Format(time.RFC3339)
+					}
+
+					if profile.ExcludeFromLatest != nil {
+						model.ExcludeFromLatest = *profile.ExcludeFromLatest
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r GalleryApplicationVersionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationVersionsClient
+
+			id, err := parse.GalleryApplicationVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GalleryApplicationVersionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandGalleryApplicationVersion(model)
+			if err != nil {
+				return err
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, *payload)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GalleryApplicationVersionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryApplicationVersionsClient
+
+			id, err := parse.GalleryApplicationVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandGalleryApplicationVersion(model GalleryApplicationVersionModel) (*compute.GalleryApplicationVersion, error) {
+	profile := &compute.GalleryApplicationVersionPublishingProfile{
+		EnableHealthCheck: utils.Bool(model.EnableHealthCheck),
+		ExcludeFromLatest: utils.Bool(model.ExcludeFromLatest),
+		TargetRegions:     expandGalleryApplicationVersionTargetRegions(model.TargetRegion),
+	}
+
+	if len(model.ManageAction) > 0 {
+		manageAction := model.ManageAction[0]
+		profile.ManageActions = &compute.UserArtifactManage{
+			Install: utils.String(manageAction.Install),
+			Remove:  utils.String(manageAction.Remove),
+		}
+		if manageAction.Update != "" {
+			profile.ManageActions.Update = utils.String(manageAction.Update)
+		}
+	}
+
+	if len(model.Source) > 0 {
+		source := model.Source[0]
+		profile.Source = &compute.UserArtifactSource{
+			MediaLink: utils.String(source.MediaLink),
+		}
+		if source.DefaultConfigurationLink != "" {
+			profile.Source.DefaultConfigurationLink = utils.String(source.DefaultConfigurationLink)
+		}
+	}
+
+	if model.EndOfLifeDate != "" {
+		endOfLifeDate, err := time.Parse(time.RFC3339, model.EndOfLifeDate)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `end_of_life_date`: %+v", err)
+		}
+		profile.EndOfLifeDate = &date.Time{Time: endOfLifeDate}
+	}
+
+	return &compute.GalleryApplicationVersion{
+		Location: utils.String(location.Normalize(model.Location)),
+		GalleryApplicationVersionProperties: &compute.GalleryApplicationVersionProperties{
+			PublishingProfile: profile,
+		},
+		Tags: tags.FromTypedObject(model.Tags),
+	}, nil
+}
+
+func expandGalleryApplicationVersionTargetRegions(input []GalleryApplicationVersionTargetRegion) *[]compute.TargetRegion {
+	results := make([]compute.TargetRegion, 0)
+	for _, v := range input {
+		results = append(results, compute.TargetRegion{
+			Name:                 utils.String(location.Normalize(v.Name)),
+			RegionalReplicaCount: utils.Int32(int32(v.RegionalReplicaCount)),
+			StorageAccountType:   compute.StorageAccountType(v.StorageAccountType),
+		})
+	}
+	return &results
+}
+
+func flattenGalleryApplicationVersionManageAction(input *compute.UserArtifactManage) []GalleryApplicationVersionManageAction {
+	if input == nil {
+		return []GalleryApplicationVersionManageAction{}
+	}
+
+	return []GalleryApplicationVersionManageAction{
+		{
+			Install: utils.NormalizeNilableString(input.Install),
+			Remove:  utils.NormalizeNilableString(input.Remove),
+			Update:  utils.NormalizeNilableString(input.Update),
+		},
+	}
+}
+
+func flattenGalleryApplicationVersionSource(input *compute.UserArtifactSource) []GalleryApplicationVersionSource {
+	if input == nil {
+		return []GalleryApplicationVersionSource{}
+	}
+
+	return []GalleryApplicationVersionSource{
+		{
+			MediaLink:                utils.NormalizeNilableString(input.MediaLink),
+			DefaultConfigurationLink: utils.NormalizeNilableString(input.DefaultConfigurationLink),
+		},
+	}
+}
+
+func flattenGalleryApplicationVersionTargetRegions(input *[]compute.TargetRegion) []GalleryApplicationVersionTargetRegion {
+	results := make([]GalleryApplicationVersionTargetRegion, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		targetRegion := GalleryApplicationVersionTargetRegion{
+			StorageAccountType: string(v.StorageAccountType),
+		}
+
+		if v.Name != nil {
+			targetRegion.Name = location.Normalize(*v.Name)
+		}
+
+		if v.RegionalReplicaCount != nil {
+			targetRegion.RegionalReplicaCount = int(*v.RegionalReplicaCount)
+		}
+
+		results = append(results, targetRegion)
+	}
+
+	return results
+}
@@ -173,6 +173,8 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": galleryApplicationSchema(true),
+
 			"identity": virtualMachineIdentity{}.Schema(),
 
 			"license_type": {
@@ -450,6 +452,10 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	if v, ok := d.GetOk("gallery_application"); ok {
+		params.VirtualMachineProperties.ApplicationProfile = expandGalleryApplications(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("license_type"); ok {
 		params.VirtualMachineProperties.LicenseType = utils.String(v.(string))
 	}
@@ -634,6 +640,10 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("setting `additional_capabilities`: %+v", err)
 	}
 
+	if err := d.Set("gallery_application", flattenGalleryApplications(props.ApplicationProfile)); err != nil {
+		return fmt.Errorf("setting `gallery_application`: %+v", err)
+	}
+
 	availabilitySetId := ""
 	if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
 		availabilitySetId = *props.AvailabilitySet.ID
@@ -1046,7 +1056,7 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		update.OsProfile.AllowExtensionOperations = utils.Bool(allowExtensionOperations)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		shouldUpdate = true
 
 		tagsRaw := d.Get("tags").(map[string]interface{})
@@ -1065,6 +1075,11 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		update.VirtualMachineProperties.AdditionalCapabilities = expandVirtualMachineAdditionalCapabilities(additionalCapabilitiesRaw)
 	}
 
+	if d.HasChange("gallery_application") {
+		shouldUpdate = true
+		update.VirtualMachineProperties.ApplicationProfile = expandGalleryApplications(d.Get("gallery_application").([]interface{}))
+	}
+
 	if d.HasChange("encryption_at_host_enabled") {
 		shouldUpdate = true
 		shouldDeallocate = true // API returns the following error if not deallocate: 'securityProfile.encryptionAtHost' can be updated only when VM is in deallocated state
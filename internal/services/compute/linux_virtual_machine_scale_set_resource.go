@@ -156,6 +156,8 @@ func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"gallery_application": galleryApplicationSchema(false),
+
 			"identity": VirtualMachineScaleSetIdentitySchema(),
 
 			"max_bid_price": {
@@ -500,6 +502,10 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta i
 		virtualMachineProfile.SecurityProfile.UefiSettings.VTpmEnabled = utils.Bool(vtpmEnabled.(bool))
 	}
 
+	if v, ok := d.GetOk("gallery_application"); ok {
+		virtualMachineProfile.ApplicationProfile = expandGalleryApplications(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("user_data"); ok {
 		virtualMachineProfile.UserData = utils.String(v.(string))
 	}
@@ -885,7 +891,7 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta i
 		updateProps.VirtualMachineProfile.ExtensionProfile.ExtensionsTimeBudget = utils.String(d.Get("extensions_time_budget").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -1002,6 +1008,10 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 			return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
 		}
 
+		if err := d.Set("gallery_application", flattenGalleryApplications(profile.ApplicationProfile)); err != nil {
+			return fmt.Errorf("setting `gallery_application`: %+v", err)
+		}
+
 		// defaulted since BillingProfile isn't returned if it's unset
 		maxBidPrice := float64(-1.0)
 		if profile.BillingProfile != nil && profile.BillingProfile.MaxPrice != nil {
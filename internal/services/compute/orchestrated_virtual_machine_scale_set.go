@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-08-01/virtualmachinescalesets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -740,6 +741,30 @@ func OrchestratedVirtualMachineScaleSetAutomaticRepairsPolicySchema() *pluginsdk
 	}
 }
 
+func OrchestratedVirtualMachineScaleSetPriorityMixPolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"base_regular_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"regular_percentage_above_base": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
 func FlattenOrchestratedVirtualMachineScaleSetOSProfile(input *compute.VirtualMachineScaleSetOSProfile, d *pluginsdk.ResourceData) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -1962,3 +1987,43 @@ func FlattenOrchestratedVirtualMachineScaleSetAutomaticRepairsPolicy(input *comp
 		},
 	}
 }
+
+func ExpandOrchestratedVirtualMachineScaleSetPriorityMixPolicy(input []interface{}) *virtualmachinescalesets.PriorityMixPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &virtualmachinescalesets.PriorityMixPolicy{
+		BaseRegularPriorityCount:           utils.Int64(int64(raw["base_regular_count"].(int))),
+		RegularPriorityPercentageAboveBase: utils.Int64(int64(raw["regular_percentage_above_base"].(int))),
+	}
+}
+
+func FlattenOrchestratedVirtualMachineScaleSetPriorityMixPolicy(input *virtualmachinescalesets.PriorityMixPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	baseRegularCount := 0
+	if input.BaseRegularPriorityCount != nil {
+		baseRegularCount = int(*input.BaseRegularPriorityCount)
+	}
+
+	regularPercentageAboveBase := 0
+	if input.RegularPriorityPercentageAboveBase != nil {
+		regularPercentageAboveBase = int(*input.RegularPriorityPercentageAboveBase)
+	}
+
+	// a Scale Set without a Priority Mix Policy (or one which has been reset) returns the defaults
+	if baseRegularCount == 0 && regularPercentageAboveBase == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_regular_count":            baseRegularCount,
+			"regular_percentage_above_base": regularPercentageAboveBase,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-08-01/virtualmachinescalesets"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -157,6 +159,8 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"priority_mix": OrchestratedVirtualMachineScaleSetPriorityMixPolicySchema(),
+
 			"proximity_placement_group_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -408,6 +412,11 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		return fmt.Errorf("an `eviction_policy` must be specified when `priority` is set to `Spot`")
 	}
 
+	priorityMixPolicy := ExpandOrchestratedVirtualMachineScaleSetPriorityMixPolicy(d.Get("priority_mix").([]interface{}))
+	if priorityMixPolicy != nil && virtualMachineProfile.Priority != compute.VirtualMachinePriorityTypesSpot {
+		return fmt.Errorf("a `priority_mix` can only be specified when `priority` is set to `Spot`")
+	}
+
 	if v, ok := d.GetOk("license_type"); ok {
 		virtualMachineProfile.LicenseType = utils.String(v.(string))
 	}
@@ -488,6 +497,15 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 	}
 
 	log.Printf("[DEBUG] Orchestrated Virtual Machine Scale Set %q (Resource Group %q) was created", name, resourceGroup)
+
+	// the Priority Mix Policy isn't available in the API version used for the Scale Set, so is applied separately
+	if priorityMixPolicy != nil {
+		subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+		priorityMixId := virtualmachinescalesets.NewVirtualMachineScaleSetID(subscriptionId, resourceGroup, name)
+		if err := updateOrchestratedVirtualMachineScaleSetPriorityMixPolicy(ctx, meta.(*clients.Client).Compute.VirtualMachineScaleSetsClient, priorityMixId, priorityMixPolicy); err != nil {
+			return err
+		}
+	}
 	log.Printf("[DEBUG] Retrieving Orchestrated Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
 
 	// Upgrading to the 2021-07-01 exposed a new expand parameter in the GET method
@@ -806,7 +824,7 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -833,6 +851,18 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 		return err
 	}
 
+	if d.HasChange("priority_mix") {
+		priorityMixPolicy := ExpandOrchestratedVirtualMachineScaleSetPriorityMixPolicy(d.Get("priority_mix").([]interface{}))
+		if priorityMixPolicy != nil && d.Get("priority").(string) != string(compute.VirtualMachinePriorityTypesSpot) {
+			return fmt.Errorf("a `priority_mix` can only be specified when `priority` is set to `Spot`")
+		}
+
+		priorityMixId := virtualmachinescalesets.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		if err := updateOrchestratedVirtualMachineScaleSetPriorityMixPolicy(ctx, meta.(*clients.Client).Compute.VirtualMachineScaleSetsClient, priorityMixId, priorityMixPolicy); err != nil {
+			return err
+		}
+	}
+
 	return resourceOrchestratedVirtualMachineScaleSetRead(d, meta)
 }
 
@@ -993,6 +1023,20 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("setting `zones`: %+v", err)
 	}
 
+	priorityMixId := virtualmachinescalesets.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	priorityMixResp, err := meta.(*clients.Client).Compute.VirtualMachineScaleSetsClient.Get(ctx, priorityMixId)
+	if err != nil {
+		return fmt.Errorf("retrieving the Priority Mix Policy for %s: %+v", priorityMixId, err)
+	}
+
+	var priorityMixPolicy *virtualmachinescalesets.PriorityMixPolicy
+	if model := priorityMixResp.Model; model != nil && model.Properties != nil {
+		priorityMixPolicy = model.Properties.PriorityMixPolicy
+	}
+	if err := d.Set("priority_mix", FlattenOrchestratedVirtualMachineScaleSetPriorityMixPolicy(priorityMixPolicy)); err != nil {
+		return fmt.Errorf("setting `priority_mix`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -1062,3 +1106,26 @@ func resourceOrchestratedVirtualMachineScaleSetDelete(d *pluginsdk.ResourceData,
 
 	return nil
 }
+
+func updateOrchestratedVirtualMachineScaleSetPriorityMixPolicy(ctx context.Context, client *virtualmachinescalesets.VirtualMachineScaleSetsClient, id virtualmachinescalesets.VirtualMachineScaleSetId, input *virtualmachinescalesets.PriorityMixPolicy) error {
+	if input == nil {
+		// the Priority Mix Policy is reset by sending the default values
+		input = &virtualmachinescalesets.PriorityMixPolicy{
+			BaseRegularPriorityCount:           utils.Int64(0),
+			RegularPriorityPercentageAboveBase: utils.Int64(0),
+		}
+	}
+
+	update := virtualmachinescalesets.VirtualMachineScaleSetUpdate{
+		Properties: &virtualmachinescalesets.VirtualMachineScaleSetUpdateProperties{
+			PriorityMixPolicy: input,
+		},
+	}
+
+	log.Printf("[DEBUG] Updating the Priority Mix Policy for %s..", id)
+	if err := client.UpdateThenPoll(ctx, id, update); err != nil {
+		return fmt.Errorf("updating the Priority Mix Policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -1,9 +1,13 @@
 package compute
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+var _ sdk.TypedServiceRegistration = Registration{}
+var _ sdk.UntypedServiceRegistration = Registration{}
+
 type Registration struct{}
 
 // Name is the name of this Service
@@ -73,3 +77,23 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 	return resources
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		CommunityGalleryImageDataSource{},
+		CommunityGalleryImageVersionDataSource{},
+	}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		CapacityReservationGroupResource{},
+		GalleryApplicationResource{},
+		GalleryApplicationVersionResource{},
+		VirtualMachineRestorePointCollectionResource{},
+		VirtualMachineRestorePointResource{},
+		VirtualMachineRunCommandResource{},
+	}
+}
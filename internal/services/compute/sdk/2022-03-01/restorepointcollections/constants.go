@@ -0,0 +1,59 @@
+package restorepointcollections
+
+import "strings"
+
+type ConsistencyModeTypes string
+
+const (
+	ConsistencyModeTypesApplicationConsistent ConsistencyModeTypes = "ApplicationConsistent"
+	ConsistencyModeTypesCrashConsistent       ConsistencyModeTypes = "CrashConsistent"
+	ConsistencyModeTypesFileSystemConsistent  ConsistencyModeTypes = "FileSystemConsistent"
+)
+
+func PossibleValuesForConsistencyModeTypes() []string {
+	return []string{
+		string(ConsistencyModeTypesApplicationConsistent),
+		string(ConsistencyModeTypesCrashConsistent),
+		string(ConsistencyModeTypesFileSystemConsistent),
+	}
+}
+
+func parseConsistencyModeTypes(input string) (*ConsistencyModeTypes, error) {
+	vals := map[string]ConsistencyModeTypes{
+		"applicationconsistent": ConsistencyModeTypesApplicationConsistent,
+		"crashconsistent":       ConsistencyModeTypesCrashConsistent,
+		"filesystemconsistent":  ConsistencyModeTypesFileSystemConsistent,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConsistencyModeTypes(input)
+	return &out, nil
+}
+
+type RestorePointCollectionExpandOptions string
+
+const (
+	RestorePointCollectionExpandOptionsRestorePoints RestorePointCollectionExpandOptions = "restorePoints"
+)
+
+func PossibleValuesForRestorePointCollectionExpandOptions() []string {
+	return []string{
+		string(RestorePointCollectionExpandOptionsRestorePoints),
+	}
+}
+
+func parseRestorePointCollectionExpandOptions(input string) (*RestorePointCollectionExpandOptions, error) {
+	vals := map[string]RestorePointCollectionExpandOptions{
+		"restorepoints": RestorePointCollectionExpandOptionsRestorePoints,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RestorePointCollectionExpandOptions(input)
+	return &out, nil
+}
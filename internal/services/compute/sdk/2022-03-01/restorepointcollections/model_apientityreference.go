@@ -0,0 +1,5 @@
+package restorepointcollections
+
+type ApiEntityReference struct {
+	Id *string `json:"id,omitempty"`
+}
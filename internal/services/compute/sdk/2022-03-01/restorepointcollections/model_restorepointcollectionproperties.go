@@ -0,0 +1,8 @@
+package restorepointcollections
+
+type RestorePointCollectionProperties struct {
+	ProvisioningState        *string                                 `json:"provisioningState,omitempty"`
+	RestorePointCollectionId *string                                 `json:"restorePointCollectionId,omitempty"`
+	RestorePoints            *[]RestorePoint                         `json:"restorePoints,omitempty"`
+	Source                   *RestorePointCollectionSourceProperties `json:"source,omitempty"`
+}
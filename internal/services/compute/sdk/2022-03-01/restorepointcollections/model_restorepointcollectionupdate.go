@@ -0,0 +1,6 @@
+package restorepointcollections
+
+type RestorePointCollectionUpdate struct {
+	Properties *RestorePointCollectionProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+}
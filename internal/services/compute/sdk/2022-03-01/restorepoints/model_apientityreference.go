@@ -0,0 +1,5 @@
+package restorepoints
+
+type ApiEntityReference struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,9 @@
+package diskencryptionsets
+
+type ApiError struct {
+	Code       *string         `json:"code,omitempty"`
+	Details    *[]ApiErrorBase `json:"details,omitempty"`
+	Innererror *InnerError     `json:"innererror,omitempty"`
+	Message    *string         `json:"message,omitempty"`
+	Target     *string         `json:"target,omitempty"`
+}
@@ -0,0 +1,11 @@
+package diskencryptionsets
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type DiskEncryptionSetUpdate struct {
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Properties *DiskEncryptionSetUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
@@ -0,0 +1,6 @@
+package diskencryptionsets
+
+type InnerError struct {
+	Errordetail   *string `json:"errordetail,omitempty"`
+	Exceptiontype *string `json:"exceptiontype,omitempty"`
+}
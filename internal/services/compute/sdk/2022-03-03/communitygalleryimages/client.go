@@ -0,0 +1,15 @@
+package communitygalleryimages
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CommunityGalleryImagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCommunityGalleryImagesClientWithBaseURI(endpoint string) CommunityGalleryImagesClient {
+	return CommunityGalleryImagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
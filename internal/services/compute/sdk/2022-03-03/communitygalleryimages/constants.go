@@ -0,0 +1,115 @@
+package communitygalleryimages
+
+import "strings"
+
+type Architecture string
+
+const (
+	ArchitectureArmSixFour Architecture = "Arm64"
+	ArchitectureXSixFour   Architecture = "x64"
+)
+
+func PossibleValuesForArchitecture() []string {
+	return []string{
+		string(ArchitectureArmSixFour),
+		string(ArchitectureXSixFour),
+	}
+}
+
+func parseArchitecture(input string) (*Architecture, error) {
+	vals := map[string]Architecture{
+		"arm64": ArchitectureArmSixFour,
+		"x64":   ArchitectureXSixFour,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Architecture(input)
+	return &out, nil
+}
+
+type HyperVGeneration string
+
+const (
+	HyperVGenerationVOne HyperVGeneration = "V1"
+	HyperVGenerationVTwo HyperVGeneration = "V2"
+)
+
+func PossibleValuesForHyperVGeneration() []string {
+	return []string{
+		string(HyperVGenerationVOne),
+		string(HyperVGenerationVTwo),
+	}
+}
+
+func parseHyperVGeneration(input string) (*HyperVGeneration, error) {
+	vals := map[string]HyperVGeneration{
+		"v1": HyperVGenerationVOne,
+		"v2": HyperVGenerationVTwo,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HyperVGeneration(input)
+	return &out, nil
+}
+
+type OperatingSystemStateTypes string
+
+const (
+	OperatingSystemStateTypesGeneralized OperatingSystemStateTypes = "Generalized"
+	OperatingSystemStateTypesSpecialized OperatingSystemStateTypes = "Specialized"
+)
+
+func PossibleValuesForOperatingSystemStateTypes() []string {
+	return []string{
+		string(OperatingSystemStateTypesGeneralized),
+		string(OperatingSystemStateTypesSpecialized),
+	}
+}
+
+func parseOperatingSystemStateTypes(input string) (*OperatingSystemStateTypes, error) {
+	vals := map[string]OperatingSystemStateTypes{
+		"generalized": OperatingSystemStateTypesGeneralized,
+		"specialized": OperatingSystemStateTypesSpecialized,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperatingSystemStateTypes(input)
+	return &out, nil
+}
+
+type OperatingSystemTypes string
+
+const (
+	OperatingSystemTypesLinux   OperatingSystemTypes = "Linux"
+	OperatingSystemTypesWindows OperatingSystemTypes = "Windows"
+)
+
+func PossibleValuesForOperatingSystemTypes() []string {
+	return []string{
+		string(OperatingSystemTypesLinux),
+		string(OperatingSystemTypesWindows),
+	}
+}
+
+func parseOperatingSystemTypes(input string) (*OperatingSystemTypes, error) {
+	vals := map[string]OperatingSystemTypes{
+		"linux":   OperatingSystemTypesLinux,
+		"windows": OperatingSystemTypesWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperatingSystemTypes(input)
+	return &out, nil
+}
@@ -0,0 +1,5 @@
+package communitygalleryimages
+
+type CommunityGalleryIdentifier struct {
+	UniqueId *string `json:"uniqueId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package communitygalleryimages
+
+type CommunityGalleryImage struct {
+	Identifier *CommunityGalleryIdentifier      `json:"identifier,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *CommunityGalleryImageProperties `json:"properties,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+}
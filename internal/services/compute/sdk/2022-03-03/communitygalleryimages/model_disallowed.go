@@ -0,0 +1,5 @@
+package communitygalleryimages
+
+type Disallowed struct {
+	DiskTypes *[]string `json:"diskTypes,omitempty"`
+}
@@ -0,0 +1,6 @@
+package communitygalleryimages
+
+type GalleryImageFeature struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
@@ -0,0 +1,7 @@
+package communitygalleryimages
+
+type GalleryImageIdentifier struct {
+	Offer     string `json:"offer"`
+	Publisher string `json:"publisher"`
+	Sku       string `json:"sku"`
+}
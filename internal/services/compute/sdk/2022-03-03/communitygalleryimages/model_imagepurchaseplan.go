@@ -0,0 +1,7 @@
+package communitygalleryimages
+
+type ImagePurchasePlan struct {
+	Name      *string `json:"name,omitempty"`
+	Product   *string `json:"product,omitempty"`
+	Publisher *string `json:"publisher,omitempty"`
+}
@@ -0,0 +1,6 @@
+package communitygalleryimages
+
+type RecommendedMachineConfiguration struct {
+	Memory *ResourceRange `json:"memory,omitempty"`
+	VCPUs  *ResourceRange `json:"vCPUs,omitempty"`
+}
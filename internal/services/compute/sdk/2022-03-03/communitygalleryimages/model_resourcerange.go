@@ -0,0 +1,6 @@
+package communitygalleryimages
+
+type ResourceRange struct {
+	Max *int64 `json:"max,omitempty"`
+	Min *int64 `json:"min,omitempty"`
+}
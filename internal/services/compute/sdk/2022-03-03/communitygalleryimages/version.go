@@ -0,0 +1,9 @@
+package communitygalleryimages
+
+import "fmt"
+
+const defaultApiVersion = "2022-03-03"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/communitygalleryimages/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package communitygalleryimageversions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CommunityGalleryImageVersionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCommunityGalleryImageVersionsClientWithBaseURI(endpoint string) CommunityGalleryImageVersionsClient {
+	return CommunityGalleryImageVersionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,150 @@
+package communitygalleryimageversions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = VersionId{}
+
+// VersionId is a struct representing the Resource ID for a Version
+type VersionId struct {
+	SubscriptionId       string
+	LocationName         string
+	CommunityGalleryName string
+	ImageName            string
+	VersionName          string
+}
+
+// NewVersionID returns a new VersionId struct
+func NewVersionID(subscriptionId string, locationName string, communityGalleryName string, imageName string, versionName string) VersionId {
+	return VersionId{
+		SubscriptionId:       subscriptionId,
+		LocationName:         locationName,
+		CommunityGalleryName: communityGalleryName,
+		ImageName:            imageName,
+		VersionName:          versionName,
+	}
+}
+
+// ParseVersionID parses 'input' into a VersionId
+func ParseVersionID(input string) (*VersionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(VersionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := VersionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.LocationName, ok = parsed.Parsed["locationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'locationName' was not found in the resource id %q", input)
+	}
+
+	if id.CommunityGalleryName, ok = parsed.Parsed["communityGalleryName"]; !ok {
+		return nil, fmt.Errorf("the segment 'communityGalleryName' was not found in the resource id %q", input)
+	}
+
+	if id.ImageName, ok = parsed.Parsed["imageName"]; !ok {
+		return nil, fmt.Errorf("the segment 'imageName' was not found in the resource id %q", input)
+	}
+
+	if id.VersionName, ok = parsed.Parsed["versionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'versionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseVersionIDInsensitively parses 'input' case-insensitively into a VersionId
+// note: this method should only be used for API response data and not user input
+func ParseVersionIDInsensitively(input string) (*VersionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(VersionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := VersionId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.LocationName, ok = parsed.Parsed["locationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'locationName' was not found in the resource id %q", input)
+	}
+
+	if id.CommunityGalleryName, ok = parsed.Parsed["communityGalleryName"]; !ok {
+		return nil, fmt.Errorf("the segment 'communityGalleryName' was not found in the resource id %q", input)
+	}
+
+	if id.ImageName, ok = parsed.Parsed["imageName"]; !ok {
+		return nil, fmt.Errorf("the segment 'imageName' was not found in the resource id %q", input)
+	}
+
+	if id.VersionName, ok = parsed.Parsed["versionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'versionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateVersionID checks that 'input' can be parsed as a Version ID
+func ValidateVersionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseVersionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Version ID
+func (id VersionId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Compute/locations/%s/communityGalleries/%s/images/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.LocationName, id.CommunityGalleryName, id.ImageName, id.VersionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Version ID
+func (id VersionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute", "Microsoft.Compute"),
+		resourceids.StaticSegment("staticLocations", "locations", "locations"),
+		resourceids.UserSpecifiedSegment("locationName", "locationValue"),
+		resourceids.StaticSegment("staticCommunityGalleries", "communityGalleries", "communityGalleries"),
+		resourceids.UserSpecifiedSegment("communityGalleryName", "communityGalleryValue"),
+		resourceids.StaticSegment("staticImages", "images", "images"),
+		resourceids.UserSpecifiedSegment("imageName", "imageValue"),
+		resourceids.StaticSegment("staticVersions", "versions", "versions"),
+		resourceids.UserSpecifiedSegment("versionName", "versionValue"),
+	}
+}
+
+// String returns a human-readable description of this Version ID
+func (id VersionId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Location Name: %q", id.LocationName),
+		fmt.Sprintf("Community Gallery Name: %q", id.CommunityGalleryName),
+		fmt.Sprintf("Image Name: %q", id.ImageName),
+		fmt.Sprintf("Version Name: %q", id.VersionName),
+	}
+	return fmt.Sprintf("Version (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,5 @@
+package communitygalleryimageversions
+
+type CommunityGalleryIdentifier struct {
+	UniqueId *string `json:"uniqueId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package communitygalleryimageversions
+
+type CommunityGalleryImageVersion struct {
+	Identifier *CommunityGalleryIdentifier             `json:"identifier,omitempty"`
+	Location   *string                                 `json:"location,omitempty"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties *CommunityGalleryImageVersionProperties `json:"properties,omitempty"`
+	Type       *string                                 `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package galleries
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GalleriesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGalleriesClientWithBaseURI(endpoint string) GalleriesClient {
+	return GalleriesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
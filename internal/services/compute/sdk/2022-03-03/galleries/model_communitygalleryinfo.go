@@ -0,0 +1,10 @@
+package galleries
+
+type CommunityGalleryInfo struct {
+	CommunityGalleryEnabled *bool     `json:"communityGalleryEnabled,omitempty"`
+	Eula                    *string   `json:"eula,omitempty"`
+	PublicNamePrefix        *string   `json:"publicNamePrefix,omitempty"`
+	PublicNames             *[]string `json:"publicNames,omitempty"`
+	PublisherContact        *string   `json:"publisherContact,omitempty"`
+	PublisherUri            *string   `json:"publisherUri,omitempty"`
+}
@@ -0,0 +1,5 @@
+package galleries
+
+type GalleryIdentifier struct {
+	UniqueName *string `json:"uniqueName,omitempty"`
+}
@@ -0,0 +1,10 @@
+package galleries
+
+type GalleryProperties struct {
+	Description       *string                   `json:"description,omitempty"`
+	Identifier        *GalleryIdentifier        `json:"identifier,omitempty"`
+	ProvisioningState *GalleryProvisioningState `json:"provisioningState,omitempty"`
+	SharingProfile    *SharingProfile           `json:"sharingProfile,omitempty"`
+	SharingStatus     *SharingStatus            `json:"sharingStatus,omitempty"`
+	SoftDeletePolicy  *SoftDeletePolicy         `json:"softDeletePolicy,omitempty"`
+}
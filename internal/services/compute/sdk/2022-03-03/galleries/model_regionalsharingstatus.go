@@ -0,0 +1,7 @@
+package galleries
+
+type RegionalSharingStatus struct {
+	Details *string       `json:"details,omitempty"`
+	Region  *string       `json:"region,omitempty"`
+	State   *SharingState `json:"state,omitempty"`
+}
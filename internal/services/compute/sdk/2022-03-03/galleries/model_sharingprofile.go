@@ -0,0 +1,7 @@
+package galleries
+
+type SharingProfile struct {
+	CommunityGalleryInfo *CommunityGalleryInfo          `json:"communityGalleryInfo,omitempty"`
+	Groups               *[]SharingProfileGroup         `json:"groups,omitempty"`
+	Permissions          *GallerySharingPermissionTypes `json:"permissions,omitempty"`
+}
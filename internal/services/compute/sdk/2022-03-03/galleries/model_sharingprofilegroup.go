@@ -0,0 +1,6 @@
+package galleries
+
+type SharingProfileGroup struct {
+	Ids  *[]string                 `json:"ids,omitempty"`
+	Type *SharingProfileGroupTypes `json:"type,omitempty"`
+}
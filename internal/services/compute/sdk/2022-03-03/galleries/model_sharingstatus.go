@@ -0,0 +1,6 @@
+package galleries
+
+type SharingStatus struct {
+	AggregatedState *SharingState            `json:"aggregatedState,omitempty"`
+	Summary         *[]RegionalSharingStatus `json:"summary,omitempty"`
+}
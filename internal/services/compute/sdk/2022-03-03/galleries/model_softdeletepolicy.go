@@ -0,0 +1,5 @@
+package galleries
+
+type SoftDeletePolicy struct {
+	IsSoftDeleteEnabled *bool `json:"isSoftDeleteEnabled,omitempty"`
+}
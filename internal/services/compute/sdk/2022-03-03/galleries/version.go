@@ -0,0 +1,9 @@
+package galleries
+
+import "fmt"
+
+const defaultApiVersion = "2022-03-03"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/galleries/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package gallerysharingupdate
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GallerySharingUpdateClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGallerySharingUpdateClientWithBaseURI(endpoint string) GallerySharingUpdateClient {
+	return GallerySharingUpdateClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
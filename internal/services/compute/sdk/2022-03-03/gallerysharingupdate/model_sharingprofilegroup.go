@@ -0,0 +1,6 @@
+package gallerysharingupdate
+
+type SharingProfileGroup struct {
+	Ids  *[]string                 `json:"ids,omitempty"`
+	Type *SharingProfileGroupTypes `json:"type,omitempty"`
+}
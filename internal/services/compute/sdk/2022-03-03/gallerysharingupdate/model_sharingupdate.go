@@ -0,0 +1,6 @@
+package gallerysharingupdate
+
+type SharingUpdate struct {
+	Groups        *[]SharingProfileGroup      `json:"groups,omitempty"`
+	OperationType SharingUpdateOperationTypes `json:"operationType"`
+}
@@ -0,0 +1,9 @@
+package gallerysharingupdate
+
+import "fmt"
+
+const defaultApiVersion = "2022-03-03"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/gallerysharingupdate/%s", defaultApiVersion)
+}
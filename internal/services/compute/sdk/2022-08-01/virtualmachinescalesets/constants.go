@@ -0,0 +1,31 @@
+package virtualmachinescalesets
+
+import "strings"
+
+type OrchestrationMode string
+
+const (
+	OrchestrationModeFlexible OrchestrationMode = "Flexible"
+	OrchestrationModeUniform  OrchestrationMode = "Uniform"
+)
+
+func PossibleValuesForOrchestrationMode() []string {
+	return []string{
+		string(OrchestrationModeFlexible),
+		string(OrchestrationModeUniform),
+	}
+}
+
+func parseOrchestrationMode(input string) (*OrchestrationMode, error) {
+	vals := map[string]OrchestrationMode{
+		"flexible": OrchestrationModeFlexible,
+		"uniform":  OrchestrationModeUniform,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OrchestrationMode(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package virtualmachinescalesets
+
+type VirtualMachineScaleSet struct {
+	Id         *string                           `json:"id,omitempty"`
+	Location   string                            `json:"location"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *VirtualMachineScaleSetProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+	Zones      *[]string                         `json:"zones,omitempty"`
+}
@@ -0,0 +1,29 @@
+package virtualmachinescalesets
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type VirtualMachineScaleSetProperties struct {
+	OrchestrationMode        *OrchestrationMode `json:"orchestrationMode,omitempty"`
+	PlatformFaultDomainCount *int64             `json:"platformFaultDomainCount,omitempty"`
+	PriorityMixPolicy        *PriorityMixPolicy `json:"priorityMixPolicy,omitempty"`
+	ProvisioningState        *string            `json:"provisioningState,omitempty"`
+	SinglePlacementGroup     *bool              `json:"singlePlacementGroup,omitempty"`
+	TimeCreated              *string            `json:"timeCreated,omitempty"`
+	UniqueId                 *string            `json:"uniqueId,omitempty"`
+}
+
+func (o VirtualMachineScaleSetProperties) GetTimeCreatedAsTime() (*time.Time, error) {
+	if o.TimeCreated == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.TimeCreated, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o VirtualMachineScaleSetProperties) SetTimeCreatedAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.TimeCreated = &formatted
+}
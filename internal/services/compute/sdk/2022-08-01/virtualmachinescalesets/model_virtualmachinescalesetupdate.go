@@ -0,0 +1,6 @@
+package virtualmachinescalesets
+
+type VirtualMachineScaleSetUpdate struct {
+	Properties *VirtualMachineScaleSetUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+}
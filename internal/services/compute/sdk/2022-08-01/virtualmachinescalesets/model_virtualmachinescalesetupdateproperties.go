@@ -0,0 +1,6 @@
+package virtualmachinescalesets
+
+type VirtualMachineScaleSetUpdateProperties struct {
+	PriorityMixPolicy    *PriorityMixPolicy `json:"priorityMixPolicy,omitempty"`
+	SinglePlacementGroup *bool              `json:"singlePlacementGroup,omitempty"`
+}
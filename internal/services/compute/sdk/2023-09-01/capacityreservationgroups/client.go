@@ -0,0 +1,15 @@
+package capacityreservationgroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CapacityReservationGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCapacityReservationGroupsClientWithBaseURI(endpoint string) CapacityReservationGroupsClient {
+	return CapacityReservationGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,59 @@
+package capacityreservationgroups
+
+import "strings"
+
+type CapacityReservationGroupInstanceViewTypes string
+
+const (
+	CapacityReservationGroupInstanceViewTypesInstanceView CapacityReservationGroupInstanceViewTypes = "instanceView"
+)
+
+func PossibleValuesForCapacityReservationGroupInstanceViewTypes() []string {
+	return []string{
+		string(CapacityReservationGroupInstanceViewTypesInstanceView),
+	}
+}
+
+func parseCapacityReservationGroupInstanceViewTypes(input string) (*CapacityReservationGroupInstanceViewTypes, error) {
+	vals := map[string]CapacityReservationGroupInstanceViewTypes{
+		"instanceview": CapacityReservationGroupInstanceViewTypesInstanceView,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CapacityReservationGroupInstanceViewTypes(input)
+	return &out, nil
+}
+
+type StatusLevelTypes string
+
+const (
+	StatusLevelTypesError   StatusLevelTypes = "Error"
+	StatusLevelTypesInfo    StatusLevelTypes = "Info"
+	StatusLevelTypesWarning StatusLevelTypes = "Warning"
+)
+
+func PossibleValuesForStatusLevelTypes() []string {
+	return []string{
+		string(StatusLevelTypesError),
+		string(StatusLevelTypesInfo),
+		string(StatusLevelTypesWarning),
+	}
+}
+
+func parseStatusLevelTypes(input string) (*StatusLevelTypes, error) {
+	vals := map[string]StatusLevelTypes{
+		"error":   StatusLevelTypesError,
+		"info":    StatusLevelTypesInfo,
+		"warning": StatusLevelTypesWarning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StatusLevelTypes(input)
+	return &out, nil
+}
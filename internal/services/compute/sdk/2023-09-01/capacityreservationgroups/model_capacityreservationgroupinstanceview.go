@@ -0,0 +1,6 @@
+package capacityreservationgroups
+
+type CapacityReservationGroupInstanceView struct {
+	CapacityReservations  *[]CapacityReservationInstanceViewWithName `json:"capacityReservations,omitempty"`
+	SharedSubscriptionIds *[]SubResourceReadOnly                     `json:"sharedSubscriptionIds,omitempty"`
+}
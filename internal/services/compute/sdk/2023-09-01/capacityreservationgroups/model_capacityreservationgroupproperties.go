@@ -0,0 +1,8 @@
+package capacityreservationgroups
+
+type CapacityReservationGroupProperties struct {
+	CapacityReservations      *[]SubResourceReadOnly                `json:"capacityReservations,omitempty"`
+	InstanceView              *CapacityReservationGroupInstanceView `json:"instanceView,omitempty"`
+	SharingProfile            *ResourceSharingProfile               `json:"sharingProfile,omitempty"`
+	VirtualMachinesAssociated *[]SubResourceReadOnly                `json:"virtualMachinesAssociated,omitempty"`
+}
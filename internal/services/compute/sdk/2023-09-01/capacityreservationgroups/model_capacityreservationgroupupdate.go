@@ -0,0 +1,6 @@
+package capacityreservationgroups
+
+type CapacityReservationGroupUpdate struct {
+	Properties *CapacityReservationGroupProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                  `json:"tags,omitempty"`
+}
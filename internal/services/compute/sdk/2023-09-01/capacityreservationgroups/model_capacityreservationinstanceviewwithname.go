@@ -0,0 +1,7 @@
+package capacityreservationgroups
+
+type CapacityReservationInstanceViewWithName struct {
+	Name            *string                         `json:"name,omitempty"`
+	Statuses        *[]InstanceViewStatus           `json:"statuses,omitempty"`
+	UtilizationInfo *CapacityReservationUtilization `json:"utilizationInfo,omitempty"`
+}
@@ -0,0 +1,6 @@
+package capacityreservationgroups
+
+type CapacityReservationUtilization struct {
+	CurrentCapacity          *int64                 `json:"currentCapacity,omitempty"`
+	VirtualMachinesAllocated *[]SubResourceReadOnly `json:"virtualMachinesAllocated,omitempty"`
+}
@@ -0,0 +1,5 @@
+package capacityreservationgroups
+
+type ResourceSharingProfile struct {
+	SubscriptionIds *[]SubResource `json:"subscriptionIds,omitempty"`
+}
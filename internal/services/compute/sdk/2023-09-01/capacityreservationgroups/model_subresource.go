@@ -0,0 +1,5 @@
+package capacityreservationgroups
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package capacityreservationgroups
+
+type SubResourceReadOnly struct {
+	Id *string `json:"id,omitempty"`
+}
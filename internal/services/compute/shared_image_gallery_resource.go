@@ -5,15 +5,18 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	resourceManagerTags "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/galleries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-03/gallerysharingupdate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -25,7 +28,7 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 		Update: resourceSharedImageGalleryCreateUpdate,
 		Delete: resourceSharedImageGalleryDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SharedImageGalleryID(id)
+			_, err := galleries.ParseGalleryID(id)
 			return err
 		}),
 
@@ -53,6 +56,96 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"sharing": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"permission": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(galleries.GallerySharingPermissionTypesCommunity),
+								string(galleries.GallerySharingPermissionTypesGroups),
+								string(galleries.GallerySharingPermissionTypesPrivate),
+							}, false),
+						},
+
+						"community_gallery": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"eula": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"prefix": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"publisher_email": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"publisher_uri": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+									},
+
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"group": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(gallerysharingupdate.SharingProfileGroupTypesAADTenants),
+											string(gallerysharingupdate.SharingProfileGroupTypesSubscriptions),
+										}, false),
+									},
+
+									"ids": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.IsUUID,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"unique_name": {
@@ -65,59 +158,83 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 
 func resourceSharedImageGalleryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.GalleriesClient
+	sharingUpdateClient := meta.(*clients.Client).Compute.GallerySharingUpdateClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Image Gallery creation.")
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	description := d.Get("description").(string)
-	t := d.Get("tags").(map[string]interface{})
+	id := galleries.NewGalleryID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		// Upgrading to the 2021-07-01 exposed a new expand parameter in the GET method
-		existing, err := client.Get(ctx, resourceGroup, name, "")
+		existing, err := client.Get(ctx, id, galleries.DefaultGetOperationOptions())
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_shared_image_gallery", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_shared_image_gallery", id.ID())
 		}
 	}
 
-	gallery := compute.Gallery{
-		Location: utils.String(location),
-		GalleryProperties: &compute.GalleryProperties{
-			Description: utils.String(description),
-		},
-		Tags: tags.Expand(t),
+	sharingRaw := d.Get("sharing").([]interface{})
+	sharingProfile, err := expandSharedImageGallerySharing(sharingRaw)
+	if err != nil {
+		return err
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gallery)
-	if err != nil {
-		return fmt.Errorf("creating/updating Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+	gallery := galleries.Gallery{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &galleries.GalleryProperties{
+			Description:    utils.String(d.Get("description").(string)),
+			SharingProfile: sharingProfile,
+		},
+		Tags: resourceManagerTags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, gallery); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	// Upgrading to the 2021-07-01 exposed a new expand parameter in the GET method
-	read, err := client.Get(ctx, resourceGroup, name, "")
-	if err != nil {
-		return fmt.Errorf("retrieving Shared Image Gallery %q (Resource Group %q): %+v", name, resourceGroup, err)
+	sharingId := gallerysharingupdate.NewGalleryID(id.SubscriptionId, id.ResourceGroupName, id.GalleryName)
+
+	// publishing the gallery to the community is a separate operation to configuring the sharing profile
+	if d.IsNewResource() && sharingProfile != nil && sharingProfile.Permissions != nil && *sharingProfile.Permissions == galleries.GallerySharingPermissionTypesCommunity {
+		update := gallerysharingupdate.SharingUpdate{
+			OperationType: gallerysharingupdate.SharingUpdateOperationTypesEnableCommunity,
+		}
+		if err := sharingUpdateClient.GallerySharingProfileUpdateThenPoll(ctx, sharingId, update); err != nil {
+			return fmt.Errorf("enabling community sharing for %s: %+v", id, err)
+		}
 	}
 
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read Shared Image Gallery %q (Resource Group %q) ID", name, resourceGroup)
+	if d.HasChange("sharing.0.group") {
+		groups := expandSharedImageGallerySharingGroups(d.Get("sharing.0.group").([]interface{}))
+
+		if !d.IsNewResource() {
+			update := gallerysharingupdate.SharingUpdate{
+				OperationType: gallerysharingupdate.SharingUpdateOperationTypesReset,
+			}
+			if err := sharingUpdateClient.GallerySharingProfileUpdateThenPoll(ctx, sharingId, update); err != nil {
+				return fmt.Errorf("resetting the sharing groups for %s: %+v", id, err)
+			}
+		}
+
+		if len(*groups) > 0 {
+			update := gallerysharingupdate.SharingUpdate{
+				OperationType: gallerysharingupdate.SharingUpdateOperationTypesAdd,
+				Groups:        groups,
+			}
+			if err := sharingUpdateClient.GallerySharingProfileUpdateThenPoll(ctx, sharingId, update); err != nil {
+				return fmt.Errorf("adding the sharing groups for %s: %+v", id, err)
+			}
+		}
 	}
 
-	d.SetId(*read.ID)
+	d.SetId(id.ID())
 
 	return resourceSharedImageGalleryRead(d, meta)
 }
@@ -127,59 +244,182 @@ func resourceSharedImageGalleryRead(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SharedImageGalleryID(d.Id())
+	id, err := galleries.ParseGalleryID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	// Upgrading to the 2021-07-01 exposed a new expand parameter in the GET method
-	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, "")
+	// the sharing groups are only returned when the permissions are explicitly selected
+	options := galleries.GetOperationOptions{
+		Select: func() *galleries.SelectPermissions {
+			v := galleries.SelectPermissionsPermissions
+			return &v
+		}(),
+	}
+	resp, err := client.Get(ctx, *id, options)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[DEBUG] Shared Image Gallery %q (Resource Group %q) was not found - removing from state", id.GalleryName, id.ResourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("making Read request on Shared Image Gallery %q (Resource Group %q): %+v", id.GalleryName, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	d.Set("name", id.GalleryName)
-	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 
-	if props := resp.GalleryProperties; props != nil {
-		d.Set("description", props.Description)
-		if identifier := props.Identifier; identifier != nil {
-			d.Set("unique_name", identifier.UniqueName)
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			if identifier := props.Identifier; identifier != nil {
+				d.Set("unique_name", identifier.UniqueName)
+			}
+
+			if err := d.Set("sharing", flattenSharedImageGallerySharing(props.SharingProfile, d.Get("sharing").([]interface{}))); err != nil {
+				return fmt.Errorf("setting `sharing`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, resourceManagerTags.Flatten(model.Tags)); err != nil {
+			return err
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceSharedImageGalleryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.GalleriesClient
+	sharingUpdateClient := meta.(*clients.Client).Compute.GallerySharingUpdateClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SharedImageGalleryID(d.Id())
+	id, err := galleries.ParseGalleryID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName)
-	if err != nil {
-		return fmt.Errorf("deleting Shared Image Gallery %q (Resource Group %q): %+v", id.GalleryName, id.ResourceGroup, err)
+	// a gallery can't be deleted whilst it's shared, so the sharing profile needs to be reset first
+	if permission := d.Get("sharing.0.permission").(string); permission != "" && permission != string(galleries.GallerySharingPermissionTypesPrivate) {
+		sharingId := gallerysharingupdate.NewGalleryID(id.SubscriptionId, id.ResourceGroupName, id.GalleryName)
+		update := gallerysharingupdate.SharingUpdate{
+			OperationType: gallerysharingupdate.SharingUpdateOperationTypesReset,
+		}
+		if err := sharingUpdateClient.GallerySharingProfileUpdateThenPoll(ctx, sharingId, update); err != nil {
+			return fmt.Errorf("resetting the sharing profile for %s: %+v", *id, err)
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("waiting for the deletion of Shared Image Gallery %q (Resource Group %q): %+v", id.GalleryName, id.ResourceGroup, err)
-		}
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
+
+func expandSharedImageGallerySharing(input []interface{}) (*galleries.SharingProfile, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	permission := galleries.GallerySharingPermissionTypes(raw["permission"].(string))
+	communityGalleryRaw := raw["community_gallery"].([]interface{})
+	groupsRaw := raw["group"].([]interface{})
+
+	if permission == galleries.GallerySharingPermissionTypesCommunity && len(communityGalleryRaw) == 0 {
+		return nil, fmt.Errorf("`community_gallery` must be specified when `permission` is set to `%s`", string(galleries.GallerySharingPermissionTypesCommunity))
+	}
+	if permission != galleries.GallerySharingPermissionTypesCommunity && len(communityGalleryRaw) > 0 {
+		return nil, fmt.Errorf("`community_gallery` can only be specified when `permission` is set to `%s`", string(galleries.GallerySharingPermissionTypesCommunity))
+	}
+	if permission != galleries.GallerySharingPermissionTypesGroups && len(groupsRaw) > 0 {
+		return nil, fmt.Errorf("`group` can only be specified when `permission` is set to `%s`", string(galleries.GallerySharingPermissionTypesGroups))
+	}
+
+	output := galleries.SharingProfile{
+		Permissions: &permission,
+	}
+
+	if len(communityGalleryRaw) > 0 && communityGalleryRaw[0] != nil {
+		communityGallery := communityGalleryRaw[0].(map[string]interface{})
+		output.CommunityGalleryInfo = &galleries.CommunityGalleryInfo{
+			Eula:             utils.String(communityGallery["eula"].(string)),
+			PublicNamePrefix: utils.String(communityGallery["prefix"].(string)),
+			PublisherContact: utils.String(communityGallery["publisher_email"].(string)),
+			PublisherUri:     utils.String(communityGallery["publisher_uri"].(string)),
+		}
+	}
+
+	return &output, nil
+}
+
+func expandSharedImageGallerySharingGroups(input []interface{}) *[]gallerysharingupdate.SharingProfileGroup {
+	output := make([]gallerysharingupdate.SharingProfileGroup, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		groupType := gallerysharingupdate.SharingProfileGroupTypes(raw["type"].(string))
+		output = append(output, gallerysharingupdate.SharingProfileGroup{
+			Type: &groupType,
+			Ids:  utils.ExpandStringSlice(raw["ids"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &output
+}
+
+func flattenSharedImageGallerySharing(input *galleries.SharingProfile, existing []interface{}) []interface{} {
+	if input == nil || input.Permissions == nil {
+		return []interface{}{}
+	}
+
+	// galleries which aren't shared are returned with the `Private` permission, which we only
+	// want to surface when it's been explicitly configured to avoid a diff
+	if *input.Permissions == galleries.GallerySharingPermissionTypesPrivate && len(existing) == 0 {
+		return []interface{}{}
+	}
+
+	communityGallery := make([]interface{}, 0)
+	if info := input.CommunityGalleryInfo; info != nil {
+		name := ""
+		if info.PublicNames != nil && len(*info.PublicNames) > 0 {
+			name = (*info.PublicNames)[0]
+		}
+
+		communityGallery = append(communityGallery, map[string]interface{}{
+			"eula":            utils.NormalizeNilableString(info.Eula),
+			"prefix":          utils.NormalizeNilableString(info.PublicNamePrefix),
+			"publisher_email": utils.NormalizeNilableString(info.PublisherContact),
+			"publisher_uri":   utils.NormalizeNilableString(info.PublisherUri),
+			"name":            name,
+		})
+	}
+
+	groups := make([]interface{}, 0)
+	if input.Groups != nil {
+		for _, v := range *input.Groups {
+			groupType := ""
+			if v.Type != nil {
+				groupType = string(*v.Type)
+			}
+
+			groups = append(groups, map[string]interface{}{
+				"type": groupType,
+				"ids":  utils.FlattenStringSlice(v.Ids),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"permission":        string(*input.Permissions),
+			"community_gallery": communityGallery,
+			"group":             groups,
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -163,6 +164,95 @@ func flattenBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
 	}
 }
 
+func galleryApplicationSchema(isVirtualMachine bool) *pluginsdk.Schema {
+	// the Scale Set Update model in this API version doesn't expose the `applicationProfile`, as such
+	// changing the Gallery Applications for a Virtual Machine Scale Set requires recreating it
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: !isVirtualMachine,
+		MaxItems: 100,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.GalleryApplicationVersionID,
+				},
+
+				"configuration_blob_uri": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				"order": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 2147483647),
+				},
+
+				"tag": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandGalleryApplications(input []interface{}) *compute.ApplicationProfile {
+	galleryApplications := make([]compute.VMGalleryApplication, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		galleryApplication := compute.VMGalleryApplication{
+			PackageReferenceID: utils.String(raw["version_id"].(string)),
+			Order:              utils.Int32(int32(raw["order"].(int))),
+		}
+
+		if configurationReference := raw["configuration_blob_uri"].(string); configurationReference != "" {
+			galleryApplication.ConfigurationReference = utils.String(configurationReference)
+		}
+
+		if tag := raw["tag"].(string); tag != "" {
+			galleryApplication.Tags = utils.String(tag)
+		}
+
+		galleryApplications = append(galleryApplications, galleryApplication)
+	}
+
+	return &compute.ApplicationProfile{
+		GalleryApplications: &galleryApplications,
+	}
+}
+
+func flattenGalleryApplications(input *compute.ApplicationProfile) []interface{} {
+	if input == nil || input.GalleryApplications == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input.GalleryApplications {
+		order := 0
+		if v.Order != nil {
+			order = int(*v.Order)
+		}
+
+		output = append(output, map[string]interface{}{
+			"version_id":             utils.NormalizeNilableString(v.PackageReferenceID),
+			"configuration_blob_uri": utils.NormalizeNilableString(v.ConfigurationReference),
+			"order":                  order,
+			"tag":                    utils.NormalizeNilableString(v.Tags),
+		})
+	}
+
+	return output
+}
+
 func linuxSecretSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
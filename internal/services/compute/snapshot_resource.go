@@ -1,8 +1,10 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
@@ -55,6 +57,7 @@ func resourceSnapshot() *pluginsdk.Resource {
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(compute.DiskCreateOptionCopy),
+					string(compute.DiskCreateOptionCopyStart),
 					string(compute.DiskCreateOptionImport),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
@@ -86,6 +89,13 @@ func resourceSnapshot() *pluginsdk.Resource {
 
 			"encryption_settings": encryptionSettingsSchema(),
 
+			"incremental_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -100,6 +110,7 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	resourceGroup := d.Get("resource_group_name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	createOption := d.Get("create_option").(string)
+	incrementalEnabled := d.Get("incremental_enabled").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
 	if d.IsNewResource() {
@@ -125,6 +136,21 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		Tags: tags.Expand(t),
 	}
 
+	if incrementalEnabled {
+		properties.SnapshotProperties.Incremental = utils.Bool(incrementalEnabled)
+	}
+
+	if strings.EqualFold(createOption, string(compute.DiskCreateOptionCopyStart)) {
+		// a CopyStart can only be used to copy an incremental snapshot into another region - as such
+		// the source must be an (incremental) snapshot and the target must be incremental too
+		if _, ok := d.GetOk("source_resource_id"); !ok {
+			return fmt.Errorf("`source_resource_id` must be specified when `create_option` is set to `CopyStart`")
+		}
+		if !incrementalEnabled {
+			return fmt.Errorf("`incremental_enabled` must be set to `true` when `create_option` is set to `CopyStart`")
+		}
+	}
+
 	if v, ok := d.GetOk("source_uri"); ok {
 		properties.SnapshotProperties.CreationData.SourceURI = utils.String(v.(string))
 	}
@@ -157,6 +183,23 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("waiting on create/update future for Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if d.IsNewResource() && strings.EqualFold(createOption, string(compute.DiskCreateOptionCopyStart)) {
+		// the CopyStart operation returns once the snapshot has been created, however the data is copied
+		// in the background - so we need to wait for the copy to complete before the snapshot can be used
+		log.Printf("[DEBUG] Waiting for the background copy of Snapshot %q (Resource Group %q) to complete", name, resourceGroup)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{"Copying"},
+			Target:     []string{"Completed"},
+			Refresh:    snapshotCopyStateRefreshFunc(ctx, client, resourceGroup, name),
+			MinTimeout: 15 * time.Second,
+			Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for the background copy of Snapshot %q (Resource Group %q) to complete: %+v", name, resourceGroup, err)
+		}
+	}
+
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("issuing get request for Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -207,6 +250,12 @@ func resourceSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("disk_size_gb", int(*props.DiskSizeGB))
 		}
 
+		incrementalEnabled := false
+		if props.Incremental != nil {
+			incrementalEnabled = *props.Incremental
+		}
+		d.Set("incremental_enabled", incrementalEnabled)
+
 		if err := d.Set("encryption_settings", flattenManagedDiskEncryptionSettings(props.EncryptionSettingsCollection)); err != nil {
 			return fmt.Errorf("setting `encryption_settings`: %+v", err)
 		}
@@ -236,3 +285,21 @@ func resourceSnapshotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func snapshotCopyStateRefreshFunc(ctx context.Context, client *compute.SnapshotsClient, resourceGroup, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := resp.SnapshotProperties; props != nil && props.CompletionPercent != nil {
+			log.Printf("[DEBUG] The background copy of Snapshot %q (Resource Group %q) is %.2f%% complete", name, resourceGroup, *props.CompletionPercent)
+			if *props.CompletionPercent < 100 {
+				return resp, "Copying", nil
+			}
+		}
+
+		return resp, "Completed", nil
+	}
+}
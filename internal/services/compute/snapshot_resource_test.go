@@ -112,6 +112,22 @@ func TestAccSnapshot_fromExistingSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccSnapshot_incrementalCopyStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_snapshot", "second")
+	r := SnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.incrementalCopyStart(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("incremental_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("source_resource_id"),
+	})
+}
+
 func TestAccSnapshot_fromUnmanagedDisk(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_snapshot", "test")
 	r := SnapshotResource{}
@@ -389,6 +405,51 @@ resource "azurerm_snapshot" "second" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (SnapshotResource) incrementalCopyStart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "secondary" {
+  name     = "acctestRG-secondary-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_disk" "original" {
+  name                 = "acctestmd-%d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "first" {
+  name                = "acctestss1_%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  create_option       = "Copy"
+  source_uri          = azurerm_managed_disk.original.id
+  incremental_enabled = true
+}
+
+resource "azurerm_snapshot" "second" {
+  name                = "acctestss2_%d"
+  location            = azurerm_resource_group.secondary.location
+  resource_group_name = azurerm_resource_group.secondary.name
+  create_option       = "CopyStart"
+  source_resource_id  = azurerm_snapshot.first.id
+  incremental_enabled = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Secondary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (SnapshotResource) fromUnmanagedDisk(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func GalleryApplicationName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z0-9]$|^[A-Za-z0-9][A-Za-z0-9._-]{0,78}[A-Za-z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s can be up to 80 characters, may only contain alphanumeric characters, full stops, dashes and underscores and must begin and end with an alphanumeric character. Got %q.", k, value))
+	}
+
+	return warnings, errors
+}
+
+func GalleryApplicationVersionName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[0-9]{1,10}\.[0-9]{1,10}\.[0-9]{1,10}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("expected %s to be in the format `1.2.3` but got %q.", k, value))
+	}
+
+	return warnings, errors
+}
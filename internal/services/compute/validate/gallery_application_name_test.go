@@ -0,0 +1,108 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGalleryApplicationName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "a",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.world-app_1",
+			ShouldError: false,
+		},
+		{
+			Input:       "-hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello,world",
+			ShouldError: true,
+		},
+		{
+			Input:       strings.Repeat("a", 80),
+			ShouldError: false,
+		},
+		{
+			Input:       strings.Repeat("a", 81),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := GalleryApplicationName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if hasErrors != tc.ShouldError {
+				t.Fatalf("Expected %t but got %t for %q", tc.ShouldError, hasErrors, tc.Input)
+			}
+		})
+	}
+}
+
+func TestGalleryApplicationVersionName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "1",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.0",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.0.0",
+			ShouldError: false,
+		},
+		{
+			Input:       "12.345.6789",
+			ShouldError: false,
+		},
+		{
+			Input:       "latest",
+			ShouldError: true,
+		},
+		{
+			Input:       "1.0.0.0",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := GalleryApplicationVersionName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if hasErrors != tc.ShouldError {
+				t.Fatalf("Expected %t but got %t for %q", tc.ShouldError, hasErrors, tc.Input)
+			}
+		})
+	}
+}
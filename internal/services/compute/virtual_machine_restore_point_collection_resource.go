@@ -0,0 +1,225 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-01/restorepointcollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = VirtualMachineRestorePointCollectionResource{}
+
+type VirtualMachineRestorePointCollectionResource struct{}
+
+type VirtualMachineRestorePointCollectionModel struct {
+	Name                           string            `tfschema:"name"`
+	ResourceGroupName              string            `tfschema:"resource_group_name"`
+	Location                       string            `tfschema:"location"`
+	SourceVirtualMachineId         string            `tfschema:"source_virtual_machine_id"`
+	SourceRestorePointCollectionId string            `tfschema:"source_restore_point_collection_id"`
+	Tags                           map[string]string `tfschema:"tags"`
+}
+
+func (r VirtualMachineRestorePointCollectionResource) ResourceType() string {
+	return "azurerm_virtual_machine_restore_point_collection"
+}
+
+func (r VirtualMachineRestorePointCollectionResource) ModelObject() interface{} {
+	return &VirtualMachineRestorePointCollectionModel{}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return restorepointcollections.ValidateRestorePointCollectionID
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"source_virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+			ExactlyOneOf: []string{"source_virtual_machine_id", "source_restore_point_collection_id"},
+		},
+
+		// a Restore Point Collection in another region is used as the source when copying Restore Points across regions
+		"source_restore_point_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: restorepointcollections.ValidateRestorePointCollectionID,
+			ExactlyOneOf: []string{"source_virtual_machine_id", "source_restore_point_collection_id"},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model VirtualMachineRestorePointCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := restorepointcollections.NewRestorePointCollectionID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id, restorepointcollections.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sourceId := model.SourceVirtualMachineId
+			if model.SourceRestorePointCollectionId != "" {
+				sourceId = model.SourceRestorePointCollectionId
+			}
+
+			payload := restorepointcollections.RestorePointCollection{
+				Location: location.Normalize(model.Location),
+				Properties: &restorepointcollections.RestorePointCollectionProperties{
+					Source: &restorepointcollections.RestorePointCollectionSourceProperties{
+						Id: utils.String(sourceId),
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := restorepointcollections.ParseRestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, restorepointcollections.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineRestorePointCollectionModel{
+				Name:              id.RestorePointCollectionName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil && props.Source != nil && props.Source.Id != nil {
+					if sourceCollectionId, err := restorepointcollections.ParseRestorePointCollectionIDInsensitively(*props.Source.Id); err == nil {
+						state.SourceRestorePointCollectionId = sourceCollectionId.ID()
+					} else if virtualMachineId, err := parse.VirtualMachineID(*props.Source.Id); err == nil {
+						state.SourceVirtualMachineId = virtualMachineId.ID()
+					} else {
+						state.SourceVirtualMachineId = *props.Source.Id
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := restorepointcollections.ParseRestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineRestorePointCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := restorepointcollections.RestorePointCollectionUpdate{}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := restorepointcollections.ParseRestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
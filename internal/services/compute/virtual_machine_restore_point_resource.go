@@ -0,0 +1,226 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-01/restorepointcollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-01/restorepoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = VirtualMachineRestorePointResource{}
+
+type VirtualMachineRestorePointResource struct{}
+
+type VirtualMachineRestorePointModel struct {
+	Name                                   string   `tfschema:"name"`
+	VirtualMachineRestorePointCollectionId string   `tfschema:"virtual_machine_restore_point_collection_id"`
+	ExcludedDiskIds                        []string `tfschema:"excluded_disk_ids"`
+	SourceRestorePointId                   string   `tfschema:"source_restore_point_id"`
+	ConsistencyMode                        string   `tfschema:"consistency_mode"`
+}
+
+func (r VirtualMachineRestorePointResource) ResourceType() string {
+	return "azurerm_virtual_machine_restore_point"
+}
+
+func (r VirtualMachineRestorePointResource) ModelObject() interface{} {
+	return &VirtualMachineRestorePointModel{}
+}
+
+func (r VirtualMachineRestorePointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return restorepoints.ValidateRestorePointID
+}
+
+func (r VirtualMachineRestorePointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_machine_restore_point_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: restorepointcollections.ValidateRestorePointCollectionID,
+		},
+
+		"excluded_disk_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ManagedDiskID,
+			},
+			ConflictsWith: []string{"source_restore_point_id"},
+		},
+
+		// copies an existing Restore Point from another region into this Restore Point Collection
+		"source_restore_point_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  restorepoints.ValidateRestorePointID,
+			ConflictsWith: []string{"excluded_disk_ids"},
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"consistency_mode": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			var model VirtualMachineRestorePointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			collectionId, err := restorepointcollections.ParseRestorePointCollectionID(model.VirtualMachineRestorePointCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := restorepoints.NewRestorePointID(collectionId.SubscriptionId, collectionId.ResourceGroupName, collectionId.RestorePointCollectionName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := restorepoints.RestorePointProperties{}
+
+			if len(model.ExcludedDiskIds) > 0 {
+				excludeDisks := make([]restorepoints.ApiEntityReference, 0)
+				for _, v := range model.ExcludedDiskIds {
+					excludeDisks = append(excludeDisks, restorepoints.ApiEntityReference{
+						Id: utils.String(v),
+					})
+				}
+				props.ExcludeDisks = &excludeDisks
+			}
+
+			if model.SourceRestorePointId != "" {
+				props.SourceRestorePoint = &restorepoints.ApiEntityReference{
+					Id: utils.String(model.SourceRestorePointId),
+				}
+			}
+
+			payload := restorepoints.RestorePoint{
+				Properties: &props,
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			id, err := restorepoints.ParseRestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineRestorePointModel{
+				Name:                                   id.RestorePointName,
+				VirtualMachineRestorePointCollectionId: restorepointcollections.NewRestorePointCollectionID(id.SubscriptionId, id.ResourceGroupName, id.RestorePointCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.ConsistencyMode != nil {
+						state.ConsistencyMode = string(*props.ConsistencyMode)
+					}
+
+					state.ExcludedDiskIds = flattenVirtualMachineRestorePointExcludedDisks(props.ExcludeDisks)
+
+					if props.SourceRestorePoint != nil && props.SourceRestorePoint.Id != nil {
+						sourceRestorePointId, err := restorepoints.ParseRestorePointIDInsensitively(*props.SourceRestorePoint.Id)
+						if err != nil {
+							return err
+						}
+						state.SourceRestorePointId = sourceRestorePointId.ID()
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineRestorePointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			id, err := restorepoints.ParseRestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenVirtualMachineRestorePointExcludedDisks(input *[]restorepoints.ApiEntityReference) []string {
+	ids := make([]string, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.Id != nil {
+			ids = append(ids, *v.Id)
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,176 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-01/restorepoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRestorePointResource struct{}
+
+func TestAccVirtualMachineRestorePoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_restore_point", "test")
+	r := VirtualMachineRestorePointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consistency_mode").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineRestorePoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_restore_point", "test")
+	r := VirtualMachineRestorePointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineRestorePoint_excludedDisks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_restore_point", "test")
+	r := VirtualMachineRestorePointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.excludedDisks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("excluded_disk_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineRestorePoint_crossRegionCopy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_restore_point", "copy")
+	r := VirtualMachineRestorePointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossRegionCopy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineRestorePointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := restorepoints.ParseRestorePointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Compute.RestorePointsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VirtualMachineRestorePointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_restore_point" "test" {
+  name                                        = "acctestRP-%d"
+  virtual_machine_restore_point_collection_id = azurerm_virtual_machine_restore_point_collection.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualMachineRestorePointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_restore_point" "import" {
+  name                                        = azurerm_virtual_machine_restore_point.test.name
+  virtual_machine_restore_point_collection_id = azurerm_virtual_machine_restore_point.test.virtual_machine_restore_point_collection_id
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineRestorePointResource) excludedDisks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%[2]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = azurerm_managed_disk.test.id
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  lun                = "0"
+  caching            = "None"
+}
+
+resource "azurerm_virtual_machine_restore_point" "test" {
+  name                                        = "acctestRP-%[2]d"
+  virtual_machine_restore_point_collection_id = azurerm_virtual_machine_restore_point_collection.test.id
+  excluded_disk_ids                           = [azurerm_managed_disk.test.id]
+
+  depends_on = [azurerm_virtual_machine_data_disk_attachment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualMachineRestorePointResource) crossRegionCopy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_resource_group" "copy" {
+  name     = "acctestRG-copy-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_virtual_machine_restore_point_collection" "copy" {
+  name                               = "acctestRPC-copy-%[2]d"
+  resource_group_name                = azurerm_resource_group.copy.name
+  location                           = azurerm_resource_group.copy.location
+  source_restore_point_collection_id = azurerm_virtual_machine_restore_point_collection.test.id
+}
+
+resource "azurerm_virtual_machine_restore_point" "copy" {
+  name                                        = "acctestRP-copy-%[2]d"
+  virtual_machine_restore_point_collection_id = azurerm_virtual_machine_restore_point_collection.copy.id
+  source_restore_point_id                     = azurerm_virtual_machine_restore_point.test.id
+}
+`, r.basic(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (VirtualMachineRestorePointResource) template(data acceptance.TestData) string {
+	return VirtualMachineRestorePointCollectionResource{}.basic(data)
+}
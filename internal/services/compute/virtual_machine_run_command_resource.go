@@ -0,0 +1,674 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+
+type VirtualMachineRunCommandResource struct{}
+
+type VirtualMachineRunCommandModel struct {
+	Name               string                                 `tfschema:"name"`
+	Location           string                                 `tfschema:"location"`
+	VirtualMachineId   string                                 `tfschema:"virtual_machine_id"`
+	Source             []VirtualMachineRunCommandSourceModel  `tfschema:"source"`
+	Parameter          []VirtualMachineRunCommandParameter    `tfschema:"parameter"`
+	ProtectedParameter []VirtualMachineRunCommandParameter    `tfschema:"protected_parameter"`
+	RunAsUser          string                                 `tfschema:"run_as_user"`
+	RunAsPassword      string                                 `tfschema:"run_as_password"`
+	TimeoutInSeconds   int                                    `tfschema:"timeout_in_seconds"`
+	OutputBlobUri      string                                 `tfschema:"output_blob_uri"`
+	ErrorBlobUri       string                                 `tfschema:"error_blob_uri"`
+	Tags               map[string]string                      `tfschema:"tags"`
+	InstanceView       []VirtualMachineRunCommandInstanceView `tfschema:"instance_view"`
+}
+
+type VirtualMachineRunCommandSourceModel struct {
+	Script    string `tfschema:"script"`
+	ScriptUri string `tfschema:"script_uri"`
+	CommandId string `tfschema:"command_id"`
+}
+
+type VirtualMachineRunCommandParameter struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type VirtualMachineRunCommandInstanceView struct {
+	ExecutionState   string `tfschema:"execution_state"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	ExitCode         int    `tfschema:"exit_code"`
+	Output           string `tfschema:"output"`
+	Error            string `tfschema:"error"`
+	StartTime        string `tfschema:"start_time"`
+	EndTime          string `tfschema:"end_time"`
+}
+
+func (r VirtualMachineRunCommandResource) ResourceType() string {
+	return "azurerm_virtual_machine_run_command"
+}
+
+func (r VirtualMachineRunCommandResource) ModelObject() interface{} {
+	return &VirtualMachineRunCommandModel{}
+}
+
+func (r VirtualMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(validate.VirtualMachineRunCommandID, validate.VirtualMachineScaleSetVirtualMachineRunCommandID)
+}
+
+func (r VirtualMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+
+		// this can be either a Virtual Machine or an instance within a Virtual Machine Scale Set
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(validate.VirtualMachineID, validate.VirtualMachineScaleSetVirtualMachineID),
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+				},
+			},
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"protected_parameter": {
+			Type:      pluginsdk.TypeList,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"run_as_user"},
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute
+
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := virtualMachineRunCommandIDFromParent(model.VirtualMachineId, model.Name)
+			if err != nil {
+				return err
+			}
+
+			existing, err := getVirtualMachineRunCommand(ctx, client, id, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := createOrUpdateVirtualMachineRunCommand(ctx, client, id, expandVirtualMachineRunCommand(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err := waitForVirtualMachineRunCommandExecution(ctx, client, id); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute
+
+			id, err := parseVirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := getVirtualMachineRunCommand(ctx, client, id, "instanceView")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the API doesn't return the sensitive values, so we source these from the existing state
+			var existing VirtualMachineRunCommandModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := VirtualMachineRunCommandModel{
+				Name:               virtualMachineRunCommandName(id),
+				VirtualMachineId:   virtualMachineRunCommandParentID(id),
+				ProtectedParameter: existing.ProtectedParameter,
+				RunAsPassword:      existing.RunAsPassword,
+				OutputBlobUri:      existing.OutputBlobUri,
+				ErrorBlobUri:       existing.ErrorBlobUri,
+				Tags:               tags.ToTypedObject(resp.Tags),
+			}
+
+			if resp.Location != nil {
+				model.Location = location.Normalize(*resp.Location)
+			}
+
+			if props := resp.VirtualMachineRunCommandProperties; props != nil {
+				model.Source = flattenVirtualMachineRunCommandSource(props.Source, existing.Source)
+				model.Parameter = flattenVirtualMachineRunCommandParameters(props.Parameters)
+				model.RunAsUser = utils.NormalizeNilableString(props.RunAsUser)
+				if props.TimeoutInSeconds != nil {
+					model.TimeoutInSeconds = int(*props.TimeoutInSeconds)
+				}
+				model.InstanceView = flattenVirtualMachineRunCommandInstanceView(props.InstanceView)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute
+
+			id, err := parseVirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Run Command is re-executed when it's updated, so we send the complete payload
+			if err := createOrUpdateVirtualMachineRunCommand(ctx, client, id, expandVirtualMachineRunCommand(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			if err := waitForVirtualMachineRunCommandExecution(ctx, client, id); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute
+
+			id, err := parseVirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			switch v := id.(type) {
+			case *parse.VirtualMachineRunCommandId:
+				future, err := client.VMRunCommandsClient.Delete(ctx, v.ResourceGroup, v.VirtualMachineName, v.RunCommandName)
+				if err != nil {
+					return fmt.Errorf("deleting %s: %+v", *v, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.VMRunCommandsClient.Client); err != nil {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *v, err)
+				}
+
+			case *parse.VirtualMachineScaleSetVirtualMachineRunCommandId:
+				future, err := client.VMScaleSetVMRunCommandsClient.Delete(ctx, v.ResourceGroup, v.VirtualMachineScaleSetName, v.VirtualMachineName, v.RunCommandName)
+				if err != nil {
+					return fmt.Errorf("deleting %s: %+v", *v, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.VMScaleSetVMRunCommandsClient.Client); err != nil {
+					return fmt.Errorf("waiting for deletion of %s: %+v", *v, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// parseVirtualMachineRunCommandID parses the ID of a Run Command, which is a child resource of
+// either a Virtual Machine or an instance within a Virtual Machine Scale Set
+func parseVirtualMachineRunCommandID(input string) (resourceid.Formatter, error) {
+	if id, err := parse.VirtualMachineRunCommandID(input); err == nil {
+		return id, nil
+	}
+
+	id, err := parse.VirtualMachineScaleSetVirtualMachineRunCommandID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as either a Virtual Machine Run Command ID or a Virtual Machine Scale Set Virtual Machine Run Command ID: %+v", input, err)
+	}
+
+	return id, nil
+}
+
+func virtualMachineRunCommandIDFromParent(virtualMachineId, name string) (resourceid.Formatter, error) {
+	if id, err := parse.VirtualMachineID(virtualMachineId); err == nil {
+		runCommandId := parse.NewVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.Name, name)
+		return &runCommandId, nil
+	}
+
+	id, err := parse.VirtualMachineScaleSetVirtualMachineID(virtualMachineId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as either a Virtual Machine ID or a Virtual Machine Scale Set Virtual Machine ID: %+v", virtualMachineId, err)
+	}
+
+	runCommandId := parse.NewVirtualMachineScaleSetVirtualMachineRunCommandID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, name)
+	return &runCommandId, nil
+}
+
+func virtualMachineRunCommandName(input resourceid.Formatter) string {
+	switch id := input.(type) {
+	case *parse.VirtualMachineRunCommandId:
+		return id.RunCommandName
+	case *parse.VirtualMachineScaleSetVirtualMachineRunCommandId:
+		return id.RunCommandName
+	}
+
+	return ""
+}
+
+func virtualMachineRunCommandParentID(input resourceid.Formatter) string {
+	switch id := input.(type) {
+	case *parse.VirtualMachineRunCommandId:
+		return parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID()
+	case *parse.VirtualMachineScaleSetVirtualMachineRunCommandId:
+		return parse.NewVirtualMachineScaleSetVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName).ID()
+	}
+
+	return ""
+}
+
+func getVirtualMachineRunCommand(ctx context.Context, client *computeClient.Client, input resourceid.Formatter, expand string) (compute.VirtualMachineRunCommand, error) {
+	switch id := input.(type) {
+	case *parse.VirtualMachineRunCommandId:
+		return client.VMRunCommandsClient.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, expand)
+	case *parse.VirtualMachineScaleSetVirtualMachineRunCommandId:
+		return client.VMScaleSetVMRunCommandsClient.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, expand)
+	}
+
+	return compute.VirtualMachineRunCommand{}, fmt.Errorf("unsupported Run Command ID type %T", input)
+}
+
+func createOrUpdateVirtualMachineRunCommand(ctx context.Context, client *computeClient.Client, input resourceid.Formatter, payload compute.VirtualMachineRunCommand) error {
+	switch id := input.(type) {
+	case *parse.VirtualMachineRunCommandId:
+		future, err := client.VMRunCommandsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, payload)
+		if err != nil {
+			return err
+		}
+		if err := future.WaitForCompletionRef(ctx, client.VMRunCommandsClient.Client); err != nil {
+			return fmt.Errorf("waiting: %+v", err)
+		}
+		return nil
+
+	case *parse.VirtualMachineScaleSetVirtualMachineRunCommandId:
+		future, err := client.VMScaleSetVMRunCommandsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, id.RunCommandName, payload)
+		if err != nil {
+			return err
+		}
+		if err := future.WaitForCompletionRef(ctx, client.VMScaleSetVMRunCommandsClient.Client); err != nil {
+			return fmt.Errorf("waiting: %+v", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unsupported Run Command ID type %T", input)
+}
+
+// waitForVirtualMachineRunCommandExecution polls the Instance View of the Run Command until the script has
+// finished executing, since the Run Command resource itself can be provisioned successfully when the script fails
+func waitForVirtualMachineRunCommandExecution(ctx context.Context, client *computeClient.Client, id resourceid.Formatter) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(compute.ExecutionStatePending),
+			string(compute.ExecutionStateRunning),
+			string(compute.ExecutionStateUnknown),
+		},
+		Target: []string{
+			string(compute.ExecutionStateSucceeded),
+		},
+		Refresh:    virtualMachineRunCommandExecutionRefreshFunc(ctx, client, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the execution of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func virtualMachineRunCommandExecutionRefreshFunc(ctx context.Context, client *computeClient.Client, id resourceid.Formatter) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := getVirtualMachineRunCommand(ctx, client, id, "instanceView")
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.VirtualMachineRunCommandProperties == nil || resp.VirtualMachineRunCommandProperties.InstanceView == nil {
+			return resp, string(compute.ExecutionStateUnknown), nil
+		}
+
+		instanceView := resp.VirtualMachineRunCommandProperties.InstanceView
+		switch instanceView.ExecutionState {
+		case compute.ExecutionStateFailed, compute.ExecutionStateTimedOut, compute.ExecutionStateCanceled:
+			exitCode := 0
+			if instanceView.ExitCode != nil {
+				exitCode = int(*instanceView.ExitCode)
+			}
+			return resp, string(instanceView.ExecutionState), fmt.Errorf("execution finished with state %q (exit code %d): %s %s", string(instanceView.ExecutionState), exitCode, utils.NormalizeNilableString(instanceView.ExecutionMessage), utils.NormalizeNilableString(instanceView.Error))
+		}
+
+		return resp, string(instanceView.ExecutionState), nil
+	}
+}
+
+func expandVirtualMachineRunCommand(input VirtualMachineRunCommandModel) compute.VirtualMachineRunCommand {
+	props := compute.VirtualMachineRunCommandProperties{
+		AsyncExecution:      utils.Bool(false),
+		Parameters:          expandVirtualMachineRunCommandParameters(input.Parameter),
+		ProtectedParameters: expandVirtualMachineRunCommandParameters(input.ProtectedParameter),
+	}
+
+	if len(input.Source) > 0 {
+		source := input.Source[0]
+		props.Source = &compute.VirtualMachineRunCommandScriptSource{}
+		if source.Script != "" {
+			props.Source.Script = utils.String(source.Script)
+		}
+		if source.ScriptUri != "" {
+			props.Source.ScriptURI = utils.String(source.ScriptUri)
+		}
+		if source.CommandId != "" {
+			props.Source.CommandID = utils.String(source.CommandId)
+		}
+	}
+
+	if input.RunAsUser != "" {
+		props.RunAsUser = utils.String(input.RunAsUser)
+	}
+
+	if input.RunAsPassword != "" {
+		props.RunAsPassword = utils.String(input.RunAsPassword)
+	}
+
+	if input.TimeoutInSeconds > 0 {
+		props.TimeoutInSeconds = utils.Int32(int32(input.TimeoutInSeconds))
+	}
+
+	if input.OutputBlobUri != "" {
+		props.OutputBlobURI = utils.String(input.OutputBlobUri)
+	}
+
+	if input.ErrorBlobUri != "" {
+		props.ErrorBlobURI = utils.String(input.ErrorBlobUri)
+	}
+
+	return compute.VirtualMachineRunCommand{
+		Location:                           utils.String(location.Normalize(input.Location)),
+		VirtualMachineRunCommandProperties: &props,
+		Tags:                               tags.FromTypedObject(input.Tags),
+	}
+}
+
+func expandVirtualMachineRunCommandParameters(input []VirtualMachineRunCommandParameter) *[]compute.RunCommandInputParameter {
+	parameters := make([]compute.RunCommandInputParameter, 0)
+	for _, v := range input {
+		parameters = append(parameters, compute.RunCommandInputParameter{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return &parameters
+}
+
+func flattenVirtualMachineRunCommandSource(input *compute.VirtualMachineRunCommandScriptSource, existing []VirtualMachineRunCommandSourceModel) []VirtualMachineRunCommandSourceModel {
+	if input == nil {
+		return []VirtualMachineRunCommandSourceModel{}
+	}
+
+	source := VirtualMachineRunCommandSourceModel{
+		Script:    utils.NormalizeNilableString(input.Script),
+		CommandId: utils.NormalizeNilableString(input.CommandID),
+	}
+
+	// the SAS token within the Script URI isn't returned by the API, so we source this from the existing state
+	if len(existing) > 0 {
+		source.ScriptUri = existing[0].ScriptUri
+	}
+
+	return []VirtualMachineRunCommandSourceModel{source}
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []VirtualMachineRunCommandParameter {
+	parameters := make([]VirtualMachineRunCommandParameter, 0)
+	if input == nil {
+		return parameters
+	}
+
+	for _, v := range *input {
+		parameters = append(parameters, VirtualMachineRunCommandParameter{
+			Name:  utils.NormalizeNilableString(v.Name),
+			Value: utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return parameters
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []VirtualMachineRunCommandInstanceView {
+	if input == nil {
+		return []VirtualMachineRunCommandInstanceView{}
+	}
+
+	instanceView := VirtualMachineRunCommandInstanceView{
+		ExecutionState:   string(input.ExecutionState),
+		ExecutionMessage: utils.NormalizeNilableString(input.ExecutionMessage),
+		Output:           utils.NormalizeNilableString(input.Output),
+		Error:            utils.NormalizeNilableString(input.Error),
+	}
+
+	if input.ExitCode != nil {
+		instanceView.ExitCode = int(*input.ExitCode)
+	}
+
+	if input.StartTime != nil {
+		instanceView.StartTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	if input.EndTime != nil {
+		instanceView.EndTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []VirtualMachineRunCommandInstanceView{instanceView}
+}
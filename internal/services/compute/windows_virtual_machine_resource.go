@@ -174,6 +174,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": galleryApplicationSchema(true),
+
 			"identity": virtualMachineIdentity{}.Schema(),
 
 			"license_type": {
@@ -547,6 +549,10 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("an `eviction_policy` must be specified when `priority` is set to `Spot`")
 	}
 
+	if v, ok := d.GetOk("gallery_application"); ok {
+		params.VirtualMachineProperties.ApplicationProfile = expandGalleryApplications(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("license_type"); ok {
 		params.LicenseType = utils.String(v.(string))
 	}
@@ -665,6 +671,10 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("setting `additional_capabilities`: %+v", err)
 	}
 
+	if err := d.Set("gallery_application", flattenGalleryApplications(props.ApplicationProfile)); err != nil {
+		return fmt.Errorf("setting `gallery_application`: %+v", err)
+	}
+
 	availabilitySetId := ""
 	if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
 		availabilitySetId = *props.AvailabilitySet.ID
@@ -1061,7 +1071,7 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		shouldUpdate = true
 
 		tagsRaw := d.Get("tags").(map[string]interface{})
@@ -1080,6 +1090,11 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		update.VirtualMachineProperties.AdditionalCapabilities = expandVirtualMachineAdditionalCapabilities(additionalCapabilitiesRaw)
 	}
 
+	if d.HasChange("gallery_application") {
+		shouldUpdate = true
+		update.VirtualMachineProperties.ApplicationProfile = expandGalleryApplications(d.Get("gallery_application").([]interface{}))
+	}
+
 	if d.HasChange("encryption_at_host_enabled") {
 		shouldUpdate = true
 		shouldDeallocate = true // API returns the following error if not deallocate: 'securityProfile.encryptionAtHost' can be updated only when VM is in deallocated state
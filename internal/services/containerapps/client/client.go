@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/managedenvironments"
+)
+
+type Client struct {
+	CertificatesClient        *certificates.CertificatesClient
+	ContainerAppClient        *containerapps.ContainerAppsClient
+	DaprComponentsClient      *daprcomponents.DaprComponentsClient
+	ManagedEnvironmentsClient *managedenvironments.ManagedEnvironmentsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	certificatesClient := certificates.NewCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&certificatesClient.Client, o.ResourceManagerAuthorizer)
+
+	containerAppsClient := containerapps.NewContainerAppsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&containerAppsClient.Client, o.ResourceManagerAuthorizer)
+
+	daprComponentsClient := daprcomponents.NewDaprComponentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&daprComponentsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedEnvironmentsClient := managedenvironments.NewManagedEnvironmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedEnvironmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CertificatesClient:        &certificatesClient,
+		ContainerAppClient:        &containerAppsClient,
+		DaprComponentsClient:      &daprComponentsClient,
+		ManagedEnvironmentsClient: &managedEnvironmentsClient,
+	}
+}
@@ -0,0 +1,269 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = ContainerAppCustomDomainResource{}
+
+type ContainerAppCustomDomainResource struct{}
+
+type ContainerAppCustomDomainModel struct {
+	Name                                 string `tfschema:"name"`
+	ContainerAppId                       string `tfschema:"container_app_id"`
+	ContainerAppEnvironmentCertificateId string `tfschema:"container_app_environment_certificate_id"`
+	CertificateBindingType               string `tfschema:"certificate_binding_type"`
+}
+
+func (r ContainerAppCustomDomainResource) ResourceType() string {
+	return "azurerm_container_app_custom_domain"
+}
+
+func (r ContainerAppCustomDomainResource) ModelObject() interface{} {
+	return &ContainerAppCustomDomainModel{}
+}
+
+func (r ContainerAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerAppCustomDomainID
+}
+
+func (r ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerapps.ValidateContainerAppID,
+		},
+
+		"container_app_environment_certificate_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: certificates.ValidateCertificateID,
+		},
+
+		"certificate_binding_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(containerapps.BindingTypeDisabled),
+				string(containerapps.BindingTypeSniEnabled),
+			}, false),
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			var config ContainerAppCustomDomainModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerAppId, err := containerapps.ParseContainerAppID(config.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerAppCustomDomainID(containerAppId.SubscriptionId, containerAppId.ResourceGroupName, containerAppId.ContainerAppName, config.Name)
+
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			containerApp, err := retrieveContainerAppForCustomDomain(ctx, client, *containerAppId)
+			if err != nil {
+				return err
+			}
+
+			ingress := containerApp.Properties.Configuration.Ingress
+			customDomains := make([]containerapps.CustomDomain, 0)
+			if ingress.CustomDomains != nil {
+				customDomains = *ingress.CustomDomains
+			}
+
+			for _, v := range customDomains {
+				if strings.EqualFold(v.Name, config.Name) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			bindingType := containerapps.BindingType(config.CertificateBindingType)
+			customDomains = append(customDomains, containerapps.CustomDomain{
+				BindingType:   &bindingType,
+				CertificateId: utils.String(config.ContainerAppEnvironmentCertificateId),
+				Name:          config.Name,
+			})
+			ingress.CustomDomains = &customDomains
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *containerAppId, *containerApp); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			id, err := parse.ContainerAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			containerAppId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroup, id.ContainerAppName)
+
+			resp, err := client.Get(ctx, containerAppId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", containerAppId, err)
+			}
+
+			var customDomain *containerapps.CustomDomain
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Configuration != nil {
+				if ingress := model.Properties.Configuration.Ingress; ingress != nil && ingress.CustomDomains != nil {
+					for _, v := range *ingress.CustomDomains {
+						if strings.EqualFold(v.Name, id.CustomDomainName) {
+							domain := v
+							customDomain = &domain
+							break
+						}
+					}
+				}
+			}
+			if customDomain == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ContainerAppCustomDomainModel{
+				Name:           id.CustomDomainName,
+				ContainerAppId: containerAppId.ID(),
+			}
+			if customDomain.BindingType != nil {
+				state.CertificateBindingType = string(*customDomain.BindingType)
+			}
+			if customDomain.CertificateId != nil {
+				certificateId, err := certificates.ParseCertificateIDInsensitively(*customDomain.CertificateId)
+				if err != nil {
+					return err
+				}
+				state.ContainerAppEnvironmentCertificateId = certificateId.ID()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			id, err := parse.ContainerAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			containerAppId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroup, id.ContainerAppName)
+
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			containerApp, err := retrieveContainerAppForCustomDomain(ctx, client, containerAppId)
+			if err != nil {
+				return err
+			}
+
+			ingress := containerApp.Properties.Configuration.Ingress
+			customDomains := make([]containerapps.CustomDomain, 0)
+			if ingress.CustomDomains != nil {
+				for _, v := range *ingress.CustomDomains {
+					if !strings.EqualFold(v.Name, id.CustomDomainName) {
+						customDomains = append(customDomains, v)
+					}
+				}
+			}
+			ingress.CustomDomains = &customDomains
+
+			if err := client.CreateOrUpdateThenPoll(ctx, containerAppId, *containerApp); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// retrieveContainerAppForCustomDomain returns the Container App with its Secrets populated, since these
+// aren't returned from the Get endpoint but must be sent back when the Container App is updated
+func retrieveContainerAppForCustomDomain(ctx context.Context, client *containerapps.ContainerAppsClient, id containerapps.ContainerAppId) (*containerapps.ContainerApp, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Configuration == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.configuration` was nil", id)
+	}
+	if resp.Model.Properties.Configuration.Ingress == nil {
+		return nil, fmt.Errorf("custom domains require `ingress` to be configured on %s", id)
+	}
+
+	secretsResp, err := client.ListSecrets(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("listing secrets for %s: %+v", id, err)
+	}
+	secrets := make([]containerapps.Secret, 0)
+	if model := secretsResp.Model; model != nil {
+		for _, v := range model.Value {
+			secrets = append(secrets, containerapps.Secret{
+				Identity:    v.Identity,
+				KeyVaultUrl: v.KeyVaultUrl,
+				Name:        v.Name,
+				Value:       v.Value,
+			})
+		}
+	}
+	resp.Model.Properties.Configuration.Secrets = &secrets
+
+	return resp.Model, nil
+}
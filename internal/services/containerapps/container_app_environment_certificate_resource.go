@@ -0,0 +1,291 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCertificateResource{}
+
+type ContainerAppEnvironmentCertificateResource struct{}
+
+type ContainerAppEnvironmentCertificateModel struct {
+	Name                      string            `tfschema:"name"`
+	ContainerAppEnvironmentId string            `tfschema:"container_app_environment_id"`
+	CertificateBlob           string            `tfschema:"certificate_blob_base64"`
+	CertificatePassword       string            `tfschema:"certificate_password"`
+	Tags                      map[string]string `tfschema:"tags"`
+
+	ExpirationDate string `tfschema:"expiration_date"`
+	IssueDate      string `tfschema:"issue_date"`
+	Issuer         string `tfschema:"issuer"`
+	SubjectName    string `tfschema:"subject_name"`
+	Thumbprint     string `tfschema:"thumbprint"`
+}
+
+func (r ContainerAppEnvironmentCertificateResource) ResourceType() string {
+	return "azurerm_container_app_environment_certificate"
+}
+
+func (r ContainerAppEnvironmentCertificateResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentCertificateModel{}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return certificates.ValidateCertificateID
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CertificateName(),
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: certificates.ValidateManagedEnvironmentID,
+		},
+
+		"certificate_blob_base64": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsBase64,
+		},
+
+		"certificate_password": {
+			Type:      pluginsdk.TypeString,
+			Required:  true,
+			ForceNew:  true,
+			Sensitive: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiration_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issue_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issuer": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"subject_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			var config ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(config.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := certificates.NewCertificateID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// Certificates must be created in the same location as their Environment
+			environment, err := environmentsClient.Get(ctx, *environmentId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *environmentId, err)
+			}
+			if environment.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *environmentId)
+			}
+
+			payload := certificates.Certificate{
+				Location: environment.Model.Location,
+				Properties: &certificates.CertificateProperties{
+					Password: utils.String(config.CertificatePassword),
+					Value:    utils.String(config.CertificateBlob),
+				},
+				Tags: &config.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the certificate and its password aren't returned by the API, so these are retained from the config
+			var config ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ContainerAppEnvironmentCertificateModel{
+				Name:                      id.CertificateName,
+				ContainerAppEnvironmentId: certificates.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+				CertificateBlob:           config.CertificateBlob,
+				CertificatePassword:       config.CertificatePassword,
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.ExpirationDate != nil {
+						state.ExpirationDate = *props.ExpirationDate
+					}
+					if props.IssueDate != nil {
+						state.IssueDate = *props.IssueDate
+					}
+					if props.Issuer != nil {
+						state.Issuer = *props.Issuer
+					}
+					if props.SubjectName != nil {
+						state.SubjectName = *props.SubjectName
+					}
+					if props.Thumbprint != nil {
+						state.Thumbprint = *props.Thumbprint
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			// the certificate isn't returned by the API, so it must be sent again with any update
+			payload.Properties = &certificates.CertificateProperties{
+				Password: utils.String(config.CertificatePassword),
+				Value:    utils.String(config.CertificateBlob),
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
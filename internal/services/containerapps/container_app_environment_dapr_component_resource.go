@@ -0,0 +1,413 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentDaprComponentResource{}
+
+type ContainerAppEnvironmentDaprComponentResource struct{}
+
+type ContainerAppEnvironmentDaprComponentModel struct {
+	Name                      string                   `tfschema:"name"`
+	ContainerAppEnvironmentId string                   `tfschema:"container_app_environment_id"`
+	ComponentType             string                   `tfschema:"component_type"`
+	Version                   string                   `tfschema:"version"`
+	IgnoreErrors              bool                     `tfschema:"ignore_errors"`
+	InitTimeout               string                   `tfschema:"init_timeout"`
+	Metadata                  []DaprComponentMetadata  `tfschema:"metadata"`
+	Scopes                    []string                 `tfschema:"scopes"`
+	Secret                    []DaprComponentSecretRef `tfschema:"secret"`
+}
+
+type DaprComponentMetadata struct {
+	Name       string `tfschema:"name"`
+	SecretName string `tfschema:"secret_name"`
+	Value      string `tfschema:"value"`
+}
+
+type DaprComponentSecretRef struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_dapr_component"
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentDaprComponentModel{}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return daprcomponents.ValidateDaprComponentID
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DaprComponentName(),
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: daprcomponents.ValidateManagedEnvironmentID,
+		},
+
+		"component_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ignore_errors": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"init_timeout": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "5s",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.SecretName(),
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.SecretName(),
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			var config ContainerAppEnvironmentDaprComponentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := daprcomponents.ParseManagedEnvironmentID(config.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := daprcomponents.NewDaprComponentID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := daprcomponents.DaprComponent{
+				Properties: &daprcomponents.DaprComponentProperties{
+					ComponentType: utils.String(config.ComponentType),
+					IgnoreErrors:  utils.Bool(config.IgnoreErrors),
+					InitTimeout:   utils.String(config.InitTimeout),
+					Metadata:      expandDaprComponentMetadata(config.Metadata),
+					Scopes:        &config.Scopes,
+					Secrets:       expandDaprComponentSecrets(config.Secret),
+					Version:       utils.String(config.Version),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentDaprComponentModel{
+				Name:                      id.ComponentName,
+				ContainerAppEnvironmentId: daprcomponents.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.ComponentType != nil {
+						state.ComponentType = *props.ComponentType
+					}
+					if props.IgnoreErrors != nil {
+						state.IgnoreErrors = *props.IgnoreErrors
+					}
+					if props.InitTimeout != nil {
+						state.InitTimeout = *props.InitTimeout
+					}
+					if props.Scopes != nil {
+						state.Scopes = *props.Scopes
+					}
+					if props.Version != nil {
+						state.Version = *props.Version
+					}
+					state.Metadata = flattenDaprComponentMetadata(props.Metadata)
+				}
+			}
+
+			// the values of secrets are only returned from the `listSecrets` endpoint
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+			}
+			if model := secretsResp.Model; model != nil {
+				state.Secret = flattenDaprComponentSecrets(model.Value)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppEnvironmentDaprComponentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			props := payload.Properties
+
+			if metadata.ResourceData.HasChange("version") {
+				props.Version = utils.String(config.Version)
+			}
+
+			if metadata.ResourceData.HasChange("ignore_errors") {
+				props.IgnoreErrors = utils.Bool(config.IgnoreErrors)
+			}
+
+			if metadata.ResourceData.HasChange("init_timeout") {
+				props.InitTimeout = utils.String(config.InitTimeout)
+			}
+
+			if metadata.ResourceData.HasChange("metadata") {
+				props.Metadata = expandDaprComponentMetadata(config.Metadata)
+			}
+
+			if metadata.ResourceData.HasChange("scopes") {
+				props.Scopes = &config.Scopes
+			}
+
+			// secrets aren't returned from the Get endpoint, so these must always be sent
+			props.Secrets = expandDaprComponentSecrets(config.Secret)
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDaprComponentMetadata(input []DaprComponentMetadata) *[]daprcomponents.DaprMetadata {
+	output := make([]daprcomponents.DaprMetadata, 0)
+	for _, v := range input {
+		metadata := daprcomponents.DaprMetadata{
+			Name: utils.String(v.Name),
+		}
+		if v.SecretName != "" {
+			metadata.SecretRef = utils.String(v.SecretName)
+		}
+		if v.Value != "" {
+			metadata.Value = utils.String(v.Value)
+		}
+		output = append(output, metadata)
+	}
+
+	return &output
+}
+
+func flattenDaprComponentMetadata(input *[]daprcomponents.DaprMetadata) []DaprComponentMetadata {
+	output := make([]DaprComponentMetadata, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		metadata := DaprComponentMetadata{}
+		if v.Name != nil {
+			metadata.Name = *v.Name
+		}
+		if v.SecretRef != nil {
+			metadata.SecretName = *v.SecretRef
+		}
+		if v.Value != nil {
+			metadata.Value = *v.Value
+		}
+		output = append(output, metadata)
+	}
+
+	return output
+}
+
+func expandDaprComponentSecrets(input []DaprComponentSecretRef) *[]daprcomponents.Secret {
+	output := make([]daprcomponents.Secret, 0)
+	for _, v := range input {
+		output = append(output, daprcomponents.Secret{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return &output
+}
+
+func flattenDaprComponentSecrets(input []daprcomponents.DaprSecret) []DaprComponentSecretRef {
+	output := make([]DaprComponentSecretRef, 0)
+	for _, v := range input {
+		secret := DaprComponentSecretRef{}
+		if v.Name != nil {
+			secret.Name = *v.Name
+		}
+		if v.Value != nil {
+			secret.Value = *v.Value
+		}
+		output = append(output, secret)
+	}
+
+	return output
+}
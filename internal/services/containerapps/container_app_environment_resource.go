@@ -0,0 +1,462 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Consumption Workload Profile is always present on Environments which use Workload Profiles,
+// so it's added/removed automatically rather than being managed by users
+const consumptionWorkloadProfileName = "Consumption"
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
+var _ sdk.ResourceWithSubscriptionIdOverride = ContainerAppEnvironmentResource{}
+
+type ContainerAppEnvironmentResource struct{}
+
+type ContainerAppEnvironmentModel struct {
+	Name                        string                                   `tfschema:"name"`
+	ResourceGroupName           string                                   `tfschema:"resource_group_name"`
+	Location                    string                                   `tfschema:"location"`
+	LogAnalyticsWorkspaceId     string                                   `tfschema:"log_analytics_workspace_id"`
+	InfrastructureSubnetId      string                                   `tfschema:"infrastructure_subnet_id"`
+	InternalLoadBalancerEnabled bool                                     `tfschema:"internal_load_balancer_enabled"`
+	ZoneRedundancyEnabled       bool                                     `tfschema:"zone_redundancy_enabled"`
+	WorkloadProfile             []ContainerAppEnvironmentWorkloadProfile `tfschema:"workload_profile"`
+	Tags                        map[string]string                        `tfschema:"tags"`
+
+	DefaultDomain                string `tfschema:"default_domain"`
+	DockerBridgeCidr             string `tfschema:"docker_bridge_cidr"`
+	PlatformReservedCidr         string `tfschema:"platform_reserved_cidr"`
+	PlatformReservedDnsIPAddress string `tfschema:"platform_reserved_dns_ip_address"`
+	StaticIPAddress              string `tfschema:"static_ip_address"`
+}
+
+type ContainerAppEnvironmentWorkloadProfile struct {
+	Name                string `tfschema:"name"`
+	WorkloadProfileType string `tfschema:"workload_profile_type"`
+	MinimumCount        int    `tfschema:"minimum_count"`
+	MaximumCount        int    `tfschema:"maximum_count"`
+}
+
+func (r ContainerAppEnvironmentResource) SupportsSubscriptionIdOverride() {}
+
+func (r ContainerAppEnvironmentResource) ResourceType() string {
+	return "azurerm_container_app_environment"
+}
+
+func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentModel{}
+}
+
+func (r ContainerAppEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedenvironments.ValidateManagedEnvironmentID
+}
+
+func (r ContainerAppEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedEnvironmentName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+		},
+
+		"infrastructure_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"internal_load_balancer_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      false,
+			RequiredWith: []string{"infrastructure_subnet_id"},
+		},
+
+		"zone_redundancy_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      false,
+			RequiredWith: []string{"infrastructure_subnet_id"},
+		},
+
+		"workload_profile": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.All(
+							validation.StringLenBetween(1, 15),
+							validation.StringNotInSlice([]string{consumptionWorkloadProfileName}, true),
+						),
+					},
+
+					"workload_profile_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"D4",
+							"D8",
+							"D16",
+							"D32",
+							"E4",
+							"E8",
+							"E16",
+							"E32",
+						}, false),
+					},
+
+					"minimum_count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+
+					"maximum_count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"docker_bridge_cidr": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"platform_reserved_cidr": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"platform_reserved_dns_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"static_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config ContainerAppEnvironmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := managedenvironments.NewManagedEnvironmentID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := managedenvironments.ManagedEnvironment{
+				Location: location.Normalize(config.Location),
+				Properties: &managedenvironments.ManagedEnvironmentProperties{
+					WorkloadProfiles: expandContainerAppEnvironmentWorkloadProfiles(config.WorkloadProfile),
+					ZoneRedundant:    utils.Bool(config.ZoneRedundancyEnabled),
+				},
+				Tags: &config.Tags,
+			}
+
+			if config.LogAnalyticsWorkspaceId != "" {
+				appLogsConfiguration, err := expandContainerAppEnvironmentLogsConfiguration(ctx, metadata, config.LogAnalyticsWorkspaceId)
+				if err != nil {
+					return err
+				}
+				payload.Properties.AppLogsConfiguration = appLogsConfiguration
+			}
+
+			if config.InfrastructureSubnetId != "" {
+				payload.Properties.VnetConfiguration = &managedenvironments.VnetConfiguration{
+					InfrastructureSubnetId: utils.String(config.InfrastructureSubnetId),
+					Internal:               utils.Bool(config.InternalLoadBalancerEnabled),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API only returns the Customer ID of the Log Analytics Workspace, so this is retained from the config
+			var config ContainerAppEnvironmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ContainerAppEnvironmentModel{
+				Name:                    id.ManagedEnvironmentName,
+				ResourceGroupName:       id.ResourceGroupName,
+				LogAnalyticsWorkspaceId: config.LogAnalyticsWorkspaceId,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if vnet := props.VnetConfiguration; vnet != nil {
+						if vnet.InfrastructureSubnetId != nil {
+							state.InfrastructureSubnetId = *vnet.InfrastructureSubnetId
+						}
+						if vnet.Internal != nil {
+							state.InternalLoadBalancerEnabled = *vnet.Internal
+						}
+						if vnet.DockerBridgeCidr != nil {
+							state.DockerBridgeCidr = *vnet.DockerBridgeCidr
+						}
+						if vnet.PlatformReservedCidr != nil {
+							state.PlatformReservedCidr = *vnet.PlatformReservedCidr
+						}
+						if vnet.PlatformReservedDnsIP != nil {
+							state.PlatformReservedDnsIPAddress = *vnet.PlatformReservedDnsIP
+						}
+					}
+
+					if props.DefaultDomain != nil {
+						state.DefaultDomain = *props.DefaultDomain
+					}
+					if props.StaticIP != nil {
+						state.StaticIPAddress = *props.StaticIP
+					}
+					if props.ZoneRedundant != nil {
+						state.ZoneRedundancyEnabled = *props.ZoneRedundant
+					}
+
+					state.WorkloadProfile = flattenContainerAppEnvironmentWorkloadProfiles(props.WorkloadProfiles)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppEnvironmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := managedenvironments.ManagedEnvironment{
+				Location:   location.Normalize(config.Location),
+				Properties: &managedenvironments.ManagedEnvironmentProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("workload_profile") {
+				payload.Properties.WorkloadProfiles = expandContainerAppEnvironmentWorkloadProfiles(config.WorkloadProfile)
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppEnvironmentLogsConfiguration(ctx context.Context, metadata sdk.ResourceMetaData, workspaceId string) (*managedenvironments.AppLogsConfiguration, error) {
+	workspacesClient := metadata.Client.LogAnalytics.WorkspacesClient
+	sharedKeysClient := metadata.Client.LogAnalytics.SharedKeysClient
+
+	id, err := loganalyticsParse.LogAnalyticsWorkspaceID(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := workspacesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if workspace.WorkspaceProperties == nil || workspace.WorkspaceProperties.CustomerID == nil {
+		return nil, fmt.Errorf("retrieving %s: `customerId` was nil", *id)
+	}
+
+	sharedKeys, err := sharedKeysClient.GetSharedKeys(ctx, id.ResourceGroup, id.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Shared Keys for %s: %+v", *id, err)
+	}
+	if sharedKeys.PrimarySharedKey == nil {
+		return nil, fmt.Errorf("retrieving Shared Keys for %s: `primarySharedKey` was nil", *id)
+	}
+
+	return &managedenvironments.AppLogsConfiguration{
+		Destination: utils.String("log-analytics"),
+		LogAnalyticsConfiguration: &managedenvironments.LogAnalyticsConfiguration{
+			CustomerId: workspace.WorkspaceProperties.CustomerID,
+			SharedKey:  sharedKeys.PrimarySharedKey,
+		},
+	}, nil
+}
+
+func expandContainerAppEnvironmentWorkloadProfiles(input []ContainerAppEnvironmentWorkloadProfile) *[]managedenvironments.WorkloadProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := []managedenvironments.WorkloadProfile{
+		{
+			Name:                consumptionWorkloadProfileName,
+			WorkloadProfileType: consumptionWorkloadProfileName,
+		},
+	}
+	for _, v := range input {
+		output = append(output, managedenvironments.WorkloadProfile{
+			MaximumCount:        utils.Int64(int64(v.MaximumCount)),
+			MinimumCount:        utils.Int64(int64(v.MinimumCount)),
+			Name:                v.Name,
+			WorkloadProfileType: v.WorkloadProfileType,
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerAppEnvironmentWorkloadProfiles(input *[]managedenvironments.WorkloadProfile) []ContainerAppEnvironmentWorkloadProfile {
+	output := make([]ContainerAppEnvironmentWorkloadProfile, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if strings.EqualFold(v.Name, consumptionWorkloadProfileName) {
+			continue
+		}
+
+		profile := ContainerAppEnvironmentWorkloadProfile{
+			Name:                v.Name,
+			WorkloadProfileType: v.WorkloadProfileType,
+		}
+		if v.MaximumCount != nil {
+			profile.MaximumCount = int(*v.MaximumCount)
+		}
+		if v.MinimumCount != nil {
+			profile.MinimumCount = int(*v.MinimumCount)
+		}
+		output = append(output, profile)
+	}
+
+	return output
+}
@@ -0,0 +1,1176 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppResource{}
+var _ sdk.ResourceWithSubscriptionIdOverride = ContainerAppResource{}
+
+type ContainerAppResource struct{}
+
+type ContainerAppModel struct {
+	Name                      string                 `tfschema:"name"`
+	ResourceGroupName         string                 `tfschema:"resource_group_name"`
+	ContainerAppEnvironmentId string                 `tfschema:"container_app_environment_id"`
+	RevisionMode              string                 `tfschema:"revision_mode"`
+	WorkloadProfileName       string                 `tfschema:"workload_profile_name"`
+	Dapr                      []ContainerAppDapr     `tfschema:"dapr"`
+	Ingress                   []ContainerAppIngress  `tfschema:"ingress"`
+	Registry                  []ContainerAppRegistry `tfschema:"registry"`
+	Secret                    []ContainerAppSecret   `tfschema:"secret"`
+	Template                  []ContainerAppTemplate `tfschema:"template"`
+	Tags                      map[string]string      `tfschema:"tags"`
+
+	Location                   string   `tfschema:"location"`
+	CustomDomainVerificationId string   `tfschema:"custom_domain_verification_id"`
+	LatestRevisionFqdn         string   `tfschema:"latest_revision_fqdn"`
+	LatestRevisionName         string   `tfschema:"latest_revision_name"`
+	OutboundIPAddresses        []string `tfschema:"outbound_ip_addresses"`
+}
+
+type ContainerAppDapr struct {
+	AppId       string `tfschema:"app_id"`
+	AppPort     int    `tfschema:"app_port"`
+	AppProtocol string `tfschema:"app_protocol"`
+}
+
+type ContainerAppIngress struct {
+	AllowInsecureConnections bool                        `tfschema:"allow_insecure_connections"`
+	ExternalEnabled          bool                        `tfschema:"external_enabled"`
+	TargetPort               int                         `tfschema:"target_port"`
+	ExposedPort              int                         `tfschema:"exposed_port"`
+	Transport                string                      `tfschema:"transport"`
+	TrafficWeight            []ContainerAppTrafficWeight `tfschema:"traffic_weight"`
+	Fqdn                     string                      `tfschema:"fqdn"`
+}
+
+type ContainerAppTrafficWeight struct {
+	Label          string `tfschema:"label"`
+	LatestRevision bool   `tfschema:"latest_revision"`
+	RevisionSuffix string `tfschema:"revision_suffix"`
+	Percentage     int    `tfschema:"percentage"`
+}
+
+type ContainerAppRegistry struct {
+	Server             string `tfschema:"server"`
+	Username           string `tfschema:"username"`
+	PasswordSecretName string `tfschema:"password_secret_name"`
+	Identity           string `tfschema:"identity"`
+}
+
+type ContainerAppSecret struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type ContainerAppTemplate struct {
+	Container      []ContainerAppContainer `tfschema:"container"`
+	MinReplicas    int                     `tfschema:"min_replicas"`
+	MaxReplicas    int                     `tfschema:"max_replicas"`
+	RevisionSuffix string                  `tfschema:"revision_suffix"`
+	Volume         []ContainerAppVolume    `tfschema:"volume"`
+}
+
+type ContainerAppContainer struct {
+	Name             string                     `tfschema:"name"`
+	Image            string                     `tfschema:"image"`
+	CPU              float64                    `tfschema:"cpu"`
+	Memory           string                     `tfschema:"memory"`
+	EphemeralStorage string                     `tfschema:"ephemeral_storage"`
+	Command          []string                   `tfschema:"command"`
+	Args             []string                   `tfschema:"args"`
+	Env              []ContainerAppEnvVar       `tfschema:"env"`
+	VolumeMounts     []ContainerAppVolumeMounts `tfschema:"volume_mounts"`
+}
+
+type ContainerAppEnvVar struct {
+	Name       string `tfschema:"name"`
+	Value      string `tfschema:"value"`
+	SecretName string `tfschema:"secret_name"`
+}
+
+type ContainerAppVolumeMounts struct {
+	Name string `tfschema:"name"`
+	Path string `tfschema:"path"`
+}
+
+type ContainerAppVolume struct {
+	Name        string `tfschema:"name"`
+	StorageName string `tfschema:"storage_name"`
+	StorageType string `tfschema:"storage_type"`
+}
+
+func (r ContainerAppResource) SupportsSubscriptionIdOverride() {}
+
+func (r ContainerAppResource) ResourceType() string {
+	return "azurerm_container_app"
+}
+
+func (r ContainerAppResource) ModelObject() interface{} {
+	return &ContainerAppModel{}
+}
+
+func (r ContainerAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerapps.ValidateContainerAppID
+}
+
+func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerapps.ValidateManagedEnvironmentID,
+		},
+
+		"revision_mode": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(containerapps.ActiveRevisionsModeMultiple),
+				string(containerapps.ActiveRevisionsModeSingle),
+			}, false),
+		},
+
+		"workload_profile_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dapr": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"app_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"app_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+
+					"app_protocol": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(containerapps.AppProtocolHttp),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerapps.AppProtocolGrpc),
+							string(containerapps.AppProtocolHttp),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"ingress": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allow_insecure_connections": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"external_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"target_port": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+
+					"exposed_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+
+					"transport": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(containerapps.IngressTransportMethodAuto),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerapps.IngressTransportMethodAuto),
+							string(containerapps.IngressTransportMethodHttp),
+							string(containerapps.IngressTransportMethodHttp2),
+							string(containerapps.IngressTransportMethodTcp),
+						}, false),
+					},
+
+					"traffic_weight": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"label": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"latest_revision": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"revision_suffix": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"percentage": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(0, 100),
+								},
+							},
+						},
+					},
+
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"registry": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"server": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password_secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.SecretName(),
+					},
+
+					"identity": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.SecretName(),
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"template": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"container": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"image": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"cpu": {
+									Type:         pluginsdk.TypeFloat,
+									Required:     true,
+									ValidateFunc: validation.FloatAtLeast(0.25),
+								},
+
+								"memory": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"ephemeral_storage": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"command": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"args": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"env": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"value": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"secret_name": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validate.SecretName(),
+											},
+										},
+									},
+								},
+
+								"volume_mounts": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"path": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"min_replicas": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 300),
+					},
+
+					"max_replicas": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 300),
+					},
+
+					"revision_suffix": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"volume": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"storage_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"storage_type": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Default:  "EmptyDir",
+									ValidateFunc: validation.StringInSlice([]string{
+										"AzureFile",
+										"EmptyDir",
+									}, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"latest_revision_fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_revision_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ContainerAppResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config ContainerAppModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := containerapps.NewContainerAppID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// Container Apps must be created in the same location as their Environment
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(config.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+			environment, err := environmentsClient.Get(ctx, *environmentId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *environmentId, err)
+			}
+			if environment.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *environmentId)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := containerapps.ContainerApp{
+				Identity: expandedIdentity,
+				Location: environment.Model.Location,
+				Properties: &containerapps.ContainerAppProperties{
+					Configuration:        expandContainerAppConfiguration(config, nil),
+					ManagedEnvironmentId: utils.String(config.ContainerAppEnvironmentId),
+					Template:             expandContainerAppTemplate(config.Template),
+				},
+				Tags: &config.Tags,
+			}
+
+			if config.WorkloadProfileName != "" {
+				payload.Properties.WorkloadProfileName = utils.String(config.WorkloadProfileName)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppModel{
+				Name:              id.ContainerAppName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					if props.ManagedEnvironmentId != nil {
+						environmentId, err := containerapps.ParseManagedEnvironmentIDInsensitively(*props.ManagedEnvironmentId)
+						if err != nil {
+							return err
+						}
+						state.ContainerAppEnvironmentId = environmentId.ID()
+					}
+					if props.WorkloadProfileName != nil {
+						state.WorkloadProfileName = *props.WorkloadProfileName
+					}
+					if props.CustomDomainVerificationId != nil {
+						state.CustomDomainVerificationId = *props.CustomDomainVerificationId
+					}
+					if props.LatestRevisionFqdn != nil {
+						state.LatestRevisionFqdn = *props.LatestRevisionFqdn
+					}
+					if props.LatestRevisionName != nil {
+						state.LatestRevisionName = *props.LatestRevisionName
+					}
+					if props.OutboundIPAddresses != nil {
+						state.OutboundIPAddresses = *props.OutboundIPAddresses
+					}
+
+					if config := props.Configuration; config != nil {
+						if config.ActiveRevisionsMode != nil {
+							state.RevisionMode = string(*config.ActiveRevisionsMode)
+						}
+						state.Dapr = flattenContainerAppDapr(config.Dapr)
+						state.Ingress = flattenContainerAppIngress(config.Ingress, id.ContainerAppName)
+						state.Registry = flattenContainerAppRegistries(config.Registries)
+					}
+
+					state.Template = flattenContainerAppTemplate(props.Template, id.ContainerAppName)
+				}
+			}
+
+			// the values of secrets are only returned from the `listSecrets` endpoint
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+			}
+			if model := secretsResp.Model; model != nil {
+				state.Secret = flattenContainerAppSecrets(model.Value)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			props := payload.Properties
+
+			// secrets aren't returned from the Get endpoint, so the configuration is rebuilt from the config - however
+			// Custom Domains are managed by the `azurerm_container_app_custom_domain` resource, so those are retained
+			var customDomains *[]containerapps.CustomDomain
+			if props.Configuration != nil && props.Configuration.Ingress != nil {
+				customDomains = props.Configuration.Ingress.CustomDomains
+			}
+			props.Configuration = expandContainerAppConfiguration(config, customDomains)
+
+			if metadata.ResourceData.HasChange("template") {
+				props.Template = expandContainerAppTemplate(config.Template)
+			}
+
+			if metadata.ResourceData.HasChange("workload_profile_name") {
+				props.WorkloadProfileName = utils.String(config.WorkloadProfileName)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppConfiguration(input ContainerAppModel, customDomains *[]containerapps.CustomDomain) *containerapps.Configuration {
+	revisionMode := containerapps.ActiveRevisionsMode(input.RevisionMode)
+	output := containerapps.Configuration{
+		ActiveRevisionsMode: &revisionMode,
+		Dapr:                expandContainerAppDapr(input.Dapr),
+		Ingress:             expandContainerAppIngress(input.Ingress, input.Name),
+		Registries:          expandContainerAppRegistries(input.Registry),
+		Secrets:             expandContainerAppSecrets(input.Secret),
+	}
+
+	if output.Ingress != nil {
+		output.Ingress.CustomDomains = customDomains
+	}
+
+	return &output
+}
+
+func expandContainerAppDapr(input []ContainerAppDapr) *containerapps.Dapr {
+	if len(input) == 0 {
+		return &containerapps.Dapr{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	dapr := input[0]
+	appProtocol := containerapps.AppProtocol(dapr.AppProtocol)
+	output := containerapps.Dapr{
+		AppId:       utils.String(dapr.AppId),
+		AppProtocol: &appProtocol,
+		Enabled:     utils.Bool(true),
+	}
+	if dapr.AppPort != 0 {
+		output.AppPort = utils.Int64(int64(dapr.AppPort))
+	}
+
+	return &output
+}
+
+func flattenContainerAppDapr(input *containerapps.Dapr) []ContainerAppDapr {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []ContainerAppDapr{}
+	}
+
+	output := ContainerAppDapr{}
+	if input.AppId != nil {
+		output.AppId = *input.AppId
+	}
+	if input.AppPort != nil {
+		output.AppPort = int(*input.AppPort)
+	}
+	if input.AppProtocol != nil {
+		output.AppProtocol = string(*input.AppProtocol)
+	}
+
+	return []ContainerAppDapr{output}
+}
+
+func expandContainerAppIngress(input []ContainerAppIngress, appName string) *containerapps.Ingress {
+	if len(input) == 0 {
+		return nil
+	}
+
+	ingress := input[0]
+	transport := containerapps.IngressTransportMethod(ingress.Transport)
+	output := containerapps.Ingress{
+		AllowInsecure: utils.Bool(ingress.AllowInsecureConnections),
+		External:      utils.Bool(ingress.ExternalEnabled),
+		TargetPort:    utils.Int64(int64(ingress.TargetPort)),
+		Traffic:       expandContainerAppTrafficWeights(ingress.TrafficWeight, appName),
+		Transport:     &transport,
+	}
+	if ingress.ExposedPort != 0 {
+		output.ExposedPort = utils.Int64(int64(ingress.ExposedPort))
+	}
+
+	return &output
+}
+
+func flattenContainerAppIngress(input *containerapps.Ingress, appName string) []ContainerAppIngress {
+	if input == nil {
+		return []ContainerAppIngress{}
+	}
+
+	output := ContainerAppIngress{
+		TrafficWeight: flattenContainerAppTrafficWeights(input.Traffic, appName),
+	}
+	if input.AllowInsecure != nil {
+		output.AllowInsecureConnections = *input.AllowInsecure
+	}
+	if input.External != nil {
+		output.ExternalEnabled = *input.External
+	}
+	if input.ExposedPort != nil {
+		output.ExposedPort = int(*input.ExposedPort)
+	}
+	if input.Fqdn != nil {
+		output.Fqdn = *input.Fqdn
+	}
+	if input.TargetPort != nil {
+		output.TargetPort = int(*input.TargetPort)
+	}
+	if input.Transport != nil {
+		output.Transport = string(*input.Transport)
+	}
+
+	return []ContainerAppIngress{output}
+}
+
+// revisions are named `{appName}--{revisionSuffix}`, however only the suffix is exposed to users
+func expandContainerAppTrafficWeights(input []ContainerAppTrafficWeight, appName string) *[]containerapps.TrafficWeight {
+	output := make([]containerapps.TrafficWeight, 0)
+	for _, v := range input {
+		weight := containerapps.TrafficWeight{
+			LatestRevision: utils.Bool(v.LatestRevision),
+			Weight:         utils.Int64(int64(v.Percentage)),
+		}
+		if v.Label != "" {
+			weight.Label = utils.String(v.Label)
+		}
+		if v.RevisionSuffix != "" && !v.LatestRevision {
+			weight.RevisionName = utils.String(fmt.Sprintf("%s--%s", appName, v.RevisionSuffix))
+		}
+		output = append(output, weight)
+	}
+
+	return &output
+}
+
+func flattenContainerAppTrafficWeights(input *[]containerapps.TrafficWeight, appName string) []ContainerAppTrafficWeight {
+	output := make([]ContainerAppTrafficWeight, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		weight := ContainerAppTrafficWeight{}
+		if v.Label != nil {
+			weight.Label = *v.Label
+		}
+		if v.LatestRevision != nil {
+			weight.LatestRevision = *v.LatestRevision
+		}
+		if v.RevisionName != nil && !weight.LatestRevision {
+			weight.RevisionSuffix = strings.TrimPrefix(*v.RevisionName, fmt.Sprintf("%s--", appName))
+		}
+		if v.Weight != nil {
+			weight.Percentage = int(*v.Weight)
+		}
+		output = append(output, weight)
+	}
+
+	return output
+}
+
+func expandContainerAppRegistries(input []ContainerAppRegistry) *[]containerapps.RegistryCredentials {
+	output := make([]containerapps.RegistryCredentials, 0)
+	for _, v := range input {
+		registry := containerapps.RegistryCredentials{
+			Server: utils.String(v.Server),
+		}
+		if v.Username != "" {
+			registry.Username = utils.String(v.Username)
+		}
+		if v.PasswordSecretName != "" {
+			registry.PasswordSecretRef = utils.String(v.PasswordSecretName)
+		}
+		if v.Identity != "" {
+			registry.Identity = utils.String(v.Identity)
+		}
+		output = append(output, registry)
+	}
+
+	return &output
+}
+
+func flattenContainerAppRegistries(input *[]containerapps.RegistryCredentials) []ContainerAppRegistry {
+	output := make([]ContainerAppRegistry, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		registry := ContainerAppRegistry{}
+		if v.Server != nil {
+			registry.Server = *v.Server
+		}
+		if v.Username != nil {
+			registry.Username = *v.Username
+		}
+		if v.PasswordSecretRef != nil {
+			registry.PasswordSecretName = *v.PasswordSecretRef
+		}
+		if v.Identity != nil {
+			registry.Identity = *v.Identity
+		}
+		output = append(output, registry)
+	}
+
+	return output
+}
+
+func expandContainerAppSecrets(input []ContainerAppSecret) *[]containerapps.Secret {
+	output := make([]containerapps.Secret, 0)
+	for _, v := range input {
+		output = append(output, containerapps.Secret{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerAppSecrets(input []containerapps.ContainerAppSecret) []ContainerAppSecret {
+	output := make([]ContainerAppSecret, 0)
+	for _, v := range input {
+		secret := ContainerAppSecret{}
+		if v.Name != nil {
+			secret.Name = *v.Name
+		}
+		if v.Value != nil {
+			secret.Value = *v.Value
+		}
+		output = append(output, secret)
+	}
+
+	return output
+}
+
+func expandContainerAppTemplate(input []ContainerAppTemplate) *containerapps.Template {
+	if len(input) == 0 {
+		return nil
+	}
+
+	template := input[0]
+	containers := make([]containerapps.Container, 0)
+	for _, v := range template.Container {
+		env := make([]containerapps.EnvironmentVar, 0)
+		for _, e := range v.Env {
+			envVar := containerapps.EnvironmentVar{
+				Name: utils.String(e.Name),
+			}
+			if e.SecretName != "" {
+				envVar.SecretRef = utils.String(e.SecretName)
+			} else {
+				envVar.Value = utils.String(e.Value)
+			}
+			env = append(env, envVar)
+		}
+
+		volumeMounts := make([]containerapps.VolumeMount, 0)
+		for _, m := range v.VolumeMounts {
+			volumeMounts = append(volumeMounts, containerapps.VolumeMount{
+				MountPath:  utils.String(m.Path),
+				VolumeName: utils.String(m.Name),
+			})
+		}
+
+		command := v.Command
+		args := v.Args
+		containers = append(containers, containerapps.Container{
+			Args:    &args,
+			Command: &command,
+			Env:     &env,
+			Image:   utils.String(v.Image),
+			Name:    utils.String(v.Name),
+			Resources: &containerapps.ContainerResources{
+				Cpu:    utils.Float(v.CPU),
+				Memory: utils.String(v.Memory),
+			},
+			VolumeMounts: &volumeMounts,
+		})
+	}
+
+	volumes := make([]containerapps.Volume, 0)
+	for _, v := range template.Volume {
+		volume := containerapps.Volume{
+			Name:        utils.String(v.Name),
+			StorageType: utils.String(v.StorageType),
+		}
+		if v.StorageName != "" {
+			volume.StorageName = utils.String(v.StorageName)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	output := containerapps.Template{
+		Containers: &containers,
+		Scale: &containerapps.Scale{
+			MaxReplicas: utils.Int64(int64(template.MaxReplicas)),
+			MinReplicas: utils.Int64(int64(template.MinReplicas)),
+		},
+		Volumes: &volumes,
+	}
+	if template.RevisionSuffix != "" {
+		output.RevisionSuffix = utils.String(template.RevisionSuffix)
+	}
+
+	return &output
+}
+
+func flattenContainerAppTemplate(input *containerapps.Template, appName string) []ContainerAppTemplate {
+	if input == nil {
+		return []ContainerAppTemplate{}
+	}
+
+	output := ContainerAppTemplate{
+		Container: make([]ContainerAppContainer, 0),
+		Volume:    make([]ContainerAppVolume, 0),
+	}
+
+	if input.Containers != nil {
+		for _, v := range *input.Containers {
+			container := ContainerAppContainer{
+				Args:         make([]string, 0),
+				Command:      make([]string, 0),
+				Env:          make([]ContainerAppEnvVar, 0),
+				VolumeMounts: make([]ContainerAppVolumeMounts, 0),
+			}
+			if v.Name != nil {
+				container.Name = *v.Name
+			}
+			if v.Image != nil {
+				container.Image = *v.Image
+			}
+			if v.Args != nil {
+				container.Args = *v.Args
+			}
+			if v.Command != nil {
+				container.Command = *v.Command
+			}
+			if resources := v.Resources; resources != nil {
+				if resources.Cpu != nil {
+					container.CPU = *resources.Cpu
+				}
+				if resources.Memory != nil {
+					container.Memory = *resources.Memory
+				}
+				if resources.EphemeralStorage != nil {
+					container.EphemeralStorage = *resources.EphemeralStorage
+				}
+			}
+			if v.Env != nil {
+				for _, e := range *v.Env {
+					envVar := ContainerAppEnvVar{}
+					if e.Name != nil {
+						envVar.Name = *e.Name
+					}
+					if e.Value != nil {
+						envVar.Value = *e.Value
+					}
+					if e.SecretRef != nil {
+						envVar.SecretName = *e.SecretRef
+					}
+					container.Env = append(container.Env, envVar)
+				}
+			}
+			if v.VolumeMounts != nil {
+				for _, m := range *v.VolumeMounts {
+					mount := ContainerAppVolumeMounts{}
+					if m.VolumeName != nil {
+						mount.Name = *m.VolumeName
+					}
+					if m.MountPath != nil {
+						mount.Path = *m.MountPath
+					}
+					container.VolumeMounts = append(container.VolumeMounts, mount)
+				}
+			}
+			output.Container = append(output.Container, container)
+		}
+	}
+
+	if input.Volumes != nil {
+		for _, v := range *input.Volumes {
+			volume := ContainerAppVolume{}
+			if v.Name != nil {
+				volume.Name = *v.Name
+			}
+			if v.StorageName != nil {
+				volume.StorageName = *v.StorageName
+			}
+			if v.StorageType != nil {
+				volume.StorageType = *v.StorageType
+			}
+			output.Volume = append(output.Volume, volume)
+		}
+	}
+
+	if scale := input.Scale; scale != nil {
+		if scale.MaxReplicas != nil {
+			output.MaxReplicas = int(*scale.MaxReplicas)
+		}
+		if scale.MinReplicas != nil {
+			output.MinReplicas = int(*scale.MinReplicas)
+		}
+	}
+
+	if input.RevisionSuffix != nil {
+		output.RevisionSuffix = strings.TrimPrefix(*input.RevisionSuffix, fmt.Sprintf("%s--", appName))
+	}
+
+	return []ContainerAppTemplate{output}
+}
@@ -0,0 +1,33 @@
+package containerapps
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Container Apps"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ContainerAppCustomDomainResource{},
+		ContainerAppEnvironmentCertificateResource{},
+		ContainerAppEnvironmentDaprComponentResource{},
+		ContainerAppEnvironmentResource{},
+		ContainerAppResource{},
+	}
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Container Apps",
+	}
+}
@@ -0,0 +1,192 @@
+package containerapps
+
+import "strings"
+
+type ActiveRevisionsMode string
+
+const (
+	ActiveRevisionsModeMultiple ActiveRevisionsMode = "Multiple"
+	ActiveRevisionsModeSingle   ActiveRevisionsMode = "Single"
+)
+
+func PossibleValuesForActiveRevisionsMode() []string {
+	return []string{
+		string(ActiveRevisionsModeMultiple),
+		string(ActiveRevisionsModeSingle),
+	}
+}
+
+func parseActiveRevisionsMode(input string) (*ActiveRevisionsMode, error) {
+	vals := map[string]ActiveRevisionsMode{
+		"multiple": ActiveRevisionsModeMultiple,
+		"single":   ActiveRevisionsModeSingle,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ActiveRevisionsMode(input)
+	return &out, nil
+}
+
+type AppProtocol string
+
+const (
+	AppProtocolGrpc AppProtocol = "grpc"
+	AppProtocolHttp AppProtocol = "http"
+)
+
+func PossibleValuesForAppProtocol() []string {
+	return []string{
+		string(AppProtocolGrpc),
+		string(AppProtocolHttp),
+	}
+}
+
+func parseAppProtocol(input string) (*AppProtocol, error) {
+	vals := map[string]AppProtocol{
+		"grpc": AppProtocolGrpc,
+		"http": AppProtocolHttp,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AppProtocol(input)
+	return &out, nil
+}
+
+type BindingType string
+
+const (
+	BindingTypeDisabled   BindingType = "Disabled"
+	BindingTypeSniEnabled BindingType = "SniEnabled"
+)
+
+func PossibleValuesForBindingType() []string {
+	return []string{
+		string(BindingTypeDisabled),
+		string(BindingTypeSniEnabled),
+	}
+}
+
+func parseBindingType(input string) (*BindingType, error) {
+	vals := map[string]BindingType{
+		"disabled":   BindingTypeDisabled,
+		"snienabled": BindingTypeSniEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BindingType(input)
+	return &out, nil
+}
+
+type ContainerAppProvisioningState string
+
+const (
+	ContainerAppProvisioningStateCanceled   ContainerAppProvisioningState = "Canceled"
+	ContainerAppProvisioningStateDeleting   ContainerAppProvisioningState = "Deleting"
+	ContainerAppProvisioningStateFailed     ContainerAppProvisioningState = "Failed"
+	ContainerAppProvisioningStateInProgress ContainerAppProvisioningState = "InProgress"
+	ContainerAppProvisioningStateSucceeded  ContainerAppProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForContainerAppProvisioningState() []string {
+	return []string{
+		string(ContainerAppProvisioningStateCanceled),
+		string(ContainerAppProvisioningStateDeleting),
+		string(ContainerAppProvisioningStateFailed),
+		string(ContainerAppProvisioningStateInProgress),
+		string(ContainerAppProvisioningStateSucceeded),
+	}
+}
+
+func parseContainerAppProvisioningState(input string) (*ContainerAppProvisioningState, error) {
+	vals := map[string]ContainerAppProvisioningState{
+		"canceled":   ContainerAppProvisioningStateCanceled,
+		"deleting":   ContainerAppProvisioningStateDeleting,
+		"failed":     ContainerAppProvisioningStateFailed,
+		"inprogress": ContainerAppProvisioningStateInProgress,
+		"succeeded":  ContainerAppProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ContainerAppProvisioningState(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type IngressTransportMethod string
+
+const (
+	IngressTransportMethodAuto  IngressTransportMethod = "auto"
+	IngressTransportMethodHttp  IngressTransportMethod = "http"
+	IngressTransportMethodHttp2 IngressTransportMethod = "http2"
+	IngressTransportMethodTcp   IngressTransportMethod = "tcp"
+)
+
+func PossibleValuesForIngressTransportMethod() []string {
+	return []string{
+		string(IngressTransportMethodAuto),
+		string(IngressTransportMethodHttp),
+		string(IngressTransportMethodHttp2),
+		string(IngressTransportMethodTcp),
+	}
+}
+
+func parseIngressTransportMethod(input string) (*IngressTransportMethod, error) {
+	vals := map[string]IngressTransportMethod{
+		"auto":  IngressTransportMethodAuto,
+		"http":  IngressTransportMethodHttp,
+		"http2": IngressTransportMethodHttp2,
+		"tcp":   IngressTransportMethodTcp,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IngressTransportMethod(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package containerapps
+
+type Container struct {
+	Args         *[]string           `json:"args,omitempty"`
+	Command      *[]string           `json:"command,omitempty"`
+	Env          *[]EnvironmentVar   `json:"env,omitempty"`
+	Image        *string             `json:"image,omitempty"`
+	Name         *string             `json:"name,omitempty"`
+	Resources    *ContainerResources `json:"resources,omitempty"`
+	VolumeMounts *[]VolumeMount      `json:"volumeMounts,omitempty"`
+}
@@ -0,0 +1,7 @@
+package containerapps
+
+type ContainerResources struct {
+	Cpu              *float64 `json:"cpu,omitempty"`
+	EphemeralStorage *string  `json:"ephemeralStorage,omitempty"`
+	Memory           *string  `json:"memory,omitempty"`
+}
@@ -0,0 +1,7 @@
+package containerapps
+
+type CustomDomain struct {
+	BindingType   *BindingType `json:"bindingType,omitempty"`
+	CertificateId *string      `json:"certificateId,omitempty"`
+	Name          string       `json:"name"`
+}
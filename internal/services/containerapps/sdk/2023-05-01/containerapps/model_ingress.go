@@ -0,0 +1,12 @@
+package containerapps
+
+type Ingress struct {
+	AllowInsecure *bool                   `json:"allowInsecure,omitempty"`
+	CustomDomains *[]CustomDomain         `json:"customDomains,omitempty"`
+	ExposedPort   *int64                  `json:"exposedPort,omitempty"`
+	External      *bool                   `json:"external,omitempty"`
+	Fqdn          *string                 `json:"fqdn,omitempty"`
+	TargetPort    *int64                  `json:"targetPort,omitempty"`
+	Traffic       *[]TrafficWeight        `json:"traffic,omitempty"`
+	Transport     *IngressTransportMethod `json:"transport,omitempty"`
+}
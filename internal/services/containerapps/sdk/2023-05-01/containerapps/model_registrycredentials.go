@@ -0,0 +1,8 @@
+package containerapps
+
+type RegistryCredentials struct {
+	Identity          *string `json:"identity,omitempty"`
+	PasswordSecretRef *string `json:"passwordSecretRef,omitempty"`
+	Server            *string `json:"server,omitempty"`
+	Username          *string `json:"username,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerapps
+
+type Scale struct {
+	MaxReplicas *int64 `json:"maxReplicas,omitempty"`
+	MinReplicas *int64 `json:"minReplicas,omitempty"`
+}
@@ -0,0 +1,7 @@
+package containerapps
+
+type Volume struct {
+	Name        *string `json:"name,omitempty"`
+	StorageName *string `json:"storageName,omitempty"`
+	StorageType *string `json:"storageType,omitempty"`
+}
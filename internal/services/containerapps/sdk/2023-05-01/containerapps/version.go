@@ -0,0 +1,9 @@
+package containerapps
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/containerapps/%s", defaultApiVersion)
+}
@@ -0,0 +1,9 @@
+package daprcomponents
+
+type DaprComponent struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *DaprComponentProperties `json:"properties,omitempty"`
+	SystemData *SystemData              `json:"systemData,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,12 @@
+package daprcomponents
+
+type DaprComponentProperties struct {
+	ComponentType        *string         `json:"componentType,omitempty"`
+	IgnoreErrors         *bool           `json:"ignoreErrors,omitempty"`
+	InitTimeout          *string         `json:"initTimeout,omitempty"`
+	Metadata             *[]DaprMetadata `json:"metadata,omitempty"`
+	Scopes               *[]string       `json:"scopes,omitempty"`
+	SecretStoreComponent *string         `json:"secretStoreComponent,omitempty"`
+	Secrets              *[]Secret       `json:"secrets,omitempty"`
+	Version              *string         `json:"version,omitempty"`
+}
@@ -0,0 +1,5 @@
+package daprcomponents
+
+type DaprSecretsCollection struct {
+	Value []DaprSecret `json:"value"`
+}
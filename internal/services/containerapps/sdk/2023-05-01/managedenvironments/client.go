@@ -0,0 +1,15 @@
+package managedenvironments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ManagedEnvironmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedEnvironmentsClientWithBaseURI(endpoint string) ManagedEnvironmentsClient {
+	return ManagedEnvironmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,89 @@
+package managedenvironments
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type EnvironmentProvisioningState string
+
+const (
+	EnvironmentProvisioningStateCanceled                      EnvironmentProvisioningState = "Canceled"
+	EnvironmentProvisioningStateFailed                        EnvironmentProvisioningState = "Failed"
+	EnvironmentProvisioningStateInfrastructureSetupComplete   EnvironmentProvisioningState = "InfrastructureSetupComplete"
+	EnvironmentProvisioningStateInfrastructureSetupInProgress EnvironmentProvisioningState = "InfrastructureSetupInProgress"
+	EnvironmentProvisioningStateInitializationInProgress      EnvironmentProvisioningState = "InitializationInProgress"
+	EnvironmentProvisioningStateScheduledForDelete            EnvironmentProvisioningState = "ScheduledForDelete"
+	EnvironmentProvisioningStateSucceeded                     EnvironmentProvisioningState = "Succeeded"
+	EnvironmentProvisioningStateUpgradeFailed                 EnvironmentProvisioningState = "UpgradeFailed"
+	EnvironmentProvisioningStateUpgradeRequested              EnvironmentProvisioningState = "UpgradeRequested"
+	EnvironmentProvisioningStateWaiting                       EnvironmentProvisioningState = "Waiting"
+)
+
+func PossibleValuesForEnvironmentProvisioningState() []string {
+	return []string{
+		string(EnvironmentProvisioningStateCanceled),
+		string(EnvironmentProvisioningStateFailed),
+		string(EnvironmentProvisioningStateInfrastructureSetupComplete),
+		string(EnvironmentProvisioningStateInfrastructureSetupInProgress),
+		string(EnvironmentProvisioningStateInitializationInProgress),
+		string(EnvironmentProvisioningStateScheduledForDelete),
+		string(EnvironmentProvisioningStateSucceeded),
+		string(EnvironmentProvisioningStateUpgradeFailed),
+		string(EnvironmentProvisioningStateUpgradeRequested),
+		string(EnvironmentProvisioningStateWaiting),
+	}
+}
+
+func parseEnvironmentProvisioningState(input string) (*EnvironmentProvisioningState, error) {
+	vals := map[string]EnvironmentProvisioningState{
+		"canceled":                      EnvironmentProvisioningStateCanceled,
+		"failed":                        EnvironmentProvisioningStateFailed,
+		"infrastructuresetupcomplete":   EnvironmentProvisioningStateInfrastructureSetupComplete,
+		"infrastructuresetupinprogress": EnvironmentProvisioningStateInfrastructureSetupInProgress,
+		"initializationinprogress":      EnvironmentProvisioningStateInitializationInProgress,
+		"scheduledfordelete":            EnvironmentProvisioningStateScheduledForDelete,
+		"succeeded":                     EnvironmentProvisioningStateSucceeded,
+		"upgradefailed":                 EnvironmentProvisioningStateUpgradeFailed,
+		"upgraderequested":              EnvironmentProvisioningStateUpgradeRequested,
+		"waiting":                       EnvironmentProvisioningStateWaiting,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EnvironmentProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package managedenvironments
+
+type AppLogsConfiguration struct {
+	Destination               *string                    `json:"destination,omitempty"`
+	LogAnalyticsConfiguration *LogAnalyticsConfiguration `json:"logAnalyticsConfiguration,omitempty"`
+}
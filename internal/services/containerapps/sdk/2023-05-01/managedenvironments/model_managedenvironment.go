@@ -0,0 +1,12 @@
+package managedenvironments
+
+type ManagedEnvironment struct {
+	Id         *string                       `json:"id,omitempty"`
+	Kind       *string                       `json:"kind,omitempty"`
+	Location   string                        `json:"location"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *ManagedEnvironmentProperties `json:"properties,omitempty"`
+	SystemData *SystemData                   `json:"systemData,omitempty"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
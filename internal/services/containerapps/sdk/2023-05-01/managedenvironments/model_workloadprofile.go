@@ -0,0 +1,8 @@
+package managedenvironments
+
+type WorkloadProfile struct {
+	MaximumCount        *int64 `json:"maximumCount,omitempty"`
+	MinimumCount        *int64 `json:"minimumCount,omitempty"`
+	Name                string `json:"name"`
+	WorkloadProfileType string `json:"workloadProfileType"`
+}
@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ContainerAppName validates the name of a Container App, which must be a valid DNS label since it's used within the FQDN of the App
+func ContainerAppName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(2, 32),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-z]([a-z0-9]|-[a-z0-9])*$`),
+			"The name must begin with a lowercase letter, end with a lowercase letter or number, may contain only lowercase letters, numbers and hyphens, and cannot contain consecutive hyphens.",
+		),
+	)
+}
+
+func ManagedEnvironmentName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(2, 60),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`),
+			"The name must begin with a lowercase letter, end with a lowercase letter or number, and may contain only lowercase letters, numbers and hyphens.",
+		),
+	)
+}
+
+func DaprComponentName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, 60),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`),
+			"The name must begin and end with a lowercase letter or number, and may contain only lowercase letters, numbers, periods and hyphens.",
+		),
+	)
+}
+
+func CertificateName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, 260),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-zA-Z0-9]([-.a-zA-Z0-9]*[a-zA-Z0-9])?$`),
+			"The name must begin and end with a letter or number, and may contain only letters, numbers, periods and hyphens.",
+		),
+	)
+}
+
+// SecretName validates the name of a Secret within a Container App or Dapr Component
+func SecretName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, 253),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`),
+			"The name must begin and end with a lowercase letter or number, and may contain only lowercase letters, numbers, periods and hyphens.",
+		),
+	)
+}
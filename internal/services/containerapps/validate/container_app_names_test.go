@@ -0,0 +1,182 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContainerAppName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "a",
+			Expected: false,
+		},
+		{
+			Name:     "app-1",
+			Expected: true,
+		},
+		{
+			Name:     "1app",
+			Expected: false,
+		},
+		{
+			Name:     "App1",
+			Expected: false,
+		},
+		{
+			Name:     "app--1",
+			Expected: false,
+		},
+		{
+			Name:     "app1-",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 32),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 33),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := ContainerAppName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestManagedEnvironmentName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "env-1",
+			Expected: true,
+		},
+		{
+			Name:     "-env",
+			Expected: false,
+		},
+		{
+			Name:     "Env1",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 60),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 61),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := ManagedEnvironmentName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestDaprComponentName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "statestore",
+			Expected: true,
+		},
+		{
+			Name:     "state.store-1",
+			Expected: true,
+		},
+		{
+			Name:     "StateStore",
+			Expected: false,
+		},
+		{
+			Name:     "statestore-",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := DaprComponentName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestCertificateName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "Cert-1.example",
+			Expected: true,
+		},
+		{
+			Name:     "cert_1",
+			Expected: false,
+		},
+		{
+			Name:     ".cert",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := CertificateName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
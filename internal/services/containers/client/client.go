@@ -8,20 +8,35 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-01-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/cacherules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/credentialsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-09-01/trustedaccess"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-09-02-preview/managedclusters"
 )
 
 type Client struct {
 	AgentPoolsClient                *containerservice.AgentPoolsClient
+	CacheRulesClient                *cacherules.CacheRulesClient
+	ClusterExtensionsClient         *extensions.ExtensionsClient
+	CredentialSetsClient            *credentialsets.CredentialSetsClient
+	FluxConfigurationsClient        *fluxconfiguration.FluxConfigurationClient
 	GroupsClient                    *containerinstance.ContainerGroupsClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
+	KubernetesClustersPreviewClient *managedclusters.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
 	RegistriesClient                *containerregistry.RegistriesClient
+	RegistriesPreviewClient         *registries.RegistriesClient
 	ReplicationsClient              *containerregistry.ReplicationsClient
 	ServicesClient                  *legacy.ContainerServicesClient
+	SnapshotsClient                 *containerservice.SnapshotsClient
 	WebhooksClient                  *containerregistry.WebhooksClient
 	TokensClient                    *containerregistry.TokensClient
 	ScopeMapsClient                 *containerregistry.ScopeMapsClient
 	TasksClient                     *legacyacr.TasksClient
+	TrustedAccessClient             *trustedaccess.TrustedAccessClient
 
 	Environment azure.Environment
 }
@@ -45,6 +60,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	tasksClient := legacyacr.NewTasksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&tasksClient.Client, o.ResourceManagerAuthorizer)
 
+	registriesPreviewClient := registries.NewRegistriesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&registriesPreviewClient.Client, o.ResourceManagerAuthorizer)
+
+	cacheRulesClient := cacherules.NewCacheRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cacheRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	credentialSetsClient := credentialsets.NewCredentialSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&credentialSetsClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -52,27 +76,51 @@ func NewClient(o *common.ClientOptions) *Client {
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&kubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
 
+	kubernetesClustersPreviewClient := managedclusters.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kubernetesClustersPreviewClient.Client, o.ResourceManagerAuthorizer)
+
 	agentPoolsClient := containerservice.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&agentPoolsClient.Client, o.ResourceManagerAuthorizer)
 
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	snapshotsClient := containerservice.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
+	trustedAccessClient := trustedaccess.NewTrustedAccessClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&trustedAccessClient.Client, o.ResourceManagerAuthorizer)
+
+	// KubernetesConfiguration
+	clusterExtensionsClient := extensions.NewExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&clusterExtensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	fluxConfigurationsClient := fluxconfiguration.NewFluxConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fluxConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AgentPoolsClient:                &agentPoolsClient,
+		CacheRulesClient:                &cacheRulesClient,
+		ClusterExtensionsClient:         &clusterExtensionsClient,
+		CredentialSetsClient:            &credentialSetsClient,
+		FluxConfigurationsClient:        &fluxConfigurationsClient,
 		KubernetesClustersClient:        &kubernetesClustersClient,
+		KubernetesClustersPreviewClient: &kubernetesClustersPreviewClient,
 		GroupsClient:                    &groupsClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
 		RegistriesClient:                &registriesClient,
+		RegistriesPreviewClient:         &registriesPreviewClient,
 		WebhooksClient:                  &webhooksClient,
 		ReplicationsClient:              &replicationsClient,
 		ServicesClient:                  &servicesClient,
+		SnapshotsClient:                 &snapshotsClient,
 		Environment:                     o.Environment,
 		TokensClient:                    &tokensClient,
 		ScopeMapsClient:                 &scopeMapsClient,
 		TasksClient:                     &tasksClient,
+		TrustedAccessClient:             &trustedAccessClient,
 	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-01-01-preview/registries"
 	validate2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	identityParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
@@ -299,9 +300,34 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"days": {
-							Type:     pluginsdk.TypeInt,
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      7,
+							ValidateFunc: validation.IntBetween(0, 365),
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
 							Optional: true,
-							Default:  7,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"soft_delete_policy": {
+				Type:       pluginsdk.TypeList,
+				MaxItems:   1,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"days": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      7,
+							ValidateFunc: validation.IntBetween(1, 90),
 						},
 
 						"enabled": {
@@ -380,6 +406,14 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				return fmt.Errorf("ACR retention policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set retention_policy {}")
 			}
 
+			softDeletePolicyEnabled, ok := d.GetOk("soft_delete_policy.0.enabled")
+			if ok && softDeletePolicyEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+				return fmt.Errorf("ACR soft delete policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set soft_delete_policy {}")
+			}
+			if ok && softDeletePolicyEnabled.(bool) && d.Get("retention_policy.0.enabled").(bool) {
+				return fmt.Errorf("ACR soft delete policy and retention policy cannot be enabled at the same time")
+			}
+
 			trustPolicyEnabled, ok := d.GetOk("trust_policy.0.enabled")
 			if ok && trustPolicyEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
 				return fmt.Errorf("ACR trust policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set trust_policy {}")
@@ -539,6 +573,13 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	// the soft delete policy isn't available in the version of the API used above, so it's applied separately
+	if softDeletePolicyRaw := d.Get("soft_delete_policy").([]interface{}); len(softDeletePolicyRaw) > 0 {
+		if err := applyContainerRegistrySoftDeletePolicy(d, meta, resourceGroup, name, softDeletePolicyRaw); err != nil {
+			return fmt.Errorf("applying soft delete policy for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -654,6 +695,12 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("waiting for update of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if d.HasChange("soft_delete_policy") {
+		if err := applyContainerRegistrySoftDeletePolicy(d, meta, resourceGroup, name, d.Get("soft_delete_policy").([]interface{})); err != nil {
+			return fmt.Errorf("applying soft delete policy for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	// downgrade to Basic or Standard SKU
 	if skuChange && (isBasicSku || isStandardSku) {
 		if err := applyContainerRegistrySku(d, meta, sku, resourceGroup, name); err != nil {
@@ -675,6 +722,27 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 	return resourceContainerRegistryRead(d, meta)
 }
 
+func applyContainerRegistrySoftDeletePolicy(d *pluginsdk.ResourceData, meta interface{}, resourceGroup string, name string, input []interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistriesPreviewClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := registries.NewRegistryID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, name)
+	parameters := registries.RegistryUpdateParameters{
+		Properties: &registries.RegistryPropertiesUpdateParameters{
+			Policies: &registries.Policies{
+				SoftDeletePolicy: expandSoftDeletePolicy(input),
+			},
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func applyContainerRegistrySku(d *pluginsdk.ResourceData, meta interface{}, sku string, resourceGroup string, name string) error {
 	client := meta.(*clients.Client).Containers.RegistriesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
@@ -743,6 +811,7 @@ func applyGeoReplicationLocations(d *pluginsdk.ResourceData, meta interface{}, r
 func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.RegistriesClient
 	replicationClient := meta.(*clients.Client).Containers.ReplicationsClient
+	softDeleteClient := meta.(*clients.Client).Containers.RegistriesPreviewClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -806,6 +875,18 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("sku", string(sku.Tier))
 	}
 
+	softDeleteResp, err := softDeleteClient.Get(ctx, registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving soft delete policy for Azure Container Registry %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	var softDeletePolicy *registries.SoftDeletePolicy
+	if model := softDeleteResp.Model; model != nil && model.Properties != nil && model.Properties.Policies != nil {
+		softDeletePolicy = model.Properties.Policies.SoftDeletePolicy
+	}
+	if err := d.Set("soft_delete_policy", flattenSoftDeletePolicy(softDeletePolicy)); err != nil {
+		return fmt.Errorf("setting `soft_delete_policy`: %+v", err)
+	}
+
 	if *resp.AdminUserEnabled {
 		credsResp, errList := client.ListCredentials(ctx, id.ResourceGroup, id.Name)
 		if errList != nil {
@@ -942,6 +1023,36 @@ func expandRetentionPolicy(p []interface{}) *containerregistry.RetentionPolicy {
 	return &retentionPolicy
 }
 
+func expandSoftDeletePolicy(p []interface{}) *registries.SoftDeletePolicy {
+	status := registries.PolicyStatusDisabled
+	softDeletePolicy := registries.SoftDeletePolicy{
+		Status: &status,
+	}
+
+	if len(p) > 0 {
+		v := p[0].(map[string]interface{})
+		if v["enabled"].(bool) {
+			status = registries.PolicyStatusEnabled
+		}
+		softDeletePolicy.RetentionDays = utils.Int64(int64(v["days"].(int)))
+	}
+
+	return &softDeletePolicy
+}
+
+func flattenSoftDeletePolicy(p *registries.SoftDeletePolicy) []interface{} {
+	if p == nil {
+		return nil
+	}
+
+	softDeletePolicy := make(map[string]interface{})
+	softDeletePolicy["enabled"] = p.Status != nil && *p.Status == registries.PolicyStatusEnabled
+	if p.RetentionDays != nil {
+		softDeletePolicy["days"] = int(*p.RetentionDays)
+	}
+	return []interface{}{softDeletePolicy}
+}
+
 func expandTrustPolicy(p []interface{}) *containerregistry.TrustPolicy {
 	trustPolicy := containerregistry.TrustPolicy{
 		Status: containerregistry.PolicyStatusDisabled,
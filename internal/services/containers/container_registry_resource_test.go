@@ -447,6 +447,39 @@ func TestAccContainerRegistry_networkAccessProfileVnet(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistry_softDeletePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
+	r := ContainerRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.softDeletePolicy(data, true, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.days").HasValue("10"),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.softDeletePolicy(data, true, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.days").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.softDeletePolicy(data, false, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerRegistry_policies(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
 	r := ContainerRegistryResource{}
@@ -1126,6 +1159,31 @@ resource "azurerm_container_registry" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, days)
 }
 
+func (ContainerRegistryResource) softDeletePolicy(data acceptance.TestData, enabled bool, days int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "acctestACR%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+
+  soft_delete_policy {
+    days    = %d
+    enabled = %t
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, days, enabled)
+}
+
 func (ContainerRegistryResource) policies_downgradeUpdate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -950,7 +950,7 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("timeout_in_seconds") {
 				existing.TaskProperties.Timeout = utils.Int32(int32(model.TimeoutInSec))
 			}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = tags.FromTypedObject(model.Tags)
 			}
 
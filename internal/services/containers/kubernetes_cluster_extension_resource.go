@@ -0,0 +1,493 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesClusterExtensionResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterExtensionResource{}
+
+type KubernetesClusterExtensionModel struct {
+	Name                           string                               `tfschema:"name"`
+	ClusterId                      string                               `tfschema:"cluster_id"`
+	ExtensionType                  string                               `tfschema:"extension_type"`
+	ConfigurationProtectedSettings map[string]string                    `tfschema:"configuration_protected_settings"`
+	ConfigurationSettings          map[string]string                    `tfschema:"configuration_settings"`
+	Plan                           []KubernetesClusterExtensionPlan     `tfschema:"plan"`
+	ReleaseTrain                   string                               `tfschema:"release_train"`
+	ReleaseNamespace               string                               `tfschema:"release_namespace"`
+	TargetNamespace                string                               `tfschema:"target_namespace"`
+	Version                        string                               `tfschema:"version"`
+	AksAssignedIdentity            []KubernetesClusterExtensionIdentity `tfschema:"aks_assigned_identity"`
+	CurrentVersion                 string                               `tfschema:"current_version"`
+}
+
+type KubernetesClusterExtensionPlan struct {
+	Name          string `tfschema:"name"`
+	Product       string `tfschema:"product"`
+	PromotionCode string `tfschema:"promotion_code"`
+	Publisher     string `tfschema:"publisher"`
+	Version       string `tfschema:"version"`
+}
+
+type KubernetesClusterExtensionIdentity struct {
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+	Type        string `tfschema:"type"`
+}
+
+func (r KubernetesClusterExtensionResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_extension"
+}
+
+func (r KubernetesClusterExtensionResource) ModelObject() interface{} {
+	return &KubernetesClusterExtensionModel{}
+}
+
+func (r KubernetesClusterExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return extensions.ValidateScopedExtensionID
+}
+
+func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesClusterExtensionName,
+		},
+
+		"cluster_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				validate.ClusterID,
+				validate.ConnectedClusterID,
+			),
+		},
+
+		"extension_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"configuration_protected_settings": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"configuration_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"plan": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"product": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"promotion_code": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"release_train": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"release_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"target_namespace"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+
+		"target_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"release_namespace"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"aks_assigned_identity": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"current_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ClusterExtensionsClient
+
+			var config KubernetesClusterExtensionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := extensions.NewScopedExtensionID(config.ClusterId, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			autoUpgradeMinorVersion := config.Version == ""
+			payload := extensions.Extension{
+				Plan: expandKubernetesClusterExtensionPlan(config.Plan),
+				Properties: &extensions.ExtensionProperties{
+					AutoUpgradeMinorVersion:        &autoUpgradeMinorVersion,
+					ConfigurationProtectedSettings: &config.ConfigurationProtectedSettings,
+					ConfigurationSettings:          &config.ConfigurationSettings,
+					ExtensionType:                  &config.ExtensionType,
+					Scope:                          expandKubernetesClusterExtensionScope(config.ReleaseNamespace, config.TargetNamespace),
+				},
+			}
+
+			if config.ReleaseTrain != "" {
+				payload.Properties.ReleaseTrain = &config.ReleaseTrain
+			}
+
+			if config.Version != "" {
+				payload.Properties.Version = &config.Version
+			}
+
+			// Extensions on Arc-connected clusters are assigned a System Assigned Identity, which is
+			// used to authenticate the extension agents running within the cluster with Azure
+			if _, err := parse.ConnectedClusterID(config.ClusterId); err == nil {
+				payload.Identity = &identity.SystemAssigned{
+					Type: identity.TypeSystemAssigned,
+				}
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ClusterExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the protected settings aren't returned by the API, so we retain them from the config
+			var config KubernetesClusterExtensionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := KubernetesClusterExtensionModel{
+				Name:                           id.ExtensionName,
+				ClusterId:                      id.Scope,
+				ConfigurationProtectedSettings: config.ConfigurationProtectedSettings,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Plan = flattenKubernetesClusterExtensionPlan(model.Plan)
+
+				if props := model.Properties; props != nil {
+					state.AksAssignedIdentity = flattenKubernetesClusterExtensionAksAssignedIdentity(props.AksAssignedIdentity)
+
+					if props.ConfigurationSettings != nil {
+						state.ConfigurationSettings = *props.ConfigurationSettings
+					}
+					if props.CurrentVersion != nil {
+						state.CurrentVersion = *props.CurrentVersion
+					}
+					if props.ExtensionType != nil {
+						state.ExtensionType = *props.ExtensionType
+					}
+					if props.ReleaseTrain != nil {
+						state.ReleaseTrain = *props.ReleaseTrain
+					}
+					if props.Version != nil {
+						state.Version = *props.Version
+					}
+
+					if scope := props.Scope; scope != nil {
+						if scope.Cluster != nil && scope.Cluster.ReleaseNamespace != nil {
+							state.ReleaseNamespace = *scope.Cluster.ReleaseNamespace
+						}
+						if scope.Namespace != nil && scope.Namespace.TargetNamespace != nil {
+							state.TargetNamespace = *scope.Namespace.TargetNamespace
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ClusterExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesClusterExtensionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := extensions.PatchExtension{
+				Properties: &extensions.PatchExtensionProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("configuration_protected_settings") {
+				payload.Properties.ConfigurationProtectedSettings = &config.ConfigurationProtectedSettings
+			}
+
+			if metadata.ResourceData.HasChange("configuration_settings") {
+				payload.Properties.ConfigurationSettings = &config.ConfigurationSettings
+			}
+
+			if metadata.ResourceData.HasChange("release_train") {
+				payload.Properties.ReleaseTrain = &config.ReleaseTrain
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				autoUpgradeMinorVersion := config.Version == ""
+				payload.Properties.AutoUpgradeMinorVersion = &autoUpgradeMinorVersion
+				if config.Version != "" {
+					payload.Properties.Version = &config.Version
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ClusterExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesClusterExtensionPlan(input []KubernetesClusterExtensionPlan) *extensions.Plan {
+	if len(input) == 0 {
+		return nil
+	}
+
+	plan := input[0]
+	output := extensions.Plan{
+		Name:      plan.Name,
+		Product:   plan.Product,
+		Publisher: plan.Publisher,
+	}
+	if plan.PromotionCode != "" {
+		output.PromotionCode = &plan.PromotionCode
+	}
+	if plan.Version != "" {
+		output.Version = &plan.Version
+	}
+
+	return &output
+}
+
+func flattenKubernetesClusterExtensionPlan(input *extensions.Plan) []KubernetesClusterExtensionPlan {
+	if input == nil {
+		return []KubernetesClusterExtensionPlan{}
+	}
+
+	output := KubernetesClusterExtensionPlan{
+		Name:      input.Name,
+		Product:   input.Product,
+		Publisher: input.Publisher,
+	}
+	if input.PromotionCode != nil {
+		output.PromotionCode = *input.PromotionCode
+	}
+	if input.Version != nil {
+		output.Version = *input.Version
+	}
+
+	return []KubernetesClusterExtensionPlan{output}
+}
+
+func expandKubernetesClusterExtensionScope(releaseNamespace string, targetNamespace string) *extensions.Scope {
+	if releaseNamespace == "" && targetNamespace == "" {
+		return nil
+	}
+
+	if targetNamespace != "" {
+		return &extensions.Scope{
+			Namespace: &extensions.ScopeNamespace{
+				TargetNamespace: &targetNamespace,
+			},
+		}
+	}
+
+	return &extensions.Scope{
+		Cluster: &extensions.ScopeCluster{
+			ReleaseNamespace: &releaseNamespace,
+		},
+	}
+}
+
+func flattenKubernetesClusterExtensionAksAssignedIdentity(input *extensions.ExtensionPropertiesAksAssignedIdentity) []KubernetesClusterExtensionIdentity {
+	if input == nil {
+		return []KubernetesClusterExtensionIdentity{}
+	}
+
+	output := KubernetesClusterExtensionIdentity{}
+	if input.PrincipalId != nil {
+		output.PrincipalId = *input.PrincipalId
+	}
+	if input.TenantId != nil {
+		output.TenantId = *input.TenantId
+	}
+	if input.Type != nil {
+		output.Type = *input.Type
+	}
+
+	return []KubernetesClusterExtensionIdentity{output}
+}
@@ -114,9 +114,9 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				}, false),
 			},
 
-			"kubelet_config": schemaNodePoolKubeletConfig(),
+			"kubelet_config": schemaNodePoolKubeletConfig(true),
 
-			"linux_os_config": schemaNodePoolLinuxOSConfig(),
+			"linux_os_config": schemaNodePoolLinuxOSConfig(true),
 
 			"fips_enabled": {
 				Type:     pluginsdk.TypeBool,
@@ -264,6 +264,12 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.ProximityPlacementGroupID,
 			},
 
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: containerValidate.NodePoolSnapshotID,
+			},
+
 			"spot_max_price": {
 				Type:         pluginsdk.TypeFloat,
 				Optional:     true,
@@ -470,6 +476,12 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.VnetSubnetID = utils.String(vnetSubnetID)
 	}
 
+	if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	maxCount := d.Get("max_count").(int)
 	minCount := d.Get("min_count").(int)
 
@@ -628,7 +640,17 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("snapshot_id") {
+		// upgrading the Node Pool using a Snapshot updates the Node Image to the one captured in the Snapshot
+		props.CreationData = nil
+		if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+			props.CreationData = &containerservice.CreationData{
+				SourceResourceID: utils.String(snapshotId),
+			}
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		props.Tags = tags.Expand(t)
 	}
@@ -828,6 +850,12 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 
 		d.Set("proximity_placement_group_id", props.ProximityPlacementGroupID)
 
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+			snapshotId = *props.CreationData.SourceResourceID
+		}
+		d.Set("snapshot_id", snapshotId)
+
 		spotMaxPrice := -1.0
 		if props.SpotMaxPrice != nil {
 			spotMaxPrice = *props.SpotMaxPrice
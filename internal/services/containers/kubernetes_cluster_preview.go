@@ -0,0 +1,198 @@
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-09-02-preview/managedclusters"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// convertKubernetesClusterToPreviewModel converts the Managed Cluster built using the 2021-08-01 models into the
+// 2023-09-02-preview models, allowing properties which are only available in the newer API to be set at creation
+// time. The payloads are compatible other than the values for the SKU which have been renamed in the newer API and
+// the disabled Add-Ons (which can be omitted when creating the cluster) since some of these have been removed.
+func convertKubernetesClusterToPreviewModel(input containerservice.ManagedCluster) (*managedclusters.ManagedCluster, error) {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling the 2021-08-01 model: %+v", err)
+	}
+
+	var output managedclusters.ManagedCluster
+	if err := json.Unmarshal(payload, &output); err != nil {
+		return nil, fmt.Errorf("unmarshaling into the 2023-09-02-preview model: %+v", err)
+	}
+
+	if output.Sku != nil {
+		if output.Sku.Name != nil && strings.EqualFold(string(*output.Sku.Name), string(containerservice.ManagedClusterSKUNameBasic)) {
+			output.Sku.Name = skuNamePtr(managedclusters.ManagedClusterSKUNameBase)
+		}
+		if output.Sku.Tier != nil && strings.EqualFold(string(*output.Sku.Tier), string(containerservice.ManagedClusterSKUTierPaid)) {
+			output.Sku.Tier = skuTierPtr(managedclusters.ManagedClusterSKUTierStandard)
+		}
+	}
+
+	if output.Properties == nil {
+		output.Properties = &managedclusters.ManagedClusterProperties{}
+	}
+
+	if output.Properties.AddonProfiles != nil {
+		addonProfiles := make(map[string]managedclusters.ManagedClusterAddonProfile)
+		for k, v := range *output.Properties.AddonProfiles {
+			if v.Enabled {
+				addonProfiles[k] = v
+			}
+		}
+		output.Properties.AddonProfiles = &addonProfiles
+	}
+
+	return &output, nil
+}
+
+// kubernetesClusterRequiresPreviewApi returns whether any of the properties which are only available in the
+// 2023-09-02-preview API have been configured, in which case the cluster must be created using that API
+func kubernetesClusterRequiresPreviewApi(d *pluginsdk.ResourceData) bool {
+	if len(d.Get("key_management_service").([]interface{})) > 0 {
+		return true
+	}
+
+	if d.Get("node_provisioning_profile.0.mode").(string) == string(managedclusters.NodeProvisioningModeAuto) {
+		return true
+	}
+
+	return d.Get("network_profile.0.network_plugin_mode").(string) != "" || d.Get("network_profile.0.ebpf_data_plane").(string) != ""
+}
+
+// expandKubernetesClusterPreviewProperties sets the properties which are only available in the 2023-09-02-preview
+// API on the converted Managed Cluster when creating the cluster
+func expandKubernetesClusterPreviewProperties(ctx context.Context, client *clients.Client, d *pluginsdk.ResourceData, props *managedclusters.ManagedClusterProperties) error {
+	if keyManagementServiceRaw := d.Get("key_management_service").([]interface{}); len(keyManagementServiceRaw) > 0 {
+		azureKeyVaultKms, err := expandKubernetesClusterAzureKeyVaultKms(ctx, client, keyManagementServiceRaw)
+		if err != nil {
+			return fmt.Errorf("expanding `key_management_service`: %+v", err)
+		}
+		props.SecurityProfile = &managedclusters.ManagedClusterSecurityProfile{
+			AzureKeyVaultKms: azureKeyVaultKms,
+		}
+	}
+
+	props.NodeProvisioningProfile = expandKubernetesClusterNodeProvisioningProfile(d.Get("node_provisioning_profile").([]interface{}))
+
+	if props.NetworkProfile != nil {
+		if v := d.Get("network_profile.0.network_plugin_mode").(string); v != "" {
+			networkPluginMode := managedclusters.NetworkPluginMode(v)
+			props.NetworkProfile.NetworkPluginMode = &networkPluginMode
+		}
+		if v := d.Get("network_profile.0.ebpf_data_plane").(string); v != "" {
+			networkDataplane := managedclusters.NetworkDataplane(v)
+			props.NetworkProfile.NetworkDataplane = &networkDataplane
+		}
+	}
+
+	return nil
+}
+
+func skuNamePtr(input managedclusters.ManagedClusterSKUName) *managedclusters.ManagedClusterSKUName {
+	return &input
+}
+
+func skuTierPtr(input managedclusters.ManagedClusterSKUTier) *managedclusters.ManagedClusterSKUTier {
+	return &input
+}
+
+func expandKubernetesClusterAzureKeyVaultKms(ctx context.Context, client *clients.Client, input []interface{}) (*managedclusters.AzureKeyVaultKms, error) {
+	if len(input) == 0 || input[0] == nil {
+		return &managedclusters.AzureKeyVaultKms{
+			Enabled: utils.Bool(false),
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	keyVaultNetworkAccess := managedclusters.KeyVaultNetworkAccessTypes(raw["key_vault_network_access"].(string))
+	azureKeyVaultKms := &managedclusters.AzureKeyVaultKms{
+		Enabled:               utils.Bool(true),
+		KeyId:                 utils.String(raw["key_vault_key_id"].(string)),
+		KeyVaultNetworkAccess: &keyVaultNetworkAccess,
+	}
+
+	// when the Key Vault is only accessible privately, AKS needs the Resource ID of the Key Vault to connect to it
+	if keyVaultNetworkAccess == managedclusters.KeyVaultNetworkAccessTypesPrivate {
+		keyId, err := keyVaultParse.ParseNestedItemID(*azureKeyVaultKms.KeyId)
+		if err != nil {
+			return nil, err
+		}
+
+		keyVaultId, err := client.KeyVault.KeyVaultIDFromBaseUrl(ctx, client.Resource, keyId.KeyVaultBaseUrl)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the Resource ID of the Key Vault at URL %q: %+v", keyId.KeyVaultBaseUrl, err)
+		}
+		if keyVaultId == nil {
+			return nil, fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", keyId.KeyVaultBaseUrl)
+		}
+
+		azureKeyVaultKms.KeyVaultResourceId = keyVaultId
+	}
+
+	return azureKeyVaultKms, nil
+}
+
+func flattenKubernetesClusterAzureKeyVaultKms(input *managedclusters.ManagedClusterSecurityProfile) []interface{} {
+	if input == nil || input.AzureKeyVaultKms == nil {
+		return []interface{}{}
+	}
+
+	kms := input.AzureKeyVaultKms
+	if kms.Enabled == nil || !*kms.Enabled {
+		return []interface{}{}
+	}
+
+	keyId := ""
+	if kms.KeyId != nil {
+		keyId = *kms.KeyId
+	}
+
+	keyVaultNetworkAccess := string(managedclusters.KeyVaultNetworkAccessTypesPublic)
+	if kms.KeyVaultNetworkAccess != nil {
+		keyVaultNetworkAccess = string(*kms.KeyVaultNetworkAccess)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":         keyId,
+			"key_vault_network_access": keyVaultNetworkAccess,
+		},
+	}
+}
+
+func expandKubernetesClusterNodeProvisioningProfile(input []interface{}) *managedclusters.ManagedClusterNodeProvisioningProfile {
+	mode := managedclusters.NodeProvisioningModeManual
+	if len(input) > 0 && input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		if v := raw["mode"].(string); v != "" {
+			mode = managedclusters.NodeProvisioningMode(v)
+		}
+	}
+
+	return &managedclusters.ManagedClusterNodeProvisioningProfile{
+		Mode: &mode,
+	}
+}
+
+func flattenKubernetesClusterNodeProvisioningProfile(input *managedclusters.ManagedClusterNodeProvisioningProfile) []interface{} {
+	mode := string(managedclusters.NodeProvisioningModeManual)
+	if input != nil && input.Mode != nil {
+		mode = string(*input.Mode)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": mode,
+		},
+	}
+}
@@ -0,0 +1,223 @@
+package containers
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestConvertKubernetesClusterToPreviewModel(t *testing.T) {
+	userAssignedIdentityId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+	input := containerservice.ManagedCluster{
+		Name:     utils.String("cluster1"),
+		Location: utils.String("westeurope"),
+		Identity: &containerservice.ManagedClusterIdentity{
+			Type: containerservice.ResourceIdentityTypeUserAssigned,
+			UserAssignedIdentities: map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+				userAssignedIdentityId: {},
+			},
+		},
+		Sku: &containerservice.ManagedClusterSKU{
+			Name: containerservice.ManagedClusterSKUNameBasic,
+			Tier: containerservice.ManagedClusterSKUTierPaid,
+		},
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			DNSPrefix:         utils.String("cluster1"),
+			KubernetesVersion: utils.String("1.27.3"),
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+				{
+					Name:   utils.String("default"),
+					Count:  utils.Int32(2),
+					VMSize: utils.String("Standard_DS2_v2"),
+					Mode:   containerservice.AgentPoolModeSystem,
+				},
+			},
+			AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+				"azurepolicy": {
+					Enabled: utils.Bool(true),
+				},
+				"kubeDashboard": {
+					Enabled: utils.Bool(false),
+				},
+			},
+			ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+				ClientID: utils.String("msi"),
+			},
+			NetworkProfile: &containerservice.NetworkProfile{
+				NetworkPlugin: containerservice.NetworkPluginAzure,
+				ServiceCidr:   utils.String("10.10.0.0/16"),
+			},
+		},
+		Tags: map[string]*string{
+			"environment": utils.String("test"),
+		},
+	}
+
+	actual, err := convertKubernetesClusterToPreviewModel(input)
+	if err != nil {
+		t.Fatalf("converting: %+v", err)
+	}
+
+	if actual.Location != "westeurope" {
+		t.Fatalf("expected `location` to be %q but got %q", "westeurope", actual.Location)
+	}
+	if actual.Identity == nil || actual.Identity.Type != identity.TypeUserAssigned {
+		t.Fatalf("expected `identity.type` to be %q but got %+v", identity.TypeUserAssigned, actual.Identity)
+	}
+	if _, ok := actual.Identity.IdentityIds[userAssignedIdentityId]; !ok {
+		t.Fatalf("expected the User Assigned Identity %q to be present but got %+v", userAssignedIdentityId, actual.Identity.IdentityIds)
+	}
+	if actual.Sku == nil || actual.Sku.Name == nil || *actual.Sku.Name != managedclusters.ManagedClusterSKUNameBase {
+		t.Fatalf("expected `sku.name` to be %q but got %+v", managedclusters.ManagedClusterSKUNameBase, actual.Sku)
+	}
+	if actual.Sku.Tier == nil || *actual.Sku.Tier != managedclusters.ManagedClusterSKUTierStandard {
+		t.Fatalf("expected `sku.tier` to be %q but got %+v", managedclusters.ManagedClusterSKUTierStandard, actual.Sku.Tier)
+	}
+	if actual.Tags == nil || (*actual.Tags)["environment"] != "test" {
+		t.Fatalf("expected the tag `environment` to be %q but got %+v", "test", actual.Tags)
+	}
+
+	props := actual.Properties
+	if props == nil {
+		t.Fatalf("expected `properties` to be present")
+	}
+	if props.KubernetesVersion == nil || *props.KubernetesVersion != "1.27.3" {
+		t.Fatalf("expected `kubernetesVersion` to be %q but got %+v", "1.27.3", props.KubernetesVersion)
+	}
+	if props.AgentPoolProfiles == nil || len(*props.AgentPoolProfiles) != 1 {
+		t.Fatalf("expected a single Agent Pool Profile but got %+v", props.AgentPoolProfiles)
+	}
+	agentPool := (*props.AgentPoolProfiles)[0]
+	if agentPool.Name != "default" || agentPool.Count == nil || *agentPool.Count != 2 {
+		t.Fatalf("expected the Agent Pool Profile `default` with 2 nodes but got %+v", agentPool)
+	}
+	if agentPool.Mode == nil || *agentPool.Mode != managedclusters.AgentPoolModeSystem {
+		t.Fatalf("expected the Agent Pool Profile `default` to be a System pool but got %+v", agentPool.Mode)
+	}
+	if props.AddonProfiles == nil || !(*props.AddonProfiles)["azurepolicy"].Enabled {
+		t.Fatalf("expected the Addon Profile `azurepolicy` to be enabled but got %+v", props.AddonProfiles)
+	}
+	if _, ok := (*props.AddonProfiles)["kubeDashboard"]; ok {
+		t.Fatalf("expected the disabled Addon Profile `kubeDashboard` to be omitted but got %+v", props.AddonProfiles)
+	}
+	if props.ServicePrincipalProfile == nil || props.ServicePrincipalProfile.ClientId != "msi" {
+		t.Fatalf("expected `servicePrincipalProfile.clientId` to be %q but got %+v", "msi", props.ServicePrincipalProfile)
+	}
+	if props.NetworkProfile == nil || props.NetworkProfile.NetworkPlugin == nil || *props.NetworkProfile.NetworkPlugin != managedclusters.NetworkPluginAzure {
+		t.Fatalf("expected `networkProfile.networkPlugin` to be %q but got %+v", managedclusters.NetworkPluginAzure, props.NetworkProfile)
+	}
+}
+
+func TestFlattenKubernetesClusterAzureKeyVaultKms(t *testing.T) {
+	private := managedclusters.KeyVaultNetworkAccessTypesPrivate
+	testData := []struct {
+		Name     string
+		Input    *managedclusters.ManagedClusterSecurityProfile
+		Expected []interface{}
+	}{
+		{
+			Name:     "no security profile",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "disabled",
+			Input: &managedclusters.ManagedClusterSecurityProfile{
+				AzureKeyVaultKms: &managedclusters.AzureKeyVaultKms{
+					Enabled: utils.Bool(false),
+				},
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "enabled without network access",
+			Input: &managedclusters.ManagedClusterSecurityProfile{
+				AzureKeyVaultKms: &managedclusters.AzureKeyVaultKms{
+					Enabled: utils.Bool(true),
+					KeyId:   utils.String("https://vault1.vault.azure.net/keys/key1/00000000000000000000000000000000"),
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id":         "https://vault1.vault.azure.net/keys/key1/00000000000000000000000000000000",
+					"key_vault_network_access": "Public",
+				},
+			},
+		},
+		{
+			Name: "enabled with private network access",
+			Input: &managedclusters.ManagedClusterSecurityProfile{
+				AzureKeyVaultKms: &managedclusters.AzureKeyVaultKms{
+					Enabled:               utils.Bool(true),
+					KeyId:                 utils.String("https://vault1.vault.azure.net/keys/key1/00000000000000000000000000000000"),
+					KeyVaultNetworkAccess: &private,
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id":         "https://vault1.vault.azure.net/keys/key1/00000000000000000000000000000000",
+					"key_vault_network_access": "Private",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenKubernetesClusterAzureKeyVaultKms(v.Input)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("expected %d items but got %d", len(v.Expected), len(actual))
+		}
+		for i := range actual {
+			expected := v.Expected[i].(map[string]interface{})
+			for key, value := range actual[i].(map[string]interface{}) {
+				if expected[key] != value {
+					t.Fatalf("expected %q to be %q but got %q", key, expected[key], value)
+				}
+			}
+		}
+	}
+}
+
+func TestFlattenKubernetesClusterNodeProvisioningProfile(t *testing.T) {
+	auto := managedclusters.NodeProvisioningModeAuto
+	testData := []struct {
+		Name     string
+		Input    *managedclusters.ManagedClusterNodeProvisioningProfile
+		Expected string
+	}{
+		{
+			Name:     "no node provisioning profile",
+			Input:    nil,
+			Expected: "Manual",
+		},
+		{
+			Name:     "no mode",
+			Input:    &managedclusters.ManagedClusterNodeProvisioningProfile{},
+			Expected: "Manual",
+		},
+		{
+			Name: "auto",
+			Input: &managedclusters.ManagedClusterNodeProvisioningProfile{
+				Mode: &auto,
+			},
+			Expected: "Auto",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenKubernetesClusterNodeProvisioningProfile(v.Input)
+		if len(actual) != 1 {
+			t.Fatalf("expected a single item but got %d", len(actual))
+		}
+		if mode := actual[0].(map[string]interface{})["mode"]; mode != v.Expected {
+			t.Fatalf("expected `mode` to be %q but got %q", v.Expected, mode)
+		}
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-09-02-preview/managedclusters"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
@@ -49,6 +51,13 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("service_principal.0.client_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old == "msi" || old == ""
 			}),
+			// changes to the Default Node Pool which can't be made in-place require the cluster to be
+			// recreated, unless a `temporary_name_for_rotation` has been specified to cycle the pool instead
+			forceNewDefaultNodePoolIfNotCycled,
+			// Node Auto-Provisioning can be enabled on an existing cluster but can't be disabled once enabled
+			pluginsdk.ForceNewIfChange("node_provisioning_profile.0.mode", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(managedclusters.NodeProvisioningModeAuto) && new.(string) != string(managedclusters.NodeProvisioningModeAuto)
+			}),
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -267,6 +276,30 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"key_management_service": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+						"key_vault_network_access": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(managedclusters.KeyVaultNetworkAccessTypesPublic),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(managedclusters.KeyVaultNetworkAccessTypesPrivate),
+								string(managedclusters.KeyVaultNetworkAccessTypesPublic),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"kubelet_identity": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -423,6 +456,24 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							}, false),
 						},
 
+						"network_plugin_mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(managedclusters.NetworkPluginModeOverlay),
+							}, false),
+						},
+
+						"ebpf_data_plane": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(managedclusters.NetworkDataplaneCilium),
+							}, false),
+						},
+
 						"network_mode": {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
@@ -602,6 +653,26 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"node_provisioning_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(managedclusters.NodeProvisioningModeManual),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(managedclusters.NodeProvisioningModeAuto),
+								string(managedclusters.NodeProvisioningModeManual),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"node_resource_group": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -1227,13 +1298,32 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.ManagedClusterProperties.DiskEncryptionSetID = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
-	}
+	if kubernetesClusterRequiresPreviewApi(d) {
+		// some properties (e.g. the Key Management Service and Node Auto-Provisioning) can only be configured
+		// using a newer version of the API
+		previewParameters, err := convertKubernetesClusterToPreviewModel(parameters)
+		if err != nil {
+			return fmt.Errorf("converting Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		if err := expandKubernetesClusterPreviewProperties(ctx, meta.(*clients.Client), d, previewParameters.Properties); err != nil {
+			return err
+		}
+
+		previewClient := meta.(*clients.Client).Containers.KubernetesClustersPreviewClient
+		previewId := managedclusters.NewManagedClusterID(client.SubscriptionID, resGroup, name)
+		if err := previewClient.CreateOrUpdateThenPoll(ctx, previewId, *previewParameters); err != nil {
+			return fmt.Errorf("creating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+		if err != nil {
+			return fmt.Errorf("creating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
 	if maintenanceConfigRaw, ok := d.GetOk("maintenance_window"); ok {
@@ -1511,7 +1601,7 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
 		existing.Tags = tags.Expand(t)
@@ -1569,6 +1659,44 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Updated the Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
 	}
 
+	if d.HasChanges("key_management_service", "node_provisioning_profile") {
+		// these can only be configured using a newer version of the API - changing the key of the Key Management
+		// Service (e.g. when rotating to a new version of the key) triggers AKS to reconcile the cluster and
+		// re-encrypt the secrets stored in etcd using the new key
+		log.Printf("[DEBUG] Updating the Kubernetes Cluster %q (Resource Group %q) using the Preview API..", id.ManagedClusterName, id.ResourceGroup)
+		previewClient := containersClient.KubernetesClustersPreviewClient
+		previewId := managedclusters.NewManagedClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName)
+		previewExisting, err := previewClient.Get(ctx, previewId)
+		if err != nil {
+			return fmt.Errorf("retrieving existing Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		}
+		if previewExisting.Model == nil || previewExisting.Model.Properties == nil {
+			return fmt.Errorf("retrieving existing Kubernetes Cluster %q (Resource Group %q): `properties` was nil", id.ManagedClusterName, id.ResourceGroup)
+		}
+
+		props := previewExisting.Model.Properties
+		if d.HasChange("key_management_service") {
+			azureKeyVaultKms, err := expandKubernetesClusterAzureKeyVaultKms(ctx, meta.(*clients.Client), d.Get("key_management_service").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `key_management_service`: %+v", err)
+			}
+
+			if props.SecurityProfile == nil {
+				props.SecurityProfile = &managedclusters.ManagedClusterSecurityProfile{}
+			}
+			props.SecurityProfile.AzureKeyVaultKms = azureKeyVaultKms
+		}
+
+		if d.HasChange("node_provisioning_profile") {
+			props.NodeProvisioningProfile = expandKubernetesClusterNodeProvisioningProfile(d.Get("node_provisioning_profile").([]interface{}))
+		}
+
+		if err := previewClient.CreateOrUpdateThenPoll(ctx, previewId, *previewExisting.Model); err != nil {
+			return fmt.Errorf("updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Updated the Kubernetes Cluster %q (Resource Group %q) using the Preview API.", id.ManagedClusterName, id.ResourceGroup)
+	}
+
 	// then roll the version of Kubernetes if necessary
 	if d.HasChange("kubernetes_version") {
 		existing, err = clusterClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
@@ -1614,15 +1742,25 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 			}
 		}
 
-		agentPool, err := nodePoolsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, nodePoolName, agentProfile)
-		if err != nil {
-			return fmt.Errorf("updating Default Node Pool %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
-		}
+		if defaultNodePoolRequiresCycling(d) {
+			// these properties can't be updated in-place, so the Default Node Pool needs to be cycled - where a temporary
+			// System Node Pool is provisioned to host the system pods whilst the Default Node Pool is recreated
+			oldName, _ := d.GetChange("default_node_pool.0.name")
+			if err := cycleDefaultNodePool(ctx, nodePoolsClient, *id, oldName.(string), d.Get("default_node_pool.0.temporary_name_for_rotation").(string), agentProfile); err != nil {
+				return err
+			}
+			log.Printf("[DEBUG] Cycled Default Node Pool.")
+		} else {
+			agentPool, err := nodePoolsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, nodePoolName, agentProfile)
+			if err != nil {
+				return fmt.Errorf("updating Default Node Pool %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+			}
 
-		if err := agentPool.WaitForCompletionRef(ctx, nodePoolsClient.Client); err != nil {
-			return fmt.Errorf("waiting for update of Default Node Pool %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+			if err := agentPool.WaitForCompletionRef(ctx, nodePoolsClient.Client); err != nil {
+				return fmt.Errorf("waiting for update of Default Node Pool %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+			}
+			log.Printf("[DEBUG] Updated Default Node Pool.")
 		}
-		log.Printf("[DEBUG] Updated Default Node Pool.")
 	}
 
 	if d.HasChange("maintenance_window") {
@@ -1678,6 +1816,25 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 	d.Set("sku_tier", skuTier)
 
+	// some properties (e.g. the Key Management Service and Node Auto-Provisioning) are only available in newer
+	// versions of the API
+	previewClient := meta.(*clients.Client).Containers.KubernetesClustersPreviewClient
+	previewResp, err := previewClient.Get(ctx, managedclusters.NewManagedClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName))
+	if err != nil {
+		return fmt.Errorf("retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+	}
+	previewProps := &managedclusters.ManagedClusterProperties{}
+	if model := previewResp.Model; model != nil && model.Properties != nil {
+		previewProps = model.Properties
+	}
+
+	if err := d.Set("key_management_service", flattenKubernetesClusterAzureKeyVaultKms(previewProps.SecurityProfile)); err != nil {
+		return fmt.Errorf("setting `key_management_service`: %+v", err)
+	}
+	if err := d.Set("node_provisioning_profile", flattenKubernetesClusterNodeProvisioningProfile(previewProps.NodeProvisioningProfile)); err != nil {
+		return fmt.Errorf("setting `node_provisioning_profile`: %+v", err)
+	}
+
 	if props := resp.ManagedClusterProperties; props != nil {
 		d.Set("dns_prefix", props.DNSPrefix)
 		d.Set("dns_prefix_private_cluster", props.FqdnSubdomain)
@@ -1751,7 +1908,7 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `linux_profile`: %+v", err)
 		}
 
-		networkProfile := flattenKubernetesClusterNetworkProfile(props.NetworkProfile)
+		networkProfile := flattenKubernetesClusterNetworkProfile(props.NetworkProfile, previewProps.NetworkProfile)
 		if err := d.Set("network_profile", networkProfile); err != nil {
 			return fmt.Errorf("setting `network_profile`: %+v", err)
 		}
@@ -1847,6 +2004,83 @@ func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}
 	return nil
 }
 
+// cycleDefaultNodePool recreates the Default Node Pool, using a temporary System Node Pool to host the system pods
+// whilst the existing Default Node Pool is removed and the replacement is provisioned
+func cycleDefaultNodePool(ctx context.Context, client *containerservice.AgentPoolsClient, id parse.ClusterId, existingName, temporaryName string, replacement containerservice.AgentPool) error {
+	if temporaryName == "" {
+		return fmt.Errorf("`default_node_pool.0.temporary_name_for_rotation` must be specified when updating properties of the Default Node Pool which require it to be cycled")
+	}
+	if temporaryName == existingName || temporaryName == *replacement.Name {
+		return fmt.Errorf("`default_node_pool.0.temporary_name_for_rotation` must differ from both the existing (%q) and updated (%q) `name` of the Default Node Pool", existingName, *replacement.Name)
+	}
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, existingName)
+	if err != nil {
+		return fmt.Errorf("retrieving Default Node Pool %q for %s: %+v", existingName, id, err)
+	}
+	if existing.ManagedClusterAgentPoolProfileProperties == nil {
+		return fmt.Errorf("retrieving Default Node Pool %q for %s: `properties` was nil", existingName, id)
+	}
+
+	// the temporary node pool is a copy of the existing Default Node Pool, which must be a System node pool
+	// to allow the existing Default Node Pool to be removed
+	temporaryProps := *existing.ManagedClusterAgentPoolProfileProperties
+	temporaryProps.Mode = containerservice.AgentPoolModeSystem
+	temporaryProps.ProvisioningState = nil
+	temporaryProps.PowerState = nil
+	temporaryProps.NodeImageVersion = nil
+	temporary := containerservice.AgentPool{
+		Name:                                     utils.String(temporaryName),
+		ManagedClusterAgentPoolProfileProperties: &temporaryProps,
+	}
+
+	log.Printf("[DEBUG] Creating Temporary Node Pool %q for %s..", temporaryName, id)
+	if err := createOrUpdateNodePoolAndWait(ctx, client, id, temporaryName, temporary); err != nil {
+		return fmt.Errorf("creating Temporary Node Pool %q to cycle the Default Node Pool: %+v", temporaryName, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Default Node Pool %q for %s..", existingName, id)
+	if err := deleteNodePoolAndWait(ctx, client, id, existingName); err != nil {
+		return fmt.Errorf("deleting Default Node Pool %q whilst cycling (Temporary Node Pool %q has been left in place): %+v", existingName, temporaryName, err)
+	}
+
+	log.Printf("[DEBUG] Creating Default Node Pool %q for %s..", *replacement.Name, id)
+	if err := createOrUpdateNodePoolAndWait(ctx, client, id, *replacement.Name, replacement); err != nil {
+		return fmt.Errorf("creating Default Node Pool %q whilst cycling (Temporary Node Pool %q has been left in place): %+v", *replacement.Name, temporaryName, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Temporary Node Pool %q for %s..", temporaryName, id)
+	if err := deleteNodePoolAndWait(ctx, client, id, temporaryName); err != nil {
+		return fmt.Errorf("deleting Temporary Node Pool %q after cycling the Default Node Pool: %+v", temporaryName, err)
+	}
+
+	return nil
+}
+
+func createOrUpdateNodePoolAndWait(ctx context.Context, client *containerservice.AgentPoolsClient, id parse.ClusterId, name string, parameters containerservice.AgentPool) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating Node Pool %q for %s: %+v", name, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of Node Pool %q for %s: %+v", name, id, err)
+	}
+
+	return nil
+}
+
+func deleteNodePoolAndWait(ctx context.Context, client *containerservice.AgentPoolsClient, id parse.ClusterId, name string) error {
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, name)
+	if err != nil {
+		return fmt.Errorf("deleting Node Pool %q for %s: %+v", name, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of Node Pool %q for %s: %+v", name, id, err)
+	}
+
+	return nil
+}
+
 func flattenKubernetesClusterAccessProfile(profile containerservice.ManagedClusterAccessProfile) (*string, []interface{}) {
 	if accessProfile := profile.AccessProfile; accessProfile != nil {
 		if kubeConfigRaw := accessProfile.KubeConfig; kubeConfigRaw != nil {
@@ -2201,11 +2435,23 @@ func resourceReferencesToIds(refs *[]containerservice.ResourceReference) []strin
 	return nil
 }
 
-func flattenKubernetesClusterNetworkProfile(profile *containerservice.NetworkProfile) []interface{} {
+func flattenKubernetesClusterNetworkProfile(profile *containerservice.NetworkProfile, previewProfile *managedclusters.ContainerServiceNetworkProfile) []interface{} {
 	if profile == nil {
 		return []interface{}{}
 	}
 
+	// the Network Plugin Mode and the eBPF Data Plane are only available in newer versions of the API
+	networkPluginMode := ""
+	ebpfDataPlane := ""
+	if previewProfile != nil {
+		if previewProfile.NetworkPluginMode != nil {
+			networkPluginMode = string(*previewProfile.NetworkPluginMode)
+		}
+		if previewProfile.NetworkDataplane != nil && *previewProfile.NetworkDataplane == managedclusters.NetworkDataplaneCilium {
+			ebpfDataPlane = string(*previewProfile.NetworkDataplane)
+		}
+	}
+
 	dnsServiceIP := ""
 	if profile.DNSServiceIP != nil {
 		dnsServiceIP = *profile.DNSServiceIP
@@ -2286,6 +2532,8 @@ func flattenKubernetesClusterNetworkProfile(profile *containerservice.NetworkPro
 			"load_balancer_profile": lbProfiles,
 			"nat_gateway_profile":   ngwProfiles,
 			"network_plugin":        string(profile.NetworkPlugin),
+			"network_plugin_mode":   networkPluginMode,
+			"ebpf_data_plane":       ebpfDataPlane,
 			"network_mode":          string(profile.NetworkMode),
 			"network_policy":        string(profile.NetworkPolicy),
 			"pod_cidr":              podCidr,
@@ -25,10 +25,20 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *containerserv
 				dnsServiceIP := profile["dns_service_ip"].(string)
 				serviceCidr := profile["service_cidr"].(string)
 				podCidr := profile["pod_cidr"].(string)
+				networkPluginMode := profile["network_plugin_mode"].(string)
+				ebpfDataPlane := profile["ebpf_data_plane"].(string)
 
-				// Azure network plugin is not compatible with pod_cidr
-				if podCidr != "" && networkPlugin == "azure" {
-					return fmt.Errorf("`pod_cidr` and `azure` cannot be set together")
+				// Azure network plugin is not compatible with pod_cidr, unless it's used in Overlay mode
+				if podCidr != "" && networkPlugin == "azure" && networkPluginMode == "" {
+					return fmt.Errorf("`pod_cidr` and `azure` cannot be set together unless `network_plugin_mode` is set to `overlay`")
+				}
+
+				if networkPluginMode != "" && networkPlugin != "azure" {
+					return fmt.Errorf("`network_plugin_mode` can only be set when `network_plugin` is set to `azure`")
+				}
+
+				if ebpfDataPlane != "" && networkPlugin != "azure" {
+					return fmt.Errorf("`ebpf_data_plane` can only be set when `network_plugin` is set to `azure`")
 				}
 
 				// if not All empty values or All set values.
@@ -39,6 +49,17 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *containerserv
 		}
 	}
 
+	if d.Get("node_provisioning_profile.0.mode").(string) == "Auto" {
+		// Node Auto-Provisioning requires Azure CNI Overlay powered by Cilium and replaces the Cluster Autoscaler
+		if d.Get("network_profile.0.network_plugin_mode").(string) != "overlay" || d.Get("network_profile.0.ebpf_data_plane").(string) != "cilium" {
+			return fmt.Errorf("`node_provisioning_profile.0.mode` can only be set to `Auto` when `network_profile.0.network_plugin_mode` is set to `overlay` and `network_profile.0.ebpf_data_plane` is set to `cilium`")
+		}
+
+		if d.Get("default_node_pool.0.enable_auto_scaling").(bool) {
+			return fmt.Errorf("`default_node_pool.0.enable_auto_scaling` cannot be enabled when `node_provisioning_profile.0.mode` is set to `Auto`")
+		}
+	}
+
 	// @tombuildsstuff: As of 2020-03-30 it's no longer possible to create a cluster using a Service Principal
 	// for authentication (albeit this worked on 2020-03-27 via API version 2019-10-01 :shrug:). However it's
 	// possible to rotate the Service Principal for an existing Cluster - so this needs to be supported via
@@ -0,0 +1,779 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	fluxConfigurationReferenceTypeBranch          = "branch"
+	fluxConfigurationReferenceTypeCommit          = "commit"
+	fluxConfigurationReferenceTypeSemanticVersion = "semantic_version"
+	fluxConfigurationReferenceTypeTag             = "tag"
+
+	// these are the keys used within `configurationProtectedSettings` for the sensitive values of the sources
+	fluxConfigurationProtectedSettingHttpsKey      = "httpsKey"
+	fluxConfigurationProtectedSettingSshPrivateKey = "sshPrivateKey"
+	fluxConfigurationProtectedSettingSecretKey     = "secretKey"
+)
+
+type KubernetesFluxConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesFluxConfigurationResource{}
+
+type KubernetesFluxConfigurationModel struct {
+	Name                            string                                     `tfschema:"name"`
+	ClusterId                       string                                     `tfschema:"cluster_id"`
+	Namespace                       string                                     `tfschema:"namespace"`
+	Scope                           string                                     `tfschema:"scope"`
+	GitRepository                   []KubernetesFluxConfigurationGitRepository `tfschema:"git_repository"`
+	Bucket                          []KubernetesFluxConfigurationBucket        `tfschema:"bucket"`
+	Kustomizations                  []KubernetesFluxConfigurationKustomization `tfschema:"kustomizations"`
+	ContinuousReconciliationEnabled bool                                       `tfschema:"continuous_reconciliation_enabled"`
+}
+
+type KubernetesFluxConfigurationGitRepository struct {
+	Url                   string `tfschema:"url"`
+	ReferenceType         string `tfschema:"reference_type"`
+	ReferenceValue        string `tfschema:"reference_value"`
+	HttpsUser             string `tfschema:"https_user"`
+	HttpsKeyBase64        string `tfschema:"https_key_base64"`
+	HttpsCACertBase64     string `tfschema:"https_ca_cert_base64"`
+	SshPrivateKeyBase64   string `tfschema:"ssh_private_key_base64"`
+	SshKnownHostsBase64   string `tfschema:"ssh_known_hosts_base64"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int    `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int    `tfschema:"timeout_in_seconds"`
+}
+
+type KubernetesFluxConfigurationBucket struct {
+	BucketName            string `tfschema:"bucket_name"`
+	Url                   string `tfschema:"url"`
+	AccessKey             string `tfschema:"access_key"`
+	SecretKeyBase64       string `tfschema:"secret_key_base64"`
+	TlsEnabled            bool   `tfschema:"tls_enabled"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int    `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int    `tfschema:"timeout_in_seconds"`
+}
+
+type KubernetesFluxConfigurationKustomization struct {
+	Name                     string   `tfschema:"name"`
+	Path                     string   `tfschema:"path"`
+	DependsOn                []string `tfschema:"depends_on"`
+	GarbageCollectionEnabled bool     `tfschema:"garbage_collection_enabled"`
+	RecreatingEnabled        bool     `tfschema:"recreating_enabled"`
+	RetryIntervalInSeconds   int      `tfschema:"retry_interval_in_seconds"`
+	SyncIntervalInSeconds    int      `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds         int      `tfschema:"timeout_in_seconds"`
+}
+
+func (r KubernetesFluxConfigurationResource) ResourceType() string {
+	return "azurerm_kubernetes_flux_configuration"
+}
+
+func (r KubernetesFluxConfigurationResource) ModelObject() interface{} {
+	return &KubernetesFluxConfigurationModel{}
+}
+
+func (r KubernetesFluxConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fluxconfiguration.ValidateScopedFluxConfigurationID
+}
+
+func (r KubernetesFluxConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesFluxConfigurationName,
+		},
+
+		"cluster_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				validate.ClusterID,
+				validate.ConnectedClusterID,
+			),
+		},
+
+		"namespace": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kustomizations": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"depends_on": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"garbage_collection_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"recreating_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"retry_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"bucket": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"bucket", "git_repository"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"bucket_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"access_key": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"bucket.0.local_auth_reference"},
+						RequiredWith:  []string{"bucket.0.secret_key_base64"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"secret_key_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ConflictsWith: []string{"bucket.0.local_auth_reference"},
+						RequiredWith:  []string{"bucket.0.access_key"},
+						ValidateFunc:  validation.StringIsBase64,
+					},
+
+					"tls_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"bucket.0.access_key", "bucket.0.secret_key_base64"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"git_repository": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"bucket", "git_repository"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^(http://|https://|ssh://|git@)`),
+							"`url` must start with `http://`, `https://`, `ssh://` or `git@`",
+						),
+					},
+
+					"reference_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							fluxConfigurationReferenceTypeBranch,
+							fluxConfigurationReferenceTypeCommit,
+							fluxConfigurationReferenceTypeSemanticVersion,
+							fluxConfigurationReferenceTypeTag,
+						}, false),
+					},
+
+					"reference_value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"https_user": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+						RequiredWith:  []string{"git_repository.0.https_key_base64"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"https_key_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+						RequiredWith:  []string{"git_repository.0.https_user"},
+						ValidateFunc:  validation.StringIsBase64,
+					},
+
+					"https_ca_cert_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+						ValidateFunc:  validation.StringIsBase64,
+					},
+
+					"ssh_private_key_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.https_user"},
+						ValidateFunc:  validation.StringIsBase64,
+					},
+
+					"ssh_known_hosts_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.https_user"},
+						ValidateFunc:  validation.StringIsBase64,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"git_repository.0.https_user", "git_repository.0.ssh_private_key_base64"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(fluxconfiguration.ScopeTypeNamespace),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(fluxconfiguration.ScopeTypeCluster),
+				string(fluxconfiguration.ScopeTypeNamespace),
+			}, false),
+		},
+
+		"continuous_reconciliation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationsClient
+
+			var config KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fluxconfiguration.NewScopedFluxConfigurationID(config.ClusterId, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			scope := fluxconfiguration.ScopeType(config.Scope)
+			properties := fluxconfiguration.FluxConfigurationProperties{
+				Kustomizations: expandKubernetesFluxConfigurationKustomizations(config.Kustomizations),
+				Namespace:      &config.Namespace,
+				Scope:          &scope,
+				Suspend:        utils.Bool(!config.ContinuousReconciliationEnabled),
+			}
+
+			protectedSettings := make(map[string]string)
+			if len(config.GitRepository) > 0 {
+				gitRepository, err := expandKubernetesFluxConfigurationGitRepository(config.GitRepository[0], protectedSettings)
+				if err != nil {
+					return err
+				}
+				sourceKind := fluxconfiguration.SourceKindTypeGitRepository
+				properties.GitRepository = gitRepository
+				properties.SourceKind = &sourceKind
+			} else if len(config.Bucket) > 0 {
+				sourceKind := fluxconfiguration.SourceKindTypeBucket
+				properties.Bucket = expandKubernetesFluxConfigurationBucket(config.Bucket[0], protectedSettings)
+				properties.SourceKind = &sourceKind
+			}
+			properties.ConfigurationProtectedSettings = &protectedSettings
+
+			payload := fluxconfiguration.FluxConfiguration{
+				Properties: &properties,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationsClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the sensitive values of the source aren't returned by the API, so we retain them from the config
+			var config KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := KubernetesFluxConfigurationModel{
+				Name:      id.FluxConfigurationName,
+				ClusterId: id.Scope,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.Namespace != nil {
+						state.Namespace = *props.Namespace
+					}
+					if props.Scope != nil {
+						state.Scope = string(*props.Scope)
+					}
+					state.ContinuousReconciliationEnabled = props.Suspend == nil || !*props.Suspend
+					state.Kustomizations = flattenKubernetesFluxConfigurationKustomizations(props.Kustomizations)
+
+					if props.SourceKind != nil && *props.SourceKind == fluxconfiguration.SourceKindTypeBucket {
+						state.Bucket = flattenKubernetesFluxConfigurationBucket(props.Bucket, config.Bucket)
+					} else {
+						state.GitRepository = flattenKubernetesFluxConfigurationGitRepository(props.GitRepository, config.GitRepository)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationsClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			// the protected settings aren't returned from the API, so they're rebuilt from the config for any update
+			payload := *existing.Model
+			props := payload.Properties
+
+			if metadata.ResourceData.HasChange("kustomizations") {
+				props.Kustomizations = expandKubernetesFluxConfigurationKustomizations(config.Kustomizations)
+			}
+
+			if metadata.ResourceData.HasChange("continuous_reconciliation_enabled") {
+				props.Suspend = utils.Bool(!config.ContinuousReconciliationEnabled)
+			}
+
+			protectedSettings := make(map[string]string)
+			props.Bucket = nil
+			props.GitRepository = nil
+			if len(config.GitRepository) > 0 {
+				gitRepository, err := expandKubernetesFluxConfigurationGitRepository(config.GitRepository[0], protectedSettings)
+				if err != nil {
+					return err
+				}
+				sourceKind := fluxconfiguration.SourceKindTypeGitRepository
+				props.GitRepository = gitRepository
+				props.SourceKind = &sourceKind
+			} else if len(config.Bucket) > 0 {
+				sourceKind := fluxconfiguration.SourceKindTypeBucket
+				props.Bucket = expandKubernetesFluxConfigurationBucket(config.Bucket[0], protectedSettings)
+				props.SourceKind = &sourceKind
+			}
+			props.ConfigurationProtectedSettings = &protectedSettings
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationsClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFluxConfigurationGitRepository(input KubernetesFluxConfigurationGitRepository, protectedSettings map[string]string) (*fluxconfiguration.GitRepositoryDefinition, error) {
+	output := fluxconfiguration.GitRepositoryDefinition{
+		RepositoryRef:         &fluxconfiguration.RepositoryRefDefinition{},
+		SyncIntervalInSeconds: utils.Int64(int64(input.SyncIntervalInSeconds)),
+		TimeoutInSeconds:      utils.Int64(int64(input.TimeoutInSeconds)),
+		Url:                   utils.String(input.Url),
+	}
+
+	switch input.ReferenceType {
+	case fluxConfigurationReferenceTypeBranch:
+		output.RepositoryRef.Branch = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeCommit:
+		output.RepositoryRef.Commit = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeSemanticVersion:
+		output.RepositoryRef.Semver = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeTag:
+		output.RepositoryRef.Tag = utils.String(input.ReferenceValue)
+	default:
+		return nil, fmt.Errorf("unsupported `reference_type` %q", input.ReferenceType)
+	}
+
+	if input.HttpsUser != "" {
+		output.HTTPSUser = utils.String(input.HttpsUser)
+	}
+	if input.HttpsKeyBase64 != "" {
+		protectedSettings[fluxConfigurationProtectedSettingHttpsKey] = input.HttpsKeyBase64
+	}
+	if input.HttpsCACertBase64 != "" {
+		output.HTTPSCACert = utils.String(input.HttpsCACertBase64)
+	}
+	if input.SshPrivateKeyBase64 != "" {
+		protectedSettings[fluxConfigurationProtectedSettingSshPrivateKey] = input.SshPrivateKeyBase64
+	}
+	if input.SshKnownHostsBase64 != "" {
+		output.SshKnownHosts = utils.String(input.SshKnownHostsBase64)
+	}
+	if input.LocalAuthReference != "" {
+		output.LocalAuthRef = utils.String(input.LocalAuthReference)
+	}
+
+	return &output, nil
+}
+
+func flattenKubernetesFluxConfigurationGitRepository(input *fluxconfiguration.GitRepositoryDefinition, config []KubernetesFluxConfigurationGitRepository) []KubernetesFluxConfigurationGitRepository {
+	if input == nil {
+		return []KubernetesFluxConfigurationGitRepository{}
+	}
+
+	output := KubernetesFluxConfigurationGitRepository{}
+	if len(config) > 0 {
+		output.HttpsKeyBase64 = config[0].HttpsKeyBase64
+		output.HttpsCACertBase64 = config[0].HttpsCACertBase64
+		output.SshPrivateKeyBase64 = config[0].SshPrivateKeyBase64
+	}
+
+	if input.Url != nil {
+		output.Url = *input.Url
+	}
+	if input.HTTPSUser != nil {
+		output.HttpsUser = *input.HTTPSUser
+	}
+	if input.SshKnownHosts != nil {
+		output.SshKnownHostsBase64 = *input.SshKnownHosts
+	}
+	if input.LocalAuthRef != nil {
+		output.LocalAuthReference = *input.LocalAuthRef
+	}
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = int(*input.SyncIntervalInSeconds)
+	}
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = int(*input.TimeoutInSeconds)
+	}
+
+	if ref := input.RepositoryRef; ref != nil {
+		switch {
+		case ref.Branch != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeBranch
+			output.ReferenceValue = *ref.Branch
+		case ref.Commit != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeCommit
+			output.ReferenceValue = *ref.Commit
+		case ref.Semver != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeSemanticVersion
+			output.ReferenceValue = *ref.Semver
+		case ref.Tag != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeTag
+			output.ReferenceValue = *ref.Tag
+		}
+	}
+
+	return []KubernetesFluxConfigurationGitRepository{output}
+}
+
+func expandKubernetesFluxConfigurationBucket(input KubernetesFluxConfigurationBucket, protectedSettings map[string]string) *fluxconfiguration.BucketDefinition {
+	output := fluxconfiguration.BucketDefinition{
+		BucketName:            utils.String(input.BucketName),
+		Insecure:              utils.Bool(!input.TlsEnabled),
+		SyncIntervalInSeconds: utils.Int64(int64(input.SyncIntervalInSeconds)),
+		TimeoutInSeconds:      utils.Int64(int64(input.TimeoutInSeconds)),
+		Url:                   utils.String(input.Url),
+	}
+
+	if input.AccessKey != "" {
+		output.AccessKey = utils.String(input.AccessKey)
+	}
+	if input.SecretKeyBase64 != "" {
+		protectedSettings[fluxConfigurationProtectedSettingSecretKey] = input.SecretKeyBase64
+	}
+	if input.LocalAuthReference != "" {
+		output.LocalAuthRef = utils.String(input.LocalAuthReference)
+	}
+
+	return &output
+}
+
+func flattenKubernetesFluxConfigurationBucket(input *fluxconfiguration.BucketDefinition, config []KubernetesFluxConfigurationBucket) []KubernetesFluxConfigurationBucket {
+	if input == nil {
+		return []KubernetesFluxConfigurationBucket{}
+	}
+
+	output := KubernetesFluxConfigurationBucket{
+		TlsEnabled: input.Insecure == nil || !*input.Insecure,
+	}
+	if len(config) > 0 {
+		output.SecretKeyBase64 = config[0].SecretKeyBase64
+	}
+
+	if input.AccessKey != nil {
+		output.AccessKey = *input.AccessKey
+	}
+	if input.BucketName != nil {
+		output.BucketName = *input.BucketName
+	}
+	if input.LocalAuthRef != nil {
+		output.LocalAuthReference = *input.LocalAuthRef
+	}
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = int(*input.SyncIntervalInSeconds)
+	}
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = int(*input.TimeoutInSeconds)
+	}
+	if input.Url != nil {
+		output.Url = *input.Url
+	}
+
+	return []KubernetesFluxConfigurationBucket{output}
+}
+
+func expandKubernetesFluxConfigurationKustomizations(input []KubernetesFluxConfigurationKustomization) *map[string]fluxconfiguration.KustomizationDefinition {
+	output := make(map[string]fluxconfiguration.KustomizationDefinition)
+	for _, v := range input {
+		dependsOn := v.DependsOn
+		output[v.Name] = fluxconfiguration.KustomizationDefinition{
+			DependsOn:              &dependsOn,
+			Force:                  utils.Bool(v.RecreatingEnabled),
+			Name:                   utils.String(v.Name),
+			Path:                   utils.String(v.Path),
+			Prune:                  utils.Bool(v.GarbageCollectionEnabled),
+			RetryIntervalInSeconds: utils.Int64(int64(v.RetryIntervalInSeconds)),
+			SyncIntervalInSeconds:  utils.Int64(int64(v.SyncIntervalInSeconds)),
+			TimeoutInSeconds:       utils.Int64(int64(v.TimeoutInSeconds)),
+		}
+	}
+
+	return &output
+}
+
+func flattenKubernetesFluxConfigurationKustomizations(input *map[string]fluxconfiguration.KustomizationDefinition) []KubernetesFluxConfigurationKustomization {
+	output := make([]KubernetesFluxConfigurationKustomization, 0)
+	if input == nil {
+		return output
+	}
+
+	names := make([]string, 0)
+	for k := range *input {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, k := range names {
+		v := (*input)[k]
+		kustomization := KubernetesFluxConfigurationKustomization{
+			Name:      k,
+			DependsOn: make([]string, 0),
+		}
+		if v.Name != nil {
+			kustomization.Name = *v.Name
+		}
+		if v.Path != nil {
+			kustomization.Path = *v.Path
+		}
+		if v.DependsOn != nil {
+			kustomization.DependsOn = *v.DependsOn
+		}
+		if v.Force != nil {
+			kustomization.RecreatingEnabled = *v.Force
+		}
+		if v.Prune != nil {
+			kustomization.GarbageCollectionEnabled = *v.Prune
+		}
+		if v.RetryIntervalInSeconds != nil {
+			kustomization.RetryIntervalInSeconds = int(*v.RetryIntervalInSeconds)
+		}
+		if v.SyncIntervalInSeconds != nil {
+			kustomization.SyncIntervalInSeconds = int(*v.SyncIntervalInSeconds)
+		}
+		if v.TimeoutInSeconds != nil {
+			kustomization.TimeoutInSeconds = int(*v.TimeoutInSeconds)
+		}
+		output = append(output, kustomization)
+	}
+
+	return output
+}
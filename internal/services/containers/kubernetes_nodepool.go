@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -28,7 +29,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"name": {
 					Type:         pluginsdk.TypeString,
 					Required:     true,
-					ForceNew:     true,
 					ValidateFunc: validate.KubernetesAgentPoolName,
 				},
 
@@ -46,7 +46,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"vm_size": {
 					Type:         pluginsdk.TypeString,
 					Required:     true,
-					ForceNew:     true,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 
@@ -54,7 +53,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"availability_zones": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
-					ForceNew: true,
 					Elem: &pluginsdk.Schema{
 						Type: pluginsdk.TypeString,
 					},
@@ -68,23 +66,20 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"enable_node_public_ip": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
-					ForceNew: true,
 				},
 
 				"enable_host_encryption": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
-					ForceNew: true,
 				},
 
-				"kubelet_config": schemaNodePoolKubeletConfig(),
+				"kubelet_config": schemaNodePoolKubeletConfig(false),
 
-				"linux_os_config": schemaNodePoolLinuxOSConfig(),
+				"linux_os_config": schemaNodePoolLinuxOSConfig(false),
 
 				"fips_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
-					ForceNew: true,
 				},
 
 				"kubelet_disk_type": {
@@ -107,7 +102,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
 					Computed: true,
-					ForceNew: true,
 				},
 
 				"min_count": {
@@ -126,7 +120,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 
 				"node_labels": {
 					Type:     pluginsdk.TypeMap,
-					ForceNew: true,
 					Optional: true,
 					Computed: true,
 					Elem: &pluginsdk.Schema{
@@ -137,7 +130,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"node_public_ip_prefix_id": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
-					ForceNew:     true,
 					ValidateFunc: azure.ValidateResourceID,
 					RequiredWith: []string{"default_node_pool.0.enable_node_public_ip"},
 				},
@@ -153,10 +145,15 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 
 				"tags": tags.Schema(),
 
+				"temporary_name_for_rotation": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.KubernetesAgentPoolName,
+				},
+
 				"os_disk_size_gb": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
 					Computed:     true,
 					ValidateFunc: validation.IntAtLeast(1),
 				},
@@ -164,7 +161,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"os_disk_type": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
-					ForceNew: true,
 					Default:  containerservice.OSDiskTypeManaged,
 					ValidateFunc: validation.StringInSlice([]string{
 						string(containerservice.OSDiskTypeEphemeral),
@@ -175,7 +171,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"os_sku": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
-					ForceNew: true,
 					Computed: true, // defaults to Ubuntu if using Linux
 					ValidateFunc: validation.StringInSlice([]string{
 						string(containerservice.OSSKUUbuntu),
@@ -185,7 +180,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 
 				"ultra_ssd_enabled": {
 					Type:     pluginsdk.TypeBool,
-					ForceNew: true,
 					Default:  false,
 					Optional: true,
 				},
@@ -193,7 +187,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"vnet_subnet_id": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
-					ForceNew:     true,
 					ValidateFunc: azure.ValidateResourceID,
 				},
 				"orchestrator_version": {
@@ -205,19 +198,16 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 				"pod_subnet_id": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
-					ForceNew:     true,
 					ValidateFunc: networkValidate.SubnetID,
 				},
 				"proximity_placement_group_id": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
-					ForceNew:     true,
 					ValidateFunc: computeValidate.ProximityPlacementGroupID,
 				},
 				"only_critical_addons_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
-					ForceNew: true,
 				},
 
 				"upgrade_settings": upgradeSettingsSchema(),
@@ -226,18 +216,18 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 	}
 }
 
-func schemaNodePoolKubeletConfig() *pluginsdk.Schema {
+func schemaNodePoolKubeletConfig(forceNew bool) *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
 		Optional: true,
-		ForceNew: true,
+		ForceNew: forceNew,
 		MaxItems: 1,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
 				"cpu_manager_policy": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 					ValidateFunc: validation.StringInSlice([]string{
 						"none",
 						"static",
@@ -247,33 +237,33 @@ func schemaNodePoolKubeletConfig() *pluginsdk.Schema {
 				"cpu_cfs_quota_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 				},
 
 				"cpu_cfs_quota_period": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 				},
 
 				"image_gc_high_threshold": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(0, 100),
 				},
 
 				"image_gc_low_threshold": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(0, 100),
 				},
 
 				"topology_manager_policy": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 					ValidateFunc: validation.StringInSlice([]string{
 						"none",
 						"best-effort",
@@ -285,7 +275,7 @@ func schemaNodePoolKubeletConfig() *pluginsdk.Schema {
 				"allowed_unsafe_sysctls": {
 					Type:     pluginsdk.TypeSet,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 					Elem: &pluginsdk.Schema{
 						Type: pluginsdk.TypeString,
 					},
@@ -294,40 +284,40 @@ func schemaNodePoolKubeletConfig() *pluginsdk.Schema {
 				"container_log_max_size_mb": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 				},
 
 				"container_log_max_line": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntAtLeast(2),
 				},
 
 				"pod_max_pid": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 				},
 			},
 		},
 	}
 }
 
-func schemaNodePoolLinuxOSConfig() *pluginsdk.Schema {
+func schemaNodePoolLinuxOSConfig(forceNew bool) *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
 		Optional: true,
-		ForceNew: true,
+		ForceNew: forceNew,
 		MaxItems: 1,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
-				"sysctl_config": schemaNodePoolSysctlConfig(),
+				"sysctl_config": schemaNodePoolSysctlConfig(forceNew),
 
 				"transparent_huge_page_enabled": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 					ValidateFunc: validation.StringInSlice([]string{
 						"always",
 						"madvise",
@@ -338,7 +328,7 @@ func schemaNodePoolLinuxOSConfig() *pluginsdk.Schema {
 				"transparent_huge_page_defrag": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 					ValidateFunc: validation.StringInSlice([]string{
 						"always",
 						"defer",
@@ -351,220 +341,220 @@ func schemaNodePoolLinuxOSConfig() *pluginsdk.Schema {
 				"swap_file_size_mb": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 				},
 			},
 		},
 	}
 }
 
-func schemaNodePoolSysctlConfig() *pluginsdk.Schema {
+func schemaNodePoolSysctlConfig(forceNew bool) *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
 		Optional: true,
-		ForceNew: true,
+		ForceNew: forceNew,
 		MaxItems: 1,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
 				"fs_aio_max_nr": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(65536, 6553500),
 				},
 
 				"fs_file_max": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(8192, 12000500),
 				},
 
 				"fs_inotify_max_user_watches": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(781250, 2097152),
 				},
 
 				"fs_nr_open": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(8192, 20000500),
 				},
 
 				"kernel_threads_max": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(20, 513785),
 				},
 
 				"net_core_netdev_max_backlog": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(1000, 3240000),
 				},
 
 				"net_core_optmem_max": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(20480, 4194304),
 				},
 
 				"net_core_rmem_default": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(212992, 134217728),
 				},
 
 				"net_core_rmem_max": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(212992, 134217728),
 				},
 
 				"net_core_somaxconn": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(4096, 3240000),
 				},
 
 				"net_core_wmem_default": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(212992, 134217728),
 				},
 
 				"net_core_wmem_max": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(212992, 134217728),
 				},
 
 				"net_ipv4_ip_local_port_range_min": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(1024, 60999),
 				},
 
 				"net_ipv4_ip_local_port_range_max": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(32768, 65000),
 				},
 
 				"net_ipv4_neigh_default_gc_thresh1": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(128, 80000),
 				},
 
 				"net_ipv4_neigh_default_gc_thresh2": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(512, 90000),
 				},
 
 				"net_ipv4_neigh_default_gc_thresh3": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(1024, 100000),
 				},
 
 				"net_ipv4_tcp_fin_timeout": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(5, 120),
 				},
 
 				"net_ipv4_tcp_keepalive_intvl": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(10, 75),
 				},
 
 				"net_ipv4_tcp_keepalive_probes": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(1, 15),
 				},
 
 				"net_ipv4_tcp_keepalive_time": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(30, 432000),
 				},
 
 				"net_ipv4_tcp_max_syn_backlog": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(128, 3240000),
 				},
 
 				"net_ipv4_tcp_max_tw_buckets": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(8000, 1440000),
 				},
 
 				"net_ipv4_tcp_tw_reuse": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
-					ForceNew: true,
+					ForceNew: forceNew,
 				},
 
 				"net_netfilter_nf_conntrack_buckets": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(65536, 147456),
 				},
 
 				"net_netfilter_nf_conntrack_max": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(131072, 589824),
 				},
 
 				"vm_max_map_count": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(65530, 262144),
 				},
 
 				"vm_swappiness": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(0, 100),
 				},
 
 				"vm_vfs_cache_pressure": {
 					Type:         pluginsdk.TypeInt,
 					Optional:     true,
-					ForceNew:     true,
+					ForceNew:     forceNew,
 					ValidateFunc: validation.IntBetween(0, 100),
 				},
 			},
@@ -586,10 +576,13 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 			LinuxOSConfig:             defaultCluster.LinuxOSConfig,
 			MaxPods:                   defaultCluster.MaxPods,
 			OsType:                    defaultCluster.OsType,
+			OsSKU:                     defaultCluster.OsSKU,
 			MaxCount:                  defaultCluster.MaxCount,
 			MinCount:                  defaultCluster.MinCount,
 			EnableAutoScaling:         defaultCluster.EnableAutoScaling,
 			EnableFIPS:                defaultCluster.EnableFIPS,
+			EnableEncryptionAtHost:    defaultCluster.EnableEncryptionAtHost,
+			EnableUltraSSD:            defaultCluster.EnableUltraSSD,
 			KubeletDiskType:           defaultCluster.KubeletDiskType,
 			Type:                      defaultCluster.Type,
 			OrchestratorVersion:       defaultCluster.OrchestratorVersion,
@@ -1067,6 +1060,13 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 	if err != nil {
 		return nil, err
 	}
+
+	// this isn't returned from the API, since it's only used when cycling the Default Node Pool
+	temporaryNameForRotation := ""
+	if v, ok := d.GetOk("default_node_pool.0.temporary_name_for_rotation"); ok {
+		temporaryNameForRotation = v.(string)
+	}
+
 	return &[]interface{}{
 		map[string]interface{}{
 			"availability_zones":           availabilityZones,
@@ -1087,6 +1087,7 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 			"os_disk_type":                 string(osDiskType),
 			"os_sku":                       string(agentPool.OsSKU),
 			"tags":                         tags.Flatten(agentPool.Tags),
+			"temporary_name_for_rotation":  temporaryNameForRotation,
 			"type":                         string(agentPool.Type),
 			"ultra_ssd_enabled":            enableUltraSSD,
 			"vm_size":                      vmSize,
@@ -1391,3 +1392,80 @@ func findDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolProfil
 
 	return agentPool, nil
 }
+
+// cycledDefaultNodePoolProperties are the properties of the Default Node Pool which can't be updated in-place,
+// changes to which require either the Default Node Pool to be cycled (when `temporary_name_for_rotation` is
+// specified) or the Kubernetes Cluster to be recreated
+var cycledDefaultNodePoolProperties = []string{
+	"availability_zones",
+	"enable_host_encryption",
+	"enable_node_public_ip",
+	"fips_enabled",
+	"kubelet_config",
+	"linux_os_config",
+	"max_pods",
+	"name",
+	"node_labels",
+	"node_public_ip_prefix_id",
+	"only_critical_addons_enabled",
+	"os_disk_size_gb",
+	"os_disk_type",
+	"os_sku",
+	"pod_subnet_id",
+	"proximity_placement_group_id",
+	"ultra_ssd_enabled",
+	"vm_size",
+	"vnet_subnet_id",
+}
+
+// defaultNodePoolRequiresCycling returns whether any of the properties of the Default Node Pool which can't be
+// updated in-place have changed
+func defaultNodePoolRequiresCycling(d interface{ HasChange(string) bool }) bool {
+	for _, property := range cycledDefaultNodePoolProperties {
+		if d.HasChange(fmt.Sprintf("default_node_pool.0.%s", property)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forceNewDefaultNodePoolIfNotCycled marks the Kubernetes Cluster as requiring recreation when any properties
+// of the Default Node Pool which can't be updated in-place have changed and `temporary_name_for_rotation`
+// isn't specified (meaning that the Default Node Pool can't be cycled)
+func forceNewDefaultNodePoolIfNotCycled(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || diff.Get("default_node_pool.0.temporary_name_for_rotation").(string) != "" {
+		return nil
+	}
+
+	schema := SchemaDefaultNodePool().Elem.(*pluginsdk.Resource).Schema
+	for _, property := range cycledDefaultNodePoolProperties {
+		if err := forceNewIfChanged(diff, fmt.Sprintf("default_node_pool.0.%s", property), schema[property]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// forceNewIfChanged marks the key (and any nested keys for blocks) as ForceNew if it has changed
+func forceNewIfChanged(diff *pluginsdk.ResourceDiff, key string, schema *pluginsdk.Schema) error {
+	if !diff.HasChange(key) {
+		return nil
+	}
+
+	if err := diff.ForceNew(key); err != nil {
+		return err
+	}
+
+	// changes within nested blocks are diffed against the nested fields, so these also need to be marked as ForceNew
+	if block, ok := schema.Elem.(*pluginsdk.Resource); ok && schema.MaxItems == 1 {
+		for name, nested := range block.Schema {
+			if err := forceNewIfChanged(diff, fmt.Sprintf("%s.0.%s", key, name), nested); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
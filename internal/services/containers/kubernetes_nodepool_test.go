@@ -0,0 +1,75 @@
+package containers
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenDefaultNodePoolWithAutoProvisionedNodePools(t *testing.T) {
+	// when Node Auto-Provisioning is enabled AKS creates and removes additional Node Pools as required, these
+	// must be ignored when determining the Default Node Pool so that they don't cause a diff
+	agentPools := &[]containerservice.ManagedClusterAgentPoolProfile{
+		{
+			Name:   utils.String("aksnap1"),
+			Count:  utils.Int32(3),
+			VMSize: utils.String("Standard_D4s_v5"),
+			Mode:   containerservice.AgentPoolModeUser,
+		},
+		{
+			Name:   utils.String("aksnap2"),
+			Count:  utils.Int32(1),
+			VMSize: utils.String("Standard_D8s_v5"),
+			Mode:   containerservice.AgentPoolModeSystem,
+		},
+		{
+			Name:   utils.String("default"),
+			Count:  utils.Int32(1),
+			VMSize: utils.String("Standard_DS2_v2"),
+			Mode:   containerservice.AgentPoolModeSystem,
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected string
+	}{
+		{
+			Name: "default node pool configured",
+			Input: map[string]interface{}{
+				"default_node_pool": []interface{}{
+					map[string]interface{}{
+						"name": "default",
+					},
+				},
+			},
+			Expected: "default",
+		},
+		{
+			Name:     "imported",
+			Input:    map[string]interface{}{},
+			Expected: "aksnap2",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := schema.TestResourceDataRaw(t, resourceKubernetesCluster().Schema, v.Input)
+		actual, err := FlattenDefaultNodePool(agentPools, d)
+		if err != nil {
+			t.Fatalf("flattening: %+v", err)
+		}
+		if actual == nil || len(*actual) != 1 {
+			t.Fatalf("expected a single Default Node Pool but got %+v", actual)
+		}
+
+		defaultNodePool := (*actual)[0].(map[string]interface{})
+		if defaultNodePool["name"] != v.Expected {
+			t.Fatalf("expected the Default Node Pool to be %q but got %q", v.Expected, defaultNodePool["name"])
+		}
+	}
+}
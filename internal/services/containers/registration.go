@@ -22,25 +22,27 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_kubernetes_service_versions":  dataSourceKubernetesServiceVersions(),
-		"azurerm_container_registry":           dataSourceContainerRegistry(),
-		"azurerm_container_registry_token":     dataSourceContainerRegistryToken(),
-		"azurerm_container_registry_scope_map": dataSourceContainerRegistryScopeMap(),
-		"azurerm_kubernetes_cluster":           dataSourceKubernetesCluster(),
-		"azurerm_kubernetes_cluster_node_pool": dataSourceKubernetesClusterNodePool(),
+		"azurerm_kubernetes_service_versions":   dataSourceKubernetesServiceVersions(),
+		"azurerm_container_registry":            dataSourceContainerRegistry(),
+		"azurerm_container_registry_token":      dataSourceContainerRegistryToken(),
+		"azurerm_container_registry_scope_map":  dataSourceContainerRegistryScopeMap(),
+		"azurerm_kubernetes_cluster":            dataSourceKubernetesCluster(),
+		"azurerm_kubernetes_cluster_node_pool":  dataSourceKubernetesClusterNodePool(),
+		"azurerm_kubernetes_node_pool_snapshot": dataSourceKubernetesNodePoolSnapshot(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_container_group":              resourceContainerGroup(),
-		"azurerm_container_registry_webhook":   resourceContainerRegistryWebhook(),
-		"azurerm_container_registry":           resourceContainerRegistry(),
-		"azurerm_container_registry_token":     resourceContainerRegistryToken(),
-		"azurerm_container_registry_scope_map": resourceContainerRegistryScopeMap(),
-		"azurerm_kubernetes_cluster":           resourceKubernetesCluster(),
-		"azurerm_kubernetes_cluster_node_pool": resourceKubernetesClusterNodePool(),
+		"azurerm_container_group":               resourceContainerGroup(),
+		"azurerm_container_registry_webhook":    resourceContainerRegistryWebhook(),
+		"azurerm_container_registry":            resourceContainerRegistry(),
+		"azurerm_container_registry_token":      resourceContainerRegistryToken(),
+		"azurerm_container_registry_scope_map":  resourceContainerRegistryScopeMap(),
+		"azurerm_kubernetes_cluster":            resourceKubernetesCluster(),
+		"azurerm_kubernetes_cluster_node_pool":  resourceKubernetesClusterNodePool(),
+		"azurerm_kubernetes_node_pool_snapshot": resourceKubernetesNodePoolSnapshot(),
 	}
 }
 
@@ -50,6 +52,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ContainerRegistryCacheRuleResource{},
+		ContainerRegistryCredentialSetResource{},
 		ContainerRegistryTaskResource{},
+		KubernetesClusterExtensionResource{},
+		KubernetesClusterTrustedAccessRoleBindingResource{},
+		KubernetesFluxConfigurationResource{},
 	}
 }
@@ -0,0 +1,14 @@
+package extensions
+
+type ExtensionProperties struct {
+	AksAssignedIdentity            *ExtensionPropertiesAksAssignedIdentity `json:"aksAssignedIdentity,omitempty"`
+	AutoUpgradeMinorVersion        *bool                                   `json:"autoUpgradeMinorVersion,omitempty"`
+	ConfigurationProtectedSettings *map[string]string                      `json:"configurationProtectedSettings,omitempty"`
+	ConfigurationSettings          *map[string]string                      `json:"configurationSettings,omitempty"`
+	CurrentVersion                 *string                                 `json:"currentVersion,omitempty"`
+	ExtensionType                  *string                                 `json:"extensionType,omitempty"`
+	ProvisioningState              *ProvisioningState                      `json:"provisioningState,omitempty"`
+	ReleaseTrain                   *string                                 `json:"releaseTrain,omitempty"`
+	Scope                          *Scope                                  `json:"scope,omitempty"`
+	Version                        *string                                 `json:"version,omitempty"`
+}
@@ -0,0 +1,5 @@
+package extensions
+
+type PatchExtension struct {
+	Properties *PatchExtensionProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,9 @@
+package extensions
+
+type Plan struct {
+	Name          string  `json:"name"`
+	Product       string  `json:"product"`
+	PromotionCode *string `json:"promotionCode,omitempty"`
+	Publisher     string  `json:"publisher"`
+	Version       *string `json:"version,omitempty"`
+}
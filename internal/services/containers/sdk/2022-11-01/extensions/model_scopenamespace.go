@@ -0,0 +1,5 @@
+package extensions
+
+type ScopeNamespace struct {
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+}
@@ -0,0 +1,9 @@
+package fluxconfiguration
+
+type FluxConfiguration struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *FluxConfigurationProperties `json:"properties,omitempty"`
+	SystemData *SystemData                  `json:"systemData,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package fluxconfiguration
+
+type FluxConfigurationPatch struct {
+	Properties *FluxConfigurationPatchProperties `json:"properties,omitempty"`
+}
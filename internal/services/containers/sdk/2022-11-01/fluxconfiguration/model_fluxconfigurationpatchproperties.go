@@ -0,0 +1,10 @@
+package fluxconfiguration
+
+type FluxConfigurationPatchProperties struct {
+	Bucket                         *BucketPatchDefinition                   `json:"bucket,omitempty"`
+	ConfigurationProtectedSettings *map[string]string                       `json:"configurationProtectedSettings,omitempty"`
+	GitRepository                  *GitRepositoryPatchDefinition            `json:"gitRepository,omitempty"`
+	Kustomizations                 *map[string]KustomizationPatchDefinition `json:"kustomizations,omitempty"`
+	SourceKind                     *SourceKindType                          `json:"sourceKind,omitempty"`
+	Suspend                        *bool                                    `json:"suspend,omitempty"`
+}
@@ -0,0 +1,11 @@
+package fluxconfiguration
+
+type KustomizationPatchDefinition struct {
+	DependsOn              *[]string `json:"dependsOn,omitempty"`
+	Force                  *bool     `json:"force,omitempty"`
+	Path                   *string   `json:"path,omitempty"`
+	Prune                  *bool     `json:"prune,omitempty"`
+	RetryIntervalInSeconds *int64    `json:"retryIntervalInSeconds,omitempty"`
+	SyncIntervalInSeconds  *int64    `json:"syncIntervalInSeconds,omitempty"`
+	TimeoutInSeconds       *int64    `json:"timeoutInSeconds,omitempty"`
+}
@@ -0,0 +1,65 @@
+package registries
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type PolicyStatus string
+
+const (
+	PolicyStatusDisabled PolicyStatus = "disabled"
+	PolicyStatusEnabled  PolicyStatus = "enabled"
+)
+
+func PossibleValuesForPolicyStatus() []string {
+	return []string{
+		string(PolicyStatusDisabled),
+		string(PolicyStatusEnabled),
+	}
+}
+
+func parsePolicyStatus(input string) (*PolicyStatus, error) {
+	vals := map[string]PolicyStatus{
+		"disabled": PolicyStatusDisabled,
+		"enabled":  PolicyStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PolicyStatus(input)
+	return &out, nil
+}
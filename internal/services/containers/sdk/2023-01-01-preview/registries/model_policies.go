@@ -0,0 +1,5 @@
+package registries
+
+type Policies struct {
+	SoftDeletePolicy *SoftDeletePolicy `json:"softDeletePolicy,omitempty"`
+}
@@ -0,0 +1,5 @@
+package registries
+
+type RegistryProperties struct {
+	Policies *Policies `json:"policies,omitempty"`
+}
@@ -0,0 +1,5 @@
+package registries
+
+type RegistryPropertiesUpdateParameters struct {
+	Policies *Policies `json:"policies,omitempty"`
+}
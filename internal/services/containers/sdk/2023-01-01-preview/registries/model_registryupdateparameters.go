@@ -0,0 +1,5 @@
+package registries
+
+type RegistryUpdateParameters struct {
+	Properties *RegistryPropertiesUpdateParameters `json:"properties,omitempty"`
+}
@@ -0,0 +1,7 @@
+package registries
+
+type SoftDeletePolicy struct {
+	LastUpdatedTime *string       `json:"lastUpdatedTime,omitempty"`
+	RetentionDays   *int64        `json:"retentionDays,omitempty"`
+	Status          *PolicyStatus `json:"status,omitempty"`
+}
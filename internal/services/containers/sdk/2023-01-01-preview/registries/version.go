@@ -0,0 +1,9 @@
+package registries
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/registries/%s", defaultApiVersion)
+}
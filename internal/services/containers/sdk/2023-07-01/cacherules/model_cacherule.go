@@ -0,0 +1,9 @@
+package cacherules
+
+type CacheRule struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *CacheRuleProperties `json:"properties,omitempty"`
+	SystemData *SystemData          `json:"systemData,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
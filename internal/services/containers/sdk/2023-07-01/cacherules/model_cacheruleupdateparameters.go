@@ -0,0 +1,5 @@
+package cacherules
+
+type CacheRuleUpdateParameters struct {
+	Properties *CacheRuleUpdateProperties `json:"properties,omitempty"`
+}
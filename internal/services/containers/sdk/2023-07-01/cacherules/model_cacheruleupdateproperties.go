@@ -0,0 +1,5 @@
+package cacherules
+
+type CacheRuleUpdateProperties struct {
+	CredentialSetResourceId *string `json:"credentialSetResourceId,omitempty"`
+}
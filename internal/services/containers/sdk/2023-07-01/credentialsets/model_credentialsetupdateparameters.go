@@ -0,0 +1,10 @@
+package credentialsets
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type CredentialSetUpdateParameters struct {
+	Identity   *identity.SystemAssigned       `json:"identity,omitempty"`
+	Properties *CredentialSetUpdateProperties `json:"properties,omitempty"`
+}
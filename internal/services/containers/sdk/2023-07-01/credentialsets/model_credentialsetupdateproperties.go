@@ -0,0 +1,5 @@
+package credentialsets
+
+type CredentialSetUpdateProperties struct {
+	AuthCredentials *[]AuthCredential `json:"authCredentials,omitempty"`
+}
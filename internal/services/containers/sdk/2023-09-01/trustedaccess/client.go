@@ -0,0 +1,15 @@
+package trustedaccess
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TrustedAccessClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTrustedAccessClientWithBaseURI(endpoint string) TrustedAccessClient {
+	return TrustedAccessClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,40 @@
+package trustedaccess
+
+import "strings"
+
+type TrustedAccessRoleBindingProvisioningState string
+
+const (
+	TrustedAccessRoleBindingProvisioningStateCanceled  TrustedAccessRoleBindingProvisioningState = "Canceled"
+	TrustedAccessRoleBindingProvisioningStateDeleting  TrustedAccessRoleBindingProvisioningState = "Deleting"
+	TrustedAccessRoleBindingProvisioningStateFailed    TrustedAccessRoleBindingProvisioningState = "Failed"
+	TrustedAccessRoleBindingProvisioningStateSucceeded TrustedAccessRoleBindingProvisioningState = "Succeeded"
+	TrustedAccessRoleBindingProvisioningStateUpdating  TrustedAccessRoleBindingProvisioningState = "Updating"
+)
+
+func PossibleValuesForTrustedAccessRoleBindingProvisioningState() []string {
+	return []string{
+		string(TrustedAccessRoleBindingProvisioningStateCanceled),
+		string(TrustedAccessRoleBindingProvisioningStateDeleting),
+		string(TrustedAccessRoleBindingProvisioningStateFailed),
+		string(TrustedAccessRoleBindingProvisioningStateSucceeded),
+		string(TrustedAccessRoleBindingProvisioningStateUpdating),
+	}
+}
+
+func parseTrustedAccessRoleBindingProvisioningState(input string) (*TrustedAccessRoleBindingProvisioningState, error) {
+	vals := map[string]TrustedAccessRoleBindingProvisioningState{
+		"canceled":  TrustedAccessRoleBindingProvisioningStateCanceled,
+		"deleting":  TrustedAccessRoleBindingProvisioningStateDeleting,
+		"failed":    TrustedAccessRoleBindingProvisioningStateFailed,
+		"succeeded": TrustedAccessRoleBindingProvisioningStateSucceeded,
+		"updating":  TrustedAccessRoleBindingProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TrustedAccessRoleBindingProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package trustedaccess
+
+type TrustedAccessRoleBindingProperties struct {
+	ProvisioningState *TrustedAccessRoleBindingProvisioningState `json:"provisioningState,omitempty"`
+	Roles             []string                                   `json:"roles"`
+	SourceResourceId  string                                     `json:"sourceResourceId"`
+}
@@ -0,0 +1,15 @@
+package managedclusters
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ManagedClustersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedClustersClientWithBaseURI(endpoint string) ManagedClustersClient {
+	return ManagedClustersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
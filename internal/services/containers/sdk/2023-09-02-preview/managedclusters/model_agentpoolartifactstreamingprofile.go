@@ -0,0 +1,5 @@
+package managedclusters
+
+type AgentPoolArtifactStreamingProfile struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
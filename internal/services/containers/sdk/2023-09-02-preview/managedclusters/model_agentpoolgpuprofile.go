@@ -0,0 +1,5 @@
+package managedclusters
+
+type AgentPoolGPUProfile struct {
+	InstallGPUDriver *bool `json:"installGPUDriver,omitempty"`
+}
@@ -0,0 +1,7 @@
+package managedclusters
+
+type AgentPoolNetworkProfile struct {
+	AllowedHostPorts          *[]PortRange `json:"allowedHostPorts,omitempty"`
+	ApplicationSecurityGroups *[]string    `json:"applicationSecurityGroups,omitempty"`
+	NodePublicIPTags          *[]IPTag     `json:"nodePublicIPTags,omitempty"`
+}
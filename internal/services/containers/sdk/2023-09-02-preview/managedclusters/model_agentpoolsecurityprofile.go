@@ -0,0 +1,5 @@
+package managedclusters
+
+type AgentPoolSecurityProfile struct {
+	SshAccess *AgentPoolSSHAccess `json:"sshAccess,omitempty"`
+}
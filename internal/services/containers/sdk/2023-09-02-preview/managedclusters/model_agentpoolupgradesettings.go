@@ -0,0 +1,7 @@
+package managedclusters
+
+type AgentPoolUpgradeSettings struct {
+	DrainTimeoutInMinutes     *int64  `json:"drainTimeoutInMinutes,omitempty"`
+	MaxSurge                  *string `json:"maxSurge,omitempty"`
+	NodeSoakDurationInMinutes *int64  `json:"nodeSoakDurationInMinutes,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type AgentPoolWindowsProfile struct {
+	DisableOutboundNat *bool `json:"disableOutboundNat,omitempty"`
+}
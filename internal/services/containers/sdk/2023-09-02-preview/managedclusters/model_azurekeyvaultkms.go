@@ -0,0 +1,8 @@
+package managedclusters
+
+type AzureKeyVaultKms struct {
+	Enabled               *bool                       `json:"enabled,omitempty"`
+	KeyId                 *string                     `json:"keyId,omitempty"`
+	KeyVaultNetworkAccess *KeyVaultNetworkAccessTypes `json:"keyVaultNetworkAccess,omitempty"`
+	KeyVaultResourceId    *string                     `json:"keyVaultResourceId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ClusterUpgradeSettings struct {
+	OverrideSettings *UpgradeOverrideSettings `json:"overrideSettings,omitempty"`
+}
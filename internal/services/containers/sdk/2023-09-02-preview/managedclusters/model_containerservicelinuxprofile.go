@@ -0,0 +1,6 @@
+package managedclusters
+
+type ContainerServiceLinuxProfile struct {
+	AdminUsername string                           `json:"adminUsername"`
+	Ssh           ContainerServiceSshConfiguration `json:"ssh"`
+}
@@ -0,0 +1,21 @@
+package managedclusters
+
+type ContainerServiceNetworkProfile struct {
+	DnsServiceIP        *string                                        `json:"dnsServiceIP,omitempty"`
+	IPFamilies          *[]IPFamily                                    `json:"ipFamilies,omitempty"`
+	KubeProxyConfig     *ContainerServiceNetworkProfileKubeProxyConfig `json:"kubeProxyConfig,omitempty"`
+	LoadBalancerProfile *ManagedClusterLoadBalancerProfile             `json:"loadBalancerProfile,omitempty"`
+	LoadBalancerSku     *LoadBalancerSku                               `json:"loadBalancerSku,omitempty"`
+	Monitoring          *NetworkMonitoring                             `json:"monitoring,omitempty"`
+	NatGatewayProfile   *ManagedClusterNATGatewayProfile               `json:"natGatewayProfile,omitempty"`
+	NetworkDataplane    *NetworkDataplane                              `json:"networkDataplane,omitempty"`
+	NetworkMode         *NetworkMode                                   `json:"networkMode,omitempty"`
+	NetworkPlugin       *NetworkPlugin                                 `json:"networkPlugin,omitempty"`
+	NetworkPluginMode   *NetworkPluginMode                             `json:"networkPluginMode,omitempty"`
+	NetworkPolicy       *NetworkPolicy                                 `json:"networkPolicy,omitempty"`
+	OutboundType        *OutboundType                                  `json:"outboundType,omitempty"`
+	PodCidr             *string                                        `json:"podCidr,omitempty"`
+	PodCidrs            *[]string                                      `json:"podCidrs,omitempty"`
+	ServiceCidr         *string                                        `json:"serviceCidr,omitempty"`
+	ServiceCidrs        *[]string                                      `json:"serviceCidrs,omitempty"`
+}
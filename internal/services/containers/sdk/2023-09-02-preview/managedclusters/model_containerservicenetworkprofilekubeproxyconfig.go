@@ -0,0 +1,7 @@
+package managedclusters
+
+type ContainerServiceNetworkProfileKubeProxyConfig struct {
+	Enabled    *bool                                                    `json:"enabled,omitempty"`
+	IPvsConfig *ContainerServiceNetworkProfileKubeProxyConfigIPvsConfig `json:"ipvsConfig,omitempty"`
+	Mode       *Mode                                                    `json:"mode,omitempty"`
+}
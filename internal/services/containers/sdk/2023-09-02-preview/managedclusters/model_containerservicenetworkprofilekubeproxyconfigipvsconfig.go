@@ -0,0 +1,8 @@
+package managedclusters
+
+type ContainerServiceNetworkProfileKubeProxyConfigIPvsConfig struct {
+	Scheduler            *IPvsScheduler `json:"scheduler,omitempty"`
+	TcpFinTimeoutSeconds *int64         `json:"tcpFinTimeoutSeconds,omitempty"`
+	TcpTimeoutSeconds    *int64         `json:"tcpTimeoutSeconds,omitempty"`
+	UdpTimeoutSeconds    *int64         `json:"udpTimeoutSeconds,omitempty"`
+}
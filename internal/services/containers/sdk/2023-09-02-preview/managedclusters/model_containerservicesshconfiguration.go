@@ -0,0 +1,5 @@
+package managedclusters
+
+type ContainerServiceSshConfiguration struct {
+	PublicKeys []ContainerServiceSshPublicKey `json:"publicKeys"`
+}
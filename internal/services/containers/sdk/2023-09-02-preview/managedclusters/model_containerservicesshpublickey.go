@@ -0,0 +1,5 @@
+package managedclusters
+
+type ContainerServiceSshPublicKey struct {
+	KeyData string `json:"keyData"`
+}
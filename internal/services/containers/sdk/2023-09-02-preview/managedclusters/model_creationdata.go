@@ -0,0 +1,5 @@
+package managedclusters
+
+type CreationData struct {
+	SourceResourceId *string `json:"sourceResourceId,omitempty"`
+}
@@ -0,0 +1,8 @@
+package managedclusters
+
+type GuardrailsProfile struct {
+	ExcludedNamespaces       *[]string `json:"excludedNamespaces,omitempty"`
+	Level                    Level     `json:"level"`
+	SystemExcludedNamespaces *[]string `json:"systemExcludedNamespaces,omitempty"`
+	Version                  *string   `json:"version,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type IstioCertificateAuthority struct {
+	Plugin *IstioPluginCertificateAuthority `json:"plugin,omitempty"`
+}
@@ -0,0 +1,6 @@
+package managedclusters
+
+type IstioComponents struct {
+	EgressGateways  *[]IstioEgressGateway  `json:"egressGateways,omitempty"`
+	IngressGateways *[]IstioIngressGateway `json:"ingressGateways,omitempty"`
+}
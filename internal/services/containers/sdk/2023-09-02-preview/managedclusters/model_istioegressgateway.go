@@ -0,0 +1,6 @@
+package managedclusters
+
+type IstioEgressGateway struct {
+	Enabled      bool               `json:"enabled"`
+	NodeSelector *map[string]string `json:"nodeSelector,omitempty"`
+}
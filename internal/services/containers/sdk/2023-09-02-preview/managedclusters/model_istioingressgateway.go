@@ -0,0 +1,6 @@
+package managedclusters
+
+type IstioIngressGateway struct {
+	Enabled bool                    `json:"enabled"`
+	Mode    IstioIngressGatewayMode `json:"mode"`
+}
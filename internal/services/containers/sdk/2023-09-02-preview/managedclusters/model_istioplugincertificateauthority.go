@@ -0,0 +1,9 @@
+package managedclusters
+
+type IstioPluginCertificateAuthority struct {
+	CertChainObjectName *string `json:"certChainObjectName,omitempty"`
+	CertObjectName      *string `json:"certObjectName,omitempty"`
+	KeyObjectName       *string `json:"keyObjectName,omitempty"`
+	KeyVaultId          *string `json:"keyVaultId,omitempty"`
+	RootCertObjectName  *string `json:"rootCertObjectName,omitempty"`
+}
@@ -0,0 +1,7 @@
+package managedclusters
+
+type IstioServiceMesh struct {
+	CertificateAuthority *IstioCertificateAuthority `json:"certificateAuthority,omitempty"`
+	Components           *IstioComponents           `json:"components,omitempty"`
+	Revisions            *[]string                  `json:"revisions,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type KubernetesPatchVersion struct {
+	Upgrades *[]string `json:"upgrades,omitempty"`
+}
@@ -0,0 +1,8 @@
+package managedclusters
+
+type KubernetesVersion struct {
+	Capabilities  *KubernetesVersionCapabilities     `json:"capabilities,omitempty"`
+	IsPreview     *bool                              `json:"isPreview,omitempty"`
+	PatchVersions *map[string]KubernetesPatchVersion `json:"patchVersions,omitempty"`
+	Version       *string                            `json:"version,omitempty"`
+}
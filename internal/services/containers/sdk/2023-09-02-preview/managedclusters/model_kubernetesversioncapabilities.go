@@ -0,0 +1,5 @@
+package managedclusters
+
+type KubernetesVersionCapabilities struct {
+	SupportPlan *[]KubernetesSupportPlan `json:"supportPlan,omitempty"`
+}
@@ -0,0 +1,8 @@
+package managedclusters
+
+type LinuxOSConfig struct {
+	SwapFileSizeMB             *int64        `json:"swapFileSizeMB,omitempty"`
+	Sysctls                    *SysctlConfig `json:"sysctls,omitempty"`
+	TransparentHugePageDefrag  *string       `json:"transparentHugePageDefrag,omitempty"`
+	TransparentHugePageEnabled *string       `json:"transparentHugePageEnabled,omitempty"`
+}
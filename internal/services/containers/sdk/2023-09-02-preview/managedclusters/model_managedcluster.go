@@ -0,0 +1,16 @@
+package managedclusters
+
+import "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+
+type ManagedCluster struct {
+	ExtendedLocation *ExtendedLocation                 `json:"extendedLocation,omitempty"`
+	Id               *string                           `json:"id,omitempty"`
+	Identity         *identity.SystemOrUserAssignedMap `json:"identity,omitempty"`
+	Location         string                            `json:"location"`
+	Name             *string                           `json:"name,omitempty"`
+	Properties       *ManagedClusterProperties         `json:"properties,omitempty"`
+	Sku              *ManagedClusterSKU                `json:"sku,omitempty"`
+	SystemData       *SystemData                       `json:"systemData,omitempty"`
+	Tags             *map[string]string                `json:"tags,omitempty"`
+	Type             *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package managedclusters
+
+type ManagedClusterAADProfile struct {
+	AdminGroupObjectIDs *[]string `json:"adminGroupObjectIDs,omitempty"`
+	ClientAppID         *string   `json:"clientAppID,omitempty"`
+	EnableAzureRBAC     *bool     `json:"enableAzureRBAC,omitempty"`
+	Managed             *bool     `json:"managed,omitempty"`
+	ServerAppID         *string   `json:"serverAppID,omitempty"`
+	ServerAppSecret     *string   `json:"serverAppSecret,omitempty"`
+	TenantID            *string   `json:"tenantID,omitempty"`
+}
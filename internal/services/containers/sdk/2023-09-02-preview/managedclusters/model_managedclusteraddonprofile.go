@@ -0,0 +1,7 @@
+package managedclusters
+
+type ManagedClusterAddonProfile struct {
+	Config   *map[string]string    `json:"config,omitempty"`
+	Enabled  bool                  `json:"enabled"`
+	Identity *UserAssignedIdentity `json:"identity,omitempty"`
+}
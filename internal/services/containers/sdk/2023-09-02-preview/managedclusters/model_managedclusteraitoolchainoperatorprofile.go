@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterAIToolchainOperatorProfile struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
@@ -0,0 +1,11 @@
+package managedclusters
+
+type ManagedClusterAPIServerAccessProfile struct {
+	AuthorizedIPRanges             *[]string `json:"authorizedIPRanges,omitempty"`
+	DisableRunCommand              *bool     `json:"disableRunCommand,omitempty"`
+	EnablePrivateCluster           *bool     `json:"enablePrivateCluster,omitempty"`
+	EnablePrivateClusterPublicFQDN *bool     `json:"enablePrivateClusterPublicFQDN,omitempty"`
+	EnableVnetIntegration          *bool     `json:"enableVnetIntegration,omitempty"`
+	PrivateDNSZone                 *string   `json:"privateDNSZone,omitempty"`
+	SubnetId                       *string   `json:"subnetId,omitempty"`
+}
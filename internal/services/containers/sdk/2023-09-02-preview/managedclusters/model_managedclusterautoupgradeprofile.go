@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterAutoUpgradeProfile struct {
+	NodeOSUpgradeChannel *NodeOSUpgradeChannel `json:"nodeOSUpgradeChannel,omitempty"`
+	UpgradeChannel       *UpgradeChannel       `json:"upgradeChannel,omitempty"`
+}
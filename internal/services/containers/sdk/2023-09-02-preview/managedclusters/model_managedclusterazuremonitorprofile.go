@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfile struct {
+	Logs    *ManagedClusterAzureMonitorProfileLogs    `json:"logs,omitempty"`
+	Metrics *ManagedClusterAzureMonitorProfileMetrics `json:"metrics,omitempty"`
+}
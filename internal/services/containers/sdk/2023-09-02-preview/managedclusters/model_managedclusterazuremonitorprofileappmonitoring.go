@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfileAppMonitoring struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfileAppMonitoringOpenTelemetryMetrics struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
@@ -0,0 +1,7 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfileContainerInsights struct {
+	Enabled                         *bool                                             `json:"enabled,omitempty"`
+	LogAnalyticsWorkspaceResourceId *string                                           `json:"logAnalyticsWorkspaceResourceId,omitempty"`
+	WindowsHostLogs                 *ManagedClusterAzureMonitorProfileWindowsHostLogs `json:"windowsHostLogs,omitempty"`
+}
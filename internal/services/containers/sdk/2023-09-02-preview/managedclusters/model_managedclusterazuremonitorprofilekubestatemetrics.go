@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfileKubeStateMetrics struct {
+	MetricAnnotationsAllowList *string `json:"metricAnnotationsAllowList,omitempty"`
+	MetricLabelsAllowlist      *string `json:"metricLabelsAllowlist,omitempty"`
+}
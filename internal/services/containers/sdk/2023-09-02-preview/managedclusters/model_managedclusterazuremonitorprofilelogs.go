@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfileLogs struct {
+	AppMonitoring     *ManagedClusterAzureMonitorProfileAppMonitoring     `json:"appMonitoring,omitempty"`
+	ContainerInsights *ManagedClusterAzureMonitorProfileContainerInsights `json:"containerInsights,omitempty"`
+}
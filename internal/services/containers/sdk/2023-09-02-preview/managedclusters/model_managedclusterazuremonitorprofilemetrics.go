@@ -0,0 +1,7 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfileMetrics struct {
+	AppMonitoringOpenTelemetryMetrics *ManagedClusterAzureMonitorProfileAppMonitoringOpenTelemetryMetrics `json:"appMonitoringOpenTelemetryMetrics,omitempty"`
+	Enabled                           bool                                                                `json:"enabled"`
+	KubeStateMetrics                  *ManagedClusterAzureMonitorProfileKubeStateMetrics                  `json:"kubeStateMetrics,omitempty"`
+}
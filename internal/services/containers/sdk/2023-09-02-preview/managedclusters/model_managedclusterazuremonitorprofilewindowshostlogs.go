@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterAzureMonitorProfileWindowsHostLogs struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
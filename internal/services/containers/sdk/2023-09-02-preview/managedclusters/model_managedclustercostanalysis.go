@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterCostAnalysis struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
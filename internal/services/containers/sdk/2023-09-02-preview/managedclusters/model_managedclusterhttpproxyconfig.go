@@ -0,0 +1,9 @@
+package managedclusters
+
+type ManagedClusterHTTPProxyConfig struct {
+	EffectiveNoProxy *[]string `json:"effectiveNoProxy,omitempty"`
+	HTTPProxy        *string   `json:"httpProxy,omitempty"`
+	HTTPSProxy       *string   `json:"httpsProxy,omitempty"`
+	NoProxy          *[]string `json:"noProxy,omitempty"`
+	TrustedCa        *string   `json:"trustedCa,omitempty"`
+}
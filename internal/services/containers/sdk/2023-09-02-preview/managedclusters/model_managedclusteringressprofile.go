@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterIngressProfile struct {
+	WebAppRouting *ManagedClusterIngressProfileWebAppRouting `json:"webAppRouting,omitempty"`
+}
@@ -0,0 +1,7 @@
+package managedclusters
+
+type ManagedClusterIngressProfileWebAppRouting struct {
+	DnsZoneResourceIds *[]string             `json:"dnsZoneResourceIds,omitempty"`
+	Enabled            *bool                 `json:"enabled,omitempty"`
+	Identity           *UserAssignedIdentity `json:"identity,omitempty"`
+}
@@ -0,0 +1,12 @@
+package managedclusters
+
+type ManagedClusterLoadBalancerProfile struct {
+	AllocatedOutboundPorts              *int64                                               `json:"allocatedOutboundPorts,omitempty"`
+	BackendPoolType                     *BackendPoolType                                     `json:"backendPoolType,omitempty"`
+	EffectiveOutboundIPs                *[]ResourceReference                                 `json:"effectiveOutboundIPs,omitempty"`
+	EnableMultipleStandardLoadBalancers *bool                                                `json:"enableMultipleStandardLoadBalancers,omitempty"`
+	IdleTimeoutInMinutes                *int64                                               `json:"idleTimeoutInMinutes,omitempty"`
+	ManagedOutboundIPs                  *ManagedClusterLoadBalancerProfileManagedOutboundIPs `json:"managedOutboundIPs,omitempty"`
+	OutboundIPPrefixes                  *ManagedClusterLoadBalancerProfileOutboundIPPrefixes `json:"outboundIPPrefixes,omitempty"`
+	OutboundIPs                         *ManagedClusterLoadBalancerProfileOutboundIPs        `json:"outboundIPs,omitempty"`
+}
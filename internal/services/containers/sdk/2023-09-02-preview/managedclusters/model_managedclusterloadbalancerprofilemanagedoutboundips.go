@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterLoadBalancerProfileManagedOutboundIPs struct {
+	Count     *int64 `json:"count,omitempty"`
+	CountIPv6 *int64 `json:"countIPv6,omitempty"`
+}
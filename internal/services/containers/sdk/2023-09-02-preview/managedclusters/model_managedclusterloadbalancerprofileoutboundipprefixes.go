@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterLoadBalancerProfileOutboundIPPrefixes struct {
+	PublicIPPrefixes *[]ResourceReference `json:"publicIPPrefixes,omitempty"`
+}
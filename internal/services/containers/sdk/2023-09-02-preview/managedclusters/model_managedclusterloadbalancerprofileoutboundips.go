@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterLoadBalancerProfileOutboundIPs struct {
+	PublicIPs *[]ResourceReference `json:"publicIPs,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterManagedOutboundIPProfile struct {
+	Count *int64 `json:"count,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterMetricsProfile struct {
+	CostAnalysis *ManagedClusterCostAnalysis `json:"costAnalysis,omitempty"`
+}
@@ -0,0 +1,7 @@
+package managedclusters
+
+type ManagedClusterNATGatewayProfile struct {
+	EffectiveOutboundIPs     *[]ResourceReference                    `json:"effectiveOutboundIPs,omitempty"`
+	IdleTimeoutInMinutes     *int64                                  `json:"idleTimeoutInMinutes,omitempty"`
+	ManagedOutboundIPProfile *ManagedClusterManagedOutboundIPProfile `json:"managedOutboundIPProfile,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterNodeProvisioningProfile struct {
+	Mode *NodeProvisioningMode `json:"mode,omitempty"`
+}
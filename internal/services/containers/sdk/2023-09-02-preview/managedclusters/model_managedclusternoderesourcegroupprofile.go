@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterNodeResourceGroupProfile struct {
+	RestrictionLevel *RestrictionLevel `json:"restrictionLevel,omitempty"`
+}
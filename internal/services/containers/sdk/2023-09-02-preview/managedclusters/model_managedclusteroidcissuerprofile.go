@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterOIDCIssuerProfile struct {
+	Enabled   *bool   `json:"enabled,omitempty"`
+	IssuerURL *string `json:"issuerURL,omitempty"`
+}
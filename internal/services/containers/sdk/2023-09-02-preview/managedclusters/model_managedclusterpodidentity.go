@@ -0,0 +1,10 @@
+package managedclusters
+
+type ManagedClusterPodIdentity struct {
+	BindingSelector   *string                                     `json:"bindingSelector,omitempty"`
+	Identity          UserAssignedIdentity                        `json:"identity"`
+	Name              string                                      `json:"name"`
+	Namespace         string                                      `json:"namespace"`
+	ProvisioningInfo  *ManagedClusterPodIdentityProvisioningInfo  `json:"provisioningInfo,omitempty"`
+	ProvisioningState *ManagedClusterPodIdentityProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,7 @@
+package managedclusters
+
+type ManagedClusterPodIdentityException struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	PodLabels map[string]string `json:"podLabels"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterPodIdentityProvisioningError struct {
+	Error *ManagedClusterPodIdentityProvisioningErrorBody `json:"error,omitempty"`
+}
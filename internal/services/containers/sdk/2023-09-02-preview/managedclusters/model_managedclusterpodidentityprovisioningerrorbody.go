@@ -0,0 +1,8 @@
+package managedclusters
+
+type ManagedClusterPodIdentityProvisioningErrorBody struct {
+	Code    *string                                           `json:"code,omitempty"`
+	Details *[]ManagedClusterPodIdentityProvisioningErrorBody `json:"details,omitempty"`
+	Message *string                                           `json:"message,omitempty"`
+	Target  *string                                           `json:"target,omitempty"`
+}
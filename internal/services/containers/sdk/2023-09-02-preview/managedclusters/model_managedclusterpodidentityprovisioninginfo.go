@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterPodIdentityProvisioningInfo struct {
+	Error *ManagedClusterPodIdentityProvisioningError `json:"error,omitempty"`
+}
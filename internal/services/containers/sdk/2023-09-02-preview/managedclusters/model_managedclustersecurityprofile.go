@@ -0,0 +1,11 @@
+package managedclusters
+
+type ManagedClusterSecurityProfile struct {
+	AzureKeyVaultKms          *AzureKeyVaultKms                              `json:"azureKeyVaultKms,omitempty"`
+	CustomCATrustCertificates *[]string                                      `json:"customCATrustCertificates,omitempty"`
+	Defender                  *ManagedClusterSecurityProfileDefender         `json:"defender,omitempty"`
+	ImageCleaner              *ManagedClusterSecurityProfileImageCleaner     `json:"imageCleaner,omitempty"`
+	ImageIntegrity            *ManagedClusterSecurityProfileImageIntegrity   `json:"imageIntegrity,omitempty"`
+	NodeRestriction           *ManagedClusterSecurityProfileNodeRestriction  `json:"nodeRestriction,omitempty"`
+	WorkloadIdentity          *ManagedClusterSecurityProfileWorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterSecurityProfileDefender struct {
+	LogAnalyticsWorkspaceResourceId *string                                                  `json:"logAnalyticsWorkspaceResourceId,omitempty"`
+	SecurityMonitoring              *ManagedClusterSecurityProfileDefenderSecurityMonitoring `json:"securityMonitoring,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterSecurityProfileDefenderSecurityMonitoring struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
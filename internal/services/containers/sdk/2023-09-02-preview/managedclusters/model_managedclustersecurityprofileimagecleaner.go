@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterSecurityProfileImageCleaner struct {
+	Enabled       *bool  `json:"enabled,omitempty"`
+	IntervalHours *int64 `json:"intervalHours,omitempty"`
+}
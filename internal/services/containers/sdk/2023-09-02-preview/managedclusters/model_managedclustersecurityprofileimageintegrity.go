@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterSecurityProfileImageIntegrity struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
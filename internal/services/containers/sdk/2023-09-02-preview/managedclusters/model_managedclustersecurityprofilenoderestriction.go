@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterSecurityProfileNodeRestriction struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
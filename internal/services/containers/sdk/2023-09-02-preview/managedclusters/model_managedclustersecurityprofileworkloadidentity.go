@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterSecurityProfileWorkloadIdentity struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
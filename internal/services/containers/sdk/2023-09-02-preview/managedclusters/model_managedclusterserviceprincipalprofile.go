@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterServicePrincipalProfile struct {
+	ClientId string  `json:"clientId"`
+	Secret   *string `json:"secret,omitempty"`
+}
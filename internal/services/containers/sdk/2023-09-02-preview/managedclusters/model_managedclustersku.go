@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterSKU struct {
+	Name *ManagedClusterSKUName `json:"name,omitempty"`
+	Tier *ManagedClusterSKUTier `json:"tier,omitempty"`
+}
@@ -0,0 +1,8 @@
+package managedclusters
+
+type ManagedClusterStorageProfile struct {
+	BlobCSIDriver      *ManagedClusterStorageProfileBlobCSIDriver      `json:"blobCSIDriver,omitempty"`
+	DiskCSIDriver      *ManagedClusterStorageProfileDiskCSIDriver      `json:"diskCSIDriver,omitempty"`
+	FileCSIDriver      *ManagedClusterStorageProfileFileCSIDriver      `json:"fileCSIDriver,omitempty"`
+	SnapshotController *ManagedClusterStorageProfileSnapshotController `json:"snapshotController,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterStorageProfileBlobCSIDriver struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
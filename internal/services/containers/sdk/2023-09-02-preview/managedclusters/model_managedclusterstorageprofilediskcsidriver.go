@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterStorageProfileDiskCSIDriver struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
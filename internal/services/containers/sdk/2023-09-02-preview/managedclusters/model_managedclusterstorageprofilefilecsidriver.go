@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterStorageProfileFileCSIDriver struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterStorageProfileSnapshotController struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
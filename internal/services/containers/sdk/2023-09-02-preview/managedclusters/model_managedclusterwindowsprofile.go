@@ -0,0 +1,9 @@
+package managedclusters
+
+type ManagedClusterWindowsProfile struct {
+	AdminPassword  *string             `json:"adminPassword,omitempty"`
+	AdminUsername  string              `json:"adminUsername"`
+	EnableCSIProxy *bool               `json:"enableCSIProxy,omitempty"`
+	GmsaProfile    *WindowsGmsaProfile `json:"gmsaProfile,omitempty"`
+	LicenseType    *LicenseType        `json:"licenseType,omitempty"`
+}
@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterWorkloadAutoScalerProfile struct {
+	Keda                  *ManagedClusterWorkloadAutoScalerProfileKeda                  `json:"keda,omitempty"`
+	VerticalPodAutoscaler *ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler `json:"verticalPodAutoscaler,omitempty"`
+}
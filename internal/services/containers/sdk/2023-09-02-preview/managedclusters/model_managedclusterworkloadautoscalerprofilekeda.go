@@ -0,0 +1,5 @@
+package managedclusters
+
+type ManagedClusterWorkloadAutoScalerProfileKeda struct {
+	Enabled bool `json:"enabled"`
+}
@@ -0,0 +1,6 @@
+package managedclusters
+
+type ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler struct {
+	AddonAutoscaling *AddonAutoscaling `json:"addonAutoscaling,omitempty"`
+	Enabled          bool              `json:"enabled"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type NetworkMonitoring struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
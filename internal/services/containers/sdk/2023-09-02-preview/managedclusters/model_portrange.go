@@ -0,0 +1,7 @@
+package managedclusters
+
+type PortRange struct {
+	PortEnd   *int64    `json:"portEnd,omitempty"`
+	PortStart *int64    `json:"portStart,omitempty"`
+	Protocol  *Protocol `json:"protocol,omitempty"`
+}
@@ -0,0 +1,5 @@
+package managedclusters
+
+type PowerState struct {
+	Code *Code `json:"code,omitempty"`
+}
@@ -0,0 +1,10 @@
+package managedclusters
+
+type PrivateLinkResource struct {
+	GroupId              *string   `json:"groupId,omitempty"`
+	Id                   *string   `json:"id,omitempty"`
+	Name                 *string   `json:"name,omitempty"`
+	PrivateLinkServiceID *string   `json:"privateLinkServiceID,omitempty"`
+	RequiredMembers      *[]string `json:"requiredMembers,omitempty"`
+	Type                 *string   `json:"type,omitempty"`
+}
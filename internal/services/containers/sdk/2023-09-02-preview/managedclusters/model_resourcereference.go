@@ -0,0 +1,5 @@
+package managedclusters
+
+type ResourceReference struct {
+	Id *string `json:"id,omitempty"`
+}
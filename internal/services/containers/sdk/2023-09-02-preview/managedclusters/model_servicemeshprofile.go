@@ -0,0 +1,6 @@
+package managedclusters
+
+type ServiceMeshProfile struct {
+	Istio *IstioServiceMesh `json:"istio,omitempty"`
+	Mode  ServiceMeshMode   `json:"mode"`
+}
@@ -0,0 +1,7 @@
+package managedclusters
+
+type UserAssignedIdentity struct {
+	ClientId   *string `json:"clientId,omitempty"`
+	ObjectId   *string `json:"objectId,omitempty"`
+	ResourceId *string `json:"resourceId,omitempty"`
+}
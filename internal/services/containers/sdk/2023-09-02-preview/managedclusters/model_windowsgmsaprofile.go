@@ -0,0 +1,7 @@
+package managedclusters
+
+type WindowsGmsaProfile struct {
+	DnsServer      *string `json:"dnsServer,omitempty"`
+	Enabled        *bool   `json:"enabled,omitempty"`
+	RootDomainName *string `json:"rootDomainName,omitempty"`
+}
@@ -0,0 +1,9 @@
+package managedclusters
+
+import "fmt"
+
+const defaultApiVersion = "2023-09-02-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/managedclusters/%s", defaultApiVersion)
+}
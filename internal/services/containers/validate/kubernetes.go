@@ -37,3 +37,36 @@ func KubernetesDNSPrefix(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func KubernetesNodePoolSnapshotName(i interface{}, k string) (warnings []string, errors []error) {
+	snapshotName := i.(string)
+
+	re := regexp.MustCompile(`^[a-zA-Z0-9]$|^[a-zA-Z0-9][-_a-zA-Z0-9]{0,61}[a-zA-Z0-9]$`)
+	if re != nil && !re.MatchString(snapshotName) {
+		errors = append(errors, fmt.Errorf("%s must start and end with a letter or number, have max length of 63, and only have characters a-zA-Z0-9, underscores and hyphens. Got %q.", k, snapshotName))
+	}
+
+	return warnings, errors
+}
+
+func KubernetesClusterExtensionName(i interface{}, k string) (warnings []string, errors []error) {
+	extensionName := i.(string)
+
+	re := regexp.MustCompile(`^[a-z0-9]$|^[a-z0-9][-.a-z0-9]{0,251}[a-z0-9]$`)
+	if re != nil && !re.MatchString(extensionName) {
+		errors = append(errors, fmt.Errorf("%s must start and end with a lowercase letter or number, have max length of 253, and only have characters a-z0-9, periods and hyphens. Got %q.", k, extensionName))
+	}
+
+	return warnings, errors
+}
+
+func KubernetesFluxConfigurationName(i interface{}, k string) (warnings []string, errors []error) {
+	configurationName := i.(string)
+
+	re := regexp.MustCompile(`^[a-z0-9]$|^[a-z0-9][-.a-z0-9]{0,28}[a-z0-9]$`)
+	if re != nil && !re.MatchString(configurationName) {
+		errors = append(errors, fmt.Errorf("%s must start and end with a lowercase letter or number, have max length of 30, and only have characters a-z0-9, periods and hyphens. Got %q.", k, configurationName))
+	}
+
+	return warnings, errors
+}
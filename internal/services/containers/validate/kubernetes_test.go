@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -133,3 +134,149 @@ func TestKubernetesDNSPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestKubernetesNodePoolSnapshotName(t *testing.T) {
+	cases := []struct {
+		SnapshotName string
+		Errors       int
+	}{
+		{
+			SnapshotName: "",
+			Errors:       1,
+		},
+		{
+			SnapshotName: "a",
+			Errors:       0,
+		},
+		{
+			SnapshotName: "snapshot1",
+			Errors:       0,
+		},
+		{
+			SnapshotName: "Node-Pool_Snapshot1",
+			Errors:       0,
+		},
+		{
+			SnapshotName: "-snapshot",
+			Errors:       1,
+		},
+		{
+			SnapshotName: "snapshot_",
+			Errors:       1,
+		},
+		{
+			SnapshotName: "snap.shot",
+			Errors:       1,
+		},
+		{
+			SnapshotName: strings.Repeat("a", 63),
+			Errors:       0,
+		},
+		{
+			SnapshotName: strings.Repeat("a", 64),
+			Errors:       1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.SnapshotName, func(t *testing.T) {
+			_, errors := KubernetesNodePoolSnapshotName(tc.SnapshotName, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected SnapshotName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestKubernetesClusterExtensionName(t *testing.T) {
+	cases := []struct {
+		ExtensionName string
+		Errors        int
+	}{
+		{
+			ExtensionName: "",
+			Errors:        1,
+		},
+		{
+			ExtensionName: "flux",
+			Errors:        0,
+		},
+		{
+			ExtensionName: "microsoft.flux-1",
+			Errors:        0,
+		},
+		{
+			ExtensionName: "Flux",
+			Errors:        1,
+		},
+		{
+			ExtensionName: "flux-",
+			Errors:        1,
+		},
+		{
+			ExtensionName: strings.Repeat("a", 253),
+			Errors:        0,
+		},
+		{
+			ExtensionName: strings.Repeat("a", 254),
+			Errors:        1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ExtensionName, func(t *testing.T) {
+			_, errors := KubernetesClusterExtensionName(tc.ExtensionName, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ExtensionName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestKubernetesFluxConfigurationName(t *testing.T) {
+	cases := []struct {
+		ConfigurationName string
+		Errors            int
+	}{
+		{
+			ConfigurationName: "",
+			Errors:            1,
+		},
+		{
+			ConfigurationName: "gitops",
+			Errors:            0,
+		},
+		{
+			ConfigurationName: "cluster-config.1",
+			Errors:            0,
+		},
+		{
+			ConfigurationName: "-gitops",
+			Errors:            1,
+		},
+		{
+			ConfigurationName: "git_ops",
+			Errors:            1,
+		},
+		{
+			ConfigurationName: strings.Repeat("a", 30),
+			Errors:            0,
+		},
+		{
+			ConfigurationName: strings.Repeat("a", 31),
+			Errors:            1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ConfigurationName, func(t *testing.T) {
+			_, errors := KubernetesFluxConfigurationName(tc.ConfigurationName, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ConfigurationName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
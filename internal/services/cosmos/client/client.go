@@ -3,12 +3,14 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2023-03-15-preview/cosmosdb"
 )
 
 type Client struct {
 	CassandraClient                  *documentdb.CassandraResourcesClient
 	CassandraClustersClient          *documentdb.CassandraClustersClient
 	CassandraDatacentersClient       *documentdb.CassandraDataCentersClient
+	CosmosDBPreviewClient            *cosmosdb.CosmosDBClient
 	DatabaseClient                   *documentdb.DatabaseAccountsClient
 	GremlinClient                    *documentdb.GremlinResourcesClient
 	MongoDbClient                    *documentdb.MongoDBResourcesClient
@@ -29,6 +31,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	cassandraDatacentersClient := documentdb.NewCassandraDataCentersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&cassandraDatacentersClient.Client, o.ResourceManagerAuthorizer)
 
+	cosmosDBPreviewClient := cosmosdb.NewCosmosDBClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cosmosDBPreviewClient.Client, o.ResourceManagerAuthorizer)
+
 	databaseClient := documentdb.NewDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&databaseClient.Client, o.ResourceManagerAuthorizer)
 
@@ -57,6 +62,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		CassandraClient:                  &cassandraClient,
 		CassandraClustersClient:          &cassandraClustersClient,
 		CassandraDatacentersClient:       &cassandraDatacentersClient,
+		CosmosDBPreviewClient:            &cosmosDBPreviewClient,
 		DatabaseClient:                   &databaseClient,
 		GremlinClient:                    &gremlinClient,
 		MongoDbClient:                    &mongoDbClient,
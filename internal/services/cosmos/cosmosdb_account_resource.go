@@ -213,6 +213,18 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"burst_capacity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"partition_merge_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"enable_automatic_failover": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -765,6 +777,17 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("Cannot read CosmosDB Account '%s' (resource group %s) ID", name, resourceGroup)
 	}
 
+	previewFeatures := cosmosDbAccountPreviewFeatures{
+		BurstCapacityEnabled:  d.Get("burst_capacity_enabled").(bool),
+		PartitionMergeEnabled: d.Get("partition_merge_enabled").(bool),
+	}
+	if previewFeatures.BurstCapacityEnabled || previewFeatures.PartitionMergeEnabled {
+		accountId := parse.NewDatabaseAccountID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, name)
+		if err := updateCosmosDbAccountPreviewFeatures(ctx, meta.(*clients.Client).Cosmos.CosmosDBPreviewClient, accountId, previewFeatures); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(*id)
 
 	return resourceCosmosDbAccountRead(d, meta)
@@ -949,6 +972,18 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("Cannot read CosmosDB Account '%s' (resource group %s) ID", name, resourceGroup)
 	}
 
+	// the upserts above don't include these properties, as such they're re-applied whenever they're enabled
+	previewFeatures := cosmosDbAccountPreviewFeatures{
+		BurstCapacityEnabled:  d.Get("burst_capacity_enabled").(bool),
+		PartitionMergeEnabled: d.Get("partition_merge_enabled").(bool),
+	}
+	if d.HasChanges("burst_capacity_enabled", "partition_merge_enabled") || previewFeatures.BurstCapacityEnabled || previewFeatures.PartitionMergeEnabled {
+		accountId := parse.NewDatabaseAccountID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, name)
+		if err := updateCosmosDbAccountPreviewFeatures(ctx, meta.(*clients.Client).Cosmos.CosmosDBPreviewClient, accountId, previewFeatures); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(*upsertResponse.ID)
 
 	return resourceCosmosDbAccountRead(d, meta)
@@ -1148,6 +1183,13 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 	d.Set("connection_strings", connStrings)
 
+	previewFeatures, err := retrieveCosmosDbAccountPreviewFeatures(ctx, meta.(*clients.Client).Cosmos.CosmosDBPreviewClient, *id)
+	if err != nil {
+		return err
+	}
+	d.Set("burst_capacity_enabled", previewFeatures.BurstCapacityEnabled)
+	d.Set("partition_merge_enabled", previewFeatures.PartitionMergeEnabled)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
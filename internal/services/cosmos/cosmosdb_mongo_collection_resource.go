@@ -96,6 +96,8 @@ func resourceCosmosDbMongoCollection() *pluginsdk.Resource {
 
 			"autoscale_settings": common.MongoCollectionAutoscaleSettingsSchema(),
 
+			"partition_merge_trigger": schemaCosmosDbPartitionMergeTrigger(),
+
 			"index": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -283,6 +285,13 @@ func resourceCosmosDbMongoCollectionUpdate(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
+	// a Partition Merge is triggered whenever the value of `partition_merge_trigger` changes
+	if d.HasChange("partition_merge_trigger") && d.Get("partition_merge_trigger").(string) != "" {
+		if err := mergeCosmosDbMongoCollectionPartitions(ctx, meta.(*clients.Client).Cosmos.CosmosDBPreviewClient, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceCosmosDbMongoCollectionRead(d, meta)
 }
 
@@ -206,6 +206,28 @@ func TestAccCosmosDbMongoCollection_serverless(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDbMongoCollection_partitionMerge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_collection", "test")
+	r := CosmosMongoCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.partitionMerge(data, "first"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("partition_merge_trigger"),
+		{
+			Config: r.partitionMerge(data, "second"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("partition_merge_trigger"),
+	})
+}
+
 func (t CosmosMongoCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.MongodbCollectionID(state.ID)
 	if err != nil {
@@ -238,6 +260,60 @@ resource "azurerm_cosmosdb_mongo_collection" "test" {
 `, CosmosMongoDatabaseResource{}.basic(data), data.RandomInteger)
 }
 
+func (CosmosMongoCollectionResource) partitionMerge(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                    = "acctest-ca-%[1]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  offer_type              = "Standard"
+  kind                    = "MongoDB"
+  partition_merge_enabled = true
+
+  capabilities {
+    name = "EnableMongo"
+  }
+
+  consistency_policy {
+    consistency_level = "Strong"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_mongo_collection" "test" {
+  name                    = "acctest-%[1]d"
+  resource_group_name     = azurerm_cosmosdb_mongo_database.test.resource_group_name
+  account_name            = azurerm_cosmosdb_mongo_database.test.account_name
+  database_name           = azurerm_cosmosdb_mongo_database.test.name
+  partition_merge_trigger = "%[3]s"
+
+  index {
+    keys   = ["_id"]
+    unique = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, trigger)
+}
+
 func (CosmosMongoCollectionResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
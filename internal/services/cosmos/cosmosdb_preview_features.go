@@ -0,0 +1,117 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2023-03-15-preview/cosmosdb"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// NOTE: Burst Capacity and Partition Merge are only available in a newer (Preview) API version than the one used for
+// the Cosmos DB Account itself, as such these are configured and read back using a separate client
+
+type cosmosDbAccountPreviewFeatures struct {
+	BurstCapacityEnabled  bool
+	PartitionMergeEnabled bool
+}
+
+func schemaCosmosDbPartitionMergeTrigger() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+}
+
+func updateCosmosDbAccountPreviewFeatures(ctx context.Context, client *cosmosdb.CosmosDBClient, id parse.DatabaseAccountId, input cosmosDbAccountPreviewFeatures) error {
+	accountId := cosmosdb.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	parameters := cosmosdb.DatabaseAccountUpdateParameters{
+		Properties: &cosmosdb.DatabaseAccountUpdateProperties{
+			EnableBurstCapacity:  utils.Bool(input.BurstCapacityEnabled),
+			EnablePartitionMerge: utils.Bool(input.PartitionMergeEnabled),
+		},
+	}
+
+	result, err := client.DatabaseAccountsUpdate(ctx, accountId, parameters)
+	if err != nil {
+		if result.HttpResponse != nil && result.HttpResponse.StatusCode == http.StatusBadRequest {
+			return fmt.Errorf("updating `burst_capacity_enabled` and `partition_merge_enabled` for %s - both features are in Preview and may need to be registered for this Subscription prior to use: %+v", accountId, err)
+		}
+		return fmt.Errorf("updating `burst_capacity_enabled` and `partition_merge_enabled` for %s: %+v", accountId, err)
+	}
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("waiting for the update of `burst_capacity_enabled` and `partition_merge_enabled` for %s: %+v", accountId, err)
+	}
+
+	return nil
+}
+
+func retrieveCosmosDbAccountPreviewFeatures(ctx context.Context, client *cosmosdb.CosmosDBClient, id parse.DatabaseAccountId) (*cosmosDbAccountPreviewFeatures, error) {
+	accountId := cosmosdb.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	resp, err := client.DatabaseAccountsGet(ctx, accountId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	output := cosmosDbAccountPreviewFeatures{}
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if v := model.Properties.EnableBurstCapacity; v != nil {
+			output.BurstCapacityEnabled = *v
+		}
+		if v := model.Properties.EnablePartitionMerge; v != nil {
+			output.PartitionMergeEnabled = *v
+		}
+	}
+	return &output, nil
+}
+
+// checkCosmosDbAccountPartitionMergeEnabled returns an error when Partition Merge hasn't been enabled on the
+// Cosmos DB Account, since the API otherwise only fails once the Partition Merge has been started
+func checkCosmosDbAccountPartitionMergeEnabled(ctx context.Context, client *cosmosdb.CosmosDBClient, id parse.DatabaseAccountId) error {
+	features, err := retrieveCosmosDbAccountPreviewFeatures(ctx, client, id)
+	if err != nil {
+		return err
+	}
+	if !features.PartitionMergeEnabled {
+		return fmt.Errorf("`partition_merge_enabled` must be enabled on the Cosmos DB Account %q (Resource Group %q) to merge partitions", id.Name, id.ResourceGroup)
+	}
+	return nil
+}
+
+func mergeCosmosDbSQLContainerPartitions(ctx context.Context, client *cosmosdb.CosmosDBClient, id parse.SqlContainerId) error {
+	if err := checkCosmosDbAccountPartitionMergeEnabled(ctx, client, parse.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName)); err != nil {
+		return err
+	}
+
+	containerId := cosmosdb.NewContainerID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	parameters := cosmosdb.MergeParameters{
+		IsDryRun: utils.Bool(false),
+	}
+	if err := client.SqlResourcesSqlContainerPartitionMergeThenPoll(ctx, containerId, parameters); err != nil {
+		return fmt.Errorf("merging the partitions of %s: %+v", containerId, err)
+	}
+
+	return nil
+}
+
+func mergeCosmosDbMongoCollectionPartitions(ctx context.Context, client *cosmosdb.CosmosDBClient, id parse.MongodbCollectionId) error {
+	if err := checkCosmosDbAccountPartitionMergeEnabled(ctx, client, parse.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName)); err != nil {
+		return err
+	}
+
+	collectionId := cosmosdb.NewMongodbDatabaseCollectionID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
+	parameters := cosmosdb.MergeParameters{
+		IsDryRun: utils.Bool(false),
+	}
+	if err := client.MongoDBResourcesMongoDBContainerPartitionMergeThenPoll(ctx, collectionId, parameters); err != nil {
+		return fmt.Errorf("merging the partitions of %s: %+v", collectionId, err)
+	}
+
+	return nil
+}
@@ -91,6 +91,8 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 
+			"partition_merge_trigger": schemaCosmosDbPartitionMergeTrigger(),
+
 			"analytical_storage_ttl": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -308,6 +310,13 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
+	// a Partition Merge is triggered whenever the value of `partition_merge_trigger` changes
+	if d.HasChange("partition_merge_trigger") && d.Get("partition_merge_trigger").(string) != "" {
+		if err := mergeCosmosDbSQLContainerPartitions(ctx, meta.(*clients.Client).Cosmos.CosmosDBPreviewClient, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceCosmosDbSQLContainerRead(d, meta)
 }
 
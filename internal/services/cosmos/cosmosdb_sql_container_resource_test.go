@@ -223,6 +223,28 @@ func TestAccCosmosDbSqlContainer_customConflictResolutionPolicy(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDbSqlContainer_partitionMerge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.partitionMerge(data, "first"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("partition_merge_trigger"),
+		{
+			Config: r.partitionMerge(data, "second"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("partition_merge_trigger"),
+	})
+}
+
 func (t CosmosSqlContainerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SqlContainerID(state.ID)
 	if err != nil {
@@ -251,6 +273,27 @@ resource "azurerm_cosmosdb_sql_container" "test" {
 `, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
 }
 
+func (CosmosSqlContainerResource) partitionMerge(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                    = "acctest-CSQLC-%[2]d"
+  resource_group_name     = azurerm_cosmosdb_account.test.resource_group_name
+  account_name            = azurerm_cosmosdb_account.test.name
+  database_name           = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path      = "/definition/id"
+  partition_merge_trigger = "%[3]s"
+}
+`, CosmosDBAccountResource{}.burstCapacityAndPartitionMerge(data, false, true), data.RandomInteger, trigger)
+}
+
 func (CosmosSqlContainerResource) basic_serverless(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
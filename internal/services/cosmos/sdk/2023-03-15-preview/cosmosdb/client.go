@@ -0,0 +1,15 @@
+package cosmosdb
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CosmosDBClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCosmosDBClientWithBaseURI(endpoint string) CosmosDBClient {
+	return CosmosDBClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,64 @@
+package cosmosdb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DatabaseAccountsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *DatabaseAccountGetResults
+}
+
+// DatabaseAccountsGet ...
+func (c CosmosDBClient) DatabaseAccountsGet(ctx context.Context, id DatabaseAccountId) (result DatabaseAccountsGetResponse, err error) {
+	req, err := c.preparerForDatabaseAccountsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "DatabaseAccountsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "DatabaseAccountsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDatabaseAccountsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "DatabaseAccountsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDatabaseAccountsGet prepares the DatabaseAccountsGet request.
+func (c CosmosDBClient) preparerForDatabaseAccountsGet(ctx context.Context, id DatabaseAccountId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDatabaseAccountsGet handles the response to the DatabaseAccountsGet request. The method always
+// closes the http.Response Body.
+func (c CosmosDBClient) responderForDatabaseAccountsGet(resp *http.Response) (result DatabaseAccountsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
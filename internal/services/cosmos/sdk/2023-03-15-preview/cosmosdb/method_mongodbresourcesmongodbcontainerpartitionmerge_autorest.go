@@ -0,0 +1,75 @@
+package cosmosdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type MongoDBResourcesMongoDBContainerPartitionMergeResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// MongoDBResourcesMongoDBContainerPartitionMerge ...
+func (c CosmosDBClient) MongoDBResourcesMongoDBContainerPartitionMerge(ctx context.Context, id MongodbDatabaseCollectionId, input MergeParameters) (result MongoDBResourcesMongoDBContainerPartitionMergeResponse, err error) {
+	req, err := c.preparerForMongoDBResourcesMongoDBContainerPartitionMerge(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "MongoDBResourcesMongoDBContainerPartitionMerge", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForMongoDBResourcesMongoDBContainerPartitionMerge(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "MongoDBResourcesMongoDBContainerPartitionMerge", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// MongoDBResourcesMongoDBContainerPartitionMergeThenPoll performs MongoDBResourcesMongoDBContainerPartitionMerge then polls until it's completed
+func (c CosmosDBClient) MongoDBResourcesMongoDBContainerPartitionMergeThenPoll(ctx context.Context, id MongodbDatabaseCollectionId, input MergeParameters) error {
+	result, err := c.MongoDBResourcesMongoDBContainerPartitionMerge(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing MongoDBResourcesMongoDBContainerPartitionMerge: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after MongoDBResourcesMongoDBContainerPartitionMerge: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForMongoDBResourcesMongoDBContainerPartitionMerge prepares the MongoDBResourcesMongoDBContainerPartitionMerge request.
+func (c CosmosDBClient) preparerForMongoDBResourcesMongoDBContainerPartitionMerge(ctx context.Context, id MongodbDatabaseCollectionId, input MergeParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/partitionMerge", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForMongoDBResourcesMongoDBContainerPartitionMerge sends the MongoDBResourcesMongoDBContainerPartitionMerge request. The method will close the
+// http.Response Body if it receives an error.
+func (c CosmosDBClient) senderForMongoDBResourcesMongoDBContainerPartitionMerge(ctx context.Context, req *http.Request) (future MongoDBResourcesMongoDBContainerPartitionMergeResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,75 @@
+package cosmosdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SqlResourcesSqlContainerPartitionMergeResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SqlResourcesSqlContainerPartitionMerge ...
+func (c CosmosDBClient) SqlResourcesSqlContainerPartitionMerge(ctx context.Context, id ContainerId, input MergeParameters) (result SqlResourcesSqlContainerPartitionMergeResponse, err error) {
+	req, err := c.preparerForSqlResourcesSqlContainerPartitionMerge(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "SqlResourcesSqlContainerPartitionMerge", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSqlResourcesSqlContainerPartitionMerge(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "SqlResourcesSqlContainerPartitionMerge", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SqlResourcesSqlContainerPartitionMergeThenPoll performs SqlResourcesSqlContainerPartitionMerge then polls until it's completed
+func (c CosmosDBClient) SqlResourcesSqlContainerPartitionMergeThenPoll(ctx context.Context, id ContainerId, input MergeParameters) error {
+	result, err := c.SqlResourcesSqlContainerPartitionMerge(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SqlResourcesSqlContainerPartitionMerge: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SqlResourcesSqlContainerPartitionMerge: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSqlResourcesSqlContainerPartitionMerge prepares the SqlResourcesSqlContainerPartitionMerge request.
+func (c CosmosDBClient) preparerForSqlResourcesSqlContainerPartitionMerge(ctx context.Context, id ContainerId, input MergeParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/partitionMerge", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSqlResourcesSqlContainerPartitionMerge sends the SqlResourcesSqlContainerPartitionMerge request. The method will close the
+// http.Response Body if it receives an error.
+func (c CosmosDBClient) senderForSqlResourcesSqlContainerPartitionMerge(ctx context.Context, req *http.Request) (future SqlResourcesSqlContainerPartitionMergeResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
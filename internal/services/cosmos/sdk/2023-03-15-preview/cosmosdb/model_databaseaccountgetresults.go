@@ -0,0 +1,9 @@
+package cosmosdb
+
+type DatabaseAccountGetResults struct {
+	Id         *string                       `json:"id,omitempty"`
+	Location   *string                       `json:"location,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *DatabaseAccountGetProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package cosmosdb
+
+type DatabaseAccountUpdateParameters struct {
+	Properties *DatabaseAccountUpdateProperties `json:"properties,omitempty"`
+}
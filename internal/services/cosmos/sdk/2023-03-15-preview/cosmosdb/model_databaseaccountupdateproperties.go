@@ -0,0 +1,6 @@
+package cosmosdb
+
+type DatabaseAccountUpdateProperties struct {
+	EnableBurstCapacity  *bool `json:"enableBurstCapacity,omitempty"`
+	EnablePartitionMerge *bool `json:"enablePartitionMerge,omitempty"`
+}
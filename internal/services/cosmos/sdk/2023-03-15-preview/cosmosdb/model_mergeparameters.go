@@ -0,0 +1,5 @@
+package cosmosdb
+
+type MergeParameters struct {
+	IsDryRun *bool `json:"isDryRun,omitempty"`
+}
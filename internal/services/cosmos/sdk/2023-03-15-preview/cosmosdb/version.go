@@ -0,0 +1,9 @@
+package cosmosdb
+
+import "fmt"
+
+const defaultApiVersion = "2023-03-15-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/cosmosdb/%s", defaultApiVersion)
+}
@@ -211,7 +211,7 @@ func resourceDeviceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	parameters := databoxedge.DevicePatch{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -2,7 +2,7 @@ package client
 
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2021-04-01-preview/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2024-05-01/workspaces"
 )
 
 type Client struct {
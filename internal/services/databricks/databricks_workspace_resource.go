@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/parse"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2021-04-01-preview/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2024-05-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/validate"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -227,6 +227,45 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				},
 			},
 
+			"enhanced_security_compliance": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"automatic_cluster_update_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliance_security_profile_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliance_security_profile_standards": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(workspaces.ComplianceStandardHIPAA),
+									string(workspaces.ComplianceStandardPCIDSS),
+								}, false),
+							},
+						},
+
+						"enhanced_security_monitoring_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"managed_resource_group_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -306,6 +345,29 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				return fmt.Errorf("'customer_managed_key_enabled', 'infrastructure_encryption_enabled' and 'managed_services_cmk_key_vault_key_id' are only available with a 'premium' workspace 'sku', got %q", newSku)
 			}
 
+			oldEnhancedSecurityCompliance, newEnhancedSecurityCompliance := d.GetChange("enhanced_security_compliance")
+			if config := newEnhancedSecurityCompliance.([]interface{}); len(config) > 0 && config[0] != nil {
+				if !strings.EqualFold("premium", newSku.(string)) {
+					return fmt.Errorf("'enhanced_security_compliance' is only available with a 'premium' workspace 'sku', got %q", newSku)
+				}
+
+				v := config[0].(map[string]interface{})
+				complianceSecurityProfileEnabled := v["compliance_security_profile_enabled"].(bool)
+				if !complianceSecurityProfileEnabled && v["compliance_security_profile_standards"].(*pluginsdk.Set).Len() > 0 {
+					return fmt.Errorf("'compliance_security_profile_standards' cannot be set when 'compliance_security_profile_enabled' is 'false'")
+				}
+				if complianceSecurityProfileEnabled && (!v["automatic_cluster_update_enabled"].(bool) || !v["enhanced_security_monitoring_enabled"].(bool)) {
+					return fmt.Errorf("'automatic_cluster_update_enabled' and 'enhanced_security_monitoring_enabled' must be set to 'true' when 'compliance_security_profile_enabled' is 'true'")
+				}
+			}
+
+			// the Compliance Security Profile cannot be disabled once it has been enabled
+			if d.HasChange("enhanced_security_compliance") && workspaceComplianceSecurityProfileEnabled(oldEnhancedSecurityCompliance.([]interface{})) && !workspaceComplianceSecurityProfileEnabled(newEnhancedSecurityCompliance.([]interface{})) {
+				if err := d.ForceNew("enhanced_security_compliance"); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		}),
 	}
@@ -459,6 +521,20 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		workspace.Properties.Encryption = encrypt
 	}
 
+	if v, ok := d.GetOk("enhanced_security_compliance"); ok {
+		workspace.Properties.EnhancedSecurityCompliance = expandWorkspaceEnhancedSecurityCompliance(v.([]interface{}))
+	} else if !d.IsNewResource() && d.HasChange("enhanced_security_compliance") {
+		// removing the block disables the settings, since omitting them retains the existing values
+		workspace.Properties.EnhancedSecurityCompliance = expandWorkspaceEnhancedSecurityCompliance([]interface{}{
+			map[string]interface{}{
+				"automatic_cluster_update_enabled":      false,
+				"compliance_security_profile_enabled":   false,
+				"compliance_security_profile_standards": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+				"enhanced_security_monitoring_enabled":  false,
+			},
+		})
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, workspace); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
@@ -467,7 +543,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 	// this will cause the updated tags to be propagated to all of the connected
 	// workspace resources.
 	// TODO: can be removed once https://github.com/Azure/azure-sdk-for-go/issues/14571 is fixed
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		workspaceUpdate := workspaces.WorkspaceUpdate{
 			Tags: expandedTags,
 		}
@@ -578,6 +654,10 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("workspace_url", model.Properties.WorkspaceUrl)
 		}
 
+		if err := d.Set("enhanced_security_compliance", flattenWorkspaceEnhancedSecurityCompliance(model.Properties.EnhancedSecurityCompliance)); err != nil {
+			return fmt.Errorf("setting `enhanced_security_compliance`: %+v", err)
+		}
+
 		if model.Properties.WorkspaceId != nil {
 			d.Set("workspace_id", model.Properties.WorkspaceId)
 		}
@@ -624,6 +704,105 @@ func resourceDatabricksWorkspaceDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+func workspaceComplianceSecurityProfileEnabled(input []interface{}) bool {
+	if len(input) == 0 || input[0] == nil {
+		return false
+	}
+
+	return input[0].(map[string]interface{})["compliance_security_profile_enabled"].(bool)
+}
+
+func expandWorkspaceEnhancedSecurityCompliance(input []interface{}) *workspaces.EnhancedSecurityComplianceDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	automaticClusterUpdate := workspaces.AutomaticClusterUpdateValueDisabled
+	if v["automatic_cluster_update_enabled"].(bool) {
+		automaticClusterUpdate = workspaces.AutomaticClusterUpdateValueEnabled
+	}
+
+	complianceSecurityProfile := workspaces.ComplianceSecurityProfileValueDisabled
+	if v["compliance_security_profile_enabled"].(bool) {
+		complianceSecurityProfile = workspaces.ComplianceSecurityProfileValueEnabled
+	}
+
+	enhancedSecurityMonitoring := workspaces.EnhancedSecurityMonitoringValueDisabled
+	if v["enhanced_security_monitoring_enabled"].(bool) {
+		enhancedSecurityMonitoring = workspaces.EnhancedSecurityMonitoringValueEnabled
+	}
+
+	complianceStandards := make([]workspaces.ComplianceStandard, 0)
+	for _, standard := range v["compliance_security_profile_standards"].(*pluginsdk.Set).List() {
+		complianceStandards = append(complianceStandards, workspaces.ComplianceStandard(standard.(string)))
+	}
+	if complianceSecurityProfile == workspaces.ComplianceSecurityProfileValueEnabled && len(complianceStandards) == 0 {
+		// the API requires `NONE` to be specified when the profile is enabled without any compliance standards
+		complianceStandards = append(complianceStandards, workspaces.ComplianceStandardNONE)
+	}
+
+	return &workspaces.EnhancedSecurityComplianceDefinition{
+		AutomaticClusterUpdate: &workspaces.AutomaticClusterUpdateDefinition{
+			Value: &automaticClusterUpdate,
+		},
+		ComplianceSecurityProfile: &workspaces.ComplianceSecurityProfileDefinition{
+			ComplianceStandards: &complianceStandards,
+			Value:               &complianceSecurityProfile,
+		},
+		EnhancedSecurityMonitoring: &workspaces.EnhancedSecurityMonitoringDefinition{
+			Value: &enhancedSecurityMonitoring,
+		},
+	}
+}
+
+func flattenWorkspaceEnhancedSecurityCompliance(input *workspaces.EnhancedSecurityComplianceDefinition) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	automaticClusterUpdateEnabled := false
+	if v := input.AutomaticClusterUpdate; v != nil && v.Value != nil {
+		automaticClusterUpdateEnabled = *v.Value == workspaces.AutomaticClusterUpdateValueEnabled
+	}
+
+	complianceSecurityProfileEnabled := false
+	complianceStandards := make([]interface{}, 0)
+	if v := input.ComplianceSecurityProfile; v != nil {
+		if v.Value != nil {
+			complianceSecurityProfileEnabled = *v.Value == workspaces.ComplianceSecurityProfileValueEnabled
+		}
+		if v.ComplianceStandards != nil {
+			for _, standard := range *v.ComplianceStandards {
+				if standard == workspaces.ComplianceStandardNONE {
+					continue
+				}
+				complianceStandards = append(complianceStandards, string(standard))
+			}
+		}
+	}
+
+	enhancedSecurityMonitoringEnabled := false
+	if v := input.EnhancedSecurityMonitoring; v != nil && v.Value != nil {
+		enhancedSecurityMonitoringEnabled = *v.Value == workspaces.EnhancedSecurityMonitoringValueEnabled
+	}
+
+	// the API returns these settings as disabled when they've never been configured, so omit the block to avoid a diff
+	if !automaticClusterUpdateEnabled && !complianceSecurityProfileEnabled && !enhancedSecurityMonitoringEnabled {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"automatic_cluster_update_enabled":      automaticClusterUpdateEnabled,
+			"compliance_security_profile_enabled":   complianceSecurityProfileEnabled,
+			"compliance_security_profile_standards": complianceStandards,
+			"enhanced_security_monitoring_enabled":  enhancedSecurityMonitoringEnabled,
+		},
+	}
+}
+
 func flattenWorkspaceStorageAccountIdentity(input *workspaces.ManagedIdentityConfiguration) []interface{} {
 	if input == nil {
 		return nil
@@ -809,7 +988,7 @@ func expandWorkspaceCustomParameters(input []interface{}, customerManagedKeyEnab
 	}
 
 	if v, ok := config["no_public_ip"].(bool); ok {
-		parameters.EnableNoPublicIp = &workspaces.WorkspaceCustomBooleanParameter{
+		parameters.EnableNoPublicIp = &workspaces.WorkspaceNoPublicIPBooleanParameter{
 			Value: v,
 		}
 	}
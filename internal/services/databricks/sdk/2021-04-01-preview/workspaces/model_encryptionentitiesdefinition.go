@@ -1,5 +0,0 @@
-package workspaces
-
-type EncryptionEntitiesDefinition struct {
-	ManagedServices *EncryptionV2 `json:"managedServices,omitempty"`
-}
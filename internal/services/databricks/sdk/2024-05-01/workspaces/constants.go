@@ -2,6 +2,93 @@ package workspaces
 
 import "strings"
 
+type AutomaticClusterUpdateValue string
+
+const (
+	AutomaticClusterUpdateValueDisabled AutomaticClusterUpdateValue = "Disabled"
+	AutomaticClusterUpdateValueEnabled  AutomaticClusterUpdateValue = "Enabled"
+)
+
+func PossibleValuesForAutomaticClusterUpdateValue() []string {
+	return []string{
+		string(AutomaticClusterUpdateValueDisabled),
+		string(AutomaticClusterUpdateValueEnabled),
+	}
+}
+
+func parseAutomaticClusterUpdateValue(input string) (*AutomaticClusterUpdateValue, error) {
+	vals := map[string]AutomaticClusterUpdateValue{
+		"disabled": AutomaticClusterUpdateValueDisabled,
+		"enabled":  AutomaticClusterUpdateValueEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AutomaticClusterUpdateValue(input)
+	return &out, nil
+}
+
+type ComplianceSecurityProfileValue string
+
+const (
+	ComplianceSecurityProfileValueDisabled ComplianceSecurityProfileValue = "Disabled"
+	ComplianceSecurityProfileValueEnabled  ComplianceSecurityProfileValue = "Enabled"
+)
+
+func PossibleValuesForComplianceSecurityProfileValue() []string {
+	return []string{
+		string(ComplianceSecurityProfileValueDisabled),
+		string(ComplianceSecurityProfileValueEnabled),
+	}
+}
+
+func parseComplianceSecurityProfileValue(input string) (*ComplianceSecurityProfileValue, error) {
+	vals := map[string]ComplianceSecurityProfileValue{
+		"disabled": ComplianceSecurityProfileValueDisabled,
+		"enabled":  ComplianceSecurityProfileValueEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ComplianceSecurityProfileValue(input)
+	return &out, nil
+}
+
+type ComplianceStandard string
+
+const (
+	ComplianceStandardHIPAA  ComplianceStandard = "HIPAA"
+	ComplianceStandardNONE   ComplianceStandard = "NONE"
+	ComplianceStandardPCIDSS ComplianceStandard = "PCI_DSS"
+)
+
+func PossibleValuesForComplianceStandard() []string {
+	return []string{
+		string(ComplianceStandardHIPAA),
+		string(ComplianceStandardNONE),
+		string(ComplianceStandardPCIDSS),
+	}
+}
+
+func parseComplianceStandard(input string) (*ComplianceStandard, error) {
+	vals := map[string]ComplianceStandard{
+		"hipaa":   ComplianceStandardHIPAA,
+		"none":    ComplianceStandardNONE,
+		"pci_dss": ComplianceStandardPCIDSS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ComplianceStandard(input)
+	return &out, nil
+}
+
 type CreatedByType string
 
 const (
@@ -67,6 +154,34 @@ func parseCustomParameterType(input string) (*CustomParameterType, error) {
 	return &out, nil
 }
 
+type DefaultStorageFirewall string
+
+const (
+	DefaultStorageFirewallDisabled DefaultStorageFirewall = "Disabled"
+	DefaultStorageFirewallEnabled  DefaultStorageFirewall = "Enabled"
+)
+
+func PossibleValuesForDefaultStorageFirewall() []string {
+	return []string{
+		string(DefaultStorageFirewallDisabled),
+		string(DefaultStorageFirewallEnabled),
+	}
+}
+
+func parseDefaultStorageFirewall(input string) (*DefaultStorageFirewall, error) {
+	vals := map[string]DefaultStorageFirewall{
+		"disabled": DefaultStorageFirewallDisabled,
+		"enabled":  DefaultStorageFirewallEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DefaultStorageFirewall(input)
+	return &out, nil
+}
+
 type EncryptionKeySource string
 
 const (
@@ -92,6 +207,90 @@ func parseEncryptionKeySource(input string) (*EncryptionKeySource, error) {
 	return &out, nil
 }
 
+type EnhancedSecurityMonitoringValue string
+
+const (
+	EnhancedSecurityMonitoringValueDisabled EnhancedSecurityMonitoringValue = "Disabled"
+	EnhancedSecurityMonitoringValueEnabled  EnhancedSecurityMonitoringValue = "Enabled"
+)
+
+func PossibleValuesForEnhancedSecurityMonitoringValue() []string {
+	return []string{
+		string(EnhancedSecurityMonitoringValueDisabled),
+		string(EnhancedSecurityMonitoringValueEnabled),
+	}
+}
+
+func parseEnhancedSecurityMonitoringValue(input string) (*EnhancedSecurityMonitoringValue, error) {
+	vals := map[string]EnhancedSecurityMonitoringValue{
+		"disabled": EnhancedSecurityMonitoringValueDisabled,
+		"enabled":  EnhancedSecurityMonitoringValueEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EnhancedSecurityMonitoringValue(input)
+	return &out, nil
+}
+
+type IdentityType string
+
+const (
+	IdentityTypeSystemAssigned IdentityType = "SystemAssigned"
+	IdentityTypeUserAssigned   IdentityType = "UserAssigned"
+)
+
+func PossibleValuesForIdentityType() []string {
+	return []string{
+		string(IdentityTypeSystemAssigned),
+		string(IdentityTypeUserAssigned),
+	}
+}
+
+func parseIdentityType(input string) (*IdentityType, error) {
+	vals := map[string]IdentityType{
+		"systemassigned": IdentityTypeSystemAssigned,
+		"userassigned":   IdentityTypeUserAssigned,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IdentityType(input)
+	return &out, nil
+}
+
+type InitialType string
+
+const (
+	InitialTypeHiveMetastore InitialType = "HiveMetastore"
+	InitialTypeUnityCatalog  InitialType = "UnityCatalog"
+)
+
+func PossibleValuesForInitialType() []string {
+	return []string{
+		string(InitialTypeHiveMetastore),
+		string(InitialTypeUnityCatalog),
+	}
+}
+
+func parseInitialType(input string) (*InitialType, error) {
+	vals := map[string]InitialType{
+		"hivemetastore": InitialTypeHiveMetastore,
+		"unitycatalog":  InitialTypeUnityCatalog,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := InitialType(input)
+	return &out, nil
+}
+
 type KeySource string
 
 const (
@@ -0,0 +1,5 @@
+package workspaces
+
+type AutomaticClusterUpdateDefinition struct {
+	Value *AutomaticClusterUpdateValue `json:"value,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type ComplianceSecurityProfileDefinition struct {
+	ComplianceStandards *[]ComplianceStandard           `json:"complianceStandards,omitempty"`
+	Value               *ComplianceSecurityProfileValue `json:"value,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type DefaultCatalogProperties struct {
+	InitialName *string      `json:"initialName,omitempty"`
+	InitialType *InitialType `json:"initialType,omitempty"`
+}
@@ -0,0 +1,7 @@
+package workspaces
+
+type EnhancedSecurityComplianceDefinition struct {
+	AutomaticClusterUpdate     *AutomaticClusterUpdateDefinition     `json:"automaticClusterUpdate,omitempty"`
+	ComplianceSecurityProfile  *ComplianceSecurityProfileDefinition  `json:"complianceSecurityProfile,omitempty"`
+	EnhancedSecurityMonitoring *EnhancedSecurityMonitoringDefinition `json:"enhancedSecurityMonitoring,omitempty"`
+}
@@ -0,0 +1,5 @@
+package workspaces
+
+type EnhancedSecurityMonitoringDefinition struct {
+	Value *EnhancedSecurityMonitoringValue `json:"value,omitempty"`
+}
@@ -0,0 +1,7 @@
+package workspaces
+
+type WorkspacePropertiesAccessConnector struct {
+	Id                     string       `json:"id"`
+	IdentityType           IdentityType `json:"identityType"`
+	UserAssignedIdentityId *string      `json:"userAssignedIdentityId,omitempty"`
+}
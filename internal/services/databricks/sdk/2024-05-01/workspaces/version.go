@@ -2,7 +2,7 @@ package workspaces
 
 import "fmt"
 
-const defaultApiVersion = "2021-04-01-preview"
+const defaultApiVersion = "2024-05-01"
 
 func userAgent() string {
 	return fmt.Sprintf("pandora/workspaces/%s", defaultApiVersion)
@@ -3,21 +3,26 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/integrationruntimes"
 )
 
 type Client struct {
-	DataFlowClient                *datafactory.DataFlowsClient
-	DatasetClient                 *datafactory.DatasetsClient
-	FactoriesClient               *datafactory.FactoriesClient
-	IntegrationRuntimesClient     *datafactory.IntegrationRuntimesClient
-	LinkedServiceClient           *datafactory.LinkedServicesClient
-	ManagedPrivateEndpointsClient *datafactory.ManagedPrivateEndpointsClient
-	ManagedVirtualNetworksClient  *datafactory.ManagedVirtualNetworksClient
-	PipelinesClient               *datafactory.PipelinesClient
-	TriggersClient                *datafactory.TriggersClient
+	AirflowIntegrationRuntimesClient *integrationruntimes.IntegrationRuntimesClient
+	DataFlowClient                   *datafactory.DataFlowsClient
+	DatasetClient                    *datafactory.DatasetsClient
+	FactoriesClient                  *datafactory.FactoriesClient
+	IntegrationRuntimesClient        *datafactory.IntegrationRuntimesClient
+	LinkedServiceClient              *datafactory.LinkedServicesClient
+	ManagedPrivateEndpointsClient    *datafactory.ManagedPrivateEndpointsClient
+	ManagedVirtualNetworksClient     *datafactory.ManagedVirtualNetworksClient
+	PipelinesClient                  *datafactory.PipelinesClient
+	TriggersClient                   *datafactory.TriggersClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	airflowIntegrationRuntimesClient := integrationruntimes.NewIntegrationRuntimesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&airflowIntegrationRuntimesClient.Client, o.ResourceManagerAuthorizer)
+
 	dataFlowClient := datafactory.NewDataFlowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataFlowClient.Client, o.ResourceManagerAuthorizer)
 
@@ -46,14 +51,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&TriggersClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		DataFlowClient:                &dataFlowClient,
-		DatasetClient:                 &DatasetClient,
-		FactoriesClient:               &FactoriesClient,
-		IntegrationRuntimesClient:     &IntegrationRuntimesClient,
-		LinkedServiceClient:           &LinkedServiceClient,
-		ManagedPrivateEndpointsClient: &ManagedPrivateEndpointsClient,
-		ManagedVirtualNetworksClient:  &ManagedVirtualNetworksClient,
-		PipelinesClient:               &PipelinesClient,
-		TriggersClient:                &TriggersClient,
+		AirflowIntegrationRuntimesClient: &airflowIntegrationRuntimesClient,
+		DataFlowClient:                   &dataFlowClient,
+		DatasetClient:                    &DatasetClient,
+		FactoriesClient:                  &FactoriesClient,
+		IntegrationRuntimesClient:        &IntegrationRuntimesClient,
+		LinkedServiceClient:              &LinkedServiceClient,
+		ManagedPrivateEndpointsClient:    &ManagedPrivateEndpointsClient,
+		ManagedVirtualNetworksClient:     &ManagedVirtualNetworksClient,
+		PipelinesClient:                  &PipelinesClient,
+		TriggersClient:                   &TriggersClient,
 	}
 }
@@ -0,0 +1,451 @@
+package datafactory
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/integrationruntimes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryIntegrationRuntimeAirflow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Read:   resourceDataFactoryIntegrationRuntimeAirflowRead,
+		Update: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Delete: resourceDataFactoryIntegrationRuntimeAirflowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IntegrationRuntimeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^([a-zA-Z0-9](-|-?[a-zA-Z0-9]+)+[a-zA-Z0-9])$`),
+					`Invalid name for Airflow Integration Runtime: minimum 3 characters, must start and end with a number or a letter, may only consist of letters, numbers and dashes and no consecutive dashes.`,
+				),
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"location": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"airflow_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"compute_size": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(integrationruntimes.AirflowComputeSizeSmall),
+				ValidateFunc: validation.StringInSlice(integrationruntimes.PossibleValuesForAirflowComputeSize(), false),
+			},
+
+			"extra_node_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 50),
+			},
+
+			"requirements": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"airflow_configuration_overrides": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"aad_integration_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"basic_authentication": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"username": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"git_sync": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service_type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(integrationruntimes.PossibleValuesForGitServiceType(), false),
+						},
+
+						"repository_url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"branch": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"credential_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(integrationruntimes.GitCredentialTypeNone),
+							ValidateFunc: validation.StringInSlice(integrationruntimes.PossibleValuesForGitCredentialType(), false),
+						},
+
+						"username": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"credential": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.AirflowIntegrationRuntimesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewIntegrationRuntimeID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+	sdkId := integrationruntimes.NewIntegrationRuntimeID(id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, sdkId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_factory_integration_runtime_airflow", id.ID())
+		}
+	}
+
+	aadIntegrationEnabled := d.Get("aad_integration_enabled").(bool)
+	basicAuthentication := d.Get("basic_authentication").([]interface{})
+	if aadIntegrationEnabled && len(basicAuthentication) > 0 {
+		return fmt.Errorf("`basic_authentication` cannot be specified when `aad_integration_enabled` is `true`")
+	}
+	if !aadIntegrationEnabled && len(basicAuthentication) == 0 {
+		return fmt.Errorf("`basic_authentication` must be specified when `aad_integration_enabled` is `false`")
+	}
+
+	computeSize := integrationruntimes.AirflowComputeSize(d.Get("compute_size").(string))
+	airflowProperties := integrationruntimes.AirflowProperties{
+		AirflowConfigurationOverrides: expandDataFactoryIntegrationRuntimeAirflowStringMap(d.Get("airflow_configuration_overrides").(map[string]interface{})),
+		AirflowRequirements:           utils.ExpandStringSlice(d.Get("requirements").([]interface{})),
+		AirflowVersion:                utils.String(d.Get("airflow_version").(string)),
+		EnableAADIntegration:          utils.Bool(aadIntegrationEnabled),
+		EnvironmentVariables:          expandDataFactoryIntegrationRuntimeAirflowStringMap(d.Get("environment_variables").(map[string]interface{})),
+		GitSyncProperties:             expandDataFactoryIntegrationRuntimeAirflowGitSync(d.Get("git_sync").([]interface{})),
+	}
+
+	if len(basicAuthentication) > 0 && basicAuthentication[0] != nil {
+		raw := basicAuthentication[0].(map[string]interface{})
+		airflowProperties.UserName = utils.String(raw["username"].(string))
+		airflowProperties.Password = utils.String(raw["password"].(string))
+	}
+
+	payload := integrationruntimes.IntegrationRuntimeResource{
+		Name: utils.String(id.Name),
+		Properties: integrationruntimes.AirflowIntegrationRuntime{
+			Type: integrationruntimes.IntegrationRuntimeTypeAirflow,
+			TypeProperties: integrationruntimes.AirflowIntegrationRuntimeTypeProperties{
+				AirflowProperties: &airflowProperties,
+				ComputeProperties: &integrationruntimes.AirflowComputeProperties{
+					ComputeSize: &computeSize,
+					ExtraNodes:  utils.Int64(int64(d.Get("extra_node_count").(int))),
+					Location:    utils.String(location.Normalize(d.Get("location").(string))),
+				},
+			},
+		},
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		payload.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, sdkId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryIntegrationRuntimeAirflowRead(d, meta)
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.AirflowIntegrationRuntimesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, integrationruntimes.NewIntegrationRuntimeID(id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		if props.Type != integrationruntimes.IntegrationRuntimeTypeAirflow {
+			return fmt.Errorf("%s is of type %q rather than %q", *id, string(props.Type), string(integrationruntimes.IntegrationRuntimeTypeAirflow))
+		}
+
+		d.Set("description", props.Description)
+
+		if computeProps := props.TypeProperties.ComputeProperties; computeProps != nil {
+			d.Set("location", location.NormalizeNilable(computeProps.Location))
+
+			computeSize := ""
+			if computeProps.ComputeSize != nil {
+				computeSize = string(*computeProps.ComputeSize)
+			}
+			d.Set("compute_size", computeSize)
+
+			extraNodeCount := 0
+			if computeProps.ExtraNodes != nil {
+				extraNodeCount = int(*computeProps.ExtraNodes)
+			}
+			d.Set("extra_node_count", extraNodeCount)
+		}
+
+		if airflowProps := props.TypeProperties.AirflowProperties; airflowProps != nil {
+			d.Set("airflow_version", airflowProps.AirflowVersion)
+			d.Set("requirements", utils.FlattenStringSlice(airflowProps.AirflowRequirements))
+			d.Set("airflow_configuration_overrides", flattenDataFactoryIntegrationRuntimeAirflowStringMap(airflowProps.AirflowConfigurationOverrides))
+			d.Set("environment_variables", flattenDataFactoryIntegrationRuntimeAirflowStringMap(airflowProps.EnvironmentVariables))
+
+			aadIntegrationEnabled := true
+			if airflowProps.EnableAADIntegration != nil {
+				aadIntegrationEnabled = *airflowProps.EnableAADIntegration
+			}
+			d.Set("aad_integration_enabled", aadIntegrationEnabled)
+
+			// the password isn't returned by the API, so is retained from the configuration
+			basicAuthentication := make([]interface{}, 0)
+			if !aadIntegrationEnabled && airflowProps.UserName != nil {
+				basicAuthentication = append(basicAuthentication, map[string]interface{}{
+					"username": *airflowProps.UserName,
+					"password": d.Get("basic_authentication.0.password").(string),
+				})
+			}
+			if err := d.Set("basic_authentication", basicAuthentication); err != nil {
+				return fmt.Errorf("setting `basic_authentication`: %+v", err)
+			}
+
+			if err := d.Set("git_sync", flattenDataFactoryIntegrationRuntimeAirflowGitSync(airflowProps.GitSyncProperties, d)); err != nil {
+				return fmt.Errorf("setting `git_sync`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.AirflowIntegrationRuntimesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, integrationruntimes.NewIntegrationRuntimeID(id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name))
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryIntegrationRuntimeAirflowStringMap(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return &output
+}
+
+func flattenDataFactoryIntegrationRuntimeAirflowStringMap(input *map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = v
+	}
+
+	return output
+}
+
+func expandDataFactoryIntegrationRuntimeAirflowGitSync(input []interface{}) *integrationruntimes.GitSyncProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	serviceType := integrationruntimes.GitServiceType(raw["service_type"].(string))
+	credentialType := integrationruntimes.GitCredentialType(raw["credential_type"].(string))
+
+	output := integrationruntimes.GitSyncProperties{
+		Branch:            utils.String(raw["branch"].(string)),
+		GitCredentialType: &credentialType,
+		GitServiceType:    &serviceType,
+		Repo:              utils.String(raw["repository_url"].(string)),
+	}
+
+	if v := raw["username"].(string); v != "" {
+		output.Username = utils.String(v)
+	}
+
+	if v := raw["credential"].(string); v != "" {
+		output.Credential = utils.String(v)
+	}
+
+	return &output
+}
+
+func flattenDataFactoryIntegrationRuntimeAirflowGitSync(input *integrationruntimes.GitSyncProperties, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil || input.Repo == nil {
+		return []interface{}{}
+	}
+
+	serviceType := ""
+	if input.GitServiceType != nil {
+		serviceType = string(*input.GitServiceType)
+	}
+
+	credentialType := string(integrationruntimes.GitCredentialTypeNone)
+	if input.GitCredentialType != nil {
+		credentialType = string(*input.GitCredentialType)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"service_type":    serviceType,
+			"repository_url":  *input.Repo,
+			"branch":          utils.NormalizeNilableString(input.Branch),
+			"credential_type": credentialType,
+			"username":        utils.NormalizeNilableString(input.Username),
+			// the credential isn't returned by the API, so is retained from the configuration
+			"credential": d.Get("git_sync.0.credential").(string),
+		},
+	}
+}
@@ -0,0 +1,214 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/integrationruntimes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type IntegrationRuntimeAirflowResource struct {
+}
+
+func TestAccDataFactoryIntegrationRuntimeAirflow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_airflow", "test")
+	r := IntegrationRuntimeAirflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("compute_size").HasValue("Small"),
+				check.That(data.ResourceName).Key("aad_integration_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeAirflow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_airflow", "test")
+	r := IntegrationRuntimeAirflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeAirflow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_airflow", "test")
+	r := IntegrationRuntimeAirflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requirements.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("basic_authentication.0.password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeAirflow_gitSync(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_airflow", "test")
+	r := IntegrationRuntimeAirflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.gitSync(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("git_sync.0.service_type").HasValue("Github"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (IntegrationRuntimeAirflowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_integration_runtime_airflow" "test" {
+  name            = "airflow-integration-runtime"
+  data_factory_id = azurerm_data_factory.test.id
+  location        = azurerm_resource_group.test.location
+  airflow_version = "2.6.3"
+}
+`, IntegrationRuntimeAirflowResource{}.template(data))
+}
+
+func (r IntegrationRuntimeAirflowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_integration_runtime_airflow" "import" {
+  name            = azurerm_data_factory_integration_runtime_airflow.test.name
+  data_factory_id = azurerm_data_factory_integration_runtime_airflow.test.data_factory_id
+  location        = azurerm_data_factory_integration_runtime_airflow.test.location
+  airflow_version = azurerm_data_factory_integration_runtime_airflow.test.airflow_version
+}
+`, r.basic(data))
+}
+
+func (IntegrationRuntimeAirflowResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_integration_runtime_airflow" "test" {
+  name             = "airflow-integration-runtime"
+  data_factory_id  = azurerm_data_factory.test.id
+  location         = azurerm_resource_group.test.location
+  airflow_version  = "2.6.3"
+  description      = "test description"
+  compute_size     = "Large"
+  extra_node_count = 1
+
+  requirements = [
+    "apache-airflow-providers-microsoft-azure",
+    "pandas==2.0.3",
+  ]
+
+  airflow_configuration_overrides = {
+    "core.default_timezone" = "utc"
+  }
+
+  environment_variables = {
+    ENVIRONMENT = "test"
+  }
+
+  aad_integration_enabled = false
+
+  basic_authentication {
+    username = "airflowadmin"
+    password = "P@ssw0rd1234!"
+  }
+}
+`, IntegrationRuntimeAirflowResource{}.template(data))
+}
+
+func (IntegrationRuntimeAirflowResource) gitSync(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_integration_runtime_airflow" "test" {
+  name            = "airflow-integration-runtime"
+  data_factory_id = azurerm_data_factory.test.id
+  location        = azurerm_resource_group.test.location
+  airflow_version = "2.6.3"
+
+  git_sync {
+    service_type   = "Github"
+    repository_url = "https://github.com/hashicorp/terraform-provider-azurerm.git"
+    branch         = "main"
+  }
+}
+`, IntegrationRuntimeAirflowResource{}.template(data))
+}
+
+func (IntegrationRuntimeAirflowResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (t IntegrationRuntimeAirflowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.IntegrationRuntimeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.AirflowIntegrationRuntimesClient.Get(ctx, integrationruntimes.NewIntegrationRuntimeID(id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
@@ -0,0 +1,15 @@
+package integrationruntimes
+
+import "github.com/Azure/go-autorest/autorest"
+
+type IntegrationRuntimesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewIntegrationRuntimesClientWithBaseURI(endpoint string) IntegrationRuntimesClient {
+	return IntegrationRuntimesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,127 @@
+package integrationruntimes
+
+import "strings"
+
+type AirflowComputeSize string
+
+const (
+	AirflowComputeSizeLarge AirflowComputeSize = "Large"
+	AirflowComputeSizeSmall AirflowComputeSize = "Small"
+)
+
+func PossibleValuesForAirflowComputeSize() []string {
+	return []string{
+		string(AirflowComputeSizeLarge),
+		string(AirflowComputeSizeSmall),
+	}
+}
+
+func parseAirflowComputeSize(input string) (*AirflowComputeSize, error) {
+	vals := map[string]AirflowComputeSize{
+		"large": AirflowComputeSizeLarge,
+		"small": AirflowComputeSizeSmall,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AirflowComputeSize(input)
+	return &out, nil
+}
+
+type GitCredentialType string
+
+const (
+	GitCredentialTypeNone GitCredentialType = "None"
+	GitCredentialTypePAT  GitCredentialType = "PAT"
+	GitCredentialTypeSPN  GitCredentialType = "SPN"
+)
+
+func PossibleValuesForGitCredentialType() []string {
+	return []string{
+		string(GitCredentialTypeNone),
+		string(GitCredentialTypePAT),
+		string(GitCredentialTypeSPN),
+	}
+}
+
+func parseGitCredentialType(input string) (*GitCredentialType, error) {
+	vals := map[string]GitCredentialType{
+		"none": GitCredentialTypeNone,
+		"pat":  GitCredentialTypePAT,
+		"spn":  GitCredentialTypeSPN,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GitCredentialType(input)
+	return &out, nil
+}
+
+type GitServiceType string
+
+const (
+	GitServiceTypeADO       GitServiceType = "ADO"
+	GitServiceTypeBitbucket GitServiceType = "Bitbucket"
+	GitServiceTypeGithub    GitServiceType = "Github"
+	GitServiceTypeGitlab    GitServiceType = "Gitlab"
+)
+
+func PossibleValuesForGitServiceType() []string {
+	return []string{
+		string(GitServiceTypeADO),
+		string(GitServiceTypeBitbucket),
+		string(GitServiceTypeGithub),
+		string(GitServiceTypeGitlab),
+	}
+}
+
+func parseGitServiceType(input string) (*GitServiceType, error) {
+	vals := map[string]GitServiceType{
+		"ado":       GitServiceTypeADO,
+		"bitbucket": GitServiceTypeBitbucket,
+		"github":    GitServiceTypeGithub,
+		"gitlab":    GitServiceTypeGitlab,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GitServiceType(input)
+	return &out, nil
+}
+
+type IntegrationRuntimeType string
+
+const (
+	IntegrationRuntimeTypeAirflow    IntegrationRuntimeType = "Airflow"
+	IntegrationRuntimeTypeManaged    IntegrationRuntimeType = "Managed"
+	IntegrationRuntimeTypeSelfHosted IntegrationRuntimeType = "SelfHosted"
+)
+
+func PossibleValuesForIntegrationRuntimeType() []string {
+	return []string{
+		string(IntegrationRuntimeTypeAirflow),
+		string(IntegrationRuntimeTypeManaged),
+		string(IntegrationRuntimeTypeSelfHosted),
+	}
+}
+
+func parseIntegrationRuntimeType(input string) (*IntegrationRuntimeType, error) {
+	vals := map[string]IntegrationRuntimeType{
+		"airflow":    IntegrationRuntimeTypeAirflow,
+		"managed":    IntegrationRuntimeTypeManaged,
+		"selfhosted": IntegrationRuntimeTypeSelfHosted,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IntegrationRuntimeType(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package integrationruntimes
+
+type AirflowComputeProperties struct {
+	ComputeSize *AirflowComputeSize `json:"computeSize,omitempty"`
+	ExtraNodes  *int64              `json:"extraNodes,omitempty"`
+	Location    *string             `json:"location,omitempty"`
+}
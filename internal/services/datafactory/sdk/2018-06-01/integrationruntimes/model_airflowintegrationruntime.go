@@ -0,0 +1,7 @@
+package integrationruntimes
+
+type AirflowIntegrationRuntime struct {
+	Description    *string                                 `json:"description,omitempty"`
+	Type           IntegrationRuntimeType                  `json:"type"`
+	TypeProperties AirflowIntegrationRuntimeTypeProperties `json:"typeProperties"`
+}
@@ -0,0 +1,6 @@
+package integrationruntimes
+
+type AirflowIntegrationRuntimeTypeProperties struct {
+	AirflowProperties *AirflowProperties        `json:"airflowProperties,omitempty"`
+	ComputeProperties *AirflowComputeProperties `json:"computeProperties,omitempty"`
+}
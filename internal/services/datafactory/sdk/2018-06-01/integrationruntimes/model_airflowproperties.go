@@ -0,0 +1,12 @@
+package integrationruntimes
+
+type AirflowProperties struct {
+	AirflowConfigurationOverrides *map[string]string `json:"airflowConfigurationOverrides,omitempty"`
+	AirflowRequirements           *[]string          `json:"airflowRequirements,omitempty"`
+	AirflowVersion                *string            `json:"airflowVersion,omitempty"`
+	EnableAADIntegration          *bool              `json:"enableAADIntegration,omitempty"`
+	EnvironmentVariables          *map[string]string `json:"environmentVariables,omitempty"`
+	GitSyncProperties             *GitSyncProperties `json:"gitSyncProperties,omitempty"`
+	Password                      *string            `json:"password,omitempty"`
+	UserName                      *string            `json:"userName,omitempty"`
+}
@@ -0,0 +1,10 @@
+package integrationruntimes
+
+type GitSyncProperties struct {
+	Branch            *string            `json:"branch,omitempty"`
+	Credential        *string            `json:"credential,omitempty"`
+	GitCredentialType *GitCredentialType `json:"gitCredentialType,omitempty"`
+	GitServiceType    *GitServiceType    `json:"gitServiceType,omitempty"`
+	Repo              *string            `json:"repo,omitempty"`
+	Username          *string            `json:"username,omitempty"`
+}
@@ -0,0 +1,9 @@
+package integrationruntimes
+
+type IntegrationRuntimeResource struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties AirflowIntegrationRuntime `json:"properties"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package integrationruntimes
+
+import "fmt"
+
+const defaultApiVersion = "2018-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/integrationruntimes/%s", defaultApiVersion)
+}
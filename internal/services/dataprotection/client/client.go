@@ -3,12 +3,15 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/legacysdk/dataprotection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/sdk/2023-05-01/backupinstances"
 )
 
 type Client struct {
 	BackupVaultClient    *dataprotection.BackupVaultsClient
 	BackupPolicyClient   *dataprotection.BackupPoliciesClient
 	BackupInstanceClient *dataprotection.BackupInstancesClient
+
+	BackupInstancesClient *backupinstances.BackupInstancesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +24,14 @@ func NewClient(o *common.ClientOptions) *Client {
 	backupInstanceClient := dataprotection.NewBackupInstancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&backupInstanceClient.Client, o.ResourceManagerAuthorizer)
 
+	backupInstancesClient := backupinstances.NewBackupInstancesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&backupInstancesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		BackupVaultClient:    &backupVaultClient,
 		BackupPolicyClient:   &backupPolicyClient,
 		BackupInstanceClient: &backupInstanceClient,
+
+		BackupInstancesClient: &backupInstancesClient,
 	}
 }
@@ -1,6 +1,7 @@
 package dataprotection
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -10,13 +11,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/legacysdk/dataprotection"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/sdk/2023-05-01/backupinstances"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/validate"
 	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -68,27 +70,38 @@ func resourceDataProtectionBackupInstanceBlobStorage() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validate.BackupPolicyID,
 			},
+
+			"storage_account_container_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
 		},
 	}
 }
+
 func resourceDataProtectionBackupInstanceBlobStorageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	client := meta.(*clients.Client).DataProtection.BackupInstancesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	vaultId, _ := parse.BackupVaultID(d.Get("vault_id").(string))
-	id := parse.NewBackupInstanceID(subscriptionId, vaultId.ResourceGroup, vaultId.Name, name)
+	id := backupinstances.NewBackupInstanceID(subscriptionId, vaultId.ResourceGroup, vaultId.Name, name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.BackupVaultName, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for existing DataProtection BackupInstance (%q): %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_data_protection_backup_instance_blob_storage", id.ID())
 		}
 	}
@@ -97,31 +110,37 @@ func resourceDataProtectionBackupInstanceBlobStorageCreateUpdate(d *schema.Resou
 	location := location.Normalize(d.Get("location").(string))
 	policyId, _ := parse.BackupPolicyID(d.Get("backup_policy_id").(string))
 
-	parameters := dataprotection.BackupInstanceResource{
-		Properties: &dataprotection.BackupInstance{
-			DataSourceInfo: &dataprotection.Datasource{
+	parameters := backupinstances.BackupInstanceResource{
+		Properties: &backupinstances.BackupInstance{
+			DataSourceInfo: backupinstances.Datasource{
 				DatasourceType:   utils.String("Microsoft.Storage/storageAccounts/blobServices"),
 				ObjectType:       utils.String("Datasource"),
-				ResourceID:       utils.String(storageAccountId.ID()),
+				ResourceID:       storageAccountId.ID(),
 				ResourceLocation: utils.String(location),
 				ResourceName:     utils.String(storageAccountId.Name),
 				ResourceType:     utils.String("Microsoft.Storage/storageAccounts"),
-				ResourceURI:      utils.String(storageAccountId.ID()),
+				ResourceUri:      utils.String(storageAccountId.ID()),
 			},
-			FriendlyName: utils.String(id.Name),
-			PolicyInfo: &dataprotection.PolicyInfo{
-				PolicyID: utils.String(policyId.ID()),
+			FriendlyName: utils.String(id.BackupInstanceName),
+			ObjectType:   "BackupInstance",
+			PolicyInfo: backupinstances.PolicyInfo{
+				PolicyId: policyId.ID(),
 			},
 		},
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.BackupVaultName, id.ResourceGroup, id.Name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating/updating DataProtection BackupInstance (%q): %+v", id, err)
+	if v, ok := d.GetOk("storage_account_container_names"); ok {
+		parameters.Properties.PolicyInfo.PolicyParameters = &backupinstances.PolicyParameters{
+			BackupDatasourceParametersList: &[]backupinstances.BackupDatasourceParameters{
+				backupinstances.BlobBackupDatasourceParameters{
+					ContainersList: *utils.ExpandStringSlice(v.([]interface{})),
+				},
+			},
+		}
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of the DataProtection BackupInstance (%q): %+v", id, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating DataProtection BackupInstance (%q): %+v", id, err)
 	}
 
 	deadline, ok := ctx.Deadline()
@@ -129,14 +148,14 @@ func resourceDataProtectionBackupInstanceBlobStorageCreateUpdate(d *schema.Resou
 		return fmt.Errorf("context had no deadline")
 	}
 	stateConf := &pluginsdk.StateChangeConf{
-		Pending:    []string{string(dataprotection.StatusConfiguringProtection), "UpdatingProtection"},
-		Target:     []string{string(dataprotection.StatusProtectionConfigured)},
-		Refresh:    policyProtectionStateRefreshFunc(ctx, client, id),
+		Pending:    []string{string(backupinstances.StatusConfiguringProtection), "UpdatingProtection"},
+		Target:     []string{string(backupinstances.StatusProtectionConfigured)},
+		Refresh:    backupInstanceProtectionStateRefreshFunc(ctx, client, id),
 		MinTimeout: 1 * time.Minute,
 		Timeout:    time.Until(deadline),
 	}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for BackupInstance(%q) policy protection to be completed: %+v", id, err)
 	}
 
@@ -145,59 +164,73 @@ func resourceDataProtectionBackupInstanceBlobStorageCreateUpdate(d *schema.Resou
 }
 
 func resourceDataProtectionBackupInstanceBlobStorageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	client := meta.(*clients.Client).DataProtection.BackupInstancesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.BackupInstanceID(d.Id())
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.BackupVaultName, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] dataprotection %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("retrieving DataProtection BackupInstance (%q): %+v", id, err)
 	}
-	vaultId := parse.NewBackupVaultID(id.SubscriptionId, id.ResourceGroup, id.BackupVaultName)
-	d.Set("name", id.Name)
+	vaultId := parse.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.BackupVaultName)
+	d.Set("name", id.BackupInstanceName)
 	d.Set("vault_id", vaultId.ID())
-	if props := resp.Properties; props != nil {
-		if props.DataSourceInfo != nil {
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
 			d.Set("storage_account_id", props.DataSourceInfo.ResourceID)
 			d.Set("location", props.DataSourceInfo.ResourceLocation)
-		}
-		if props.PolicyInfo != nil {
-			d.Set("backup_policy_id", props.PolicyInfo.PolicyID)
+			d.Set("backup_policy_id", props.PolicyInfo.PolicyId)
+
+			containerNames := make([]interface{}, 0)
+			if params := props.PolicyInfo.PolicyParameters; params != nil && params.BackupDatasourceParametersList != nil {
+				for _, item := range *params.BackupDatasourceParametersList {
+					if v, ok := item.(backupinstances.BlobBackupDatasourceParameters); ok {
+						containerNames = append(containerNames, utils.FlattenStringSlice(&v.ContainersList)...)
+					}
+				}
+			}
+			d.Set("storage_account_container_names", containerNames)
 		}
 	}
 	return nil
 }
 
 func resourceDataProtectionBackupInstanceBlobStorageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataProtection.BackupInstanceClient
+	client := meta.(*clients.Client).DataProtection.BackupInstancesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.BackupInstanceID(d.Id())
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.BackupVaultName, id.ResourceGroup, id.Name)
-	if err != nil {
-		if response.WasNotFound(future.Response()) {
-			return nil
-		}
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting DataProtection BackupInstance (%q): %+v", id, err)
 	}
+	return nil
+}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of the DataProtection BackupInstance (%q): %+v", id.Name, err)
+func backupInstanceProtectionStateRefreshFunc(ctx context.Context, client *backupinstances.BackupInstancesClient, id backupinstances.BackupInstanceId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving DataProtection BackupInstance (%q): %+v", id, err)
+		}
+		if res.Model == nil || res.Model.Properties == nil || res.Model.Properties.ProtectionStatus == nil || res.Model.Properties.ProtectionStatus.Status == nil {
+			return nil, "", fmt.Errorf("reading DataProtection BackupInstance (%q) protection status: %+v", id, err)
+		}
+
+		return res, string(*res.Model.Properties.ProtectionStatus.Status), nil
 	}
-	return nil
 }
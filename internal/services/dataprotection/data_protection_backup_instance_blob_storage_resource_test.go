@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/sdk/2023-05-01/backupinstances"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -86,14 +87,28 @@ func TestAccDataProtectionBackupInstanceBlobStorage_update(t *testing.T) {
 	})
 }
 
+func TestAccDataProtectionBackupInstanceBlobStorage_vaultBackup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_instance_blob_storage", "test")
+	r := DataProtectionBackupInstanceBlobStorageResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.vaultBackup(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r DataProtectionBackupInstanceBlobStorageResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	id, err := parse.BackupInstanceID(state.ID)
+	id, err := backupinstances.ParseBackupInstanceID(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.DataProtection.BackupInstanceClient.Get(ctx, id.BackupVaultName, id.ResourceGroup, id.Name)
+	resp, err := client.DataProtection.BackupInstancesClient.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving DataProtection BackupInstance (%q): %+v", id, err)
@@ -198,3 +213,33 @@ resource "azurerm_data_protection_backup_instance_blob_storage" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r DataProtectionBackupInstanceBlobStorageResource) vaultBackup(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestsc-%d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_data_protection_backup_policy_blob_storage" "vault" {
+  name                             = "acctest-dbp-vault-%d"
+  vault_id                         = azurerm_data_protection_backup_vault.test.id
+  vault_default_retention_duration = "P7D"
+  backup_repeating_time_intervals  = ["R/2021-05-23T02:30:00+00:00/P1W"]
+}
+
+resource "azurerm_data_protection_backup_instance_blob_storage" "test" {
+  name                            = "acctest-dbi-%d"
+  location                        = azurerm_resource_group.test.location
+  vault_id                        = azurerm_data_protection_backup_vault.test.id
+  storage_account_id              = azurerm_storage_account.test.id
+  backup_policy_id                = azurerm_data_protection_backup_policy_blob_storage.vault.id
+  storage_account_container_names = [azurerm_storage_container.test.name]
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, template, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
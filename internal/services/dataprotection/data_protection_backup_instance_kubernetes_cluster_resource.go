@@ -0,0 +1,351 @@
+package dataprotection
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	containersParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	containersValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/sdk/2023-05-01/backupinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataProtectionBackupInstanceKubernetesCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataProtectionBackupInstanceKubernetesClusterCreateUpdate,
+		Read:   resourceDataProtectionBackupInstanceKubernetesClusterRead,
+		Update: resourceDataProtectionBackupInstanceKubernetesClusterCreateUpdate,
+		Delete: resourceDataProtectionBackupInstanceKubernetesClusterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := backupinstances.ParseBackupInstanceID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": location.Schema(),
+
+			"vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.BackupVaultID,
+			},
+
+			"kubernetes_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containersValidate.ClusterID,
+			},
+
+			"snapshot_resource_group_name": azure.SchemaResourceGroupName(),
+
+			"backup_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.BackupPolicyID,
+			},
+
+			"backup_datasource_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_scoped_resources_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"excluded_namespaces": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"excluded_resource_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"included_namespaces": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"included_resource_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"label_selectors": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"volume_snapshot_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataProtectionBackupInstanceKubernetesClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).DataProtection.BackupInstancesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	vaultId, _ := parse.BackupVaultID(d.Get("vault_id").(string))
+	id := backupinstances.NewBackupInstanceID(subscriptionId, vaultId.ResourceGroup, vaultId.Name, name)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing DataProtection BackupInstance (%q): %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_protection_backup_instance_kubernetes_cluster", id.ID())
+		}
+	}
+
+	clusterId, _ := containersParse.ClusterID(d.Get("kubernetes_cluster_id").(string))
+	location := location.Normalize(d.Get("location").(string))
+	policyId, _ := parse.BackupPolicyID(d.Get("backup_policy_id").(string))
+	snapshotResourceGroupId := commonids.NewResourceGroupID(subscriptionId, d.Get("snapshot_resource_group_name").(string))
+
+	parameters := backupinstances.BackupInstanceResource{
+		Properties: &backupinstances.BackupInstance{
+			DataSourceInfo: backupinstances.Datasource{
+				DatasourceType:   utils.String("Microsoft.ContainerService/managedClusters"),
+				ObjectType:       utils.String("Datasource"),
+				ResourceID:       clusterId.ID(),
+				ResourceLocation: utils.String(location),
+				ResourceName:     utils.String(clusterId.ManagedClusterName),
+				ResourceType:     utils.String("Microsoft.ContainerService/managedClusters"),
+				ResourceUri:      utils.String(clusterId.ID()),
+			},
+			DataSourceSetInfo: &backupinstances.DatasourceSet{
+				DatasourceType:   utils.String("Microsoft.ContainerService/managedClusters"),
+				ObjectType:       utils.String("DatasourceSet"),
+				ResourceID:       clusterId.ID(),
+				ResourceLocation: utils.String(location),
+				ResourceName:     utils.String(clusterId.ManagedClusterName),
+				ResourceType:     utils.String("Microsoft.ContainerService/managedClusters"),
+				ResourceUri:      utils.String(clusterId.ID()),
+			},
+			FriendlyName: utils.String(id.BackupInstanceName),
+			ObjectType:   "BackupInstance",
+			PolicyInfo: backupinstances.PolicyInfo{
+				PolicyId: policyId.ID(),
+				PolicyParameters: &backupinstances.PolicyParameters{
+					BackupDatasourceParametersList: expandBackupInstanceKubernetesClusterBackupDatasourceParameters(d.Get("backup_datasource_parameters").([]interface{})),
+					DataStoreParametersList: &[]backupinstances.DataStoreParameters{
+						backupinstances.AzureOperationalStoreParameters{
+							DataStoreType:   backupinstances.DataStoreTypesOperationalStore,
+							ResourceGroupId: utils.String(snapshotResourceGroupId.ID()),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating DataProtection BackupInstance (%q): %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(backupinstances.StatusConfiguringProtection), "UpdatingProtection"},
+		Target:     []string{string(backupinstances.StatusProtectionConfigured)},
+		Refresh:    backupInstanceProtectionStateRefreshFunc(ctx, client, id),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for BackupInstance(%q) policy protection to be completed: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataProtectionBackupInstanceKubernetesClusterRead(d, meta)
+}
+
+func resourceDataProtectionBackupInstanceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataProtection.BackupInstancesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] dataprotection %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving DataProtection BackupInstance (%q): %+v", id, err)
+	}
+	vaultId := parse.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.BackupVaultName)
+	d.Set("name", id.BackupInstanceName)
+	d.Set("vault_id", vaultId.ID())
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("kubernetes_cluster_id", props.DataSourceInfo.ResourceID)
+			d.Set("location", props.DataSourceInfo.ResourceLocation)
+			d.Set("backup_policy_id", props.PolicyInfo.PolicyId)
+
+			if params := props.PolicyInfo.PolicyParameters; params != nil {
+				if params.DataStoreParametersList != nil {
+					for _, item := range *params.DataStoreParametersList {
+						if v, ok := item.(backupinstances.AzureOperationalStoreParameters); ok && v.ResourceGroupId != nil {
+							resourceGroupId, err := commonids.ParseResourceGroupIDInsensitively(*v.ResourceGroupId)
+							if err != nil {
+								return err
+							}
+							d.Set("snapshot_resource_group_name", resourceGroupId.ResourceGroupName)
+						}
+					}
+				}
+
+				if err := d.Set("backup_datasource_parameters", flattenBackupInstanceKubernetesClusterBackupDatasourceParameters(params.BackupDatasourceParametersList)); err != nil {
+					return fmt.Errorf("setting `backup_datasource_parameters`: %+v", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func resourceDataProtectionBackupInstanceKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataProtection.BackupInstancesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := backupinstances.ParseBackupInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting DataProtection BackupInstance (%q): %+v", id, err)
+	}
+	return nil
+}
+
+func expandBackupInstanceKubernetesClusterBackupDatasourceParameters(input []interface{}) *[]backupinstances.BackupDatasourceParameters {
+	if len(input) == 0 || input[0] == nil {
+		return &[]backupinstances.BackupDatasourceParameters{
+			backupinstances.KubernetesClusterBackupDatasourceParameters{},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &[]backupinstances.BackupDatasourceParameters{
+		backupinstances.KubernetesClusterBackupDatasourceParameters{
+			ExcludedNamespaces:           utils.ExpandStringSlice(v["excluded_namespaces"].([]interface{})),
+			ExcludedResourceTypes:        utils.ExpandStringSlice(v["excluded_resource_types"].([]interface{})),
+			IncludeClusterScopeResources: v["cluster_scoped_resources_enabled"].(bool),
+			IncludedNamespaces:           utils.ExpandStringSlice(v["included_namespaces"].([]interface{})),
+			IncludedResourceTypes:        utils.ExpandStringSlice(v["included_resource_types"].([]interface{})),
+			LabelSelectors:               utils.ExpandStringSlice(v["label_selectors"].([]interface{})),
+			SnapshotVolumes:              v["volume_snapshot_enabled"].(bool),
+		},
+	}
+}
+
+func flattenBackupInstanceKubernetesClusterBackupDatasourceParameters(input *[]backupinstances.BackupDatasourceParameters) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if v, ok := item.(backupinstances.KubernetesClusterBackupDatasourceParameters); ok {
+			// the API returns the parameters even when none were specified, so omit them when they're all unset
+			if !v.IncludeClusterScopeResources && !v.SnapshotVolumes && isEmptyStringSlice(v.ExcludedNamespaces) && isEmptyStringSlice(v.ExcludedResourceTypes) &&
+				isEmptyStringSlice(v.IncludedNamespaces) && isEmptyStringSlice(v.IncludedResourceTypes) && isEmptyStringSlice(v.LabelSelectors) {
+				continue
+			}
+
+			results = append(results, map[string]interface{}{
+				"cluster_scoped_resources_enabled": v.IncludeClusterScopeResources,
+				"excluded_namespaces":              utils.FlattenStringSlice(v.ExcludedNamespaces),
+				"excluded_resource_types":          utils.FlattenStringSlice(v.ExcludedResourceTypes),
+				"included_namespaces":              utils.FlattenStringSlice(v.IncludedNamespaces),
+				"included_resource_types":          utils.FlattenStringSlice(v.IncludedResourceTypes),
+				"label_selectors":                  utils.FlattenStringSlice(v.LabelSelectors),
+				"volume_snapshot_enabled":          v.SnapshotVolumes,
+			})
+		}
+	}
+	return results
+}
+
+func isEmptyStringSlice(input *[]string) bool {
+	return input == nil || len(*input) == 0
+}
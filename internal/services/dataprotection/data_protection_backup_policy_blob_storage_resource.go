@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest/date"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	helperValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -13,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/legacysdk/dataprotection"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -57,13 +61,137 @@ func resourceDataProtectionBackupPolicyBlobStorage() *schema.Resource {
 
 			"retention_duration": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: helperValidate.ISO8601Duration,
+				AtLeastOneOf: []string{"retention_duration", "vault_default_retention_duration"},
+			},
+
+			"vault_default_retention_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: helperValidate.ISO8601Duration,
+				AtLeastOneOf: []string{"retention_duration", "vault_default_retention_duration"},
+				RequiredWith: []string{"backup_repeating_time_intervals"},
+			},
+
+			"backup_repeating_time_intervals": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				RequiredWith: []string{"vault_default_retention_duration"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"retention_rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"vault_default_retention_duration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"duration": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: helperValidate.ISO8601Duration,
+						},
+
+						"criteria": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_criteria": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(dataprotection.AbsoluteMarkerAllBackup),
+											string(dataprotection.AbsoluteMarkerFirstOfDay),
+											string(dataprotection.AbsoluteMarkerFirstOfMonth),
+											string(dataprotection.AbsoluteMarkerFirstOfWeek),
+											string(dataprotection.AbsoluteMarkerFirstOfYear),
+										}, false),
+									},
+
+									"days_of_week": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsDayOfTheWeek(false),
+										},
+									},
+
+									"months_of_year": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsMonth(false),
+										},
+									},
+
+									"scheduled_backup_times": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsRFC3339Time,
+										},
+									},
+
+									"weeks_of_month": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(dataprotection.WeekNumberFirst),
+												string(dataprotection.WeekNumberSecond),
+												string(dataprotection.WeekNumberThird),
+												string(dataprotection.WeekNumberFourth),
+												string(dataprotection.WeekNumberLast),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
 			},
 		},
 	}
 }
+
 func resourceDataProtectionBackupPolicyBlobStorageCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).DataProtection.BackupPolicyClient
@@ -84,28 +212,17 @@ func resourceDataProtectionBackupPolicyBlobStorageCreate(d *schema.ResourceData,
 		return tf.ImportAsExistsError("azurerm_data_protection_backup_policy_blob_storage", id.ID())
 	}
 
+	policyRules := make([]dataprotection.BasicBasePolicyRule, 0)
+	if v, ok := d.GetOk("backup_repeating_time_intervals"); ok {
+		taggingCriteria := expandBackupPolicyBlobStorageTaggingCriteriaArray(d.Get("retention_rule").([]interface{}))
+		policyRules = append(policyRules, expandBackupPolicyBlobStorageAzureBackupRule(v.([]interface{}), taggingCriteria))
+	}
+	policyRules = append(policyRules, expandBackupPolicyBlobStorageDefaultAzureRetentionRule(d.Get("retention_duration").(string), d.Get("vault_default_retention_duration").(string)))
+	policyRules = append(policyRules, expandBackupPolicyBlobStorageAzureRetentionRuleArray(d.Get("retention_rule").([]interface{}))...)
+
 	parameters := dataprotection.BaseBackupPolicyResource{
 		Properties: &dataprotection.BackupPolicy{
-			PolicyRules: &[]dataprotection.BasicBasePolicyRule{
-				dataprotection.AzureRetentionRule{
-					Name:       utils.String("Default"),
-					ObjectType: dataprotection.ObjectTypeBasicBasePolicyRuleObjectTypeAzureRetentionRule,
-					IsDefault:  utils.Bool(true),
-					Lifecycles: &[]dataprotection.SourceLifeCycle{
-						{
-							DeleteAfter: dataprotection.AbsoluteDeleteOption{
-								Duration:   utils.String(d.Get("retention_duration").(string)),
-								ObjectType: dataprotection.ObjectTypeBasicDeleteOptionObjectTypeAbsoluteDeleteOption,
-							},
-							SourceDataStore: &dataprotection.DataStoreInfoBase{
-								DataStoreType: "OperationalStore",
-								ObjectType:    utils.String("DataStoreInfoBase"),
-							},
-							TargetDataStoreCopySettings: &[]dataprotection.TargetCopySetting{},
-						},
-					},
-				},
-			},
+			PolicyRules:     &policyRules,
 			DatasourceTypes: &[]string{"Microsoft.Storage/storageAccounts/blobServices"},
 			ObjectType:      dataprotection.ObjectTypeBasicBaseBackupPolicyObjectTypeBackupPolicy,
 		},
@@ -143,8 +260,17 @@ func resourceDataProtectionBackupPolicyBlobStorageRead(d *schema.ResourceData, m
 	d.Set("vault_id", vaultId.ID())
 	if resp.Properties != nil {
 		if props, ok := resp.Properties.AsBackupPolicy(); ok {
-			if err := d.Set("retention_duration", flattenBackupPolicyBlobStorageDefaultRetentionRuleDuration(props.PolicyRules)); err != nil {
-				return fmt.Errorf("setting `default_retention_duration`: %+v", err)
+			if err := d.Set("retention_duration", flattenBackupPolicyBlobStorageDefaultRetentionRuleDuration(props.PolicyRules, dataprotection.DataStoreTypesOperationalStore)); err != nil {
+				return fmt.Errorf("setting `retention_duration`: %+v", err)
+			}
+			if err := d.Set("vault_default_retention_duration", flattenBackupPolicyBlobStorageDefaultRetentionRuleDuration(props.PolicyRules, dataprotection.DataStoreTypesVaultStore)); err != nil {
+				return fmt.Errorf("setting `vault_default_retention_duration`: %+v", err)
+			}
+			if err := d.Set("backup_repeating_time_intervals", flattenBackupPolicyBlobStorageBackupRuleArray(props.PolicyRules)); err != nil {
+				return fmt.Errorf("setting `backup_repeating_time_intervals`: %+v", err)
+			}
+			if err := d.Set("retention_rule", flattenBackupPolicyBlobStorageRetentionRuleArray(props.PolicyRules)); err != nil {
+				return fmt.Errorf("setting `retention_rule`: %+v", err)
 			}
 		}
 	}
@@ -171,15 +297,189 @@ func resourceDataProtectionBackupPolicyBlobStorageDelete(d *schema.ResourceData,
 	return nil
 }
 
-func flattenBackupPolicyBlobStorageDefaultRetentionRuleDuration(input *[]dataprotection.BasicBasePolicyRule) interface{} {
+func expandBackupPolicyBlobStorageAzureBackupRule(input []interface{}, taggingCriteria *[]dataprotection.TaggingCriteria) dataprotection.BasicBasePolicyRule {
+	return dataprotection.AzureBackupRule{
+		Name:       utils.String("BackupIntervals"),
+		ObjectType: dataprotection.ObjectTypeBasicBasePolicyRuleObjectTypeAzureBackupRule,
+		DataStore: &dataprotection.DataStoreInfoBase{
+			DataStoreType: dataprotection.DataStoreTypesVaultStore,
+			ObjectType:    utils.String("DataStoreInfoBase"),
+		},
+		BackupParameters: &dataprotection.AzureBackupParams{
+			BackupType: utils.String("Discrete"),
+			ObjectType: dataprotection.ObjectTypeBasicBackupParametersObjectTypeAzureBackupParams,
+		},
+		Trigger: dataprotection.ScheduleBasedTriggerContext{
+			Schedule: &dataprotection.BackupSchedule{
+				RepeatingTimeIntervals: utils.ExpandStringSlice(input),
+			},
+			TaggingCriteria: taggingCriteria,
+			ObjectType:      dataprotection.ObjectTypeBasicTriggerContextObjectTypeScheduleBasedTriggerContext,
+		},
+	}
+}
+
+func expandBackupPolicyBlobStorageDefaultAzureRetentionRule(operationalDuration, vaultDuration string) dataprotection.BasicBasePolicyRule {
+	lifeCycles := make([]dataprotection.SourceLifeCycle, 0)
+	if operationalDuration != "" {
+		lifeCycles = append(lifeCycles, expandBackupPolicyBlobStorageLifeCycle(operationalDuration, dataprotection.DataStoreTypesOperationalStore))
+	}
+	if vaultDuration != "" {
+		lifeCycles = append(lifeCycles, expandBackupPolicyBlobStorageLifeCycle(vaultDuration, dataprotection.DataStoreTypesVaultStore))
+	}
+
+	return dataprotection.AzureRetentionRule{
+		Name:       utils.String("Default"),
+		ObjectType: dataprotection.ObjectTypeBasicBasePolicyRuleObjectTypeAzureRetentionRule,
+		IsDefault:  utils.Bool(true),
+		Lifecycles: &lifeCycles,
+	}
+}
+
+func expandBackupPolicyBlobStorageAzureRetentionRuleArray(input []interface{}) []dataprotection.BasicBasePolicyRule {
+	results := make([]dataprotection.BasicBasePolicyRule, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, dataprotection.AzureRetentionRule{
+			Name:       utils.String(v["name"].(string)),
+			ObjectType: dataprotection.ObjectTypeBasicBasePolicyRuleObjectTypeAzureRetentionRule,
+			IsDefault:  utils.Bool(false),
+			Lifecycles: &[]dataprotection.SourceLifeCycle{
+				expandBackupPolicyBlobStorageLifeCycle(v["duration"].(string), dataprotection.DataStoreTypesVaultStore),
+			},
+		})
+	}
+	return results
+}
+
+func expandBackupPolicyBlobStorageLifeCycle(duration string, dataStoreType dataprotection.DataStoreTypes) dataprotection.SourceLifeCycle {
+	return dataprotection.SourceLifeCycle{
+		DeleteAfter: dataprotection.AbsoluteDeleteOption{
+			Duration:   utils.String(duration),
+			ObjectType: dataprotection.ObjectTypeBasicDeleteOptionObjectTypeAbsoluteDeleteOption,
+		},
+		SourceDataStore: &dataprotection.DataStoreInfoBase{
+			DataStoreType: dataStoreType,
+			ObjectType:    utils.String("DataStoreInfoBase"),
+		},
+		TargetDataStoreCopySettings: &[]dataprotection.TargetCopySetting{},
+	}
+}
+
+func expandBackupPolicyBlobStorageTaggingCriteriaArray(input []interface{}) *[]dataprotection.TaggingCriteria {
+	results := []dataprotection.TaggingCriteria{
+		{
+			Criteria:        nil,
+			IsDefault:       utils.Bool(true),
+			TaggingPriority: utils.Int64(99),
+			TagInfo: &dataprotection.RetentionTag{
+				ID:      utils.String("Default_"),
+				TagName: utils.String("Default"),
+			},
+		},
+	}
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, dataprotection.TaggingCriteria{
+			Criteria:        expandBackupPolicyBlobStorageCriteriaArray(v["criteria"].([]interface{})),
+			IsDefault:       utils.Bool(false),
+			TaggingPriority: utils.Int64(int64(v["priority"].(int))),
+			TagInfo: &dataprotection.RetentionTag{
+				ID:      utils.String(v["name"].(string) + "_"),
+				TagName: utils.String(v["name"].(string)),
+			},
+		})
+	}
+	return &results
+}
+
+func expandBackupPolicyBlobStorageCriteriaArray(input []interface{}) *[]dataprotection.BasicBackupCriteria {
+	results := make([]dataprotection.BasicBackupCriteria, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		var absoluteCriteria []dataprotection.AbsoluteMarker
+		if absoluteCriteriaRaw := v["absolute_criteria"].(string); len(absoluteCriteriaRaw) > 0 {
+			absoluteCriteria = []dataprotection.AbsoluteMarker{dataprotection.AbsoluteMarker(absoluteCriteriaRaw)}
+		}
+
+		var daysOfWeek []dataprotection.DayOfWeek
+		if v["days_of_week"].(*pluginsdk.Set).Len() > 0 {
+			daysOfWeek = make([]dataprotection.DayOfWeek, 0)
+			for _, value := range v["days_of_week"].(*pluginsdk.Set).List() {
+				daysOfWeek = append(daysOfWeek, dataprotection.DayOfWeek(value.(string)))
+			}
+		}
+
+		var monthsOfYear []dataprotection.Month
+		if v["months_of_year"].(*pluginsdk.Set).Len() > 0 {
+			monthsOfYear = make([]dataprotection.Month, 0)
+			for _, value := range v["months_of_year"].(*pluginsdk.Set).List() {
+				monthsOfYear = append(monthsOfYear, dataprotection.Month(value.(string)))
+			}
+		}
+
+		var weeksOfMonth []dataprotection.WeekNumber
+		if v["weeks_of_month"].(*pluginsdk.Set).Len() > 0 {
+			weeksOfMonth = make([]dataprotection.WeekNumber, 0)
+			for _, value := range v["weeks_of_month"].(*pluginsdk.Set).List() {
+				weeksOfMonth = append(weeksOfMonth, dataprotection.WeekNumber(value.(string)))
+			}
+		}
+
+		var scheduleTimes []date.Time
+		if v["scheduled_backup_times"].(*pluginsdk.Set).Len() > 0 {
+			scheduleTimes = make([]date.Time, 0)
+			for _, value := range v["scheduled_backup_times"].(*pluginsdk.Set).List() {
+				t, _ := time.Parse(time.RFC3339, value.(string))
+				scheduleTimes = append(scheduleTimes, date.Time{Time: t})
+			}
+		}
+		results = append(results, dataprotection.ScheduleBasedBackupCriteria{
+			AbsoluteCriteria: &absoluteCriteria,
+			DaysOfMonth:      nil,
+			DaysOfTheWeek:    &daysOfWeek,
+			MonthsOfYear:     &monthsOfYear,
+			ScheduleTimes:    &scheduleTimes,
+			WeeksOfTheMonth:  &weeksOfMonth,
+			ObjectType:       dataprotection.ObjectTypeBasicBackupCriteriaObjectTypeScheduleBasedBackupCriteria,
+		})
+	}
+	return &results
+}
+
+func flattenBackupPolicyBlobStorageBackupRuleArray(input *[]dataprotection.BasicBasePolicyRule) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+	for _, item := range *input {
+		if backupRule, ok := item.AsAzureBackupRule(); ok {
+			if backupRule.Trigger != nil {
+				if scheduleBasedTrigger, ok := backupRule.Trigger.AsScheduleBasedTriggerContext(); ok {
+					if scheduleBasedTrigger.Schedule != nil {
+						return utils.FlattenStringSlice(scheduleBasedTrigger.Schedule.RepeatingTimeIntervals)
+					}
+				}
+			}
+		}
+	}
+	return make([]interface{}, 0)
+}
+
+func flattenBackupPolicyBlobStorageDefaultRetentionRuleDuration(input *[]dataprotection.BasicBasePolicyRule, dataStoreType dataprotection.DataStoreTypes) interface{} {
 	if input == nil {
 		return nil
 	}
 
 	for _, item := range *input {
 		if retentionRule, ok := item.AsAzureRetentionRule(); ok && retentionRule.IsDefault != nil && *retentionRule.IsDefault {
-			if retentionRule.Lifecycles != nil && len(*retentionRule.Lifecycles) > 0 {
-				if deleteOption, ok := (*retentionRule.Lifecycles)[0].DeleteAfter.AsAbsoluteDeleteOption(); ok {
+			if retentionRule.Lifecycles == nil {
+				continue
+			}
+			for _, lifeCycle := range *retentionRule.Lifecycles {
+				if lifeCycle.SourceDataStore == nil || lifeCycle.SourceDataStore.DataStoreType != dataStoreType {
+					continue
+				}
+				if deleteOption, ok := lifeCycle.DeleteAfter.AsAbsoluteDeleteOption(); ok {
 					return *deleteOption.Duration
 				}
 			}
@@ -187,3 +487,104 @@ func flattenBackupPolicyBlobStorageDefaultRetentionRuleDuration(input *[]datapro
 	}
 	return nil
 }
+
+func flattenBackupPolicyBlobStorageRetentionRuleArray(input *[]dataprotection.BasicBasePolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	var taggingCriterias []dataprotection.TaggingCriteria
+	for _, item := range *input {
+		if backupRule, ok := item.AsAzureBackupRule(); ok {
+			if trigger, ok := backupRule.Trigger.AsScheduleBasedTriggerContext(); ok {
+				if trigger.TaggingCriteria != nil {
+					taggingCriterias = *trigger.TaggingCriteria
+				}
+			}
+		}
+	}
+
+	for _, item := range *input {
+		if retentionRule, ok := item.AsAzureRetentionRule(); ok && (retentionRule.IsDefault == nil || !*retentionRule.IsDefault) {
+			var name string
+			if retentionRule.Name != nil {
+				name = *retentionRule.Name
+			}
+			var taggingPriority int64
+			var taggingCriteria []interface{}
+			for _, criteria := range taggingCriterias {
+				if criteria.TagInfo != nil && criteria.TagInfo.TagName != nil && strings.EqualFold(*criteria.TagInfo.TagName, name) {
+					taggingPriority = *criteria.TaggingPriority
+					taggingCriteria = flattenBackupPolicyBlobStorageBackupCriteriaArray(criteria.Criteria)
+				}
+			}
+			var duration string
+			if retentionRule.Lifecycles != nil && len(*retentionRule.Lifecycles) > 0 {
+				if deleteOption, ok := (*retentionRule.Lifecycles)[0].DeleteAfter.AsAbsoluteDeleteOption(); ok {
+					duration = *deleteOption.Duration
+				}
+			}
+			results = append(results, map[string]interface{}{
+				"name":     name,
+				"priority": taggingPriority,
+				"criteria": taggingCriteria,
+				"duration": duration,
+			})
+		}
+	}
+	return results
+}
+
+func flattenBackupPolicyBlobStorageBackupCriteriaArray(input *[]dataprotection.BasicBackupCriteria) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if criteria, ok := item.AsScheduleBasedBackupCriteria(); ok {
+			var absoluteCriteria string
+			if criteria.AbsoluteCriteria != nil && len(*criteria.AbsoluteCriteria) > 0 {
+				absoluteCriteria = string((*criteria.AbsoluteCriteria)[0])
+			}
+			var daysOfWeek []string
+			if criteria.DaysOfTheWeek != nil {
+				daysOfWeek = make([]string, 0)
+				for _, item := range *criteria.DaysOfTheWeek {
+					daysOfWeek = append(daysOfWeek, (string)(item))
+				}
+			}
+			var monthsOfYear []string
+			if criteria.MonthsOfYear != nil {
+				monthsOfYear = make([]string, 0)
+				for _, item := range *criteria.MonthsOfYear {
+					monthsOfYear = append(monthsOfYear, (string)(item))
+				}
+			}
+			var weeksOfMonth []string
+			if criteria.WeeksOfTheMonth != nil {
+				weeksOfMonth = make([]string, 0)
+				for _, item := range *criteria.WeeksOfTheMonth {
+					weeksOfMonth = append(weeksOfMonth, (string)(item))
+				}
+			}
+			var scheduleTimes []string
+			if criteria.ScheduleTimes != nil {
+				scheduleTimes = make([]string, 0)
+				for _, item := range *criteria.ScheduleTimes {
+					scheduleTimes = append(scheduleTimes, item.String())
+				}
+			}
+
+			results = append(results, map[string]interface{}{
+				"absolute_criteria":      absoluteCriteria,
+				"days_of_week":           daysOfWeek,
+				"months_of_year":         monthsOfYear,
+				"weeks_of_month":         weeksOfMonth,
+				"scheduled_backup_times": scheduleTimes,
+			})
+		}
+	}
+	return results
+}
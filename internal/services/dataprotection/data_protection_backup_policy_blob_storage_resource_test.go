@@ -44,6 +44,20 @@ func TestAccDataProtectionBackupPolicyBlobStorage_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccDataProtectionBackupPolicyBlobStorage_vaultBackup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_policy_blob_storage", "test")
+	r := DataProtectionBackupPolicyBlobStorageResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.vaultBackup(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r DataProtectionBackupPolicyBlobStorageResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.BackupPolicyID(state.ID)
 	if err != nil {
@@ -93,6 +107,42 @@ resource "azurerm_data_protection_backup_policy_blob_storage" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r DataProtectionBackupPolicyBlobStorageResource) vaultBackup(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_protection_backup_policy_blob_storage" "test" {
+  name                             = "acctest-dbp-%d"
+  vault_id                         = azurerm_data_protection_backup_vault.test.id
+  retention_duration               = "P30D"
+  vault_default_retention_duration = "P7D"
+  backup_repeating_time_intervals  = ["R/2021-05-23T02:30:00+00:00/P1W"]
+
+  retention_rule {
+    name     = "Weekly"
+    duration = "P6M"
+    priority = 20
+
+    criteria {
+      absolute_criteria = "FirstOfWeek"
+    }
+  }
+
+  retention_rule {
+    name     = "Monthly"
+    duration = "P1Y"
+    priority = 15
+
+    criteria {
+      days_of_week   = ["Sunday"]
+      weeks_of_month = ["First"]
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r DataProtectionBackupPolicyBlobStorageResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,592 @@
+package dataprotection
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	helperValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/legacysdk/dataprotection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataProtectionBackupPolicyKubernetesCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataProtectionBackupPolicyKubernetesClusterCreate,
+		Read:   resourceDataProtectionBackupPolicyKubernetesClusterRead,
+		Delete: resourceDataProtectionBackupPolicyKubernetesClusterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.BackupPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[-a-zA-Z0-9]{3,150}$"),
+					"DataProtection BackupPolicy name must be 3 - 150 characters long, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.BackupVaultID,
+			},
+
+			"backup_repeating_time_intervals": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"default_retention_duration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: helperValidate.ISO8601Duration,
+			},
+
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"retention_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"duration": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: helperValidate.ISO8601Duration,
+						},
+
+						"criteria": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_criteria": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(dataprotection.AbsoluteMarkerAllBackup),
+											string(dataprotection.AbsoluteMarkerFirstOfDay),
+											string(dataprotection.AbsoluteMarkerFirstOfMonth),
+											string(dataprotection.AbsoluteMarkerFirstOfWeek),
+											string(dataprotection.AbsoluteMarkerFirstOfYear),
+										}, false),
+									},
+
+									"days_of_week": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsDayOfTheWeek(false),
+										},
+									},
+
+									"months_of_year": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsMonth(false),
+										},
+									},
+
+									"scheduled_backup_times": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsRFC3339Time,
+										},
+									},
+
+									"weeks_of_month": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(dataprotection.WeekNumberFirst),
+												string(dataprotection.WeekNumberSecond),
+												string(dataprotection.WeekNumberThird),
+												string(dataprotection.WeekNumberFourth),
+												string(dataprotection.WeekNumberLast),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataProtectionBackupPolicyKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).DataProtection.BackupPolicyClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	vaultId, _ := parse.BackupVaultID(d.Get("vault_id").(string))
+	id := parse.NewBackupPolicyID(subscriptionId, vaultId.ResourceGroup, vaultId.Name, name)
+
+	existing, err := client.Get(ctx, id.BackupVaultName, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing DataProtection BackupPolicy (%q): %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_data_protection_backup_policy_kubernetes_cluster", id.ID())
+	}
+
+	taggingCriteria := expandBackupPolicyKubernetesClusterTaggingCriteriaArray(d.Get("retention_rule").([]interface{}))
+	policyRules := make([]dataprotection.BasicBasePolicyRule, 0)
+	policyRules = append(policyRules, expandBackupPolicyKubernetesClusterAzureBackupRule(d.Get("backup_repeating_time_intervals").([]interface{}), d.Get("time_zone").(string), taggingCriteria))
+	policyRules = append(policyRules, expandBackupPolicyKubernetesClusterDefaultAzureRetentionRule(d.Get("default_retention_duration").(string)))
+	policyRules = append(policyRules, expandBackupPolicyKubernetesClusterAzureRetentionRuleArray(d.Get("retention_rule").([]interface{}))...)
+
+	parameters := dataprotection.BaseBackupPolicyResource{
+		Properties: &dataprotection.BackupPolicy{
+			PolicyRules:     &policyRules,
+			DatasourceTypes: &[]string{"Microsoft.ContainerService/managedClusters"},
+			ObjectType:      dataprotection.ObjectTypeBasicBaseBackupPolicyObjectTypeBackupPolicy,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.BackupVaultName, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating DataProtection BackupPolicy (%q): %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataProtectionBackupPolicyKubernetesClusterRead(d, meta)
+}
+
+func resourceDataProtectionBackupPolicyKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataProtection.BackupPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.BackupVaultName, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] dataprotection %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving DataProtection BackupPolicy (%q): %+v", id, err)
+	}
+	vaultId := parse.NewBackupVaultID(id.SubscriptionId, id.ResourceGroup, id.BackupVaultName)
+	d.Set("name", id.Name)
+	d.Set("vault_id", vaultId.ID())
+	if resp.Properties != nil {
+		if props, ok := resp.Properties.AsBackupPolicy(); ok {
+			if err := d.Set("backup_repeating_time_intervals", flattenBackupPolicyKubernetesClusterBackupRuleArray(props.PolicyRules)); err != nil {
+				return fmt.Errorf("setting `backup_repeating_time_intervals`: %+v", err)
+			}
+			if err := d.Set("default_retention_duration", flattenBackupPolicyKubernetesClusterDefaultRetentionRuleDuration(props.PolicyRules)); err != nil {
+				return fmt.Errorf("setting `default_retention_duration`: %+v", err)
+			}
+			d.Set("time_zone", flattenBackupPolicyKubernetesClusterBackupTimeZone(props.PolicyRules))
+			if err := d.Set("retention_rule", flattenBackupPolicyKubernetesClusterRetentionRuleArray(props.PolicyRules)); err != nil {
+				return fmt.Errorf("setting `retention_rule`: %+v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceDataProtectionBackupPolicyKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataProtection.BackupPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.BackupVaultName, id.ResourceGroup, id.Name); err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting DataProtection BackupPolicy (%q): %+v", id, err)
+	}
+	return nil
+}
+
+func expandBackupPolicyKubernetesClusterAzureBackupRule(input []interface{}, timeZone string, taggingCriteria *[]dataprotection.TaggingCriteria) dataprotection.BasicBasePolicyRule {
+	schedule := dataprotection.BackupSchedule{
+		RepeatingTimeIntervals: utils.ExpandStringSlice(input),
+	}
+	if timeZone != "" {
+		schedule.TimeZone = utils.String(timeZone)
+	}
+
+	return dataprotection.AzureBackupRule{
+		Name:       utils.String("BackupIntervals"),
+		ObjectType: dataprotection.ObjectTypeBasicBasePolicyRuleObjectTypeAzureBackupRule,
+		DataStore: &dataprotection.DataStoreInfoBase{
+			DataStoreType: dataprotection.DataStoreTypesOperationalStore,
+			ObjectType:    utils.String("DataStoreInfoBase"),
+		},
+		BackupParameters: &dataprotection.AzureBackupParams{
+			BackupType: utils.String("Incremental"),
+			ObjectType: dataprotection.ObjectTypeBasicBackupParametersObjectTypeAzureBackupParams,
+		},
+		Trigger: dataprotection.ScheduleBasedTriggerContext{
+			Schedule:        &schedule,
+			TaggingCriteria: taggingCriteria,
+			ObjectType:      dataprotection.ObjectTypeBasicTriggerContextObjectTypeScheduleBasedTriggerContext,
+		},
+	}
+}
+
+func expandBackupPolicyKubernetesClusterDefaultAzureRetentionRule(input string) dataprotection.BasicBasePolicyRule {
+	return dataprotection.AzureRetentionRule{
+		Name:       utils.String("Default"),
+		ObjectType: dataprotection.ObjectTypeBasicBasePolicyRuleObjectTypeAzureRetentionRule,
+		IsDefault:  utils.Bool(true),
+		Lifecycles: &[]dataprotection.SourceLifeCycle{
+			expandBackupPolicyKubernetesClusterLifeCycle(input),
+		},
+	}
+}
+
+func expandBackupPolicyKubernetesClusterAzureRetentionRuleArray(input []interface{}) []dataprotection.BasicBasePolicyRule {
+	results := make([]dataprotection.BasicBasePolicyRule, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, dataprotection.AzureRetentionRule{
+			Name:       utils.String(v["name"].(string)),
+			ObjectType: dataprotection.ObjectTypeBasicBasePolicyRuleObjectTypeAzureRetentionRule,
+			IsDefault:  utils.Bool(false),
+			Lifecycles: &[]dataprotection.SourceLifeCycle{
+				expandBackupPolicyKubernetesClusterLifeCycle(v["duration"].(string)),
+			},
+		})
+	}
+	return results
+}
+
+func expandBackupPolicyKubernetesClusterLifeCycle(duration string) dataprotection.SourceLifeCycle {
+	return dataprotection.SourceLifeCycle{
+		DeleteAfter: dataprotection.AbsoluteDeleteOption{
+			Duration:   utils.String(duration),
+			ObjectType: dataprotection.ObjectTypeBasicDeleteOptionObjectTypeAbsoluteDeleteOption,
+		},
+		SourceDataStore: &dataprotection.DataStoreInfoBase{
+			DataStoreType: dataprotection.DataStoreTypesOperationalStore,
+			ObjectType:    utils.String("DataStoreInfoBase"),
+		},
+		TargetDataStoreCopySettings: &[]dataprotection.TargetCopySetting{},
+	}
+}
+
+func expandBackupPolicyKubernetesClusterTaggingCriteriaArray(input []interface{}) *[]dataprotection.TaggingCriteria {
+	results := []dataprotection.TaggingCriteria{
+		{
+			Criteria:        nil,
+			IsDefault:       utils.Bool(true),
+			TaggingPriority: utils.Int64(99),
+			TagInfo: &dataprotection.RetentionTag{
+				ID:      utils.String("Default_"),
+				TagName: utils.String("Default"),
+			},
+		},
+	}
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, dataprotection.TaggingCriteria{
+			Criteria:        expandBackupPolicyKubernetesClusterCriteriaArray(v["criteria"].([]interface{})),
+			IsDefault:       utils.Bool(false),
+			TaggingPriority: utils.Int64(int64(v["priority"].(int))),
+			TagInfo: &dataprotection.RetentionTag{
+				ID:      utils.String(v["name"].(string) + "_"),
+				TagName: utils.String(v["name"].(string)),
+			},
+		})
+	}
+	return &results
+}
+
+func expandBackupPolicyKubernetesClusterCriteriaArray(input []interface{}) *[]dataprotection.BasicBackupCriteria {
+	results := make([]dataprotection.BasicBackupCriteria, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		var absoluteCriteria []dataprotection.AbsoluteMarker
+		if absoluteCriteriaRaw := v["absolute_criteria"].(string); len(absoluteCriteriaRaw) > 0 {
+			absoluteCriteria = []dataprotection.AbsoluteMarker{dataprotection.AbsoluteMarker(absoluteCriteriaRaw)}
+		}
+
+		var daysOfWeek []dataprotection.DayOfWeek
+		if v["days_of_week"].(*pluginsdk.Set).Len() > 0 {
+			daysOfWeek = make([]dataprotection.DayOfWeek, 0)
+			for _, value := range v["days_of_week"].(*pluginsdk.Set).List() {
+				daysOfWeek = append(daysOfWeek, dataprotection.DayOfWeek(value.(string)))
+			}
+		}
+
+		var monthsOfYear []dataprotection.Month
+		if v["months_of_year"].(*pluginsdk.Set).Len() > 0 {
+			monthsOfYear = make([]dataprotection.Month, 0)
+			for _, value := range v["months_of_year"].(*pluginsdk.Set).List() {
+				monthsOfYear = append(monthsOfYear, dataprotection.Month(value.(string)))
+			}
+		}
+
+		var weeksOfMonth []dataprotection.WeekNumber
+		if v["weeks_of_month"].(*pluginsdk.Set).Len() > 0 {
+			weeksOfMonth = make([]dataprotection.WeekNumber, 0)
+			for _, value := range v["weeks_of_month"].(*pluginsdk.Set).List() {
+				weeksOfMonth = append(weeksOfMonth, dataprotection.WeekNumber(value.(string)))
+			}
+		}
+
+		var scheduleTimes []date.Time
+		if v["scheduled_backup_times"].(*pluginsdk.Set).Len() > 0 {
+			scheduleTimes = make([]date.Time, 0)
+			for _, value := range v["scheduled_backup_times"].(*pluginsdk.Set).List() {
+				t, _ := time.Parse(time.RFC3339, value.(string))
+				scheduleTimes = append(scheduleTimes, date.Time{Time: t})
+			}
+		}
+		results = append(results, dataprotection.ScheduleBasedBackupCriteria{
+			AbsoluteCriteria: &absoluteCriteria,
+			DaysOfMonth:      nil,
+			DaysOfTheWeek:    &daysOfWeek,
+			MonthsOfYear:     &monthsOfYear,
+			ScheduleTimes:    &scheduleTimes,
+			WeeksOfTheMonth:  &weeksOfMonth,
+			ObjectType:       dataprotection.ObjectTypeBasicBackupCriteriaObjectTypeScheduleBasedBackupCriteria,
+		})
+	}
+	return &results
+}
+
+func flattenBackupPolicyKubernetesClusterBackupRuleArray(input *[]dataprotection.BasicBasePolicyRule) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+	for _, item := range *input {
+		if backupRule, ok := item.AsAzureBackupRule(); ok {
+			if backupRule.Trigger != nil {
+				if scheduleBasedTrigger, ok := backupRule.Trigger.AsScheduleBasedTriggerContext(); ok {
+					if scheduleBasedTrigger.Schedule != nil {
+						return utils.FlattenStringSlice(scheduleBasedTrigger.Schedule.RepeatingTimeIntervals)
+					}
+				}
+			}
+		}
+	}
+	return make([]interface{}, 0)
+}
+
+func flattenBackupPolicyKubernetesClusterBackupTimeZone(input *[]dataprotection.BasicBasePolicyRule) string {
+	if input == nil {
+		return ""
+	}
+	for _, item := range *input {
+		if backupRule, ok := item.AsAzureBackupRule(); ok {
+			if backupRule.Trigger != nil {
+				if scheduleBasedTrigger, ok := backupRule.Trigger.AsScheduleBasedTriggerContext(); ok {
+					if scheduleBasedTrigger.Schedule != nil && scheduleBasedTrigger.Schedule.TimeZone != nil {
+						return *scheduleBasedTrigger.Schedule.TimeZone
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func flattenBackupPolicyKubernetesClusterDefaultRetentionRuleDuration(input *[]dataprotection.BasicBasePolicyRule) interface{} {
+	if input == nil {
+		return nil
+	}
+
+	for _, item := range *input {
+		if retentionRule, ok := item.AsAzureRetentionRule(); ok && retentionRule.IsDefault != nil && *retentionRule.IsDefault {
+			if retentionRule.Lifecycles != nil && len(*retentionRule.Lifecycles) > 0 {
+				if deleteOption, ok := (*retentionRule.Lifecycles)[0].DeleteAfter.AsAbsoluteDeleteOption(); ok {
+					return *deleteOption.Duration
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func flattenBackupPolicyKubernetesClusterRetentionRuleArray(input *[]dataprotection.BasicBasePolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	var taggingCriterias []dataprotection.TaggingCriteria
+	for _, item := range *input {
+		if backupRule, ok := item.AsAzureBackupRule(); ok {
+			if trigger, ok := backupRule.Trigger.AsScheduleBasedTriggerContext(); ok {
+				if trigger.TaggingCriteria != nil {
+					taggingCriterias = *trigger.TaggingCriteria
+				}
+			}
+		}
+	}
+
+	for _, item := range *input {
+		if retentionRule, ok := item.AsAzureRetentionRule(); ok && (retentionRule.IsDefault == nil || !*retentionRule.IsDefault) {
+			var name string
+			if retentionRule.Name != nil {
+				name = *retentionRule.Name
+			}
+			var taggingPriority int64
+			var taggingCriteria []interface{}
+			for _, criteria := range taggingCriterias {
+				if criteria.TagInfo != nil && criteria.TagInfo.TagName != nil && strings.EqualFold(*criteria.TagInfo.TagName, name) {
+					taggingPriority = *criteria.TaggingPriority
+					taggingCriteria = flattenBackupPolicyKubernetesClusterBackupCriteriaArray(criteria.Criteria)
+				}
+			}
+			var duration string
+			if retentionRule.Lifecycles != nil && len(*retentionRule.Lifecycles) > 0 {
+				if deleteOption, ok := (*retentionRule.Lifecycles)[0].DeleteAfter.AsAbsoluteDeleteOption(); ok {
+					duration = *deleteOption.Duration
+				}
+			}
+			results = append(results, map[string]interface{}{
+				"name":     name,
+				"priority": taggingPriority,
+				"criteria": taggingCriteria,
+				"duration": duration,
+			})
+		}
+	}
+	return results
+}
+
+func flattenBackupPolicyKubernetesClusterBackupCriteriaArray(input *[]dataprotection.BasicBackupCriteria) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if criteria, ok := item.AsScheduleBasedBackupCriteria(); ok {
+			var absoluteCriteria string
+			if criteria.AbsoluteCriteria != nil && len(*criteria.AbsoluteCriteria) > 0 {
+				absoluteCriteria = string((*criteria.AbsoluteCriteria)[0])
+			}
+			var daysOfWeek []string
+			if criteria.DaysOfTheWeek != nil {
+				daysOfWeek = make([]string, 0)
+				for _, item := range *criteria.DaysOfTheWeek {
+					daysOfWeek = append(daysOfWeek, (string)(item))
+				}
+			}
+			var monthsOfYear []string
+			if criteria.MonthsOfYear != nil {
+				monthsOfYear = make([]string, 0)
+				for _, item := range *criteria.MonthsOfYear {
+					monthsOfYear = append(monthsOfYear, (string)(item))
+				}
+			}
+			var weeksOfMonth []string
+			if criteria.WeeksOfTheMonth != nil {
+				weeksOfMonth = make([]string, 0)
+				for _, item := range *criteria.WeeksOfTheMonth {
+					weeksOfMonth = append(weeksOfMonth, (string)(item))
+				}
+			}
+			var scheduleTimes []string
+			if criteria.ScheduleTimes != nil {
+				scheduleTimes = make([]string, 0)
+				for _, item := range *criteria.ScheduleTimes {
+					scheduleTimes = append(scheduleTimes, item.String())
+				}
+			}
+
+			results = append(results, map[string]interface{}{
+				"absolute_criteria":      absoluteCriteria,
+				"days_of_week":           daysOfWeek,
+				"months_of_year":         monthsOfYear,
+				"weeks_of_month":         weeksOfMonth,
+				"scheduled_backup_times": scheduleTimes,
+			})
+		}
+	}
+	return results
+}
@@ -0,0 +1,74 @@
+package backupinstances
+
+import "strings"
+
+type DataStoreTypes string
+
+const (
+	DataStoreTypesArchiveStore     DataStoreTypes = "ArchiveStore"
+	DataStoreTypesOperationalStore DataStoreTypes = "OperationalStore"
+	DataStoreTypesVaultStore       DataStoreTypes = "VaultStore"
+)
+
+func PossibleValuesForDataStoreTypes() []string {
+	return []string{
+		string(DataStoreTypesArchiveStore),
+		string(DataStoreTypesOperationalStore),
+		string(DataStoreTypesVaultStore),
+	}
+}
+
+func parseDataStoreTypes(input string) (*DataStoreTypes, error) {
+	vals := map[string]DataStoreTypes{
+		"archivestore":     DataStoreTypesArchiveStore,
+		"operationalstore": DataStoreTypesOperationalStore,
+		"vaultstore":       DataStoreTypesVaultStore,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataStoreTypes(input)
+	return &out, nil
+}
+
+type Status string
+
+const (
+	StatusConfiguringProtection       Status = "ConfiguringProtection"
+	StatusConfiguringProtectionFailed Status = "ConfiguringProtectionFailed"
+	StatusProtectionConfigured        Status = "ProtectionConfigured"
+	StatusProtectionStopped           Status = "ProtectionStopped"
+	StatusSoftDeleted                 Status = "SoftDeleted"
+	StatusSoftDeleting                Status = "SoftDeleting"
+)
+
+func PossibleValuesForStatus() []string {
+	return []string{
+		string(StatusConfiguringProtection),
+		string(StatusConfiguringProtectionFailed),
+		string(StatusProtectionConfigured),
+		string(StatusProtectionStopped),
+		string(StatusSoftDeleted),
+		string(StatusSoftDeleting),
+	}
+}
+
+func parseStatus(input string) (*Status, error) {
+	vals := map[string]Status{
+		"configuringprotection":       StatusConfiguringProtection,
+		"configuringprotectionfailed": StatusConfiguringProtectionFailed,
+		"protectionconfigured":        StatusProtectionConfigured,
+		"protectionstopped":           StatusProtectionStopped,
+		"softdeleted":                 StatusSoftDeleted,
+		"softdeleting":                StatusSoftDeleting,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Status(input)
+	return &out, nil
+}
@@ -0,0 +1,12 @@
+package backupinstances
+
+type BackupInstance struct {
+	CurrentProtectionState *string                  `json:"currentProtectionState,omitempty"`
+	DataSourceInfo         Datasource               `json:"dataSourceInfo"`
+	DataSourceSetInfo      *DatasourceSet           `json:"dataSourceSetInfo,omitempty"`
+	FriendlyName           *string                  `json:"friendlyName,omitempty"`
+	ObjectType             string                   `json:"objectType"`
+	PolicyInfo             PolicyInfo               `json:"policyInfo"`
+	ProtectionStatus       *ProtectionStatusDetails `json:"protectionStatus,omitempty"`
+	ProvisioningState      *string                  `json:"provisioningState,omitempty"`
+}
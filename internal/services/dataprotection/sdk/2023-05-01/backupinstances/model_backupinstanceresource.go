@@ -0,0 +1,8 @@
+package backupinstances
+
+type BackupInstanceResource struct {
+	Id         *string         `json:"id,omitempty"`
+	Name       *string         `json:"name,omitempty"`
+	Properties *BackupInstance `json:"properties,omitempty"`
+	Type       *string         `json:"type,omitempty"`
+}
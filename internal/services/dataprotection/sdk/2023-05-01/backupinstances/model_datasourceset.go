@@ -0,0 +1,11 @@
+package backupinstances
+
+type DatasourceSet struct {
+	DatasourceType   *string `json:"datasourceType,omitempty"`
+	ObjectType       *string `json:"objectType,omitempty"`
+	ResourceID       string  `json:"resourceID"`
+	ResourceLocation *string `json:"resourceLocation,omitempty"`
+	ResourceName     *string `json:"resourceName,omitempty"`
+	ResourceType     *string `json:"resourceType,omitempty"`
+	ResourceUri      *string `json:"resourceUri,omitempty"`
+}
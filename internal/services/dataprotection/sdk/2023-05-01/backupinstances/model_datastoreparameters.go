@@ -0,0 +1,45 @@
+package backupinstances
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type DataStoreParameters interface {
+}
+
+func unmarshalDataStoreParametersImplementation(input []byte) (DataStoreParameters, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling DataStoreParameters into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["objectType"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "AzureOperationalStoreParameters") {
+		var out AzureOperationalStoreParameters
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into AzureOperationalStoreParameters: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawDataStoreParametersImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawDataStoreParametersImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
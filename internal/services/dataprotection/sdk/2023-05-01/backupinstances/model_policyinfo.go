@@ -0,0 +1,7 @@
+package backupinstances
+
+type PolicyInfo struct {
+	PolicyId         string            `json:"policyId"`
+	PolicyParameters *PolicyParameters `json:"policyParameters,omitempty"`
+	PolicyVersion    *string           `json:"policyVersion,omitempty"`
+}
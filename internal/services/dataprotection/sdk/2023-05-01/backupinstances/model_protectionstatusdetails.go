@@ -0,0 +1,5 @@
+package backupinstances
+
+type ProtectionStatusDetails struct {
+	Status *Status `json:"status,omitempty"`
+}
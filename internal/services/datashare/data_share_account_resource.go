@@ -171,7 +171,7 @@ func resourceDataShareAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	props := datashare.AccountUpdateParameters{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -203,7 +203,7 @@ func (StorageDisksPoolResource) Update() sdk.ResourceFunc {
 				sku := expandDisksPoolSku(m.Sku)
 				patch.Sku = &sku
 			}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				patch.Tags = tags.Expand(m.Tags)
 			}
 
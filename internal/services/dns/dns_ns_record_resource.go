@@ -146,7 +146,7 @@ func resourceDnsNsRecordUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		existing.RecordSetProperties.NsRecords = records
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		existing.RecordSetProperties.Metadata = tags.Expand(t)
 	}
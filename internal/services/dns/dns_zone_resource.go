@@ -6,11 +6,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -140,6 +142,68 @@ func resourceDnsZone() *pluginsdk.Resource {
 				},
 			},
 
+			"dnssec_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"dnssec_signing_keys": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"flags": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"key_tag": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"public_key": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_algorithm_type": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"delegation_signer_records": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"digest_algorithm_type": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"digest_value": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"record": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -148,6 +212,7 @@ func resourceDnsZone() *pluginsdk.Resource {
 func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Dns.ZonesClient
 	recordSetsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnssecConfigsClient := meta.(*clients.Client).Dns.DnssecConfigsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -203,6 +268,19 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.IsNewResource() || d.HasChange("dnssec_enabled") {
+		dnssecId := dnssecconfigs.NewDnsZoneID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.Name)
+		if d.Get("dnssec_enabled").(bool) {
+			if err := dnssecConfigsClient.CreateOrUpdateThenPoll(ctx, dnssecId); err != nil {
+				return fmt.Errorf("enabling DNSSEC for DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		} else if !d.IsNewResource() {
+			if err := dnssecConfigsClient.DeleteThenPoll(ctx, dnssecId); err != nil {
+				return fmt.Errorf("disabling DNSSEC for DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceDnsZoneRead(d, meta)
@@ -211,6 +289,7 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 func resourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	zonesClient := meta.(*clients.Client).Dns.ZonesClient
 	recordSetsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnssecConfigsClient := meta.(*clients.Client).Dns.DnssecConfigsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -251,6 +330,25 @@ func resourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `soa_record`: %+v", err)
 	}
 
+	dnssecEnabled := false
+	var signingKeys *[]dnssecconfigs.SigningKey
+	dnssecResp, err := dnssecConfigsClient.Get(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		if !response.WasNotFound(dnssecResp.HttpResponse) {
+			return fmt.Errorf("reading DNSSEC configuration for DNS Zone %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	} else {
+		dnssecEnabled = true
+		if model := dnssecResp.Model; model != nil && model.Properties != nil {
+			signingKeys = model.Properties.SigningKeys
+		}
+	}
+
+	d.Set("dnssec_enabled", dnssecEnabled)
+	if err := d.Set("dnssec_signing_keys", flattenArmDNSZoneDnssecSigningKeys(signingKeys)); err != nil {
+		return fmt.Errorf("setting `dnssec_signing_keys`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -361,3 +459,74 @@ func flattenArmDNSZoneSOARecord(input *dns.RecordSet) []interface{} {
 		},
 	}
 }
+
+func flattenArmDNSZoneDnssecSigningKeys(input *[]dnssecconfigs.SigningKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, key := range *input {
+		flags := 0
+		if key.Flags != nil {
+			flags = int(*key.Flags)
+		}
+
+		keyTag := 0
+		if key.KeyTag != nil {
+			keyTag = int(*key.KeyTag)
+		}
+
+		protocol := 0
+		if key.Protocol != nil {
+			protocol = int(*key.Protocol)
+		}
+
+		publicKey := ""
+		if key.PublicKey != nil {
+			publicKey = *key.PublicKey
+		}
+
+		securityAlgorithmType := 0
+		if key.SecurityAlgorithmType != nil {
+			securityAlgorithmType = int(*key.SecurityAlgorithmType)
+		}
+
+		delegationSignerRecords := make([]interface{}, 0)
+		if key.DelegationSignerInfo != nil {
+			for _, info := range *key.DelegationSignerInfo {
+				digestAlgorithmType := 0
+				if info.DigestAlgorithmType != nil {
+					digestAlgorithmType = int(*info.DigestAlgorithmType)
+				}
+
+				digestValue := ""
+				if info.DigestValue != nil {
+					digestValue = *info.DigestValue
+				}
+
+				record := ""
+				if info.Record != nil {
+					record = *info.Record
+				}
+
+				delegationSignerRecords = append(delegationSignerRecords, map[string]interface{}{
+					"digest_algorithm_type": digestAlgorithmType,
+					"digest_value":          digestValue,
+					"record":                record,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"flags":                     flags,
+			"key_tag":                   keyTag,
+			"protocol":                  protocol,
+			"public_key":                publicKey,
+			"security_algorithm_type":   securityAlgorithmType,
+			"delegation_signer_records": delegationSignerRecords,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,15 @@
+package dnssecconfigs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DnssecConfigsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDnssecConfigsClientWithBaseURI(endpoint string) DnssecConfigsClient {
+	return DnssecConfigsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package dnssecconfigs
+
+import "fmt"
+
+const defaultApiVersion = "2023-07-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/dnssecconfigs/%s", defaultApiVersion)
+}
@@ -0,0 +1,232 @@
+package dnsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dnsresolver/sdk/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dnsresolver/sdk/2022-07-01/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverDnsForwardingRulesetResource{}
+
+type PrivateDNSResolverDnsForwardingRulesetResource struct{}
+
+type PrivateDNSResolverDnsForwardingRulesetModel struct {
+	Name                                  string            `tfschema:"name"`
+	ResourceGroupName                     string            `tfschema:"resource_group_name"`
+	Location                              string            `tfschema:"location"`
+	PrivateDNSResolverOutboundEndpointIds []string          `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
+	Tags                                  map[string]string `tfschema:"tags"`
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) ModelObject() interface{} {
+	return &PrivateDNSResolverDnsForwardingRulesetModel{}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dnsforwardingrulesets.ValidateDnsForwardingRulesetID
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"private_dns_resolver_outbound_endpoint_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: outboundendpoints.ValidateOutboundEndpointID,
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.DnsForwardingRulesetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model PrivateDNSResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := dnsforwardingrulesets.NewDnsForwardingRulesetID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := dnsforwardingrulesets.DnsForwardingRuleset{
+				Location: location.Normalize(model.Location),
+				Properties: dnsforwardingrulesets.DnsForwardingRulesetProperties{
+					DnsResolverOutboundEndpoints: expandDnsForwardingRulesetOutboundEndpoints(model.PrivateDNSResolverOutboundEndpointIds),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDNSResolverDnsForwardingRulesetModel{
+				Name:              id.DnsForwardingRulesetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.PrivateDNSResolverOutboundEndpointIds = flattenDnsForwardingRulesetOutboundEndpoints(model.Properties.DnsResolverOutboundEndpoints)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDNSResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("private_dns_resolver_outbound_endpoint_ids") {
+				payload.Properties.DnsResolverOutboundEndpoints = expandDnsForwardingRulesetOutboundEndpoints(model.PrivateDNSResolverOutboundEndpointIds)
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDnsForwardingRulesetOutboundEndpoints(input []string) []dnsforwardingrulesets.SubResource {
+	results := make([]dnsforwardingrulesets.SubResource, 0)
+	for _, v := range input {
+		results = append(results, dnsforwardingrulesets.SubResource{
+			Id: v,
+		})
+	}
+
+	return results
+}
+
+func flattenDnsForwardingRulesetOutboundEndpoints(input []dnsforwardingrulesets.SubResource) []string {
+	results := make([]string, 0)
+	for _, v := range input {
+		results = append(results, v.Id)
+	}
+
+	return results
+}
@@ -0,0 +1,289 @@
+package dnsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dnsresolver/sdk/2022-07-01/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dnsresolver/sdk/2022-07-01/inboundendpoints"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverInboundEndpointResource{}
+
+type PrivateDNSResolverInboundEndpointResource struct{}
+
+type PrivateDNSResolverInboundEndpointModel struct {
+	Name                 string                         `tfschema:"name"`
+	PrivateDNSResolverId string                         `tfschema:"private_dns_resolver_id"`
+	Location             string                         `tfschema:"location"`
+	IPConfigurations     []InboundEndpointIPConfigModel `tfschema:"ip_configurations"`
+	Tags                 map[string]string              `tfschema:"tags"`
+}
+
+type InboundEndpointIPConfigModel struct {
+	SubnetId                  string `tfschema:"subnet_id"`
+	PrivateIPAddress          string `tfschema:"private_ip_address"`
+	PrivateIPAllocationMethod string `tfschema:"private_ip_allocation_method"`
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_inbound_endpoint"
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) ModelObject() interface{} {
+	return &PrivateDNSResolverInboundEndpointModel{}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return inboundendpoints.ValidateInboundEndpointID
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"private_dns_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsresolvers.ValidateDnsResolverID,
+		},
+
+		"location": commonschema.Location(),
+
+		"ip_configurations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"private_ip_allocation_method": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(inboundendpoints.IPAllocationMethodDynamic),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(inboundendpoints.IPAllocationMethodDynamic),
+							string(inboundendpoints.IPAllocationMethodStatic),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.InboundEndpointsClient
+
+			var model PrivateDNSResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			dnsResolverId, err := dnsresolvers.ParseDnsResolverID(model.PrivateDNSResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := inboundendpoints.NewInboundEndpointID(dnsResolverId.SubscriptionId, dnsResolverId.ResourceGroupName, dnsResolverId.DnsResolverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := inboundendpoints.InboundEndpoint{
+				Location: location.Normalize(model.Location),
+				Properties: inboundendpoints.InboundEndpointProperties{
+					IPConfigurations: expandInboundEndpointIPConfigurations(model.IPConfigurations),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDNSResolverInboundEndpointModel{
+				Name:                 id.InboundEndpointName,
+				PrivateDNSResolverId: dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroupName, id.DnsResolverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.IPConfigurations = flattenInboundEndpointIPConfigurations(model.Properties.IPConfigurations)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDNSResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DnsResolver.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandInboundEndpointIPConfigurations(input []InboundEndpointIPConfigModel) []inboundendpoints.IPConfiguration {
+	results := make([]inboundendpoints.IPConfiguration, 0)
+	for _, v := range input {
+		allocationMethod := inboundendpoints.IPAllocationMethod(v.PrivateIPAllocationMethod)
+		ipConfiguration := inboundendpoints.IPConfiguration{
+			PrivateIPAllocationMethod: &allocationMethod,
+			Subnet: inboundendpoints.SubResource{
+				Id: v.SubnetId,
+			},
+		}
+
+		if v.PrivateIPAddress != "" {
+			ipConfiguration.PrivateIPAddress = utils.String(v.PrivateIPAddress)
+		}
+
+		results = append(results, ipConfiguration)
+	}
+
+	return results
+}
+
+func flattenInboundEndpointIPConfigurations(input []inboundendpoints.IPConfiguration) []InboundEndpointIPConfigModel {
+	results := make([]InboundEndpointIPConfigModel, 0)
+	for _, v := range input {
+		allocationMethod := ""
+		if v.PrivateIPAllocationMethod != nil {
+			allocationMethod = string(*v.PrivateIPAllocationMethod)
+		}
+
+		results = append(results, InboundEndpointIPConfigModel{
+			SubnetId:                  v.Subnet.Id,
+			PrivateIPAddress:          utils.NormalizeNilableString(v.PrivateIPAddress),
+			PrivateIPAllocationMethod: allocationMethod,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,34 @@
+package dnsresolver
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Private DNS Resolver"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PrivateDNSResolverDnsForwardingRulesetResource{},
+		PrivateDNSResolverForwardingRuleResource{},
+		PrivateDNSResolverInboundEndpointResource{},
+		PrivateDNSResolverOutboundEndpointResource{},
+		PrivateDNSResolverResource{},
+		PrivateDNSResolverVirtualNetworkLinkResource{},
+	}
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Private DNS Resolver",
+	}
+}
@@ -0,0 +1,9 @@
+package dnsforwardingrulesets
+
+import "fmt"
+
+const defaultApiVersion = "2022-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/dnsforwardingrulesets/%s", defaultApiVersion)
+}
@@ -0,0 +1,6 @@
+package forwardingrules
+
+type TargetDnsServer struct {
+	IPAddress string `json:"ipAddress"`
+	Port      *int64 `json:"port,omitempty"`
+}
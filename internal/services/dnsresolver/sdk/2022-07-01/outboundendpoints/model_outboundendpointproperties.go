@@ -0,0 +1,7 @@
+package outboundendpoints
+
+type OutboundEndpointProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+	Subnet            SubResource        `json:"subnet"`
+}
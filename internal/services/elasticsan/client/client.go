@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/elasticsans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumes"
+)
+
+type Client struct {
+	ElasticSansClient  *elasticsans.ElasticSansClient
+	SnapshotsClient    *snapshots.SnapshotsClient
+	VolumeGroupsClient *volumegroups.VolumeGroupsClient
+	VolumesClient      *volumes.VolumesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	elasticSansClient := elasticsans.NewElasticSansClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&elasticSansClient.Client, o.ResourceManagerAuthorizer)
+
+	snapshotsClient := snapshots.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
+	volumeGroupsClient := volumegroups.NewVolumeGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&volumeGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	volumesClient := volumes.NewVolumesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&volumesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ElasticSansClient:  &elasticSansClient,
+		SnapshotsClient:    &snapshotsClient,
+		VolumeGroupsClient: &volumeGroupsClient,
+		VolumesClient:      &volumesClient,
+	}
+}
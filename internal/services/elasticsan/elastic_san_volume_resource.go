@@ -0,0 +1,315 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ElasticSanVolumeResource{}
+
+type ElasticSanVolumeResource struct{}
+
+type ElasticSanVolumeResourceModel struct {
+	Name                 string                         `tfschema:"name"`
+	VolumeGroupId        string                         `tfschema:"volume_group_id"`
+	SizeInGiB            int                            `tfschema:"size_in_gib"`
+	CreateSource         []ElasticSanVolumeCreateSource `tfschema:"create_source"`
+	TargetIqn            string                         `tfschema:"target_iqn"`
+	TargetPortalHostname string                         `tfschema:"target_portal_hostname"`
+	TargetPortalPort     int                            `tfschema:"target_portal_port"`
+	VolumeId             string                         `tfschema:"volume_id"`
+}
+
+type ElasticSanVolumeCreateSource struct {
+	SourceType string `tfschema:"source_type"`
+	SourceId   string `tfschema:"source_id"`
+}
+
+func (r ElasticSanVolumeResource) ResourceType() string {
+	return "azurerm_elastic_san_volume"
+}
+
+func (r ElasticSanVolumeResource) ModelObject() interface{} {
+	return &ElasticSanVolumeResourceModel{}
+}
+
+func (r ElasticSanVolumeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return volumes.ValidateVolumeID
+}
+
+func (r ElasticSanVolumeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ElasticSanVolumeName(),
+		},
+
+		"volume_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: volumes.ValidateVolumeGroupID,
+		},
+
+		"size_in_gib": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65536),
+		},
+
+		"create_source": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(volumes.VolumeCreateOptionDisk),
+							string(volumes.VolumeCreateOptionDiskRestorePoint),
+							string(volumes.VolumeCreateOptionDiskSnapshot),
+							string(volumes.VolumeCreateOptionVolumeSnapshot),
+						}, false),
+					},
+
+					"source_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_iqn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"target_portal_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"target_portal_port": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"volume_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		// creating many Volumes within the same Elastic SAN concurrently is commonly throttled,
+		// so we retry for longer than usual before surfacing the error
+		Retry: &common.RetryOptions{
+			MaxAttempts:     10,
+			MaxElapsedTime:  30 * time.Minute,
+			HonorRetryAfter: true,
+		},
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			var config ElasticSanVolumeResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			volumeGroupId, err := volumes.ParseVolumeGroupID(config.VolumeGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := volumes.NewVolumeID(volumeGroupId.SubscriptionId, volumeGroupId.ResourceGroupName, volumeGroupId.ElasticSanName, volumeGroupId.VolumeGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := volumes.Volume{
+				Properties: volumes.VolumeProperties{
+					CreationData: expandElasticSanVolumeCreateSource(config.CreateSource),
+					SizeGiB:      int64(config.SizeInGiB),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ElasticSanVolumeResourceModel{
+				Name:          id.VolumeName,
+				VolumeGroupId: volumes.NewVolumeGroupID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName, id.VolumeGroupName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.SizeInGiB = int(props.SizeGiB)
+				state.CreateSource = flattenElasticSanVolumeCreateSource(props.CreationData)
+
+				if props.VolumeId != nil {
+					state.VolumeId = *props.VolumeId
+				}
+
+				if target := props.StorageTarget; target != nil {
+					if target.TargetIqn != nil {
+						state.TargetIqn = *target.TargetIqn
+					}
+					if target.TargetPortalHostname != nil {
+						state.TargetPortalHostname = *target.TargetPortalHostname
+					}
+					if target.TargetPortalPort != nil {
+						state.TargetPortalPort = int(*target.TargetPortalPort)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ElasticSanVolumeResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("size_in_gib") {
+				// volumes can only be expanded - shrinking a volume isn't supported by the API
+				oldSize, newSize := metadata.ResourceData.GetChange("size_in_gib")
+				if newSize.(int) < oldSize.(int) {
+					return fmt.Errorf("`size_in_gib` can only be increased - %s is currently %d GiB", *id, oldSize.(int))
+				}
+
+				payload := volumes.VolumeUpdate{
+					Properties: &volumes.VolumeUpdateProperties{
+						SizeGiB: utils.Int64(int64(config.SizeInGiB)),
+					},
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandElasticSanVolumeCreateSource(input []ElasticSanVolumeCreateSource) *volumes.SourceCreationData {
+	if len(input) == 0 {
+		return nil
+	}
+
+	createSource := volumes.VolumeCreateOption(input[0].SourceType)
+	return &volumes.SourceCreationData{
+		CreateSource: &createSource,
+		SourceId:     utils.String(input[0].SourceId),
+	}
+}
+
+func flattenElasticSanVolumeCreateSource(input *volumes.SourceCreationData) []ElasticSanVolumeCreateSource {
+	if input == nil || input.CreateSource == nil || *input.CreateSource == volumes.VolumeCreateOptionNone {
+		return []ElasticSanVolumeCreateSource{}
+	}
+
+	sourceId := ""
+	if input.SourceId != nil {
+		sourceId = *input.SourceId
+	}
+
+	return []ElasticSanVolumeCreateSource{
+		{
+			SourceType: string(*input.CreateSource),
+			SourceId:   sourceId,
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package elasticsan_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSanVolumeSnapshotResource struct{}
+
+func TestAccElasticSanVolumeSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_snapshot", "test")
+	r := ElasticSanVolumeSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_volume_size_in_gib").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSanVolumeSnapshot_restoreVolume(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_snapshot", "test")
+	r := ElasticSanVolumeSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.restoreVolume(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_elastic_san_volume.restored").ExistsInAzure(ElasticSanVolumeResource{}),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ElasticSanVolumeSnapshotResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := snapshots.ParseSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ElasticSan.SnapshotsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ElasticSanVolumeSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_snapshot" "test" {
+  name             = "acctestess-%d"
+  volume_group_id  = azurerm_elastic_san_volume_group.test.id
+  source_volume_id = azurerm_elastic_san_volume.test.id
+}
+`, ElasticSanVolumeResource{}.basic(data, 1), data.RandomInteger)
+}
+
+func (r ElasticSanVolumeSnapshotResource) restoreVolume(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "restored" {
+  name            = "acctestesv-restored-%d"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = 1
+
+  create_source {
+    source_type = "VolumeSnapshot"
+    source_id   = azurerm_elastic_san_volume_snapshot.test.id
+  }
+}
+`, r.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,32 @@
+package elasticsan
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Elastic SAN"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ElasticSanResource{},
+		ElasticSanVolumeGroupResource{},
+		ElasticSanVolumeResource{},
+		ElasticSanVolumeSnapshotResource{},
+	}
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Elastic SAN",
+	}
+}
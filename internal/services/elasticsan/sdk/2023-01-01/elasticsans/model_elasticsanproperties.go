@@ -0,0 +1,15 @@
+package elasticsans
+
+type ElasticSanProperties struct {
+	AvailabilityZones       *[]string            `json:"availabilityZones,omitempty"`
+	BaseSizeTiB             int64                `json:"baseSizeTiB"`
+	ExtendedCapacitySizeTiB int64                `json:"extendedCapacitySizeTiB"`
+	ProvisioningState       *ProvisioningStates  `json:"provisioningState,omitempty"`
+	PublicNetworkAccess     *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+	Sku                     Sku                  `json:"sku"`
+	TotalIops               *int64               `json:"totalIops,omitempty"`
+	TotalMBps               *int64               `json:"totalMBps,omitempty"`
+	TotalSizeTiB            *int64               `json:"totalSizeTiB,omitempty"`
+	TotalVolumeSizeGiB      *int64               `json:"totalVolumeSizeGiB,omitempty"`
+	VolumeGroupCount        *int64               `json:"volumeGroupCount,omitempty"`
+}
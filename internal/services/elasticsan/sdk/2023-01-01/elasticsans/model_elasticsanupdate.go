@@ -0,0 +1,6 @@
+package elasticsans
+
+type ElasticSanUpdate struct {
+	Properties *ElasticSanUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string          `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package snapshots
+
+type Snapshot struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties SnapshotProperties `json:"properties"`
+	SystemData *SystemData        `json:"systemData,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package snapshots
+
+type SnapshotCreationData struct {
+	SourceId string `json:"sourceId"`
+}
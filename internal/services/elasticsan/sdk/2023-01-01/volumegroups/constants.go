@@ -0,0 +1,164 @@
+package volumegroups
+
+import "strings"
+
+type Action string
+
+const (
+	ActionAllow Action = "Allow"
+)
+
+func PossibleValuesForAction() []string {
+	return []string{
+		string(ActionAllow),
+	}
+}
+
+func parseAction(input string) (*Action, error) {
+	vals := map[string]Action{
+		"allow": ActionAllow,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Action(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type EncryptionType string
+
+const (
+	EncryptionTypeEncryptionAtRestWithCustomerManagedKey EncryptionType = "EncryptionAtRestWithCustomerManagedKey"
+	EncryptionTypeEncryptionAtRestWithPlatformKey        EncryptionType = "EncryptionAtRestWithPlatformKey"
+)
+
+func PossibleValuesForEncryptionType() []string {
+	return []string{
+		string(EncryptionTypeEncryptionAtRestWithCustomerManagedKey),
+		string(EncryptionTypeEncryptionAtRestWithPlatformKey),
+	}
+}
+
+func parseEncryptionType(input string) (*EncryptionType, error) {
+	vals := map[string]EncryptionType{
+		"encryptionatrestwithcustomermanagedkey": EncryptionTypeEncryptionAtRestWithCustomerManagedKey,
+		"encryptionatrestwithplatformkey":        EncryptionTypeEncryptionAtRestWithPlatformKey,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EncryptionType(input)
+	return &out, nil
+}
+
+type ProvisioningStates string
+
+const (
+	ProvisioningStatesCanceled  ProvisioningStates = "Canceled"
+	ProvisioningStatesCreating  ProvisioningStates = "Creating"
+	ProvisioningStatesDeleting  ProvisioningStates = "Deleting"
+	ProvisioningStatesFailed    ProvisioningStates = "Failed"
+	ProvisioningStatesInvalid   ProvisioningStates = "Invalid"
+	ProvisioningStatesPending   ProvisioningStates = "Pending"
+	ProvisioningStatesSucceeded ProvisioningStates = "Succeeded"
+	ProvisioningStatesUpdating  ProvisioningStates = "Updating"
+)
+
+func PossibleValuesForProvisioningStates() []string {
+	return []string{
+		string(ProvisioningStatesCanceled),
+		string(ProvisioningStatesCreating),
+		string(ProvisioningStatesDeleting),
+		string(ProvisioningStatesFailed),
+		string(ProvisioningStatesInvalid),
+		string(ProvisioningStatesPending),
+		string(ProvisioningStatesSucceeded),
+		string(ProvisioningStatesUpdating),
+	}
+}
+
+func parseProvisioningStates(input string) (*ProvisioningStates, error) {
+	vals := map[string]ProvisioningStates{
+		"canceled":  ProvisioningStatesCanceled,
+		"creating":  ProvisioningStatesCreating,
+		"deleting":  ProvisioningStatesDeleting,
+		"failed":    ProvisioningStatesFailed,
+		"invalid":   ProvisioningStatesInvalid,
+		"pending":   ProvisioningStatesPending,
+		"succeeded": ProvisioningStatesSucceeded,
+		"updating":  ProvisioningStatesUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStates(input)
+	return &out, nil
+}
+
+type StorageTargetType string
+
+const (
+	StorageTargetTypeIscsi StorageTargetType = "Iscsi"
+	StorageTargetTypeNone  StorageTargetType = "None"
+)
+
+func PossibleValuesForStorageTargetType() []string {
+	return []string{
+		string(StorageTargetTypeIscsi),
+		string(StorageTargetTypeNone),
+	}
+}
+
+func parseStorageTargetType(input string) (*StorageTargetType, error) {
+	vals := map[string]StorageTargetType{
+		"iscsi": StorageTargetTypeIscsi,
+		"none":  StorageTargetTypeNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageTargetType(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package volumegroups
+
+type EncryptionProperties struct {
+	Identity           *EncryptionIdentity `json:"identity,omitempty"`
+	KeyVaultProperties *KeyVaultProperties `json:"keyVaultProperties,omitempty"`
+}
@@ -0,0 +1,5 @@
+package volumegroups
+
+type NetworkRuleSet struct {
+	VirtualNetworkRules *[]VirtualNetworkRule `json:"virtualNetworkRules,omitempty"`
+}
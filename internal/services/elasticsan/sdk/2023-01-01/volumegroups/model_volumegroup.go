@@ -0,0 +1,14 @@
+package volumegroups
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type VolumeGroup struct {
+	Id         *string                           `json:"id,omitempty"`
+	Identity   *identity.SystemOrUserAssignedMap `json:"identity,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *VolumeGroupProperties            `json:"properties,omitempty"`
+	SystemData *SystemData                       `json:"systemData,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
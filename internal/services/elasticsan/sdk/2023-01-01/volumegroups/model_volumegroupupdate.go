@@ -0,0 +1,10 @@
+package volumegroups
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type VolumeGroupUpdate struct {
+	Identity   *identity.SystemOrUserAssignedMap `json:"identity,omitempty"`
+	Properties *VolumeGroupUpdateProperties      `json:"properties,omitempty"`
+}
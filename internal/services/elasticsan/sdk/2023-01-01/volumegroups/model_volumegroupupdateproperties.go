@@ -0,0 +1,8 @@
+package volumegroups
+
+type VolumeGroupUpdateProperties struct {
+	Encryption           *EncryptionType       `json:"encryption,omitempty"`
+	EncryptionProperties *EncryptionProperties `json:"encryptionProperties,omitempty"`
+	NetworkAcls          *NetworkRuleSet       `json:"networkAcls,omitempty"`
+	ProtocolType         *StorageTargetType    `json:"protocolType,omitempty"`
+}
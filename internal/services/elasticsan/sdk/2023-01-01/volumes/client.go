@@ -0,0 +1,15 @@
+package volumes
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VolumesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVolumesClientWithBaseURI(endpoint string) VolumesClient {
+	return VolumesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package volumes
+
+type IscsiTargetInfo struct {
+	ProvisioningState    *ProvisioningStates `json:"provisioningState,omitempty"`
+	Status               *OperationalStatus  `json:"status,omitempty"`
+	TargetIqn            *string             `json:"targetIqn,omitempty"`
+	TargetPortalHostname *string             `json:"targetPortalHostname,omitempty"`
+	TargetPortalPort     *int64              `json:"targetPortalPort,omitempty"`
+}
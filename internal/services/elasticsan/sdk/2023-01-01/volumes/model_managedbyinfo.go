@@ -0,0 +1,5 @@
+package volumes
+
+type ManagedByInfo struct {
+	ResourceId *string `json:"resourceId,omitempty"`
+}
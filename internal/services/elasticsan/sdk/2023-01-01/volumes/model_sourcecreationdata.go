@@ -0,0 +1,6 @@
+package volumes
+
+type SourceCreationData struct {
+	CreateSource *VolumeCreateOption `json:"createSource,omitempty"`
+	SourceId     *string             `json:"sourceId,omitempty"`
+}
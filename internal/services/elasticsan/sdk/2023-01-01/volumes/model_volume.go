@@ -0,0 +1,9 @@
+package volumes
+
+type Volume struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties VolumeProperties `json:"properties"`
+	SystemData *SystemData      `json:"systemData,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
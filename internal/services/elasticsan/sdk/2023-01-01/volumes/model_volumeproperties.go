@@ -0,0 +1,10 @@
+package volumes
+
+type VolumeProperties struct {
+	CreationData      *SourceCreationData `json:"creationData,omitempty"`
+	ManagedBy         *ManagedByInfo      `json:"managedBy,omitempty"`
+	ProvisioningState *ProvisioningStates `json:"provisioningState,omitempty"`
+	SizeGiB           int64               `json:"sizeGiB"`
+	StorageTarget     *IscsiTargetInfo    `json:"storageTarget,omitempty"`
+	VolumeId          *string             `json:"volumeId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package volumes
+
+type VolumeUpdate struct {
+	Properties *VolumeUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,9 @@
+package volumes
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/volumes/%s", defaultApiVersion)
+}
@@ -0,0 +1,40 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var elasticSanChildNameRegex = regexp.MustCompile(`^[a-z0-9]+([-_][a-z0-9]+)*$`)
+
+const elasticSanChildNameMessage = "The name must begin and end with a lowercase letter or number, and may contain only lowercase letters, numbers, hyphens and underscores. Hyphens and underscores must be immediately preceded and followed by a letter or number."
+
+func ElasticSanName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(3, 24),
+		validation.StringMatch(elasticSanChildNameRegex, elasticSanChildNameMessage),
+	)
+}
+
+func ElasticSanVolumeGroupName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(3, 63),
+		validation.StringMatch(elasticSanChildNameRegex, elasticSanChildNameMessage),
+	)
+}
+
+func ElasticSanVolumeName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(3, 63),
+		validation.StringMatch(elasticSanChildNameRegex, elasticSanChildNameMessage),
+	)
+}
+
+func ElasticSanSnapshotName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(3, 80),
+		validation.StringMatch(elasticSanChildNameRegex, elasticSanChildNameMessage),
+	)
+}
@@ -0,0 +1,114 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestElasticSanName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "ab",
+			Expected: false,
+		},
+		{
+			Name:     "abc",
+			Expected: true,
+		},
+		{
+			Name:     "san-1_test",
+			Expected: true,
+		},
+		{
+			Name:     "San1",
+			Expected: false,
+		},
+		{
+			Name:     "-san1",
+			Expected: false,
+		},
+		{
+			Name:     "san1_",
+			Expected: false,
+		},
+		{
+			Name:     "san--1",
+			Expected: false,
+		},
+		{
+			Name:     "san-_1",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 24),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 25),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := ElasticSanName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestElasticSanVolumeGroupName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "vg",
+			Expected: false,
+		},
+		{
+			Name:     "volume-group_1",
+			Expected: true,
+		},
+		{
+			Name:     "Volume-group",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 63),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 64),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := ElasticSanVolumeGroupName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
@@ -3,6 +3,13 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2020-10-15-preview/eventgrid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/cacertificates"
+	namespaceclient "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/clientgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/permissionbindings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/topicspaces"
 )
 
 type Client struct {
@@ -12,6 +19,14 @@ type Client struct {
 	TopicsClient                        *eventgrid.TopicsClient
 	SystemTopicsClient                  *eventgrid.SystemTopicsClient
 	SystemTopicEventSubscriptionsClient *eventgrid.SystemTopicEventSubscriptionsClient
+
+	NamespacesClient         *namespaces.NamespacesClient
+	NamespaceTopicsClient    *namespacetopics.NamespaceTopicsClient
+	TopicSpacesClient        *topicspaces.TopicSpacesClient
+	ClientsClient            *namespaceclient.ClientClient
+	ClientGroupsClient       *clientgroups.ClientGroupsClient
+	PermissionBindingsClient *permissionbindings.PermissionBindingsClient
+	CaCertificatesClient     *cacertificates.CaCertificatesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -33,6 +48,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	SystemTopicEventSubscriptionsClient := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SystemTopicEventSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
 
+	NamespacesClient := namespaces.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceTopicsClient := namespacetopics.NewNamespaceTopicsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceTopicsClient.Client, o.ResourceManagerAuthorizer)
+
+	TopicSpacesClient := topicspaces.NewTopicSpacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&TopicSpacesClient.Client, o.ResourceManagerAuthorizer)
+
+	ClientsClient := namespaceclient.NewClientClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ClientsClient.Client, o.ResourceManagerAuthorizer)
+
+	ClientGroupsClient := clientgroups.NewClientGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ClientGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	PermissionBindingsClient := permissionbindings.NewPermissionBindingsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PermissionBindingsClient.Client, o.ResourceManagerAuthorizer)
+
+	CaCertificatesClient := cacertificates.NewCaCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&CaCertificatesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		DomainsClient:                       &DomainsClient,
 		EventSubscriptionsClient:            &EventSubscriptionsClient,
@@ -40,5 +76,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		TopicsClient:                        &TopicsClient,
 		SystemTopicsClient:                  &SystemTopicsClient,
 		SystemTopicEventSubscriptionsClient: &SystemTopicEventSubscriptionsClient,
+
+		NamespacesClient:         &NamespacesClient,
+		NamespaceTopicsClient:    &NamespaceTopicsClient,
+		TopicSpacesClient:        &TopicSpacesClient,
+		ClientsClient:            &ClientsClient,
+		ClientGroupsClient:       &ClientGroupsClient,
+		PermissionBindingsClient: &PermissionBindingsClient,
+		CaCertificatesClient:     &CaCertificatesClient,
 	}
 }
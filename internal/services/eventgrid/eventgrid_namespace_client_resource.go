@@ -0,0 +1,307 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	namespaceclient "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceClientResource{}
+
+type EventGridNamespaceClientResource struct{}
+
+type EventGridNamespaceClientResourceModel struct {
+	Name                            string                                    `tfschema:"name"`
+	NamespaceId                     string                                    `tfschema:"namespace_id"`
+	AuthenticationName              string                                    `tfschema:"authentication_name"`
+	Attributes                      map[string]string                         `tfschema:"attributes"`
+	ClientCertificateAuthentication []EventGridNamespaceClientCertificateAuth `tfschema:"client_certificate_authentication"`
+	Description                     string                                    `tfschema:"description"`
+	Enabled                         bool                                      `tfschema:"enabled"`
+}
+
+type EventGridNamespaceClientCertificateAuth struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientResourceModel{}
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaceclient.ValidateClientID
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9:._]{3,50}$"),
+				"EventGrid Namespace Client name must be 3 - 50 characters long, contain only letters, numbers, hyphens, colons, periods and underscores.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(3, 128),
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(namespaceclient.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			var config EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := namespaces.ParseNamespaceID(config.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := namespaceclient.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := namespaceclient.Client{
+				Properties: expandEventGridNamespaceClientProperties(config),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := namespaceclient.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientResourceModel{
+				Name:        id.ClientName,
+				NamespaceId: namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AuthenticationName = utils.NormalizeNilableString(props.AuthenticationName)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Enabled = props.State == nil || *props.State == namespaceclient.ClientStateEnabled
+
+					attributes := make(map[string]string)
+					if props.Attributes != nil {
+						for k, v := range *props.Attributes {
+							if value, ok := v.(string); ok {
+								attributes[k] = value
+							}
+						}
+					}
+					state.Attributes = attributes
+
+					if auth := props.ClientCertificateAuthentication; auth != nil {
+						certificateAuth := EventGridNamespaceClientCertificateAuth{
+							AllowedThumbprints: make([]string, 0),
+						}
+						if auth.ValidationScheme != nil {
+							certificateAuth.ValidationScheme = string(*auth.ValidationScheme)
+						}
+						if auth.AllowedThumbprints != nil {
+							certificateAuth.AllowedThumbprints = *auth.AllowedThumbprints
+						}
+						state.ClientCertificateAuthentication = []EventGridNamespaceClientCertificateAuth{certificateAuth}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := namespaceclient.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := namespaceclient.Client{
+				Properties: expandEventGridNamespaceClientProperties(config),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := namespaceclient.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceClientProperties(input EventGridNamespaceClientResourceModel) *namespaceclient.ClientProperties {
+	state := namespaceclient.ClientStateDisabled
+	if input.Enabled {
+		state = namespaceclient.ClientStateEnabled
+	}
+
+	attributes := make(map[string]interface{})
+	for k, v := range input.Attributes {
+		attributes[k] = v
+	}
+
+	output := &namespaceclient.ClientProperties{
+		Attributes:  &attributes,
+		Description: utils.String(input.Description),
+		State:       &state,
+	}
+
+	if input.AuthenticationName != "" {
+		output.AuthenticationName = utils.String(input.AuthenticationName)
+	}
+
+	if len(input.ClientCertificateAuthentication) > 0 {
+		auth := input.ClientCertificateAuthentication[0]
+		validationScheme := namespaceclient.ClientCertificateValidationScheme(auth.ValidationScheme)
+		output.ClientCertificateAuthentication = &namespaceclient.ClientCertificateAuthentication{
+			AllowedThumbprints: &auth.AllowedThumbprints,
+			ValidationScheme:   &validationScheme,
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,487 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2025-02-15/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+
+type EventGridNamespaceResource struct{}
+
+type EventGridNamespaceResourceModel struct {
+	Name                     string                                     `tfschema:"name"`
+	ResourceGroupName        string                                     `tfschema:"resource_group_name"`
+	Location                 string                                     `tfschema:"location"`
+	Sku                      string                                     `tfschema:"sku"`
+	Capacity                 int64                                      `tfschema:"capacity"`
+	InboundIPRules           []EventGridNamespaceInboundIPRuleModel     `tfschema:"inbound_ip_rule"`
+	PublicNetworkAccess      string                                     `tfschema:"public_network_access"`
+	TopicSpacesConfiguration []EventGridNamespaceTopicSpacesConfigModel `tfschema:"topic_spaces_configuration"`
+	Tags                     map[string]string                          `tfschema:"tags"`
+	TopicsHostname           string                                     `tfschema:"topics_hostname"`
+	TopicSpacesHostname      string                                     `tfschema:"topic_spaces_hostname"`
+}
+
+type EventGridNamespaceInboundIPRuleModel struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type EventGridNamespaceTopicSpacesConfigModel struct {
+	AlternativeAuthenticationNameSources       []string `tfschema:"alternative_authentication_name_sources"`
+	MaximumClientSessionsPerAuthenticationName int64    `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64    `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string   `tfschema:"route_topic_id"`
+}
+
+func (r EventGridNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (r EventGridNamespaceResource) ModelObject() interface{} {
+	return &EventGridNamespaceResourceModel{}
+}
+
+func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaces.ValidateNamespaceID
+}
+
+func (r EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(namespaces.SkuNameStandard),
+			ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForSkuName(), false),
+		},
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+		"inbound_ip_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 128,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPv4Address),
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(namespaces.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForIPActionType(), false),
+					},
+				},
+			},
+		},
+
+		"public_network_access": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(namespaces.PublicNetworkAccessEnabled),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(namespaces.PublicNetworkAccessDisabled),
+				string(namespaces.PublicNetworkAccessEnabled),
+			}, false),
+		},
+
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_sources": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForAlternativeAuthenticationNameSource(), false),
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"topics_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"topic_spaces_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config EventGridNamespaceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := namespaces.NewNamespaceID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := namespaces.Namespace{
+				Location:   location.Normalize(config.Location),
+				Identity:   expandedIdentity,
+				Properties: expandEventGridNamespaceProperties(config),
+				Sku: &namespaces.NamespaceSku{
+					Capacity: utils.Int64(config.Capacity),
+					Name:     pointerToNamespaceSkuName(config.Sku),
+				},
+				Tags: &config.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceResourceModel{
+				Name:              id.NamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if sku := model.Sku; sku != nil {
+					if sku.Name != nil {
+						state.Sku = string(*sku.Name)
+					}
+					if sku.Capacity != nil {
+						state.Capacity = *sku.Capacity
+					}
+				}
+
+				if props := model.Properties; props != nil {
+					state.InboundIPRules = flattenEventGridNamespaceInboundIPRules(props.InboundIPRules)
+
+					state.PublicNetworkAccess = string(namespaces.PublicNetworkAccessEnabled)
+					if props.PublicNetworkAccess != nil {
+						state.PublicNetworkAccess = string(*props.PublicNetworkAccess)
+					}
+
+					topicSpacesConfiguration, err := flattenEventGridNamespaceTopicSpacesConfiguration(props.TopicSpacesConfiguration)
+					if err != nil {
+						return fmt.Errorf("flattening `topic_spaces_configuration`: %+v", err)
+					}
+					state.TopicSpacesConfiguration = topicSpacesConfiguration
+
+					if config := props.TopicSpacesConfiguration; config != nil && config.Hostname != nil {
+						state.TopicSpacesHostname = *config.Hostname
+					}
+					if config := props.TopicsConfiguration; config != nil && config.Hostname != nil {
+						state.TopicsHostname = *config.Hostname
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config EventGridNamespaceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChanges("sku", "capacity") {
+				payload.Sku = &namespaces.NamespaceSku{
+					Capacity: utils.Int64(config.Capacity),
+					Name:     pointerToNamespaceSkuName(config.Sku),
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("inbound_ip_rule", "public_network_access", "topic_spaces_configuration") {
+				payload.Properties = expandEventGridNamespaceProperties(config)
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func pointerToNamespaceSkuName(input string) *namespaces.SkuName {
+	sku := namespaces.SkuName(input)
+	return &sku
+}
+
+func expandEventGridNamespaceProperties(input EventGridNamespaceResourceModel) *namespaces.NamespaceProperties {
+	publicNetworkAccess := namespaces.PublicNetworkAccess(input.PublicNetworkAccess)
+
+	inboundIPRules := make([]namespaces.InboundIPRule, 0)
+	for _, v := range input.InboundIPRules {
+		action := namespaces.IPActionType(v.Action)
+		inboundIPRules = append(inboundIPRules, namespaces.InboundIPRule{
+			Action: &action,
+			IPMask: utils.String(v.IPMask),
+		})
+	}
+
+	state := namespaces.TopicSpacesConfigurationStateDisabled
+	topicSpacesConfiguration := &namespaces.TopicSpacesConfiguration{
+		State: &state,
+	}
+	if len(input.TopicSpacesConfiguration) > 0 {
+		config := input.TopicSpacesConfiguration[0]
+		state = namespaces.TopicSpacesConfigurationStateEnabled
+
+		nameSources := make([]namespaces.AlternativeAuthenticationNameSource, 0)
+		for _, v := range config.AlternativeAuthenticationNameSources {
+			nameSources = append(nameSources, namespaces.AlternativeAuthenticationNameSource(v))
+		}
+
+		topicSpacesConfiguration = &namespaces.TopicSpacesConfiguration{
+			ClientAuthentication: &namespaces.ClientAuthenticationSettings{
+				AlternativeAuthenticationNameSources: &nameSources,
+			},
+			MaximumClientSessionsPerAuthenticationName: utils.Int64(config.MaximumClientSessionsPerAuthenticationName),
+			MaximumSessionExpiryInHours:                utils.Int64(config.MaximumSessionExpiryInHours),
+			State:                                      &state,
+		}
+		if config.RouteTopicId != "" {
+			topicSpacesConfiguration.RouteTopicResourceId = utils.String(config.RouteTopicId)
+		}
+	}
+
+	return &namespaces.NamespaceProperties{
+		InboundIPRules:           &inboundIPRules,
+		PublicNetworkAccess:      &publicNetworkAccess,
+		TopicSpacesConfiguration: topicSpacesConfiguration,
+	}
+}
+
+func flattenEventGridNamespaceInboundIPRules(input *[]namespaces.InboundIPRule) []EventGridNamespaceInboundIPRuleModel {
+	output := make([]EventGridNamespaceInboundIPRuleModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		rule := EventGridNamespaceInboundIPRuleModel{
+			Action: string(namespaces.IPActionTypeAllow),
+		}
+		if v.Action != nil {
+			rule.Action = string(*v.Action)
+		}
+		if v.IPMask != nil {
+			rule.IPMask = *v.IPMask
+		}
+		output = append(output, rule)
+	}
+
+	return output
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *namespaces.TopicSpacesConfiguration) ([]EventGridNamespaceTopicSpacesConfigModel, error) {
+	if input == nil || input.State == nil || *input.State != namespaces.TopicSpacesConfigurationStateEnabled {
+		return []EventGridNamespaceTopicSpacesConfigModel{}, nil
+	}
+
+	output := EventGridNamespaceTopicSpacesConfigModel{
+		AlternativeAuthenticationNameSources: make([]string, 0),
+	}
+
+	if auth := input.ClientAuthentication; auth != nil && auth.AlternativeAuthenticationNameSources != nil {
+		for _, v := range *auth.AlternativeAuthenticationNameSources {
+			output.AlternativeAuthenticationNameSources = append(output.AlternativeAuthenticationNameSources, string(v))
+		}
+	}
+
+	if input.MaximumClientSessionsPerAuthenticationName != nil {
+		output.MaximumClientSessionsPerAuthenticationName = *input.MaximumClientSessionsPerAuthenticationName
+	}
+
+	if input.MaximumSessionExpiryInHours != nil {
+		output.MaximumSessionExpiryInHours = *input.MaximumSessionExpiryInHours
+	}
+
+	if input.RouteTopicResourceId != nil && *input.RouteTopicResourceId != "" {
+		routeTopicId, err := namespacetopics.ParseNamespaceTopicIDInsensitively(*input.RouteTopicResourceId)
+		if err != nil {
+			return nil, err
+		}
+		output.RouteTopicId = routeTopicId.ID()
+	}
+
+	return []EventGridNamespaceTopicSpacesConfigModel{output}, nil
+}
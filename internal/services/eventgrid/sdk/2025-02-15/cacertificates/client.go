@@ -0,0 +1,15 @@
+package cacertificates
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CaCertificatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCaCertificatesClientWithBaseURI(endpoint string) CaCertificatesClient {
+	return CaCertificatesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,46 @@
+package cacertificates
+
+import "strings"
+
+type CaCertificateProvisioningState string
+
+const (
+	CaCertificateProvisioningStateCanceled  CaCertificateProvisioningState = "Canceled"
+	CaCertificateProvisioningStateCreating  CaCertificateProvisioningState = "Creating"
+	CaCertificateProvisioningStateDeleted   CaCertificateProvisioningState = "Deleted"
+	CaCertificateProvisioningStateDeleting  CaCertificateProvisioningState = "Deleting"
+	CaCertificateProvisioningStateFailed    CaCertificateProvisioningState = "Failed"
+	CaCertificateProvisioningStateSucceeded CaCertificateProvisioningState = "Succeeded"
+	CaCertificateProvisioningStateUpdating  CaCertificateProvisioningState = "Updating"
+)
+
+func PossibleValuesForCaCertificateProvisioningState() []string {
+	return []string{
+		string(CaCertificateProvisioningStateCanceled),
+		string(CaCertificateProvisioningStateCreating),
+		string(CaCertificateProvisioningStateDeleted),
+		string(CaCertificateProvisioningStateDeleting),
+		string(CaCertificateProvisioningStateFailed),
+		string(CaCertificateProvisioningStateSucceeded),
+		string(CaCertificateProvisioningStateUpdating),
+	}
+}
+
+func parseCaCertificateProvisioningState(input string) (*CaCertificateProvisioningState, error) {
+	vals := map[string]CaCertificateProvisioningState{
+		"canceled":  CaCertificateProvisioningStateCanceled,
+		"creating":  CaCertificateProvisioningStateCreating,
+		"deleted":   CaCertificateProvisioningStateDeleted,
+		"deleting":  CaCertificateProvisioningStateDeleting,
+		"failed":    CaCertificateProvisioningStateFailed,
+		"succeeded": CaCertificateProvisioningStateSucceeded,
+		"updating":  CaCertificateProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CaCertificateProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package cacertificates
+
+type CaCertificate struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *CaCertificateProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
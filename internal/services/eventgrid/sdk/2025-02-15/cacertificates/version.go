@@ -0,0 +1,9 @@
+package cacertificates
+
+import "fmt"
+
+const defaultApiVersion = "2025-02-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/cacertificates/%s", defaultApiVersion)
+}
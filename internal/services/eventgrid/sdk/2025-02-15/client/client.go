@@ -0,0 +1,15 @@
+package client
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ClientClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClientClientWithBaseURI(endpoint string) ClientClient {
+	return ClientClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,10 @@
+package client
+
+type ClientProperties struct {
+	Attributes                      *map[string]interface{}          `json:"attributes,omitempty"`
+	AuthenticationName              *string                          `json:"authenticationName,omitempty"`
+	ClientCertificateAuthentication *ClientCertificateAuthentication `json:"clientCertificateAuthentication,omitempty"`
+	Description                     *string                          `json:"description,omitempty"`
+	ProvisioningState               *ClientProvisioningState         `json:"provisioningState,omitempty"`
+	State                           *ClientState                     `json:"state,omitempty"`
+}
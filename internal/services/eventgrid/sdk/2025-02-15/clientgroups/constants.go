@@ -0,0 +1,46 @@
+package clientgroups
+
+import "strings"
+
+type ClientGroupProvisioningState string
+
+const (
+	ClientGroupProvisioningStateCanceled  ClientGroupProvisioningState = "Canceled"
+	ClientGroupProvisioningStateCreating  ClientGroupProvisioningState = "Creating"
+	ClientGroupProvisioningStateDeleted   ClientGroupProvisioningState = "Deleted"
+	ClientGroupProvisioningStateDeleting  ClientGroupProvisioningState = "Deleting"
+	ClientGroupProvisioningStateFailed    ClientGroupProvisioningState = "Failed"
+	ClientGroupProvisioningStateSucceeded ClientGroupProvisioningState = "Succeeded"
+	ClientGroupProvisioningStateUpdating  ClientGroupProvisioningState = "Updating"
+)
+
+func PossibleValuesForClientGroupProvisioningState() []string {
+	return []string{
+		string(ClientGroupProvisioningStateCanceled),
+		string(ClientGroupProvisioningStateCreating),
+		string(ClientGroupProvisioningStateDeleted),
+		string(ClientGroupProvisioningStateDeleting),
+		string(ClientGroupProvisioningStateFailed),
+		string(ClientGroupProvisioningStateSucceeded),
+		string(ClientGroupProvisioningStateUpdating),
+	}
+}
+
+func parseClientGroupProvisioningState(input string) (*ClientGroupProvisioningState, error) {
+	vals := map[string]ClientGroupProvisioningState{
+		"canceled":  ClientGroupProvisioningStateCanceled,
+		"creating":  ClientGroupProvisioningStateCreating,
+		"deleted":   ClientGroupProvisioningStateDeleted,
+		"deleting":  ClientGroupProvisioningStateDeleting,
+		"failed":    ClientGroupProvisioningStateFailed,
+		"succeeded": ClientGroupProvisioningStateSucceeded,
+		"updating":  ClientGroupProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ClientGroupProvisioningState(input)
+	return &out, nil
+}
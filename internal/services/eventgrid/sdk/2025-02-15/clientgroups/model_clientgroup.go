@@ -0,0 +1,8 @@
+package clientgroups
+
+type ClientGroup struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ClientGroupProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
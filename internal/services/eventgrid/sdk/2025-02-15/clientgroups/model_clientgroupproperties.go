@@ -0,0 +1,7 @@
+package clientgroups
+
+type ClientGroupProperties struct {
+	Description       *string                       `json:"description,omitempty"`
+	ProvisioningState *ClientGroupProvisioningState `json:"provisioningState,omitempty"`
+	Query             *string                       `json:"query,omitempty"`
+}
@@ -0,0 +1,9 @@
+package clientgroups
+
+import "fmt"
+
+const defaultApiVersion = "2025-02-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/clientgroups/%s", defaultApiVersion)
+}
@@ -0,0 +1,5 @@
+package namespaces
+
+type ClientAuthenticationSettings struct {
+	AlternativeAuthenticationNameSources *[]AlternativeAuthenticationNameSource `json:"alternativeAuthenticationNameSources,omitempty"`
+}
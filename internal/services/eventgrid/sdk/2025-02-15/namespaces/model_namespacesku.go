@@ -0,0 +1,6 @@
+package namespaces
+
+type NamespaceSku struct {
+	Capacity *int64   `json:"capacity,omitempty"`
+	Name     *SkuName `json:"name,omitempty"`
+}
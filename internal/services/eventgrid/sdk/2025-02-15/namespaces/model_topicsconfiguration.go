@@ -0,0 +1,5 @@
+package namespaces
+
+type TopicsConfiguration struct {
+	Hostname *string `json:"hostname,omitempty"`
+}
@@ -0,0 +1,9 @@
+package namespaces
+
+import "fmt"
+
+const defaultApiVersion = "2025-02-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/namespaces/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package namespacetopics
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NamespaceTopicsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespaceTopicsClientWithBaseURI(endpoint string) NamespaceTopicsClient {
+	return NamespaceTopicsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
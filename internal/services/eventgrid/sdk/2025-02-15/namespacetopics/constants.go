@@ -0,0 +1,102 @@
+package namespacetopics
+
+import "strings"
+
+type EventInputSchema string
+
+const (
+	EventInputSchemaCloudEventSchemaVOneZero EventInputSchema = "CloudEventSchemaV1_0"
+)
+
+func PossibleValuesForEventInputSchema() []string {
+	return []string{
+		string(EventInputSchemaCloudEventSchemaVOneZero),
+	}
+}
+
+func parseEventInputSchema(input string) (*EventInputSchema, error) {
+	vals := map[string]EventInputSchema{
+		"cloudeventschemav1_0": EventInputSchemaCloudEventSchemaVOneZero,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EventInputSchema(input)
+	return &out, nil
+}
+
+type NamespaceTopicProvisioningState string
+
+const (
+	NamespaceTopicProvisioningStateCanceled     NamespaceTopicProvisioningState = "Canceled"
+	NamespaceTopicProvisioningStateCreateFailed NamespaceTopicProvisioningState = "CreateFailed"
+	NamespaceTopicProvisioningStateCreating     NamespaceTopicProvisioningState = "Creating"
+	NamespaceTopicProvisioningStateDeleteFailed NamespaceTopicProvisioningState = "DeleteFailed"
+	NamespaceTopicProvisioningStateDeleted      NamespaceTopicProvisioningState = "Deleted"
+	NamespaceTopicProvisioningStateDeleting     NamespaceTopicProvisioningState = "Deleting"
+	NamespaceTopicProvisioningStateFailed       NamespaceTopicProvisioningState = "Failed"
+	NamespaceTopicProvisioningStateSucceeded    NamespaceTopicProvisioningState = "Succeeded"
+	NamespaceTopicProvisioningStateUpdating     NamespaceTopicProvisioningState = "Updating"
+)
+
+func PossibleValuesForNamespaceTopicProvisioningState() []string {
+	return []string{
+		string(NamespaceTopicProvisioningStateCanceled),
+		string(NamespaceTopicProvisioningStateCreateFailed),
+		string(NamespaceTopicProvisioningStateCreating),
+		string(NamespaceTopicProvisioningStateDeleteFailed),
+		string(NamespaceTopicProvisioningStateDeleted),
+		string(NamespaceTopicProvisioningStateDeleting),
+		string(NamespaceTopicProvisioningStateFailed),
+		string(NamespaceTopicProvisioningStateSucceeded),
+		string(NamespaceTopicProvisioningStateUpdating),
+	}
+}
+
+func parseNamespaceTopicProvisioningState(input string) (*NamespaceTopicProvisioningState, error) {
+	vals := map[string]NamespaceTopicProvisioningState{
+		"canceled":     NamespaceTopicProvisioningStateCanceled,
+		"createfailed": NamespaceTopicProvisioningStateCreateFailed,
+		"creating":     NamespaceTopicProvisioningStateCreating,
+		"deletefailed": NamespaceTopicProvisioningStateDeleteFailed,
+		"deleted":      NamespaceTopicProvisioningStateDeleted,
+		"deleting":     NamespaceTopicProvisioningStateDeleting,
+		"failed":       NamespaceTopicProvisioningStateFailed,
+		"succeeded":    NamespaceTopicProvisioningStateSucceeded,
+		"updating":     NamespaceTopicProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NamespaceTopicProvisioningState(input)
+	return &out, nil
+}
+
+type PublisherType string
+
+const (
+	PublisherTypeCustom PublisherType = "Custom"
+)
+
+func PossibleValuesForPublisherType() []string {
+	return []string{
+		string(PublisherTypeCustom),
+	}
+}
+
+func parsePublisherType(input string) (*PublisherType, error) {
+	vals := map[string]PublisherType{
+		"custom": PublisherTypeCustom,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublisherType(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package namespacetopics
+
+import "fmt"
+
+const defaultApiVersion = "2025-02-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/namespacetopics/%s", defaultApiVersion)
+}
@@ -0,0 +1,74 @@
+package permissionbindings
+
+import "strings"
+
+type PermissionType string
+
+const (
+	PermissionTypePublisher  PermissionType = "Publisher"
+	PermissionTypeSubscriber PermissionType = "Subscriber"
+)
+
+func PossibleValuesForPermissionType() []string {
+	return []string{
+		string(PermissionTypePublisher),
+		string(PermissionTypeSubscriber),
+	}
+}
+
+func parsePermissionType(input string) (*PermissionType, error) {
+	vals := map[string]PermissionType{
+		"publisher":  PermissionTypePublisher,
+		"subscriber": PermissionTypeSubscriber,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PermissionType(input)
+	return &out, nil
+}
+
+type PermissionBindingProvisioningState string
+
+const (
+	PermissionBindingProvisioningStateCanceled  PermissionBindingProvisioningState = "Canceled"
+	PermissionBindingProvisioningStateCreating  PermissionBindingProvisioningState = "Creating"
+	PermissionBindingProvisioningStateDeleted   PermissionBindingProvisioningState = "Deleted"
+	PermissionBindingProvisioningStateDeleting  PermissionBindingProvisioningState = "Deleting"
+	PermissionBindingProvisioningStateFailed    PermissionBindingProvisioningState = "Failed"
+	PermissionBindingProvisioningStateSucceeded PermissionBindingProvisioningState = "Succeeded"
+	PermissionBindingProvisioningStateUpdating  PermissionBindingProvisioningState = "Updating"
+)
+
+func PossibleValuesForPermissionBindingProvisioningState() []string {
+	return []string{
+		string(PermissionBindingProvisioningStateCanceled),
+		string(PermissionBindingProvisioningStateCreating),
+		string(PermissionBindingProvisioningStateDeleted),
+		string(PermissionBindingProvisioningStateDeleting),
+		string(PermissionBindingProvisioningStateFailed),
+		string(PermissionBindingProvisioningStateSucceeded),
+		string(PermissionBindingProvisioningStateUpdating),
+	}
+}
+
+func parsePermissionBindingProvisioningState(input string) (*PermissionBindingProvisioningState, error) {
+	vals := map[string]PermissionBindingProvisioningState{
+		"canceled":  PermissionBindingProvisioningStateCanceled,
+		"creating":  PermissionBindingProvisioningStateCreating,
+		"deleted":   PermissionBindingProvisioningStateDeleted,
+		"deleting":  PermissionBindingProvisioningStateDeleting,
+		"failed":    PermissionBindingProvisioningStateFailed,
+		"succeeded": PermissionBindingProvisioningStateSucceeded,
+		"updating":  PermissionBindingProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PermissionBindingProvisioningState(input)
+	return &out, nil
+}
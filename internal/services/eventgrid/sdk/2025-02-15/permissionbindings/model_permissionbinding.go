@@ -0,0 +1,8 @@
+package permissionbindings
+
+type PermissionBinding struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *PermissionBindingProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
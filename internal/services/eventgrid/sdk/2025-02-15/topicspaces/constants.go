@@ -0,0 +1,46 @@
+package topicspaces
+
+import "strings"
+
+type TopicSpaceProvisioningState string
+
+const (
+	TopicSpaceProvisioningStateCanceled  TopicSpaceProvisioningState = "Canceled"
+	TopicSpaceProvisioningStateCreating  TopicSpaceProvisioningState = "Creating"
+	TopicSpaceProvisioningStateDeleted   TopicSpaceProvisioningState = "Deleted"
+	TopicSpaceProvisioningStateDeleting  TopicSpaceProvisioningState = "Deleting"
+	TopicSpaceProvisioningStateFailed    TopicSpaceProvisioningState = "Failed"
+	TopicSpaceProvisioningStateSucceeded TopicSpaceProvisioningState = "Succeeded"
+	TopicSpaceProvisioningStateUpdating  TopicSpaceProvisioningState = "Updating"
+)
+
+func PossibleValuesForTopicSpaceProvisioningState() []string {
+	return []string{
+		string(TopicSpaceProvisioningStateCanceled),
+		string(TopicSpaceProvisioningStateCreating),
+		string(TopicSpaceProvisioningStateDeleted),
+		string(TopicSpaceProvisioningStateDeleting),
+		string(TopicSpaceProvisioningStateFailed),
+		string(TopicSpaceProvisioningStateSucceeded),
+		string(TopicSpaceProvisioningStateUpdating),
+	}
+}
+
+func parseTopicSpaceProvisioningState(input string) (*TopicSpaceProvisioningState, error) {
+	vals := map[string]TopicSpaceProvisioningState{
+		"canceled":  TopicSpaceProvisioningStateCanceled,
+		"creating":  TopicSpaceProvisioningStateCreating,
+		"deleted":   TopicSpaceProvisioningStateDeleted,
+		"deleting":  TopicSpaceProvisioningStateDeleting,
+		"failed":    TopicSpaceProvisioningStateFailed,
+		"succeeded": TopicSpaceProvisioningStateSucceeded,
+		"updating":  TopicSpaceProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TopicSpaceProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package topicspaces
+
+import "fmt"
+
+const defaultApiVersion = "2025-02-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/topicspaces/%s", defaultApiVersion)
+}
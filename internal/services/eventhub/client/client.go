@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2018-01-01-preview/eventhubsclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2018-01-01-preview/networkrulesets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	eventhubsLatest "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2024-01-01/eventhubs"
 )
 
 type Client struct {
@@ -19,6 +20,7 @@ type Client struct {
 	DisasterRecoveryConfigsClient          *disasterrecoveryconfigs.DisasterRecoveryConfigsClient
 	DisasterRecoveryNameAvailabilityClient *checknameavailabilitydisasterrecoveryconfigs.CheckNameAvailabilityDisasterRecoveryConfigsClient
 	EventHubsClient                        *eventhubs.EventHubsClient
+	EventHubsLatestClient                  *eventhubsLatest.EventHubsClient
 	EventHubAuthorizationRulesClient       *authorizationruleseventhubs.AuthorizationRulesEventHubsClient
 	NamespacesClient                       *namespaces.NamespacesClient
 	NamespaceAuthorizationRulesClient      *authorizationrulesnamespaces.AuthorizationRulesNamespacesClient
@@ -41,6 +43,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	eventhubsClient := eventhubs.NewEventHubsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&eventhubsClient.Client, o.ResourceManagerAuthorizer)
 
+	eventhubsLatestClient := eventhubsLatest.NewEventHubsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&eventhubsLatestClient.Client, o.ResourceManagerAuthorizer)
+
 	eventHubAuthorizationRulesClient := authorizationruleseventhubs.NewAuthorizationRulesEventHubsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&eventHubAuthorizationRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -59,6 +64,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		DisasterRecoveryConfigsClient:          &disasterRecoveryConfigsClient,
 		DisasterRecoveryNameAvailabilityClient: &disasterRecoveryNameAvailabilityClient,
 		EventHubsClient:                        &eventhubsClient,
+		EventHubsLatestClient:                  &eventhubsLatestClient,
 		EventHubAuthorizationRulesClient:       &eventHubAuthorizationRulesClient,
 		NamespacesClient:                       &namespacesClient,
 		NamespaceAuthorizationRulesClient:      &namespaceAuthorizationRulesClient,
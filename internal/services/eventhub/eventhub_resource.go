@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -65,8 +65,40 @@ func resourceEventHub() *pluginsdk.Resource {
 
 			"message_retention": {
 				Type:         pluginsdk.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validate.ValidateEventHubMessageRetentionCount,
+				ExactlyOneOf: []string{"message_retention", "retention_description"},
+			},
+
+			"retention_description": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"message_retention", "retention_description"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cleanup_policy": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(eventhubs.CleanupPolicyRetentionDescriptionDelete),
+								string(eventhubs.CleanupPolicyRetentionDescriptionCompact),
+							}, false),
+						},
+						"retention_time_in_hours": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 2160),
+						},
+						"tombstone_retention_time_in_hours": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 2160),
+						},
+					},
+				},
 			},
 
 			"capture_description": {
@@ -164,7 +196,7 @@ func resourceEventHub() *pluginsdk.Resource {
 }
 
 func resourceEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub.EventHubsLatestClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -187,17 +219,26 @@ func resourceEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	partitionCount := int64(d.Get("partition_count").(int))
-	messageRetention := int64(d.Get("message_retention").(int))
 	eventhubStatus := eventhubs.EntityStatus(d.Get("status").(string))
 
 	parameters := eventhubs.Eventhub{
 		Properties: &eventhubs.EventhubProperties{
-			PartitionCount:         &partitionCount,
-			MessageRetentionInDays: &messageRetention,
-			Status:                 &eventhubStatus,
+			PartitionCount: &partitionCount,
+			Status:         &eventhubStatus,
 		},
 	}
 
+	// both fields are Computed, so the raw config is used to determine which one the user has specified
+	if rawRetention := d.GetRawConfig().GetAttr("retention_description"); !rawRetention.IsNull() && rawRetention.LengthInt() > 0 {
+		retentionDescription, err := expandEventHubRetentionDescription(d.Get("retention_description").([]interface{}))
+		if err != nil {
+			return err
+		}
+		parameters.Properties.RetentionDescription = retentionDescription
+	} else {
+		parameters.Properties.MessageRetentionInDays = utils.Int64(int64(d.Get("message_retention").(int)))
+	}
+
 	if _, ok := d.GetOk("capture_description"); ok {
 		parameters.Properties.CaptureDescription = expandEventHubCaptureDescription(d)
 	}
@@ -212,7 +253,7 @@ func resourceEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 }
 
 func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub.EventHubsLatestClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -245,6 +286,10 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			if err := d.Set("capture_description", captureDescription); err != nil {
 				return err
 			}
+
+			if err := d.Set("retention_description", flattenEventHubRetentionDescription(props.RetentionDescription)); err != nil {
+				return fmt.Errorf("setting `retention_description`: %+v", err)
+			}
 		}
 	}
 
@@ -252,7 +297,7 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 }
 
 func resourceEventHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub.EventHubsLatestClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -373,3 +418,66 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 
 	return results
 }
+
+func expandEventHubRetentionDescription(inputs []interface{}) (*eventhubs.RetentionDescription, error) {
+	if len(inputs) == 0 || inputs[0] == nil {
+		return nil, nil
+	}
+	input := inputs[0].(map[string]interface{})
+
+	cleanupPolicy := eventhubs.CleanupPolicyRetentionDescription(input["cleanup_policy"].(string))
+	retentionTimeInHours := input["retention_time_in_hours"].(int)
+	tombstoneRetentionTimeInHours := input["tombstone_retention_time_in_hours"].(int)
+
+	output := eventhubs.RetentionDescription{
+		CleanupPolicy: &cleanupPolicy,
+	}
+
+	switch cleanupPolicy {
+	case eventhubs.CleanupPolicyRetentionDescriptionDelete:
+		if tombstoneRetentionTimeInHours != 0 {
+			return nil, fmt.Errorf("`tombstone_retention_time_in_hours` cannot be specified when `cleanup_policy` is `%s`", cleanupPolicy)
+		}
+		if retentionTimeInHours != 0 {
+			output.RetentionTimeInHours = utils.Int64(int64(retentionTimeInHours))
+		}
+	case eventhubs.CleanupPolicyRetentionDescriptionCompact:
+		if retentionTimeInHours != 0 {
+			return nil, fmt.Errorf("`retention_time_in_hours` cannot be specified when `cleanup_policy` is `%s`", cleanupPolicy)
+		}
+		if tombstoneRetentionTimeInHours != 0 {
+			output.TombstoneRetentionTimeInHours = utils.Int64(int64(tombstoneRetentionTimeInHours))
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenEventHubRetentionDescription(input *eventhubs.RetentionDescription) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	cleanupPolicy := ""
+	if input.CleanupPolicy != nil {
+		cleanupPolicy = string(*input.CleanupPolicy)
+	}
+
+	retentionTimeInHours := 0
+	if input.RetentionTimeInHours != nil {
+		retentionTimeInHours = int(*input.RetentionTimeInHours)
+	}
+
+	tombstoneRetentionTimeInHours := 0
+	if input.TombstoneRetentionTimeInHours != nil {
+		tombstoneRetentionTimeInHours = int(*input.TombstoneRetentionTimeInHours)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cleanup_policy":                    cleanupPolicy,
+			"retention_time_in_hours":           retentionTimeInHours,
+			"tombstone_retention_time_in_hours": tombstoneRetentionTimeInHours,
+		},
+	}
+}
@@ -0,0 +1,6 @@
+package eventhubs
+
+type Destination struct {
+	Name       *string                `json:"name,omitempty"`
+	Properties *DestinationProperties `json:"properties,omitempty"`
+}
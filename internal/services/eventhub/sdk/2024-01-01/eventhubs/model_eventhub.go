@@ -0,0 +1,9 @@
+package eventhubs
+
+type Eventhub struct {
+	Id         *string             `json:"id,omitempty"`
+	Location   *string             `json:"location,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *EventhubProperties `json:"properties,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package eventhubs
+
+type RetentionDescription struct {
+	CleanupPolicy                 *CleanupPolicyRetentionDescription `json:"cleanupPolicy,omitempty"`
+	RetentionTimeInHours          *int64                             `json:"retentionTimeInHours,omitempty"`
+	TombstoneRetentionTimeInHours *int64                             `json:"tombstoneRetentionTimeInHours,omitempty"`
+}
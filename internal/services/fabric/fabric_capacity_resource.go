@@ -0,0 +1,290 @@
+package fabric
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/sdk/2023-11-01/fabriccapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceFabricCapacity() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFabricCapacityCreate,
+		Read:   resourceFabricCapacityRead,
+		Update: resourceFabricCapacityUpdate,
+		Delete: resourceFabricCapacityDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := fabriccapacities.ParseCapacityID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CapacityName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"F2",
+								"F4",
+								"F8",
+								"F16",
+								"F32",
+								"F64",
+								"F128",
+								"F256",
+								"F512",
+								"F1024",
+								"F2048",
+							}, false),
+						},
+
+						"tier": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(fabriccapacities.RpSkuTierFabric),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"administration_members": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"paused": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceFabricCapacityCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Fabric.CapacityClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := fabriccapacities.NewCapacityID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_fabric_capacity", id.ID())
+	}
+
+	parameters := fabriccapacities.FabricCapacity{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: fabriccapacities.FabricCapacityProperties{
+			Administration: fabriccapacities.CapacityAdministration{
+				Members: *utils.ExpandStringSlice(d.Get("administration_members").(*pluginsdk.Set).List()),
+			},
+		},
+		Sku:  expandFabricCapacitySku(d.Get("sku").([]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// a capacity is always created in an active state, so it has to be paused afterwards
+	if d.Get("paused").(bool) {
+		if err := client.SuspendThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("pausing %s: %+v", id, err)
+		}
+	}
+
+	return resourceFabricCapacityRead(d, meta)
+}
+
+func resourceFabricCapacityRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Fabric.CapacityClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fabriccapacities.ParseCapacityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CapacityName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if err := d.Set("administration_members", model.Properties.Administration.Members); err != nil {
+			return fmt.Errorf("setting `administration_members`: %+v", err)
+		}
+
+		paused := false
+		if state := model.Properties.State; state != nil {
+			paused = *state == fabriccapacities.ResourceStatePaused || *state == fabriccapacities.ResourceStateSuspended
+		}
+		d.Set("paused", paused)
+
+		if err := d.Set("sku", flattenFabricCapacitySku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceFabricCapacityUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Fabric.CapacityClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fabriccapacities.ParseCapacityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	paused := d.Get("paused").(bool)
+
+	// resume the capacity before making any other changes, so that they're applied to an active capacity
+	if d.HasChange("paused") && !paused {
+		if err := client.ResumeThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("resuming %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChanges("administration_members", "sku", "tags", "tags_all") {
+		parameters := fabriccapacities.FabricCapacityUpdate{}
+
+		if d.HasChange("administration_members") {
+			parameters.Properties = &fabriccapacities.FabricCapacityUpdateProperties{
+				Administration: &fabriccapacities.CapacityAdministration{
+					Members: *utils.ExpandStringSlice(d.Get("administration_members").(*pluginsdk.Set).List()),
+				},
+			}
+		}
+
+		if d.HasChange("sku") {
+			sku := expandFabricCapacitySku(d.Get("sku").([]interface{}))
+			parameters.Sku = &sku
+		}
+
+		if d.HasChanges("tags", "tags_all") {
+			parameters.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+		}
+
+		if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("paused") && paused {
+		if err := client.SuspendThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("pausing %s: %+v", *id, err)
+		}
+	}
+
+	return resourceFabricCapacityRead(d, meta)
+}
+
+func resourceFabricCapacityDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Fabric.CapacityClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fabriccapacities.ParseCapacityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFabricCapacitySku(input []interface{}) fabriccapacities.RpSku {
+	if len(input) == 0 || input[0] == nil {
+		return fabriccapacities.RpSku{}
+	}
+
+	v := input[0].(map[string]interface{})
+	return fabriccapacities.RpSku{
+		Name: v["name"].(string),
+		Tier: fabriccapacities.RpSkuTier(v["tier"].(string)),
+	}
+}
+
+func flattenFabricCapacitySku(input fabriccapacities.RpSku) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name": input.Name,
+			"tier": string(input.Tier),
+		},
+	}
+}
@@ -0,0 +1,5 @@
+package fabriccapacities
+
+type CapacityAdministration struct {
+	Members []string `json:"members"`
+}
@@ -0,0 +1,11 @@
+package fabriccapacities
+
+type FabricCapacity struct {
+	Id         *string                  `json:"id,omitempty"`
+	Location   string                   `json:"location"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties FabricCapacityProperties `json:"properties"`
+	Sku        RpSku                    `json:"sku"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package fabriccapacities
+
+type FabricCapacityUpdate struct {
+	Properties *FabricCapacityUpdateProperties `json:"properties,omitempty"`
+	Sku        *RpSku                          `json:"sku,omitempty"`
+	Tags       *map[string]string              `json:"tags,omitempty"`
+}
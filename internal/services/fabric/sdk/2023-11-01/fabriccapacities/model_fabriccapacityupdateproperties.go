@@ -0,0 +1,5 @@
+package fabriccapacities
+
+type FabricCapacityUpdateProperties struct {
+	Administration *CapacityAdministration `json:"administration,omitempty"`
+}
@@ -0,0 +1,6 @@
+package fabriccapacities
+
+type RpSku struct {
+	Name string    `json:"name"`
+	Tier RpSkuTier `json:"tier"`
+}
@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func CapacityName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-z][a-z0-9]{2,62}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters in length, start with a lowercase letter and contain only lowercase letters or numbers.", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCapacityName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// too short
+			input:    "ab",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// can contain numbers
+			input:    "hello123",
+			expected: true,
+		},
+		{
+			// can't start with a number
+			input:    "1hello",
+			expected: false,
+		},
+		{
+			// can't contain uppercase letters
+			input:    "Hello",
+			expected: false,
+		},
+		{
+			// can't contain a dash
+			input:    "hello-world",
+			expected: false,
+		},
+		{
+			// 63 characters
+			input:    "a" + strings.Repeat("b", 62),
+			expected: true,
+		},
+		{
+			// 64 characters
+			input:    "a" + strings.Repeat("b", 63),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := CapacityName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	logAnalytiscValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -175,8 +178,9 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 										Optional: true,
 									},
 									"id": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+$`), "the signature ID must be numeric"),
 									},
 								},
 							},
@@ -290,12 +294,14 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"key_vault_secret_id": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 						},
 						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
 				},
@@ -378,9 +384,35 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 
 			"tags": tags.SchemaEnforceLowerCaseKeys(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateFirewallPolicyPremiumSettings(d)
+		}),
 	}
 }
 
+// validateFirewallPolicyPremiumSettings ensures that the settings which are only available for Premium Firewall Policies
+// are not specified on a Standard Firewall Policy, since the API silently ignores these otherwise
+func validateFirewallPolicyPremiumSettings(d *pluginsdk.ResourceDiff) error {
+	if d.Get("sku").(string) == string(network.FirewallPolicySkuTierPremium) {
+		if tlsCertificates := d.Get("tls_certificate").([]interface{}); len(tlsCertificates) > 0 {
+			if identities := d.Get("identity").([]interface{}); len(identities) == 0 || identities[0] == nil {
+				return fmt.Errorf("an `identity` block must be specified when `tls_certificate` is set, since the Managed Identity is used to retrieve the certificate from Key Vault")
+			}
+		}
+
+		return nil
+	}
+
+	for _, key := range []string{"intrusion_detection", "tls_certificate"} {
+		if v := d.Get(key).([]interface{}); len(v) > 0 {
+			return fmt.Errorf("`%s` can only be specified when `sku` is set to `%s`", key, string(network.FirewallPolicySkuTierPremium))
+		}
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -46,6 +47,18 @@ func TestAccFirewallPolicy_basicPremium(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_intrusionDetectionRequiresPremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.intrusionDetectionStandard(data),
+			ExpectError: regexp.MustCompile("`intrusion_detection` can only be specified when `sku` is set to `Premium`"),
+		},
+	})
+}
+
 func TestAccFirewallPolicy_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
 	r := FirewallPolicyResource{}
@@ -234,6 +247,24 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) intrusionDetectionStandard(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard"
+
+  intrusion_detection {
+    mode = "Alert"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyResource) complete(data acceptance.TestData) string {
 	r := FirewallPolicyResource{}
 	template := r.template(data)
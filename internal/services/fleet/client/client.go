@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/updateruns"
+)
+
+type Client struct {
+	FleetMembersClient          *fleetmembers.FleetMembersClient
+	FleetsClient                *fleets.FleetsClient
+	FleetUpdateStrategiesClient *fleetupdatestrategies.FleetUpdateStrategiesClient
+	UpdateRunsClient            *updateruns.UpdateRunsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	fleetMembersClient := fleetmembers.NewFleetMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetMembersClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetsClient := fleets.NewFleetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetsClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetUpdateStrategiesClient := fleetupdatestrategies.NewFleetUpdateStrategiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetUpdateStrategiesClient.Client, o.ResourceManagerAuthorizer)
+
+	updateRunsClient := updateruns.NewUpdateRunsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&updateRunsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		FleetMembersClient:          &fleetMembersClient,
+		FleetsClient:                &fleetsClient,
+		FleetUpdateStrategiesClient: &fleetUpdateStrategiesClient,
+		UpdateRunsClient:            &updateRunsClient,
+	}
+}
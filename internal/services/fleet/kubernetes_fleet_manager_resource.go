@@ -0,0 +1,256 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetManagerResource{}
+var _ sdk.ResourceWithSubscriptionIdOverride = KubernetesFleetManagerResource{}
+
+type KubernetesFleetManagerResource struct{}
+
+type KubernetesFleetManagerResourceModel struct {
+	Name              string                             `tfschema:"name"`
+	ResourceGroupName string                             `tfschema:"resource_group_name"`
+	Location          string                             `tfschema:"location"`
+	HubProfile        []KubernetesFleetManagerHubProfile `tfschema:"hub_profile"`
+	Tags              map[string]string                  `tfschema:"tags"`
+}
+
+type KubernetesFleetManagerHubProfile struct {
+	DnsPrefix         string `tfschema:"dns_prefix"`
+	Fqdn              string `tfschema:"fqdn"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+}
+
+func (r KubernetesFleetManagerResource) SupportsSubscriptionIdOverride() {}
+
+func (r KubernetesFleetManagerResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_manager"
+}
+
+func (r KubernetesFleetManagerResource) ModelObject() interface{} {
+	return &KubernetesFleetManagerResourceModel{}
+}
+
+func (r KubernetesFleetManagerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleets.ValidateFleetID
+}
+
+func (r KubernetesFleetManagerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FleetName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"hub_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dns_prefix": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.FleetHubDnsPrefix(),
+					},
+
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"kubernetes_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r KubernetesFleetManagerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetManagerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetsClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config KubernetesFleetManagerResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fleets.NewFleetID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := fleets.Fleet{
+				Location: location.Normalize(config.Location),
+				Properties: &fleets.FleetProperties{
+					HubProfile: expandKubernetesFleetManagerHubProfile(config.HubProfile),
+				},
+				Tags: &config.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetManagerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetManagerResourceModel{
+				Name:              id.FleetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.HubProfile = flattenKubernetesFleetManagerHubProfile(props.HubProfile)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetManagerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesFleetManagerResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := fleets.FleetPatch{}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetManagerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetManagerHubProfile(input []KubernetesFleetManagerHubProfile) *fleets.FleetHubProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &fleets.FleetHubProfile{
+		DnsPrefix: &input[0].DnsPrefix,
+	}
+}
+
+func flattenKubernetesFleetManagerHubProfile(input *fleets.FleetHubProfile) []KubernetesFleetManagerHubProfile {
+	if input == nil {
+		return []KubernetesFleetManagerHubProfile{}
+	}
+
+	output := KubernetesFleetManagerHubProfile{}
+	if input.DnsPrefix != nil {
+		output.DnsPrefix = *input.DnsPrefix
+	}
+	if input.Fqdn != nil {
+		output.Fqdn = *input.Fqdn
+	}
+	if input.KubernetesVersion != nil {
+		output.KubernetesVersion = *input.KubernetesVersion
+	}
+
+	return []KubernetesFleetManagerHubProfile{output}
+}
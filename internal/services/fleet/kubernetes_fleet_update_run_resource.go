@@ -0,0 +1,382 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateRunResource{}
+
+type KubernetesFleetUpdateRunResource struct{}
+
+type KubernetesFleetUpdateRunResourceModel struct {
+	Name                  string                                `tfschema:"name"`
+	KubernetesFleetId     string                                `tfschema:"kubernetes_fleet_id"`
+	FleetUpdateStrategyId string                                `tfschema:"fleet_update_strategy_id"`
+	ManagedClusterUpdate  []KubernetesFleetManagedClusterUpdate `tfschema:"managed_cluster_update"`
+	Stage                 []KubernetesFleetStage                `tfschema:"stage"`
+}
+
+type KubernetesFleetManagedClusterUpdate struct {
+	Upgrade            []KubernetesFleetManagedClusterUpgrade   `tfschema:"upgrade"`
+	NodeImageSelection []KubernetesFleetManagedClusterNodeImage `tfschema:"node_image_selection"`
+}
+
+type KubernetesFleetManagedClusterUpgrade struct {
+	Type              string `tfschema:"type"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+}
+
+type KubernetesFleetManagedClusterNodeImage struct {
+	Type string `tfschema:"type"`
+}
+
+func (r KubernetesFleetUpdateRunResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_run"
+}
+
+func (r KubernetesFleetUpdateRunResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateRunResourceModel{}
+}
+
+func (r KubernetesFleetUpdateRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return updateruns.ValidateUpdateRunID
+}
+
+func (r KubernetesFleetUpdateRunResource) Arguments() map[string]*pluginsdk.Schema {
+	stages := schemaKubernetesFleetStages(false)
+	stages.ConflictsWith = []string{"fleet_update_strategy_id"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FleetName(),
+		},
+
+		"kubernetes_fleet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: updateruns.ValidateFleetID,
+		},
+
+		"managed_cluster_update": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"upgrade": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForManagedClusterUpgradeType(), false),
+								},
+
+								"kubernetes_version": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"node_image_selection": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForNodeImageSelectionType(), false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"fleet_update_strategy_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  fleetupdatestrategies.ValidateUpdateStrategyID,
+			ConflictsWith: []string{"stage"},
+		},
+
+		"stage": stages,
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.UpdateRunsClient
+
+			var config KubernetesFleetUpdateRunResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := updateruns.ParseFleetID(config.KubernetesFleetId)
+			if err != nil {
+				return err
+			}
+
+			id := updateruns.NewUpdateRunID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := updateruns.UpdateRun{
+				Properties: expandKubernetesFleetUpdateRunProperties(config),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.UpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetUpdateRunResourceModel{
+				Name:              id.UpdateRunName,
+				KubernetesFleetId: updateruns.NewFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ManagedClusterUpdate = flattenKubernetesFleetManagedClusterUpdate(props.ManagedClusterUpdate)
+
+					if props.UpdateStrategyId != nil {
+						strategyId, err := fleetupdatestrategies.ParseUpdateStrategyIDInsensitively(*props.UpdateStrategyId)
+						if err != nil {
+							return err
+						}
+						state.FleetUpdateStrategyId = strategyId.ID()
+					} else if props.Strategy != nil {
+						// the stages are copied from the Update Strategy when one is specified, so are only
+						// tracked when they've been specified directly
+						state.Stage = flattenKubernetesFleetUpdateRunStages(props.Strategy.Stages)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.UpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesFleetUpdateRunResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// an Update Run can only be updated prior to it being started, which the API enforces
+			payload := updateruns.UpdateRun{
+				Properties: expandKubernetesFleetUpdateRunProperties(config),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.UpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateRunProperties(input KubernetesFleetUpdateRunResourceModel) *updateruns.UpdateRunProperties {
+	output := &updateruns.UpdateRunProperties{
+		ManagedClusterUpdate: expandKubernetesFleetManagedClusterUpdate(input.ManagedClusterUpdate),
+	}
+
+	if input.FleetUpdateStrategyId != "" {
+		output.UpdateStrategyId = &input.FleetUpdateStrategyId
+	}
+
+	if len(input.Stage) > 0 {
+		output.Strategy = &updateruns.UpdateRunStrategy{
+			Stages: expandKubernetesFleetUpdateRunStages(input.Stage),
+		}
+	}
+
+	return output
+}
+
+func expandKubernetesFleetManagedClusterUpdate(input []KubernetesFleetManagedClusterUpdate) updateruns.ManagedClusterUpdate {
+	output := updateruns.ManagedClusterUpdate{}
+	if len(input) == 0 {
+		return output
+	}
+
+	if upgrade := input[0].Upgrade; len(upgrade) > 0 {
+		output.Upgrade = updateruns.ManagedClusterUpgradeSpec{
+			Type: updateruns.ManagedClusterUpgradeType(upgrade[0].Type),
+		}
+		if upgrade[0].KubernetesVersion != "" {
+			output.Upgrade.KubernetesVersion = &upgrade[0].KubernetesVersion
+		}
+	}
+
+	if nodeImageSelection := input[0].NodeImageSelection; len(nodeImageSelection) > 0 {
+		output.NodeImageSelection = &updateruns.NodeImageSelection{
+			Type: updateruns.NodeImageSelectionType(nodeImageSelection[0].Type),
+		}
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetManagedClusterUpdate(input updateruns.ManagedClusterUpdate) []KubernetesFleetManagedClusterUpdate {
+	upgrade := KubernetesFleetManagedClusterUpgrade{
+		Type: string(input.Upgrade.Type),
+	}
+	if input.Upgrade.KubernetesVersion != nil {
+		upgrade.KubernetesVersion = *input.Upgrade.KubernetesVersion
+	}
+
+	nodeImageSelection := make([]KubernetesFleetManagedClusterNodeImage, 0)
+	if input.NodeImageSelection != nil {
+		nodeImageSelection = append(nodeImageSelection, KubernetesFleetManagedClusterNodeImage{
+			Type: string(input.NodeImageSelection.Type),
+		})
+	}
+
+	return []KubernetesFleetManagedClusterUpdate{
+		{
+			Upgrade:            []KubernetesFleetManagedClusterUpgrade{upgrade},
+			NodeImageSelection: nodeImageSelection,
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateRunStages(input []KubernetesFleetStage) []updateruns.UpdateStage {
+	output := make([]updateruns.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]updateruns.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, updateruns.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		afterStageWait := int64(stage.AfterStageWaitInSeconds)
+		output = append(output, updateruns.UpdateStage{
+			AfterStageWaitInSeconds: &afterStageWait,
+			Groups:                  &groups,
+			Name:                    stage.Name,
+		})
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateRunStages(input []updateruns.UpdateStage) []KubernetesFleetStage {
+	output := make([]KubernetesFleetStage, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetStageGroup, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetStageGroup{
+					Name: group.Name,
+				})
+			}
+		}
+
+		afterStageWait := 0
+		if stage.AfterStageWaitInSeconds != nil {
+			afterStageWait = int(*stage.AfterStageWaitInSeconds)
+		}
+
+		output = append(output, KubernetesFleetStage{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: afterStageWait,
+		})
+	}
+
+	return output
+}
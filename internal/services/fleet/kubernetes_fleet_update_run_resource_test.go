@@ -0,0 +1,172 @@
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetUpdateRunResource struct{}
+
+func TestAccKubernetesFleetUpdateRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFleetUpdateRun_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesFleetUpdateRun_updateStrategy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.updateStrategy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KubernetesFleetUpdateRunResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := updateruns.ParseUpdateRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Fleet.UpdateRunsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesFleetUpdateRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_run" "test" {
+  name                = "acctestkfur-%d"
+  kubernetes_fleet_id = azurerm_kubernetes_fleet_manager.test.id
+
+  managed_cluster_update {
+    upgrade {
+      type = "NodeImageOnly"
+    }
+
+    node_image_selection {
+      type = "Latest"
+    }
+  }
+
+  stage {
+    name = "stage-1"
+
+    group {
+      name = "canary"
+    }
+  }
+
+  depends_on = [azurerm_kubernetes_fleet_member.test]
+}
+`, KubernetesFleetMemberResource{}.group(data, "canary"), data.RandomInteger)
+}
+
+func (r KubernetesFleetUpdateRunResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_run" "import" {
+  name                = azurerm_kubernetes_fleet_update_run.test.name
+  kubernetes_fleet_id = azurerm_kubernetes_fleet_update_run.test.kubernetes_fleet_id
+
+  managed_cluster_update {
+    upgrade {
+      type = "NodeImageOnly"
+    }
+
+    node_image_selection {
+      type = "Latest"
+    }
+  }
+
+  stage {
+    name = "stage-1"
+
+    group {
+      name = "canary"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r KubernetesFleetUpdateRunResource) updateStrategy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_strategy" "test" {
+  name                = "acctestkfus-%d"
+  kubernetes_fleet_id = azurerm_kubernetes_fleet_manager.test.id
+
+  stage {
+    name = "stage-1"
+
+    group {
+      name = "canary"
+    }
+  }
+}
+
+resource "azurerm_kubernetes_fleet_update_run" "test" {
+  name                     = "acctestkfur-%d"
+  kubernetes_fleet_id      = azurerm_kubernetes_fleet_manager.test.id
+  fleet_update_strategy_id = azurerm_kubernetes_fleet_update_strategy.test.id
+
+  managed_cluster_update {
+    upgrade {
+      type = "NodeImageOnly"
+    }
+  }
+
+  depends_on = [azurerm_kubernetes_fleet_member.test]
+}
+`, KubernetesFleetMemberResource{}.group(data, "canary"), data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,297 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fleet/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateStrategyResource{}
+
+type KubernetesFleetUpdateStrategyResource struct{}
+
+type KubernetesFleetUpdateStrategyResourceModel struct {
+	Name              string                 `tfschema:"name"`
+	KubernetesFleetId string                 `tfschema:"kubernetes_fleet_id"`
+	Stage             []KubernetesFleetStage `tfschema:"stage"`
+}
+
+type KubernetesFleetStage struct {
+	Name                    string                      `tfschema:"name"`
+	Group                   []KubernetesFleetStageGroup `tfschema:"group"`
+	AfterStageWaitInSeconds int                         `tfschema:"after_stage_wait_in_seconds"`
+}
+
+type KubernetesFleetStageGroup struct {
+	Name string `tfschema:"name"`
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_strategy"
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateStrategyResourceModel{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleetupdatestrategies.ValidateUpdateStrategyID
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FleetName(),
+		},
+
+		"kubernetes_fleet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleetupdatestrategies.ValidateFleetID,
+		},
+
+		"stage": schemaKubernetesFleetStages(true),
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetUpdateStrategiesClient
+
+			var config KubernetesFleetUpdateStrategyResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleetupdatestrategies.ParseFleetID(config.KubernetesFleetId)
+			if err != nil {
+				return err
+			}
+
+			id := fleetupdatestrategies.NewUpdateStrategyID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := fleetupdatestrategies.FleetUpdateStrategy{
+				Properties: &fleetupdatestrategies.FleetUpdateStrategyProperties{
+					Strategy: fleetupdatestrategies.UpdateRunStrategy{
+						Stages: expandKubernetesFleetUpdateStrategyStages(config.Stage),
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetUpdateStrategyResourceModel{
+				Name:              id.UpdateStrategyName,
+				KubernetesFleetId: fleetupdatestrategies.NewFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Stage = flattenKubernetesFleetUpdateStrategyStages(props.Strategy.Stages)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesFleetUpdateStrategyResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("stage") {
+				payload.Properties.Strategy.Stages = expandKubernetesFleetUpdateStrategyStages(config.Stage)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fleet.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// schemaKubernetesFleetStages returns the schema for the `stage` blocks, which are shared between
+// Update Strategies (where they're required) and Update Runs (where they can be omitted in favour of a Strategy)
+func schemaKubernetesFleetStages(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.FleetName(),
+				},
+
+				"group": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.FleetName(),
+							},
+						},
+					},
+				},
+
+				"after_stage_wait_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+			},
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateStrategyStages(input []KubernetesFleetStage) []fleetupdatestrategies.UpdateStage {
+	output := make([]fleetupdatestrategies.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]fleetupdatestrategies.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, fleetupdatestrategies.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		afterStageWait := int64(stage.AfterStageWaitInSeconds)
+		output = append(output, fleetupdatestrategies.UpdateStage{
+			AfterStageWaitInSeconds: &afterStageWait,
+			Groups:                  &groups,
+			Name:                    stage.Name,
+		})
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateStrategyStages(input []fleetupdatestrategies.UpdateStage) []KubernetesFleetStage {
+	output := make([]KubernetesFleetStage, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetStageGroup, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetStageGroup{
+					Name: group.Name,
+				})
+			}
+		}
+
+		afterStageWait := 0
+		if stage.AfterStageWaitInSeconds != nil {
+			afterStageWait = int(*stage.AfterStageWaitInSeconds)
+		}
+
+		output = append(output, KubernetesFleetStage{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: afterStageWait,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,32 @@
+package fleet
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Fleet Manager"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		KubernetesFleetManagerResource{},
+		KubernetesFleetMemberResource{},
+		KubernetesFleetUpdateRunResource{},
+		KubernetesFleetUpdateStrategyResource{},
+	}
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Container",
+	}
+}
@@ -0,0 +1,10 @@
+package fleetmembers
+
+type FleetMember struct {
+	ETag       *string                `json:"eTag,omitempty"`
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *FleetMemberProperties `json:"properties,omitempty"`
+	SystemData *SystemData            `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
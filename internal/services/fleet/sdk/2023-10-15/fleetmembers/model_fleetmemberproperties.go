@@ -0,0 +1,7 @@
+package fleetmembers
+
+type FleetMemberProperties struct {
+	ClusterResourceId string                        `json:"clusterResourceId"`
+	Group             *string                       `json:"group,omitempty"`
+	ProvisioningState *FleetMemberProvisioningState `json:"provisioningState,omitempty"`
+}
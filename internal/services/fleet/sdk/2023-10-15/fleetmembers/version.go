@@ -0,0 +1,9 @@
+package fleetmembers
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/fleetmembers/%s", defaultApiVersion)
+}
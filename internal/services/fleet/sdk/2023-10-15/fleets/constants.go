@@ -0,0 +1,77 @@
+package fleets
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type FleetProvisioningState string
+
+const (
+	FleetProvisioningStateCanceled  FleetProvisioningState = "Canceled"
+	FleetProvisioningStateCreating  FleetProvisioningState = "Creating"
+	FleetProvisioningStateDeleting  FleetProvisioningState = "Deleting"
+	FleetProvisioningStateFailed    FleetProvisioningState = "Failed"
+	FleetProvisioningStateSucceeded FleetProvisioningState = "Succeeded"
+	FleetProvisioningStateUpdating  FleetProvisioningState = "Updating"
+)
+
+func PossibleValuesForFleetProvisioningState() []string {
+	return []string{
+		string(FleetProvisioningStateCanceled),
+		string(FleetProvisioningStateCreating),
+		string(FleetProvisioningStateDeleting),
+		string(FleetProvisioningStateFailed),
+		string(FleetProvisioningStateSucceeded),
+		string(FleetProvisioningStateUpdating),
+	}
+}
+
+func parseFleetProvisioningState(input string) (*FleetProvisioningState, error) {
+	vals := map[string]FleetProvisioningState{
+		"canceled":  FleetProvisioningStateCanceled,
+		"creating":  FleetProvisioningStateCreating,
+		"deleting":  FleetProvisioningStateDeleting,
+		"failed":    FleetProvisioningStateFailed,
+		"succeeded": FleetProvisioningStateSucceeded,
+		"updating":  FleetProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FleetProvisioningState(input)
+	return &out, nil
+}
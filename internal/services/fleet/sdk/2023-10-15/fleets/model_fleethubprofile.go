@@ -0,0 +1,8 @@
+package fleets
+
+type FleetHubProfile struct {
+	DnsPrefix         *string `json:"dnsPrefix,omitempty"`
+	Fqdn              *string `json:"fqdn,omitempty"`
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+	PortalFqdn        *string `json:"portalFqdn,omitempty"`
+}
@@ -0,0 +1,9 @@
+package fleetupdatestrategies
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/fleetupdatestrategies/%s", defaultApiVersion)
+}
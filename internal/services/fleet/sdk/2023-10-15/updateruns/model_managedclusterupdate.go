@@ -0,0 +1,6 @@
+package updateruns
+
+type ManagedClusterUpdate struct {
+	NodeImageSelection *NodeImageSelection       `json:"nodeImageSelection,omitempty"`
+	Upgrade            ManagedClusterUpgradeSpec `json:"upgrade"`
+}
@@ -0,0 +1,10 @@
+package updateruns
+
+type UpdateRun struct {
+	ETag       *string              `json:"eTag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *UpdateRunProperties `json:"properties,omitempty"`
+	SystemData *SystemData          `json:"systemData,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
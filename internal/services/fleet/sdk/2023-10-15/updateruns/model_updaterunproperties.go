@@ -0,0 +1,9 @@
+package updateruns
+
+type UpdateRunProperties struct {
+	ManagedClusterUpdate ManagedClusterUpdate        `json:"managedClusterUpdate"`
+	ProvisioningState    *UpdateRunProvisioningState `json:"provisioningState,omitempty"`
+	Status               *UpdateRunStatus            `json:"status,omitempty"`
+	Strategy             *UpdateRunStrategy          `json:"strategy,omitempty"`
+	UpdateStrategyId     *string                     `json:"updateStrategyId,omitempty"`
+}
@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var fleetNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+const fleetNameMessage = "The name must begin and end with a lowercase letter or number, and may contain only lowercase letters, numbers and hyphens."
+
+// FleetName validates the name of a Kubernetes Fleet Manager, as well as the names of its Members,
+// Update Strategies, Update Runs and the Groups which Members belong to, which share the same rules
+func FleetName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, 63),
+		validation.StringMatch(fleetNameRegex, fleetNameMessage),
+	)
+}
+
+func FleetMemberName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, 50),
+		validation.StringMatch(fleetNameRegex, fleetNameMessage),
+	)
+}
+
+func FleetHubDnsPrefix() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, 54),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`),
+			"The DNS Prefix must begin and end with a letter or number, and may contain only letters, numbers and hyphens.",
+		),
+	)
+}
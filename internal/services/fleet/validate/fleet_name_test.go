@@ -0,0 +1,148 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFleetName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "a",
+			Expected: true,
+		},
+		{
+			Name:     "fleet-1",
+			Expected: true,
+		},
+		{
+			Name:     "Fleet1",
+			Expected: false,
+		},
+		{
+			Name:     "-fleet1",
+			Expected: false,
+		},
+		{
+			Name:     "fleet1-",
+			Expected: false,
+		},
+		{
+			Name:     "fleet_1",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 63),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 64),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := FleetName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestFleetMemberName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "member-1",
+			Expected: true,
+		},
+		{
+			Name:     "Member1",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 50),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 51),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := FleetMemberName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestFleetHubDnsPrefix(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "Fleet-Hub1",
+			Expected: true,
+		},
+		{
+			Name:     "-hub",
+			Expected: false,
+		},
+		{
+			Name:     "hub.1",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 54),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 55),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := FleetHubDnsPrefix()(v.Name, "dns_prefix")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
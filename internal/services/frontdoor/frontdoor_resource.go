@@ -710,7 +710,7 @@ func resourceFrontDoorUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		existingModel.Properties.EnabledState = &enabledState
 	}
 
-	if d.HasChanges("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		existingModel.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
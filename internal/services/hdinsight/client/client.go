@@ -3,13 +3,17 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/hdinsight/mgmt/2018-06-01/hdinsight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusterpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
 )
 
 type Client struct {
-	ApplicationsClient   *hdinsight.ApplicationsClient
-	ClustersClient       *hdinsight.ClustersClient
-	ConfigurationsClient *hdinsight.ConfigurationsClient
-	ExtensionsClient     *hdinsight.ExtensionsClient
+	ApplicationsClient        *hdinsight.ApplicationsClient
+	ClusterPoolsClient        *clusterpools.ClusterPoolsClient
+	ClusterPoolClustersClient *clusters.ClustersClient
+	ClustersClient            *hdinsight.ClustersClient
+	ConfigurationsClient      *hdinsight.ConfigurationsClient
+	ExtensionsClient          *hdinsight.ExtensionsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -29,10 +33,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	ExtensionsClient := hdinsight.NewExtensionsClientWithBaseURI(opts.ResourceManagerEndpoint, opts.SubscriptionId)
 	opts.ConfigureClient(&ExtensionsClient.Client, opts.ResourceManagerAuthorizer)
 
+	ClusterPoolsClient := clusterpools.NewClusterPoolsClientWithBaseURI(opts.ResourceManagerEndpoint)
+	opts.ConfigureClient(&ClusterPoolsClient.Client, opts.ResourceManagerAuthorizer)
+
+	ClusterPoolClustersClient := clusters.NewClustersClientWithBaseURI(opts.ResourceManagerEndpoint)
+	opts.ConfigureClient(&ClusterPoolClustersClient.Client, opts.ResourceManagerAuthorizer)
+
 	return &Client{
-		ApplicationsClient:   &ApplicationsClient,
-		ClustersClient:       &ClustersClient,
-		ConfigurationsClient: &ConfigurationsClient,
-		ExtensionsClient:     &ExtensionsClient,
+		ApplicationsClient:        &ApplicationsClient,
+		ClusterPoolsClient:        &ClusterPoolsClient,
+		ClusterPoolClustersClient: &ClusterPoolClustersClient,
+		ClustersClient:            &ClustersClient,
+		ConfigurationsClient:      &ConfigurationsClient,
+		ExtensionsClient:          &ExtensionsClient,
 	}
 }
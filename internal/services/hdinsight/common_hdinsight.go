@@ -30,7 +30,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 		resourceGroup := id.ResourceGroup
 		name := id.Name
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			t := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
 				Tags: tags.Expand(t),
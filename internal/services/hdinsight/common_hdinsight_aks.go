@@ -0,0 +1,816 @@
+package hdinsight
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	hdInsightAksNodeTypeHead   = "Head"
+	hdInsightAksNodeTypeWorker = "Worker"
+)
+
+// hdInsightAksClusterSchema returns the arguments shared by all HDInsight on AKS cluster types,
+// merged with the arguments specific to the cluster type
+func hdInsightAksClusterSchema(clusterTypeSchema map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cluster_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: clusters.ValidateClusterPoolID,
+		},
+
+		"location": azure.SchemaLocation(),
+
+		"cluster_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"oss_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authorized_user_ids": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: []string{"authorized_user_ids", "authorized_group_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"authorized_group_ids": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: []string{"authorized_user_ids", "authorized_group_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"managed_identity": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: msiValidate.UserAssignedIdentityID,
+					},
+
+					"client_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"object_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+			},
+		},
+
+		"head_node": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vm_size": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"worker_node": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vm_size": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"autoscale": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"graceful_decommission_timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"load_based": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"autoscale.0.load_based", "autoscale.0.schedule_based"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"min_nodes": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"max_nodes": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"poll_interval_in_seconds": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"cooldown_period_in_seconds": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"scaling_rule": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"action_type": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													string(clusters.ScaleActionTypeScaledown),
+													string(clusters.ScaleActionTypeScaleup),
+												}, false),
+											},
+
+											"evaluation_count": {
+												Type:         pluginsdk.TypeInt,
+												Required:     true,
+												ValidateFunc: validation.IntAtLeast(1),
+											},
+
+											"scaling_metric": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"operator": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													string(clusters.ComparisonOperatorGreaterThan),
+													string(clusters.ComparisonOperatorGreaterThanOrEqual),
+													string(clusters.ComparisonOperatorLessThan),
+													string(clusters.ComparisonOperatorLessThanOrEqual),
+												}, false),
+											},
+
+											"threshold": {
+												Type:     pluginsdk.TypeFloat,
+												Required: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"schedule_based": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"autoscale.0.load_based", "autoscale.0.schedule_based"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"timezone": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"default_count": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"schedule": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"days": {
+												Type:     pluginsdk.TypeSet,
+												Required: true,
+												Elem: &pluginsdk.Schema{
+													Type: pluginsdk.TypeString,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(clusters.ScheduleDayMonday),
+														string(clusters.ScheduleDayTuesday),
+														string(clusters.ScheduleDayWednesday),
+														string(clusters.ScheduleDayThursday),
+														string(clusters.ScheduleDayFriday),
+														string(clusters.ScheduleDaySaturday),
+														string(clusters.ScheduleDaySunday),
+													}, false),
+												},
+											},
+
+											"start_time": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"end_time": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"count": {
+												Type:         pluginsdk.TypeInt,
+												Required:     true,
+												ValidateFunc: validation.IntAtLeast(1),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+
+	for k, v := range clusterTypeSchema {
+		s[k] = v
+	}
+
+	return s
+}
+
+func hdinsightAksClusterCreate(clusterType string, resourceName string, expandClusterTypeProfile func(d *pluginsdk.ResourceData, profile *clusters.ClusterProfile), readFunc pluginsdk.ReadFunc) pluginsdk.CreateFunc {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).HDInsight.ClusterPoolClustersClient
+		ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		clusterPoolId, err := clusters.ParseClusterPoolID(d.Get("cluster_pool_id").(string))
+		if err != nil {
+			return err
+		}
+
+		id := clusters.NewClusterID(clusterPoolId.SubscriptionId, clusterPoolId.ResourceGroupName, clusterPoolId.ClusterPoolName, d.Get("name").(string))
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError(resourceName, id.ID())
+		}
+
+		profile := clusters.ClusterProfile{
+			AuthorizationProfile: expandHDInsightAksAuthorizationProfile(d),
+			AutoscaleProfile:     expandHDInsightAksAutoscaleProfile(d.Get("autoscale").([]interface{})),
+			ClusterVersion:       d.Get("cluster_version").(string),
+			IdentityProfile:      expandHDInsightAksIdentityProfile(d.Get("managed_identity").([]interface{})),
+			OssVersion:           d.Get("oss_version").(string),
+		}
+		expandClusterTypeProfile(d, &profile)
+
+		parameters := clusters.Cluster{
+			Location: azure.NormalizeLocation(d.Get("location").(string)),
+			Properties: &clusters.ClusterResourceProperties{
+				ClusterProfile: profile,
+				ClusterType:    clusterType,
+				ComputeProfile: clusters.ComputeProfile{
+					Nodes: expandHDInsightAksNodes(d),
+				},
+			},
+			Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		}
+
+		if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+
+		d.SetId(id.ID())
+
+		return readFunc(d, meta)
+	}
+}
+
+func hdinsightAksClusterRead(flattenClusterTypeProfile func(d *pluginsdk.ResourceData, profile clusters.ClusterProfile) error) pluginsdk.ReadFunc {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).HDInsight.ClusterPoolClustersClient
+		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := clusters.ParseClusterID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				log.Printf("[DEBUG] %s was not found - removing from state", *id)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		d.Set("name", id.ClusterName)
+		d.Set("cluster_pool_id", clusters.NewClusterPoolID(id.SubscriptionId, id.ResourceGroupName, id.ClusterPoolName).ID())
+
+		if model := resp.Model; model != nil {
+			d.Set("location", location.Normalize(model.Location))
+
+			if props := model.Properties; props != nil {
+				profile := props.ClusterProfile
+				d.Set("cluster_version", profile.ClusterVersion)
+				d.Set("oss_version", profile.OssVersion)
+				d.Set("authorized_user_ids", utils.FlattenStringSlice(profile.AuthorizationProfile.UserIds))
+				d.Set("authorized_group_ids", utils.FlattenStringSlice(profile.AuthorizationProfile.GroupIds))
+
+				if err := d.Set("managed_identity", flattenHDInsightAksIdentityProfile(profile.IdentityProfile)); err != nil {
+					return fmt.Errorf("setting `managed_identity`: %+v", err)
+				}
+
+				autoscale := flattenHDInsightAksAutoscaleProfile(profile.AutoscaleProfile)
+				if err := d.Set("autoscale", autoscale); err != nil {
+					return fmt.Errorf("setting `autoscale`: %+v", err)
+				}
+
+				// when autoscale is enabled the number of worker nodes is managed by the service,
+				// so the configured count is only used as the initial size of the cluster
+				if err := flattenHDInsightAksNodes(d, props.ComputeProfile.Nodes, len(autoscale) > 0); err != nil {
+					return err
+				}
+
+				if err := flattenClusterTypeProfile(d, profile); err != nil {
+					return err
+				}
+			}
+
+			if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func hdinsightAksClusterUpdate(readFunc pluginsdk.ReadFunc) pluginsdk.UpdateFunc {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).HDInsight.ClusterPoolClustersClient
+		ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := clusters.ParseClusterID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		if d.HasChanges("authorized_user_ids", "authorized_group_ids", "autoscale", "tags", "tags_all") {
+			authorizationProfile := expandHDInsightAksAuthorizationProfile(d)
+			parameters := clusters.ClusterPatch{
+				Properties: &clusters.ClusterPatchProperties{
+					ClusterProfile: &clusters.UpdatableClusterProfile{
+						AuthorizationProfile: &authorizationProfile,
+						AutoscaleProfile:     expandHDInsightAksAutoscaleProfile(d.Get("autoscale").([]interface{})),
+					},
+				},
+				Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+		}
+
+		if d.HasChange("worker_node.0.count") && len(d.Get("autoscale").([]interface{})) == 0 {
+			parameters := clusters.ClusterResizeData{
+				Location: azure.NormalizeLocation(d.Get("location").(string)),
+				Properties: &clusters.ClusterResizeProperties{
+					TargetWorkerNodeCount: int64(d.Get("worker_node.0.count").(int)),
+				},
+			}
+
+			if err := client.ResizeThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("resizing %s: %+v", *id, err)
+			}
+		}
+
+		return readFunc(d, meta)
+	}
+}
+
+func hdinsightAksClusterDelete() pluginsdk.DeleteFunc {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).HDInsight.ClusterPoolClustersClient
+		ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := clusters.ParseClusterID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		if err := client.DeleteThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+
+		return nil
+	}
+}
+
+func expandHDInsightAksAuthorizationProfile(d *pluginsdk.ResourceData) clusters.AuthorizationProfile {
+	result := clusters.AuthorizationProfile{}
+
+	if v := d.Get("authorized_user_ids").(*pluginsdk.Set).List(); len(v) > 0 {
+		result.UserIds = utils.ExpandStringSlice(v)
+	}
+
+	if v := d.Get("authorized_group_ids").(*pluginsdk.Set).List(); len(v) > 0 {
+		result.GroupIds = utils.ExpandStringSlice(v)
+	}
+
+	return result
+}
+
+func expandHDInsightAksIdentityProfile(input []interface{}) *clusters.IdentityProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &clusters.IdentityProfile{
+		MsiClientId:   v["client_id"].(string),
+		MsiObjectId:   v["object_id"].(string),
+		MsiResourceId: v["id"].(string),
+	}
+}
+
+func flattenHDInsightAksIdentityProfile(input *clusters.IdentityProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":        input.MsiResourceId,
+			"client_id": input.MsiClientId,
+			"object_id": input.MsiObjectId,
+		},
+	}
+}
+
+func expandHDInsightAksNodes(d *pluginsdk.ResourceData) []clusters.NodeProfile {
+	return []clusters.NodeProfile{
+		{
+			Type:   hdInsightAksNodeTypeHead,
+			VMSize: d.Get("head_node.0.vm_size").(string),
+			Count:  int64(d.Get("head_node.0.count").(int)),
+		},
+		{
+			Type:   hdInsightAksNodeTypeWorker,
+			VMSize: d.Get("worker_node.0.vm_size").(string),
+			Count:  int64(d.Get("worker_node.0.count").(int)),
+		},
+	}
+}
+
+func flattenHDInsightAksNodes(d *pluginsdk.ResourceData, input []clusters.NodeProfile, autoscaleEnabled bool) error {
+	for _, node := range input {
+		count := int(node.Count)
+
+		switch node.Type {
+		case hdInsightAksNodeTypeHead:
+			if err := d.Set("head_node", []interface{}{
+				map[string]interface{}{
+					"vm_size": node.VMSize,
+					"count":   count,
+				},
+			}); err != nil {
+				return fmt.Errorf("setting `head_node`: %+v", err)
+			}
+
+		case hdInsightAksNodeTypeWorker:
+			if autoscaleEnabled {
+				if v, ok := d.GetOk("worker_node.0.count"); ok {
+					count = v.(int)
+				}
+			}
+
+			if err := d.Set("worker_node", []interface{}{
+				map[string]interface{}{
+					"vm_size": node.VMSize,
+					"count":   count,
+				},
+			}); err != nil {
+				return fmt.Errorf("setting `worker_node`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandHDInsightAksAutoscaleProfile(input []interface{}) *clusters.AutoscaleProfile {
+	if len(input) == 0 || input[0] == nil {
+		return &clusters.AutoscaleProfile{
+			Enabled: false,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	result := clusters.AutoscaleProfile{
+		Enabled: true,
+	}
+
+	if timeout := v["graceful_decommission_timeout_in_seconds"].(int); timeout > 0 {
+		result.GracefulDecommissionTimeout = utils.Int64(int64(timeout))
+	}
+
+	if loadBased := v["load_based"].([]interface{}); len(loadBased) > 0 && loadBased[0] != nil {
+		autoscaleType := clusters.AutoscaleTypeLoadBased
+		result.AutoscaleType = &autoscaleType
+
+		config := loadBased[0].(map[string]interface{})
+		loadBasedConfig := clusters.LoadBasedConfig{
+			MinNodes:     int64(config["min_nodes"].(int)),
+			MaxNodes:     int64(config["max_nodes"].(int)),
+			ScalingRules: make([]clusters.ScalingRule, 0),
+		}
+
+		if pollInterval := config["poll_interval_in_seconds"].(int); pollInterval > 0 {
+			loadBasedConfig.PollInterval = utils.Int64(int64(pollInterval))
+		}
+
+		if cooldownPeriod := config["cooldown_period_in_seconds"].(int); cooldownPeriod > 0 {
+			loadBasedConfig.CooldownPeriod = utils.Int64(int64(cooldownPeriod))
+		}
+
+		for _, item := range config["scaling_rule"].([]interface{}) {
+			if item == nil {
+				continue
+			}
+			rule := item.(map[string]interface{})
+			loadBasedConfig.ScalingRules = append(loadBasedConfig.ScalingRules, clusters.ScalingRule{
+				ActionType:      clusters.ScaleActionType(rule["action_type"].(string)),
+				EvaluationCount: int64(rule["evaluation_count"].(int)),
+				ScalingMetric:   rule["scaling_metric"].(string),
+				ComparisonRule: clusters.ComparisonRule{
+					Operator:  clusters.ComparisonOperator(rule["operator"].(string)),
+					Threshold: rule["threshold"].(float64),
+				},
+			})
+		}
+
+		result.LoadBasedConfig = &loadBasedConfig
+	}
+
+	if scheduleBased := v["schedule_based"].([]interface{}); len(scheduleBased) > 0 && scheduleBased[0] != nil {
+		autoscaleType := clusters.AutoscaleTypeScheduleBased
+		result.AutoscaleType = &autoscaleType
+
+		config := scheduleBased[0].(map[string]interface{})
+		scheduleBasedConfig := clusters.ScheduleBasedConfig{
+			TimeZone:     config["timezone"].(string),
+			DefaultCount: int64(config["default_count"].(int)),
+			Schedules:    make([]clusters.Schedule, 0),
+		}
+
+		for _, item := range config["schedule"].([]interface{}) {
+			if item == nil {
+				continue
+			}
+			schedule := item.(map[string]interface{})
+
+			days := make([]clusters.ScheduleDay, 0)
+			for _, day := range schedule["days"].(*pluginsdk.Set).List() {
+				days = append(days, clusters.ScheduleDay(day.(string)))
+			}
+
+			scheduleBasedConfig.Schedules = append(scheduleBasedConfig.Schedules, clusters.Schedule{
+				Count:     int64(schedule["count"].(int)),
+				Days:      days,
+				EndTime:   schedule["end_time"].(string),
+				StartTime: schedule["start_time"].(string),
+			})
+		}
+
+		result.ScheduleBasedConfig = &scheduleBasedConfig
+	}
+
+	return &result
+}
+
+func flattenHDInsightAksAutoscaleProfile(input *clusters.AutoscaleProfile) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	gracefulDecommissionTimeout := 0
+	if input.GracefulDecommissionTimeout != nil {
+		gracefulDecommissionTimeout = int(*input.GracefulDecommissionTimeout)
+	}
+
+	loadBased := make([]interface{}, 0)
+	if config := input.LoadBasedConfig; config != nil {
+		pollInterval := 0
+		if config.PollInterval != nil {
+			pollInterval = int(*config.PollInterval)
+		}
+
+		cooldownPeriod := 0
+		if config.CooldownPeriod != nil {
+			cooldownPeriod = int(*config.CooldownPeriod)
+		}
+
+		scalingRules := make([]interface{}, 0)
+		for _, rule := range config.ScalingRules {
+			scalingRules = append(scalingRules, map[string]interface{}{
+				"action_type":      string(rule.ActionType),
+				"evaluation_count": int(rule.EvaluationCount),
+				"scaling_metric":   rule.ScalingMetric,
+				"operator":         string(rule.ComparisonRule.Operator),
+				"threshold":        rule.ComparisonRule.Threshold,
+			})
+		}
+
+		loadBased = append(loadBased, map[string]interface{}{
+			"min_nodes":                  int(config.MinNodes),
+			"max_nodes":                  int(config.MaxNodes),
+			"poll_interval_in_seconds":   pollInterval,
+			"cooldown_period_in_seconds": cooldownPeriod,
+			"scaling_rule":               scalingRules,
+		})
+	}
+
+	scheduleBased := make([]interface{}, 0)
+	if config := input.ScheduleBasedConfig; config != nil {
+		schedules := make([]interface{}, 0)
+		for _, schedule := range config.Schedules {
+			days := make([]interface{}, 0)
+			for _, day := range schedule.Days {
+				days = append(days, string(day))
+			}
+
+			schedules = append(schedules, map[string]interface{}{
+				"days":       days,
+				"start_time": schedule.StartTime,
+				"end_time":   schedule.EndTime,
+				"count":      int(schedule.Count),
+			})
+		}
+
+		scheduleBased = append(scheduleBased, map[string]interface{}{
+			"timezone":      config.TimeZone,
+			"default_count": int(config.DefaultCount),
+			"schedule":      schedules,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"graceful_decommission_timeout_in_seconds": gracefulDecommissionTimeout,
+			"load_based":     loadBased,
+			"schedule_based": scheduleBased,
+		},
+	}
+}
+
+func expandHDInsightAksComputeResource(input []interface{}) *clusters.ComputeResourceDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &clusters.ComputeResourceDefinition{
+		Cpu:    v["cpu"].(float64),
+		Memory: int64(v["memory_in_mb"].(int)),
+	}
+}
+
+func flattenHDInsightAksComputeResource(input *clusters.ComputeResourceDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cpu":          input.Cpu,
+			"memory_in_mb": int(input.Memory),
+		},
+	}
+}
+
+func schemaHDInsightAksComputeResource(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cpu": {
+					Type:         pluginsdk.TypeFloat,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.FloatAtLeast(0.1),
+				},
+
+				"memory_in_mb": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,253 @@
+package hdinsight
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusterpools"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceHDInsightAksClusterPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceHDInsightAksClusterPoolCreate,
+		Read:   resourceHDInsightAksClusterPoolRead,
+		Update: resourceHDInsightAksClusterPoolUpdate,
+		Delete: resourceHDInsightAksClusterPoolDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := clusterpools.ParseClusterPoolID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"cluster_pool_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_machine_size": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"managed_resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"aks_managed_resource_group_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceHDInsightAksClusterPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HDInsight.ClusterPoolsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := clusterpools.NewClusterPoolID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_hdinsight_aks_cluster_pool", id.ID())
+	}
+
+	props := clusterpools.ClusterPoolResourceProperties{
+		ClusterPoolProfile: &clusterpools.ClusterPoolProfile{
+			ClusterPoolVersion: d.Get("cluster_pool_version").(string),
+		},
+		ComputeProfile: clusterpools.ClusterPoolComputeProfile{
+			VMSize: d.Get("virtual_machine_size").(string),
+		},
+	}
+
+	if v := d.Get("managed_resource_group_name").(string); v != "" {
+		props.ManagedResourceGroupName = utils.String(v)
+	}
+
+	if v := d.Get("subnet_id").(string); v != "" {
+		props.NetworkProfile = &clusterpools.ClusterPoolNetworkProfile{
+			SubnetId: v,
+		}
+	}
+
+	if v := d.Get("log_analytics_workspace_id").(string); v != "" {
+		props.LogAnalyticsProfile = &clusterpools.ClusterPoolLogAnalyticsProfile{
+			Enabled:     true,
+			WorkspaceId: utils.String(v),
+		}
+	}
+
+	parameters := clusterpools.ClusterPool{
+		Location:   azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &props,
+		Tags:       tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceHDInsightAksClusterPoolRead(d, meta)
+}
+
+func resourceHDInsightAksClusterPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HDInsight.ClusterPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := clusterpools.ParseClusterPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ClusterPoolName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			clusterPoolVersion := ""
+			if props.ClusterPoolProfile != nil {
+				clusterPoolVersion = props.ClusterPoolProfile.ClusterPoolVersion
+			}
+			d.Set("cluster_pool_version", clusterPoolVersion)
+			d.Set("virtual_machine_size", props.ComputeProfile.VMSize)
+			d.Set("managed_resource_group_name", props.ManagedResourceGroupName)
+			d.Set("aks_managed_resource_group_name", props.AksManagedResourceGroupName)
+
+			subnetId := ""
+			if props.NetworkProfile != nil {
+				subnetId = props.NetworkProfile.SubnetId
+			}
+			d.Set("subnet_id", subnetId)
+
+			logAnalyticsWorkspaceId := ""
+			if profile := props.LogAnalyticsProfile; profile != nil && profile.Enabled && profile.WorkspaceId != nil {
+				logAnalyticsWorkspaceId = *profile.WorkspaceId
+			}
+			d.Set("log_analytics_workspace_id", logAnalyticsWorkspaceId)
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceHDInsightAksClusterPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HDInsight.ClusterPoolsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := clusterpools.ParseClusterPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		parameters := clusterpools.TagsObject{
+			Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if err := client.UpdateTagsThenPoll(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceHDInsightAksClusterPoolRead(d, meta)
+}
+
+func resourceHDInsightAksClusterPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HDInsight.ClusterPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := clusterpools.ParseClusterPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
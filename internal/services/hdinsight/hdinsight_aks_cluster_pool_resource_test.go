@@ -0,0 +1,167 @@
+package hdinsight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusterpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HDInsightAksClusterPoolResource struct{}
+
+func TestAccHDInsightAksClusterPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_cluster_pool", "test")
+	r := HDInsightAksClusterPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("aks_managed_resource_group_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHDInsightAksClusterPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_cluster_pool", "test")
+	r := HDInsightAksClusterPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccHDInsightAksClusterPool_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_cluster_pool", "test")
+	r := HDInsightAksClusterPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "Test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.environment").HasValue("Test"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (HDInsightAksClusterPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := clusterpools.ParseClusterPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HDInsight.ClusterPoolsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (HDInsightAksClusterPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hdiaks-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r HDInsightAksClusterPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_cluster_pool" "test" {
+  name                 = "acctesthdiaks%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  location             = azurerm_resource_group.test.location
+  cluster_pool_version = "1.1"
+  virtual_machine_size = "Standard_F4s_v2"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r HDInsightAksClusterPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_cluster_pool" "import" {
+  name                 = azurerm_hdinsight_aks_cluster_pool.test.name
+  resource_group_name  = azurerm_hdinsight_aks_cluster_pool.test.resource_group_name
+  location             = azurerm_hdinsight_aks_cluster_pool.test.location
+  cluster_pool_version = azurerm_hdinsight_aks_cluster_pool.test.cluster_pool_version
+  virtual_machine_size = azurerm_hdinsight_aks_cluster_pool.test.virtual_machine_size
+}
+`, r.basic(data))
+}
+
+func (r HDInsightAksClusterPoolResource) complete(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_hdinsight_aks_cluster_pool" "test" {
+  name                        = "acctesthdiaks%[2]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  cluster_pool_version        = "1.1"
+  virtual_machine_size        = "Standard_F4s_v2"
+  managed_resource_group_name = "acctestRG-hdiaks-managed-%[2]d"
+  subnet_id                   = azurerm_subnet.test.id
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+
+  tags = {
+    environment = "%[3]s"
+  }
+}
+`, r.template(data), data.RandomInteger, environment)
+}
@@ -0,0 +1,82 @@
+package hdinsight
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func resourceHDInsightAksFlinkCluster() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: hdinsightAksClusterCreate("Flink", "azurerm_hdinsight_aks_flink_cluster", expandHDInsightAksFlinkProfile, resourceHDInsightAksFlinkClusterRead),
+		Read:   resourceHDInsightAksFlinkClusterRead,
+		Update: hdinsightAksClusterUpdate(resourceHDInsightAksFlinkClusterRead),
+		Delete: hdinsightAksClusterDelete(),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := clusters.ParseClusterID(id)
+			return err
+		}),
+
+		Schema: hdInsightAksClusterSchema(map[string]*pluginsdk.Schema{
+			"storage_uri": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_manager": schemaHDInsightAksComputeResource(true),
+
+			"task_manager": schemaHDInsightAksComputeResource(true),
+
+			"history_server": schemaHDInsightAksComputeResource(false),
+		}),
+	}
+}
+
+var resourceHDInsightAksFlinkClusterRead = hdinsightAksClusterRead(flattenHDInsightAksFlinkProfile)
+
+func expandHDInsightAksFlinkProfile(d *pluginsdk.ResourceData, profile *clusters.ClusterProfile) {
+	profile.FlinkProfile = &clusters.FlinkProfile{
+		HistoryServer: expandHDInsightAksComputeResource(d.Get("history_server").([]interface{})),
+		JobManager:    *expandHDInsightAksComputeResource(d.Get("job_manager").([]interface{})),
+		Storage: clusters.FlinkStorageProfile{
+			StorageUri: d.Get("storage_uri").(string),
+		},
+		TaskManager: *expandHDInsightAksComputeResource(d.Get("task_manager").([]interface{})),
+	}
+}
+
+func flattenHDInsightAksFlinkProfile(d *pluginsdk.ResourceData, profile clusters.ClusterProfile) error {
+	flink := profile.FlinkProfile
+	if flink == nil {
+		flink = &clusters.FlinkProfile{}
+	}
+
+	d.Set("storage_uri", flink.Storage.StorageUri)
+
+	if err := d.Set("job_manager", flattenHDInsightAksComputeResource(&flink.JobManager)); err != nil {
+		return fmt.Errorf("setting `job_manager`: %+v", err)
+	}
+
+	if err := d.Set("task_manager", flattenHDInsightAksComputeResource(&flink.TaskManager)); err != nil {
+		return fmt.Errorf("setting `task_manager`: %+v", err)
+	}
+
+	if err := d.Set("history_server", flattenHDInsightAksComputeResource(flink.HistoryServer)); err != nil {
+		return fmt.Errorf("setting `history_server`: %+v", err)
+	}
+
+	return nil
+}
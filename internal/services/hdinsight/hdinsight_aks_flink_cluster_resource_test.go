@@ -0,0 +1,214 @@
+package hdinsight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HDInsightAksFlinkClusterResource struct{}
+
+func TestAccHDInsightAksFlinkCluster_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_flink_cluster", "test")
+	r := HDInsightAksFlinkClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHDInsightAksFlinkCluster_loadBasedAutoscale(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_flink_cluster", "test")
+	r := HDInsightAksFlinkClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.loadBasedAutoscale(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (HDInsightAksFlinkClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := clusters.ParseClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HDInsight.ClusterPoolClustersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (HDInsightAksFlinkClusterResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "test" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "flink"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+`, HDInsightAksClusterPoolResource{}.basic(data), data.RandomInteger, data.RandomString)
+}
+
+func (r HDInsightAksFlinkClusterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_flink_cluster" "test" {
+  name                = "acctestflink%[2]d"
+  cluster_pool_id     = azurerm_hdinsight_aks_cluster_pool.test.id
+  location            = azurerm_resource_group.test.location
+  cluster_version     = "1.1.1"
+  oss_version         = "1.17.0"
+  authorized_user_ids = [data.azurerm_client_config.test.object_id]
+  storage_uri         = "abfs://${azurerm_storage_data_lake_gen2_filesystem.test.name}@${azurerm_storage_account.test.name}.dfs.core.windows.net"
+
+  managed_identity {
+    id        = azurerm_user_assigned_identity.test.id
+    client_id = azurerm_user_assigned_identity.test.client_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+  }
+
+  head_node {
+    vm_size = "Standard_D8ds_v5"
+    count   = 2
+  }
+
+  worker_node {
+    vm_size = "Standard_D8ds_v5"
+    count   = 3
+  }
+
+  job_manager {
+    cpu          = 1
+    memory_in_mb = 2000
+  }
+
+  task_manager {
+    cpu          = 1
+    memory_in_mb = 2000
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r HDInsightAksFlinkClusterResource) loadBasedAutoscale(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_flink_cluster" "test" {
+  name                = "acctestflink%[2]d"
+  cluster_pool_id     = azurerm_hdinsight_aks_cluster_pool.test.id
+  location            = azurerm_resource_group.test.location
+  cluster_version     = "1.1.1"
+  oss_version         = "1.17.0"
+  authorized_user_ids = [data.azurerm_client_config.test.object_id]
+  storage_uri         = "abfs://${azurerm_storage_data_lake_gen2_filesystem.test.name}@${azurerm_storage_account.test.name}.dfs.core.windows.net"
+
+  managed_identity {
+    id        = azurerm_user_assigned_identity.test.id
+    client_id = azurerm_user_assigned_identity.test.client_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+  }
+
+  head_node {
+    vm_size = "Standard_D8ds_v5"
+    count   = 2
+  }
+
+  worker_node {
+    vm_size = "Standard_D8ds_v5"
+    count   = 3
+  }
+
+  job_manager {
+    cpu          = 1
+    memory_in_mb = 2000
+  }
+
+  task_manager {
+    cpu          = 1
+    memory_in_mb = 2000
+  }
+
+  history_server {
+    cpu          = 1
+    memory_in_mb = 2000
+  }
+
+  autoscale {
+    graceful_decommission_timeout_in_seconds = 3600
+
+    load_based {
+      min_nodes                  = 3
+      max_nodes                  = 5
+      poll_interval_in_seconds   = 60
+      cooldown_period_in_seconds = 300
+
+      scaling_rule {
+        action_type      = "scaleup"
+        evaluation_count = 3
+        scaling_metric   = "cpu"
+        operator         = "greaterThan"
+        threshold        = 80
+      }
+
+      scaling_rule {
+        action_type      = "scaledown"
+        evaluation_count = 3
+        scaling_metric   = "cpu"
+        operator         = "lessThan"
+        threshold        = 20
+      }
+    }
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,60 @@
+package hdinsight
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceHDInsightAksSparkCluster() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: hdinsightAksClusterCreate("Spark", "azurerm_hdinsight_aks_spark_cluster", expandHDInsightAksSparkProfile, resourceHDInsightAksSparkClusterRead),
+		Read:   resourceHDInsightAksSparkClusterRead,
+		Update: hdinsightAksClusterUpdate(resourceHDInsightAksSparkClusterRead),
+		Delete: hdinsightAksClusterDelete(),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := clusters.ParseClusterID(id)
+			return err
+		}),
+
+		Schema: hdInsightAksClusterSchema(map[string]*pluginsdk.Schema{
+			"default_storage_url": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		}),
+	}
+}
+
+var resourceHDInsightAksSparkClusterRead = hdinsightAksClusterRead(flattenHDInsightAksSparkProfile)
+
+func expandHDInsightAksSparkProfile(d *pluginsdk.ResourceData, profile *clusters.ClusterProfile) {
+	profile.SparkProfile = &clusters.SparkProfile{}
+
+	if v := d.Get("default_storage_url").(string); v != "" {
+		profile.SparkProfile.DefaultStorageUrl = utils.String(v)
+	}
+}
+
+func flattenHDInsightAksSparkProfile(d *pluginsdk.ResourceData, profile clusters.ClusterProfile) error {
+	defaultStorageUrl := ""
+	if spark := profile.SparkProfile; spark != nil && spark.DefaultStorageUrl != nil {
+		defaultStorageUrl = *spark.DefaultStorageUrl
+	}
+	d.Set("default_storage_url", defaultStorageUrl)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package hdinsight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HDInsightAksSparkClusterResource struct{}
+
+func TestAccHDInsightAksSparkCluster_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_spark_cluster", "test")
+	r := HDInsightAksSparkClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (HDInsightAksSparkClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := clusters.ParseClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HDInsight.ClusterPoolClustersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r HDInsightAksSparkClusterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_spark_cluster" "test" {
+  name                = "acctestspark%[2]d"
+  cluster_pool_id     = azurerm_hdinsight_aks_cluster_pool.test.id
+  location            = azurerm_resource_group.test.location
+  cluster_version     = "1.1.1"
+  oss_version         = "3.4.1"
+  authorized_user_ids = [data.azurerm_client_config.test.object_id]
+  default_storage_url = "abfs://${azurerm_storage_data_lake_gen2_filesystem.test.name}@${azurerm_storage_account.test.name}.dfs.core.windows.net"
+
+  managed_identity {
+    id        = azurerm_user_assigned_identity.test.id
+    client_id = azurerm_user_assigned_identity.test.client_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+  }
+
+  head_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = 3
+  }
+
+  worker_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = 3
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, HDInsightAksFlinkClusterResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,59 @@
+package hdinsight
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceHDInsightAksTrinoCluster() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: hdinsightAksClusterCreate("Trino", "azurerm_hdinsight_aks_trino_cluster", expandHDInsightAksTrinoProfile, resourceHDInsightAksTrinoClusterRead),
+		Read:   resourceHDInsightAksTrinoClusterRead,
+		Update: hdinsightAksClusterUpdate(resourceHDInsightAksTrinoClusterRead),
+		Delete: hdinsightAksClusterDelete(),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := clusters.ParseClusterID(id)
+			return err
+		}),
+
+		Schema: hdInsightAksClusterSchema(map[string]*pluginsdk.Schema{
+			"coordinator_high_availability_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		}),
+	}
+}
+
+var resourceHDInsightAksTrinoClusterRead = hdinsightAksClusterRead(flattenHDInsightAksTrinoProfile)
+
+func expandHDInsightAksTrinoProfile(d *pluginsdk.ResourceData, profile *clusters.ClusterProfile) {
+	profile.TrinoProfile = &clusters.TrinoProfile{
+		Coordinator: &clusters.TrinoCoordinator{
+			HighAvailabilityEnabled: utils.Bool(d.Get("coordinator_high_availability_enabled").(bool)),
+		},
+	}
+}
+
+func flattenHDInsightAksTrinoProfile(d *pluginsdk.ResourceData, profile clusters.ClusterProfile) error {
+	highAvailabilityEnabled := false
+	if trino := profile.TrinoProfile; trino != nil && trino.Coordinator != nil {
+		highAvailabilityEnabled = utils.NormaliseNilableBool(trino.Coordinator.HighAvailabilityEnabled)
+	}
+	d.Set("coordinator_high_availability_enabled", highAvailabilityEnabled)
+
+	return nil
+}
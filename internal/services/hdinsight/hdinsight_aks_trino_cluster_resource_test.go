@@ -0,0 +1,243 @@
+package hdinsight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/sdk/2024-05-01-preview/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HDInsightAksTrinoClusterResource struct{}
+
+func TestAccHDInsightAksTrinoCluster_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_trino_cluster", "test")
+	r := HDInsightAksTrinoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHDInsightAksTrinoCluster_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_trino_cluster", "test")
+	r := HDInsightAksTrinoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccHDInsightAksTrinoCluster_resize(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_trino_cluster", "test")
+	r := HDInsightAksTrinoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.workerCount(data, 4),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("worker_node.0.count").HasValue("4"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHDInsightAksTrinoCluster_autoscale(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hdinsight_aks_trino_cluster", "test")
+	r := HDInsightAksTrinoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scheduleBasedAutoscale(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (HDInsightAksTrinoClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := clusters.ParseClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HDInsight.ClusterPoolClustersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (HDInsightAksTrinoClusterResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "test" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, HDInsightAksClusterPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r HDInsightAksTrinoClusterResource) basic(data acceptance.TestData) string {
+	return r.workerCount(data, 3)
+}
+
+func (r HDInsightAksTrinoClusterResource) workerCount(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_trino_cluster" "test" {
+  name                = "acctesttrino%[2]d"
+  cluster_pool_id     = azurerm_hdinsight_aks_cluster_pool.test.id
+  location            = azurerm_resource_group.test.location
+  cluster_version     = "1.1.1"
+  oss_version         = "0.440.0"
+  authorized_user_ids = [data.azurerm_client_config.test.object_id]
+
+  managed_identity {
+    id        = azurerm_user_assigned_identity.test.id
+    client_id = azurerm_user_assigned_identity.test.client_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+  }
+
+  head_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = 2
+  }
+
+  worker_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = %[3]d
+  }
+}
+`, r.template(data), data.RandomInteger, count)
+}
+
+func (r HDInsightAksTrinoClusterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_trino_cluster" "import" {
+  name                = azurerm_hdinsight_aks_trino_cluster.test.name
+  cluster_pool_id     = azurerm_hdinsight_aks_trino_cluster.test.cluster_pool_id
+  location            = azurerm_hdinsight_aks_trino_cluster.test.location
+  cluster_version     = azurerm_hdinsight_aks_trino_cluster.test.cluster_version
+  oss_version         = azurerm_hdinsight_aks_trino_cluster.test.oss_version
+  authorized_user_ids = azurerm_hdinsight_aks_trino_cluster.test.authorized_user_ids
+
+  managed_identity {
+    id        = azurerm_user_assigned_identity.test.id
+    client_id = azurerm_user_assigned_identity.test.client_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+  }
+
+  head_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = 2
+  }
+
+  worker_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = 3
+  }
+}
+`, r.basic(data))
+}
+
+func (r HDInsightAksTrinoClusterResource) scheduleBasedAutoscale(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_hdinsight_aks_trino_cluster" "test" {
+  name                = "acctesttrino%[2]d"
+  cluster_pool_id     = azurerm_hdinsight_aks_cluster_pool.test.id
+  location            = azurerm_resource_group.test.location
+  cluster_version     = "1.1.1"
+  oss_version         = "0.440.0"
+  authorized_user_ids = [data.azurerm_client_config.test.object_id]
+
+  managed_identity {
+    id        = azurerm_user_assigned_identity.test.id
+    client_id = azurerm_user_assigned_identity.test.client_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+  }
+
+  head_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = 2
+  }
+
+  worker_node {
+    vm_size = "Standard_E8ads_v5"
+    count   = 3
+  }
+
+  autoscale {
+    graceful_decommission_timeout_in_seconds = 3600
+
+    schedule_based {
+      timezone      = "UTC"
+      default_count = 3
+
+      schedule {
+        days       = ["Saturday", "Sunday"]
+        start_time = "00:00"
+        end_time   = "23:59"
+        count      = 5
+      }
+    }
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
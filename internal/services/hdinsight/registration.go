@@ -28,6 +28,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_hdinsight_aks_cluster_pool":          resourceHDInsightAksClusterPool(),
+		"azurerm_hdinsight_aks_flink_cluster":         resourceHDInsightAksFlinkCluster(),
+		"azurerm_hdinsight_aks_spark_cluster":         resourceHDInsightAksSparkCluster(),
+		"azurerm_hdinsight_aks_trino_cluster":         resourceHDInsightAksTrinoCluster(),
 		"azurerm_hdinsight_hadoop_cluster":            resourceHDInsightHadoopCluster(),
 		"azurerm_hdinsight_hbase_cluster":             resourceHDInsightHBaseCluster(),
 		"azurerm_hdinsight_interactive_query_cluster": resourceHDInsightInteractiveQueryCluster(),
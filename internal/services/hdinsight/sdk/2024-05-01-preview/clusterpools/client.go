@@ -0,0 +1,15 @@
+package clusterpools
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ClusterPoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClusterPoolsClientWithBaseURI(endpoint string) ClusterPoolsClient {
+	return ClusterPoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,37 @@
+package clusterpools
+
+import "strings"
+
+type ProvisioningStatus string
+
+const (
+	ProvisioningStatusAccepted  ProvisioningStatus = "Accepted"
+	ProvisioningStatusCanceled  ProvisioningStatus = "Canceled"
+	ProvisioningStatusFailed    ProvisioningStatus = "Failed"
+	ProvisioningStatusSucceeded ProvisioningStatus = "Succeeded"
+)
+
+func PossibleValuesForProvisioningStatus() []string {
+	return []string{
+		string(ProvisioningStatusAccepted),
+		string(ProvisioningStatusCanceled),
+		string(ProvisioningStatusFailed),
+		string(ProvisioningStatusSucceeded),
+	}
+}
+
+func parseProvisioningStatus(input string) (*ProvisioningStatus, error) {
+	vals := map[string]ProvisioningStatus{
+		"accepted":  ProvisioningStatusAccepted,
+		"canceled":  ProvisioningStatusCanceled,
+		"failed":    ProvisioningStatusFailed,
+		"succeeded": ProvisioningStatusSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStatus(input)
+	return &out, nil
+}
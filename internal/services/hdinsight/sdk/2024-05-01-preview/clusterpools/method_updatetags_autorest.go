@@ -0,0 +1,75 @@
+package clusterpools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type UpdateTagsResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// UpdateTags ...
+func (c ClusterPoolsClient) UpdateTags(ctx context.Context, id ClusterPoolId, input TagsObject) (result UpdateTagsResponse, err error) {
+	req, err := c.preparerForUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusterpools.ClusterPoolsClient", "UpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForUpdateTags(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusterpools.ClusterPoolsClient", "UpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// UpdateTagsThenPoll performs UpdateTags then polls until it's completed
+func (c ClusterPoolsClient) UpdateTagsThenPoll(ctx context.Context, id ClusterPoolId, input TagsObject) error {
+	result, err := c.UpdateTags(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing UpdateTags: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after UpdateTags: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForUpdateTags prepares the UpdateTags request.
+func (c ClusterPoolsClient) preparerForUpdateTags(ctx context.Context, id ClusterPoolId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForUpdateTags sends the UpdateTags request. The method will close the
+// http.Response Body if it receives an error.
+func (c ClusterPoolsClient) senderForUpdateTags(ctx context.Context, req *http.Request) (future UpdateTagsResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
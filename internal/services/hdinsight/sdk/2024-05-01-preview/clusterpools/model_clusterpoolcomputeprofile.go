@@ -0,0 +1,6 @@
+package clusterpools
+
+type ClusterPoolComputeProfile struct {
+	Count  *int64 `json:"count,omitempty"`
+	VMSize string `json:"vmSize"`
+}
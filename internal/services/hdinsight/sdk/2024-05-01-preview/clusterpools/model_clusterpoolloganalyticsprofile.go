@@ -0,0 +1,6 @@
+package clusterpools
+
+type ClusterPoolLogAnalyticsProfile struct {
+	Enabled     bool    `json:"enabled"`
+	WorkspaceId *string `json:"workspaceId,omitempty"`
+}
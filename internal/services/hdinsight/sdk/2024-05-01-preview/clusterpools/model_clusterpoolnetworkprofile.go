@@ -0,0 +1,5 @@
+package clusterpools
+
+type ClusterPoolNetworkProfile struct {
+	SubnetId string `json:"subnetId"`
+}
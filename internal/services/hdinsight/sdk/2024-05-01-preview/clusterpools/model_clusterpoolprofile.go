@@ -0,0 +1,5 @@
+package clusterpools
+
+type ClusterPoolProfile struct {
+	ClusterPoolVersion string `json:"clusterPoolVersion"`
+}
@@ -0,0 +1,12 @@
+package clusterpools
+
+type ClusterPoolResourceProperties struct {
+	AksManagedResourceGroupName *string                         `json:"aksManagedResourceGroupName,omitempty"`
+	ClusterPoolProfile          *ClusterPoolProfile             `json:"clusterPoolProfile,omitempty"`
+	ComputeProfile              ClusterPoolComputeProfile       `json:"computeProfile"`
+	LogAnalyticsProfile         *ClusterPoolLogAnalyticsProfile `json:"logAnalyticsProfile,omitempty"`
+	ManagedResourceGroupName    *string                         `json:"managedResourceGroupName,omitempty"`
+	NetworkProfile              *ClusterPoolNetworkProfile      `json:"networkProfile,omitempty"`
+	ProvisioningState           *ProvisioningStatus             `json:"provisioningState,omitempty"`
+	Status                      *string                         `json:"status,omitempty"`
+}
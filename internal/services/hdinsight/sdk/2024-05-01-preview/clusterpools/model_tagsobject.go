@@ -0,0 +1,5 @@
+package clusterpools
+
+type TagsObject struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
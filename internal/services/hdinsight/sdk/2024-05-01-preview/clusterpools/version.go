@@ -0,0 +1,9 @@
+package clusterpools
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/clusterpools/%s", defaultApiVersion)
+}
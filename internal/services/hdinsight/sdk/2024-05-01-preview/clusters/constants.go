@@ -0,0 +1,170 @@
+package clusters
+
+import "strings"
+
+type AutoscaleType string
+
+const (
+	AutoscaleTypeLoadBased     AutoscaleType = "LoadBased"
+	AutoscaleTypeScheduleBased AutoscaleType = "ScheduleBased"
+)
+
+func PossibleValuesForAutoscaleType() []string {
+	return []string{
+		string(AutoscaleTypeLoadBased),
+		string(AutoscaleTypeScheduleBased),
+	}
+}
+
+func parseAutoscaleType(input string) (*AutoscaleType, error) {
+	vals := map[string]AutoscaleType{
+		"loadbased":     AutoscaleTypeLoadBased,
+		"schedulebased": AutoscaleTypeScheduleBased,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AutoscaleType(input)
+	return &out, nil
+}
+
+type ComparisonOperator string
+
+const (
+	ComparisonOperatorGreaterThan        ComparisonOperator = "greaterThan"
+	ComparisonOperatorGreaterThanOrEqual ComparisonOperator = "greaterThanOrEqual"
+	ComparisonOperatorLessThan           ComparisonOperator = "lessThan"
+	ComparisonOperatorLessThanOrEqual    ComparisonOperator = "lessThanOrEqual"
+)
+
+func PossibleValuesForComparisonOperator() []string {
+	return []string{
+		string(ComparisonOperatorGreaterThan),
+		string(ComparisonOperatorGreaterThanOrEqual),
+		string(ComparisonOperatorLessThan),
+		string(ComparisonOperatorLessThanOrEqual),
+	}
+}
+
+func parseComparisonOperator(input string) (*ComparisonOperator, error) {
+	vals := map[string]ComparisonOperator{
+		"greaterthan":        ComparisonOperatorGreaterThan,
+		"greaterthanorequal": ComparisonOperatorGreaterThanOrEqual,
+		"lessthan":           ComparisonOperatorLessThan,
+		"lessthanorequal":    ComparisonOperatorLessThanOrEqual,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ComparisonOperator(input)
+	return &out, nil
+}
+
+type ProvisioningStatus string
+
+const (
+	ProvisioningStatusAccepted  ProvisioningStatus = "Accepted"
+	ProvisioningStatusCanceled  ProvisioningStatus = "Canceled"
+	ProvisioningStatusFailed    ProvisioningStatus = "Failed"
+	ProvisioningStatusSucceeded ProvisioningStatus = "Succeeded"
+)
+
+func PossibleValuesForProvisioningStatus() []string {
+	return []string{
+		string(ProvisioningStatusAccepted),
+		string(ProvisioningStatusCanceled),
+		string(ProvisioningStatusFailed),
+		string(ProvisioningStatusSucceeded),
+	}
+}
+
+func parseProvisioningStatus(input string) (*ProvisioningStatus, error) {
+	vals := map[string]ProvisioningStatus{
+		"accepted":  ProvisioningStatusAccepted,
+		"canceled":  ProvisioningStatusCanceled,
+		"failed":    ProvisioningStatusFailed,
+		"succeeded": ProvisioningStatusSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStatus(input)
+	return &out, nil
+}
+
+type ScaleActionType string
+
+const (
+	ScaleActionTypeScaledown ScaleActionType = "scaledown"
+	ScaleActionTypeScaleup   ScaleActionType = "scaleup"
+)
+
+func PossibleValuesForScaleActionType() []string {
+	return []string{
+		string(ScaleActionTypeScaledown),
+		string(ScaleActionTypeScaleup),
+	}
+}
+
+func parseScaleActionType(input string) (*ScaleActionType, error) {
+	vals := map[string]ScaleActionType{
+		"scaledown": ScaleActionTypeScaledown,
+		"scaleup":   ScaleActionTypeScaleup,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScaleActionType(input)
+	return &out, nil
+}
+
+type ScheduleDay string
+
+const (
+	ScheduleDayFriday    ScheduleDay = "Friday"
+	ScheduleDayMonday    ScheduleDay = "Monday"
+	ScheduleDaySaturday  ScheduleDay = "Saturday"
+	ScheduleDaySunday    ScheduleDay = "Sunday"
+	ScheduleDayThursday  ScheduleDay = "Thursday"
+	ScheduleDayTuesday   ScheduleDay = "Tuesday"
+	ScheduleDayWednesday ScheduleDay = "Wednesday"
+)
+
+func PossibleValuesForScheduleDay() []string {
+	return []string{
+		string(ScheduleDayFriday),
+		string(ScheduleDayMonday),
+		string(ScheduleDaySaturday),
+		string(ScheduleDaySunday),
+		string(ScheduleDayThursday),
+		string(ScheduleDayTuesday),
+		string(ScheduleDayWednesday),
+	}
+}
+
+func parseScheduleDay(input string) (*ScheduleDay, error) {
+	vals := map[string]ScheduleDay{
+		"friday":    ScheduleDayFriday,
+		"monday":    ScheduleDayMonday,
+		"saturday":  ScheduleDaySaturday,
+		"sunday":    ScheduleDaySunday,
+		"thursday":  ScheduleDayThursday,
+		"tuesday":   ScheduleDayTuesday,
+		"wednesday": ScheduleDayWednesday,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScheduleDay(input)
+	return &out, nil
+}
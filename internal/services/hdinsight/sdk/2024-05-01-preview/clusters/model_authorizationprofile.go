@@ -0,0 +1,6 @@
+package clusters
+
+type AuthorizationProfile struct {
+	GroupIds *[]string `json:"groupIds,omitempty"`
+	UserIds  *[]string `json:"userIds,omitempty"`
+}
@@ -0,0 +1,9 @@
+package clusters
+
+type AutoscaleProfile struct {
+	AutoscaleType               *AutoscaleType       `json:"autoscaleType,omitempty"`
+	Enabled                     bool                 `json:"enabled"`
+	GracefulDecommissionTimeout *int64               `json:"gracefulDecommissionTimeout,omitempty"`
+	LoadBasedConfig             *LoadBasedConfig     `json:"loadBasedConfig,omitempty"`
+	ScheduleBasedConfig         *ScheduleBasedConfig `json:"scheduleBasedConfig,omitempty"`
+}
@@ -0,0 +1,6 @@
+package clusters
+
+type ClusterPatch struct {
+	Properties *ClusterPatchProperties `json:"properties,omitempty"`
+	Tags       *map[string]string      `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package clusters
+
+type ClusterPatchProperties struct {
+	ClusterProfile *UpdatableClusterProfile `json:"clusterProfile,omitempty"`
+}
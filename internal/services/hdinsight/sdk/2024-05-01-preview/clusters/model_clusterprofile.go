@@ -0,0 +1,12 @@
+package clusters
+
+type ClusterProfile struct {
+	AuthorizationProfile AuthorizationProfile `json:"authorizationProfile"`
+	AutoscaleProfile     *AutoscaleProfile    `json:"autoscaleProfile,omitempty"`
+	ClusterVersion       string               `json:"clusterVersion"`
+	FlinkProfile         *FlinkProfile        `json:"flinkProfile,omitempty"`
+	IdentityProfile      *IdentityProfile     `json:"identityProfile,omitempty"`
+	OssVersion           string               `json:"ossVersion"`
+	SparkProfile         *SparkProfile        `json:"sparkProfile,omitempty"`
+	TrinoProfile         *TrinoProfile        `json:"trinoProfile,omitempty"`
+}
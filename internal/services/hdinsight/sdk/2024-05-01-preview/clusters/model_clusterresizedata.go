@@ -0,0 +1,6 @@
+package clusters
+
+type ClusterResizeData struct {
+	Location   string                   `json:"location"`
+	Properties *ClusterResizeProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,5 @@
+package clusters
+
+type ClusterResizeProperties struct {
+	TargetWorkerNodeCount int64 `json:"targetWorkerNodeCount"`
+}
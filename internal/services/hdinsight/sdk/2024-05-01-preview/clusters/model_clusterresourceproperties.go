@@ -0,0 +1,10 @@
+package clusters
+
+type ClusterResourceProperties struct {
+	ClusterProfile    ClusterProfile      `json:"clusterProfile"`
+	ClusterType       string              `json:"clusterType"`
+	ComputeProfile    ComputeProfile      `json:"computeProfile"`
+	DeploymentId      *string             `json:"deploymentId,omitempty"`
+	ProvisioningState *ProvisioningStatus `json:"provisioningState,omitempty"`
+	Status            *string             `json:"status,omitempty"`
+}
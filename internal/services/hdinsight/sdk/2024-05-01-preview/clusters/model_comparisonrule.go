@@ -0,0 +1,6 @@
+package clusters
+
+type ComparisonRule struct {
+	Operator  ComparisonOperator `json:"operator"`
+	Threshold float64            `json:"threshold"`
+}
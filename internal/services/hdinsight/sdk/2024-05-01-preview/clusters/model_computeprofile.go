@@ -0,0 +1,5 @@
+package clusters
+
+type ComputeProfile struct {
+	Nodes []NodeProfile `json:"nodes"`
+}
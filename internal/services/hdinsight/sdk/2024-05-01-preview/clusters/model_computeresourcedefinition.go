@@ -0,0 +1,6 @@
+package clusters
+
+type ComputeResourceDefinition struct {
+	Cpu    float64 `json:"cpu"`
+	Memory int64   `json:"memory"`
+}
@@ -0,0 +1,9 @@
+package clusters
+
+type FlinkProfile struct {
+	HistoryServer *ComputeResourceDefinition `json:"historyServer,omitempty"`
+	JobManager    ComputeResourceDefinition  `json:"jobManager"`
+	NumReplicas   *int64                     `json:"numReplicas,omitempty"`
+	Storage       FlinkStorageProfile        `json:"storage"`
+	TaskManager   ComputeResourceDefinition  `json:"taskManager"`
+}
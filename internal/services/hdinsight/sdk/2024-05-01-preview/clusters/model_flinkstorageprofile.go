@@ -0,0 +1,5 @@
+package clusters
+
+type FlinkStorageProfile struct {
+	StorageUri string `json:"storageUri"`
+}
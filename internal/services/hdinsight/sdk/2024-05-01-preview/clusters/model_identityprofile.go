@@ -0,0 +1,7 @@
+package clusters
+
+type IdentityProfile struct {
+	MsiClientId   string `json:"msiClientId"`
+	MsiObjectId   string `json:"msiObjectId"`
+	MsiResourceId string `json:"msiResourceId"`
+}
@@ -0,0 +1,9 @@
+package clusters
+
+type LoadBasedConfig struct {
+	CooldownPeriod *int64        `json:"cooldownPeriod,omitempty"`
+	MaxNodes       int64         `json:"maxNodes"`
+	MinNodes       int64         `json:"minNodes"`
+	PollInterval   *int64        `json:"pollInterval,omitempty"`
+	ScalingRules   []ScalingRule `json:"scalingRules"`
+}
@@ -0,0 +1,7 @@
+package clusters
+
+type NodeProfile struct {
+	Count  int64  `json:"count"`
+	Type   string `json:"type"`
+	VMSize string `json:"vmSize"`
+}
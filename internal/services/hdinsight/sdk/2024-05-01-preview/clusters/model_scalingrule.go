@@ -0,0 +1,8 @@
+package clusters
+
+type ScalingRule struct {
+	ActionType      ScaleActionType `json:"actionType"`
+	ComparisonRule  ComparisonRule  `json:"comparisonRule"`
+	EvaluationCount int64           `json:"evaluationCount"`
+	ScalingMetric   string          `json:"scalingMetric"`
+}
@@ -0,0 +1,8 @@
+package clusters
+
+type Schedule struct {
+	Count     int64         `json:"count"`
+	Days      []ScheduleDay `json:"days"`
+	EndTime   string        `json:"endTime"`
+	StartTime string        `json:"startTime"`
+}
@@ -0,0 +1,7 @@
+package clusters
+
+type ScheduleBasedConfig struct {
+	DefaultCount int64      `json:"defaultCount"`
+	Schedules    []Schedule `json:"schedules"`
+	TimeZone     string     `json:"timeZone"`
+}
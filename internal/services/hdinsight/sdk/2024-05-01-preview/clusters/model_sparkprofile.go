@@ -0,0 +1,5 @@
+package clusters
+
+type SparkProfile struct {
+	DefaultStorageUrl *string `json:"defaultStorageUrl,omitempty"`
+}
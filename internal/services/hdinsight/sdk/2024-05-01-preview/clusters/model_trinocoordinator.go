@@ -0,0 +1,5 @@
+package clusters
+
+type TrinoCoordinator struct {
+	HighAvailabilityEnabled *bool `json:"highAvailabilityEnabled,omitempty"`
+}
@@ -0,0 +1,5 @@
+package clusters
+
+type TrinoProfile struct {
+	Coordinator *TrinoCoordinator `json:"coordinator,omitempty"`
+}
@@ -0,0 +1,6 @@
+package clusters
+
+type UpdatableClusterProfile struct {
+	AuthorizationProfile *AuthorizationProfile `json:"authorizationProfile,omitempty"`
+	AutoscaleProfile     *AutoscaleProfile     `json:"autoscaleProfile,omitempty"`
+}
@@ -0,0 +1,9 @@
+package clusters
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/clusters/%s", defaultApiVersion)
+}
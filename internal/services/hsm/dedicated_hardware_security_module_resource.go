@@ -207,7 +207,7 @@ func resourceDedicatedHardwareSecurityModuleUpdate(d *pluginsdk.ResourceData, me
 	}
 
 	parameters := hardwaresecuritymodules.DedicatedHsmPatchParameters{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
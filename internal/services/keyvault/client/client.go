@@ -4,30 +4,52 @@ import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/2021-10-01/managedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.4/managedhsmdataplane"
 )
 
 type Client struct {
-	ManagedHsmClient *keyvault.ManagedHsmsClient
-	ManagementClient *keyvaultmgmt.BaseClient
-	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+	DeletedManagedHsmsClient                 *managedhsms.ManagedHsmsClient
+	ManagedHsmClient                         *keyvault.ManagedHsmsClient
+	ManagedHsmDataPlaneClient                *managedhsmdataplane.BaseClient
+	ManagedHsmDataPlaneRoleAssignmentsClient *managedhsmdataplane.RoleAssignmentsClient
+	ManagedHsmDataPlaneRoleDefinitionsClient *managedhsmdataplane.RoleDefinitionsClient
+	ManagementClient                         *keyvaultmgmt.BaseClient
+	VaultsClient                             *keyvault.VaultsClient
+	options                                  *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	deletedManagedHsmsClient := managedhsms.NewManagedHsmsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&deletedManagedHsmsClient.Client, o.ResourceManagerAuthorizer)
+
 	managedHsmClient := keyvault.NewManagedHsmsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedHsmClient.Client, o.ResourceManagerAuthorizer)
 
+	managedHsmDataPlaneClient := managedhsmdataplane.New()
+	o.ConfigureKeyVaultDataPlaneClient(&managedHsmDataPlaneClient.Client)
+
+	managedHsmDataPlaneRoleAssignmentsClient := managedhsmdataplane.NewRoleAssignmentsClient()
+	o.ConfigureKeyVaultDataPlaneClient(&managedHsmDataPlaneRoleAssignmentsClient.Client)
+
+	managedHsmDataPlaneRoleDefinitionsClient := managedhsmdataplane.NewRoleDefinitionsClient()
+	o.ConfigureKeyVaultDataPlaneClient(&managedHsmDataPlaneRoleDefinitionsClient.Client)
+
 	managementClient := keyvaultmgmt.New()
-	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
+	o.ConfigureKeyVaultDataPlaneClient(&managementClient.Client)
 
 	vaultsClient := keyvault.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ManagedHsmClient: &managedHsmClient,
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
-		options:          o,
+		DeletedManagedHsmsClient:                 &deletedManagedHsmsClient,
+		ManagedHsmClient:                         &managedHsmClient,
+		ManagedHsmDataPlaneClient:                &managedHsmDataPlaneClient,
+		ManagedHsmDataPlaneRoleAssignmentsClient: &managedHsmDataPlaneRoleAssignmentsClient,
+		ManagedHsmDataPlaneRoleDefinitionsClient: &managedHsmDataPlaneRoleDefinitionsClient,
+		ManagementClient:                         &managementClient,
+		VaultsClient:                             &vaultsClient,
+		options:                                  o,
 	}
 }
 
@@ -0,0 +1,562 @@
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.4/managedhsmdataplane"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModuleKey() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleKeyCreate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleKeyRead,
+		Update: resourceKeyVaultManagedHardwareSecurityModuleKeyUpdate,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleKeyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseNestedItemID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"key_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(managedhsmdataplane.ECHSM),
+					string(managedhsmdataplane.OctHSM),
+					string(managedhsmdataplane.RSAHSM),
+				}, false),
+			},
+
+			"key_opts": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(managedhsmdataplane.Decrypt),
+						string(managedhsmdataplane.Encrypt),
+						string(managedhsmdataplane.Export),
+						string(managedhsmdataplane.Import),
+						string(managedhsmdataplane.Sign),
+						string(managedhsmdataplane.UnwrapKey),
+						string(managedhsmdataplane.Verify),
+						string(managedhsmdataplane.WrapKey),
+					}, false),
+				},
+			},
+
+			"key_size": {
+				Type:          pluginsdk.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"curve"},
+			},
+
+			"curve": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(managedhsmdataplane.P256),
+					string(managedhsmdataplane.P256K),
+					string(managedhsmdataplane.P384),
+					string(managedhsmdataplane.P521),
+				}, false),
+				ConflictsWith: []string{"key_size"},
+			},
+
+			"not_before_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"expiration_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{"rotation_policy.0.automatic.0.time_after_creation", "rotation_policy.0.automatic.0.time_before_expiry"},
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{"rotation_policy.0.automatic.0.time_after_creation", "rotation_policy.0.automatic.0.time_before_expiry"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	managedHSMId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	baseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHSMId)
+	if err != nil {
+		return fmt.Errorf("looking up the Data Plane URI for Key %q: %+v", name, err)
+	}
+
+	existing, err := client.GetKey(ctx, *baseUri, name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Key %q (%s): %+v", name, *managedHSMId, err)
+		}
+	}
+	if existing.Key != nil && existing.Key.Kid != nil && *existing.Key.Kid != "" {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_key", *existing.Key.Kid)
+	}
+
+	keyOptions := expandManagedHSMKeyOptions(d.Get("key_opts").([]interface{}))
+	attributes, err := expandManagedHSMKeyAttributes(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := managedhsmdataplane.KeyCreateParameters{
+		Kty:           managedhsmdataplane.JSONWebKeyType(d.Get("key_type").(string)),
+		KeyOps:        &keyOptions,
+		KeyAttributes: attributes,
+		Tags:          tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	switch parameters.Kty {
+	case managedhsmdataplane.ECHSM:
+		parameters.Curve = managedhsmdataplane.JSONWebKeyCurveName(d.Get("curve").(string))
+	case managedhsmdataplane.OctHSM, managedhsmdataplane.RSAHSM:
+		keySize, ok := d.GetOk("key_size")
+		if !ok {
+			return fmt.Errorf("`key_size` is required when `key_type` is %q", string(parameters.Kty))
+		}
+		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+	}
+
+	if resp, err := client.CreateKey(ctx, *baseUri, name, parameters); err != nil {
+		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeys || !utils.ResponseWasConflict(resp.Response) {
+			return fmt.Errorf("creating Key %q (%s): %+v", name, *managedHSMId, err)
+		}
+
+		log.Printf("[DEBUG] Recovering Key %q (%s)", name, *managedHSMId)
+		if _, err := client.RecoverDeletedKey(ctx, *baseUri, name); err != nil {
+			return fmt.Errorf("recovering Key %q (%s): %+v", name, *managedHSMId, err)
+		}
+
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:                   []string{"pending"},
+			Target:                    []string{"available"},
+			Refresh:                   managedHSMKeyRefreshFunc(ctx, client, *baseUri, name),
+			Delay:                     30 * time.Second,
+			PollInterval:              10 * time.Second,
+			ContinuousTargetOccurence: 10,
+			Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for Key %q (%s) to become available: %+v", name, *managedHSMId, err)
+		}
+	}
+
+	if v := d.Get("rotation_policy").([]interface{}); len(v) > 0 {
+		if _, err := client.UpdateKeyRotationPolicy(ctx, *baseUri, name, expandManagedHSMKeyRotationPolicy(v)); err != nil {
+			return fmt.Errorf("setting the Rotation Policy for Key %q (%s): %+v", name, *managedHSMId, err)
+		}
+	}
+
+	// "" indicates the latest version
+	read, err := client.GetKey(ctx, *baseUri, name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Key %q (%s): %+v", name, *managedHSMId, err)
+	}
+	if read.Key == nil || read.Key.Kid == nil {
+		return fmt.Errorf("retrieving Key %q (%s): `kid` was nil", name, *managedHSMId)
+	}
+
+	d.SetId(*read.Key.Kid)
+
+	return resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("key_opts", "not_before_date", "expiration_date", "tags", "tags_all") {
+		keyOptions := expandManagedHSMKeyOptions(d.Get("key_opts").([]interface{}))
+		attributes, err := expandManagedHSMKeyAttributes(d)
+		if err != nil {
+			return err
+		}
+
+		parameters := managedhsmdataplane.KeyUpdateParameters{
+			KeyOps:        &keyOptions,
+			KeyAttributes: attributes,
+			Tags:          tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+			return fmt.Errorf("updating Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	if d.HasChange("rotation_policy") {
+		// removing the block resets the policy to one which neither expires nor rotates the key
+		policy := expandManagedHSMKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))
+		if _, err := client.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, policy); err != nil {
+			return fmt.Errorf("updating the Rotation Policy for Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	return resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHSMIdRaw, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID for the Managed HSM at URL %q: %+v", id.KeyVaultBaseUrl, err)
+	}
+	if managedHSMIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Key %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", *managedHSMIdRaw)
+
+	if key := resp.Key; key != nil {
+		d.Set("key_type", string(key.Kty))
+		d.Set("key_opts", utils.FlattenStringSlice(key.KeyOps))
+		d.Set("curve", string(key.Crv))
+
+		// the size of an `oct-HSM` key isn't returned, so the value from the config is retained
+		if key.N != nil {
+			nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
+			if err != nil {
+				return fmt.Errorf("decoding N: %+v", err)
+			}
+			d.Set("key_size", len(nBytes)*8)
+		}
+	}
+
+	notBeforeDate := ""
+	expirationDate := ""
+	if attributes := resp.Attributes; attributes != nil {
+		if v := attributes.NotBefore; v != nil {
+			notBeforeDate = time.Time(*v).Format(time.RFC3339)
+		}
+		if v := attributes.Expires; v != nil {
+			expirationDate = time.Time(*v).Format(time.RFC3339)
+		}
+	}
+	d.Set("not_before_date", notBeforeDate)
+	d.Set("expiration_date", expirationDate)
+
+	policy, err := client.GetKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Rotation Policy for Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if err := d.Set("rotation_policy", flattenManagedHSMKeyRotationPolicy(policy)); err != nil {
+		return fmt.Errorf("setting `rotation_policy`: %+v", err)
+	}
+
+	d.Set("version", id.Version)
+	d.Set("versionless_id", id.VersionlessID())
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	shouldPurge := meta.(*clients.Client).Features.KeyVault.PurgeSoftDeletedKeysOnDestroy
+	description := fmt.Sprintf("Key %q (Managed HSM %q)", id.Name, id.KeyVaultBaseUrl)
+	deleter := deleteAndPurgeManagedHSMKey{
+		client:        client,
+		managedHSMUri: id.KeyVaultBaseUrl,
+		name:          id.Name,
+	}
+	if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ deleteAndPurgeNestedItem = deleteAndPurgeManagedHSMKey{}
+
+type deleteAndPurgeManagedHSMKey struct {
+	client        *managedhsmdataplane.BaseClient
+	managedHSMUri string
+	name          string
+}
+
+func (d deleteAndPurgeManagedHSMKey) DeleteNestedItem(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.DeleteKey(ctx, d.managedHSMUri, d.name)
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeManagedHSMKey) NestedItemHasBeenDeleted(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetKey(ctx, d.managedHSMUri, d.name, "")
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeManagedHSMKey) PurgeNestedItem(ctx context.Context) (autorest.Response, error) {
+	return d.client.PurgeDeletedKey(ctx, d.managedHSMUri, d.name)
+}
+
+func (d deleteAndPurgeManagedHSMKey) NestedItemHasBeenPurged(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetDeletedKey(ctx, d.managedHSMUri, d.name)
+	return resp.Response, err
+}
+
+func managedHSMKeyRefreshFunc(ctx context.Context, client *managedhsmdataplane.BaseClient, managedHSMUri, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetKey(ctx, managedHSMUri, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "pending", nil
+			}
+			return nil, "", err
+		}
+
+		return resp, "available", nil
+	}
+}
+
+func expandManagedHSMKeyOptions(input []interface{}) []managedhsmdataplane.JSONWebKeyOperation {
+	results := make([]managedhsmdataplane.JSONWebKeyOperation, 0)
+	for _, option := range input {
+		results = append(results, managedhsmdataplane.JSONWebKeyOperation(option.(string)))
+	}
+
+	return results
+}
+
+func expandManagedHSMKeyAttributes(d *pluginsdk.ResourceData) (*managedhsmdataplane.KeyAttributes, error) {
+	attributes := &managedhsmdataplane.KeyAttributes{
+		Enabled: utils.Bool(true),
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `not_before_date`: %+v", err)
+		}
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		attributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `expiration_date`: %+v", err)
+		}
+		expirationUnixTime := date.UnixTime(expirationDate)
+		attributes.Expires = &expirationUnixTime
+	}
+
+	return attributes, nil
+}
+
+func expandManagedHSMKeyRotationPolicy(input []interface{}) managedhsmdataplane.KeyRotationPolicy {
+	lifetimeActions := make([]managedhsmdataplane.LifetimeAction, 0)
+	policy := managedhsmdataplane.KeyRotationPolicy{
+		LifetimeActions: &lifetimeActions,
+		Attributes:      &managedhsmdataplane.KeyRotationPolicyAttributes{},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return policy
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v := raw["expire_after"].(string); v != "" {
+		policy.Attributes.ExpiryTime = utils.String(v)
+	}
+
+	if automatic := raw["automatic"].([]interface{}); len(automatic) > 0 && automatic[0] != nil {
+		autoRaw := automatic[0].(map[string]interface{})
+		trigger := managedhsmdataplane.LifetimeActionTrigger{}
+		if v := autoRaw["time_after_creation"].(string); v != "" {
+			trigger.TimeAfterCreate = utils.String(v)
+		}
+		if v := autoRaw["time_before_expiry"].(string); v != "" {
+			trigger.TimeBeforeExpiry = utils.String(v)
+		}
+
+		lifetimeActions = append(lifetimeActions, managedhsmdataplane.LifetimeAction{
+			Trigger: &trigger,
+			Action: &managedhsmdataplane.LifetimeActionType{
+				Type: managedhsmdataplane.KeyRotationPolicyActionRotate,
+			},
+		})
+	}
+
+	return policy
+}
+
+func flattenManagedHSMKeyRotationPolicy(input managedhsmdataplane.KeyRotationPolicy) []interface{} {
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Action.Type != managedhsmdataplane.KeyRotationPolicyActionRotate || action.Trigger == nil {
+				continue
+			}
+
+			timeAfterCreation := ""
+			if action.Trigger.TimeAfterCreate != nil {
+				timeAfterCreation = *action.Trigger.TimeAfterCreate
+			}
+			timeBeforeExpiry := ""
+			if action.Trigger.TimeBeforeExpiry != nil {
+				timeBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+			}
+
+			automatic = append(automatic, map[string]interface{}{
+				"time_after_creation": timeAfterCreation,
+				"time_before_expiry":  timeBeforeExpiry,
+			})
+		}
+	}
+
+	// an unconfigured policy is returned with neither an expiry nor any actions
+	if expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expire_after": expireAfter,
+			"automatic":    automatic,
+		},
+	}
+}
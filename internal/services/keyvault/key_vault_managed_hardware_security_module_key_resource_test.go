@@ -0,0 +1,243 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleKeyResource struct{}
+
+// NOTE: the data plane of a Managed HSM is only available once the HSM has been activated (by downloading
+// the Security Domain), which can't be done from Terraform - as such these tests require an existing,
+// activated Managed HSM which is specified via the `ARM_TEST_MANAGED_HSM_ID` environment variable.
+
+func TestAccKeyVaultManagedHardwareSecurityModuleKey_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleKey_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleKey_rotationPolicy(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotationPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotationPolicyUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleKey_complete(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmDataPlaneClient.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Key != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestHSMKey-%s"
+  managed_hsm_id = %q
+  key_type       = "EC-HSM"
+  curve          = "P-256"
+  key_opts       = ["sign", "verify"]
+}
+`, data.RandomString, os.Getenv("ARM_TEST_MANAGED_HSM_ID"))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "import" {
+  name           = azurerm_key_vault_managed_hardware_security_module_key.test.name
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module_key.test.managed_hsm_id
+  key_type       = azurerm_key_vault_managed_hardware_security_module_key.test.key_type
+  curve          = azurerm_key_vault_managed_hardware_security_module_key.test.curve
+  key_opts       = azurerm_key_vault_managed_hardware_security_module_key.test.key_opts
+}
+`, r.basic(data))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) rotationPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestHSMKey-%s"
+  managed_hsm_id = %q
+  key_type       = "EC-HSM"
+  curve          = "P-256"
+  key_opts       = ["sign", "verify"]
+
+  rotation_policy {
+    expire_after = "P90D"
+
+    automatic {
+      time_before_expiry = "P30D"
+    }
+  }
+}
+`, data.RandomString, os.Getenv("ARM_TEST_MANAGED_HSM_ID"))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) rotationPolicyUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestHSMKey-%s"
+  managed_hsm_id = %q
+  key_type       = "EC-HSM"
+  curve          = "P-256"
+  key_opts       = ["sign", "verify"]
+
+  rotation_policy {
+    expire_after = "P180D"
+
+    automatic {
+      time_after_creation = "P60D"
+    }
+  }
+}
+`, data.RandomString, os.Getenv("ARM_TEST_MANAGED_HSM_ID"))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name            = "acctestHSMKey-%s"
+  managed_hsm_id  = %q
+  key_type        = "RSA-HSM"
+  key_size        = 2048
+  key_opts        = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+  not_before_date = "2021-01-01T01:02:03Z"
+  expiration_date = "2035-01-01T01:02:03Z"
+
+  rotation_policy {
+    expire_after = "P90D"
+
+    automatic {
+      time_before_expiry = "P30D"
+    }
+  }
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, data.RandomString, os.Getenv("ARM_TEST_MANAGED_HSM_ID"))
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/gofrs/uuid"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/2021-10-01/managedhsms"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -103,6 +105,7 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 
 func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).KeyVault.ManagedHsmClient
+	deletedClient := meta.(*clients.Client).KeyVault.DeletedManagedHsmsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -121,13 +124,36 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module", id.ID())
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if the Managed HSM exists in the soft delete state
+	deletedId := managedhsms.NewDeletedManagedHSMID(id.SubscriptionId, location, id.Name)
+	deleted, err := deletedClient.GetDeleted(ctx, deletedId)
+
+	// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+	softDeleted := !response.WasNotFound(deleted.HttpResponse) && !utils.ResponseWasForbidden(autorest.Response{Response: deleted.HttpResponse})
+	if err != nil && softDeleted {
+		return fmt.Errorf("checking for presence of an existing %s: %+v", deletedId, err)
+	}
+
+	// if so, does the user want us to recover it?
+	createMode := keyvault.CreateModeDefault
+	if softDeleted {
+		if !meta.(*clients.Client).Features.ManagedHSM.RecoverSoftDeleted {
+			// this exists but the users opted out so they must import this it out-of-band
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedManagedHSMErrorFmt(id.Name, location))
+		}
+
+		createMode = keyvault.CreateModeRecover
+	}
+
 	tenantId := uuid.FromStringOrNil(d.Get("tenant_id").(string))
 	hsm := keyvault.ManagedHsm{
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Location: utils.String(location),
 		Properties: &keyvault.ManagedHsmProperties{
 			TenantID:                  &tenantId,
 			InitialAdminObjectIds:     utils.ExpandStringSlice(d.Get("admin_object_ids").(*pluginsdk.Set).List()),
-			CreateMode:                keyvault.CreateModeDefault,
+			CreateMode:                createMode,
 			EnableSoftDelete:          utils.Bool(true),
 			SoftDeleteRetentionInDays: utils.Int32(int32(d.Get("soft_delete_retention_days").(int))),
 			EnablePurgeProtection:     utils.Bool(d.Get("purge_protection_enabled").(bool)),
@@ -200,6 +226,7 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleRead(d *pluginsdk.ResourceD
 
 func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).KeyVault.ManagedHsmClient
+	deletedClient := meta.(*clients.Client).KeyVault.DeletedManagedHsmsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,6 +235,15 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.Resourc
 		return err
 	}
 
+	// first we need to retrieve it, since we need the location to be able to purge it
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", id)
+	}
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
@@ -221,5 +257,40 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.Resourc
 				"waiting for deletion of API Management Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 		}
 	}
+
+	if !meta.(*clients.Client).Features.ManagedHSM.PurgeOnDestroy {
+		log.Printf("[DEBUG] Skipping Purge of %s", id)
+		return nil
+	}
+
+	// Managed HSMs with Purge Protection Enabled cannot be purged unless done by Azure
+	if props := existing.Properties; props != nil && props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+		log.Printf("[DEBUG] %s has Purge Protection Enabled and will be purged automatically by Azure", id)
+		return nil
+	}
+
+	deletedId := managedhsms.NewDeletedManagedHSMID(id.SubscriptionId, location.Normalize(*existing.Location), id.Name)
+	log.Printf("[DEBUG] Purging %s..", deletedId)
+	if err := deletedClient.PurgeDeletedThenPoll(ctx, deletedId); err != nil {
+		return fmt.Errorf("purging %s: %+v", deletedId, err)
+	}
+
 	return nil
 }
+
+func optedOutOfRecoveringSoftDeletedManagedHSMErrorFmt(name, location string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted Managed Hardware Security Module exists with the Name %q in the location %q,
+however automatically recovering this Managed Hardware Security Module has been disabled via the
+"features" block.
+
+Terraform can automatically recover the soft-deleted Managed Hardware Security Module when this
+behaviour is enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`, name, location)
+}
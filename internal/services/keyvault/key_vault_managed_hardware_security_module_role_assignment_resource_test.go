@@ -0,0 +1,168 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct{}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_builtInRole(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.builtInRole(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.builtInRole(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_customRoleKeyScope(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customRoleKeyScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmDataPlaneRoleAssignmentsClient.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) builtInRole(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "random_uuid" "test" {}
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = random_uuid.test.result
+  managed_hsm_id     = %q
+  scope              = "/keys"
+  role_definition_id = "/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b"
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, os.Getenv("ARM_TEST_MANAGED_HSM_ID"))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "import" {
+  name               = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.name
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.managed_hsm_id
+  scope              = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.scope
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.role_definition_id
+  principal_id       = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.principal_id
+}
+`, r.builtInRole(data))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) customRoleKeyScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "random_uuid" "definition" {}
+
+resource "random_uuid" "assignment" {}
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestHSMKey-%[2]s"
+  managed_hsm_id = %[1]q
+  key_type       = "EC-HSM"
+  curve          = "P-256"
+  key_opts       = ["sign", "verify"]
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = random_uuid.definition.result
+  managed_hsm_id = %[1]q
+  role_name      = "acctest-hsm-role-%[2]s"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+      "Microsoft.KeyVault/managedHsm/keys/sign/action",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = random_uuid.assignment.result
+  managed_hsm_id     = %[1]q
+  scope              = "/keys/${azurerm_key_vault_managed_hardware_security_module_key.test.name}"
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, os.Getenv("ARM_TEST_MANAGED_HSM_ID"), data.RandomString)
+}
@@ -0,0 +1,276 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.4/managedhsmdataplane"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// custom Role Definitions can only be created at the root scope of a Managed HSM
+const managedHSMRoleDefinitionScope = "/"
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead,
+		Update: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedHSMRoleDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"role_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"permission": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"not_actions": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"not_data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleDefinitionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managedHSMId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	baseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHSMId)
+	if err != nil {
+		return fmt.Errorf("looking up the Data Plane URI for %s: %+v", *managedHSMId, err)
+	}
+
+	id, err := parse.NewManagedHSMRoleNestedItemID(*baseUri, managedHSMRoleDefinitionScope, parse.ManagedHSMRoleDefinitionType, d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_role_definition", id.ID())
+		}
+	}
+
+	parameters := managedhsmdataplane.RoleDefinitionCreateParameters{
+		Properties: &managedhsmdataplane.RoleDefinitionProperties{
+			RoleName:         utils.String(d.Get("role_name").(string)),
+			Description:      utils.String(d.Get("description").(string)),
+			RoleType:         managedhsmdataplane.CustomRole,
+			Permissions:      expandManagedHSMRoleDefinitionPermissions(d.Get("permission").([]interface{})),
+			AssignableScopes: &[]string{managedHSMRoleDefinitionScope},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleDefinitionsClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHSMIdRaw, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.ManagedHSMBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID for the Managed HSM at URL %q: %+v", id.ManagedHSMBaseUrl, err)
+	}
+	if managedHSMIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.ManagedHSMBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Role Definition %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.ManagedHSMBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", *managedHSMIdRaw)
+	d.Set("resource_manager_id", resp.ID)
+
+	if props := resp.Properties; props != nil {
+		d.Set("role_name", props.RoleName)
+		d.Set("description", props.Description)
+
+		if err := d.Set("permission", flattenManagedHSMRoleDefinitionPermissions(props.Permissions)); err != nil {
+			return fmt.Errorf("setting `permission`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmDataPlaneRoleDefinitionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func expandManagedHSMRoleDefinitionPermissions(input []interface{}) *[]managedhsmdataplane.Permission {
+	permissions := make([]managedhsmdataplane.Permission, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		permissions = append(permissions, managedhsmdataplane.Permission{
+			Actions:        utils.ExpandStringSlice(raw["actions"].([]interface{})),
+			NotActions:     utils.ExpandStringSlice(raw["not_actions"].([]interface{})),
+			DataActions:    utils.ExpandStringSlice(raw["data_actions"].(*pluginsdk.Set).List()),
+			NotDataActions: utils.ExpandStringSlice(raw["not_data_actions"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &permissions
+}
+
+func flattenManagedHSMRoleDefinitionPermissions(input *[]managedhsmdataplane.Permission) []interface{} {
+	permissions := make([]interface{}, 0)
+	if input == nil {
+		return permissions
+	}
+
+	for _, permission := range *input {
+		permissions = append(permissions, map[string]interface{}{
+			"actions":          utils.FlattenStringSlice(permission.Actions),
+			"not_actions":      utils.FlattenStringSlice(permission.NotActions),
+			"data_actions":     utils.FlattenStringSlice(permission.DataActions),
+			"not_data_actions": utils.FlattenStringSlice(permission.NotDataActions),
+		})
+	}
+
+	return permissions
+}
@@ -0,0 +1,90 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+const (
+	ManagedHSMRoleAssignmentType = "roleAssignments"
+	ManagedHSMRoleDefinitionType = "roleDefinitions"
+)
+
+var _ resourceid.Formatter = ManagedHSMRoleNestedItemId{}
+
+// ManagedHSMRoleNestedItemId is the Data Plane ID of a Role Assignment or Role Definition within a Managed HSM
+type ManagedHSMRoleNestedItemId struct {
+	ManagedHSMBaseUrl string
+	Scope             string
+	NestedItemType    string
+	Name              string
+}
+
+func NewManagedHSMRoleNestedItemID(managedHSMBaseUrl, scope, nestedItemType, name string) (*ManagedHSMRoleNestedItemId, error) {
+	managedHSMUrl, err := url.Parse(managedHSMBaseUrl)
+	if err != nil || managedHSMBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", managedHSMBaseUrl, err)
+	}
+
+	return &ManagedHSMRoleNestedItemId{
+		ManagedHSMBaseUrl: fmt.Sprintf("%s://%s/", managedHSMUrl.Scheme, managedHSMUrl.Host),
+		Scope:             scope,
+		NestedItemType:    nestedItemType,
+		Name:              name,
+	}, nil
+}
+
+func (id ManagedHSMRoleNestedItemId) ID() string {
+	// example: https://the-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000
+	scope := strings.TrimSuffix(id.Scope, "/")
+	return fmt.Sprintf("%s%s/providers/Microsoft.Authorization/%s/%s", strings.TrimSuffix(id.ManagedHSMBaseUrl, "/"), scope, id.NestedItemType, id.Name)
+}
+
+// ManagedHSMRoleAssignmentID parses the Data Plane ID of a Role Assignment within a Managed HSM
+func ManagedHSMRoleAssignmentID(input string) (*ManagedHSMRoleNestedItemId, error) {
+	return parseManagedHSMRoleNestedItemId(input, ManagedHSMRoleAssignmentType)
+}
+
+// ManagedHSMRoleDefinitionID parses the Data Plane ID of a Role Definition within a Managed HSM
+func ManagedHSMRoleDefinitionID(input string) (*ManagedHSMRoleNestedItemId, error) {
+	return parseManagedHSMRoleNestedItemId(input, ManagedHSMRoleDefinitionType)
+}
+
+func parseManagedHSMRoleNestedItemId(input, nestedItemType string) (*ManagedHSMRoleNestedItemId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	separator := "/providers/Microsoft.Authorization/"
+	index := strings.Index(idURL.Path, separator)
+	if index == -1 {
+		return nil, fmt.Errorf("expected %q to contain %q", input, separator)
+	}
+
+	scope := idURL.Path[:index]
+	if scope == "" {
+		scope = "/"
+	}
+
+	components := strings.Split(strings.TrimSuffix(idURL.Path[index+len(separator):], "/"), "/")
+	if len(components) != 2 {
+		return nil, fmt.Errorf("expected %q to end with `%s{type}/{name}`", input, separator)
+	}
+	if components[0] != nestedItemType {
+		return nil, fmt.Errorf("expected %q to be a %q but got %q", input, nestedItemType, components[0])
+	}
+	if components[1] == "" {
+		return nil, fmt.Errorf("expected %q to contain a name", input)
+	}
+
+	return &ManagedHSMRoleNestedItemId{
+		ManagedHSMBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Scope:             scope,
+		NestedItemType:    nestedItemType,
+		Name:              components[1],
+	}, nil
+}
@@ -0,0 +1,129 @@
+package parse
+
+import "testing"
+
+func TestManagedHSMRoleAssignmentID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    ManagedHSMRoleNestedItemId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000/extra",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			ExpectError: false,
+			Expected: ManagedHSMRoleNestedItemId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/",
+				NestedItemType:    ManagedHSMRoleAssignmentType,
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			ExpectError: false,
+			Expected: ManagedHSMRoleNestedItemId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys",
+				NestedItemType:    ManagedHSMRoleAssignmentType,
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/keys/key1/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			ExpectError: false,
+			Expected: ManagedHSMRoleNestedItemId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys/key1",
+				NestedItemType:    ManagedHSMRoleAssignmentType,
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := ManagedHSMRoleAssignmentID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if tc.Expected != *id {
+			t.Fatalf("Expected %+v but got %+v for ID '%s'", tc.Expected, *id, tc.Input)
+		}
+
+		if tc.Input != id.ID() {
+			t.Fatalf("Expected 'ID()' to be '%s', got '%s'", tc.Input, id.ID())
+		}
+	}
+}
+
+func TestManagedHSMRoleDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    ManagedHSMRoleNestedItemId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			ExpectError: false,
+			Expected: ManagedHSMRoleNestedItemId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/",
+				NestedItemType:    ManagedHSMRoleDefinitionType,
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := ManagedHSMRoleDefinitionID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if tc.Expected != *id {
+			t.Fatalf("Expected %+v but got %+v for ID '%s'", tc.Expected, *id, tc.Input)
+		}
+
+		if tc.Input != id.ID() {
+			t.Fatalf("Expected 'ID()' to be '%s', got '%s'", tc.Input, id.ID())
+		}
+	}
+}
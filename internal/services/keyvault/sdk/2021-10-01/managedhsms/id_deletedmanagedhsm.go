@@ -0,0 +1,124 @@
+package managedhsms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = DeletedManagedHSMId{}
+
+// DeletedManagedHSMId is a struct representing the Resource ID for a Deleted Managed HSM
+type DeletedManagedHSMId struct {
+	SubscriptionId        string
+	Location              string
+	DeletedManagedHSMName string
+}
+
+// NewDeletedManagedHSMID returns a new DeletedManagedHSMId struct
+func NewDeletedManagedHSMID(subscriptionId string, location string, deletedManagedHSMName string) DeletedManagedHSMId {
+	return DeletedManagedHSMId{
+		SubscriptionId:        subscriptionId,
+		Location:              location,
+		DeletedManagedHSMName: deletedManagedHSMName,
+	}
+}
+
+// ParseDeletedManagedHSMID parses 'input' into a DeletedManagedHSMId
+func ParseDeletedManagedHSMID(input string) (*DeletedManagedHSMId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DeletedManagedHSMId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DeletedManagedHSMId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.Location, ok = parsed.Parsed["location"]; !ok {
+		return nil, fmt.Errorf("the segment 'location' was not found in the resource id %q", input)
+	}
+
+	if id.DeletedManagedHSMName, ok = parsed.Parsed["deletedManagedHSMName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deletedManagedHSMName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseDeletedManagedHSMIDInsensitively parses 'input' case-insensitively into a DeletedManagedHSMId
+// note: this method should only be used for API response data and not user input
+func ParseDeletedManagedHSMIDInsensitively(input string) (*DeletedManagedHSMId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DeletedManagedHSMId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DeletedManagedHSMId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.Location, ok = parsed.Parsed["location"]; !ok {
+		return nil, fmt.Errorf("the segment 'location' was not found in the resource id %q", input)
+	}
+
+	if id.DeletedManagedHSMName, ok = parsed.Parsed["deletedManagedHSMName"]; !ok {
+		return nil, fmt.Errorf("the segment 'deletedManagedHSMName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateDeletedManagedHSMID checks that 'input' can be parsed as a Deleted Managed HSM ID
+func ValidateDeletedManagedHSMID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDeletedManagedHSMID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Deleted Managed HSM ID
+func (id DeletedManagedHSMId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.KeyVault/locations/%s/deletedManagedHSMs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Location, id.DeletedManagedHSMName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Deleted Managed HSM ID
+func (id DeletedManagedHSMId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault", "Microsoft.KeyVault"),
+		resourceids.StaticSegment("staticLocations", "locations", "locations"),
+		resourceids.UserSpecifiedSegment("location", "locationValue"),
+		resourceids.StaticSegment("staticDeletedManagedHSMs", "deletedManagedHSMs", "deletedManagedHSMs"),
+		resourceids.UserSpecifiedSegment("deletedManagedHSMName", "deletedManagedHSMValue"),
+	}
+}
+
+// String returns a human-readable description of this Deleted Managed HSM ID
+func (id DeletedManagedHSMId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Location: %q", id.Location),
+		fmt.Sprintf("Deleted Managed HSMName: %q", id.DeletedManagedHSMName),
+	}
+	return fmt.Sprintf("Deleted Managed HSM (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,9 @@
+package managedhsms
+
+import "fmt"
+
+const defaultApiVersion = "2021-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/managedhsms/%s", defaultApiVersion)
+}
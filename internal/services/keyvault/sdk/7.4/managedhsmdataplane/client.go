@@ -0,0 +1,47 @@
+// Package managedhsmdataplane implements the subset of the Key Vault data plane API version 7.4 which is used to
+// manage the Keys and the local role-based access control within a Managed HSM.
+package managedhsmdataplane
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// APIVersion is the version of the Key Vault data plane API used by this package.
+const APIVersion = "7.4"
+
+// BaseClient is the base client for the Managed HSM data plane.
+type BaseClient struct {
+	autorest.Client
+}
+
+// New creates an instance of the BaseClient client.
+func New() BaseClient {
+	return BaseClient{
+		Client: autorest.NewClientWithUserAgent(UserAgent()),
+	}
+}
+
+// RoleAssignmentsClient is the client for the Role Assignments within a Managed HSM.
+type RoleAssignmentsClient struct {
+	BaseClient
+}
+
+// NewRoleAssignmentsClient creates an instance of the RoleAssignmentsClient client.
+func NewRoleAssignmentsClient() RoleAssignmentsClient {
+	return RoleAssignmentsClient{New()}
+}
+
+// RoleDefinitionsClient is the client for the Role Definitions within a Managed HSM.
+type RoleDefinitionsClient struct {
+	BaseClient
+}
+
+// NewRoleDefinitionsClient creates an instance of the RoleDefinitionsClient client.
+func NewRoleDefinitionsClient() RoleDefinitionsClient {
+	return RoleDefinitionsClient{New()}
+}
+
+// UserAgent returns the UserAgent string to use when sending http.Requests.
+func UserAgent() string {
+	return "managedhsmdataplane/" + APIVersion
+}
@@ -0,0 +1,592 @@
+package managedhsmdataplane
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// CreateKey creates a new key within the Managed HSM, or a new version of an existing key.
+func (client BaseClient) CreateKey(ctx context.Context, vaultBaseURL string, keyName string, parameters KeyCreateParameters) (result KeyBundle, err error) {
+	req, err := client.CreateKeyPreparer(ctx, vaultBaseURL, keyName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "CreateKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "CreateKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "CreateKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateKeyPreparer prepares the CreateKey request.
+func (client BaseClient) CreateKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string, parameters KeyCreateParameters) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/create", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// CreateKeySender sends the CreateKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) CreateKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// CreateKeyResponder handles the response to the CreateKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) CreateKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// GetKey retrieves the specified version of a key, or the latest version when `keyVersion` is empty.
+func (client BaseClient) GetKey(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string) (result KeyBundle, err error) {
+	req, err := client.GetKeyPreparer(ctx, vaultBaseURL, keyName, keyVersion)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetKeyPreparer prepares the GetKey request.
+func (client BaseClient) GetKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name":    autorest.Encode("path", keyName),
+		"key-version": autorest.Encode("path", keyVersion),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/{key-version}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetKeySender sends the GetKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetKeyResponder handles the response to the GetKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// UpdateKey updates the attributes of the specified version of a key.
+func (client BaseClient) UpdateKey(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string, parameters KeyUpdateParameters) (result KeyBundle, err error) {
+	req, err := client.UpdateKeyPreparer(ctx, vaultBaseURL, keyName, keyVersion, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "UpdateKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "UpdateKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "UpdateKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateKeyPreparer prepares the UpdateKey request.
+func (client BaseClient) UpdateKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string, parameters KeyUpdateParameters) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name":    autorest.Encode("path", keyName),
+		"key-version": autorest.Encode("path", keyVersion),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/{key-version}", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateKeySender sends the UpdateKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateKeyResponder handles the response to the UpdateKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// DeleteKey deletes all versions of a key.
+func (client BaseClient) DeleteKey(ctx context.Context, vaultBaseURL string, keyName string) (result DeletedKeyBundle, err error) {
+	req, err := client.DeleteKeyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "DeleteKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.DeleteKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "DeleteKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.DeleteKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "DeleteKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// DeleteKeyPreparer prepares the DeleteKey request.
+func (client BaseClient) DeleteKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// DeleteKeySender sends the DeleteKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) DeleteKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// DeleteKeyResponder handles the response to the DeleteKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) DeleteKeyResponder(resp *http.Response) (result DeletedKeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// GetDeletedKey retrieves a soft-deleted key.
+func (client BaseClient) GetDeletedKey(ctx context.Context, vaultBaseURL string, keyName string) (result DeletedKeyBundle, err error) {
+	req, err := client.GetDeletedKeyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetDeletedKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetDeletedKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetDeletedKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetDeletedKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetDeletedKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetDeletedKeyPreparer prepares the GetDeletedKey request.
+func (client BaseClient) GetDeletedKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/deletedkeys/{key-name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetDeletedKeySender sends the GetDeletedKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetDeletedKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetDeletedKeyResponder handles the response to the GetDeletedKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetDeletedKeyResponder(resp *http.Response) (result DeletedKeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// PurgeDeletedKey permanently deletes a soft-deleted key.
+func (client BaseClient) PurgeDeletedKey(ctx context.Context, vaultBaseURL string, keyName string) (result autorest.Response, err error) {
+	req, err := client.PurgeDeletedKeyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "PurgeDeletedKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.PurgeDeletedKeySender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "PurgeDeletedKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.PurgeDeletedKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "PurgeDeletedKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// PurgeDeletedKeyPreparer prepares the PurgeDeletedKey request.
+func (client BaseClient) PurgeDeletedKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/deletedkeys/{key-name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// PurgeDeletedKeySender sends the PurgeDeletedKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) PurgeDeletedKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// PurgeDeletedKeyResponder handles the response to the PurgeDeletedKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) PurgeDeletedKeyResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// RecoverDeletedKey recovers a soft-deleted key.
+func (client BaseClient) RecoverDeletedKey(ctx context.Context, vaultBaseURL string, keyName string) (result KeyBundle, err error) {
+	req, err := client.RecoverDeletedKeyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "RecoverDeletedKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.RecoverDeletedKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "RecoverDeletedKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.RecoverDeletedKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "RecoverDeletedKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// RecoverDeletedKeyPreparer prepares the RecoverDeletedKey request.
+func (client BaseClient) RecoverDeletedKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/deletedkeys/{key-name}/recover", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// RecoverDeletedKeySender sends the RecoverDeletedKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) RecoverDeletedKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// RecoverDeletedKeyResponder handles the response to the RecoverDeletedKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) RecoverDeletedKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// GetKeyRotationPolicy retrieves the rotation policy for a key.
+func (client BaseClient) GetKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string) (result KeyRotationPolicy, err error) {
+	req, err := client.GetKeyRotationPolicyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetKeyRotationPolicySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetKeyRotationPolicy", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetKeyRotationPolicyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "GetKeyRotationPolicy", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetKeyRotationPolicyPreparer prepares the GetKeyRotationPolicy request.
+func (client BaseClient) GetKeyRotationPolicyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetKeyRotationPolicySender sends the GetKeyRotationPolicy request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetKeyRotationPolicySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetKeyRotationPolicyResponder handles the response to the GetKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetKeyRotationPolicyResponder(resp *http.Response) (result KeyRotationPolicy, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// UpdateKeyRotationPolicy sets the rotation policy for a key.
+func (client BaseClient) UpdateKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string, parameters KeyRotationPolicy) (result KeyRotationPolicy, err error) {
+	req, err := client.UpdateKeyRotationPolicyPreparer(ctx, vaultBaseURL, keyName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "UpdateKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateKeyRotationPolicySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateKeyRotationPolicyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedhsmdataplane.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateKeyRotationPolicyPreparer prepares the UpdateKeyRotationPolicy request.
+func (client BaseClient) UpdateKeyRotationPolicyPreparer(ctx context.Context, vaultBaseURL string, keyName string, parameters KeyRotationPolicy) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateKeyRotationPolicySender sends the UpdateKeyRotationPolicy request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateKeyRotationPolicySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateKeyRotationPolicyResponder handles the response to the UpdateKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateKeyRotationPolicyResponder(resp *http.Response) (result KeyRotationPolicy, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -0,0 +1,222 @@
+package managedhsmdataplane
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// JSONWebKeyType enumerates the values for the type of a Key within a Managed HSM.
+type JSONWebKeyType string
+
+const (
+	ECHSM  JSONWebKeyType = "EC-HSM"
+	OctHSM JSONWebKeyType = "oct-HSM"
+	RSAHSM JSONWebKeyType = "RSA-HSM"
+)
+
+// PossibleJSONWebKeyTypeValues returns an array of possible values for the JSONWebKeyType const type.
+func PossibleJSONWebKeyTypeValues() []JSONWebKeyType {
+	return []JSONWebKeyType{ECHSM, OctHSM, RSAHSM}
+}
+
+// JSONWebKeyCurveName enumerates the values for the elliptic curve of a Key.
+type JSONWebKeyCurveName string
+
+const (
+	P256  JSONWebKeyCurveName = "P-256"
+	P256K JSONWebKeyCurveName = "P-256K"
+	P384  JSONWebKeyCurveName = "P-384"
+	P521  JSONWebKeyCurveName = "P-521"
+)
+
+// PossibleJSONWebKeyCurveNameValues returns an array of possible values for the JSONWebKeyCurveName const type.
+func PossibleJSONWebKeyCurveNameValues() []JSONWebKeyCurveName {
+	return []JSONWebKeyCurveName{P256, P256K, P384, P521}
+}
+
+// JSONWebKeyOperation enumerates the values for the operations which can be performed using a Key.
+type JSONWebKeyOperation string
+
+const (
+	Decrypt   JSONWebKeyOperation = "decrypt"
+	Encrypt   JSONWebKeyOperation = "encrypt"
+	Export    JSONWebKeyOperation = "export"
+	Import    JSONWebKeyOperation = "import"
+	Sign      JSONWebKeyOperation = "sign"
+	UnwrapKey JSONWebKeyOperation = "unwrapKey"
+	Verify    JSONWebKeyOperation = "verify"
+	WrapKey   JSONWebKeyOperation = "wrapKey"
+)
+
+// PossibleJSONWebKeyOperationValues returns an array of possible values for the JSONWebKeyOperation const type.
+func PossibleJSONWebKeyOperationValues() []JSONWebKeyOperation {
+	return []JSONWebKeyOperation{Decrypt, Encrypt, Export, Import, Sign, UnwrapKey, Verify, WrapKey}
+}
+
+// KeyRotationPolicyAction enumerates the values for the action taken by a Lifetime Action.
+type KeyRotationPolicyAction string
+
+const (
+	KeyRotationPolicyActionNotify KeyRotationPolicyAction = "Notify"
+	KeyRotationPolicyActionRotate KeyRotationPolicyAction = "Rotate"
+)
+
+// RoleType enumerates the values for the type of a Role Definition.
+type RoleType string
+
+const (
+	BuiltInRole RoleType = "AKVBuiltInRole"
+	CustomRole  RoleType = "CustomRole"
+)
+
+// KeyAttributes the attributes of a Key.
+type KeyAttributes struct {
+	Enabled       *bool          `json:"enabled,omitempty"`
+	NotBefore     *date.UnixTime `json:"nbf,omitempty"`
+	Expires       *date.UnixTime `json:"exp,omitempty"`
+	Created       *date.UnixTime `json:"created,omitempty"`
+	Updated       *date.UnixTime `json:"updated,omitempty"`
+	RecoveryLevel *string        `json:"recoveryLevel,omitempty"`
+	Exportable    *bool          `json:"exportable,omitempty"`
+	HsmPlatform   *string        `json:"hsmPlatform,omitempty"`
+}
+
+// KeyCreateParameters the parameters used to create a Key.
+type KeyCreateParameters struct {
+	Kty           JSONWebKeyType         `json:"kty"`
+	KeySize       *int32                 `json:"key_size,omitempty"`
+	KeyOps        *[]JSONWebKeyOperation `json:"key_ops,omitempty"`
+	KeyAttributes *KeyAttributes         `json:"attributes,omitempty"`
+	Tags          map[string]*string     `json:"tags,omitempty"`
+	Curve         JSONWebKeyCurveName    `json:"crv,omitempty"`
+}
+
+// KeyUpdateParameters the parameters used to update the attributes of a Key.
+type KeyUpdateParameters struct {
+	KeyOps        *[]JSONWebKeyOperation `json:"key_ops,omitempty"`
+	KeyAttributes *KeyAttributes         `json:"attributes,omitempty"`
+	Tags          map[string]*string     `json:"tags,omitempty"`
+}
+
+// JSONWebKey a Key in the JSON Web Key format.
+type JSONWebKey struct {
+	Kid    *string             `json:"kid,omitempty"`
+	Kty    JSONWebKeyType      `json:"kty,omitempty"`
+	KeyOps *[]string           `json:"key_ops,omitempty"`
+	N      *string             `json:"n,omitempty"`
+	E      *string             `json:"e,omitempty"`
+	Crv    JSONWebKeyCurveName `json:"crv,omitempty"`
+	X      *string             `json:"x,omitempty"`
+	Y      *string             `json:"y,omitempty"`
+}
+
+// KeyBundle a Key along with its attributes.
+type KeyBundle struct {
+	autorest.Response `json:"-"`
+	Key               *JSONWebKey        `json:"key,omitempty"`
+	Attributes        *KeyAttributes     `json:"attributes,omitempty"`
+	Tags              map[string]*string `json:"tags"`
+	Managed           *bool              `json:"managed,omitempty"`
+}
+
+// DeletedKeyBundle a soft-deleted Key along with its attributes.
+type DeletedKeyBundle struct {
+	autorest.Response  `json:"-"`
+	RecoveryID         *string            `json:"recoveryId,omitempty"`
+	ScheduledPurgeDate *date.UnixTime     `json:"scheduledPurgeDate,omitempty"`
+	DeletedDate        *date.UnixTime     `json:"deletedDate,omitempty"`
+	Key                *JSONWebKey        `json:"key,omitempty"`
+	Attributes         *KeyAttributes     `json:"attributes,omitempty"`
+	Tags               map[string]*string `json:"tags"`
+}
+
+// KeyRotationPolicy the rotation policy of a Key.
+type KeyRotationPolicy struct {
+	autorest.Response `json:"-"`
+	ID                *string                      `json:"id,omitempty"`
+	LifetimeActions   *[]LifetimeAction            `json:"lifetimeActions,omitempty"`
+	Attributes        *KeyRotationPolicyAttributes `json:"attributes,omitempty"`
+}
+
+// KeyRotationPolicyAttributes the attributes of a Key Rotation Policy.
+type KeyRotationPolicyAttributes struct {
+	// ExpiryTime - the expiry time applied to new versions of the Key, as an ISO 8601 duration.
+	ExpiryTime *string        `json:"expiryTime,omitempty"`
+	Created    *date.UnixTime `json:"created,omitempty"`
+	Updated    *date.UnixTime `json:"updated,omitempty"`
+}
+
+// LifetimeAction an action, and the trigger which causes it to be performed.
+type LifetimeAction struct {
+	Trigger *LifetimeActionTrigger `json:"trigger,omitempty"`
+	Action  *LifetimeActionType    `json:"action,omitempty"`
+}
+
+// LifetimeActionTrigger the trigger of a Lifetime Action, as ISO 8601 durations.
+type LifetimeActionTrigger struct {
+	TimeAfterCreate  *string `json:"timeAfterCreate,omitempty"`
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
+
+// LifetimeActionType the type of a Lifetime Action.
+type LifetimeActionType struct {
+	Type KeyRotationPolicyAction `json:"type,omitempty"`
+}
+
+// Permission the actions which a Role Definition grants or denies.
+type Permission struct {
+	Actions        *[]string `json:"actions,omitempty"`
+	NotActions     *[]string `json:"notActions,omitempty"`
+	DataActions    *[]string `json:"dataActions,omitempty"`
+	NotDataActions *[]string `json:"notDataActions,omitempty"`
+}
+
+// RoleDefinitionProperties the properties of a Role Definition.
+type RoleDefinitionProperties struct {
+	RoleName         *string       `json:"roleName,omitempty"`
+	Description      *string       `json:"description,omitempty"`
+	RoleType         RoleType      `json:"type,omitempty"`
+	Permissions      *[]Permission `json:"permissions,omitempty"`
+	AssignableScopes *[]string     `json:"assignableScopes,omitempty"`
+}
+
+// RoleDefinition a Role Definition within a Managed HSM.
+type RoleDefinition struct {
+	autorest.Response `json:"-"`
+	ID                *string                   `json:"id,omitempty"`
+	Name              *string                   `json:"name,omitempty"`
+	Type              *string                   `json:"type,omitempty"`
+	Properties        *RoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+// RoleDefinitionCreateParameters the parameters used to create or update a Role Definition.
+type RoleDefinitionCreateParameters struct {
+	Properties *RoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+// RoleAssignmentProperties the properties of a Role Assignment.
+type RoleAssignmentProperties struct {
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	PrincipalID      *string `json:"principalId,omitempty"`
+}
+
+// RoleAssignmentPropertiesWithScope the properties of a Role Assignment, including its scope.
+type RoleAssignmentPropertiesWithScope struct {
+	Scope            *string `json:"scope,omitempty"`
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	PrincipalID      *string `json:"principalId,omitempty"`
+}
+
+// RoleAssignment a Role Assignment within a Managed HSM.
+type RoleAssignment struct {
+	autorest.Response `json:"-"`
+	ID                *string                            `json:"id,omitempty"`
+	Name              *string                            `json:"name,omitempty"`
+	Type              *string                            `json:"type,omitempty"`
+	Properties        *RoleAssignmentPropertiesWithScope `json:"properties,omitempty"`
+}
+
+// RoleAssignmentCreateParameters the parameters used to create a Role Assignment.
+type RoleAssignmentCreateParameters struct {
+	Properties *RoleAssignmentProperties `json:"properties,omitempty"`
+}
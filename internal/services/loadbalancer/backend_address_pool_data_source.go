@@ -57,6 +57,34 @@ func dataSourceArmLoadBalancerBackendAddressPool() *pluginsdk.Resource {
 				},
 			},
 
+			"tunnel_interface": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"identifier": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"backend_ip_configurations": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -116,6 +144,10 @@ func dataSourceArmLoadBalancerBackendAddressPoolRead(d *pluginsdk.ResourceData,
 			return fmt.Errorf("setting `backend_address`: %v", err)
 		}
 
+		if err := d.Set("tunnel_interface", flattenGatewayLoadBalancerTunnelInterfaces(props.TunnelInterfaces)); err != nil {
+			return fmt.Errorf("setting `tunnel_interface`: %v", err)
+		}
+
 		var backendIPConfigurations []interface{}
 		if beipConfigs := props.BackendIPConfigurations; beipConfigs != nil {
 			for _, config := range *beipConfigs {
@@ -76,6 +76,11 @@ func dataSourceArmLoadBalancer() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"gateway_load_balancer_frontend_ip_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"zones": azure.SchemaZonesComputed(),
 
 						"id": {
@@ -193,6 +198,10 @@ func flattenLoadBalancerDataSourceFrontendIpConfiguration(ipConfigs *[]network.F
 			if pip := props.PublicIPAddress; pip != nil && pip.ID != nil {
 				ipConfig["public_ip_address_id"] = *pip.ID
 			}
+
+			if gatewayLB := props.GatewayLoadBalancer; gatewayLB != nil && gatewayLB.ID != nil {
+				ipConfig["gateway_load_balancer_frontend_ip_configuration_id"] = *gatewayLB.ID
+			}
 		}
 
 		result = append(result, ipConfig)
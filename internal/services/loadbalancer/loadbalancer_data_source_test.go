@@ -25,6 +25,20 @@ func TestAccAzureRMDataSourceLoadBalancer_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDataSourceLoadBalancer_pointToGatewayLB(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_lb", "test")
+	d := LoadBalancer{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.dataSourcePointToGatewayLB(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("frontend_ip_configuration.0.gateway_load_balancer_frontend_ip_configuration_id").Exists(),
+			),
+		},
+	})
+}
+
 func (r LoadBalancer) dataSourceBasic(data acceptance.TestData) string {
 	resource := r.basic(data)
 	return fmt.Sprintf(`
@@ -36,3 +50,14 @@ data "azurerm_lb" "test" {
 }
 `, resource)
 }
+
+func (r LoadBalancer) dataSourcePointToGatewayLB(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_lb" "test" {
+  name                = azurerm_lb.consumer.name
+  resource_group_name = azurerm_lb.consumer.resource_group_name
+}
+`, r.pointToGatewayLB(data))
+}
@@ -19,6 +19,7 @@ type LoadTestResource struct {
 }
 
 var _ sdk.ResourceWithUpdate = LoadTestResource{}
+var _ sdk.ResourceWithSubscriptionIdOverride = LoadTestResource{}
 
 type LoadTestResourceModel struct {
 	Name          string            `tfschema:"name"`
@@ -57,6 +58,8 @@ func (r LoadTestResource) ModelObject() interface{} {
 	return &LoadTestResourceModel{}
 }
 
+func (r LoadTestResource) SupportsSubscriptionIdOverride() {}
+
 func (r LoadTestResource) ResourceType() string {
 	return "azurerm_load_test"
 }
@@ -74,7 +77,7 @@ func (r LoadTestResource) Create() sdk.ResourceFunc {
 			}
 
 			client := metadata.Client.LoadTest.LoadTestsClient
-			subscriptionId := metadata.Client.Account.SubscriptionId
+			subscriptionId := metadata.SubscriptionId()
 			id := loadtests.NewLoadTestID(subscriptionId, model.ResourceGroup, model.Name)
 
 			existing, err := client.Get(ctx, id)
@@ -124,7 +127,7 @@ func (r LoadTestResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("reading Load Test %s: %v", id, err)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Model.Tags = &state.Tags
 			}
 
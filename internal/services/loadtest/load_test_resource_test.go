@@ -31,6 +31,26 @@ func TestLoadTest_basic(t *testing.T) {
 	})
 }
 
+func TestAccLoadTest_alternateSubscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test", "test")
+	r := LoadTestResource{}
+
+	if data.Client().SubscriptionIDAlt == "" {
+		t.Skip("Skipping since `ARM_SUBSCRIPTION_ID_ALT` is not specified")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.alternateSubscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(data.Client().SubscriptionIDAlt),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 // Exists func
 
 func (r LoadTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
@@ -73,6 +93,33 @@ resource "azurerm_load_test" "test" {
 `, r.baseTemplate(data), data.RandomInteger)
 }
 
+func (r LoadTestResource) alternateSubscription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azurerm" {
+  alias           = "alt"
+  subscription_id = "%[1]s"
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  provider = azurerm.alt
+  name     = "acctestRG-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_load_test" "test" {
+  name                = "acctestALT-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subscription_id     = "%[1]s"
+}
+`, data.Client().SubscriptionIDAlt, data.RandomInteger, data.Locations.Primary)
+}
+
 func (LoadTestResource) baseTemplate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 
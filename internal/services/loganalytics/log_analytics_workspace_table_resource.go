@@ -0,0 +1,397 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2023-01-01-preview/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// custom tables are identified by the `_CL` suffix on their name, all other tables are built in to the workspace
+const logAnalyticsCustomTableSuffix = "_CL"
+
+func resourceLogAnalyticsWorkspaceTable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceLogAnalyticsWorkspaceTableRead,
+		Update: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceLogAnalyticsWorkspaceTableDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := tables.ParseTableID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				isCustomTable := strings.HasSuffix(d.Get("name").(string), logAnalyticsCustomTableSuffix)
+				columns := d.Get("column").([]interface{})
+				if isCustomTable && len(columns) == 0 {
+					return fmt.Errorf("at least one `column` must be specified for custom tables")
+				}
+				if !isCustomTable && (len(columns) > 0 || d.Get("description").(string) != "") {
+					return fmt.Errorf("`column` and `description` can only be specified for custom tables, whose `name` must end with %q", logAnalyticsCustomTableSuffix)
+				}
+
+				if plan := d.Get("plan").(string); plan != string(tables.TablePlanEnumAnalytics) {
+					if raw := d.GetRawConfig().GetAttr("retention_in_days"); !raw.IsNull() {
+						return fmt.Errorf("`retention_in_days` cannot be specified when `plan` is %q, since the interactive retention of the plan is fixed", plan)
+					}
+				}
+
+				if plan := d.Get("plan").(string); plan == string(tables.TablePlanEnumAuxiliary) && !isCustomTable {
+					return fmt.Errorf("`plan` can only be %q for custom tables", plan)
+				}
+
+				return nil
+			},
+		),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceID,
+			},
+
+			"plan": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(tables.TablePlanEnumAnalytics),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(tables.TablePlanEnumAnalytics),
+					string(tables.TablePlanEnumAuxiliary),
+					string(tables.TablePlanEnumBasic),
+				}, false),
+			},
+
+			"retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(4, 730),
+			},
+
+			"total_retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(4, 4383),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"column": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(tables.ColumnTypeEnumBoolean),
+								string(tables.ColumnTypeEnumDateTime),
+								string(tables.ColumnTypeEnumDynamic),
+								string(tables.ColumnTypeEnumGuid),
+								string(tables.ColumnTypeEnumInt),
+								string(tables.ColumnTypeEnumLong),
+								string(tables.ColumnTypeEnumReal),
+								string(tables.ColumnTypeEnumString),
+							}, false),
+						},
+
+						"data_type_hint": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(tables.ColumnDataTypeHintEnumArmPath),
+								string(tables.ColumnDataTypeHintEnumGuid),
+								string(tables.ColumnDataTypeHintEnumIp),
+								string(tables.ColumnDataTypeHintEnumUri),
+							}, false),
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"display_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"archive_retention_in_days": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLogAnalyticsWorkspaceTableCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.LogAnalyticsWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, d.Get("name").(string))
+	isCustomTable := strings.HasSuffix(id.TableName, logAnalyticsCustomTableSuffix)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		// built-in tables always exist within the workspace, so only custom tables can conflict with an existing resource
+		if isCustomTable && !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_log_analytics_workspace_table", id.ID())
+		}
+		if !isCustomTable && response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found - only custom tables (with a name ending in %q) can be created", id, logAnalyticsCustomTableSuffix)
+		}
+	}
+
+	plan := tables.TablePlanEnum(d.Get("plan").(string))
+	properties := tables.TableProperties{
+		Plan: &plan,
+	}
+
+	if v, ok := d.GetOk("retention_in_days"); ok && plan == tables.TablePlanEnumAnalytics {
+		properties.RetentionInDays = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("total_retention_in_days"); ok {
+		properties.TotalRetentionInDays = utils.Int64(int64(v.(int)))
+	}
+
+	if isCustomTable {
+		properties.Schema = &tables.Schema{
+			Name:    utils.String(id.TableName),
+			Columns: expandLogAnalyticsWorkspaceTableColumns(d.Get("column").([]interface{})),
+		}
+		if v, ok := d.GetOk("description"); ok {
+			properties.Schema.Description = utils.String(v.(string))
+		}
+
+		if err := client.CreateOrUpdateThenPoll(ctx, id, tables.Table{Properties: &properties}); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+	} else {
+		if err := client.UpdateThenPoll(ctx, id, tables.Table{Properties: &properties}); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceLogAnalyticsWorkspaceTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TableName)
+	d.Set("workspace_id", parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			plan := string(tables.TablePlanEnumAnalytics)
+			if props.Plan != nil {
+				plan = string(*props.Plan)
+			}
+			d.Set("plan", plan)
+
+			retentionInDays := 0
+			if props.RetentionInDays != nil {
+				retentionInDays = int(*props.RetentionInDays)
+			}
+			d.Set("retention_in_days", retentionInDays)
+
+			totalRetentionInDays := 0
+			if props.TotalRetentionInDays != nil {
+				totalRetentionInDays = int(*props.TotalRetentionInDays)
+			}
+			d.Set("total_retention_in_days", totalRetentionInDays)
+
+			archiveRetentionInDays := 0
+			if props.ArchiveRetentionInDays != nil {
+				archiveRetentionInDays = int(*props.ArchiveRetentionInDays)
+			}
+			d.Set("archive_retention_in_days", archiveRetentionInDays)
+
+			description := ""
+			var columns *[]tables.Column
+			if schema := props.Schema; schema != nil && strings.HasSuffix(id.TableName, logAnalyticsCustomTableSuffix) {
+				description = utils.NormalizeNilableString(schema.Description)
+				columns = schema.Columns
+			}
+			d.Set("description", description)
+			if err := d.Set("column", flattenLogAnalyticsWorkspaceTableColumns(columns)); err != nil {
+				return fmt.Errorf("setting `column`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if strings.HasSuffix(id.TableName, logAnalyticsCustomTableSuffix) {
+		if err := client.DeleteThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+
+		return nil
+	}
+
+	// built-in tables can't be deleted, instead the retention is reset to the workspace defaults (signified by `-1`)
+	parameters := tables.Table{
+		Properties: &tables.TableProperties{
+			RetentionInDays:      utils.Int64(-1),
+			TotalRetentionInDays: utils.Int64(-1),
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("resetting the retention for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandLogAnalyticsWorkspaceTableColumns(input []interface{}) *[]tables.Column {
+	results := make([]tables.Column, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		columnType := tables.ColumnTypeEnum(v["type"].(string))
+		column := tables.Column{
+			Name: utils.String(v["name"].(string)),
+			Type: &columnType,
+		}
+
+		if hint := v["data_type_hint"].(string); hint != "" {
+			dataTypeHint := tables.ColumnDataTypeHintEnum(hint)
+			column.DataTypeHint = &dataTypeHint
+		}
+
+		if description := v["description"].(string); description != "" {
+			column.Description = utils.String(description)
+		}
+
+		if displayName := v["display_name"].(string); displayName != "" {
+			column.DisplayName = utils.String(displayName)
+		}
+
+		results = append(results, column)
+	}
+
+	return &results
+}
+
+func flattenLogAnalyticsWorkspaceTableColumns(input *[]tables.Column) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		columnType := ""
+		if item.Type != nil {
+			columnType = string(*item.Type)
+		}
+
+		dataTypeHint := ""
+		if item.DataTypeHint != nil {
+			dataTypeHint = string(*item.DataTypeHint)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           utils.NormalizeNilableString(item.Name),
+			"type":           columnType,
+			"data_type_hint": dataTypeHint,
+			"description":    utils.NormalizeNilableString(item.Description),
+			"display_name":   utils.NormalizeNilableString(item.DisplayName),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,12 @@
+package tables
+
+type Schema struct {
+	Columns         *[]Column         `json:"columns,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	DisplayName     *string           `json:"displayName,omitempty"`
+	Name            *string           `json:"name,omitempty"`
+	Solutions       *[]string         `json:"solutions,omitempty"`
+	StandardColumns *[]Column         `json:"standardColumns,omitempty"`
+	TableSubType    *TableSubTypeEnum `json:"tableSubType,omitempty"`
+	TableType       *TableTypeEnum    `json:"tableType,omitempty"`
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -157,9 +158,23 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 
 			"storage_account_access_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{
+					"storage_account_access_key",
+					"storage_uses_managed_identity",
+				},
+			},
+
+			"storage_uses_managed_identity": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+				ExactlyOneOf: []string{
+					"storage_account_access_key",
+					"storage_uses_managed_identity",
+				},
 			},
 
 			"storage_account_share_name": {
@@ -174,6 +189,12 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 				Default:  "~3",
 			},
 
+			"virtual_network_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed Only
@@ -305,6 +326,10 @@ func resourceLogicAppStandardCreate(d *pluginsdk.ResourceData, meta interface{})
 		siteEnvelope.SiteProperties.ClientCertMode = web.ClientCertMode(clientCertMode)
 	}
 
+	if v, ok := d.GetOk("virtual_network_subnet_id"); ok {
+		siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(v.(string))
+	}
+
 	if _, ok := d.GetOk("identity"); ok {
 		appServiceIdentityRaw := d.Get("identity").([]interface{})
 		appServiceIdentity := expandLogicAppStandardIdentity(appServiceIdentityRaw)
@@ -392,6 +417,10 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 		siteEnvelope.SiteProperties.ClientCertMode = web.ClientCertMode(clientCertMode)
 	}
 
+	if v, ok := d.GetOk("virtual_network_subnet_id"); ok {
+		siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(v.(string))
+	}
+
 	if _, ok := d.GetOk("identity"); ok {
 		appServiceIdentityRaw := d.Get("identity").([]interface{})
 		appServiceIdentity := expandLogicAppStandardIdentity(appServiceIdentityRaw)
@@ -407,6 +436,15 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("waiting for the update of %s: %+v", id, err)
 	}
 
+	// omitting the subnet from the update doesn't remove the existing integration, so this needs to be removed explicitly
+	if d.HasChange("virtual_network_subnet_id") {
+		if _, ok := d.GetOk("virtual_network_subnet_id"); !ok {
+			if _, err := client.DeleteSwiftVirtualNetwork(ctx, id.ResourceGroup, id.SiteName); err != nil {
+				return fmt.Errorf("removing the Virtual Network integration for %s: %+v", *id, err)
+			}
+		}
+	}
+
 	settings := web.StringDictionary{
 		Properties: appSettings,
 	}
@@ -502,6 +540,7 @@ func resourceLogicAppStandardRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("possible_outbound_ip_addresses", props.PossibleOutboundIPAddresses)
 		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
 		d.Set("custom_domain_verification_id", props.CustomDomainVerificationID)
+		d.Set("virtual_network_subnet_id", props.VirtualNetworkSubnetID)
 
 		clientCertMode := ""
 		if props.ClientCertEnabled != nil && *props.ClientCertEnabled {
@@ -516,21 +555,28 @@ func resourceLogicAppStandardRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	connectionString := appSettings["AzureWebJobsStorage"]
+	if accountName, ok := appSettings["AzureWebJobsStorage__accountName"]; ok {
+		d.Set("storage_account_name", accountName)
+		d.Set("storage_uses_managed_identity", true)
+	} else {
+		d.Set("storage_uses_managed_identity", false)
 
-	// This teases out the necessary attributes from the storage connection string
-	connectionStringParts := strings.Split(connectionString, ";")
-	for _, part := range connectionStringParts {
-		if strings.HasPrefix(part, "AccountName") {
-			accountNameParts := strings.Split(part, "AccountName=")
-			if len(accountNameParts) > 1 {
-				d.Set("storage_account_name", accountNameParts[1])
+		connectionString := appSettings["AzureWebJobsStorage"]
+
+		// This teases out the necessary attributes from the storage connection string
+		connectionStringParts := strings.Split(connectionString, ";")
+		for _, part := range connectionStringParts {
+			if strings.HasPrefix(part, "AccountName") {
+				accountNameParts := strings.Split(part, "AccountName=")
+				if len(accountNameParts) > 1 {
+					d.Set("storage_account_name", accountNameParts[1])
+				}
 			}
-		}
-		if strings.HasPrefix(part, "AccountKey") {
-			accountKeyParts := strings.Split(part, "AccountKey=")
-			if len(accountKeyParts) > 1 {
-				d.Set("storage_account_access_key", accountKeyParts[1])
+			if strings.HasPrefix(part, "AccountKey") {
+				accountKeyParts := strings.Split(part, "AccountKey=")
+				if len(accountKeyParts) > 1 {
+					d.Set("storage_account_access_key", accountKeyParts[1])
+				}
 			}
 		}
 	}
@@ -557,6 +603,10 @@ func resourceLogicAppStandardRead(d *pluginsdk.ResourceData, meta interface{}) e
 	delete(appSettings, "AzureFunctionsJobHost__extensionBundle__version")
 	delete(appSettings, "AzureWebJobsDashboard")
 	delete(appSettings, "AzureWebJobsStorage")
+	delete(appSettings, "AzureWebJobsStorage__accountName")
+	delete(appSettings, "AzureWebJobsStorage__blobServiceUri")
+	delete(appSettings, "AzureWebJobsStorage__queueServiceUri")
+	delete(appSettings, "AzureWebJobsStorage__tableServiceUri")
 	delete(appSettings, "FUNCTIONS_EXTENSION_VERSION")
 	delete(appSettings, "WEBSITE_CONTENTSHARE")
 
@@ -615,21 +665,38 @@ func getBasicLogicAppSettings(d *pluginsdk.ResourceData, endpointSuffix string)
 	appKindPropValue := "workflowApp"
 
 	storageAccount := d.Get("storage_account_name").(string)
-	accountKey := d.Get("storage_account_access_key").(string)
-	storageConnection := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", storageAccount, accountKey, endpointSuffix)
 	functionVersion := d.Get("version").(string)
 
-	contentShare := strings.ToLower(d.Get("name").(string)) + "-content"
-	if _, ok := d.GetOk("storage_account_share_name"); ok {
-		contentShare = d.Get("storage_account_share_name").(string)
-	}
-
 	basicSettings := []web.NameValuePair{
-		{Name: &storagePropName, Value: &storageConnection},
 		{Name: &functionVersionPropName, Value: &functionVersion},
 		{Name: &appKindPropName, Value: &appKindPropValue},
-		{Name: &contentSharePropName, Value: &contentShare},
-		{Name: &contentFileConnStringPropName, Value: &storageConnection},
+	}
+
+	if d.Get("storage_uses_managed_identity").(bool) {
+		// the content share uses Azure Files, which doesn't support identity-based connections, so is omitted here
+		storageSettings := map[string]string{
+			"AzureWebJobsStorage__accountName":     storageAccount,
+			"AzureWebJobsStorage__blobServiceUri":  fmt.Sprintf("https://%s.blob.%s", storageAccount, endpointSuffix),
+			"AzureWebJobsStorage__queueServiceUri": fmt.Sprintf("https://%s.queue.%s", storageAccount, endpointSuffix),
+			"AzureWebJobsStorage__tableServiceUri": fmt.Sprintf("https://%s.table.%s", storageAccount, endpointSuffix),
+		}
+		for k, v := range storageSettings {
+			basicSettings = append(basicSettings, web.NameValuePair{Name: utils.String(k), Value: utils.String(v)})
+		}
+	} else {
+		accountKey := d.Get("storage_account_access_key").(string)
+		storageConnection := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", storageAccount, accountKey, endpointSuffix)
+
+		contentShare := strings.ToLower(d.Get("name").(string)) + "-content"
+		if _, ok := d.GetOk("storage_account_share_name"); ok {
+			contentShare = d.Get("storage_account_share_name").(string)
+		}
+
+		basicSettings = append(basicSettings, []web.NameValuePair{
+			{Name: &storagePropName, Value: &storageConnection},
+			{Name: &contentSharePropName, Value: &contentShare},
+			{Name: &contentFileConnStringPropName, Value: &storageConnection},
+		}...)
 	}
 
 	useExtensionBundle := d.Get("use_extension_bundle").(bool)
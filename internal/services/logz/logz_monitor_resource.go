@@ -285,7 +285,7 @@ func resourceLogzMonitorUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		body.Properties.MonitoringStatus = monitoringStatus
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		body.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -3,22 +3,34 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/machinelearningservices/mgmt/2021-07-01/machinelearningservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-10-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-10-01/workspaces"
 )
 
 type Client struct {
 	WorkspacesClient             *machinelearningservices.WorkspacesClient
+	WorkspacesLatestClient       *workspaces.WorkspacesClient
 	MachineLearningComputeClient *machinelearningservices.ComputeClient
+	ManagedNetworkClient         *managednetwork.ManagedNetworkClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	WorkspacesClient := machinelearningservices.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&WorkspacesClient.Client, o.ResourceManagerAuthorizer)
 
+	WorkspacesLatestClient := workspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WorkspacesLatestClient.Client, o.ResourceManagerAuthorizer)
+
 	MachineLearningComputeClient := machinelearningservices.NewComputeClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&MachineLearningComputeClient.Client, o.ResourceManagerAuthorizer)
 
+	ManagedNetworkClient := managednetwork.NewManagedNetworkClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagedNetworkClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		WorkspacesClient:             &WorkspacesClient,
+		WorkspacesLatestClient:       &WorkspacesLatestClient,
 		MachineLearningComputeClient: &MachineLearningComputeClient,
+		ManagedNetworkClient:         &ManagedNetworkClient,
 	}
 }
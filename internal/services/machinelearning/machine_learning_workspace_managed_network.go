@@ -0,0 +1,413 @@
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-10-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func schemaMachineLearningWorkspaceManagedNetwork() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"isolation_mode": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(workspaces.IsolationModeDisabled),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(workspaces.IsolationModeAllowInternetOutbound),
+						string(workspaces.IsolationModeAllowOnlyApprovedOutbound),
+						string(workspaces.IsolationModeDisabled),
+					}, false),
+				},
+
+				// the managed network is otherwise only provisioned when the first compute is created within the workspace
+				"provision_on_creation_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"outbound_rule_fqdn": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"destination": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"outbound_rule_private_endpoint": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"service_resource_id": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+
+							"sub_resource_target": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"spark_enabled": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"outbound_rule_service_tag": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"service_tag": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"protocol": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"*",
+									"ICMP",
+									"TCP",
+									"UDP",
+								}, false),
+							},
+
+							"port_ranges": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// machineLearningWorkspaceManagedNetworkIsolationMode returns the Isolation Mode from the `managed_network` block,
+// which defaults to `Disabled` when the block isn't specified
+func machineLearningWorkspaceManagedNetworkIsolationMode(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return string(workspaces.IsolationModeDisabled)
+	}
+
+	return input[0].(map[string]interface{})["isolation_mode"].(string)
+}
+
+func applyMachineLearningWorkspaceManagedNetwork(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.WorkspaceId) error {
+	workspacesClient := meta.(*clients.Client).MachineLearning.WorkspacesLatestClient
+	managedNetworkClient := meta.(*clients.Client).MachineLearning.ManagedNetworkClient
+
+	isNewResource := d.IsNewResource()
+	oldRaw, newRaw := d.GetChange("managed_network")
+	oldConfig := oldRaw.([]interface{})
+	newConfig := newRaw.([]interface{})
+	if isNewResource {
+		oldConfig = make([]interface{}, 0)
+	}
+
+	oldRules, err := expandMachineLearningWorkspaceOutboundRules(oldConfig)
+	if err != nil {
+		return err
+	}
+	newRules, err := expandMachineLearningWorkspaceOutboundRules(newConfig)
+	if err != nil {
+		return err
+	}
+
+	isolationMode := machineLearningWorkspaceManagedNetworkIsolationMode(newConfig)
+	isolationModeChanged := isolationMode != machineLearningWorkspaceManagedNetworkIsolationMode(oldConfig)
+	if isolationModeChanged {
+		mode := workspaces.IsolationMode(isolationMode)
+		payload := workspaces.WorkspaceUpdateParameters{
+			Properties: &workspaces.WorkspacePropertiesUpdateParameters{
+				ManagedNetwork: &workspaces.ManagedNetworkSettings{
+					IsolationMode: &mode,
+				},
+			},
+		}
+
+		workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		if err := workspacesClient.UpdateThenPoll(ctx, workspaceId, payload); err != nil {
+			return fmt.Errorf("updating the Managed Network Isolation Mode for %s: %+v", id, err)
+		}
+	}
+
+	for name := range oldRules {
+		if _, ok := newRules[name]; ok {
+			continue
+		}
+
+		ruleId := managednetwork.NewOutboundRuleID(id.SubscriptionId, id.ResourceGroup, id.Name, name)
+		if err := managedNetworkClient.SettingsRuleDeleteThenPoll(ctx, ruleId); err != nil {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+	}
+
+	for name, rule := range newRules {
+		if existing, ok := oldRules[name]; ok && reflect.DeepEqual(existing, rule) {
+			continue
+		}
+
+		ruleId := managednetwork.NewOutboundRuleID(id.SubscriptionId, id.ResourceGroup, id.Name, name)
+		payload := managednetwork.OutboundRuleBasicResource{
+			Properties: rule,
+		}
+		if err := managedNetworkClient.SettingsRuleCreateOrUpdateThenPoll(ctx, ruleId, payload); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", ruleId, err)
+		}
+	}
+
+	provisionEnabled := len(newConfig) > 0 && newConfig[0] != nil && newConfig[0].(map[string]interface{})["provision_on_creation_enabled"].(bool)
+	if provisionEnabled && isolationModeChanged && isolationMode != string(workspaces.IsolationModeDisabled) {
+		workspaceId := managednetwork.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		payload := managednetwork.ManagedNetworkProvisionOptions{
+			IncludeSpark: utils.Bool(false),
+		}
+		if err := managedNetworkClient.ProvisionsProvisionManagedNetworkThenPoll(ctx, workspaceId, payload); err != nil {
+			return fmt.Errorf("provisioning the Managed Network for %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMachineLearningWorkspaceOutboundRules(input []interface{}) (map[string]managednetwork.OutboundRule, error) {
+	output := make(map[string]managednetwork.OutboundRule)
+	if len(input) == 0 || input[0] == nil {
+		return output, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	category := managednetwork.RuleCategoryUserDefined
+
+	add := func(name string, rule managednetwork.OutboundRule) error {
+		if _, exists := output[name]; exists {
+			return fmt.Errorf("the outbound rule name %q must be unique across `outbound_rule_fqdn`, `outbound_rule_private_endpoint` and `outbound_rule_service_tag`", name)
+		}
+		output[name] = rule
+		return nil
+	}
+
+	for _, raw := range v["outbound_rule_fqdn"].([]interface{}) {
+		rule := raw.(map[string]interface{})
+		if err := add(rule["name"].(string), managednetwork.FQDNOutboundRule{
+			Category:    &category,
+			Destination: utils.String(rule["destination"].(string)),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, raw := range v["outbound_rule_private_endpoint"].([]interface{}) {
+		rule := raw.(map[string]interface{})
+		if err := add(rule["name"].(string), managednetwork.PrivateEndpointOutboundRule{
+			Category: &category,
+			Destination: &managednetwork.PrivateEndpointDestination{
+				ServiceResourceId: utils.String(rule["service_resource_id"].(string)),
+				SparkEnabled:      utils.Bool(rule["spark_enabled"].(bool)),
+				SubresourceTarget: utils.String(rule["sub_resource_target"].(string)),
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, raw := range v["outbound_rule_service_tag"].([]interface{}) {
+		rule := raw.(map[string]interface{})
+		action := managednetwork.RuleActionAllow
+		if err := add(rule["name"].(string), managednetwork.ServiceTagOutboundRule{
+			Category: &category,
+			Destination: &managednetwork.ServiceTagDestination{
+				Action:     &action,
+				PortRanges: utils.String(rule["port_ranges"].(string)),
+				Protocol:   utils.String(rule["protocol"].(string)),
+				ServiceTag: utils.String(rule["service_tag"].(string)),
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func flattenMachineLearningWorkspaceManagedNetwork(input *workspaces.ManagedNetworkSettings, existing []interface{}) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	isolationMode := string(workspaces.IsolationModeDisabled)
+	if input.IsolationMode != nil {
+		isolationMode = string(*input.IsolationMode)
+	}
+
+	fqdnRules := make([]interface{}, 0)
+	privateEndpointRules := make([]interface{}, 0)
+	serviceTagRules := make([]interface{}, 0)
+
+	if input.OutboundRules != nil {
+		// only the user-defined rules are managed here, the service adds the Required and Recommended rules itself
+		for name, raw := range *input.OutboundRules {
+			switch rule := raw.(type) {
+			case workspaces.FQDNOutboundRule:
+				if !machineLearningWorkspaceOutboundRuleIsUserDefined(rule.Category) {
+					continue
+				}
+				fqdnRules = append(fqdnRules, map[string]interface{}{
+					"name":        name,
+					"destination": utils.NormalizeNilableString(rule.Destination),
+				})
+
+			case workspaces.PrivateEndpointOutboundRule:
+				if !machineLearningWorkspaceOutboundRuleIsUserDefined(rule.Category) {
+					continue
+				}
+				serviceResourceId := ""
+				subResourceTarget := ""
+				sparkEnabled := false
+				if destination := rule.Destination; destination != nil {
+					serviceResourceId = utils.NormalizeNilableString(destination.ServiceResourceId)
+					subResourceTarget = utils.NormalizeNilableString(destination.SubresourceTarget)
+					if destination.SparkEnabled != nil {
+						sparkEnabled = *destination.SparkEnabled
+					}
+				}
+				privateEndpointRules = append(privateEndpointRules, map[string]interface{}{
+					"name":                name,
+					"service_resource_id": serviceResourceId,
+					"sub_resource_target": subResourceTarget,
+					"spark_enabled":       sparkEnabled,
+				})
+
+			case workspaces.ServiceTagOutboundRule:
+				if !machineLearningWorkspaceOutboundRuleIsUserDefined(rule.Category) {
+					continue
+				}
+				serviceTag := ""
+				protocol := ""
+				portRanges := ""
+				if destination := rule.Destination; destination != nil {
+					serviceTag = utils.NormalizeNilableString(destination.ServiceTag)
+					protocol = utils.NormalizeNilableString(destination.Protocol)
+					portRanges = utils.NormalizeNilableString(destination.PortRanges)
+				}
+				serviceTagRules = append(serviceTagRules, map[string]interface{}{
+					"name":        name,
+					"service_tag": serviceTag,
+					"protocol":    protocol,
+					"port_ranges": portRanges,
+				})
+			}
+		}
+	}
+
+	provisionOnCreationEnabled := false
+	if len(existing) > 0 && existing[0] != nil {
+		provisionOnCreationEnabled = existing[0].(map[string]interface{})["provision_on_creation_enabled"].(bool)
+	}
+
+	// omit the block when the managed network isn't in use to avoid a diff when it isn't specified
+	if isolationMode == string(workspaces.IsolationModeDisabled) && len(fqdnRules) == 0 && len(privateEndpointRules) == 0 && len(serviceTagRules) == 0 && len(existing) == 0 {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"isolation_mode":                 isolationMode,
+			"provision_on_creation_enabled":  provisionOnCreationEnabled,
+			"outbound_rule_fqdn":             sortMachineLearningWorkspaceOutboundRules(fqdnRules, existing, "outbound_rule_fqdn"),
+			"outbound_rule_private_endpoint": sortMachineLearningWorkspaceOutboundRules(privateEndpointRules, existing, "outbound_rule_private_endpoint"),
+			"outbound_rule_service_tag":      sortMachineLearningWorkspaceOutboundRules(serviceTagRules, existing, "outbound_rule_service_tag"),
+		},
+	}
+}
+
+func machineLearningWorkspaceOutboundRuleIsUserDefined(input *workspaces.RuleCategory) bool {
+	return input == nil || *input == workspaces.RuleCategoryUserDefined
+}
+
+// sortMachineLearningWorkspaceOutboundRules orders the rules returned from the API (as a map) in the order they're
+// defined in the configuration, with any rules not present in the configuration appended in name order
+func sortMachineLearningWorkspaceOutboundRules(input []interface{}, existing []interface{}, key string) []interface{} {
+	rules := make(map[string]interface{})
+	names := make([]string, 0)
+	for _, raw := range input {
+		name := raw.(map[string]interface{})["name"].(string)
+		rules[name] = raw
+		names = append(names, name)
+	}
+
+	output := make([]interface{}, 0)
+	if len(existing) > 0 && existing[0] != nil {
+		for _, raw := range existing[0].(map[string]interface{})[key].([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			name := raw.(map[string]interface{})["name"].(string)
+			if rule, ok := rules[name]; ok {
+				output = append(output, rule)
+				delete(rules, name)
+			}
+		}
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		if rule, ok := rules[name]; ok {
+			output = append(output, rule)
+		}
+	}
+
+	return output
+}
@@ -1,6 +1,7 @@
 package machinelearning
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2023-10-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -183,6 +185,8 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 				}, true),
 			},
 
+			"managed_network": schemaMachineLearningWorkspaceManagedNetwork(),
+
 			"discovery_url": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -190,6 +194,14 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the Managed Network can't be disabled once it's been enabled
+			pluginsdk.ForceNewIfChange("managed_network", func(ctx context.Context, old, new, _ interface{}) bool {
+				return machineLearningWorkspaceManagedNetworkIsolationMode(old.([]interface{})) != string(workspaces.IsolationModeDisabled) &&
+					machineLearningWorkspaceManagedNetworkIsolationMode(new.([]interface{})) == string(workspaces.IsolationModeDisabled)
+			}),
+		),
 	}
 }
 
@@ -260,6 +272,13 @@ func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta inte
 
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	id := parse.NewWorkspaceID(subscriptionId, resGroup, name)
+
+	if _, ok := d.GetOk("managed_network"); ok {
+		if err := applyMachineLearningWorkspaceManagedNetwork(ctx, d, meta, id); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceMachineLearningWorkspaceRead(d, meta)
@@ -316,6 +335,19 @@ func resourceMachineLearningWorkspaceRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("flattening encryption on Workspace %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	// the Managed Network is only available in a newer API version than the one used for the rest of the Workspace
+	latest, err := meta.(*clients.Client).MachineLearning.WorkspacesLatestClient.Get(ctx, workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving Managed Network for %s: %+v", *id, err)
+	}
+	var managedNetwork *workspaces.ManagedNetworkSettings
+	if model := latest.Model; model != nil && model.Properties != nil {
+		managedNetwork = model.Properties.ManagedNetwork
+	}
+	if err := d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(managedNetwork, d.Get("managed_network").([]interface{}))); err != nil {
+		return fmt.Errorf("setting `managed_network`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -349,7 +381,7 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 		update.WorkspacePropertiesUpdateParameters.FriendlyName = utils.String(d.Get("friendly_name").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -357,6 +389,12 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("updating Machine Learning Workspace %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	if d.HasChange("managed_network") {
+		if err := applyMachineLearningWorkspaceManagedNetwork(ctx, d, meta, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
 
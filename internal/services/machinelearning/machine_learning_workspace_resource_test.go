@@ -132,6 +132,41 @@ func TestAccMachineLearningWorkspace_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMachineLearningWorkspace_managedNetwork(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
+	r := WorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedNetwork(data, "AllowInternetOutbound"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.isolation_mode").HasValue("AllowInternetOutbound"),
+			),
+		},
+		data.ImportStep("managed_network.0.provision_on_creation_enabled"),
+		{
+			Config: r.managedNetworkOutboundRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.isolation_mode").HasValue("AllowOnlyApprovedOutbound"),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_fqdn.#").HasValue("1"),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_private_endpoint.#").HasValue("1"),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_service_tag.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("managed_network.0.provision_on_creation_enabled"),
+		{
+			Config: r.managedNetwork(data, "AllowOnlyApprovedOutbound"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_fqdn.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("managed_network.0.provision_on_creation_enabled"),
+	})
+}
+
 func (r WorkspaceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	workspacesClient := client.MachineLearning.WorkspacesClient
 	id, err := parse.WorkspaceID(state.ID)
@@ -322,6 +357,74 @@ resource "azurerm_machine_learning_workspace" "test" {
 `, template, data.RandomIntOfLength(16))
 }
 
+func (r WorkspaceResource) managedNetwork(data acceptance.TestData, isolationMode string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace" "test" {
+  name                    = "acctest-MLW-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  key_vault_id            = azurerm_key_vault.test.id
+  storage_account_id      = azurerm_storage_account.test.id
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  managed_network {
+    isolation_mode                = "%s"
+    provision_on_creation_enabled = true
+  }
+}
+`, template, data.RandomInteger, isolationMode)
+}
+
+func (r WorkspaceResource) managedNetworkOutboundRules(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace" "test" {
+  name                    = "acctest-MLW-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  key_vault_id            = azurerm_key_vault.test.id
+  storage_account_id      = azurerm_storage_account.test.id
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  managed_network {
+    isolation_mode                = "AllowOnlyApprovedOutbound"
+    provision_on_creation_enabled = true
+
+    outbound_rule_fqdn {
+      name        = "pypi"
+      destination = "pypi.org"
+    }
+
+    outbound_rule_private_endpoint {
+      name                = "storage"
+      service_resource_id = azurerm_storage_account.test.id
+      sub_resource_target = "blob"
+    }
+
+    outbound_rule_service_tag {
+      name        = "datafactory"
+      service_tag = "DataFactory"
+      protocol    = "TCP"
+      port_ranges = "443"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r WorkspaceResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,75 @@
+package managednetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ProvisionsProvisionManagedNetworkResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ProvisionsProvisionManagedNetwork ...
+func (c ManagedNetworkClient) ProvisionsProvisionManagedNetwork(ctx context.Context, id WorkspaceId, input ManagedNetworkProvisionOptions) (result ProvisionsProvisionManagedNetworkResponse, err error) {
+	req, err := c.preparerForProvisionsProvisionManagedNetwork(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "ProvisionsProvisionManagedNetwork", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForProvisionsProvisionManagedNetwork(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "ProvisionsProvisionManagedNetwork", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ProvisionsProvisionManagedNetworkThenPoll performs ProvisionsProvisionManagedNetwork then polls until it's completed
+func (c ManagedNetworkClient) ProvisionsProvisionManagedNetworkThenPoll(ctx context.Context, id WorkspaceId, input ManagedNetworkProvisionOptions) error {
+	result, err := c.ProvisionsProvisionManagedNetwork(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ProvisionsProvisionManagedNetwork: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ProvisionsProvisionManagedNetwork: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForProvisionsProvisionManagedNetwork prepares the ProvisionsProvisionManagedNetwork request.
+func (c ManagedNetworkClient) preparerForProvisionsProvisionManagedNetwork(ctx context.Context, id WorkspaceId, input ManagedNetworkProvisionOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/provisionManagedNetwork", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForProvisionsProvisionManagedNetwork sends the ProvisionsProvisionManagedNetwork request. The method will close the
+// http.Response Body if it receives an error.
+func (c ManagedNetworkClient) senderForProvisionsProvisionManagedNetwork(ctx context.Context, req *http.Request) (future ProvisionsProvisionManagedNetworkResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
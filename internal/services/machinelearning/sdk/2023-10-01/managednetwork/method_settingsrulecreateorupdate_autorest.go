@@ -0,0 +1,75 @@
+package managednetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SettingsRuleCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SettingsRuleCreateOrUpdate ...
+func (c ManagedNetworkClient) SettingsRuleCreateOrUpdate(ctx context.Context, id OutboundRuleId, input OutboundRuleBasicResource) (result SettingsRuleCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSettingsRuleCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "SettingsRuleCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSettingsRuleCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "SettingsRuleCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SettingsRuleCreateOrUpdateThenPoll performs SettingsRuleCreateOrUpdate then polls until it's completed
+func (c ManagedNetworkClient) SettingsRuleCreateOrUpdateThenPoll(ctx context.Context, id OutboundRuleId, input OutboundRuleBasicResource) error {
+	result, err := c.SettingsRuleCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SettingsRuleCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SettingsRuleCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSettingsRuleCreateOrUpdate prepares the SettingsRuleCreateOrUpdate request.
+func (c ManagedNetworkClient) preparerForSettingsRuleCreateOrUpdate(ctx context.Context, id OutboundRuleId, input OutboundRuleBasicResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSettingsRuleCreateOrUpdate sends the SettingsRuleCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c ManagedNetworkClient) senderForSettingsRuleCreateOrUpdate(ctx context.Context, req *http.Request) (future SettingsRuleCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
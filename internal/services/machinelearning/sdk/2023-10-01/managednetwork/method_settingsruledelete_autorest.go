@@ -0,0 +1,74 @@
+package managednetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SettingsRuleDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SettingsRuleDelete ...
+func (c ManagedNetworkClient) SettingsRuleDelete(ctx context.Context, id OutboundRuleId) (result SettingsRuleDeleteResponse, err error) {
+	req, err := c.preparerForSettingsRuleDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "SettingsRuleDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSettingsRuleDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "SettingsRuleDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SettingsRuleDeleteThenPoll performs SettingsRuleDelete then polls until it's completed
+func (c ManagedNetworkClient) SettingsRuleDeleteThenPoll(ctx context.Context, id OutboundRuleId) error {
+	result, err := c.SettingsRuleDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SettingsRuleDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SettingsRuleDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSettingsRuleDelete prepares the SettingsRuleDelete request.
+func (c ManagedNetworkClient) preparerForSettingsRuleDelete(ctx context.Context, id OutboundRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSettingsRuleDelete sends the SettingsRuleDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c ManagedNetworkClient) senderForSettingsRuleDelete(ctx context.Context, req *http.Request) (future SettingsRuleDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
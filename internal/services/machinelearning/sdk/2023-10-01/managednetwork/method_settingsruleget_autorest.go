@@ -0,0 +1,64 @@
+package managednetwork
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SettingsRuleGetResponse struct {
+	HttpResponse *http.Response
+	Model        *OutboundRuleBasicResource
+}
+
+// SettingsRuleGet ...
+func (c ManagedNetworkClient) SettingsRuleGet(ctx context.Context, id OutboundRuleId) (result SettingsRuleGetResponse, err error) {
+	req, err := c.preparerForSettingsRuleGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "SettingsRuleGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "SettingsRuleGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSettingsRuleGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managednetwork.ManagedNetworkClient", "SettingsRuleGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSettingsRuleGet prepares the SettingsRuleGet request.
+func (c ManagedNetworkClient) preparerForSettingsRuleGet(ctx context.Context, id OutboundRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSettingsRuleGet handles the response to the SettingsRuleGet request. The method always
+// closes the http.Response Body.
+func (c ManagedNetworkClient) responderForSettingsRuleGet(resp *http.Response) (result SettingsRuleGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
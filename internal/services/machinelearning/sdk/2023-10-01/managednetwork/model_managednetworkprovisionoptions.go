@@ -0,0 +1,5 @@
+package managednetwork
+
+type ManagedNetworkProvisionOptions struct {
+	IncludeSpark *bool `json:"includeSpark,omitempty"`
+}
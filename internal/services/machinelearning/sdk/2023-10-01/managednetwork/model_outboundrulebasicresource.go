@@ -0,0 +1,41 @@
+package managednetwork
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type OutboundRuleBasicResource struct {
+	Id         *string      `json:"id,omitempty"`
+	Name       *string      `json:"name,omitempty"`
+	Properties OutboundRule `json:"properties"`
+	Type       *string      `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &OutboundRuleBasicResource{}
+
+func (s *OutboundRuleBasicResource) UnmarshalJSON(bytes []byte) error {
+	type alias OutboundRuleBasicResource
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into OutboundRuleBasicResource: %+v", err)
+	}
+
+	s.Id = decoded.Id
+	s.Name = decoded.Name
+	s.Type = decoded.Type
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling OutboundRuleBasicResource into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalOutboundRuleImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'OutboundRuleBasicResource': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
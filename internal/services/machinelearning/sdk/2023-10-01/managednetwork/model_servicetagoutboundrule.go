@@ -0,0 +1,40 @@
+package managednetwork
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ OutboundRule = ServiceTagOutboundRule{}
+
+type ServiceTagOutboundRule struct {
+	Destination *ServiceTagDestination `json:"destination,omitempty"`
+
+	// Fields inherited from OutboundRule
+	Category *RuleCategory `json:"category,omitempty"`
+	Status   *RuleStatus   `json:"status,omitempty"`
+}
+
+var _ json.Marshaler = ServiceTagOutboundRule{}
+
+func (s ServiceTagOutboundRule) MarshalJSON() ([]byte, error) {
+	type wrapper ServiceTagOutboundRule
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ServiceTagOutboundRule: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ServiceTagOutboundRule: %+v", err)
+	}
+	decoded["type"] = "ServiceTag"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ServiceTagOutboundRule: %+v", err)
+	}
+
+	return encoded, nil
+}
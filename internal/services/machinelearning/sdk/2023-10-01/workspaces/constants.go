@@ -0,0 +1,183 @@
+package workspaces
+
+import "strings"
+
+type IsolationMode string
+
+const (
+	IsolationModeAllowInternetOutbound     IsolationMode = "AllowInternetOutbound"
+	IsolationModeAllowOnlyApprovedOutbound IsolationMode = "AllowOnlyApprovedOutbound"
+	IsolationModeDisabled                  IsolationMode = "Disabled"
+)
+
+func PossibleValuesForIsolationMode() []string {
+	return []string{
+		string(IsolationModeAllowInternetOutbound),
+		string(IsolationModeAllowOnlyApprovedOutbound),
+		string(IsolationModeDisabled),
+	}
+}
+
+func parseIsolationMode(input string) (*IsolationMode, error) {
+	vals := map[string]IsolationMode{
+		"allowinternetoutbound":     IsolationModeAllowInternetOutbound,
+		"allowonlyapprovedoutbound": IsolationModeAllowOnlyApprovedOutbound,
+		"disabled":                  IsolationModeDisabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IsolationMode(input)
+	return &out, nil
+}
+
+type ManagedNetworkStatus string
+
+const (
+	ManagedNetworkStatusActive   ManagedNetworkStatus = "Active"
+	ManagedNetworkStatusInactive ManagedNetworkStatus = "Inactive"
+)
+
+func PossibleValuesForManagedNetworkStatus() []string {
+	return []string{
+		string(ManagedNetworkStatusActive),
+		string(ManagedNetworkStatusInactive),
+	}
+}
+
+func parseManagedNetworkStatus(input string) (*ManagedNetworkStatus, error) {
+	vals := map[string]ManagedNetworkStatus{
+		"active":   ManagedNetworkStatusActive,
+		"inactive": ManagedNetworkStatusInactive,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedNetworkStatus(input)
+	return &out, nil
+}
+
+type RuleAction string
+
+const (
+	RuleActionAllow RuleAction = "Allow"
+	RuleActionDeny  RuleAction = "Deny"
+)
+
+func PossibleValuesForRuleAction() []string {
+	return []string{
+		string(RuleActionAllow),
+		string(RuleActionDeny),
+	}
+}
+
+func parseRuleAction(input string) (*RuleAction, error) {
+	vals := map[string]RuleAction{
+		"allow": RuleActionAllow,
+		"deny":  RuleActionDeny,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RuleAction(input)
+	return &out, nil
+}
+
+type RuleCategory string
+
+const (
+	RuleCategoryDependency  RuleCategory = "Dependency"
+	RuleCategoryRecommended RuleCategory = "Recommended"
+	RuleCategoryRequired    RuleCategory = "Required"
+	RuleCategoryUserDefined RuleCategory = "UserDefined"
+)
+
+func PossibleValuesForRuleCategory() []string {
+	return []string{
+		string(RuleCategoryDependency),
+		string(RuleCategoryRecommended),
+		string(RuleCategoryRequired),
+		string(RuleCategoryUserDefined),
+	}
+}
+
+func parseRuleCategory(input string) (*RuleCategory, error) {
+	vals := map[string]RuleCategory{
+		"dependency":  RuleCategoryDependency,
+		"recommended": RuleCategoryRecommended,
+		"required":    RuleCategoryRequired,
+		"userdefined": RuleCategoryUserDefined,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RuleCategory(input)
+	return &out, nil
+}
+
+type RuleStatus string
+
+const (
+	RuleStatusActive   RuleStatus = "Active"
+	RuleStatusInactive RuleStatus = "Inactive"
+)
+
+func PossibleValuesForRuleStatus() []string {
+	return []string{
+		string(RuleStatusActive),
+		string(RuleStatusInactive),
+	}
+}
+
+func parseRuleStatus(input string) (*RuleStatus, error) {
+	vals := map[string]RuleStatus{
+		"active":   RuleStatusActive,
+		"inactive": RuleStatusInactive,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RuleStatus(input)
+	return &out, nil
+}
+
+type RuleType string
+
+const (
+	RuleTypeFQDN            RuleType = "FQDN"
+	RuleTypePrivateEndpoint RuleType = "PrivateEndpoint"
+	RuleTypeServiceTag      RuleType = "ServiceTag"
+)
+
+func PossibleValuesForRuleType() []string {
+	return []string{
+		string(RuleTypeFQDN),
+		string(RuleTypePrivateEndpoint),
+		string(RuleTypeServiceTag),
+	}
+}
+
+func parseRuleType(input string) (*RuleType, error) {
+	vals := map[string]RuleType{
+		"fqdn":            RuleTypeFQDN,
+		"privateendpoint": RuleTypePrivateEndpoint,
+		"servicetag":      RuleTypeServiceTag,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RuleType(input)
+	return &out, nil
+}
@@ -0,0 +1,40 @@
+package workspaces
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ OutboundRule = FQDNOutboundRule{}
+
+type FQDNOutboundRule struct {
+	Destination *string `json:"destination,omitempty"`
+
+	// Fields inherited from OutboundRule
+	Category *RuleCategory `json:"category,omitempty"`
+	Status   *RuleStatus   `json:"status,omitempty"`
+}
+
+var _ json.Marshaler = FQDNOutboundRule{}
+
+func (s FQDNOutboundRule) MarshalJSON() ([]byte, error) {
+	type wrapper FQDNOutboundRule
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling FQDNOutboundRule: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling FQDNOutboundRule: %+v", err)
+	}
+	decoded["type"] = "FQDN"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling FQDNOutboundRule: %+v", err)
+	}
+
+	return encoded, nil
+}
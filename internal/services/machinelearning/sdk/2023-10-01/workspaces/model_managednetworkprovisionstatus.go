@@ -0,0 +1,6 @@
+package workspaces
+
+type ManagedNetworkProvisionStatus struct {
+	SparkReady *bool                 `json:"sparkReady,omitempty"`
+	Status     *ManagedNetworkStatus `json:"status,omitempty"`
+}
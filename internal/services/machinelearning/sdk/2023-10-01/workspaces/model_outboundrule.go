@@ -0,0 +1,61 @@
+package workspaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type OutboundRule interface {
+}
+
+func unmarshalOutboundRuleImplementation(input []byte) (OutboundRule, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling OutboundRule into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "FQDN") {
+		var out FQDNOutboundRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into FQDNOutboundRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "PrivateEndpoint") {
+		var out PrivateEndpointOutboundRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into PrivateEndpointOutboundRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "ServiceTag") {
+		var out ServiceTagOutboundRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ServiceTagOutboundRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawOutboundRuleImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawOutboundRuleImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
@@ -0,0 +1,8 @@
+package workspaces
+
+type PrivateEndpointDestination struct {
+	ServiceResourceId *string     `json:"serviceResourceId,omitempty"`
+	SparkEnabled      *bool       `json:"sparkEnabled,omitempty"`
+	SparkStatus       *RuleStatus `json:"sparkStatus,omitempty"`
+	SubresourceTarget *string     `json:"subresourceTarget,omitempty"`
+}
@@ -0,0 +1,8 @@
+package workspaces
+
+type Workspace struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *WorkspaceProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceProperties struct {
+	ManagedNetwork      *ManagedNetworkSettings `json:"managedNetwork,omitempty"`
+	ProvisionNetworkNow *bool                   `json:"provisionNetworkNow,omitempty"`
+}
@@ -0,0 +1,5 @@
+package workspaces
+
+type WorkspacePropertiesUpdateParameters struct {
+	ManagedNetwork *ManagedNetworkSettings `json:"managedNetwork,omitempty"`
+}
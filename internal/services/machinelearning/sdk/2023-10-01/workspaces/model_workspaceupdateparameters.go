@@ -0,0 +1,5 @@
+package workspaces
+
+type WorkspaceUpdateParameters struct {
+	Properties *WorkspacePropertiesUpdateParameters `json:"properties,omitempty"`
+}
@@ -3,11 +3,13 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/maintenance/mgmt/2021-05-01/maintenance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/sdk/2023-04-01/configurationassignments"
 )
 
 type Client struct {
-	ConfigurationsClient           *maintenance.ConfigurationsClient
-	ConfigurationAssignmentsClient *maintenance.ConfigurationAssignmentsClient
+	ConfigurationsClient                       *maintenance.ConfigurationsClient
+	ConfigurationAssignmentsClient             *maintenance.ConfigurationAssignmentsClient
+	ConfigurationAssignmentsDynamicScopeClient *configurationassignments.ConfigurationAssignmentsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,8 +19,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	configurationAssignmentsClient := maintenance.NewConfigurationAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&configurationAssignmentsClient.Client, o.ResourceManagerAuthorizer)
 
+	configurationAssignmentsDynamicScopeClient := configurationassignments.NewConfigurationAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&configurationAssignmentsDynamicScopeClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ConfigurationsClient:           &configurationsClient,
-		ConfigurationAssignmentsClient: &configurationAssignmentsClient,
+		ConfigurationsClient:                       &configurationsClient,
+		ConfigurationAssignmentsClient:             &configurationAssignmentsClient,
+		ConfigurationAssignmentsDynamicScopeClient: &configurationAssignmentsDynamicScopeClient,
 	}
 }
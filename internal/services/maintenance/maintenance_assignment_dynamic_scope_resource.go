@@ -0,0 +1,367 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/sdk/2023-04-01/configurationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = MaintenanceAssignmentDynamicScopeResource{}
+
+type MaintenanceAssignmentDynamicScopeResource struct{}
+
+type MaintenanceAssignmentDynamicScopeModel struct {
+	Name                       string                                    `tfschema:"name"`
+	MaintenanceConfigurationId string                                    `tfschema:"maintenance_configuration_id"`
+	Filter                     []MaintenanceAssignmentDynamicScopeFilter `tfschema:"filter"`
+}
+
+type MaintenanceAssignmentDynamicScopeFilter struct {
+	Locations      []string                                     `tfschema:"locations"`
+	OsTypes        []string                                     `tfschema:"os_types"`
+	ResourceGroups []string                                     `tfschema:"resource_groups"`
+	ResourceTypes  []string                                     `tfschema:"resource_types"`
+	TagFilter      string                                       `tfschema:"tag_filter"`
+	Tags           []MaintenanceAssignmentDynamicScopeFilterTag `tfschema:"tags"`
+}
+
+type MaintenanceAssignmentDynamicScopeFilterTag struct {
+	Tag    string   `tfschema:"tag"`
+	Values []string `tfschema:"values"`
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) ResourceType() string {
+	return "azurerm_maintenance_assignment_dynamic_scope"
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) ModelObject() interface{} {
+	return &MaintenanceAssignmentDynamicScopeModel{}
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return configurationassignments.ValidateConfigurationAssignmentID
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"maintenance_configuration_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MaintenanceConfigurationID,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"locations": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"os_types": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Linux",
+								"Windows",
+							}, false),
+						},
+					},
+
+					"resource_groups": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"resource_types": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Microsoft.Compute/virtualMachines",
+								"Microsoft.HybridCompute/machines",
+							}, false),
+						},
+					},
+
+					"tag_filter": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(configurationassignments.TagOperatorsAny),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(configurationassignments.TagOperatorsAll),
+							string(configurationassignments.TagOperatorsAny),
+						}, false),
+					},
+
+					"tags": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"tag": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"values": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Maintenance.ConfigurationAssignmentsDynamicScopeClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MaintenanceAssignmentDynamicScopeModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := configurationassignments.NewConfigurationAssignmentID(subscriptionId, model.Name)
+			existing, err := client.ForSubscriptionsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := configurationassignments.ConfigurationAssignment{
+				Name: utils.String(model.Name),
+				Properties: &configurationassignments.ConfigurationAssignmentProperties{
+					Filter:                     expandMaintenanceAssignmentDynamicScopeFilter(model.Filter),
+					MaintenanceConfigurationId: utils.String(model.MaintenanceConfigurationId),
+					ResourceId:                 utils.String(fmt.Sprintf("/subscriptions/%s", subscriptionId)),
+				},
+			}
+
+			if _, err := client.ForSubscriptionsCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Maintenance.ConfigurationAssignmentsDynamicScopeClient
+
+			id, err := configurationassignments.ParseConfigurationAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ForSubscriptionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MaintenanceAssignmentDynamicScopeModel{
+				Name: id.ConfigurationAssignmentName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.MaintenanceConfigurationId != nil {
+						maintenanceConfigurationId, err := parse.MaintenanceConfigurationIDInsensitively(*props.MaintenanceConfigurationId)
+						if err != nil {
+							return err
+						}
+						state.MaintenanceConfigurationId = maintenanceConfigurationId.ID()
+					}
+
+					state.Filter = flattenMaintenanceAssignmentDynamicScopeFilter(props.Filter)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Maintenance.ConfigurationAssignmentsDynamicScopeClient
+
+			id, err := configurationassignments.ParseConfigurationAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MaintenanceAssignmentDynamicScopeModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.ForSubscriptionsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("filter") {
+				payload.Properties.Filter = expandMaintenanceAssignmentDynamicScopeFilter(model.Filter)
+			}
+
+			if _, err := client.ForSubscriptionsCreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MaintenanceAssignmentDynamicScopeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Maintenance.ConfigurationAssignmentsDynamicScopeClient
+
+			id, err := configurationassignments.ParseConfigurationAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.ForSubscriptionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMaintenanceAssignmentDynamicScopeFilter(input []MaintenanceAssignmentDynamicScopeFilter) *configurationassignments.ConfigurationAssignmentFilterProperties {
+	if len(input) == 0 {
+		return nil
+	}
+
+	filter := input[0]
+	output := configurationassignments.ConfigurationAssignmentFilterProperties{
+		Locations:      pointer.FromSliceOfStrings(filter.Locations),
+		OsTypes:        pointer.FromSliceOfStrings(filter.OsTypes),
+		ResourceGroups: pointer.FromSliceOfStrings(filter.ResourceGroups),
+		ResourceTypes:  pointer.FromSliceOfStrings(filter.ResourceTypes),
+	}
+
+	if len(filter.Tags) > 0 {
+		tags := make(map[string][]string)
+		for _, v := range filter.Tags {
+			tags[v.Tag] = v.Values
+		}
+
+		filterOperator := configurationassignments.TagOperators(filter.TagFilter)
+		output.TagSettings = &configurationassignments.TagSettingsProperties{
+			FilterOperator: &filterOperator,
+			Tags:           &tags,
+		}
+	}
+
+	return &output
+}
+
+func flattenMaintenanceAssignmentDynamicScopeFilter(input *configurationassignments.ConfigurationAssignmentFilterProperties) []MaintenanceAssignmentDynamicScopeFilter {
+	if input == nil {
+		return []MaintenanceAssignmentDynamicScopeFilter{}
+	}
+
+	output := MaintenanceAssignmentDynamicScopeFilter{
+		Locations:      pointer.ToSliceOfStrings(input.Locations),
+		OsTypes:        pointer.ToSliceOfStrings(input.OsTypes),
+		ResourceGroups: pointer.ToSliceOfStrings(input.ResourceGroups),
+		ResourceTypes:  pointer.ToSliceOfStrings(input.ResourceTypes),
+		TagFilter:      string(configurationassignments.TagOperatorsAny),
+		Tags:           make([]MaintenanceAssignmentDynamicScopeFilterTag, 0),
+	}
+
+	if tagSettings := input.TagSettings; tagSettings != nil {
+		if tagSettings.FilterOperator != nil {
+			output.TagFilter = string(*tagSettings.FilterOperator)
+		}
+
+		if tagSettings.Tags != nil {
+			for tag, values := range *tagSettings.Tags {
+				output.Tags = append(output.Tags, MaintenanceAssignmentDynamicScopeFilterTag{
+					Tag:    tag,
+					Values: values,
+				})
+			}
+
+			// the API returns the tags as a map, so sort them to keep the ordering stable
+			sort.Slice(output.Tags, func(i, j int) bool {
+				return output.Tags[i].Tag < output.Tags[j].Tag
+			})
+		}
+	}
+
+	return []MaintenanceAssignmentDynamicScopeFilter{output}
+}
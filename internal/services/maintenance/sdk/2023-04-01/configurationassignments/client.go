@@ -0,0 +1,15 @@
+package configurationassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ConfigurationAssignmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConfigurationAssignmentsClientWithBaseURI(endpoint string) ConfigurationAssignmentsClient {
+	return ConfigurationAssignmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
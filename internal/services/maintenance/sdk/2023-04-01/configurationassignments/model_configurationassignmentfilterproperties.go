@@ -0,0 +1,9 @@
+package configurationassignments
+
+type ConfigurationAssignmentFilterProperties struct {
+	Locations      *[]string              `json:"locations,omitempty"`
+	OsTypes        *[]string              `json:"osTypes,omitempty"`
+	ResourceGroups *[]string              `json:"resourceGroups,omitempty"`
+	ResourceTypes  *[]string              `json:"resourceTypes,omitempty"`
+	TagSettings    *TagSettingsProperties `json:"tagSettings,omitempty"`
+}
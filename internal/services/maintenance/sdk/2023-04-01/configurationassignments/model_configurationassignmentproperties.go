@@ -0,0 +1,7 @@
+package configurationassignments
+
+type ConfigurationAssignmentProperties struct {
+	Filter                     *ConfigurationAssignmentFilterProperties `json:"filter,omitempty"`
+	MaintenanceConfigurationId *string                                  `json:"maintenanceConfigurationId,omitempty"`
+	ResourceId                 *string                                  `json:"resourceId,omitempty"`
+}
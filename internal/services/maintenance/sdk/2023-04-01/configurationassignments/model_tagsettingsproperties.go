@@ -0,0 +1,6 @@
+package configurationassignments
+
+type TagSettingsProperties struct {
+	FilterOperator *TagOperators        `json:"filterOperator,omitempty"`
+	Tags           *map[string][]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package configurationassignments
+
+import "fmt"
+
+const defaultApiVersion = "2023-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/configurationassignments/%s", defaultApiVersion)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/redisenterprise"
+)
+
+type Client struct {
+	DatabasesClient       *databases.DatabasesClient
+	RedisEnterpriseClient *redisenterprise.RedisEnterpriseClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	databasesClient := databases.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&databasesClient.Client, o.ResourceManagerAuthorizer)
+
+	redisEnterpriseClient := redisenterprise.NewRedisEnterpriseClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&redisEnterpriseClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DatabasesClient:       &databasesClient,
+		RedisEnterpriseClient: &redisEnterpriseClient,
+	}
+}
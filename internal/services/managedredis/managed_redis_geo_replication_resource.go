@@ -0,0 +1,296 @@
+package managedredis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/redisenterprise"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagedRedisGeoReplicationResource{}
+
+type ManagedRedisGeoReplicationResource struct{}
+
+type ManagedRedisGeoReplicationResourceModel struct {
+	ManagedRedisId        string   `tfschema:"managed_redis_id"`
+	LinkedManagedRedisIds []string `tfschema:"linked_managed_redis_ids"`
+}
+
+func (r ManagedRedisGeoReplicationResource) ResourceType() string {
+	return "azurerm_managed_redis_geo_replication"
+}
+
+func (r ManagedRedisGeoReplicationResource) ModelObject() interface{} {
+	return &ManagedRedisGeoReplicationResourceModel{}
+}
+
+func (r ManagedRedisGeoReplicationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return redisenterprise.ValidateRedisEnterpriseID
+}
+
+func (r ManagedRedisGeoReplicationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_redis_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: redisenterprise.ValidateRedisEnterpriseID,
+		},
+
+		"linked_managed_redis_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 4,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: redisenterprise.ValidateRedisEnterpriseID,
+			},
+		},
+	}
+}
+
+func (r ManagedRedisGeoReplicationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagedRedisGeoReplicationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.DatabasesClient
+
+			var config ManagedRedisGeoReplicationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := redisenterprise.ParseRedisEnterpriseID(config.ManagedRedisId)
+			if err != nil {
+				return err
+			}
+			databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, defaultDatabaseName)
+
+			existing, err := client.Get(ctx, databaseId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+			}
+
+			groupNickname, linkedDatabaseIds := managedRedisLinkedDatabases(existing.Model, databaseId)
+			if groupNickname == "" {
+				return fmt.Errorf("`default_database.0.geo_replication_group_name` must be set on %s before it can be linked", *id)
+			}
+			if len(linkedDatabaseIds) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := linkManagedRedisDatabases(ctx, client, databaseId, groupNickname, config.LinkedManagedRedisIds); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedRedisGeoReplicationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.DatabasesClient
+
+			id, err := redisenterprise.ParseRedisEnterpriseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, defaultDatabaseName)
+
+			resp, err := client.Get(ctx, databaseId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+			}
+
+			_, linkedDatabaseIds := managedRedisLinkedDatabases(resp.Model, databaseId)
+			if len(linkedDatabaseIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ManagedRedisGeoReplicationResourceModel{
+				ManagedRedisId:        id.ID(),
+				LinkedManagedRedisIds: make([]string, 0),
+			}
+			for _, linkedDatabaseId := range linkedDatabaseIds {
+				state.LinkedManagedRedisIds = append(state.LinkedManagedRedisIds, redisenterprise.NewRedisEnterpriseID(linkedDatabaseId.SubscriptionId, linkedDatabaseId.ResourceGroupName, linkedDatabaseId.ClusterName).ID())
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedRedisGeoReplicationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.DatabasesClient
+
+			id, err := redisenterprise.ParseRedisEnterpriseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, defaultDatabaseName)
+
+			var config ManagedRedisGeoReplicationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("linked_managed_redis_ids") {
+				oldRaw, newRaw := metadata.ResourceData.GetChange("linked_managed_redis_ids")
+				removed := oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set)).List()
+				added := newRaw.(*pluginsdk.Set).Difference(oldRaw.(*pluginsdk.Set)).List()
+
+				if len(removed) > 0 {
+					removedIds := make([]string, 0)
+					for _, v := range removed {
+						removedIds = append(removedIds, v.(string))
+					}
+					if err := unlinkManagedRedisDatabases(ctx, client, databaseId, removedIds); err != nil {
+						return err
+					}
+				}
+
+				if len(added) > 0 {
+					existing, err := client.Get(ctx, databaseId)
+					if err != nil {
+						return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+					}
+					groupNickname, _ := managedRedisLinkedDatabases(existing.Model, databaseId)
+
+					if err := linkManagedRedisDatabases(ctx, client, databaseId, groupNickname, config.LinkedManagedRedisIds); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedRedisGeoReplicationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.DatabasesClient
+
+			id, err := redisenterprise.ParseRedisEnterpriseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, defaultDatabaseName)
+
+			var config ManagedRedisGeoReplicationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := unlinkManagedRedisDatabases(ctx, client, databaseId, config.LinkedManagedRedisIds); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+// managedRedisLinkedDatabases returns the geo-replication group nickname of the specified Database,
+// along with the IDs of the other Databases which are linked to it
+func managedRedisLinkedDatabases(input *databases.Database, id databases.DatabaseId) (string, []databases.DatabaseId) {
+	groupNickname := ""
+	linkedDatabaseIds := make([]databases.DatabaseId, 0)
+	if input == nil || input.Properties == nil || input.Properties.GeoReplication == nil {
+		return groupNickname, linkedDatabaseIds
+	}
+
+	geoReplication := input.Properties.GeoReplication
+	if geoReplication.GroupNickname != nil {
+		groupNickname = *geoReplication.GroupNickname
+	}
+
+	if geoReplication.LinkedDatabases != nil {
+		for _, item := range *geoReplication.LinkedDatabases {
+			if item.Id == nil {
+				continue
+			}
+
+			linkedDatabaseId, err := databases.ParseDatabaseIDInsensitively(*item.Id)
+			if err != nil || strings.EqualFold(linkedDatabaseId.ID(), id.ID()) {
+				continue
+			}
+			linkedDatabaseIds = append(linkedDatabaseIds, *linkedDatabaseId)
+		}
+	}
+
+	return groupNickname, linkedDatabaseIds
+}
+
+func linkManagedRedisDatabases(ctx context.Context, client *databases.DatabasesClient, id databases.DatabaseId, groupNickname string, linkedManagedRedisIds []string) error {
+	linkedDatabases := []databases.LinkedDatabase{
+		{
+			Id: utils.String(id.ID()),
+		},
+	}
+	for _, v := range linkedManagedRedisIds {
+		linkedId, err := redisenterprise.ParseRedisEnterpriseIDInsensitively(v)
+		if err != nil {
+			return err
+		}
+		linkedDatabases = append(linkedDatabases, databases.LinkedDatabase{
+			Id: utils.String(databases.NewDatabaseID(linkedId.SubscriptionId, linkedId.ResourceGroupName, linkedId.ClusterName, defaultDatabaseName).ID()),
+		})
+	}
+
+	payload := databases.ForceLinkParameters{
+		GeoReplication: databases.ForceLinkParametersGeoReplication{
+			GroupNickname:   utils.String(groupNickname),
+			LinkedDatabases: &linkedDatabases,
+		},
+	}
+	if err := client.ForceLinkToReplicationGroupThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("linking %s to geo-replication group %q: %+v", id, groupNickname, err)
+	}
+
+	return nil
+}
+
+func unlinkManagedRedisDatabases(ctx context.Context, client *databases.DatabasesClient, id databases.DatabaseId, managedRedisIds []string) error {
+	unlinkedIds := make([]string, 0)
+	for _, v := range managedRedisIds {
+		unlinkedId, err := redisenterprise.ParseRedisEnterpriseIDInsensitively(v)
+		if err != nil {
+			return err
+		}
+		unlinkedIds = append(unlinkedIds, databases.NewDatabaseID(unlinkedId.SubscriptionId, unlinkedId.ResourceGroupName, unlinkedId.ClusterName, defaultDatabaseName).ID())
+	}
+
+	payload := databases.ForceUnlinkParameters{
+		Ids: unlinkedIds,
+	}
+	if err := client.ForceUnlinkThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("force-unlinking %s from %s: %+v", strings.Join(unlinkedIds, ", "), id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package managedredis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/redisenterprise"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagedRedisGeoReplicationResource struct{}
+
+func TestAccManagedRedisGeoReplication_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_redis_geo_replication", "test")
+	r := ManagedRedisGeoReplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_managed_redis_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedRedisGeoReplication_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_redis_geo_replication", "test")
+	r := ManagedRedisGeoReplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_managed_redis_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_managed_redis_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagedRedisGeoReplicationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := redisenterprise.ParseRedisEnterpriseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, "default")
+
+	resp, err := client.ManagedRedis.DatabasesClient.Get(ctx, databaseId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", databaseId, err)
+	}
+
+	linked := false
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.GeoReplication != nil {
+		if linkedDatabases := model.Properties.GeoReplication.LinkedDatabases; linkedDatabases != nil {
+			linked = len(*linkedDatabases) > 1
+		}
+	}
+
+	return utils.Bool(linked), nil
+}
+
+func (r ManagedRedisGeoReplicationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_redis_geo_replication" "test" {
+  managed_redis_id = azurerm_managed_redis.test.id
+
+  linked_managed_redis_ids = [
+    azurerm_managed_redis.test2.id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r ManagedRedisGeoReplicationResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_redis_geo_replication" "test" {
+  managed_redis_id = azurerm_managed_redis.test.id
+
+  linked_managed_redis_ids = [
+    azurerm_managed_redis.test2.id,
+    azurerm_managed_redis.test3.id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r ManagedRedisGeoReplicationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-managedredis-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_managed_redis" "test" {
+  name                = "acctest-amr-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%[2]s"
+  sku_name            = "Balanced_B3"
+
+  default_database {
+    eviction_policy            = "NoEviction"
+    geo_replication_group_name = "acctest-group-%[1]d"
+  }
+}
+
+resource "azurerm_managed_redis" "test2" {
+  name                = "acctest-amr2-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%[3]s"
+  sku_name            = "Balanced_B3"
+
+  default_database {
+    eviction_policy            = "NoEviction"
+    geo_replication_group_name = "acctest-group-%[1]d"
+  }
+}
+
+resource "azurerm_managed_redis" "test3" {
+  name                = "acctest-amr3-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%[4]s"
+  sku_name            = "Balanced_B3"
+
+  default_database {
+    eviction_policy            = "NoEviction"
+    geo_replication_group_name = "acctest-group-%[1]d"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary, data.Locations.Ternary)
+}
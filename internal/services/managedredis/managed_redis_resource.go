@@ -0,0 +1,524 @@
+package managedredis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedredis/sdk/2025-04-01/redisenterprise"
+	redisEnterpriseValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// defaultDatabaseName is the name of the single Database which can exist within a Managed Redis instance
+const defaultDatabaseName = "default"
+
+var _ sdk.ResourceWithUpdate = ManagedRedisResource{}
+
+type ManagedRedisResource struct{}
+
+type ManagedRedisResourceModel struct {
+	Name                    string                      `tfschema:"name"`
+	ResourceGroupName       string                      `tfschema:"resource_group_name"`
+	Location                string                      `tfschema:"location"`
+	SkuName                 string                      `tfschema:"sku_name"`
+	HighAvailabilityEnabled bool                        `tfschema:"high_availability_enabled"`
+	DefaultDatabase         []ManagedRedisDatabaseModel `tfschema:"default_database"`
+	Tags                    map[string]string           `tfschema:"tags"`
+	Hostname                string                      `tfschema:"hostname"`
+}
+
+type ManagedRedisDatabaseModel struct {
+	AccessKeysAuthenticationEnabled bool                      `tfschema:"access_keys_authentication_enabled"`
+	ClientProtocol                  string                    `tfschema:"client_protocol"`
+	ClusteringPolicy                string                    `tfschema:"clustering_policy"`
+	EvictionPolicy                  string                    `tfschema:"eviction_policy"`
+	GeoReplicationGroupName         string                    `tfschema:"geo_replication_group_name"`
+	Module                          []ManagedRedisModuleModel `tfschema:"module"`
+	Port                            int64                     `tfschema:"port"`
+	PrimaryAccessKey                string                    `tfschema:"primary_access_key"`
+	SecondaryAccessKey              string                    `tfschema:"secondary_access_key"`
+}
+
+type ManagedRedisModuleModel struct {
+	Name    string `tfschema:"name"`
+	Args    string `tfschema:"args"`
+	Version string `tfschema:"version"`
+}
+
+func (r ManagedRedisResource) ResourceType() string {
+	return "azurerm_managed_redis"
+}
+
+func (r ManagedRedisResource) ModelObject() interface{} {
+	return &ManagedRedisResourceModel{}
+}
+
+func (r ManagedRedisResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return redisenterprise.ValidateRedisEnterpriseID
+}
+
+func (r ManagedRedisResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: redisEnterpriseValidate.RedisEnterpriseName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(managedRedisSkuNames(), false),
+		},
+
+		"high_availability_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"default_database": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"access_keys_authentication_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"client_protocol": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(databases.ProtocolEncrypted),
+						ValidateFunc: validation.StringInSlice(databases.PossibleValuesForProtocol(), false),
+					},
+
+					"clustering_policy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(databases.ClusteringPolicyOSSCluster),
+						ValidateFunc: validation.StringInSlice(databases.PossibleValuesForClusteringPolicy(), false),
+					},
+
+					"eviction_policy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(databases.EvictionPolicyVolatileLRU),
+						ValidateFunc: validation.StringInSlice(databases.PossibleValuesForEvictionPolicy(), false),
+					},
+
+					"geo_replication_group_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"module": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 4,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ForceNew: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										"RedisBloom",
+										"RedisJSON",
+										"RediSearch",
+										"RedisTimeSeries",
+									}, false),
+								},
+
+								"args": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+
+								"version": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+
+					"port": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"primary_access_key": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+
+					"secondary_access_key": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagedRedisResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagedRedisResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.RedisEnterpriseClient
+			databasesClient := metadata.Client.ManagedRedis.DatabasesClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config ManagedRedisResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := redisenterprise.NewRedisEnterpriseID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			minimumTlsVersion := redisenterprise.TlsVersionOnePointTwo
+			payload := redisenterprise.Cluster{
+				Location: location.Normalize(config.Location),
+				Properties: &redisenterprise.ClusterProperties{
+					HighAvailability:  expandManagedRedisHighAvailability(config.HighAvailabilityEnabled),
+					MinimumTlsVersion: &minimumTlsVersion,
+				},
+				Sku: redisenterprise.Sku{
+					Name: redisenterprise.SkuName(config.SkuName),
+				},
+				Tags: &config.Tags,
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(config.DefaultDatabase) > 0 {
+				databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, defaultDatabaseName)
+				database := databases.Database{
+					Properties: expandManagedRedisDatabase(databaseId, config.DefaultDatabase[0]),
+				}
+				if err := databasesClient.CreateThenPoll(ctx, databaseId, database); err != nil {
+					return fmt.Errorf("creating %s: %+v", databaseId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedRedisResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.RedisEnterpriseClient
+			databasesClient := metadata.Client.ManagedRedis.DatabasesClient
+
+			id, err := redisenterprise.ParseRedisEnterpriseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagedRedisResourceModel{
+				Name:              id.ClusterName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.SkuName = string(model.Sku.Name)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.HighAvailabilityEnabled = props.HighAvailability == nil || *props.HighAvailability == redisenterprise.HighAvailabilityEnabled
+					if props.HostName != nil {
+						state.Hostname = *props.HostName
+					}
+				}
+			}
+
+			databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, defaultDatabaseName)
+			databaseResp, err := databasesClient.Get(ctx, databaseId)
+			if err != nil && !response.WasNotFound(databaseResp.HttpResponse) {
+				return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+			}
+
+			if model := databaseResp.Model; model != nil && model.Properties != nil {
+				database := flattenManagedRedisDatabase(*model.Properties)
+
+				if database.AccessKeysAuthenticationEnabled {
+					keysResp, err := databasesClient.ListKeys(ctx, databaseId)
+					if err != nil {
+						return fmt.Errorf("listing keys for %s: %+v", databaseId, err)
+					}
+					if keys := keysResp.Model; keys != nil {
+						database.PrimaryAccessKey = utils.NormalizeNilableString(keys.PrimaryKey)
+						database.SecondaryAccessKey = utils.NormalizeNilableString(keys.SecondaryKey)
+					}
+				}
+
+				state.DefaultDatabase = []ManagedRedisDatabaseModel{database}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedRedisResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.RedisEnterpriseClient
+			databasesClient := metadata.Client.ManagedRedis.DatabasesClient
+
+			id, err := redisenterprise.ParseRedisEnterpriseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ManagedRedisResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("sku_name", "high_availability_enabled", "tags", "tags_all") {
+				payload := redisenterprise.ClusterUpdate{}
+
+				if metadata.ResourceData.HasChange("sku_name") {
+					payload.Sku = &redisenterprise.Sku{
+						Name: redisenterprise.SkuName(config.SkuName),
+					}
+				}
+
+				if metadata.ResourceData.HasChange("high_availability_enabled") {
+					payload.Properties = &redisenterprise.ClusterProperties{
+						HighAvailability: expandManagedRedisHighAvailability(config.HighAvailabilityEnabled),
+					}
+				}
+
+				if metadata.ResourceData.HasChanges("tags", "tags_all") {
+					payload.Tags = &config.Tags
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("default_database") {
+				databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, defaultDatabaseName)
+				oldRaw, _ := metadata.ResourceData.GetChange("default_database")
+
+				switch {
+				case len(config.DefaultDatabase) == 0:
+					if err := databasesClient.DeleteThenPoll(ctx, databaseId); err != nil {
+						return fmt.Errorf("deleting %s: %+v", databaseId, err)
+					}
+
+				case len(oldRaw.([]interface{})) == 0:
+					database := databases.Database{
+						Properties: expandManagedRedisDatabase(databaseId, config.DefaultDatabase[0]),
+					}
+					if err := databasesClient.CreateThenPoll(ctx, databaseId, database); err != nil {
+						return fmt.Errorf("creating %s: %+v", databaseId, err)
+					}
+
+				default:
+					// the modules, clustering policy and geo-replication group can only be set when the Database is created
+					properties := expandManagedRedisDatabase(databaseId, config.DefaultDatabase[0])
+					properties.ClusteringPolicy = nil
+					properties.GeoReplication = nil
+					properties.Modules = nil
+
+					payload := databases.DatabaseUpdate{
+						Properties: properties,
+					}
+					if err := databasesClient.UpdateThenPoll(ctx, databaseId, payload); err != nil {
+						return fmt.Errorf("updating %s: %+v", databaseId, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedRedisResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedRedis.RedisEnterpriseClient
+
+			id, err := redisenterprise.ParseRedisEnterpriseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// deleting the Managed Redis instance also deletes its Database
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// managedRedisSkuNames returns the SKUs which are available for Azure Managed Redis, which shares
+// the Resource Provider with Redis Enterprise and so excludes the Enterprise and Enterprise Flash SKUs
+func managedRedisSkuNames() []string {
+	skuNames := make([]string, 0)
+	for _, v := range redisenterprise.PossibleValuesForSkuName() {
+		if !strings.HasPrefix(v, "Enterprise") {
+			skuNames = append(skuNames, v)
+		}
+	}
+	return skuNames
+}
+
+func expandManagedRedisHighAvailability(input bool) *redisenterprise.HighAvailability {
+	highAvailability := redisenterprise.HighAvailabilityDisabled
+	if input {
+		highAvailability = redisenterprise.HighAvailabilityEnabled
+	}
+	return &highAvailability
+}
+
+func expandManagedRedisDatabase(id databases.DatabaseId, input ManagedRedisDatabaseModel) *databases.DatabaseProperties {
+	accessKeysAuthentication := databases.AccessKeysAuthenticationDisabled
+	if input.AccessKeysAuthenticationEnabled {
+		accessKeysAuthentication = databases.AccessKeysAuthenticationEnabled
+	}
+	clientProtocol := databases.Protocol(input.ClientProtocol)
+	clusteringPolicy := databases.ClusteringPolicy(input.ClusteringPolicy)
+	evictionPolicy := databases.EvictionPolicy(input.EvictionPolicy)
+
+	modules := make([]databases.Module, 0)
+	for _, module := range input.Module {
+		modules = append(modules, databases.Module{
+			Name: module.Name,
+			Args: utils.String(module.Args),
+		})
+	}
+
+	output := &databases.DatabaseProperties{
+		AccessKeysAuthentication: &accessKeysAuthentication,
+		ClientProtocol:           &clientProtocol,
+		ClusteringPolicy:         &clusteringPolicy,
+		EvictionPolicy:           &evictionPolicy,
+		Modules:                  &modules,
+	}
+
+	if input.GeoReplicationGroupName != "" {
+		output.GeoReplication = &databases.DatabasePropertiesGeoReplication{
+			GroupNickname: utils.String(input.GeoReplicationGroupName),
+			LinkedDatabases: &[]databases.LinkedDatabase{
+				{
+					Id: utils.String(id.ID()),
+				},
+			},
+		}
+	}
+
+	return output
+}
+
+func flattenManagedRedisDatabase(input databases.DatabaseProperties) ManagedRedisDatabaseModel {
+	output := ManagedRedisDatabaseModel{
+		AccessKeysAuthenticationEnabled: input.AccessKeysAuthentication != nil && *input.AccessKeysAuthentication == databases.AccessKeysAuthenticationEnabled,
+	}
+
+	if input.ClientProtocol != nil {
+		output.ClientProtocol = string(*input.ClientProtocol)
+	}
+	if input.ClusteringPolicy != nil {
+		output.ClusteringPolicy = string(*input.ClusteringPolicy)
+	}
+	if input.EvictionPolicy != nil {
+		output.EvictionPolicy = string(*input.EvictionPolicy)
+	}
+	if input.GeoReplication != nil && input.GeoReplication.GroupNickname != nil {
+		output.GeoReplicationGroupName = *input.GeoReplication.GroupNickname
+	}
+	if input.Port != nil {
+		output.Port = *input.Port
+	}
+
+	if input.Modules != nil {
+		for _, module := range *input.Modules {
+			args := utils.NormalizeNilableString(module.Args)
+			// the API defaults the args for RediSearch to `PARTITIONS AUTO` when they're omitted
+			if strings.EqualFold(args, "PARTITIONS AUTO") {
+				args = ""
+			}
+
+			output.Module = append(output.Module, ManagedRedisModuleModel{
+				Name:    module.Name,
+				Args:    args,
+				Version: utils.NormalizeNilableString(module.Version),
+			})
+		}
+	}
+
+	return output
+}
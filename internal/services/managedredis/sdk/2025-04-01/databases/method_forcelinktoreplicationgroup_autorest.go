@@ -0,0 +1,75 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ForceLinkToReplicationGroupResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ForceLinkToReplicationGroup ...
+func (c DatabasesClient) ForceLinkToReplicationGroup(ctx context.Context, id DatabaseId, input ForceLinkParameters) (result ForceLinkToReplicationGroupResponse, err error) {
+	req, err := c.preparerForForceLinkToReplicationGroup(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "databases.DatabasesClient", "ForceLinkToReplicationGroup", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForForceLinkToReplicationGroup(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "databases.DatabasesClient", "ForceLinkToReplicationGroup", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ForceLinkToReplicationGroupThenPoll performs ForceLinkToReplicationGroup then polls until it's completed
+func (c DatabasesClient) ForceLinkToReplicationGroupThenPoll(ctx context.Context, id DatabaseId, input ForceLinkParameters) error {
+	result, err := c.ForceLinkToReplicationGroup(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ForceLinkToReplicationGroup: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ForceLinkToReplicationGroup: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForForceLinkToReplicationGroup prepares the ForceLinkToReplicationGroup request.
+func (c DatabasesClient) preparerForForceLinkToReplicationGroup(ctx context.Context, id DatabaseId, input ForceLinkParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/forceLinkToReplicationGroup", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForForceLinkToReplicationGroup sends the ForceLinkToReplicationGroup request. The method will close the
+// http.Response Body if it receives an error.
+func (c DatabasesClient) senderForForceLinkToReplicationGroup(ctx context.Context, req *http.Request) (future ForceLinkToReplicationGroupResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,6 @@
+package databases
+
+type AccessKeys struct {
+	PrimaryKey   *string `json:"primaryKey,omitempty"`
+	SecondaryKey *string `json:"secondaryKey,omitempty"`
+}
@@ -0,0 +1,6 @@
+package databases
+
+type DatabasePropertiesGeoReplication struct {
+	GroupNickname   *string           `json:"groupNickname,omitempty"`
+	LinkedDatabases *[]LinkedDatabase `json:"linkedDatabases,omitempty"`
+}
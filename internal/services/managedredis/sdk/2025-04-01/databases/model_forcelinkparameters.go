@@ -0,0 +1,5 @@
+package databases
+
+type ForceLinkParameters struct {
+	GeoReplication ForceLinkParametersGeoReplication `json:"geoReplication"`
+}
@@ -0,0 +1,6 @@
+package databases
+
+type ForceLinkParametersGeoReplication struct {
+	GroupNickname   *string           `json:"groupNickname,omitempty"`
+	LinkedDatabases *[]LinkedDatabase `json:"linkedDatabases,omitempty"`
+}
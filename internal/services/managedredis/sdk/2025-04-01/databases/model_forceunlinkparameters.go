@@ -0,0 +1,5 @@
+package databases
+
+type ForceUnlinkParameters struct {
+	Ids []string `json:"ids"`
+}
@@ -0,0 +1,6 @@
+package databases
+
+type LinkedDatabase struct {
+	Id    *string    `json:"id,omitempty"`
+	State *LinkState `json:"state,omitempty"`
+}
@@ -0,0 +1,9 @@
+package databases
+
+import "fmt"
+
+const defaultApiVersion = "2025-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/databases/%s", defaultApiVersion)
+}
@@ -0,0 +1,341 @@
+package redisenterprise
+
+import "strings"
+
+type HighAvailability string
+
+const (
+	HighAvailabilityDisabled HighAvailability = "Disabled"
+	HighAvailabilityEnabled  HighAvailability = "Enabled"
+)
+
+func PossibleValuesForHighAvailability() []string {
+	return []string{
+		string(HighAvailabilityDisabled),
+		string(HighAvailabilityEnabled),
+	}
+}
+
+func parseHighAvailability(input string) (*HighAvailability, error) {
+	vals := map[string]HighAvailability{
+		"disabled": HighAvailabilityDisabled,
+		"enabled":  HighAvailabilityEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HighAvailability(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type ResourceState string
+
+const (
+	ResourceStateCreateFailed  ResourceState = "CreateFailed"
+	ResourceStateCreating      ResourceState = "Creating"
+	ResourceStateDeleteFailed  ResourceState = "DeleteFailed"
+	ResourceStateDeleting      ResourceState = "Deleting"
+	ResourceStateDisableFailed ResourceState = "DisableFailed"
+	ResourceStateDisabled      ResourceState = "Disabled"
+	ResourceStateDisabling     ResourceState = "Disabling"
+	ResourceStateEnableFailed  ResourceState = "EnableFailed"
+	ResourceStateEnabling      ResourceState = "Enabling"
+	ResourceStateRunning       ResourceState = "Running"
+	ResourceStateUpdateFailed  ResourceState = "UpdateFailed"
+	ResourceStateUpdating      ResourceState = "Updating"
+)
+
+func PossibleValuesForResourceState() []string {
+	return []string{
+		string(ResourceStateCreateFailed),
+		string(ResourceStateCreating),
+		string(ResourceStateDeleteFailed),
+		string(ResourceStateDeleting),
+		string(ResourceStateDisableFailed),
+		string(ResourceStateDisabled),
+		string(ResourceStateDisabling),
+		string(ResourceStateEnableFailed),
+		string(ResourceStateEnabling),
+		string(ResourceStateRunning),
+		string(ResourceStateUpdateFailed),
+		string(ResourceStateUpdating),
+	}
+}
+
+func parseResourceState(input string) (*ResourceState, error) {
+	vals := map[string]ResourceState{
+		"createfailed":  ResourceStateCreateFailed,
+		"creating":      ResourceStateCreating,
+		"deletefailed":  ResourceStateDeleteFailed,
+		"deleting":      ResourceStateDeleting,
+		"disablefailed": ResourceStateDisableFailed,
+		"disabled":      ResourceStateDisabled,
+		"disabling":     ResourceStateDisabling,
+		"enablefailed":  ResourceStateEnableFailed,
+		"enabling":      ResourceStateEnabling,
+		"running":       ResourceStateRunning,
+		"updatefailed":  ResourceStateUpdateFailed,
+		"updating":      ResourceStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceState(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameBalancedB0           SkuName = "Balanced_B0"
+	SkuNameBalancedB1           SkuName = "Balanced_B1"
+	SkuNameBalancedB3           SkuName = "Balanced_B3"
+	SkuNameBalancedB5           SkuName = "Balanced_B5"
+	SkuNameBalancedB10          SkuName = "Balanced_B10"
+	SkuNameBalancedB20          SkuName = "Balanced_B20"
+	SkuNameBalancedB50          SkuName = "Balanced_B50"
+	SkuNameBalancedB100         SkuName = "Balanced_B100"
+	SkuNameBalancedB150         SkuName = "Balanced_B150"
+	SkuNameBalancedB250         SkuName = "Balanced_B250"
+	SkuNameBalancedB350         SkuName = "Balanced_B350"
+	SkuNameBalancedB500         SkuName = "Balanced_B500"
+	SkuNameBalancedB700         SkuName = "Balanced_B700"
+	SkuNameBalancedB1000        SkuName = "Balanced_B1000"
+	SkuNameComputeOptimizedX3   SkuName = "ComputeOptimized_X3"
+	SkuNameComputeOptimizedX5   SkuName = "ComputeOptimized_X5"
+	SkuNameComputeOptimizedX10  SkuName = "ComputeOptimized_X10"
+	SkuNameComputeOptimizedX20  SkuName = "ComputeOptimized_X20"
+	SkuNameComputeOptimizedX50  SkuName = "ComputeOptimized_X50"
+	SkuNameComputeOptimizedX100 SkuName = "ComputeOptimized_X100"
+	SkuNameComputeOptimizedX150 SkuName = "ComputeOptimized_X150"
+	SkuNameComputeOptimizedX250 SkuName = "ComputeOptimized_X250"
+	SkuNameComputeOptimizedX350 SkuName = "ComputeOptimized_X350"
+	SkuNameComputeOptimizedX500 SkuName = "ComputeOptimized_X500"
+	SkuNameComputeOptimizedX700 SkuName = "ComputeOptimized_X700"
+	SkuNameEnterpriseE1         SkuName = "Enterprise_E1"
+	SkuNameEnterpriseE5         SkuName = "Enterprise_E5"
+	SkuNameEnterpriseE10        SkuName = "Enterprise_E10"
+	SkuNameEnterpriseE20        SkuName = "Enterprise_E20"
+	SkuNameEnterpriseE50        SkuName = "Enterprise_E50"
+	SkuNameEnterpriseE100       SkuName = "Enterprise_E100"
+	SkuNameEnterpriseE200       SkuName = "Enterprise_E200"
+	SkuNameEnterpriseE400       SkuName = "Enterprise_E400"
+	SkuNameEnterpriseFlashF300  SkuName = "EnterpriseFlash_F300"
+	SkuNameEnterpriseFlashF700  SkuName = "EnterpriseFlash_F700"
+	SkuNameEnterpriseFlashF1500 SkuName = "EnterpriseFlash_F1500"
+	SkuNameFlashOptimizedA250   SkuName = "FlashOptimized_A250"
+	SkuNameFlashOptimizedA500   SkuName = "FlashOptimized_A500"
+	SkuNameFlashOptimizedA700   SkuName = "FlashOptimized_A700"
+	SkuNameFlashOptimizedA1000  SkuName = "FlashOptimized_A1000"
+	SkuNameFlashOptimizedA1500  SkuName = "FlashOptimized_A1500"
+	SkuNameFlashOptimizedA2000  SkuName = "FlashOptimized_A2000"
+	SkuNameFlashOptimizedA4500  SkuName = "FlashOptimized_A4500"
+	SkuNameMemoryOptimizedM10   SkuName = "MemoryOptimized_M10"
+	SkuNameMemoryOptimizedM20   SkuName = "MemoryOptimized_M20"
+	SkuNameMemoryOptimizedM50   SkuName = "MemoryOptimized_M50"
+	SkuNameMemoryOptimizedM100  SkuName = "MemoryOptimized_M100"
+	SkuNameMemoryOptimizedM150  SkuName = "MemoryOptimized_M150"
+	SkuNameMemoryOptimizedM250  SkuName = "MemoryOptimized_M250"
+	SkuNameMemoryOptimizedM350  SkuName = "MemoryOptimized_M350"
+	SkuNameMemoryOptimizedM500  SkuName = "MemoryOptimized_M500"
+	SkuNameMemoryOptimizedM700  SkuName = "MemoryOptimized_M700"
+	SkuNameMemoryOptimizedM1000 SkuName = "MemoryOptimized_M1000"
+	SkuNameMemoryOptimizedM1500 SkuName = "MemoryOptimized_M1500"
+	SkuNameMemoryOptimizedM2000 SkuName = "MemoryOptimized_M2000"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameBalancedB0),
+		string(SkuNameBalancedB1),
+		string(SkuNameBalancedB3),
+		string(SkuNameBalancedB5),
+		string(SkuNameBalancedB10),
+		string(SkuNameBalancedB20),
+		string(SkuNameBalancedB50),
+		string(SkuNameBalancedB100),
+		string(SkuNameBalancedB150),
+		string(SkuNameBalancedB250),
+		string(SkuNameBalancedB350),
+		string(SkuNameBalancedB500),
+		string(SkuNameBalancedB700),
+		string(SkuNameBalancedB1000),
+		string(SkuNameComputeOptimizedX3),
+		string(SkuNameComputeOptimizedX5),
+		string(SkuNameComputeOptimizedX10),
+		string(SkuNameComputeOptimizedX20),
+		string(SkuNameComputeOptimizedX50),
+		string(SkuNameComputeOptimizedX100),
+		string(SkuNameComputeOptimizedX150),
+		string(SkuNameComputeOptimizedX250),
+		string(SkuNameComputeOptimizedX350),
+		string(SkuNameComputeOptimizedX500),
+		string(SkuNameComputeOptimizedX700),
+		string(SkuNameEnterpriseE1),
+		string(SkuNameEnterpriseE5),
+		string(SkuNameEnterpriseE10),
+		string(SkuNameEnterpriseE20),
+		string(SkuNameEnterpriseE50),
+		string(SkuNameEnterpriseE100),
+		string(SkuNameEnterpriseE200),
+		string(SkuNameEnterpriseE400),
+		string(SkuNameEnterpriseFlashF300),
+		string(SkuNameEnterpriseFlashF700),
+		string(SkuNameEnterpriseFlashF1500),
+		string(SkuNameFlashOptimizedA250),
+		string(SkuNameFlashOptimizedA500),
+		string(SkuNameFlashOptimizedA700),
+		string(SkuNameFlashOptimizedA1000),
+		string(SkuNameFlashOptimizedA1500),
+		string(SkuNameFlashOptimizedA2000),
+		string(SkuNameFlashOptimizedA4500),
+		string(SkuNameMemoryOptimizedM10),
+		string(SkuNameMemoryOptimizedM20),
+		string(SkuNameMemoryOptimizedM50),
+		string(SkuNameMemoryOptimizedM100),
+		string(SkuNameMemoryOptimizedM150),
+		string(SkuNameMemoryOptimizedM250),
+		string(SkuNameMemoryOptimizedM350),
+		string(SkuNameMemoryOptimizedM500),
+		string(SkuNameMemoryOptimizedM700),
+		string(SkuNameMemoryOptimizedM1000),
+		string(SkuNameMemoryOptimizedM1500),
+		string(SkuNameMemoryOptimizedM2000),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"balanced_b0":           SkuNameBalancedB0,
+		"balanced_b1":           SkuNameBalancedB1,
+		"balanced_b3":           SkuNameBalancedB3,
+		"balanced_b5":           SkuNameBalancedB5,
+		"balanced_b10":          SkuNameBalancedB10,
+		"balanced_b20":          SkuNameBalancedB20,
+		"balanced_b50":          SkuNameBalancedB50,
+		"balanced_b100":         SkuNameBalancedB100,
+		"balanced_b150":         SkuNameBalancedB150,
+		"balanced_b250":         SkuNameBalancedB250,
+		"balanced_b350":         SkuNameBalancedB350,
+		"balanced_b500":         SkuNameBalancedB500,
+		"balanced_b700":         SkuNameBalancedB700,
+		"balanced_b1000":        SkuNameBalancedB1000,
+		"computeoptimized_x3":   SkuNameComputeOptimizedX3,
+		"computeoptimized_x5":   SkuNameComputeOptimizedX5,
+		"computeoptimized_x10":  SkuNameComputeOptimizedX10,
+		"computeoptimized_x20":  SkuNameComputeOptimizedX20,
+		"computeoptimized_x50":  SkuNameComputeOptimizedX50,
+		"computeoptimized_x100": SkuNameComputeOptimizedX100,
+		"computeoptimized_x150": SkuNameComputeOptimizedX150,
+		"computeoptimized_x250": SkuNameComputeOptimizedX250,
+		"computeoptimized_x350": SkuNameComputeOptimizedX350,
+		"computeoptimized_x500": SkuNameComputeOptimizedX500,
+		"computeoptimized_x700": SkuNameComputeOptimizedX700,
+		"enterprise_e1":         SkuNameEnterpriseE1,
+		"enterprise_e5":         SkuNameEnterpriseE5,
+		"enterprise_e10":        SkuNameEnterpriseE10,
+		"enterprise_e20":        SkuNameEnterpriseE20,
+		"enterprise_e50":        SkuNameEnterpriseE50,
+		"enterprise_e100":       SkuNameEnterpriseE100,
+		"enterprise_e200":       SkuNameEnterpriseE200,
+		"enterprise_e400":       SkuNameEnterpriseE400,
+		"enterpriseflash_f300":  SkuNameEnterpriseFlashF300,
+		"enterpriseflash_f700":  SkuNameEnterpriseFlashF700,
+		"enterpriseflash_f1500": SkuNameEnterpriseFlashF1500,
+		"flashoptimized_a250":   SkuNameFlashOptimizedA250,
+		"flashoptimized_a500":   SkuNameFlashOptimizedA500,
+		"flashoptimized_a700":   SkuNameFlashOptimizedA700,
+		"flashoptimized_a1000":  SkuNameFlashOptimizedA1000,
+		"flashoptimized_a1500":  SkuNameFlashOptimizedA1500,
+		"flashoptimized_a2000":  SkuNameFlashOptimizedA2000,
+		"flashoptimized_a4500":  SkuNameFlashOptimizedA4500,
+		"memoryoptimized_m10":   SkuNameMemoryOptimizedM10,
+		"memoryoptimized_m20":   SkuNameMemoryOptimizedM20,
+		"memoryoptimized_m50":   SkuNameMemoryOptimizedM50,
+		"memoryoptimized_m100":  SkuNameMemoryOptimizedM100,
+		"memoryoptimized_m150":  SkuNameMemoryOptimizedM150,
+		"memoryoptimized_m250":  SkuNameMemoryOptimizedM250,
+		"memoryoptimized_m350":  SkuNameMemoryOptimizedM350,
+		"memoryoptimized_m500":  SkuNameMemoryOptimizedM500,
+		"memoryoptimized_m700":  SkuNameMemoryOptimizedM700,
+		"memoryoptimized_m1000": SkuNameMemoryOptimizedM1000,
+		"memoryoptimized_m1500": SkuNameMemoryOptimizedM1500,
+		"memoryoptimized_m2000": SkuNameMemoryOptimizedM2000,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
+type TlsVersion string
+
+const (
+	TlsVersionOnePointTwo TlsVersion = "1.2"
+)
+
+func PossibleValuesForTlsVersion() []string {
+	return []string{
+		string(TlsVersionOnePointTwo),
+	}
+}
+
+func parseTlsVersion(input string) (*TlsVersion, error) {
+	vals := map[string]TlsVersion{
+		"1.2": TlsVersionOnePointTwo,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TlsVersion(input)
+	return &out, nil
+}
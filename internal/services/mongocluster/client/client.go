@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/sdk/2024-07-01/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/sdk/2024-07-01/mongoclusters"
+)
+
+type Client struct {
+	FirewallRulesClient *firewallrules.FirewallRulesClient
+	MongoClustersClient *mongoclusters.MongoClustersClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	firewallRulesClient := firewallrules.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&firewallRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	mongoClustersClient := mongoclusters.NewMongoClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&mongoClustersClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		FirewallRulesClient: &firewallRulesClient,
+		MongoClustersClient: &mongoClustersClient,
+	}
+}
@@ -0,0 +1,203 @@
+package mongocluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/sdk/2024-07-01/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/sdk/2024-07-01/mongoclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = MongoClusterFirewallRuleResource{}
+
+type MongoClusterFirewallRuleResource struct{}
+
+type MongoClusterFirewallRuleResourceModel struct {
+	Name           string `tfschema:"name"`
+	MongoClusterId string `tfschema:"mongo_cluster_id"`
+	StartIPAddress string `tfschema:"start_ip_address"`
+	EndIPAddress   string `tfschema:"end_ip_address"`
+}
+
+func (r MongoClusterFirewallRuleResource) ResourceType() string {
+	return "azurerm_mongo_cluster_firewall_rule"
+}
+
+func (r MongoClusterFirewallRuleResource) ModelObject() interface{} {
+	return &MongoClusterFirewallRuleResourceModel{}
+}
+
+func (r MongoClusterFirewallRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return firewallrules.ValidateFirewallRuleID
+}
+
+func (r MongoClusterFirewallRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MongoClusterFirewallRuleName(),
+		},
+
+		"mongo_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mongoclusters.ValidateMongoClusterID,
+		},
+
+		"start_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+
+		"end_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+	}
+}
+
+func (r MongoClusterFirewallRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MongoClusterFirewallRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.FirewallRulesClient
+
+			var config MongoClusterFirewallRuleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := mongoclusters.ParseMongoClusterID(config.MongoClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := firewallrules.NewFirewallRuleID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.MongoClusterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := firewallrules.FirewallRule{
+				Properties: &firewallrules.FirewallRuleProperties{
+					StartIPAddress: config.StartIPAddress,
+					EndIPAddress:   config.EndIPAddress,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MongoClusterFirewallRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.FirewallRulesClient
+
+			id, err := firewallrules.ParseFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MongoClusterFirewallRuleResourceModel{
+				Name:           id.FirewallRuleName,
+				MongoClusterId: mongoclusters.NewMongoClusterID(id.SubscriptionId, id.ResourceGroupName, id.MongoClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.StartIPAddress = props.StartIPAddress
+					state.EndIPAddress = props.EndIPAddress
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MongoClusterFirewallRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.FirewallRulesClient
+
+			id, err := firewallrules.ParseFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MongoClusterFirewallRuleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := firewallrules.FirewallRule{
+				Properties: &firewallrules.FirewallRuleProperties{
+					StartIPAddress: config.StartIPAddress,
+					EndIPAddress:   config.EndIPAddress,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MongoClusterFirewallRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.FirewallRulesClient
+
+			id, err := firewallrules.ParseFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package mongocluster_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/sdk/2024-07-01/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MongoClusterFirewallRuleResource struct{}
+
+func TestAccMongoClusterFirewallRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster_firewall_rule", "test")
+	r := MongoClusterFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMongoClusterFirewallRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster_firewall_rule", "test")
+	r := MongoClusterFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMongoClusterFirewallRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster_firewall_rule", "test")
+	r := MongoClusterFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("end_ip_address").HasValue("10.0.0.255"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MongoClusterFirewallRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := firewallrules.ParseFirewallRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MongoCluster.FirewallRulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MongoClusterFirewallRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mongo_cluster_firewall_rule" "test" {
+  name             = "acctest-fwr-%d"
+  mongo_cluster_id = azurerm_mongo_cluster.test.id
+  start_ip_address = "10.0.0.1"
+  end_ip_address   = "10.0.0.1"
+}
+`, MongoClusterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r MongoClusterFirewallRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mongo_cluster_firewall_rule" "import" {
+  name             = azurerm_mongo_cluster_firewall_rule.test.name
+  mongo_cluster_id = azurerm_mongo_cluster_firewall_rule.test.mongo_cluster_id
+  start_ip_address = azurerm_mongo_cluster_firewall_rule.test.start_ip_address
+  end_ip_address   = azurerm_mongo_cluster_firewall_rule.test.end_ip_address
+}
+`, r.basic(data))
+}
+
+func (r MongoClusterFirewallRuleResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mongo_cluster_firewall_rule" "test" {
+  name             = "acctest-fwr-%d"
+  mongo_cluster_id = azurerm_mongo_cluster.test.id
+  start_ip_address = "10.0.0.0"
+  end_ip_address   = "10.0.0.255"
+}
+`, MongoClusterResource{}.basic(data), data.RandomInteger)
+}
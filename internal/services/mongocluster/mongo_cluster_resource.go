@@ -0,0 +1,359 @@
+package mongocluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/sdk/2024-07-01/mongoclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mongocluster/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = MongoClusterResource{}
+
+type MongoClusterResource struct{}
+
+type MongoClusterResourceModel struct {
+	Name                  string            `tfschema:"name"`
+	ResourceGroupName     string            `tfschema:"resource_group_name"`
+	Location              string            `tfschema:"location"`
+	AdministratorUsername string            `tfschema:"administrator_username"`
+	AdministratorPassword string            `tfschema:"administrator_password"`
+	ServerVersion         string            `tfschema:"server_version"`
+	ComputeTier           string            `tfschema:"compute_tier"`
+	StorageSizeInGb       int64             `tfschema:"storage_size_in_gb"`
+	ShardCount            int64             `tfschema:"shard_count"`
+	HighAvailabilityMode  string            `tfschema:"high_availability_mode"`
+	PublicNetworkAccess   string            `tfschema:"public_network_access"`
+	Tags                  map[string]string `tfschema:"tags"`
+	ConnectionString      string            `tfschema:"connection_string"`
+}
+
+func (r MongoClusterResource) ResourceType() string {
+	return "azurerm_mongo_cluster"
+}
+
+func (r MongoClusterResource) ModelObject() interface{} {
+	return &MongoClusterResourceModel{}
+}
+
+func (r MongoClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return mongoclusters.ValidateMongoClusterID
+}
+
+func (r MongoClusterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MongoClusterName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"administrator_username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"administrator_password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringLenBetween(8, 256),
+		},
+
+		"server_version": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"5.0",
+				"6.0",
+				"7.0",
+			}, false),
+		},
+
+		"compute_tier": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Free",
+				"M10",
+				"M20",
+				"M25",
+				"M30",
+				"M40",
+				"M50",
+				"M60",
+				"M80",
+				"M200",
+			}, false),
+		},
+
+		"storage_size_in_gb": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(32, 16384),
+		},
+
+		"shard_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"high_availability_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(mongoclusters.HighAvailabilityModeDisabled),
+			ValidateFunc: validation.StringInSlice(mongoclusters.PossibleValuesForHighAvailabilityMode(), false),
+		},
+
+		"public_network_access": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(mongoclusters.PublicNetworkAccessEnabled),
+			ValidateFunc: validation.StringInSlice(mongoclusters.PossibleValuesForPublicNetworkAccess(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MongoClusterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r MongoClusterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.MongoClustersClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config MongoClusterResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := mongoclusters.NewMongoClusterID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			createMode := mongoclusters.CreateModeDefault
+			highAvailabilityMode := mongoclusters.HighAvailabilityMode(config.HighAvailabilityMode)
+			publicNetworkAccess := mongoclusters.PublicNetworkAccess(config.PublicNetworkAccess)
+			payload := mongoclusters.MongoCluster{
+				Location: location.Normalize(config.Location),
+				Properties: &mongoclusters.MongoClusterProperties{
+					Administrator: &mongoclusters.AdministratorProperties{
+						UserName: utils.String(config.AdministratorUsername),
+						Password: utils.String(config.AdministratorPassword),
+					},
+					Compute: &mongoclusters.ComputeProperties{
+						Tier: utils.String(config.ComputeTier),
+					},
+					CreateMode: &createMode,
+					HighAvailability: &mongoclusters.HighAvailabilityProperties{
+						TargetMode: &highAvailabilityMode,
+					},
+					PublicNetworkAccess: &publicNetworkAccess,
+					ServerVersion:       utils.String(config.ServerVersion),
+					Sharding: &mongoclusters.ShardingProperties{
+						ShardCount: utils.Int64(config.ShardCount),
+					},
+					Storage: &mongoclusters.StorageProperties{
+						SizeGb: utils.Int64(config.StorageSizeInGb),
+					},
+				},
+				Tags: &config.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MongoClusterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.MongoClustersClient
+
+			id, err := mongoclusters.ParseMongoClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MongoClusterResourceModel{
+				Name:              id.MongoClusterName,
+				ResourceGroupName: id.ResourceGroupName,
+				// the password isn't returned from the API so we'll look this up from the config
+				AdministratorPassword: metadata.ResourceData.Get("administrator_password").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.Administrator != nil && props.Administrator.UserName != nil {
+						state.AdministratorUsername = *props.Administrator.UserName
+					}
+					if props.Compute != nil && props.Compute.Tier != nil {
+						state.ComputeTier = *props.Compute.Tier
+					}
+					if props.ConnectionString != nil {
+						state.ConnectionString = *props.ConnectionString
+					}
+					if props.HighAvailability != nil && props.HighAvailability.TargetMode != nil {
+						state.HighAvailabilityMode = string(*props.HighAvailability.TargetMode)
+					}
+					if props.PublicNetworkAccess != nil {
+						state.PublicNetworkAccess = string(*props.PublicNetworkAccess)
+					}
+					if props.ServerVersion != nil {
+						state.ServerVersion = *props.ServerVersion
+					}
+					if props.Sharding != nil && props.Sharding.ShardCount != nil {
+						state.ShardCount = *props.Sharding.ShardCount
+					}
+					if props.Storage != nil && props.Storage.SizeGb != nil {
+						state.StorageSizeInGb = *props.Storage.SizeGb
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MongoClusterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.MongoClustersClient
+
+			id, err := mongoclusters.ParseMongoClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MongoClusterResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := mongoclusters.MongoClusterUpdate{
+				Properties: &mongoclusters.MongoClusterUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("administrator_password") {
+				payload.Properties.Administrator = &mongoclusters.AdministratorProperties{
+					UserName: utils.String(config.AdministratorUsername),
+					Password: utils.String(config.AdministratorPassword),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("compute_tier") {
+				payload.Properties.Compute = &mongoclusters.ComputeProperties{
+					Tier: utils.String(config.ComputeTier),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("high_availability_mode") {
+				highAvailabilityMode := mongoclusters.HighAvailabilityMode(config.HighAvailabilityMode)
+				payload.Properties.HighAvailability = &mongoclusters.HighAvailabilityProperties{
+					TargetMode: &highAvailabilityMode,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access") {
+				publicNetworkAccess := mongoclusters.PublicNetworkAccess(config.PublicNetworkAccess)
+				payload.Properties.PublicNetworkAccess = &publicNetworkAccess
+			}
+
+			if metadata.ResourceData.HasChange("server_version") {
+				payload.Properties.ServerVersion = utils.String(config.ServerVersion)
+			}
+
+			if metadata.ResourceData.HasChange("storage_size_in_gb") {
+				payload.Properties.Storage = &mongoclusters.StorageProperties{
+					SizeGb: utils.Int64(config.StorageSizeInGb),
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MongoClusterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MongoCluster.MongoClustersClient
+
+			id, err := mongoclusters.ParseMongoClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
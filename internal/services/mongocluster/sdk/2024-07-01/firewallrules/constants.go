@@ -0,0 +1,43 @@
+package firewallrules
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled   ProvisioningState = "Canceled"
+	ProvisioningStateDropping   ProvisioningState = "Dropping"
+	ProvisioningStateFailed     ProvisioningState = "Failed"
+	ProvisioningStateInProgress ProvisioningState = "InProgress"
+	ProvisioningStateSucceeded  ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating   ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDropping),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateInProgress),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":   ProvisioningStateCanceled,
+		"dropping":   ProvisioningStateDropping,
+		"failed":     ProvisioningStateFailed,
+		"inprogress": ProvisioningStateInProgress,
+		"succeeded":  ProvisioningStateSucceeded,
+		"updating":   ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package firewallrules
+
+type FirewallRuleProperties struct {
+	EndIPAddress      string             `json:"endIpAddress"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	StartIPAddress    string             `json:"startIpAddress"`
+}
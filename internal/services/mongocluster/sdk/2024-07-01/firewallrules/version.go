@@ -0,0 +1,9 @@
+package firewallrules
+
+import "fmt"
+
+const defaultApiVersion = "2024-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/firewallrules/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package mongoclusters
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MongoClustersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMongoClustersClientWithBaseURI(endpoint string) MongoClustersClient {
+	return MongoClustersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
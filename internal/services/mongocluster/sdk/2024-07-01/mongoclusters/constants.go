@@ -0,0 +1,179 @@
+package mongoclusters
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled   ProvisioningState = "Canceled"
+	ProvisioningStateDropping   ProvisioningState = "Dropping"
+	ProvisioningStateFailed     ProvisioningState = "Failed"
+	ProvisioningStateInProgress ProvisioningState = "InProgress"
+	ProvisioningStateSucceeded  ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating   ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDropping),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateInProgress),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":   ProvisioningStateCanceled,
+		"dropping":   ProvisioningStateDropping,
+		"failed":     ProvisioningStateFailed,
+		"inprogress": ProvisioningStateInProgress,
+		"succeeded":  ProvisioningStateSucceeded,
+		"updating":   ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type CreateMode string
+
+const (
+	CreateModeDefault            CreateMode = "Default"
+	CreateModeGeoReplica         CreateMode = "GeoReplica"
+	CreateModePointInTimeRestore CreateMode = "PointInTimeRestore"
+	CreateModeReplica            CreateMode = "Replica"
+)
+
+func PossibleValuesForCreateMode() []string {
+	return []string{
+		string(CreateModeDefault),
+		string(CreateModeGeoReplica),
+		string(CreateModePointInTimeRestore),
+		string(CreateModeReplica),
+	}
+}
+
+func parseCreateMode(input string) (*CreateMode, error) {
+	vals := map[string]CreateMode{
+		"default":            CreateModeDefault,
+		"georeplica":         CreateModeGeoReplica,
+		"pointintimerestore": CreateModePointInTimeRestore,
+		"replica":            CreateModeReplica,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreateMode(input)
+	return &out, nil
+}
+
+type HighAvailabilityMode string
+
+const (
+	HighAvailabilityModeDisabled               HighAvailabilityMode = "Disabled"
+	HighAvailabilityModeSameZone               HighAvailabilityMode = "SameZone"
+	HighAvailabilityModeZoneRedundantPreferred HighAvailabilityMode = "ZoneRedundantPreferred"
+)
+
+func PossibleValuesForHighAvailabilityMode() []string {
+	return []string{
+		string(HighAvailabilityModeDisabled),
+		string(HighAvailabilityModeSameZone),
+		string(HighAvailabilityModeZoneRedundantPreferred),
+	}
+}
+
+func parseHighAvailabilityMode(input string) (*HighAvailabilityMode, error) {
+	vals := map[string]HighAvailabilityMode{
+		"disabled":               HighAvailabilityModeDisabled,
+		"samezone":               HighAvailabilityModeSameZone,
+		"zoneredundantpreferred": HighAvailabilityModeZoneRedundantPreferred,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HighAvailabilityMode(input)
+	return &out, nil
+}
+
+type MongoClusterStatus string
+
+const (
+	MongoClusterStatusDropping     MongoClusterStatus = "Dropping"
+	MongoClusterStatusProvisioning MongoClusterStatus = "Provisioning"
+	MongoClusterStatusReady        MongoClusterStatus = "Ready"
+	MongoClusterStatusStarting     MongoClusterStatus = "Starting"
+	MongoClusterStatusStopped      MongoClusterStatus = "Stopped"
+	MongoClusterStatusStopping     MongoClusterStatus = "Stopping"
+	MongoClusterStatusUpdating     MongoClusterStatus = "Updating"
+)
+
+func PossibleValuesForMongoClusterStatus() []string {
+	return []string{
+		string(MongoClusterStatusDropping),
+		string(MongoClusterStatusProvisioning),
+		string(MongoClusterStatusReady),
+		string(MongoClusterStatusStarting),
+		string(MongoClusterStatusStopped),
+		string(MongoClusterStatusStopping),
+		string(MongoClusterStatusUpdating),
+	}
+}
+
+func parseMongoClusterStatus(input string) (*MongoClusterStatus, error) {
+	vals := map[string]MongoClusterStatus{
+		"dropping":     MongoClusterStatusDropping,
+		"provisioning": MongoClusterStatusProvisioning,
+		"ready":        MongoClusterStatusReady,
+		"starting":     MongoClusterStatusStarting,
+		"stopped":      MongoClusterStatusStopped,
+		"stopping":     MongoClusterStatusStopping,
+		"updating":     MongoClusterStatusUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := MongoClusterStatus(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
@@ -0,0 +1,124 @@
+package mongoclusters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = MongoClusterId{}
+
+// MongoClusterId is a struct representing the Resource ID for a Mongo Cluster
+type MongoClusterId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	MongoClusterName  string
+}
+
+// NewMongoClusterID returns a new MongoClusterId struct
+func NewMongoClusterID(subscriptionId string, resourceGroupName string, mongoClusterName string) MongoClusterId {
+	return MongoClusterId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		MongoClusterName:  mongoClusterName,
+	}
+}
+
+// ParseMongoClusterID parses 'input' into a MongoClusterId
+func ParseMongoClusterID(input string) (*MongoClusterId, error) {
+	parser := resourceids.NewParserFromResourceIdType(MongoClusterId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := MongoClusterId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.MongoClusterName, ok = parsed.Parsed["mongoClusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'mongoClusterName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseMongoClusterIDInsensitively parses 'input' case-insensitively into a MongoClusterId
+// note: this method should only be used for API response data and not user input
+func ParseMongoClusterIDInsensitively(input string) (*MongoClusterId, error) {
+	parser := resourceids.NewParserFromResourceIdType(MongoClusterId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := MongoClusterId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.MongoClusterName, ok = parsed.Parsed["mongoClusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'mongoClusterName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateMongoClusterID checks that 'input' can be parsed as a Mongo Cluster ID
+func ValidateMongoClusterID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseMongoClusterID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Mongo Cluster ID
+func (id MongoClusterId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/mongoClusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.MongoClusterName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Mongo Cluster ID
+func (id MongoClusterId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticMongoClusters", "mongoClusters", "mongoClusters"),
+		resourceids.UserSpecifiedSegment("mongoClusterName", "mongoClusterValue"),
+	}
+}
+
+// String returns a human-readable description of this Mongo Cluster ID
+func (id MongoClusterId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Mongo Cluster Name: %q", id.MongoClusterName),
+	}
+	return fmt.Sprintf("Mongo Cluster (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,6 @@
+package mongoclusters
+
+type AdministratorProperties struct {
+	Password *string `json:"password,omitempty"`
+	UserName *string `json:"userName,omitempty"`
+}
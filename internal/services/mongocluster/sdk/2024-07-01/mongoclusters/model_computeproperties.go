@@ -0,0 +1,5 @@
+package mongoclusters
+
+type ComputeProperties struct {
+	Tier *string `json:"tier,omitempty"`
+}
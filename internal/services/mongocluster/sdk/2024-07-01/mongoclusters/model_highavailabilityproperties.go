@@ -0,0 +1,5 @@
+package mongoclusters
+
+type HighAvailabilityProperties struct {
+	TargetMode *HighAvailabilityMode `json:"targetMode,omitempty"`
+}
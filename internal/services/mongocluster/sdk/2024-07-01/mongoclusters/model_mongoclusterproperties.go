@@ -0,0 +1,15 @@
+package mongoclusters
+
+type MongoClusterProperties struct {
+	Administrator       *AdministratorProperties    `json:"administrator,omitempty"`
+	ClusterStatus       *MongoClusterStatus         `json:"clusterStatus,omitempty"`
+	Compute             *ComputeProperties          `json:"compute,omitempty"`
+	ConnectionString    *string                     `json:"connectionString,omitempty"`
+	CreateMode          *CreateMode                 `json:"createMode,omitempty"`
+	HighAvailability    *HighAvailabilityProperties `json:"highAvailability,omitempty"`
+	ProvisioningState   *ProvisioningState          `json:"provisioningState,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess        `json:"publicNetworkAccess,omitempty"`
+	ServerVersion       *string                     `json:"serverVersion,omitempty"`
+	Sharding            *ShardingProperties         `json:"sharding,omitempty"`
+	Storage             *StorageProperties          `json:"storage,omitempty"`
+}
@@ -0,0 +1,6 @@
+package mongoclusters
+
+type MongoClusterUpdate struct {
+	Properties *MongoClusterUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+}
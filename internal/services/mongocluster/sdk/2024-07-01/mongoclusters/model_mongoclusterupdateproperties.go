@@ -0,0 +1,11 @@
+package mongoclusters
+
+type MongoClusterUpdateProperties struct {
+	Administrator       *AdministratorProperties    `json:"administrator,omitempty"`
+	Compute             *ComputeProperties          `json:"compute,omitempty"`
+	HighAvailability    *HighAvailabilityProperties `json:"highAvailability,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess        `json:"publicNetworkAccess,omitempty"`
+	ServerVersion       *string                     `json:"serverVersion,omitempty"`
+	Sharding            *ShardingProperties         `json:"sharding,omitempty"`
+	Storage             *StorageProperties          `json:"storage,omitempty"`
+}
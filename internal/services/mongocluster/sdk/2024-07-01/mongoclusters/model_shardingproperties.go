@@ -0,0 +1,5 @@
+package mongoclusters
+
+type ShardingProperties struct {
+	ShardCount *int64 `json:"shardCount,omitempty"`
+}
@@ -0,0 +1,5 @@
+package mongoclusters
+
+type StorageProperties struct {
+	SizeGb *int64 `json:"sizeGb,omitempty"`
+}
@@ -0,0 +1,9 @@
+package mongoclusters
+
+import "fmt"
+
+const defaultApiVersion = "2024-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/mongoclusters/%s", defaultApiVersion)
+}
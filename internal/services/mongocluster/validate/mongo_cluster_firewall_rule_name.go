@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// MongoClusterFirewallRuleName validates the name of a Mongo Cluster Firewall Rule
+func MongoClusterFirewallRuleName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][-_.a-zA-Z0-9]{0,79}$`),
+		"The name must be between 1 and 80 characters long, must start with a letter or number and may contain only letters, numbers, hyphens, underscores and periods.",
+	)
+}
@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMongoClusterFirewallRuleName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "a",
+			Expected: true,
+		},
+		{
+			Name:     "AllowAll_0.0.0.0-255.255.255.255",
+			Expected: true,
+		},
+		{
+			Name:     "rule-1",
+			Expected: true,
+		},
+		{
+			Name:     "-rule",
+			Expected: false,
+		},
+		{
+			Name:     "_rule",
+			Expected: false,
+		},
+		{
+			Name:     "rule name",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 80),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 81),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := MongoClusterFirewallRuleName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// MongoClusterName validates the name of a Mongo Cluster
+func MongoClusterName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-z0-9][-a-z0-9]{1,38}[a-z0-9]$`),
+		"The name must be between 3 and 40 characters long, may contain only lowercase letters, numbers and hyphens, and must not start or end with a hyphen.",
+	)
+}
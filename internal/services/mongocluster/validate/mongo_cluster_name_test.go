@@ -0,0 +1,72 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMongoClusterName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "ab",
+			Expected: false,
+		},
+		{
+			Name:     "abc",
+			Expected: true,
+		},
+		{
+			Name:     "mongo-cluster-1",
+			Expected: true,
+		},
+		{
+			Name:     "1cluster",
+			Expected: true,
+		},
+		{
+			Name:     "Mongo-Cluster",
+			Expected: false,
+		},
+		{
+			Name:     "-cluster",
+			Expected: false,
+		},
+		{
+			Name:     "cluster-",
+			Expected: false,
+		},
+		{
+			Name:     "mongo_cluster",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 40),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 41),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := MongoClusterName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
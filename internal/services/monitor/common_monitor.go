@@ -114,3 +114,25 @@ func flattenAzureRmScheduledQueryRulesLogDimension(input *[]insights.Dimension)
 
 	return result
 }
+
+func expandMonitorStringMap(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return &output
+}
+
+func flattenMonitorStringMap(input *map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = v
+	}
+
+	return output
+}
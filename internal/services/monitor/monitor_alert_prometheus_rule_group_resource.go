@@ -0,0 +1,450 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-03-01/prometheusrulegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorAlertPrometheusRuleGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorAlertPrometheusRuleGroupCreateUpdate,
+		Read:   resourceMonitorAlertPrometheusRuleGroupRead,
+		Update: resourceMonitorAlertPrometheusRuleGroupCreateUpdate,
+		Delete: resourceMonitorAlertPrometheusRuleGroupDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := prometheusrulegroups.ParsePrometheusRuleGroupID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				for i, item := range d.Get("rule").([]interface{}) {
+					if item == nil {
+						continue
+					}
+					rule := item.(map[string]interface{})
+
+					alert := rule["alert"].(string)
+					record := rule["record"].(string)
+					if (alert == "") == (record == "") {
+						return fmt.Errorf("exactly one of `rule.%d.alert` or `rule.%d.record` must be specified", i, i)
+					}
+
+					if record != "" {
+						if rule["for"].(string) != "" || len(rule["annotations"].(map[string]interface{})) > 0 || len(rule["action"].([]interface{})) > 0 || len(rule["alert_resolution"].([]interface{})) > 0 {
+							return fmt.Errorf("`for`, `annotations`, `action` and `alert_resolution` can only be specified for alerting rules, but `rule.%d` is a recording rule", i)
+						}
+					}
+				}
+
+				return nil
+			},
+		),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"scopes": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"rule": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expression": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"alert": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"record": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"for": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: helpersValidate.ISO8601Duration,
+						},
+
+						"severity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 4),
+						},
+
+						"labels": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"annotations": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"action_group_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validate.ActionGroupID,
+									},
+
+									"action_properties": {
+										Type:     pluginsdk.TypeMap,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"alert_resolution": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"auto_resolved": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+
+									"time_to_resolve": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: helpersValidate.ISO8601Duration,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"cluster_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"interval": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: helpersValidate.ISO8601Duration,
+			},
+
+			"rule_group_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorAlertPrometheusRuleGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.PrometheusRuleGroupsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := prometheusrulegroups.NewPrometheusRuleGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_alert_prometheus_rule_group", id.ID())
+		}
+	}
+
+	props := prometheusrulegroups.PrometheusRuleGroupProperties{
+		Enabled: utils.Bool(d.Get("rule_group_enabled").(bool)),
+		Rules:   expandMonitorAlertPrometheusRules(d.Get("rule").([]interface{})),
+		Scopes:  *utils.ExpandStringSlice(d.Get("scopes").([]interface{})),
+	}
+
+	if v := d.Get("cluster_name").(string); v != "" {
+		props.ClusterName = utils.String(v)
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		props.Description = utils.String(v)
+	}
+
+	if v := d.Get("interval").(string); v != "" {
+		props.Interval = utils.String(v)
+	}
+
+	parameters := prometheusrulegroups.PrometheusRuleGroupResource{
+		Location:   azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: props,
+		Tags:       tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorAlertPrometheusRuleGroupRead(d, meta)
+}
+
+func resourceMonitorAlertPrometheusRuleGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.PrometheusRuleGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := prometheusrulegroups.ParsePrometheusRuleGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PrometheusRuleGroupName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		props := model.Properties
+		d.Set("cluster_name", utils.NormalizeNilableString(props.ClusterName))
+		d.Set("description", utils.NormalizeNilableString(props.Description))
+		d.Set("interval", utils.NormalizeNilableString(props.Interval))
+
+		ruleGroupEnabled := true
+		if props.Enabled != nil {
+			ruleGroupEnabled = *props.Enabled
+		}
+		d.Set("rule_group_enabled", ruleGroupEnabled)
+
+		if err := d.Set("scopes", props.Scopes); err != nil {
+			return fmt.Errorf("setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("rule", flattenMonitorAlertPrometheusRules(props.Rules)); err != nil {
+			return fmt.Errorf("setting `rule`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorAlertPrometheusRuleGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.PrometheusRuleGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := prometheusrulegroups.ParsePrometheusRuleGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMonitorAlertPrometheusRules(input []interface{}) []prometheusrulegroups.PrometheusRule {
+	results := make([]prometheusrulegroups.PrometheusRule, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		rule := prometheusrulegroups.PrometheusRule{
+			Enabled:    utils.Bool(v["enabled"].(bool)),
+			Expression: v["expression"].(string),
+			Labels:     expandMonitorStringMap(v["labels"].(map[string]interface{})),
+		}
+
+		if record := v["record"].(string); record != "" {
+			rule.Record = utils.String(record)
+		}
+
+		if alert := v["alert"].(string); alert != "" {
+			rule.Alert = utils.String(alert)
+			rule.Annotations = expandMonitorStringMap(v["annotations"].(map[string]interface{}))
+			rule.Severity = utils.Int64(int64(v["severity"].(int)))
+
+			if duration := v["for"].(string); duration != "" {
+				rule.For = utils.String(duration)
+			}
+
+			actions := make([]prometheusrulegroups.PrometheusRuleGroupAction, 0)
+			for _, actionRaw := range v["action"].([]interface{}) {
+				if actionRaw == nil {
+					continue
+				}
+				action := actionRaw.(map[string]interface{})
+				actions = append(actions, prometheusrulegroups.PrometheusRuleGroupAction{
+					ActionGroupId:    utils.String(action["action_group_id"].(string)),
+					ActionProperties: expandMonitorStringMap(action["action_properties"].(map[string]interface{})),
+				})
+			}
+			rule.Actions = &actions
+
+			if resolution := v["alert_resolution"].([]interface{}); len(resolution) > 0 && resolution[0] != nil {
+				config := resolution[0].(map[string]interface{})
+				rule.ResolveConfiguration = &prometheusrulegroups.PrometheusRuleResolveConfiguration{
+					AutoResolved: utils.Bool(config["auto_resolved"].(bool)),
+				}
+				if timeToResolve := config["time_to_resolve"].(string); timeToResolve != "" {
+					rule.ResolveConfiguration.TimeToResolve = utils.String(timeToResolve)
+				}
+			}
+		}
+
+		results = append(results, rule)
+	}
+
+	return results
+}
+
+func flattenMonitorAlertPrometheusRules(input []prometheusrulegroups.PrometheusRule) []interface{} {
+	results := make([]interface{}, 0)
+	for _, rule := range input {
+		enabled := true
+		if rule.Enabled != nil {
+			enabled = *rule.Enabled
+		}
+
+		severity := 0
+		if rule.Severity != nil {
+			severity = int(*rule.Severity)
+		}
+
+		actions := make([]interface{}, 0)
+		if rule.Actions != nil {
+			for _, action := range *rule.Actions {
+				actions = append(actions, map[string]interface{}{
+					"action_group_id":   utils.NormalizeNilableString(action.ActionGroupId),
+					"action_properties": flattenMonitorStringMap(action.ActionProperties),
+				})
+			}
+		}
+
+		alertResolution := make([]interface{}, 0)
+		if config := rule.ResolveConfiguration; config != nil {
+			alertResolution = append(alertResolution, map[string]interface{}{
+				"auto_resolved":   utils.NormaliseNilableBool(config.AutoResolved),
+				"time_to_resolve": utils.NormalizeNilableString(config.TimeToResolve),
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"expression":       rule.Expression,
+			"alert":            utils.NormalizeNilableString(rule.Alert),
+			"record":           utils.NormalizeNilableString(rule.Record),
+			"enabled":          enabled,
+			"for":              utils.NormalizeNilableString(rule.For),
+			"severity":         severity,
+			"labels":           flattenMonitorStringMap(rule.Labels),
+			"annotations":      flattenMonitorStringMap(rule.Annotations),
+			"action":           actions,
+			"alert_resolution": alertResolution,
+		})
+	}
+
+	return results
+}
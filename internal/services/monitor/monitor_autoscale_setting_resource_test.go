@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-10-01/autoscalesettings"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -288,18 +288,60 @@ func TestAccAzureRMMonitorAutoScaleSetting_multipleRulesDimensions(t *testing.T)
 	})
 }
 
+func TestAccMonitorAutoScaleSetting_predictive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_autoscale_setting", "test")
+	r := MonitorAutoScaleSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.predictive(data, "ForecastOnly", "PT5M"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.0.scale_mode").HasValue("ForecastOnly"),
+				check.That(data.ResourceName).Key("predictive.0.look_ahead_time").HasValue("PT5M"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.predictive(data, "Enabled", "PT1M"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.0.scale_mode").HasValue("Enabled"),
+				check.That(data.ResourceName).Key("predictive.0.look_ahead_time").HasValue("PT1M"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t MonitorAutoScaleSettingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := parse.AutoscaleSettingID(state.ID)
+	id, err := autoscalesettings.ParseAutoscaleSettingID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := clients.Monitor.AutoscaleSettingsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Monitor.AutoscaleSettingsClient.Get(ctx, *id)
 	if err != nil {
 		return nil, fmt.Errorf("reading (%s): %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil), nil
 }
 
 func (MonitorAutoScaleSettingResource) basic(data acceptance.TestData) string {
@@ -963,6 +1005,56 @@ resource "azurerm_monitor_autoscale_setting" "test" {
 `, template, data.RandomInteger)
 }
 
+func (MonitorAutoScaleSettingResource) predictive(data acceptance.TestData, scaleMode string, lookAheadTime string) string {
+	template := MonitorAutoScaleSettingResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_autoscale_setting" "test" {
+  name                = "acctestautoscale-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  target_resource_id  = azurerm_virtual_machine_scale_set.test.id
+
+  profile {
+    name = "metricRules"
+
+    capacity {
+      default = 1
+      minimum = 1
+      maximum = 10
+    }
+
+    rule {
+      metric_trigger {
+        metric_name              = "Percentage CPU"
+        metric_resource_id       = azurerm_virtual_machine_scale_set.test.id
+        time_grain               = "PT1M"
+        statistic                = "Average"
+        time_window              = "PT5M"
+        time_aggregation         = "Last"
+        operator                 = "GreaterThan"
+        threshold                = 75
+        divide_by_instance_count = true
+      }
+
+      scale_action {
+        direction = "Increase"
+        type      = "ChangeCount"
+        value     = 1
+        cooldown  = "PT1M"
+      }
+    }
+  }
+
+  predictive {
+    scale_mode      = %q
+    look_ahead_time = %q
+  }
+}
+`, template, data.RandomInteger, scaleMode, lookAheadTime)
+}
+
 func (MonitorAutoScaleSettingResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
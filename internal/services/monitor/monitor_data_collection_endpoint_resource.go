@@ -0,0 +1,238 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorDataCollectionEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionEndpointCreateUpdate,
+		Read:   resourceMonitorDataCollectionEndpointRead,
+		Update: resourceMonitorDataCollectionEndpointCreateUpdate,
+		Delete: resourceMonitorDataCollectionEndpointDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := datacollectionendpoints.ParseDataCollectionEndpointID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datacollectionendpoints.KnownDataCollectionEndpointResourceKindLinux),
+					string(datacollectionendpoints.KnownDataCollectionEndpointResourceKindWindows),
+				}, false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"configuration_access_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"logs_ingestion_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"metrics_ingestion_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"immutable_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorDataCollectionEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := datacollectionendpoints.NewDataCollectionEndpointID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_endpoint", id.ID())
+		}
+	}
+
+	publicNetworkAccess := datacollectionendpoints.KnownPublicNetworkAccessOptionsEnabled
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = datacollectionendpoints.KnownPublicNetworkAccessOptionsDisabled
+	}
+
+	parameters := datacollectionendpoints.DataCollectionEndpointResource{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &datacollectionendpoints.DataCollectionEndpoint{
+			NetworkAcls: &datacollectionendpoints.NetworkRuleSet{
+				PublicNetworkAccess: &publicNetworkAccess,
+			},
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("kind").(string); v != "" {
+		kind := datacollectionendpoints.KnownDataCollectionEndpointResourceKind(v)
+		parameters.Kind = &kind
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionEndpointRead(d, meta)
+}
+
+func resourceMonitorDataCollectionEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionendpoints.ParseDataCollectionEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataCollectionEndpointName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		kind := ""
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
+		if props := model.Properties; props != nil {
+			d.Set("description", utils.NormalizeNilableString(props.Description))
+			d.Set("immutable_id", utils.NormalizeNilableString(props.ImmutableId))
+
+			publicNetworkAccessEnabled := true
+			if props.NetworkAcls != nil && props.NetworkAcls.PublicNetworkAccess != nil {
+				publicNetworkAccessEnabled = *props.NetworkAcls.PublicNetworkAccess == datacollectionendpoints.KnownPublicNetworkAccessOptionsEnabled
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+			configurationAccessEndpoint := ""
+			if props.ConfigurationAccess != nil {
+				configurationAccessEndpoint = utils.NormalizeNilableString(props.ConfigurationAccess.Endpoint)
+			}
+			d.Set("configuration_access_endpoint", configurationAccessEndpoint)
+
+			logsIngestionEndpoint := ""
+			if props.LogsIngestion != nil {
+				logsIngestionEndpoint = utils.NormalizeNilableString(props.LogsIngestion.Endpoint)
+			}
+			d.Set("logs_ingestion_endpoint", logsIngestionEndpoint)
+
+			metricsIngestionEndpoint := ""
+			if props.MetricsIngestion != nil {
+				metricsIngestionEndpoint = utils.NormalizeNilableString(props.MetricsIngestion.Endpoint)
+			}
+			d.Set("metrics_ingestion_endpoint", metricsIngestionEndpoint)
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionendpoints.ParseDataCollectionEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionruleassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// an association to a Data Collection Endpoint must use this name, only a single one can exist per resource
+const monitorDataCollectionEndpointAssociationName = "configurationAccessEndpoint"
+
+func resourceMonitorDataCollectionRuleAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleAssociationCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleAssociationRead,
+		Update: resourceMonitorDataCollectionRuleAssociationCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleAssociationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				name := d.Get("name").(string)
+				if d.Get("data_collection_endpoint_id").(string) != "" && name != monitorDataCollectionEndpointAssociationName {
+					return fmt.Errorf("`name` must be %q when `data_collection_endpoint_id` is specified", monitorDataCollectionEndpointAssociationName)
+				}
+				if d.Get("data_collection_rule_id").(string) != "" && name == monitorDataCollectionEndpointAssociationName {
+					return fmt.Errorf("`name` cannot be %q when `data_collection_rule_id` is specified", monitorDataCollectionEndpointAssociationName)
+				}
+
+				return nil
+			},
+		),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      monitorDataCollectionEndpointAssociationName,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"data_collection_rule_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: datacollectionrules.ValidateDataCollectionRuleID,
+				ExactlyOneOf: []string{"data_collection_rule_id", "data_collection_endpoint_id"},
+			},
+
+			"data_collection_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: datacollectionendpoints.ValidateDataCollectionEndpointID,
+				ExactlyOneOf: []string{"data_collection_rule_id", "data_collection_endpoint_id"},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(d.Get("target_resource_id").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule_association", id.ID())
+		}
+	}
+
+	parameters := datacollectionruleassociations.DataCollectionRuleAssociationProxyOnlyResource{
+		Properties: &datacollectionruleassociations.DataCollectionRuleAssociation{},
+	}
+
+	if v := d.Get("data_collection_rule_id").(string); v != "" {
+		parameters.Properties.DataCollectionRuleId = utils.String(v)
+	}
+
+	if v := d.Get("data_collection_endpoint_id").(string); v != "" {
+		parameters.Properties.DataCollectionEndpointId = utils.String(v)
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionRuleAssociationRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataCollectionRuleAssociationName)
+	d.Set("target_resource_id", id.ResourceUri)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("data_collection_rule_id", utils.NormalizeNilableString(props.DataCollectionRuleId))
+			d.Set("data_collection_endpoint_id", utils.NormalizeNilableString(props.DataCollectionEndpointId))
+			d.Set("description", utils.NormalizeNilableString(props.Description))
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionRuleAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
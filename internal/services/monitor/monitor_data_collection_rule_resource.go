@@ -0,0 +1,764 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	logAnalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorDataCollectionRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleRead,
+		Update: resourceMonitorDataCollectionRuleCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := datacollectionrules.ParseDataCollectionRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datacollectionrules.KnownDataCollectionRuleResourceKindLinux),
+					string(datacollectionrules.KnownDataCollectionRuleResourceKindWindows),
+				}, false),
+			},
+
+			"data_collection_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: datacollectionendpoints.ValidateDataCollectionEndpointID,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"data_sources": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"log_file": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							AtLeastOneOf: []string{"data_sources.0.log_file", "data_sources.0.prometheus_forwarder"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringMatch(regexp.MustCompile(`^Custom-.+`), "custom log streams must start with `Custom-`"),
+										},
+									},
+
+									"file_patterns": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"format": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(datacollectionrules.KnownLogFilesDataSourceFormatJson),
+											string(datacollectionrules.KnownLogFilesDataSourceFormatText),
+										}, false),
+									},
+
+									"settings": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"text": {
+													Type:     pluginsdk.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"record_start_timestamp_format": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(datacollectionrules.PossibleValuesForKnownLogFileTextSettingsRecordStartTimestampFormat(), false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"prometheus_forwarder": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							AtLeastOneOf: []string{"data_sources.0.log_file", "data_sources.0.prometheus_forwarder"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Microsoft-PrometheusMetrics",
+											}, false),
+										},
+									},
+
+									"label_include_filter": {
+										Type:     pluginsdk.TypeMap,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"destinations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"log_analytics": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							AtLeastOneOf: []string{"destinations.0.log_analytics", "destinations.0.monitor_account"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"workspace_resource_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+									},
+								},
+							},
+						},
+
+						"monitor_account": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							AtLeastOneOf: []string{"destinations.0.log_analytics", "destinations.0.monitor_account"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"monitor_account_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azuremonitorworkspaces.ValidateAccountID,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"data_flow": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"streams": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"output_stream": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"transform_kql": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.DataCollectionRuleTransformKql,
+						},
+					},
+				},
+			},
+
+			"stream_declaration": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"stream_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^Custom-.+`), "custom stream names must start with `Custom-`"),
+						},
+
+						"column": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"type": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(datacollectionrules.PossibleValuesForKnownColumnDefinitionType(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"immutable_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := datacollectionrules.NewDataCollectionRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule", id.ID())
+		}
+	}
+
+	parameters := datacollectionrules.DataCollectionRuleResource{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &datacollectionrules.DataCollectionRule{
+			DataFlows:          expandMonitorDataCollectionRuleDataFlows(d.Get("data_flow").([]interface{})),
+			DataSources:        expandMonitorDataCollectionRuleDataSources(d.Get("data_sources").([]interface{})),
+			Destinations:       expandMonitorDataCollectionRuleDestinations(d.Get("destinations").([]interface{})),
+			StreamDeclarations: expandMonitorDataCollectionRuleStreamDeclarations(d.Get("stream_declaration").(*pluginsdk.Set).List()),
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("kind").(string); v != "" {
+		kind := datacollectionrules.KnownDataCollectionRuleResourceKind(v)
+		parameters.Kind = &kind
+	}
+
+	if v := d.Get("data_collection_endpoint_id").(string); v != "" {
+		parameters.Properties.DataCollectionEndpointId = utils.String(v)
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionRuleRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionrules.ParseDataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataCollectionRuleName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		kind := ""
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
+		if props := model.Properties; props != nil {
+			d.Set("data_collection_endpoint_id", utils.NormalizeNilableString(props.DataCollectionEndpointId))
+			d.Set("description", utils.NormalizeNilableString(props.Description))
+			d.Set("immutable_id", utils.NormalizeNilableString(props.ImmutableId))
+
+			if err := d.Set("data_sources", flattenMonitorDataCollectionRuleDataSources(props.DataSources)); err != nil {
+				return fmt.Errorf("setting `data_sources`: %+v", err)
+			}
+
+			if err := d.Set("destinations", flattenMonitorDataCollectionRuleDestinations(props.Destinations)); err != nil {
+				return fmt.Errorf("setting `destinations`: %+v", err)
+			}
+
+			if err := d.Set("data_flow", flattenMonitorDataCollectionRuleDataFlows(props.DataFlows)); err != nil {
+				return fmt.Errorf("setting `data_flow`: %+v", err)
+			}
+
+			if err := d.Set("stream_declaration", flattenMonitorDataCollectionRuleStreamDeclarations(props.StreamDeclarations)); err != nil {
+				return fmt.Errorf("setting `stream_declaration`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionrules.ParseDataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMonitorDataCollectionRuleDataSources(input []interface{}) *datacollectionrules.DataSourcesSpec {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	forwarders := make([]datacollectionrules.PrometheusForwarderDataSource, 0)
+	for _, item := range v["prometheus_forwarder"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		forwarder := item.(map[string]interface{})
+		forwarders = append(forwarders, datacollectionrules.PrometheusForwarderDataSource{
+			LabelIncludeFilter: expandMonitorStringMap(forwarder["label_include_filter"].(map[string]interface{})),
+			Name:               utils.String(forwarder["name"].(string)),
+			Streams:            utils.ExpandStringSlice(forwarder["streams"].([]interface{})),
+		})
+	}
+
+	logFiles := make([]datacollectionrules.LogFilesDataSource, 0)
+	for _, item := range v["log_file"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		logFile := item.(map[string]interface{})
+		logFiles = append(logFiles, datacollectionrules.LogFilesDataSource{
+			FilePatterns: *utils.ExpandStringSlice(logFile["file_patterns"].([]interface{})),
+			Format:       datacollectionrules.KnownLogFilesDataSourceFormat(logFile["format"].(string)),
+			Name:         utils.String(logFile["name"].(string)),
+			Settings:     expandMonitorDataCollectionRuleLogFileSettings(logFile["settings"].([]interface{})),
+			Streams:      *utils.ExpandStringSlice(logFile["streams"].([]interface{})),
+		})
+	}
+
+	return &datacollectionrules.DataSourcesSpec{
+		LogFiles:            &logFiles,
+		PrometheusForwarder: &forwarders,
+	}
+}
+
+func expandMonitorDataCollectionRuleLogFileSettings(input []interface{}) *datacollectionrules.LogFileSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	settings := datacollectionrules.LogFileSettings{}
+	if text := v["text"].([]interface{}); len(text) > 0 && text[0] != nil {
+		raw := text[0].(map[string]interface{})
+		settings.Text = &datacollectionrules.LogFileTextSettings{
+			RecordStartTimestampFormat: datacollectionrules.KnownLogFileTextSettingsRecordStartTimestampFormat(raw["record_start_timestamp_format"].(string)),
+		}
+	}
+
+	return &settings
+}
+
+func flattenMonitorDataCollectionRuleDataSources(input *datacollectionrules.DataSourcesSpec) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	forwarders := make([]interface{}, 0)
+	if input.PrometheusForwarder != nil {
+		for _, forwarder := range *input.PrometheusForwarder {
+			forwarders = append(forwarders, map[string]interface{}{
+				"name":                 utils.NormalizeNilableString(forwarder.Name),
+				"streams":              utils.FlattenStringSlice(forwarder.Streams),
+				"label_include_filter": flattenMonitorStringMap(forwarder.LabelIncludeFilter),
+			})
+		}
+	}
+
+	logFiles := make([]interface{}, 0)
+	if input.LogFiles != nil {
+		for _, logFile := range *input.LogFiles {
+			logFiles = append(logFiles, map[string]interface{}{
+				"name":          utils.NormalizeNilableString(logFile.Name),
+				"streams":       utils.FlattenStringSlice(&logFile.Streams),
+				"file_patterns": utils.FlattenStringSlice(&logFile.FilePatterns),
+				"format":        string(logFile.Format),
+				"settings":      flattenMonitorDataCollectionRuleLogFileSettings(logFile.Settings),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_file":             logFiles,
+			"prometheus_forwarder": forwarders,
+		},
+	}
+}
+
+func flattenMonitorDataCollectionRuleLogFileSettings(input *datacollectionrules.LogFileSettings) []interface{} {
+	if input == nil || input.Text == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"text": []interface{}{
+				map[string]interface{}{
+					"record_start_timestamp_format": string(input.Text.RecordStartTimestampFormat),
+				},
+			},
+		},
+	}
+}
+
+func expandMonitorDataCollectionRuleDestinations(input []interface{}) *datacollectionrules.DestinationsSpec {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	accounts := make([]datacollectionrules.MonitoringAccountDestination, 0)
+	for _, item := range v["monitor_account"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		account := item.(map[string]interface{})
+		accounts = append(accounts, datacollectionrules.MonitoringAccountDestination{
+			AccountResourceId: utils.String(account["monitor_account_id"].(string)),
+			Name:              utils.String(account["name"].(string)),
+		})
+	}
+
+	logAnalytics := make([]datacollectionrules.LogAnalyticsDestination, 0)
+	for _, item := range v["log_analytics"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		destination := item.(map[string]interface{})
+		logAnalytics = append(logAnalytics, datacollectionrules.LogAnalyticsDestination{
+			Name:                utils.String(destination["name"].(string)),
+			WorkspaceResourceId: utils.String(destination["workspace_resource_id"].(string)),
+		})
+	}
+
+	return &datacollectionrules.DestinationsSpec{
+		LogAnalytics:       &logAnalytics,
+		MonitoringAccounts: &accounts,
+	}
+}
+
+func flattenMonitorDataCollectionRuleDestinations(input *datacollectionrules.DestinationsSpec) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	accounts := make([]interface{}, 0)
+	if input.MonitoringAccounts != nil {
+		for _, account := range *input.MonitoringAccounts {
+			accountId := ""
+			if account.AccountResourceId != nil {
+				parsed, err := azuremonitorworkspaces.ParseAccountIDInsensitively(*account.AccountResourceId)
+				if err == nil {
+					accountId = parsed.ID()
+				} else {
+					accountId = *account.AccountResourceId
+				}
+			}
+
+			accounts = append(accounts, map[string]interface{}{
+				"name":               utils.NormalizeNilableString(account.Name),
+				"monitor_account_id": accountId,
+			})
+		}
+	}
+
+	logAnalytics := make([]interface{}, 0)
+	if input.LogAnalytics != nil {
+		for _, destination := range *input.LogAnalytics {
+			workspaceId := ""
+			if destination.WorkspaceResourceId != nil {
+				parsed, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*destination.WorkspaceResourceId)
+				if err == nil {
+					workspaceId = parsed.ID()
+				} else {
+					workspaceId = *destination.WorkspaceResourceId
+				}
+			}
+
+			logAnalytics = append(logAnalytics, map[string]interface{}{
+				"name":                  utils.NormalizeNilableString(destination.Name),
+				"workspace_resource_id": workspaceId,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics":   logAnalytics,
+			"monitor_account": accounts,
+		},
+	}
+}
+
+func expandMonitorDataCollectionRuleDataFlows(input []interface{}) *[]datacollectionrules.DataFlow {
+	results := make([]datacollectionrules.DataFlow, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		flow := datacollectionrules.DataFlow{
+			Destinations: utils.ExpandStringSlice(v["destinations"].([]interface{})),
+			Streams:      utils.ExpandStringSlice(v["streams"].([]interface{})),
+		}
+
+		if outputStream := v["output_stream"].(string); outputStream != "" {
+			flow.OutputStream = utils.String(outputStream)
+		}
+
+		if transformKql := v["transform_kql"].(string); transformKql != "" {
+			flow.TransformKql = utils.String(transformKql)
+		}
+
+		results = append(results, flow)
+	}
+
+	return &results
+}
+
+func flattenMonitorDataCollectionRuleDataFlows(input *[]datacollectionrules.DataFlow) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, flow := range *input {
+		results = append(results, map[string]interface{}{
+			"streams":       utils.FlattenStringSlice(flow.Streams),
+			"destinations":  utils.FlattenStringSlice(flow.Destinations),
+			"output_stream": utils.NormalizeNilableString(flow.OutputStream),
+			"transform_kql": utils.NormalizeNilableString(flow.TransformKql),
+		})
+	}
+
+	return results
+}
+
+func expandMonitorDataCollectionRuleStreamDeclarations(input []interface{}) *map[string]datacollectionrules.StreamDeclaration {
+	results := make(map[string]datacollectionrules.StreamDeclaration)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		columns := make([]datacollectionrules.ColumnDefinition, 0)
+		for _, raw := range v["column"].([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			column := raw.(map[string]interface{})
+			columnType := datacollectionrules.KnownColumnDefinitionType(column["type"].(string))
+			columns = append(columns, datacollectionrules.ColumnDefinition{
+				Name: utils.String(column["name"].(string)),
+				Type: &columnType,
+			})
+		}
+
+		results[v["stream_name"].(string)] = datacollectionrules.StreamDeclaration{
+			Columns: &columns,
+		}
+	}
+
+	return &results
+}
+
+func flattenMonitorDataCollectionRuleStreamDeclarations(input *map[string]datacollectionrules.StreamDeclaration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for name, declaration := range *input {
+		columns := make([]interface{}, 0)
+		if declaration.Columns != nil {
+			for _, column := range *declaration.Columns {
+				columnType := ""
+				if column.Type != nil {
+					columnType = string(*column.Type)
+				}
+
+				columns = append(columns, map[string]interface{}{
+					"name": utils.NormalizeNilableString(column.Name),
+					"type": columnType,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"stream_name": name,
+			"column":      columns,
+		})
+	}
+
+	return results
+}
@@ -5,14 +5,16 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-07-01-preview/privatelinkscopesapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -32,7 +34,7 @@ func resourceMonitorPrivateLinkScope() *pluginsdk.Resource {
 		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.PrivateLinkScopeID(id)
+			_, err := privatelinkscopesapis.ParsePrivateLinkScopeID(id)
 			return err
 		}),
 
@@ -46,6 +48,46 @@ func resourceMonitorPrivateLinkScope() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			"ingestion_access_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(privatelinkscopesapis.AccessModeOpen),
+				ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+			},
+
+			"query_access_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(privatelinkscopesapis.AccessModeOpen),
+				ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+			},
+
+			"exclusion": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"private_endpoint_connection_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ingestion_access_mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+						},
+
+						"query_access_mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -57,30 +99,39 @@ func resourceMonitorPrivateLinkScopeCreateUpdate(d *pluginsdk.ResourceData, meta
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
-
-	id := parse.NewPrivateLinkScopeID(subscriptionId, resourceGroup, name)
+	id := privatelinkscopesapis.NewPrivateLinkScopeID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_monitor_private_link_scope", id.ID())
 		}
 	}
 
-	parameters := insights.AzureMonitorPrivateLinkScope{
-		Location: utils.String("Global"),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	exclusions, err := expandMonitorPrivateLinkScopeExclusions(d.Get("exclusion").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	parameters := privatelinkscopesapis.AzureMonitorPrivateLinkScope{
+		Location: "Global",
+		Properties: privatelinkscopesapis.AzureMonitorPrivateLinkScopeProperties{
+			AccessModeSettings: privatelinkscopesapis.AccessModeSettings{
+				Exclusions:          exclusions,
+				IngestionAccessMode: privatelinkscopesapis.AccessMode(d.Get("ingestion_access_mode").(string)),
+				QueryAccessMode:     privatelinkscopesapis.AccessMode(d.Get("query_access_mode").(string)),
+			},
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
@@ -94,14 +145,14 @@ func resourceMonitorPrivateLinkScopeRead(d *pluginsdk.ResourceData, meta interfa
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PrivateLinkScopeID(d.Id())
+	id, err := privatelinkscopesapis.ParsePrivateLinkScopeID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s does not exist - removing from state!", id)
 			d.SetId("")
 			return nil
@@ -109,10 +160,24 @@ func resourceMonitorPrivateLinkScopeRead(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("name", id.PrivateLinkScopeName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		settings := model.Properties.AccessModeSettings
+		d.Set("ingestion_access_mode", string(settings.IngestionAccessMode))
+		d.Set("query_access_mode", string(settings.QueryAccessMode))
+
+		if err := d.Set("exclusion", flattenMonitorPrivateLinkScopeExclusions(settings.Exclusions)); err != nil {
+			return fmt.Errorf("setting `exclusion`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceMonitorPrivateLinkScopeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -120,19 +185,70 @@ func resourceMonitorPrivateLinkScopeDelete(d *pluginsdk.ResourceData, meta inter
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PrivateLinkScopeID(d.Id())
+	id, err := privatelinkscopesapis.ParsePrivateLinkScopeID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting %s: %+v", id, err)
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	return nil
+}
+
+func expandMonitorPrivateLinkScopeExclusions(input []interface{}) (*[]privatelinkscopesapis.AccessModeSettingsExclusion, error) {
+	results := make([]privatelinkscopesapis.AccessModeSettingsExclusion, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		connectionName := v["private_endpoint_connection_name"].(string)
+		exclusion := privatelinkscopesapis.AccessModeSettingsExclusion{
+			PrivateEndpointConnectionName: utils.String(connectionName),
+		}
+
+		if mode := v["ingestion_access_mode"].(string); mode != "" {
+			accessMode := privatelinkscopesapis.AccessMode(mode)
+			exclusion.IngestionAccessMode = &accessMode
+		}
+
+		if mode := v["query_access_mode"].(string); mode != "" {
+			accessMode := privatelinkscopesapis.AccessMode(mode)
+			exclusion.QueryAccessMode = &accessMode
+		}
+
+		if exclusion.IngestionAccessMode == nil && exclusion.QueryAccessMode == nil {
+			return nil, fmt.Errorf("at least one of `ingestion_access_mode` or `query_access_mode` must be specified for the `exclusion` of private endpoint connection %q", connectionName)
+		}
+
+		results = append(results, exclusion)
 	}
 
-	return nil
+	return &results, nil
+}
+
+func flattenMonitorPrivateLinkScopeExclusions(input *[]privatelinkscopesapis.AccessModeSettingsExclusion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var ingestionAccessMode, queryAccessMode string
+		if item.IngestionAccessMode != nil {
+			ingestionAccessMode = string(*item.IngestionAccessMode)
+		}
+		if item.QueryAccessMode != nil {
+			queryAccessMode = string(*item.QueryAccessMode)
+		}
+
+		results = append(results, map[string]interface{}{
+			"private_endpoint_connection_name": utils.NormalizeNilableString(item.PrivateEndpointConnectionName),
+			"ingestion_access_mode":            ingestionAccessMode,
+			"query_access_mode":                queryAccessMode,
+		})
+	}
+
+	return results
 }
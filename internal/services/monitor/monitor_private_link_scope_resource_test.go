@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-07-01-preview/privatelinkscopesapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -82,21 +83,59 @@ func TestAccMonitorPrivateLinkScope_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorPrivateLinkScope_accessModes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scope", "test")
+	r := MonitorPrivateLinkScopeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ingestion_access_mode").HasValue("Open"),
+				check.That(data.ResourceName).Key("query_access_mode").HasValue("Open"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.accessModes(data, "PrivateOnly", "Open"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.accessModes(data, "PrivateOnly", "PrivateOnly"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r MonitorPrivateLinkScopeResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := parse.PrivateLinkScopeID(state.ID)
+	id, err := privatelinkscopesapis.ParsePrivateLinkScopeID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := client.Monitor.PrivateLinkScopesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Monitor.PrivateLinkScopesClient.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving %q %+v", id, err)
 	}
 
-	return utils.Bool(resp.AzureMonitorPrivateLinkScopeProperties != nil), nil
+	return utils.Bool(resp.Model != nil), nil
 }
 
 func (r MonitorPrivateLinkScopeResource) template(data acceptance.TestData) string {
@@ -148,3 +187,17 @@ resource "azurerm_monitor_private_link_scope" "test" {
 }
 `, r.template(data), data.RandomInteger, tag)
 }
+
+func (r MonitorPrivateLinkScopeResource) accessModes(data acceptance.TestData, ingestionAccessMode, queryAccessMode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scope" "test" {
+  name                = "acctest-ampls-%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  ingestion_access_mode = "%s"
+  query_access_mode     = "%s"
+}
+`, r.template(data), data.RandomInteger, ingestionAccessMode, queryAccessMode)
+}
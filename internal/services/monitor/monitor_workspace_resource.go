@@ -0,0 +1,216 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceMonitorWorkspace() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorWorkspaceCreate,
+		Read:   resourceMonitorWorkspaceRead,
+		Update: resourceMonitorWorkspaceUpdate,
+		Delete: resourceMonitorWorkspaceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := azuremonitorworkspaces.ParseAccountID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"query_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"default_data_collection_endpoint_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"default_data_collection_rule_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AzureMonitorWorkspacesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := azuremonitorworkspaces.NewAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_monitor_workspace", id.ID())
+	}
+
+	publicNetworkAccess := azuremonitorworkspaces.PublicNetworkAccessEnabled
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = azuremonitorworkspaces.PublicNetworkAccessDisabled
+	}
+
+	parameters := azuremonitorworkspaces.AzureMonitorWorkspaceResource{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &azuremonitorworkspaces.AzureMonitorWorkspace{
+			PublicNetworkAccess: &publicNetworkAccess,
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorWorkspaceRead(d, meta)
+}
+
+func resourceMonitorWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AzureMonitorWorkspacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuremonitorworkspaces.ParseAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AccountName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			publicNetworkAccessEnabled := true
+			if props.PublicNetworkAccess != nil {
+				publicNetworkAccessEnabled = *props.PublicNetworkAccess == azuremonitorworkspaces.PublicNetworkAccessEnabled
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+
+			queryEndpoint := ""
+			if props.Metrics != nil && props.Metrics.PrometheusQueryEndpoint != nil {
+				queryEndpoint = *props.Metrics.PrometheusQueryEndpoint
+			}
+			d.Set("query_endpoint", queryEndpoint)
+
+			dataCollectionEndpointId := ""
+			dataCollectionRuleId := ""
+			if settings := props.DefaultIngestionSettings; settings != nil {
+				if settings.DataCollectionEndpointResourceId != nil {
+					dataCollectionEndpointId = *settings.DataCollectionEndpointResourceId
+				}
+				if settings.DataCollectionRuleResourceId != nil {
+					dataCollectionRuleId = *settings.DataCollectionRuleResourceId
+				}
+			}
+			d.Set("default_data_collection_endpoint_id", dataCollectionEndpointId)
+			d.Set("default_data_collection_rule_id", dataCollectionRuleId)
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AzureMonitorWorkspacesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuremonitorworkspaces.ParseAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		parameters := azuremonitorworkspaces.AzureMonitorWorkspaceResourceForUpdate{
+			Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.Update(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceMonitorWorkspaceRead(d, meta)
+}
+
+func resourceMonitorWorkspaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AzureMonitorWorkspacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuremonitorworkspaces.ParseAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
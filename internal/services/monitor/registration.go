@@ -32,19 +32,24 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_monitor_aad_diagnostic_setting":      resourceMonitorAADDiagnosticSetting(),
-		"azurerm_monitor_autoscale_setting":           resourceMonitorAutoScaleSetting(),
-		"azurerm_monitor_action_group":                resourceMonitorActionGroup(),
-		"azurerm_monitor_action_rule_action_group":    resourceMonitorActionRuleActionGroup(),
-		"azurerm_monitor_action_rule_suppression":     resourceMonitorActionRuleSuppression(),
-		"azurerm_monitor_activity_log_alert":          resourceMonitorActivityLogAlert(),
-		"azurerm_monitor_diagnostic_setting":          resourceMonitorDiagnosticSetting(),
-		"azurerm_monitor_log_profile":                 resourceMonitorLogProfile(),
-		"azurerm_monitor_metric_alert":                resourceMonitorMetricAlert(),
-		"azurerm_monitor_private_link_scope":          resourceMonitorPrivateLinkScope(),
-		"azurerm_monitor_private_link_scoped_service": resourceMonitorPrivateLinkScopedService(),
-		"azurerm_monitor_scheduled_query_rules_alert": resourceMonitorScheduledQueryRulesAlert(),
-		"azurerm_monitor_scheduled_query_rules_log":   resourceMonitorScheduledQueryRulesLog(),
-		"azurerm_monitor_smart_detector_alert_rule":   resourceMonitorSmartDetectorAlertRule(),
+		"azurerm_monitor_aad_diagnostic_setting":           resourceMonitorAADDiagnosticSetting(),
+		"azurerm_monitor_autoscale_setting":                resourceMonitorAutoScaleSetting(),
+		"azurerm_monitor_action_group":                     resourceMonitorActionGroup(),
+		"azurerm_monitor_action_rule_action_group":         resourceMonitorActionRuleActionGroup(),
+		"azurerm_monitor_action_rule_suppression":          resourceMonitorActionRuleSuppression(),
+		"azurerm_monitor_activity_log_alert":               resourceMonitorActivityLogAlert(),
+		"azurerm_monitor_alert_prometheus_rule_group":      resourceMonitorAlertPrometheusRuleGroup(),
+		"azurerm_monitor_data_collection_endpoint":         resourceMonitorDataCollectionEndpoint(),
+		"azurerm_monitor_data_collection_rule":             resourceMonitorDataCollectionRule(),
+		"azurerm_monitor_data_collection_rule_association": resourceMonitorDataCollectionRuleAssociation(),
+		"azurerm_monitor_diagnostic_setting":               resourceMonitorDiagnosticSetting(),
+		"azurerm_monitor_log_profile":                      resourceMonitorLogProfile(),
+		"azurerm_monitor_metric_alert":                     resourceMonitorMetricAlert(),
+		"azurerm_monitor_private_link_scope":               resourceMonitorPrivateLinkScope(),
+		"azurerm_monitor_private_link_scoped_service":      resourceMonitorPrivateLinkScopedService(),
+		"azurerm_monitor_scheduled_query_rules_alert":      resourceMonitorScheduledQueryRulesAlert(),
+		"azurerm_monitor_scheduled_query_rules_log":        resourceMonitorScheduledQueryRulesLog(),
+		"azurerm_monitor_smart_detector_alert_rule":        resourceMonitorSmartDetectorAlertRule(),
+		"azurerm_monitor_workspace":                        resourceMonitorWorkspace(),
 	}
 }
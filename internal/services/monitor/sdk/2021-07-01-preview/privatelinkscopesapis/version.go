@@ -0,0 +1,9 @@
+package privatelinkscopesapis
+
+import "fmt"
+
+const defaultApiVersion = "2021-07-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/privatelinkscopesapis/%s", defaultApiVersion)
+}
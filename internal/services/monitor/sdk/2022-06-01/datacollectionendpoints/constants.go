@@ -0,0 +1,62 @@
+package datacollectionendpoints
+
+import "strings"
+
+type KnownDataCollectionEndpointResourceKind string
+
+const (
+	KnownDataCollectionEndpointResourceKindLinux   KnownDataCollectionEndpointResourceKind = "Linux"
+	KnownDataCollectionEndpointResourceKindWindows KnownDataCollectionEndpointResourceKind = "Windows"
+)
+
+func PossibleValuesForKnownDataCollectionEndpointResourceKind() []string {
+	return []string{
+		string(KnownDataCollectionEndpointResourceKindLinux),
+		string(KnownDataCollectionEndpointResourceKindWindows),
+	}
+}
+
+func parseKnownDataCollectionEndpointResourceKind(input string) (*KnownDataCollectionEndpointResourceKind, error) {
+	vals := map[string]KnownDataCollectionEndpointResourceKind{
+		"linux":   KnownDataCollectionEndpointResourceKindLinux,
+		"windows": KnownDataCollectionEndpointResourceKindWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownDataCollectionEndpointResourceKind(input)
+	return &out, nil
+}
+
+type KnownPublicNetworkAccessOptions string
+
+const (
+	KnownPublicNetworkAccessOptionsDisabled           KnownPublicNetworkAccessOptions = "Disabled"
+	KnownPublicNetworkAccessOptionsEnabled            KnownPublicNetworkAccessOptions = "Enabled"
+	KnownPublicNetworkAccessOptionsSecuredByPerimeter KnownPublicNetworkAccessOptions = "SecuredByPerimeter"
+)
+
+func PossibleValuesForKnownPublicNetworkAccessOptions() []string {
+	return []string{
+		string(KnownPublicNetworkAccessOptionsDisabled),
+		string(KnownPublicNetworkAccessOptionsEnabled),
+		string(KnownPublicNetworkAccessOptionsSecuredByPerimeter),
+	}
+}
+
+func parseKnownPublicNetworkAccessOptions(input string) (*KnownPublicNetworkAccessOptions, error) {
+	vals := map[string]KnownPublicNetworkAccessOptions{
+		"disabled":           KnownPublicNetworkAccessOptionsDisabled,
+		"enabled":            KnownPublicNetworkAccessOptionsEnabled,
+		"securedbyperimeter": KnownPublicNetworkAccessOptionsSecuredByPerimeter,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownPublicNetworkAccessOptions(input)
+	return &out, nil
+}
@@ -0,0 +1,5 @@
+package datacollectionendpoints
+
+type ConfigurationAccessEndpointSpec struct {
+	Endpoint *string `json:"endpoint,omitempty"`
+}
@@ -0,0 +1,5 @@
+package datacollectionendpoints
+
+type LogsIngestionEndpointSpec struct {
+	Endpoint *string `json:"endpoint,omitempty"`
+}
@@ -0,0 +1,5 @@
+package datacollectionendpoints
+
+type MetricsIngestionEndpointSpec struct {
+	Endpoint *string `json:"endpoint,omitempty"`
+}
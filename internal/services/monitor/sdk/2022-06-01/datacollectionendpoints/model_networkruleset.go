@@ -0,0 +1,5 @@
+package datacollectionendpoints
+
+type NetworkRuleSet struct {
+	PublicNetworkAccess *KnownPublicNetworkAccessOptions `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,15 @@
+package datacollectionruleassociations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DataCollectionRuleAssociationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDataCollectionRuleAssociationsClientWithBaseURI(endpoint string) DataCollectionRuleAssociationsClient {
+	return DataCollectionRuleAssociationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,7 @@
+package datacollectionruleassociations
+
+type DataCollectionRuleAssociation struct {
+	DataCollectionEndpointId *string `json:"dataCollectionEndpointId,omitempty"`
+	DataCollectionRuleId     *string `json:"dataCollectionRuleId,omitempty"`
+	Description              *string `json:"description,omitempty"`
+}
@@ -0,0 +1,8 @@
+package datacollectionruleassociations
+
+type DataCollectionRuleAssociationProxyOnlyResource struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *DataCollectionRuleAssociation `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package datacollectionruleassociations
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/datacollectionruleassociations/%s", defaultApiVersion)
+}
@@ -0,0 +1,151 @@
+package datacollectionrules
+
+import "strings"
+
+type KnownDataCollectionRuleResourceKind string
+
+const (
+	KnownDataCollectionRuleResourceKindLinux   KnownDataCollectionRuleResourceKind = "Linux"
+	KnownDataCollectionRuleResourceKindWindows KnownDataCollectionRuleResourceKind = "Windows"
+)
+
+func PossibleValuesForKnownDataCollectionRuleResourceKind() []string {
+	return []string{
+		string(KnownDataCollectionRuleResourceKindLinux),
+		string(KnownDataCollectionRuleResourceKindWindows),
+	}
+}
+
+func parseKnownDataCollectionRuleResourceKind(input string) (*KnownDataCollectionRuleResourceKind, error) {
+	vals := map[string]KnownDataCollectionRuleResourceKind{
+		"linux":   KnownDataCollectionRuleResourceKindLinux,
+		"windows": KnownDataCollectionRuleResourceKindWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownDataCollectionRuleResourceKind(input)
+	return &out, nil
+}
+
+type KnownColumnDefinitionType string
+
+const (
+	KnownColumnDefinitionTypeBoolean  KnownColumnDefinitionType = "boolean"
+	KnownColumnDefinitionTypeDatetime KnownColumnDefinitionType = "datetime"
+	KnownColumnDefinitionTypeDynamic  KnownColumnDefinitionType = "dynamic"
+	KnownColumnDefinitionTypeInt      KnownColumnDefinitionType = "int"
+	KnownColumnDefinitionTypeLong     KnownColumnDefinitionType = "long"
+	KnownColumnDefinitionTypeReal     KnownColumnDefinitionType = "real"
+	KnownColumnDefinitionTypeString   KnownColumnDefinitionType = "string"
+)
+
+func PossibleValuesForKnownColumnDefinitionType() []string {
+	return []string{
+		string(KnownColumnDefinitionTypeBoolean),
+		string(KnownColumnDefinitionTypeDatetime),
+		string(KnownColumnDefinitionTypeDynamic),
+		string(KnownColumnDefinitionTypeInt),
+		string(KnownColumnDefinitionTypeLong),
+		string(KnownColumnDefinitionTypeReal),
+		string(KnownColumnDefinitionTypeString),
+	}
+}
+
+func parseKnownColumnDefinitionType(input string) (*KnownColumnDefinitionType, error) {
+	vals := map[string]KnownColumnDefinitionType{
+		"boolean":  KnownColumnDefinitionTypeBoolean,
+		"datetime": KnownColumnDefinitionTypeDatetime,
+		"dynamic":  KnownColumnDefinitionTypeDynamic,
+		"int":      KnownColumnDefinitionTypeInt,
+		"long":     KnownColumnDefinitionTypeLong,
+		"real":     KnownColumnDefinitionTypeReal,
+		"string":   KnownColumnDefinitionTypeString,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownColumnDefinitionType(input)
+	return &out, nil
+}
+
+type KnownLogFileTextSettingsRecordStartTimestampFormat string
+
+const (
+	KnownLogFileTextSettingsRecordStartTimestampFormatDdMMMYyyyHHMmSsZzz                         KnownLogFileTextSettingsRecordStartTimestampFormat = "dd/MMM/yyyy:HH:mm:ss zzz"
+	KnownLogFileTextSettingsRecordStartTimestampFormatDdMMYyHHMmSs                               KnownLogFileTextSettingsRecordStartTimestampFormat = "ddMMyy HH:mm:ss"
+	KnownLogFileTextSettingsRecordStartTimestampFormatISOEightSixZeroOne                         KnownLogFileTextSettingsRecordStartTimestampFormat = "ISO 8601"
+	KnownLogFileTextSettingsRecordStartTimestampFormatMDYYYYHHMMSSAMPM                           KnownLogFileTextSettingsRecordStartTimestampFormat = "M/D/YYYY HH:MM:SS AM/PM"
+	KnownLogFileTextSettingsRecordStartTimestampFormatMMMDHhMmSs                                 KnownLogFileTextSettingsRecordStartTimestampFormat = "MMM d hh:mm:ss"
+	KnownLogFileTextSettingsRecordStartTimestampFormatMonDDYYYYHHMMSS                            KnownLogFileTextSettingsRecordStartTimestampFormat = "Mon DD, YYYY HH:MM:SS"
+	KnownLogFileTextSettingsRecordStartTimestampFormatYYYYNegativeMMNegativeDDHHMMSS             KnownLogFileTextSettingsRecordStartTimestampFormat = "YYYY-MM-DD HH:MM:SS"
+	KnownLogFileTextSettingsRecordStartTimestampFormatYyMMddHHColonmmColonss                     KnownLogFileTextSettingsRecordStartTimestampFormat = "yyMMdd HH:mm:ss"
+	KnownLogFileTextSettingsRecordStartTimestampFormatYyyyNegativeMMNegativeddTHHColonmmColonssK KnownLogFileTextSettingsRecordStartTimestampFormat = "yyyy-MM-ddTHH:mm:ssK"
+)
+
+func PossibleValuesForKnownLogFileTextSettingsRecordStartTimestampFormat() []string {
+	return []string{
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatDdMMMYyyyHHMmSsZzz),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatDdMMYyHHMmSs),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatISOEightSixZeroOne),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatMDYYYYHHMMSSAMPM),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatMMMDHhMmSs),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatMonDDYYYYHHMMSS),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatYYYYNegativeMMNegativeDDHHMMSS),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatYyMMddHHColonmmColonss),
+		string(KnownLogFileTextSettingsRecordStartTimestampFormatYyyyNegativeMMNegativeddTHHColonmmColonssK),
+	}
+}
+
+func parseKnownLogFileTextSettingsRecordStartTimestampFormat(input string) (*KnownLogFileTextSettingsRecordStartTimestampFormat, error) {
+	vals := map[string]KnownLogFileTextSettingsRecordStartTimestampFormat{
+		"dd/mmm/yyyy:hh:mm:ss zzz": KnownLogFileTextSettingsRecordStartTimestampFormatDdMMMYyyyHHMmSsZzz,
+		"ddmmyy hh:mm:ss":          KnownLogFileTextSettingsRecordStartTimestampFormatDdMMYyHHMmSs,
+		"iso 8601":                 KnownLogFileTextSettingsRecordStartTimestampFormatISOEightSixZeroOne,
+		"m/d/yyyy hh:mm:ss am/pm":  KnownLogFileTextSettingsRecordStartTimestampFormatMDYYYYHHMMSSAMPM,
+		"mmm d hh:mm:ss":           KnownLogFileTextSettingsRecordStartTimestampFormatMMMDHhMmSs,
+		"mon dd, yyyy hh:mm:ss":    KnownLogFileTextSettingsRecordStartTimestampFormatMonDDYYYYHHMMSS,
+		"yyyy-mm-dd hh:mm:ss":      KnownLogFileTextSettingsRecordStartTimestampFormatYYYYNegativeMMNegativeDDHHMMSS,
+		"yymmdd hh:mm:ss":          KnownLogFileTextSettingsRecordStartTimestampFormatYyMMddHHColonmmColonss,
+		"yyyy-mm-ddthh:mm:ssk":     KnownLogFileTextSettingsRecordStartTimestampFormatYyyyNegativeMMNegativeddTHHColonmmColonssK,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownLogFileTextSettingsRecordStartTimestampFormat(input)
+	return &out, nil
+}
+
+type KnownLogFilesDataSourceFormat string
+
+const (
+	KnownLogFilesDataSourceFormatJson KnownLogFilesDataSourceFormat = "json"
+	KnownLogFilesDataSourceFormatText KnownLogFilesDataSourceFormat = "text"
+)
+
+func PossibleValuesForKnownLogFilesDataSourceFormat() []string {
+	return []string{
+		string(KnownLogFilesDataSourceFormatJson),
+		string(KnownLogFilesDataSourceFormatText),
+	}
+}
+
+func parseKnownLogFilesDataSourceFormat(input string) (*KnownLogFilesDataSourceFormat, error) {
+	vals := map[string]KnownLogFilesDataSourceFormat{
+		"json": KnownLogFilesDataSourceFormatJson,
+		"text": KnownLogFilesDataSourceFormatText,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownLogFilesDataSourceFormat(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package datacollectionrules
+
+type DataSourcesSpec struct {
+	LogFiles            *[]LogFilesDataSource            `json:"logFiles,omitempty"`
+	PrometheusForwarder *[]PrometheusForwarderDataSource `json:"prometheusForwarder,omitempty"`
+}
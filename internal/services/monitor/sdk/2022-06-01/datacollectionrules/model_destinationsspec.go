@@ -0,0 +1,6 @@
+package datacollectionrules
+
+type DestinationsSpec struct {
+	LogAnalytics       *[]LogAnalyticsDestination      `json:"logAnalytics,omitempty"`
+	MonitoringAccounts *[]MonitoringAccountDestination `json:"monitoringAccounts,omitempty"`
+}
@@ -0,0 +1,5 @@
+package datacollectionrules
+
+type LogFileSettings struct {
+	Text *LogFileTextSettings `json:"text,omitempty"`
+}
@@ -0,0 +1,6 @@
+package datacollectionrules
+
+type MonitoringAccountDestination struct {
+	AccountResourceId *string `json:"accountResourceId,omitempty"`
+	Name              *string `json:"name,omitempty"`
+}
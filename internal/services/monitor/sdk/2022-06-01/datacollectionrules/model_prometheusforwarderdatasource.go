@@ -0,0 +1,7 @@
+package datacollectionrules
+
+type PrometheusForwarderDataSource struct {
+	LabelIncludeFilter *map[string]string `json:"labelIncludeFilter,omitempty"`
+	Name               *string            `json:"name,omitempty"`
+	Streams            *[]string          `json:"streams,omitempty"`
+}
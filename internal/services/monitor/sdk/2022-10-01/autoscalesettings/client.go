@@ -0,0 +1,15 @@
+package autoscalesettings
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AutoscaleSettingsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAutoscaleSettingsClientWithBaseURI(endpoint string) AutoscaleSettingsClient {
+	return AutoscaleSettingsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
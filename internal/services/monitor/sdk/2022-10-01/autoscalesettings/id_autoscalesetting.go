@@ -0,0 +1,124 @@
+package autoscalesettings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = AutoscaleSettingId{}
+
+// AutoscaleSettingId is a struct representing the Resource ID for a Autoscale Setting
+type AutoscaleSettingId struct {
+	SubscriptionId       string
+	ResourceGroupName    string
+	AutoscaleSettingName string
+}
+
+// NewAutoscaleSettingID returns a new AutoscaleSettingId struct
+func NewAutoscaleSettingID(subscriptionId string, resourceGroupName string, autoscaleSettingName string) AutoscaleSettingId {
+	return AutoscaleSettingId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroupName:    resourceGroupName,
+		AutoscaleSettingName: autoscaleSettingName,
+	}
+}
+
+// ParseAutoscaleSettingID parses 'input' into a AutoscaleSettingId
+func ParseAutoscaleSettingID(input string) (*AutoscaleSettingId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AutoscaleSettingId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AutoscaleSettingId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AutoscaleSettingName, ok = parsed.Parsed["autoscaleSettingName"]; !ok {
+		return nil, fmt.Errorf("the segment 'autoscaleSettingName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseAutoscaleSettingIDInsensitively parses 'input' case-insensitively into a AutoscaleSettingId
+// note: this method should only be used for API response data and not user input
+func ParseAutoscaleSettingIDInsensitively(input string) (*AutoscaleSettingId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AutoscaleSettingId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AutoscaleSettingId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AutoscaleSettingName, ok = parsed.Parsed["autoscaleSettingName"]; !ok {
+		return nil, fmt.Errorf("the segment 'autoscaleSettingName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateAutoscaleSettingID checks that 'input' can be parsed as a Autoscale Setting ID
+func ValidateAutoscaleSettingID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAutoscaleSettingID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Autoscale Setting ID
+func (id AutoscaleSettingId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/autoscaleSettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.AutoscaleSettingName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Autoscale Setting ID
+func (id AutoscaleSettingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftInsights", "Microsoft.Insights", "Microsoft.Insights"),
+		resourceids.StaticSegment("staticAutoscaleSettings", "autoscaleSettings", "autoscaleSettings"),
+		resourceids.UserSpecifiedSegment("autoscaleSettingName", "autoscaleSettingValue"),
+	}
+}
+
+// String returns a human-readable description of this Autoscale Setting ID
+func (id AutoscaleSettingId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Autoscale Setting Name: %q", id.AutoscaleSettingName),
+	}
+	return fmt.Sprintf("Autoscale Setting (%s)", strings.Join(components, "\n"))
+}
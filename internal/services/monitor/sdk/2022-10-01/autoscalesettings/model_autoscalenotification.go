@@ -0,0 +1,7 @@
+package autoscalesettings
+
+type AutoscaleNotification struct {
+	Email     *EmailNotification     `json:"email,omitempty"`
+	Operation OperationType          `json:"operation"`
+	Webhooks  *[]WebhookNotification `json:"webhooks,omitempty"`
+}
@@ -0,0 +1,9 @@
+package autoscalesettings
+
+type AutoscaleProfile struct {
+	Capacity   ScaleCapacity `json:"capacity"`
+	FixedDate  *TimeWindow   `json:"fixedDate,omitempty"`
+	Name       string        `json:"name"`
+	Recurrence *Recurrence   `json:"recurrence,omitempty"`
+	Rules      []ScaleRule   `json:"rules"`
+}
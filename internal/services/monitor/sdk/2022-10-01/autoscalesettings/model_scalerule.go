@@ -0,0 +1,6 @@
+package autoscalesettings
+
+type ScaleRule struct {
+	MetricTrigger MetricTrigger `json:"metricTrigger"`
+	ScaleAction   ScaleAction   `json:"scaleAction"`
+}
@@ -0,0 +1,31 @@
+package autoscalesettings
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type TimeWindow struct {
+	End      string  `json:"end"`
+	Start    string  `json:"start"`
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+func (o TimeWindow) GetEndAsTime() (*time.Time, error) {
+	return dates.ParseAsFormat(&o.End, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o *TimeWindow) SetEndAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.End = formatted
+}
+
+func (o TimeWindow) GetStartAsTime() (*time.Time, error) {
+	return dates.ParseAsFormat(&o.Start, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o *TimeWindow) SetStartAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.Start = formatted
+}
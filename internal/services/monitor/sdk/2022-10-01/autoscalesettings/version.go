@@ -0,0 +1,9 @@
+package autoscalesettings
+
+import "fmt"
+
+const defaultApiVersion = "2022-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/autoscalesettings/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package prometheusrulegroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrometheusRuleGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrometheusRuleGroupsClientWithBaseURI(endpoint string) PrometheusRuleGroupsClient {
+	return PrometheusRuleGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
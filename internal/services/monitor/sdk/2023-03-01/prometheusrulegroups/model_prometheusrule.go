@@ -0,0 +1,14 @@
+package prometheusrulegroups
+
+type PrometheusRule struct {
+	Actions              *[]PrometheusRuleGroupAction        `json:"actions,omitempty"`
+	Alert                *string                             `json:"alert,omitempty"`
+	Annotations          *map[string]string                  `json:"annotations,omitempty"`
+	Enabled              *bool                               `json:"enabled,omitempty"`
+	Expression           string                              `json:"expression"`
+	For                  *string                             `json:"for,omitempty"`
+	Labels               *map[string]string                  `json:"labels,omitempty"`
+	Record               *string                             `json:"record,omitempty"`
+	ResolveConfiguration *PrometheusRuleResolveConfiguration `json:"resolveConfiguration,omitempty"`
+	Severity             *int64                              `json:"severity,omitempty"`
+}
@@ -0,0 +1,6 @@
+package prometheusrulegroups
+
+type PrometheusRuleGroupAction struct {
+	ActionGroupId    *string            `json:"actionGroupId,omitempty"`
+	ActionProperties *map[string]string `json:"actionProperties,omitempty"`
+}
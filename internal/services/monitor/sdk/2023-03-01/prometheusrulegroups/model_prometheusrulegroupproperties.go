@@ -0,0 +1,10 @@
+package prometheusrulegroups
+
+type PrometheusRuleGroupProperties struct {
+	ClusterName *string          `json:"clusterName,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Enabled     *bool            `json:"enabled,omitempty"`
+	Interval    *string          `json:"interval,omitempty"`
+	Rules       []PrometheusRule `json:"rules"`
+	Scopes      []string         `json:"scopes"`
+}
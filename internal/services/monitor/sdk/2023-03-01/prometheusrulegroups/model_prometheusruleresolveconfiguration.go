@@ -0,0 +1,6 @@
+package prometheusrulegroups
+
+type PrometheusRuleResolveConfiguration struct {
+	AutoResolved  *bool   `json:"autoResolved,omitempty"`
+	TimeToResolve *string `json:"timeToResolve,omitempty"`
+}
@@ -0,0 +1,6 @@
+package azuremonitorworkspaces
+
+type IngestionSettings struct {
+	DataCollectionEndpointResourceId *string `json:"dataCollectionEndpointResourceId,omitempty"`
+	DataCollectionRuleResourceId     *string `json:"dataCollectionRuleResourceId,omitempty"`
+}
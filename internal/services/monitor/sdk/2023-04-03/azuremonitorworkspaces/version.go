@@ -0,0 +1,9 @@
+package azuremonitorworkspaces
+
+import "fmt"
+
+const defaultApiVersion = "2023-04-03"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/azuremonitorworkspaces/%s", defaultApiVersion)
+}
@@ -0,0 +1,116 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DataCollectionRuleTransformKql performs a syntactic check of a Data Collection Rule transformation, which must be a
+// KQL query over the virtual `source` table. The service only validates the query once the rule is applied, so this
+// catches the common mistakes (unbalanced brackets, unterminated strings and empty pipeline stages) at plan time.
+func DataCollectionRuleTransformKql(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	query := strings.TrimSpace(v)
+	if query == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	stages, err := splitTransformKqlStages(query)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid KQL query: %+v", k, err))
+		return
+	}
+
+	if first := strings.Fields(stages[0]); len(first) != 1 || first[0] != "source" {
+		errors = append(errors, fmt.Errorf("%q must begin with the `source` table, e.g. `source | where ...`", k))
+		return
+	}
+
+	for i, stage := range stages[1:] {
+		if strings.TrimSpace(stage) == "" {
+			errors = append(errors, fmt.Errorf("%q is not a valid KQL query: pipeline stage %d is empty", k, i+2))
+			return
+		}
+	}
+
+	return
+}
+
+// splitTransformKqlStages splits the query on the top-level pipe operators, ignoring any within string literals,
+// comments or brackets, and returns an error if a string literal or bracket isn't closed.
+func splitTransformKqlStages(query string) ([]string, error) {
+	closers := map[rune]rune{
+		'(': ')',
+		'[': ']',
+		'{': '}',
+	}
+
+	stages := make([]string, 0)
+	brackets := make([]rune, 0)
+	runes := []rune(query)
+	var stage strings.Builder
+
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+			continue
+
+		case c == '\'' || c == '"':
+			verbatim := i > 0 && runes[i-1] == '@'
+			terminated := false
+			begin := i
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\\' && !verbatim {
+					i++
+					continue
+				}
+				if runes[i] == c {
+					// within a verbatim string the quote character is escaped by doubling it
+					if verbatim && i+1 < len(runes) && runes[i+1] == c {
+						i++
+						continue
+					}
+					terminated = true
+					break
+				}
+			}
+			if !terminated {
+				return nil, fmt.Errorf("unterminated string literal")
+			}
+			stage.WriteString(string(runes[begin : i+1]))
+			continue
+
+		case c == '(' || c == '[' || c == '{':
+			brackets = append(brackets, closers[c])
+
+		case c == ')' || c == ']' || c == '}':
+			if len(brackets) == 0 || brackets[len(brackets)-1] != c {
+				return nil, fmt.Errorf("unexpected %q at position %d", string(c), i+1)
+			}
+			brackets = brackets[:len(brackets)-1]
+
+		case c == '|' && len(brackets) == 0:
+			stages = append(stages, stage.String())
+			stage.Reset()
+			continue
+		}
+
+		stage.WriteRune(c)
+	}
+
+	if len(brackets) > 0 {
+		return nil, fmt.Errorf("expected %q before the end of the query", string(brackets[len(brackets)-1]))
+	}
+
+	return append(stages, stage.String()), nil
+}
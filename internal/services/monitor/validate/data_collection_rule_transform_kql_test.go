@@ -0,0 +1,77 @@
+package validate
+
+import "testing"
+
+func TestDataCollectionRuleTransformKql(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "source",
+			expected: true,
+		},
+		{
+			input:    "source | where Level == 'Error'",
+			expected: true,
+		},
+		{
+			input:    "source | extend TimeGenerated = todatetime(Time) | project TimeGenerated, Message = tostring(RawData)",
+			expected: true,
+		},
+		{
+			input:    "source\n// drop the noisy entries\n| where Message !has \"debug|trace\"",
+			expected: true,
+		},
+		{
+			input:    "source | extend Path = @'C:\\logs\\app.log', Quote = 'it''s'",
+			expected: true,
+		},
+		{
+			input:    "source | extend Props = parse_json(RawData) | extend Name = tostring(Props['name'])",
+			expected: true,
+		},
+		{
+			input:    "Syslog | where Level == 'Error'",
+			expected: false,
+		},
+		{
+			input:    "source Syslog",
+			expected: false,
+		},
+		{
+			input:    "source | where Level == 'Error",
+			expected: false,
+		},
+		{
+			input:    "source | extend Name = tostring(Props['name']",
+			expected: false,
+		},
+		{
+			input:    "source | extend Name = tostring(Props['name')]",
+			expected: false,
+		},
+		{
+			input:    "source | | where Level == 'Error'",
+			expected: false,
+		},
+		{
+			input:    "source | where Level == 'Error' |",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DataCollectionRuleTransformKql(v.input, "transform_kql")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
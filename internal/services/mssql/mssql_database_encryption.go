@@ -0,0 +1,141 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-08-01-preview/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// NOTE: Database-level Customer Managed Keys are only available in a newer API version than the one used for
+// the Database itself, as such these are configured with a separate PATCH once the Database has been provisioned
+
+func updateMsSqlDatabaseEncryption(ctx context.Context, client *databases.DatabasesClient, databaseId parse.DatabaseId, d *pluginsdk.ResourceData) error {
+	id := databases.NewDatabaseID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name)
+
+	keyVaultKeyId := d.Get("transparent_data_encryption_key_vault_key_id").(string)
+	keys := make(map[string]databases.DatabaseKey)
+	if keyVaultKeyId != "" {
+		keys[keyVaultKeyId] = databases.DatabaseKey{}
+	}
+	for _, v := range d.Get("transparent_data_encryption_additional_key_vault_key_ids").(*pluginsdk.Set).List() {
+		keys[v.(string)] = databases.DatabaseKey{}
+	}
+
+	payload := databases.DatabaseUpdate{
+		Identity: expandMsSqlDatabaseIdentity(d.Get("identity").([]interface{})),
+		Properties: &databases.DatabaseUpdateProperties{
+			EncryptionProtectorAutoRotation: utils.Bool(d.Get("transparent_data_encryption_key_automatic_rotation_enabled").(bool)),
+			Keys:                            &keys,
+		},
+	}
+	if keyVaultKeyId != "" {
+		payload.Properties.EncryptionProtector = utils.String(keyVaultKeyId)
+	}
+
+	if err := client.UpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating the encryption configuration for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func readMsSqlDatabaseEncryption(ctx context.Context, client *databases.DatabasesClient, databaseId parse.DatabaseId, d *pluginsdk.ResourceData) error {
+	id := databases.NewDatabaseID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name)
+
+	options := databases.GetOperationOptions{
+		Expand: utils.String("keys"),
+	}
+	resp, err := client.Get(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("retrieving the encryption configuration for %s: %+v", id, err)
+	}
+
+	identity := make([]interface{}, 0)
+	keyVaultKeyId := ""
+	additionalKeyVaultKeyIds := make([]interface{}, 0)
+	autoRotationEnabled := false
+
+	if model := resp.Model; model != nil {
+		identity, err = flattenMsSqlDatabaseIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			if props.EncryptionProtector != nil {
+				keyVaultKeyId = *props.EncryptionProtector
+			}
+			if props.EncryptionProtectorAutoRotation != nil {
+				autoRotationEnabled = *props.EncryptionProtectorAutoRotation
+			}
+			if props.Keys != nil {
+				for key := range *props.Keys {
+					if !strings.EqualFold(key, keyVaultKeyId) {
+						additionalKeyVaultKeyIds = append(additionalKeyVaultKeyIds, key)
+					}
+				}
+			}
+		}
+	}
+
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+	d.Set("transparent_data_encryption_key_vault_key_id", keyVaultKeyId)
+	d.Set("transparent_data_encryption_additional_key_vault_key_ids", additionalKeyVaultKeyIds)
+	d.Set("transparent_data_encryption_key_automatic_rotation_enabled", autoRotationEnabled)
+
+	return nil
+}
+
+func expandMsSqlDatabaseIdentity(input []interface{}) *databases.DatabaseIdentity {
+	identityType := databases.DatabaseIdentityTypeNone
+	if len(input) == 0 || input[0] == nil {
+		return &databases.DatabaseIdentity{
+			Type: &identityType,
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	identityType = databases.DatabaseIdentityType(raw["type"].(string))
+
+	userAssignedIdentities := make(map[string]databases.DatabaseUserIdentity)
+	for _, id := range raw["user_assigned_identity_ids"].(*pluginsdk.Set).List() {
+		userAssignedIdentities[id.(string)] = databases.DatabaseUserIdentity{}
+	}
+
+	return &databases.DatabaseIdentity{
+		Type:                   &identityType,
+		UserAssignedIdentities: &userAssignedIdentities,
+	}
+}
+
+func flattenMsSqlDatabaseIdentity(input *databases.DatabaseIdentity) ([]interface{}, error) {
+	if input == nil || input.Type == nil || *input.Type == databases.DatabaseIdentityTypeNone {
+		return []interface{}{}, nil
+	}
+
+	identityIds := make([]interface{}, 0)
+	if input.UserAssignedIdentities != nil {
+		for key := range *input.UserAssignedIdentities {
+			parsedId, err := msiparse.UserAssignedIdentityIDInsensitively(key)
+			if err != nil {
+				return nil, err
+			}
+			identityIds = append(identityIds, parsedId.ID())
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                       string(*input.Type),
+			"user_assigned_identity_ids": identityIds,
+		},
+	}, nil
+}
@@ -697,6 +697,30 @@ func TestAccMsSqlDatabase_geoBackupPolicy(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_transparentDataEncryptionKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.transparentDataEncryptionKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.transparentDataEncryptionKeyRotated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("transparent_data_encryption_additional_key_vault_key_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("transparent_data_encryption_key_automatic_rotation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMsSqlDatabase_transitDataEncryption(t *testing.T) {
 	if !features.ThreePointOh() {
 		t.Skipf("This test runs only on 3.0")
@@ -1747,3 +1771,95 @@ resource "azurerm_mssql_database" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r MsSqlDatabaseResource) transparentDataEncryptionKeyTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestdb%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  soft_delete_retention_days = 7
+  purge_protection_enabled   = true
+  sku_name                   = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    key_permissions = ["Get", "List", "Create", "Delete", "Update", "Purge", "GetRotationPolicy", "SetRotationPolicy"]
+  }
+
+  access_policy {
+    tenant_id = azurerm_user_assigned_identity.test.tenant_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+
+    key_permissions = ["Get", "WrapKey", "UnwrapKey"]
+  }
+}
+
+resource "azurerm_key_vault_key" "generated" {
+  name         = "generated"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["unwrapKey", "wrapKey"]
+}
+
+resource "azurerm_key_vault_key" "rotated" {
+  name         = "rotated"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["unwrapKey", "wrapKey"]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r MsSqlDatabaseResource) transparentDataEncryptionKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+
+  identity {
+    type                       = "UserAssigned"
+    user_assigned_identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  transparent_data_encryption_key_vault_key_id = azurerm_key_vault_key.generated.id
+}
+`, r.transparentDataEncryptionKeyTemplate(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseResource) transparentDataEncryptionKeyRotated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+
+  identity {
+    type                       = "UserAssigned"
+    user_assigned_identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  transparent_data_encryption_key_vault_key_id               = azurerm_key_vault_key.rotated.id
+  transparent_data_encryption_additional_key_vault_key_ids   = [azurerm_key_vault_key.generated.id]
+  transparent_data_encryption_key_automatic_rotation_enabled = true
+}
+`, r.transparentDataEncryptionKeyTemplate(data), data.RandomInteger)
+}
@@ -0,0 +1,56 @@
+package databases
+
+import "strings"
+
+type DatabaseIdentityType string
+
+const (
+	DatabaseIdentityTypeNone         DatabaseIdentityType = "None"
+	DatabaseIdentityTypeUserAssigned DatabaseIdentityType = "UserAssigned"
+)
+
+func PossibleValuesForDatabaseIdentityType() []string {
+	return []string{
+		string(DatabaseIdentityTypeNone),
+		string(DatabaseIdentityTypeUserAssigned),
+	}
+}
+
+func parseDatabaseIdentityType(input string) (*DatabaseIdentityType, error) {
+	vals := map[string]DatabaseIdentityType{
+		"none":         DatabaseIdentityTypeNone,
+		"userassigned": DatabaseIdentityTypeUserAssigned,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DatabaseIdentityType(input)
+	return &out, nil
+}
+
+type DatabaseKeyType string
+
+const (
+	DatabaseKeyTypeAzureKeyVault DatabaseKeyType = "AzureKeyVault"
+)
+
+func PossibleValuesForDatabaseKeyType() []string {
+	return []string{
+		string(DatabaseKeyTypeAzureKeyVault),
+	}
+}
+
+func parseDatabaseKeyType(input string) (*DatabaseKeyType, error) {
+	vals := map[string]DatabaseKeyType{
+		"azurekeyvault": DatabaseKeyTypeAzureKeyVault,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DatabaseKeyType(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package databases
+
+type Database struct {
+	Id         *string             `json:"id,omitempty"`
+	Identity   *DatabaseIdentity   `json:"identity,omitempty"`
+	Location   string              `json:"location"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *DatabaseProperties `json:"properties,omitempty"`
+	Tags       *map[string]string  `json:"tags,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package databases
+
+type DatabaseIdentity struct {
+	TenantId               *string                          `json:"tenantId,omitempty"`
+	Type                   *DatabaseIdentityType            `json:"type,omitempty"`
+	UserAssignedIdentities *map[string]DatabaseUserIdentity `json:"userAssignedIdentities,omitempty"`
+}
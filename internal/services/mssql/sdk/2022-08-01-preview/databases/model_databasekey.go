@@ -0,0 +1,8 @@
+package databases
+
+type DatabaseKey struct {
+	CreationDate *string          `json:"creationDate,omitempty"`
+	Subregion    *string          `json:"subregion,omitempty"`
+	Thumbprint   *string          `json:"thumbprint,omitempty"`
+	Type         *DatabaseKeyType `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package databases
+
+type DatabaseUpdate struct {
+	Identity   *DatabaseIdentity         `json:"identity,omitempty"`
+	Properties *DatabaseUpdateProperties `json:"properties,omitempty"`
+}
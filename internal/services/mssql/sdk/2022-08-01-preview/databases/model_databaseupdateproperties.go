@@ -0,0 +1,7 @@
+package databases
+
+type DatabaseUpdateProperties struct {
+	EncryptionProtector             *string                 `json:"encryptionProtector,omitempty"`
+	EncryptionProtectorAutoRotation *bool                   `json:"encryptionProtectorAutoRotation,omitempty"`
+	Keys                            *map[string]DatabaseKey `json:"keys,omitempty"`
+}
@@ -0,0 +1,6 @@
+package databases
+
+type DatabaseUserIdentity struct {
+	ClientId    *string `json:"clientId,omitempty"`
+	PrincipalId *string `json:"principalId,omitempty"`
+}
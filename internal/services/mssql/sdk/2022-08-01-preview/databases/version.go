@@ -0,0 +1,9 @@
+package databases
+
+import "fmt"
+
+const defaultApiVersion = "2022-08-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/databases/%s", defaultApiVersion)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2021-05-01/mysqlflexibleservers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2023-12-30/servers"
 )
 
 type Client struct {
@@ -14,6 +15,7 @@ type Client struct {
 	FlexibleServerConfigurationsClient *mysqlflexibleservers.ConfigurationsClient
 	FlexibleServerClient               *mysqlflexibleservers.ServersClient
 	FlexibleServerFirewallRulesClient  *mysqlflexibleservers.FirewallRulesClient
+	FlexibleServersLatestClient        *servers.ServersClient
 	ServersClient                      *mysql.ServersClient
 	ServerKeysClient                   *mysql.ServerKeysClient
 	ServerSecurityAlertPoliciesClient  *mysql.ServerSecurityAlertPoliciesClient
@@ -40,6 +42,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerFirewallRulesClient := mysqlflexibleservers.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerFirewallRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServersLatestClient := servers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServersLatestClient.Client, o.ResourceManagerAuthorizer)
+
 	flexibleServerConfigurationsClient := mysqlflexibleservers.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -66,6 +71,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		FlexibleServerClient:               &flexibleServerClient,
 		FlexibleServerFirewallRulesClient:  &flexibleServerFirewallRulesClient,
 		FlexibleServerConfigurationsClient: &flexibleServerConfigurationsClient,
+		FlexibleServersLatestClient:        &flexibleServersLatestClient,
 		ServersClient:                      &ServersClient,
 		ServerKeysClient:                   &ServerKeysClient,
 		ServerSecurityAlertPoliciesClient:  &serverSecurityAlertPoliciesClient,
@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2023-12-30/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
@@ -223,6 +225,12 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 							ValidateFunc: validation.IntBetween(360, 20000),
 						},
 
+						"log_on_disk_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
 						"size_gb": {
 							Type:         pluginsdk.TypeInt,
 							Optional:     true,
@@ -326,6 +334,11 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	logOnDiskEnabled := d.Get("storage.0.log_on_disk_enabled").(bool)
+	if logOnDiskEnabled && !strings.HasPrefix(d.Get("sku_name").(string), "MO_") {
+		return fmt.Errorf("`storage.0.log_on_disk_enabled` can only be enabled when `sku_name` is a Business Critical (`MO_`) SKU")
+	}
+
 	sku, err := expandFlexibleServerSku(d.Get("sku_name").(string))
 	if err != nil {
 		return fmt.Errorf("expanding `sku_name` for MySql Flexible Server %s (Resource Group %q): %v", id.Name, id.ResourceGroup, err)
@@ -396,6 +409,13 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	// `log_on_disk_enabled` (Accelerated Logs) is only available in a newer API version and can only be enabled once the server exists
+	if logOnDiskEnabled {
+		if err := updateMysqlFlexibleServerLogOnDisk(ctx, meta.(*clients.Client).MySQL.FlexibleServersLatestClient, id, true); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceMysqlFlexibleServerRead(d, meta)
@@ -441,7 +461,12 @@ func resourceMysqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interface{}
 			return fmt.Errorf("setting `maintenance_window`: %+v", err)
 		}
 
-		if err := d.Set("storage", flattenArmServerStorage(props.Storage)); err != nil {
+		logOnDiskEnabled, err := retrieveMysqlFlexibleServerLogOnDisk(ctx, meta.(*clients.Client).MySQL.FlexibleServersLatestClient, *id)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("storage", flattenArmServerStorage(props.Storage, logOnDiskEnabled)); err != nil {
 			return fmt.Errorf("setting `storage`: %+v", err)
 		}
 
@@ -558,27 +583,37 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 			return fmt.Errorf("waiting for failover of %s: %+v", *id, err)
 		}
 	} else if d.HasChange("high_availability") {
+		oldHighAvailability, newHighAvailability := d.GetChange("high_availability")
+		oldMode := expandFlexibleServerHighAvailability(oldHighAvailability.([]interface{})).Mode
 		parameters := mysqlflexibleservers.ServerForUpdate{
 			ServerPropertiesForUpdate: &mysqlflexibleservers.ServerPropertiesForUpdate{
-				HighAvailability: &mysqlflexibleservers.HighAvailability{
-					Mode: mysqlflexibleservers.HighAvailabilityModeDisabled,
-				},
+				HighAvailability: expandFlexibleServerHighAvailability(newHighAvailability.([]interface{})),
 			},
 		}
 
-		future, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters)
-		if err != nil {
-			return fmt.Errorf("updating Mysql Flexible Server %q (Resource Group %q) to disable `high_availability`: %+v", id.Name, id.ResourceGroup, err)
-		}
+		// switching between `ZoneRedundant` and `SameZone` (or changing the standby zone) requires `high_availability` to be
+		// disabled first, whereas enabling/disabling `high_availability` can be done in a single request
+		if oldMode != mysqlflexibleservers.HighAvailabilityModeDisabled {
+			disableParameters := mysqlflexibleservers.ServerForUpdate{
+				ServerPropertiesForUpdate: &mysqlflexibleservers.ServerPropertiesForUpdate{
+					HighAvailability: &mysqlflexibleservers.HighAvailability{
+						Mode: mysqlflexibleservers.HighAvailabilityModeDisabled,
+					},
+				},
+			}
 
-		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting for the update of the Mysql Flexible Server %q (Resource Group %q) to disable `high_availability`: %+v", id.Name, id.ResourceGroup, err)
-		}
+			future, err := client.Update(ctx, id.ResourceGroup, id.Name, disableParameters)
+			if err != nil {
+				return fmt.Errorf("updating Mysql Flexible Server %q (Resource Group %q) to disable `high_availability`: %+v", id.Name, id.ResourceGroup, err)
+			}
 
-		parameters.HighAvailability = expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{}))
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the update of the Mysql Flexible Server %q (Resource Group %q) to disable `high_availability`: %+v", id.Name, id.ResourceGroup, err)
+			}
+		}
 
 		if parameters.HighAvailability.Mode != mysqlflexibleservers.HighAvailabilityModeDisabled {
-			future, err = client.Update(ctx, id.ResourceGroup, id.Name, parameters)
+			future, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters)
 			if err != nil {
 				return fmt.Errorf("updating Mysql Flexible Server %q (Resource Group %q) to update `high_availability`: %+v", id.Name, id.ResourceGroup, err)
 			}
@@ -613,7 +648,7 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 		parameters.Sku = sku
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -643,6 +678,17 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("storage.0.log_on_disk_enabled") {
+		logOnDiskEnabled := d.Get("storage.0.log_on_disk_enabled").(bool)
+		if logOnDiskEnabled && !strings.HasPrefix(d.Get("sku_name").(string), "MO_") {
+			return fmt.Errorf("`storage.0.log_on_disk_enabled` can only be enabled when `sku_name` is a Business Critical (`MO_`) SKU")
+		}
+
+		if err := updateMysqlFlexibleServerLogOnDisk(ctx, meta.(*clients.Client).MySQL.FlexibleServersLatestClient, *id, logOnDiskEnabled); err != nil {
+			return err
+		}
+	}
+
 	return resourceMysqlFlexibleServerRead(d, meta)
 }
 
@@ -725,7 +771,7 @@ func expandArmServerStorage(inputs []interface{}) *mysqlflexibleservers.Storage
 	return &storage
 }
 
-func flattenArmServerStorage(storage *mysqlflexibleservers.Storage) []interface{} {
+func flattenArmServerStorage(storage *mysqlflexibleservers.Storage, logOnDiskEnabled bool) []interface{} {
 	if storage == nil {
 		return []interface{}{}
 	}
@@ -741,9 +787,10 @@ func flattenArmServerStorage(storage *mysqlflexibleservers.Storage) []interface{
 
 	return []interface{}{
 		map[string]interface{}{
-			"size_gb":           size,
-			"iops":              iops,
-			"auto_grow_enabled": storage.AutoGrow == mysqlflexibleservers.EnableStatusEnumEnabled,
+			"size_gb":             size,
+			"iops":                iops,
+			"auto_grow_enabled":   storage.AutoGrow == mysqlflexibleservers.EnableStatusEnumEnabled,
+			"log_on_disk_enabled": logOnDiskEnabled,
 		},
 	}
 }
@@ -880,3 +927,39 @@ func flattenFlexibleServerHighAvailability(ha *mysqlflexibleservers.HighAvailabi
 		},
 	}
 }
+
+// updateMysqlFlexibleServerLogOnDisk enables or disables Accelerated Logs (`logOnDisk`) which is only available in a newer API version
+func updateMysqlFlexibleServerLogOnDisk(ctx context.Context, client *servers.ServersClient, id parse.FlexibleServerId, enabled bool) error {
+	logOnDisk := servers.EnableStatusEnumDisabled
+	if enabled {
+		logOnDisk = servers.EnableStatusEnumEnabled
+	}
+
+	serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	parameters := servers.ServerForUpdate{
+		Properties: &servers.ServerPropertiesForUpdate{
+			Storage: &servers.Storage{
+				LogOnDisk: &logOnDisk,
+			},
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, serverId, parameters); err != nil {
+		return fmt.Errorf("updating `log_on_disk_enabled` for %s: %+v", serverId, err)
+	}
+
+	return nil
+}
+
+func retrieveMysqlFlexibleServerLogOnDisk(ctx context.Context, client *servers.ServersClient, id parse.FlexibleServerId) (bool, error) {
+	serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	resp, err := client.Get(ctx, serverId)
+	if err != nil {
+		return false, fmt.Errorf("retrieving `log_on_disk_enabled` for %s: %+v", serverId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Storage != nil && model.Properties.Storage.LogOnDisk != nil {
+		return *model.Properties.Storage.LogOnDisk == servers.EnableStatusEnumEnabled, nil
+	}
+	return false, nil
+}
@@ -239,6 +239,14 @@ func TestAccMySqlFlexibleServer_updateHA(t *testing.T) {
 			),
 		},
 		data.ImportStep("administrator_password"),
+		{
+			Config: r.updateHAZoneRedundant(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("high_availability.0.mode").HasValue("ZoneRedundant"),
+			),
+		},
+		data.ImportStep("administrator_password"),
 	})
 }
 
@@ -375,6 +383,38 @@ func TestAccMySqlFlexibleServer_updateStorage(t *testing.T) {
 	})
 }
 
+func TestAccMySqlFlexibleServer_logOnDisk(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
+	r := MySqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.logOnDisk(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage.0.log_on_disk_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("administrator_password"),
+		{
+			Config: r.logOnDisk(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage.0.log_on_disk_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("administrator_password"),
+		{
+			Config: r.logOnDisk(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage.0.log_on_disk_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("administrator_password"),
+	})
+}
+
 func TestAccMySqlFlexibleServer_updateReplicationRole(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
 	r := MySqlFlexibleServerResource{}
@@ -894,6 +934,27 @@ resource "azurerm_mysql_flexible_server" "test" {
 `, r.template(data), data.RandomInteger, sizeGB, iops, enabled)
 }
 
+func (r MySqlFlexibleServerResource) logOnDisk(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  sku_name               = "MO_Standard_E2ds_v4"
+  version                = "8.0.21"
+
+  storage {
+    size_gb             = 32
+    log_on_disk_enabled = %t
+  }
+}
+`, r.template(data), data.RandomInteger, enabled)
+}
+
 func (r MySqlFlexibleServerResource) failover(data acceptance.TestData, primaryZone string, standbyZone string) string {
 	return fmt.Sprintf(`
 %s
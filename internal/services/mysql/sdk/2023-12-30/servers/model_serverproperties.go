@@ -0,0 +1,5 @@
+package servers
+
+type ServerProperties struct {
+	Storage *Storage `json:"storage,omitempty"`
+}
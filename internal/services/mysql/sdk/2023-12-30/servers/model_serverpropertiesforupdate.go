@@ -0,0 +1,5 @@
+package servers
+
+type ServerPropertiesForUpdate struct {
+	Storage *Storage `json:"storage,omitempty"`
+}
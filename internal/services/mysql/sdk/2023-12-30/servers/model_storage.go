@@ -0,0 +1,10 @@
+package servers
+
+type Storage struct {
+	AutoGrow      *EnableStatusEnum `json:"autoGrow,omitempty"`
+	AutoIoScaling *EnableStatusEnum `json:"autoIoScaling,omitempty"`
+	Iops          *int64            `json:"iops,omitempty"`
+	LogOnDisk     *EnableStatusEnum `json:"logOnDisk,omitempty"`
+	StorageSizeGB *int64            `json:"storageSizeGB,omitempty"`
+	StorageSku    *string           `json:"storageSku,omitempty"`
+}
@@ -0,0 +1,9 @@
+package servers
+
+import "fmt"
+
+const defaultApiVersion = "2023-12-30"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/servers/%s", defaultApiVersion)
+}
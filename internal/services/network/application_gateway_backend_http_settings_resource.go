@@ -0,0 +1,200 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendHTTPSettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Read:   resourceApplicationGatewayBackendHTTPSettingsRead,
+		Update: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Delete: resourceApplicationGatewayBackendHTTPSettingsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendHttpSettingsCollectionID(id)
+			return err
+		}),
+
+		Schema: applicationGatewayChildResourceSchema("backend_http_settings"),
+	}
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendHttpSettingsCollectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+	props := gateway.ApplicationGatewayPropertiesFormat
+
+	expanded := expandApplicationGatewayBackendHTTPSettings(expandApplicationGatewayChildResource(d, "backend_http_settings"), gatewayId.ID())
+	setting := (*expanded)[0]
+
+	if settingProps := setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; settingProps != nil && settingProps.HostName != nil && settingProps.PickHostNameFromBackendAddress != nil {
+		if *settingProps.HostName != "" && *settingProps.PickHostNameFromBackendAddress {
+			return fmt.Errorf("Only one of `host_name` or `pick_host_name_from_backend_address` can be set")
+		}
+	}
+
+	settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+	if props.BackendHTTPSettingsCollection != nil {
+		settings = *props.BackendHTTPSettingsCollection
+	}
+
+	exists := false
+	for i, item := range settings {
+		if item.Name != nil && strings.EqualFold(*item.Name, id.BackendHttpSettingsCollectionName) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_http_settings", id.ID())
+			}
+
+			settings[i] = setting
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		settings = append(settings, setting)
+	}
+	props.BackendHTTPSettingsCollection = &settings
+
+	if err := updateApplicationGatewayWithETag(ctx, client, *gatewayId, gateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var setting *network.ApplicationGatewayBackendHTTPSettings
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendHTTPSettingsCollection != nil {
+		for _, item := range *props.BackendHTTPSettingsCollection {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.BackendHttpSettingsCollectionName) {
+				item := item
+				setting = &item
+				break
+			}
+		}
+	}
+	if setting == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.BackendHttpSettingsCollectionName)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	flattened, err := flattenApplicationGatewayBackendHTTPSettings(&[]network.ApplicationGatewayBackendHTTPSettings{*setting})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	return flattenApplicationGatewayChildResource(d, "backend_http_settings", flattened)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	props := gateway.ApplicationGatewayPropertiesFormat
+	if props == nil || props.BackendHTTPSettingsCollection == nil {
+		return nil
+	}
+
+	settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+	for _, item := range *props.BackendHTTPSettingsCollection {
+		if item.Name != nil && strings.EqualFold(*item.Name, id.BackendHttpSettingsCollectionName) {
+			continue
+		}
+
+		settings = append(settings, item)
+	}
+	props.BackendHTTPSettingsCollection = &settings
+
+	if err := updateApplicationGatewayWithETag(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
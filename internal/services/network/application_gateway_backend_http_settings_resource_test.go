@@ -0,0 +1,150 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayBackendHTTPSettingsResource struct {
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayBackendHTTPSettingsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendHttpSettingsCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.BackendHTTPSettingsCollection != nil {
+		for _, item := range *props.BackendHTTPSettingsCollection {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.BackendHttpSettingsCollectionName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayBackendHTTPSettingsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                   = "acctest-be-htst-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  cookie_based_affinity  = "Disabled"
+  port                   = 8080
+  protocol               = "Http"
+  request_timeout        = 30
+}
+`, ApplicationGatewayResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (ApplicationGatewayBackendHTTPSettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                   = "acctest-be-htst-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  cookie_based_affinity  = "Enabled"
+  affinity_cookie_name   = "acctest"
+  path                   = "/api/"
+  port                   = 8081
+  protocol               = "Http"
+  request_timeout        = 60
+
+  connection_draining {
+    enabled           = true
+    drain_timeout_sec = 60
+  }
+}
+`, ApplicationGatewayResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "import" {
+  name                   = azurerm_application_gateway_backend_http_settings.test.name
+  application_gateway_id = azurerm_application_gateway_backend_http_settings.test.application_gateway_id
+  cookie_based_affinity  = azurerm_application_gateway_backend_http_settings.test.cookie_based_affinity
+  port                   = azurerm_application_gateway_backend_http_settings.test.port
+  protocol               = azurerm_application_gateway_backend_http_settings.test.protocol
+}
+`, r.basic(data))
+}
@@ -0,0 +1,100 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// The Application Gateway API doesn't expose its listeners, rules, pools etc. as child resources - so the
+// resources managing these individually read the whole Application Gateway, update the single entry they
+// own and then PUT the Application Gateway back, using the ETag to detect concurrent modifications.
+
+// applicationGatewayChildResourceSchema returns the schema for a resource managing a single entry within
+// the specified block of an Application Gateway, which is the schema of that block in `azurerm_application_gateway`
+func applicationGatewayChildResourceSchema(block string) map[string]*pluginsdk.Schema {
+	out := map[string]*pluginsdk.Schema{
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApplicationGatewayID,
+		},
+	}
+
+	for k, v := range resourceApplicationGateway().Schema[block].Elem.(*pluginsdk.Resource).Schema {
+		// the ID of the entry is the ID of the child resource
+		if k == "id" {
+			continue
+		}
+		out[k] = v
+	}
+	out["name"].ForceNew = true
+
+	return out
+}
+
+// expandApplicationGatewayChildResource returns the entry defined by a child resource in the same format as the
+// specified block of `azurerm_application_gateway`, so that it can be expanded using the same functions
+func expandApplicationGatewayChildResource(d *pluginsdk.ResourceData, block string) []interface{} {
+	v := make(map[string]interface{})
+	for k := range applicationGatewayChildResourceSchema(block) {
+		if k == "application_gateway_id" {
+			continue
+		}
+		v[k] = d.Get(k)
+	}
+
+	return []interface{}{v}
+}
+
+// flattenApplicationGatewayChildResource sets the flattened entry of the specified block of `azurerm_application_gateway` into a child resource
+func flattenApplicationGatewayChildResource(d *pluginsdk.ResourceData, block string, input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	s := applicationGatewayChildResourceSchema(block)
+	for k, v := range input[0].(map[string]interface{}) {
+		if _, ok := s[k]; !ok || k == "name" {
+			continue
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// updateApplicationGatewayWithETag updates the Application Gateway, failing if it has been modified since it was retrieved
+func updateApplicationGatewayWithETag(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, gateway network.ApplicationGateway) error {
+	req, err := client.CreateOrUpdatePreparer(ctx, id.ResourceGroup, id.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("preparing update request for %s: %+v", id, err)
+	}
+
+	if gateway.Etag != nil {
+		req, err = autorest.Prepare(req, autorest.WithHeader("If-Match", *gateway.Etag))
+		if err != nil {
+			return fmt.Errorf("preparing update request for %s: %+v", id, err)
+		}
+	}
+
+	future, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayProbe() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayProbeCreateUpdate,
+		Read:   resourceApplicationGatewayProbeRead,
+		Update: resourceApplicationGatewayProbeCreateUpdate,
+		Delete: resourceApplicationGatewayProbeDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProbeID(id)
+			return err
+		}),
+
+		Schema: applicationGatewayChildResourceSchema("probe"),
+	}
+}
+
+func resourceApplicationGatewayProbeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewProbeID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+	props := gateway.ApplicationGatewayPropertiesFormat
+
+	expanded := expandApplicationGatewayProbes(expandApplicationGatewayChildResource(d, "probe"))
+	probe := (*expanded)[0]
+
+	if probeProps := probe.ApplicationGatewayProbePropertiesFormat; probeProps != nil && probeProps.Host != nil && probeProps.PickHostNameFromBackendHTTPSettings != nil {
+		if *probeProps.Host == "" && !*probeProps.PickHostNameFromBackendHTTPSettings {
+			return fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
+		}
+
+		if *probeProps.Host != "" && *probeProps.PickHostNameFromBackendHTTPSettings {
+			return fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
+		}
+	}
+
+	probes := make([]network.ApplicationGatewayProbe, 0)
+	if props.Probes != nil {
+		probes = *props.Probes
+	}
+
+	exists := false
+	for i, item := range probes {
+		if item.Name != nil && strings.EqualFold(*item.Name, id.Name) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_probe", id.ID())
+			}
+
+			probes[i] = probe
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		probes = append(probes, probe)
+	}
+	props.Probes = &probes
+
+	if err := updateApplicationGatewayWithETag(ctx, client, *gatewayId, gateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var probe *network.ApplicationGatewayProbe
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.Probes != nil {
+		for _, item := range *props.Probes {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.Name) {
+				item := item
+				probe = &item
+				break
+			}
+		}
+	}
+	if probe == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	flattened := flattenApplicationGatewayProbes(&[]network.ApplicationGatewayProbe{*probe})
+
+	return flattenApplicationGatewayChildResource(d, "probe", flattened)
+}
+
+func resourceApplicationGatewayProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	props := gateway.ApplicationGatewayPropertiesFormat
+	if props == nil || props.Probes == nil {
+		return nil
+	}
+
+	probes := make([]network.ApplicationGatewayProbe, 0)
+	for _, item := range *props.Probes {
+		if item.Name != nil && strings.EqualFold(*item.Name, id.Name) {
+			continue
+		}
+
+		probes = append(probes, item)
+	}
+	props.Probes = &probes
+
+	if err := updateApplicationGatewayWithETag(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
 // See https://github.com/Azure/azure-sdk-for-go/blob/master/services/network/mgmt/2018-04-01/network/models.go
 func possibleApplicationGatewaySslCipherSuiteValues() []string {
 	cipherSuites := make([]string, 0)
@@ -1549,6 +1552,9 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	enablehttp2 := d.Get("enable_http2").(bool)
 	t := d.Get("tags").(map[string]interface{})
@@ -1559,7 +1565,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("expanding `trusted_root_certificate`: %+v", err)
 	}
 
-	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d, id.ID())
+	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d.Get("request_routing_rule").(*pluginsdk.Set).List(), id.ID())
 	if err != nil {
 		return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
 	}
@@ -1588,12 +1594,12 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 
 	gatewayIPConfigurations, stopApplicationGateway := expandApplicationGatewayIPConfigurations(d)
 
-	httpListeners, err := expandApplicationGatewayHTTPListeners(d, id.ID())
+	httpListeners, err := expandApplicationGatewayHTTPListeners(d.Get("http_listener").([]interface{}), id.ID())
 	if err != nil {
 		return fmt.Errorf("fail to expand `http_listener`: %+v", err)
 	}
 
-	rewriteRuleSets, err := expandApplicationGatewayRewriteRuleSets(d)
+	rewriteRuleSets, err := expandApplicationGatewayRewriteRuleSets(d.Get("rewrite_rule_set").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("expanding `rewrite_rule_set`: %v", err)
 	}
@@ -1608,15 +1614,15 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			AuthenticationCertificates:    expandApplicationGatewayAuthenticationCertificates(d.Get("authentication_certificate").([]interface{})),
 			TrustedRootCertificates:       trustedRootCertificates,
 			CustomErrorConfigurations:     expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{})),
-			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
-			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, id.ID()),
+			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d.Get("backend_address_pool").([]interface{})),
+			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d.Get("backend_http_settings").([]interface{}), id.ID()),
 			EnableHTTP2:                   utils.Bool(enablehttp2),
 			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d, id.ID()),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
 			HTTPListeners:                 httpListeners,
 			PrivateLinkConfigurations:     expandApplicationGatewayPrivateLinkConfigurations(d),
-			Probes:                        expandApplicationGatewayProbes(d),
+			Probes:                        expandApplicationGatewayProbes(d.Get("probe").([]interface{})),
 			RequestRoutingRules:           requestRoutingRules,
 			RedirectConfigurations:        redirectConfigurations,
 			Sku:                           expandApplicationGatewaySku(d),
@@ -1904,6 +1910,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -2103,8 +2112,7 @@ func flattenApplicationGatewayTrustedRootCertificates(certs *[]network.Applicati
 	return results
 }
 
-func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayBackendAddressPool {
-	vs := d.Get("backend_address_pool").([]interface{})
+func expandApplicationGatewayBackendAddressPools(vs []interface{}) *[]network.ApplicationGatewayBackendAddressPool {
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
@@ -2177,9 +2185,8 @@ func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGa
 	return results
 }
 
-func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
+func expandApplicationGatewayBackendHTTPSettings(vs []interface{}, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
 	results := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
-	vs := d.Get("backend_http_settings").([]interface{})
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
@@ -2474,8 +2481,7 @@ func flattenApplicationGatewaySslPolicy(input *network.ApplicationGatewaySslPoli
 	return results
 }
 
-func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
-	vs := d.Get("http_listener").([]interface{})
+func expandApplicationGatewayHTTPListeners(vs []interface{}, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
 	results := make([]network.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
@@ -2871,8 +2877,7 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 	return results, nil
 }
 
-func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayProbe {
-	vs := d.Get("probe").([]interface{})
+func expandApplicationGatewayProbes(vs []interface{}) *[]network.ApplicationGatewayProbe {
 	results := make([]network.ApplicationGatewayProbe, 0)
 
 	for _, raw := range vs {
@@ -3114,8 +3119,7 @@ func flattenApplicationGatewayPrivateLinkConfigurations(input *[]network.Applica
 	return plConfigResults
 }
 
-func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
-	vs := d.Get("request_routing_rule").(*pluginsdk.Set).List()
+func expandApplicationGatewayRequestRoutingRules(vs []interface{}, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
 	results := make([]network.ApplicationGatewayRequestRoutingRule, 0)
 	priorityset := false
 
@@ -3301,8 +3305,7 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 	return results, nil
 }
 
-func expandApplicationGatewayRewriteRuleSets(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayRewriteRuleSet, error) {
-	vs := d.Get("rewrite_rule_set").([]interface{})
+func expandApplicationGatewayRewriteRuleSets(vs []interface{}) (*[]network.ApplicationGatewayRewriteRuleSet, error) {
 	ruleSets := make([]network.ApplicationGatewayRewriteRuleSet, 0)
 
 	for _, raw := range vs {
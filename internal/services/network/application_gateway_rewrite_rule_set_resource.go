@@ -0,0 +1,194 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayRewriteRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayRewriteRuleSetCreateUpdate,
+		Read:   resourceApplicationGatewayRewriteRuleSetRead,
+		Update: resourceApplicationGatewayRewriteRuleSetCreateUpdate,
+		Delete: resourceApplicationGatewayRewriteRuleSetDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RewriteRuleSetID(id)
+			return err
+		}),
+
+		Schema: applicationGatewayChildResourceSchema("rewrite_rule_set"),
+	}
+}
+
+func resourceApplicationGatewayRewriteRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewRewriteRuleSetID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+	props := gateway.ApplicationGatewayPropertiesFormat
+
+	expanded, err := expandApplicationGatewayRewriteRuleSets(expandApplicationGatewayChildResource(d, "rewrite_rule_set"))
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+	ruleSet := (*expanded)[0]
+
+	ruleSets := make([]network.ApplicationGatewayRewriteRuleSet, 0)
+	if props.RewriteRuleSets != nil {
+		ruleSets = *props.RewriteRuleSets
+	}
+
+	exists := false
+	for i, item := range ruleSets {
+		if item.Name != nil && strings.EqualFold(*item.Name, id.Name) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_rewrite_rule_set", id.ID())
+			}
+
+			ruleSets[i] = ruleSet
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		ruleSets = append(ruleSets, ruleSet)
+	}
+	props.RewriteRuleSets = &ruleSets
+
+	if err := updateApplicationGatewayWithETag(ctx, client, *gatewayId, gateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayRewriteRuleSetRead(d, meta)
+}
+
+func resourceApplicationGatewayRewriteRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RewriteRuleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var ruleSet *network.ApplicationGatewayRewriteRuleSet
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.RewriteRuleSets != nil {
+		for _, item := range *props.RewriteRuleSets {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.Name) {
+				item := item
+				ruleSet = &item
+				break
+			}
+		}
+	}
+	if ruleSet == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	flattened := flattenApplicationGatewayRewriteRuleSets(&[]network.ApplicationGatewayRewriteRuleSet{*ruleSet})
+
+	return flattenApplicationGatewayChildResource(d, "rewrite_rule_set", flattened)
+}
+
+func resourceApplicationGatewayRewriteRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RewriteRuleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	props := gateway.ApplicationGatewayPropertiesFormat
+	if props == nil || props.RewriteRuleSets == nil {
+		return nil
+	}
+
+	ruleSets := make([]network.ApplicationGatewayRewriteRuleSet, 0)
+	for _, item := range *props.RewriteRuleSets {
+		if item.Name != nil && strings.EqualFold(*item.Name, id.Name) {
+			continue
+		}
+
+		ruleSets = append(ruleSets, item)
+	}
+	props.RewriteRuleSets = &ruleSets
+
+	if err := updateApplicationGatewayWithETag(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
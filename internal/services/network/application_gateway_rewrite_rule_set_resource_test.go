@@ -0,0 +1,173 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayRewriteRuleSetResource struct {
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayRewriteRuleSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RewriteRuleSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.RewriteRuleSets != nil {
+		for _, item := range *props.RewriteRuleSets {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayRewriteRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "test" {
+  name                   = "acctest-rewrite-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+
+  rewrite_rule {
+    name          = "set-forwarded-port"
+    rule_sequence = 100
+
+    request_header_configuration {
+      header_name  = "X-Forwarded-Port"
+      header_value = "80"
+    }
+  }
+}
+`, ApplicationGatewayResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (ApplicationGatewayRewriteRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "test" {
+  name                   = "acctest-rewrite-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+
+  rewrite_rule {
+    name          = "set-forwarded-port"
+    rule_sequence = 100
+
+    condition {
+      variable    = "var_client_ip"
+      pattern     = "10.0.0.0"
+      ignore_case = true
+    }
+
+    request_header_configuration {
+      header_name  = "X-Forwarded-Port"
+      header_value = "80"
+    }
+
+    response_header_configuration {
+      header_name  = "X-Acceptance-Test"
+      header_value = "true"
+    }
+  }
+}
+`, ApplicationGatewayResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "import" {
+  name                   = azurerm_application_gateway_rewrite_rule_set.test.name
+  application_gateway_id = azurerm_application_gateway_rewrite_rule_set.test.application_gateway_id
+
+  rewrite_rule {
+    name          = "set-forwarded-port"
+    rule_sequence = 100
+
+    request_header_configuration {
+      header_name  = "X-Forwarded-Port"
+      header_value = "80"
+    }
+  }
+}
+`, r.basic(data))
+}
@@ -1,16 +1,17 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/bastionhosts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -27,7 +28,7 @@ func resourceBastionHost() *pluginsdk.Resource {
 		Delete: resourceBastionHostDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.BastionHostID(id)
+			_, err := bastionhosts.ParseBastionHostID(id)
 			return err
 		}),
 
@@ -83,10 +84,29 @@ func resourceBastionHost() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.BastionHostSkuNameBasic),
-					string(network.BastionHostSkuNameStandard),
+					string(bastionhosts.BastionHostSkuNameBasic),
+					string(bastionhosts.BastionHostSkuNameStandard),
+					string(bastionhosts.BastionHostSkuNamePremium),
 				}, false),
-				Default: string(network.BastionHostSkuNameBasic),
+				Default: string(bastionhosts.BastionHostSkuNameBasic),
+			},
+
+			"kerberos_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"session_recording_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"shareable_link_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"dns_name": {
@@ -96,60 +116,74 @@ func resourceBastionHost() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			sku := d.Get("sku").(string)
+
+			if sku == string(bastionhosts.BastionHostSkuNameBasic) {
+				if d.Get("kerberos_enabled").(bool) {
+					return fmt.Errorf("`kerberos_enabled` is only supported when `sku` is `Standard` or `Premium`")
+				}
+
+				if d.Get("shareable_link_enabled").(bool) {
+					return fmt.Errorf("`shareable_link_enabled` is only supported when `sku` is `Standard` or `Premium`")
+				}
+			}
+
+			if sku != string(bastionhosts.BastionHostSkuNamePremium) && d.Get("session_recording_enabled").(bool) {
+				return fmt.Errorf("`session_recording_enabled` is only supported when `sku` is `Premium`")
+			}
+
+			return nil
+		}),
 	}
 }
 
 func resourceBastionHostCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.BastionHostsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Println("[INFO] preparing arguments for Azure Bastion Host creation.")
 
-	resourceGroup := d.Get("resource_group_name").(string)
-	name := d.Get("name").(string)
+	id := bastionhosts.NewBastionHostID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing Bastion Host %q (Resource Group %q): %s", name, resourceGroup, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_bastion_host", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_bastion_host", id.ID())
 		}
 	}
 
-	parameters := network.BastionHost{
+	sku := bastionhosts.BastionHostSkuName(d.Get("sku").(string))
+	parameters := bastionhosts.BastionHost{
 		Location: &location,
-		BastionHostPropertiesFormat: &network.BastionHostPropertiesFormat{
-			IPConfigurations: expandBastionHostIPConfiguration(d.Get("ip_configuration").([]interface{})),
+		Properties: &bastionhosts.BastionHostPropertiesFormat{
+			EnableKerberos:         utils.Bool(d.Get("kerberos_enabled").(bool)),
+			EnableSessionRecording: utils.Bool(d.Get("session_recording_enabled").(bool)),
+			EnableShareableLink:    utils.Bool(d.Get("shareable_link_enabled").(bool)),
+			IPConfigurations:       expandBastionHostIPConfiguration(d.Get("ip_configuration").([]interface{})),
 		},
-		Sku: &network.Sku{
-			Name: network.BastionHostSkuName(d.Get("sku").(string)),
+		Sku: &bastionhosts.Sku{
+			Name: &sku,
 		},
-		Tags: tags.Expand(t),
+		Tags: tagsHelper.Expand(t),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating/updating Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	read, err := client.Get(ctx, resourceGroup, name)
-	if err != nil {
-		return fmt.Errorf("retrieving Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-
-	d.SetId(*read.ID)
+	d.SetId(id.ID())
 
 	return resourceBastionHostRead(d, meta)
 }
@@ -159,14 +193,14 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.BastionHostID(d.Id())
+	id, err := bastionhosts.ParseBastionHostID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
 			return nil
@@ -174,28 +208,37 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", sku.Name)
-	}
+	d.Set("name", id.BastionHostName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if props := resp.BastionHostPropertiesFormat; props != nil {
-		d.Set("dns_name", props.DNSName)
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
 
-		if ipConfigs := props.IPConfigurations; ipConfigs != nil {
-			if err := d.Set("ip_configuration", flattenBastionHostIPConfiguration(ipConfigs)); err != nil {
-				return fmt.Errorf("flattening `ip_configuration`: %+v", err)
+		sku := ""
+		if model.Sku != nil && model.Sku.Name != nil {
+			sku = string(*model.Sku.Name)
+		}
+		d.Set("sku", sku)
+
+		if props := model.Properties; props != nil {
+			d.Set("dns_name", props.DnsName)
+			d.Set("kerberos_enabled", utils.NormaliseNilableBool(props.EnableKerberos))
+			d.Set("session_recording_enabled", utils.NormaliseNilableBool(props.EnableSessionRecording))
+			d.Set("shareable_link_enabled", utils.NormaliseNilableBool(props.EnableShareableLink))
+
+			if ipConfigs := props.IPConfigurations; ipConfigs != nil {
+				if err := d.Set("ip_configuration", flattenBastionHostIPConfiguration(ipConfigs)); err != nil {
+					return fmt.Errorf("flattening `ip_configuration`: %+v", err)
+				}
 			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceBastionHostDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -203,26 +246,19 @@ func resourceBastionHostDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.BastionHostID(d.Id())
+	id, err := bastionhosts.ParseBastionHostID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-		}
-	}
-
 	return nil
 }
 
-func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]network.BastionHostIPConfiguration) {
+func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]bastionhosts.BastionHostIPConfiguration) {
 	if len(input) == 0 {
 		return nil
 	}
@@ -232,22 +268,22 @@ func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]network
 	subID := property["subnet_id"].(string)
 	pipID := property["public_ip_address_id"].(string)
 
-	return &[]network.BastionHostIPConfiguration{
+	return &[]bastionhosts.BastionHostIPConfiguration{
 		{
 			Name: &ipConfName,
-			BastionHostIPConfigurationPropertiesFormat: &network.BastionHostIPConfigurationPropertiesFormat{
-				Subnet: &network.SubResource{
-					ID: &subID,
+			Properties: &bastionhosts.BastionHostIPConfigurationPropertiesFormat{
+				Subnet: bastionhosts.SubResource{
+					Id: &subID,
 				},
-				PublicIPAddress: &network.SubResource{
-					ID: &pipID,
+				PublicIPAddress: bastionhosts.SubResource{
+					Id: &pipID,
 				},
 			},
 		},
 	}
 }
 
-func flattenBastionHostIPConfiguration(ipConfigs *[]network.BastionHostIPConfiguration) []interface{} {
+func flattenBastionHostIPConfiguration(ipConfigs *[]bastionhosts.BastionHostIPConfiguration) []interface{} {
 	result := make([]interface{}, 0)
 	if ipConfigs == nil {
 		return result
@@ -260,13 +296,13 @@ func flattenBastionHostIPConfiguration(ipConfigs *[]network.BastionHostIPConfigu
 			ipConfig["name"] = *config.Name
 		}
 
-		if props := config.BastionHostIPConfigurationPropertiesFormat; props != nil {
-			if subnet := props.Subnet; subnet != nil {
-				ipConfig["subnet_id"] = *subnet.ID
+		if props := config.Properties; props != nil {
+			if props.Subnet.Id != nil {
+				ipConfig["subnet_id"] = *props.Subnet.Id
 			}
 
-			if pip := props.PublicIPAddress; pip != nil {
-				ipConfig["public_ip_address_id"] = *pip.ID
+			if props.PublicIPAddress.Id != nil {
+				ipConfig["public_ip_address_id"] = *props.PublicIPAddress.Id
 			}
 		}
 
@@ -3,63 +3,94 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/staticmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/bastionhosts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/configurationpolicygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/flowlogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/p2svpngateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/publicipaddresses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/virtualnetworkgatewayconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/ipampools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/staticcidrs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/subnets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/virtualnetworks"
 )
 
 type Client struct {
-	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
-	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
-	BastionHostsClient                     *network.BastionHostsClient
-	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
-	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
-	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
-	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
-	ExpressRouteCircuitConnectionClient    *network.ExpressRouteCircuitConnectionsClient
-	ExpressRouteConnectionsClient          *network.ExpressRouteConnectionsClient
-	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
-	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
-	ExpressRoutePortsClient                *network.ExpressRoutePortsClient
-	FlowLogsClient                         *network.FlowLogsClient
-	HubRouteTableClient                    *network.HubRouteTablesClient
-	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
-	InterfacesClient                       *network.InterfacesClient
-	IPGroupsClient                         *network.IPGroupsClient
-	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
-	NatRuleClient                          *network.NatRulesClient
-	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
-	ProfileClient                          *network.ProfilesClient
-	PacketCapturesClient                   *network.PacketCapturesClient
-	PrivateEndpointClient                  *network.PrivateEndpointsClient
-	PublicIPsClient                        *network.PublicIPAddressesClient
-	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
-	RoutesClient                           *network.RoutesClient
-	RouteFiltersClient                     *network.RouteFiltersClient
-	RouteTablesClient                      *network.RouteTablesClient
-	SecurityGroupClient                    *network.SecurityGroupsClient
-	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
-	SecurityRuleClient                     *network.SecurityRulesClient
-	ServiceEndpointPoliciesClient          *network.ServiceEndpointPoliciesClient
-	ServiceEndpointPolicyDefinitionsClient *network.ServiceEndpointPolicyDefinitionsClient
-	ServiceTagsClient                      *network.ServiceTagsClient
-	SubnetsClient                          *network.SubnetsClient
-	NatGatewayClient                       *network.NatGatewaysClient
-	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
-	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
-	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
-	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
-	VnetClient                             *network.VirtualNetworksClient
-	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
-	VirtualWanClient                       *network.VirtualWansClient
-	VirtualHubClient                       *network.VirtualHubsClient
-	VpnConnectionsClient                   *network.VpnConnectionsClient
-	VpnGatewaysClient                      *network.VpnGatewaysClient
-	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
-	VpnSitesClient                         *network.VpnSitesClient
-	WatcherClient                          *network.WatchersClient
-	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
-	PrivateDnsZoneGroupClient              *network.PrivateDNSZoneGroupsClient
-	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
-	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
-	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+	ApplicationGatewaysClient                *network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient          *network.ApplicationSecurityGroupsClient
+	BastionHostsClient                       *bastionhosts.BastionHostsClient
+	ConnectionMonitorsClient                 *network.ConnectionMonitorsClient
+	DDOSProtectionPlansClient                *network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient                  *network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitsClient               *network.ExpressRouteCircuitsClient
+	ExpressRouteCircuitConnectionClient      *network.ExpressRouteCircuitConnectionsClient
+	ExpressRouteConnectionsClient            *network.ExpressRouteConnectionsClient
+	ExpressRouteGatewaysClient               *network.ExpressRouteGatewaysClient
+	ExpressRoutePeeringsClient               *network.ExpressRouteCircuitPeeringsClient
+	ExpressRoutePortsClient                  *network.ExpressRoutePortsClient
+	FlowLogsClient                           *flowlogs.FlowLogsClient
+	HubRouteTableClient                      *network.HubRouteTablesClient
+	HubVirtualNetworkConnectionClient        *network.HubVirtualNetworkConnectionsClient
+	InterfacesClient                         *network.InterfacesClient
+	IPGroupsClient                           *network.IPGroupsClient
+	LocalNetworkGatewaysClient               *network.LocalNetworkGatewaysClient
+	ManagerAdminRuleCollectionsClient        *adminrulecollections.AdminRuleCollectionsClient
+	ManagerAdminRulesClient                  *adminrules.AdminRulesClient
+	ManagerConnectivityConfigurationsClient  *connectivityconfigurations.ConnectivityConfigurationsClient
+	ManagerIPamPoolsClient                   *ipampools.IPamPoolsClient
+	ManagerIPamPoolStaticCidrsClient         *staticcidrs.StaticCidrsClient
+	ManagerNetworkGroupsClient               *networkgroups.NetworkGroupsClient
+	ManagersClient                           *networkmanagers.NetworkManagersClient
+	ManagerSecurityAdminConfigurationsClient *securityadminconfigurations.SecurityAdminConfigurationsClient
+	ManagerStaticMembersClient               *staticmembers.StaticMembersClient
+	NatRuleClient                            *network.NatRulesClient
+	PointToSiteVpnGatewaysClient             *p2svpngateways.P2sVpnGatewaysClient
+	ProfileClient                            *network.ProfilesClient
+	PacketCapturesClient                     *network.PacketCapturesClient
+	PrivateEndpointClient                    *network.PrivateEndpointsClient
+	PublicIPsClient                          *network.PublicIPAddressesClient
+	PublicIPAddressesClient                  *publicipaddresses.PublicIPAddressesClient
+	PublicIPPrefixesClient                   *network.PublicIPPrefixesClient
+	RoutesClient                             *network.RoutesClient
+	RouteFiltersClient                       *network.RouteFiltersClient
+	RouteTablesClient                        *network.RouteTablesClient
+	RoutingIntentClient                      *network.RoutingIntentClient
+	SecurityGroupClient                      *network.SecurityGroupsClient
+	SecurityPartnerProviderClient            *network.SecurityPartnerProvidersClient
+	SecurityRuleClient                       *network.SecurityRulesClient
+	ServiceEndpointPoliciesClient            *network.ServiceEndpointPoliciesClient
+	ServiceEndpointPolicyDefinitionsClient   *network.ServiceEndpointPolicyDefinitionsClient
+	ServiceTagsClient                        *network.ServiceTagsClient
+	SubnetsClient                            *network.SubnetsClient
+	NatGatewayClient                         *network.NatGatewaysClient
+	VirtualHubBgpConnectionClient            *network.VirtualHubBgpConnectionClient
+	VirtualHubIPClient                       *network.VirtualHubIPConfigurationClient
+	VnetGatewayConnectionsClient             *virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionsClient
+	VnetGatewayClient                        *network.VirtualNetworkGatewaysClient
+	VnetClient                               *network.VirtualNetworksClient
+	VirtualNetworksClient                    *virtualnetworks.VirtualNetworksClient
+	VirtualNetworkSubnetsClient              *subnets.SubnetsClient
+	VnetPeeringsClient                       *network.VirtualNetworkPeeringsClient
+	VirtualWanClient                         *network.VirtualWansClient
+	VirtualHubClient                         *network.VirtualHubsClient
+	VpnConnectionsClient                     *network.VpnConnectionsClient
+	VpnGatewaysClient                        *network.VpnGatewaysClient
+	VpnServerConfigurationsClient            *network.VpnServerConfigurationsClient
+	VpnServerConfigurationPolicyGroupsClient *configurationpolicygroups.ConfigurationPolicyGroupsClient
+	VpnSitesClient                           *network.VpnSitesClient
+	WatcherClient                            *network.WatchersClient
+	WebApplicationFirewallPoliciesClient     *network.WebApplicationFirewallPoliciesClient
+	PrivateDnsZoneGroupClient                *network.PrivateDNSZoneGroupsClient
+	PrivateLinkServiceClient                 *network.PrivateLinkServicesClient
+	ServiceAssociationLinkClient             *network.ServiceAssociationLinksClient
+	ResourceNavigationLinkClient             *network.ResourceNavigationLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -69,7 +100,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	ApplicationSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ApplicationSecurityGroupsClient.Client, o.ResourceManagerAuthorizer)
 
-	BastionHostsClient := network.NewBastionHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	BastionHostsClient := bastionhosts.NewBastionHostsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&BastionHostsClient.Client, o.ResourceManagerAuthorizer)
 
 	ConnectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -99,7 +130,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	ExpressRoutePortsClient := network.NewExpressRoutePortsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExpressRoutePortsClient.Client, o.ResourceManagerAuthorizer)
 
-	FlowLogsClient := network.NewFlowLogsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	FlowLogsClient := flowlogs.NewFlowLogsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&FlowLogsClient.Client, o.ResourceManagerAuthorizer)
 
 	HubRouteTableClient := network.NewHubRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -120,18 +151,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	NatRuleClient := network.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatRuleClient.Client, o.ResourceManagerAuthorizer)
 
-	pointToSiteVpnGatewaysClient := network.NewP2sVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	pointToSiteVpnGatewaysClient := p2svpngateways.NewP2sVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&pointToSiteVpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
 	vpnServerConfigurationsClient := network.NewVpnServerConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vpnServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	vpnServerConfigurationPolicyGroupsClient := configurationpolicygroups.NewConfigurationPolicyGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&vpnServerConfigurationPolicyGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	ProfileClient := network.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ProfileClient.Client, o.ResourceManagerAuthorizer)
 
 	VnetClient := network.NewVirtualNetworksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetClient.Client, o.ResourceManagerAuthorizer)
 
+	VirtualNetworksClient := virtualnetworks.NewVirtualNetworksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&VirtualNetworksClient.Client, o.ResourceManagerAuthorizer)
+
+	VirtualNetworkSubnetsClient := subnets.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&VirtualNetworkSubnetsClient.Client, o.ResourceManagerAuthorizer)
+
 	PacketCapturesClient := network.NewPacketCapturesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PacketCapturesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -144,6 +184,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	PublicIPsClient := network.NewPublicIPAddressesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PublicIPsClient.Client, o.ResourceManagerAuthorizer)
 
+	PublicIPAddressesClient := publicipaddresses.NewPublicIPAddressesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PublicIPAddressesClient.Client, o.ResourceManagerAuthorizer)
+
 	PublicIPPrefixesClient := network.NewPublicIPPrefixesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PublicIPPrefixesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -162,6 +205,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
+	RoutingIntentClient := network.NewRoutingIntentClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RoutingIntentClient.Client, o.ResourceManagerAuthorizer)
+
 	SecurityGroupClient := network.NewSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SecurityGroupClient.Client, o.ResourceManagerAuthorizer)
 
@@ -192,10 +238,37 @@ func NewClient(o *common.ClientOptions) *Client {
 	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
 
+	ManagerAdminRuleCollectionsClient := adminrulecollections.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerAdminRulesClient := adminrules.NewAdminRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerAdminRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerConnectivityConfigurationsClient := connectivityconfigurations.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerConnectivityConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerIPamPoolsClient := ipampools.NewIPamPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerIPamPoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerIPamPoolStaticCidrsClient := staticcidrs.NewStaticCidrsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerIPamPoolStaticCidrsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerNetworkGroupsClient := networkgroups.NewNetworkGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerNetworkGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagersClient := networkmanagers.NewNetworkManagersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagersClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerSecurityAdminConfigurationsClient := securityadminconfigurations.NewSecurityAdminConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerSecurityAdminConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerStaticMembersClient := staticmembers.NewStaticMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerStaticMembersClient.Client, o.ResourceManagerAuthorizer)
+
 	NatGatewayClient := network.NewNatGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatGatewayClient.Client, o.ResourceManagerAuthorizer)
 
-	VnetGatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	VnetGatewayConnectionsClient := virtualnetworkgatewayconnections.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&VnetGatewayConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
 	VirtualWanClient := network.NewVirtualWansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -226,59 +299,73 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
-		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
-		BastionHostsClient:                     &BastionHostsClient,
-		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
-		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
-		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
-		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
-		ExpressRouteCircuitConnectionClient:    &ExpressRouteCircuitConnectionClient,
-		ExpressRouteConnectionsClient:          &ExpressRouteConnectionsClient,
-		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
-		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
-		ExpressRoutePortsClient:                &ExpressRoutePortsClient,
-		FlowLogsClient:                         &FlowLogsClient,
-		HubRouteTableClient:                    &HubRouteTableClient,
-		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
-		InterfacesClient:                       &InterfacesClient,
-		IPGroupsClient:                         &IpGroupsClient,
-		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
-		NatRuleClient:                          &NatRuleClient,
-		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
-		ProfileClient:                          &ProfileClient,
-		PacketCapturesClient:                   &PacketCapturesClient,
-		PrivateEndpointClient:                  &PrivateEndpointClient,
-		PublicIPsClient:                        &PublicIPsClient,
-		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
-		RoutesClient:                           &RoutesClient,
-		RouteFiltersClient:                     &RouteFiltersClient,
-		RouteTablesClient:                      &RouteTablesClient,
-		SecurityGroupClient:                    &SecurityGroupClient,
-		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
-		SecurityRuleClient:                     &SecurityRuleClient,
-		ServiceEndpointPoliciesClient:          &ServiceEndpointPoliciesClient,
-		ServiceEndpointPolicyDefinitionsClient: &ServiceEndpointPolicyDefinitionsClient,
-		ServiceTagsClient:                      &ServiceTagsClient,
-		SubnetsClient:                          &SubnetsClient,
-		NatGatewayClient:                       &NatGatewayClient,
-		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
-		VirtualHubIPClient:                     &VirtualHubIPClient,
-		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
-		VnetGatewayClient:                      &VnetGatewayClient,
-		VnetClient:                             &VnetClient,
-		VnetPeeringsClient:                     &VnetPeeringsClient,
-		VirtualWanClient:                       &VirtualWanClient,
-		VirtualHubClient:                       &VirtualHubClient,
-		VpnConnectionsClient:                   &vpnConnectionsClient,
-		VpnGatewaysClient:                      &vpnGatewaysClient,
-		VpnServerConfigurationsClient:          &vpnServerConfigurationsClient,
-		VpnSitesClient:                         &vpnSitesClient,
-		WatcherClient:                          &WatcherClient,
-		WebApplicationFirewallPoliciesClient:   &WebApplicationFirewallPoliciesClient,
-		PrivateDnsZoneGroupClient:              &PrivateDnsZoneGroupClient,
-		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
-		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
-		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+		ApplicationGatewaysClient:                &ApplicationGatewaysClient,
+		ApplicationSecurityGroupsClient:          &ApplicationSecurityGroupsClient,
+		BastionHostsClient:                       &BastionHostsClient,
+		ConnectionMonitorsClient:                 &ConnectionMonitorsClient,
+		DDOSProtectionPlansClient:                &DDOSProtectionPlansClient,
+		ExpressRouteAuthsClient:                  &ExpressRouteAuthsClient,
+		ExpressRouteCircuitsClient:               &ExpressRouteCircuitsClient,
+		ExpressRouteCircuitConnectionClient:      &ExpressRouteCircuitConnectionClient,
+		ExpressRouteConnectionsClient:            &ExpressRouteConnectionsClient,
+		ExpressRouteGatewaysClient:               &ExpressRouteGatewaysClient,
+		ExpressRoutePeeringsClient:               &ExpressRoutePeeringsClient,
+		ExpressRoutePortsClient:                  &ExpressRoutePortsClient,
+		FlowLogsClient:                           &FlowLogsClient,
+		HubRouteTableClient:                      &HubRouteTableClient,
+		HubVirtualNetworkConnectionClient:        &HubVirtualNetworkConnectionClient,
+		InterfacesClient:                         &InterfacesClient,
+		IPGroupsClient:                           &IpGroupsClient,
+		LocalNetworkGatewaysClient:               &LocalNetworkGatewaysClient,
+		ManagerAdminRuleCollectionsClient:        &ManagerAdminRuleCollectionsClient,
+		ManagerAdminRulesClient:                  &ManagerAdminRulesClient,
+		ManagerConnectivityConfigurationsClient:  &ManagerConnectivityConfigurationsClient,
+		ManagerIPamPoolsClient:                   &ManagerIPamPoolsClient,
+		ManagerIPamPoolStaticCidrsClient:         &ManagerIPamPoolStaticCidrsClient,
+		ManagerNetworkGroupsClient:               &ManagerNetworkGroupsClient,
+		ManagersClient:                           &ManagersClient,
+		ManagerSecurityAdminConfigurationsClient: &ManagerSecurityAdminConfigurationsClient,
+		ManagerStaticMembersClient:               &ManagerStaticMembersClient,
+		NatRuleClient:                            &NatRuleClient,
+		PointToSiteVpnGatewaysClient:             &pointToSiteVpnGatewaysClient,
+		ProfileClient:                            &ProfileClient,
+		PacketCapturesClient:                     &PacketCapturesClient,
+		PrivateEndpointClient:                    &PrivateEndpointClient,
+		PublicIPsClient:                          &PublicIPsClient,
+		PublicIPAddressesClient:                  &PublicIPAddressesClient,
+		PublicIPPrefixesClient:                   &PublicIPPrefixesClient,
+		RoutesClient:                             &RoutesClient,
+		RouteFiltersClient:                       &RouteFiltersClient,
+		RouteTablesClient:                        &RouteTablesClient,
+		RoutingIntentClient:                      &RoutingIntentClient,
+		SecurityGroupClient:                      &SecurityGroupClient,
+		SecurityPartnerProviderClient:            &SecurityPartnerProviderClient,
+		SecurityRuleClient:                       &SecurityRuleClient,
+		ServiceEndpointPoliciesClient:            &ServiceEndpointPoliciesClient,
+		ServiceEndpointPolicyDefinitionsClient:   &ServiceEndpointPolicyDefinitionsClient,
+		ServiceTagsClient:                        &ServiceTagsClient,
+		SubnetsClient:                            &SubnetsClient,
+		NatGatewayClient:                         &NatGatewayClient,
+		VirtualHubBgpConnectionClient:            &VirtualHubBgpConnectionClient,
+		VirtualHubIPClient:                       &VirtualHubIPClient,
+		VnetGatewayConnectionsClient:             &VnetGatewayConnectionsClient,
+		VnetGatewayClient:                        &VnetGatewayClient,
+		VnetClient:                               &VnetClient,
+		VirtualNetworksClient:                    &VirtualNetworksClient,
+		VirtualNetworkSubnetsClient:              &VirtualNetworkSubnetsClient,
+		VnetPeeringsClient:                       &VnetPeeringsClient,
+		VirtualWanClient:                         &VirtualWanClient,
+		VirtualHubClient:                         &VirtualHubClient,
+		VpnConnectionsClient:                     &vpnConnectionsClient,
+		VpnGatewaysClient:                        &vpnGatewaysClient,
+		VpnServerConfigurationsClient:            &vpnServerConfigurationsClient,
+		VpnServerConfigurationPolicyGroupsClient: &vpnServerConfigurationPolicyGroupsClient,
+		VpnSitesClient:                           &vpnSitesClient,
+		WatcherClient:                            &WatcherClient,
+		WebApplicationFirewallPoliciesClient:     &WebApplicationFirewallPoliciesClient,
+		PrivateDnsZoneGroupClient:                &PrivateDnsZoneGroupClient,
+		PrivateLinkServiceClient:                 &PrivateLinkServiceClient,
+		ServiceAssociationLinkClient:             &ServiceAssociationLinkClient,
+		ResourceNavigationLinkClient:             &ResourceNavigationLinkClient,
 	}
 }
@@ -111,6 +111,23 @@ func TestAccAzureRMExpressRoutePort_linkCipher(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMExpressRoutePort_linkCipherXpn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkCipherXpn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.macsec_sci_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("link2.0.macsec_sci_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ExpressRoutePortResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	client := clients.Network.ExpressRoutePortsClient
 
@@ -287,6 +304,85 @@ resource "azurerm_express_route_port" "test" {
 `, template, data.RandomIntOfLength(8))
 }
 
+func (r ExpressRoutePortResource) linkCipherXpn(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest1%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "acctestKv-%[2]d"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+  sku_name                 = "premium"
+  soft_delete_enabled      = true
+  purge_protection_enabled = false
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+    secret_permissions = [
+      "get",
+    ]
+  }
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+    secret_permissions = [
+      "get",
+      "set",
+      "delete",
+      "purge"
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "cak" {
+  name         = "cak"
+  value        = "ead3664f508eb06c40ac7104cdae4ce5"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "ckn" {
+  name         = "ckn"
+  value        = "dffafc8d7b9a43d5b9a3dfbbf6a30c16"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "CDC-Canberra2"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+  link1 {
+    macsec_cipher                 = "GcmAesXpn256"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+    macsec_sci_enabled            = true
+  }
+  link2 {
+    macsec_cipher                 = "GcmAesXpn128"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+}
+`, template, data.RandomIntOfLength(8))
+}
+
 func (r ExpressRoutePortResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
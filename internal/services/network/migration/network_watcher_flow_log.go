@@ -0,0 +1,147 @@
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/flowlogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = NetworkWatcherFlowLogV0ToV1{}
+
+type NetworkWatcherFlowLogV0ToV1 struct{}
+
+func (NetworkWatcherFlowLogV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"network_security_group_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"storage_account_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"days": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"traffic_analytics": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"workspace_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"workspace_region": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"workspace_resource_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"interval_in_minutes": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						Default:  60,
+					},
+				},
+			},
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (NetworkWatcherFlowLogV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// the ID used to be a composite of the Network Watcher ID and the Network Security Group ID, from which
+		// the name of the Flow Log was derived - this is now the Resource Manager ID of the Flow Log itself
+		oldId := rawState["id"].(string)
+		legacyId, err := parse.FlowLogID(oldId)
+		if err != nil {
+			return rawState, err
+		}
+
+		newId := flowlogs.NewFlowLogID(legacyId.SubscriptionId, legacyId.ResourceGroupName, legacyId.NetworkWatcherName, legacyId.Name())
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId.ID())
+
+		rawState["id"] = newId.ID()
+		rawState["name"] = legacyId.Name()
+		rawState["target_resource_id"] = legacyId.NetworkSecurityGroupID()
+
+		return rawState, nil
+	}
+}
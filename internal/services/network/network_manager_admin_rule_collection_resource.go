@@ -0,0 +1,247 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerAdminRuleCollectionResource{}
+
+type ManagerAdminRuleCollectionResource struct{}
+
+type ManagerAdminRuleCollectionModel struct {
+	Name                         string   `tfschema:"name"`
+	SecurityAdminConfigurationId string   `tfschema:"security_admin_configuration_id"`
+	NetworkGroupIds              []string `tfschema:"network_group_ids"`
+	Description                  string   `tfschema:"description"`
+}
+
+func (r ManagerAdminRuleCollectionResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule_collection"
+}
+
+func (r ManagerAdminRuleCollectionResource) ModelObject() interface{} {
+	return &ManagerAdminRuleCollectionModel{}
+}
+
+func (r ManagerAdminRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return adminrulecollections.ValidateRuleCollectionID
+}
+
+func (r ManagerAdminRuleCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"security_admin_configuration_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: securityadminconfigurations.ValidateSecurityAdminConfigurationID,
+		},
+
+		"network_group_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkgroups.ValidateNetworkGroupID,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerAdminRuleCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerAdminRuleCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			var model ManagerAdminRuleCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			configurationId, err := securityadminconfigurations.ParseSecurityAdminConfigurationID(model.SecurityAdminConfigurationId)
+			if err != nil {
+				return err
+			}
+
+			id := adminrulecollections.NewRuleCollectionID(configurationId.SubscriptionId, configurationId.ResourceGroupName, configurationId.NetworkManagerName, configurationId.SecurityAdminConfigurationName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := adminrulecollections.AdminRuleCollection{
+				Properties: &adminrulecollections.AdminRuleCollectionPropertiesFormat{
+					AppliesToGroups: expandNetworkManagerSecurityGroupItems(model.NetworkGroupIds),
+				},
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			id, err := adminrulecollections.ParseRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerAdminRuleCollectionModel{
+				Name:                         id.RuleCollectionName,
+				SecurityAdminConfigurationId: securityadminconfigurations.NewSecurityAdminConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					networkGroupIds, err := flattenNetworkManagerSecurityGroupItems(props.AppliesToGroups)
+					if err != nil {
+						return err
+					}
+					state.NetworkGroupIds = networkGroupIds
+					state.Description = utils.NormalizeNilableString(props.Description)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerAdminRuleCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			id, err := adminrulecollections.ParseRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerAdminRuleCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("network_group_ids") {
+				payload.Properties.AppliesToGroups = expandNetworkManagerSecurityGroupItems(model.NetworkGroupIds)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			id, err := adminrulecollections.ParseRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, adminrulecollections.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerSecurityGroupItems(input []string) []adminrulecollections.NetworkManagerSecurityGroupItem {
+	output := make([]adminrulecollections.NetworkManagerSecurityGroupItem, 0)
+	for _, v := range input {
+		output = append(output, adminrulecollections.NetworkManagerSecurityGroupItem{
+			NetworkGroupId: v,
+		})
+	}
+	return output
+}
+
+func flattenNetworkManagerSecurityGroupItems(input []adminrulecollections.NetworkManagerSecurityGroupItem) ([]string, error) {
+	output := make([]string, 0)
+	for _, v := range input {
+		networkGroupId, err := networkgroups.ParseNetworkGroupIDInsensitively(v.NetworkGroupId)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, networkGroupId.ID())
+	}
+	return output, nil
+}
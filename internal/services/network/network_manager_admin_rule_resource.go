@@ -0,0 +1,350 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+
+type ManagerAdminRuleResource struct{}
+
+type ManagerAdminRuleModel struct {
+	Name                  string                          `tfschema:"name"`
+	AdminRuleCollectionId string                          `tfschema:"admin_rule_collection_id"`
+	Action                string                          `tfschema:"action"`
+	Description           string                          `tfschema:"description"`
+	DestinationPortRanges []string                        `tfschema:"destination_port_ranges"`
+	Destinations          []ManagerAdminRuleAddressPrefix `tfschema:"destination"`
+	Direction             string                          `tfschema:"direction"`
+	Priority              int64                           `tfschema:"priority"`
+	Protocol              string                          `tfschema:"protocol"`
+	SourcePortRanges      []string                        `tfschema:"source_port_ranges"`
+	Sources               []ManagerAdminRuleAddressPrefix `tfschema:"source"`
+}
+
+type ManagerAdminRuleAddressPrefix struct {
+	AddressPrefix     string `tfschema:"address_prefix"`
+	AddressPrefixType string `tfschema:"address_prefix_type"`
+}
+
+func (r ManagerAdminRuleResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule"
+}
+
+func (r ManagerAdminRuleResource) ModelObject() interface{} {
+	return &ManagerAdminRuleModel{}
+}
+
+func (r ManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return adminrules.ValidateRuleID
+}
+
+func (r ManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"admin_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: adminrulecollections.ValidateRuleCollectionID,
+		},
+
+		"action": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(adminrules.SecurityConfigurationRuleAccessAllow),
+				string(adminrules.SecurityConfigurationRuleAccessAlwaysAllow),
+				string(adminrules.SecurityConfigurationRuleAccessDeny),
+			}, false),
+		},
+
+		"direction": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(adminrules.SecurityConfigurationRuleDirectionInbound),
+				string(adminrules.SecurityConfigurationRuleDirectionOutbound),
+			}, false),
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 4096),
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(adminrules.SecurityConfigurationRuleProtocolAh),
+				string(adminrules.SecurityConfigurationRuleProtocolAny),
+				string(adminrules.SecurityConfigurationRuleProtocolEsp),
+				string(adminrules.SecurityConfigurationRuleProtocolIcmp),
+				string(adminrules.SecurityConfigurationRuleProtocolTcp),
+				string(adminrules.SecurityConfigurationRuleProtocolUdp),
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"destination": networkManagerAdminRuleAddressPrefixSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"source": networkManagerAdminRuleAddressPrefixSchema(),
+	}
+}
+
+func (r ManagerAdminRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerAdminRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			ruleCollectionId, err := adminrulecollections.ParseRuleCollectionID(model.AdminRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := adminrules.NewRuleID(ruleCollectionId.SubscriptionId, ruleCollectionId.ResourceGroupName, ruleCollectionId.NetworkManagerName, ruleCollectionId.SecurityAdminConfigurationName, ruleCollectionId.RuleCollectionName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := adminrules.AdminRule{
+				Kind:       adminrules.AdminRuleKindCustom,
+				Properties: expandNetworkManagerAdminRuleProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerAdminRuleModel{
+				Name:                  id.RuleName,
+				AdminRuleCollectionId: adminrulecollections.NewRuleCollectionID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Kind != adminrules.AdminRuleKindCustom {
+					return fmt.Errorf("retrieving %s: expected `kind` to be %q but got %q", *id, adminrules.AdminRuleKindCustom, model.Kind)
+				}
+
+				if props := model.Properties; props != nil {
+					state.Action = string(props.Access)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.DestinationPortRanges = pointer.ToSliceOfStrings(props.DestinationPortRanges)
+					state.Destinations = flattenNetworkManagerAdminRuleAddressPrefixItems(props.Destinations)
+					state.Direction = string(props.Direction)
+					state.Priority = props.Priority
+					state.Protocol = string(props.Protocol)
+					state.SourcePortRanges = pointer.ToSliceOfStrings(props.SourcePortRanges)
+					state.Sources = flattenNetworkManagerAdminRuleAddressPrefixItems(props.Sources)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := adminrules.AdminRule{
+				Kind:       adminrules.AdminRuleKindCustom,
+				Properties: expandNetworkManagerAdminRuleProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, adminrules.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func networkManagerAdminRuleAddressPrefixSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(adminrules.AddressPrefixTypeIPPrefix),
+						string(adminrules.AddressPrefixTypeServiceTag),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkManagerAdminRuleProperties(input ManagerAdminRuleModel) *adminrules.AdminPropertiesFormat {
+	output := adminrules.AdminPropertiesFormat{
+		Access:                adminrules.SecurityConfigurationRuleAccess(input.Action),
+		DestinationPortRanges: pointer.FromSliceOfStrings(input.DestinationPortRanges),
+		Destinations:          expandNetworkManagerAdminRuleAddressPrefixItems(input.Destinations),
+		Direction:             adminrules.SecurityConfigurationRuleDirection(input.Direction),
+		Priority:              input.Priority,
+		Protocol:              adminrules.SecurityConfigurationRuleProtocol(input.Protocol),
+		SourcePortRanges:      pointer.FromSliceOfStrings(input.SourcePortRanges),
+		Sources:               expandNetworkManagerAdminRuleAddressPrefixItems(input.Sources),
+	}
+
+	if input.Description != "" {
+		output.Description = utils.String(input.Description)
+	}
+
+	return &output
+}
+
+func expandNetworkManagerAdminRuleAddressPrefixItems(input []ManagerAdminRuleAddressPrefix) *[]adminrules.AddressPrefixItem {
+	output := make([]adminrules.AddressPrefixItem, 0)
+	for _, v := range input {
+		addressPrefixType := adminrules.AddressPrefixType(v.AddressPrefixType)
+		output = append(output, adminrules.AddressPrefixItem{
+			AddressPrefix:     utils.String(v.AddressPrefix),
+			AddressPrefixType: &addressPrefixType,
+		})
+	}
+	return &output
+}
+
+func flattenNetworkManagerAdminRuleAddressPrefixItems(input *[]adminrules.AddressPrefixItem) []ManagerAdminRuleAddressPrefix {
+	output := make([]ManagerAdminRuleAddressPrefix, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		item := ManagerAdminRuleAddressPrefix{
+			AddressPrefix: utils.NormalizeNilableString(v.AddressPrefix),
+		}
+		if v.AddressPrefixType != nil {
+			item.AddressPrefixType = string(*v.AddressPrefixType)
+		}
+		output = append(output, item)
+	}
+	return output
+}
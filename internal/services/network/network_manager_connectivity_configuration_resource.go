@@ -0,0 +1,404 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerConnectivityConfigurationResource{}
+
+type ManagerConnectivityConfigurationResource struct{}
+
+type ManagerConnectivityConfigurationModel struct {
+	Name                         string                                           `tfschema:"name"`
+	NetworkManagerId             string                                           `tfschema:"network_manager_id"`
+	AppliesToGroups              []ManagerConnectivityConfigurationGroupItemModel `tfschema:"applies_to_group"`
+	ConnectivityTopology         string                                           `tfschema:"connectivity_topology"`
+	DeleteExistingPeeringEnabled bool                                             `tfschema:"delete_existing_peering_enabled"`
+	Description                  string                                           `tfschema:"description"`
+	GlobalMeshEnabled            bool                                             `tfschema:"global_mesh_enabled"`
+	Hub                          []ManagerConnectivityConfigurationHubModel       `tfschema:"hub"`
+}
+
+type ManagerConnectivityConfigurationGroupItemModel struct {
+	GroupConnectivity string `tfschema:"group_connectivity"`
+	GlobalMeshEnabled bool   `tfschema:"global_mesh_enabled"`
+	NetworkGroupId    string `tfschema:"network_group_id"`
+	UseHubGateway     bool   `tfschema:"use_hub_gateway"`
+}
+
+type ManagerConnectivityConfigurationHubModel struct {
+	ResourceId   string `tfschema:"resource_id"`
+	ResourceType string `tfschema:"resource_type"`
+}
+
+func (r ManagerConnectivityConfigurationResource) ResourceType() string {
+	return "azurerm_network_manager_connectivity_configuration"
+}
+
+func (r ManagerConnectivityConfigurationResource) ModelObject() interface{} {
+	return &ManagerConnectivityConfigurationModel{}
+}
+
+func (r ManagerConnectivityConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectivityconfigurations.ValidateConnectivityConfigurationID
+}
+
+func (r ManagerConnectivityConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"applies_to_group": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_group_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkgroups.ValidateNetworkGroupID,
+					},
+
+					"group_connectivity": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(connectivityconfigurations.GroupConnectivityNone),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(connectivityconfigurations.GroupConnectivityDirectlyConnected),
+							string(connectivityconfigurations.GroupConnectivityNone),
+						}, false),
+					},
+
+					"global_mesh_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"use_hub_gateway": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"connectivity_topology": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(connectivityconfigurations.ConnectivityTopologyHubAndSpoke),
+				string(connectivityconfigurations.ConnectivityTopologyMesh),
+			}, false),
+		},
+
+		"delete_existing_peering_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"global_mesh_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"hub": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerConnectivityConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			var model ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := connectivityconfigurations.NewConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := connectivityconfigurations.ConnectivityConfiguration{
+				Properties: expandNetworkManagerConnectivityConfigurationProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerConnectivityConfigurationModel{
+				Name:             id.ConnectivityConfigurationName,
+				NetworkManagerId: networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					appliesToGroups, err := flattenNetworkManagerConnectivityGroupItems(props.AppliesToGroups)
+					if err != nil {
+						return err
+					}
+					state.AppliesToGroups = appliesToGroups
+					state.ConnectivityTopology = string(props.ConnectivityTopology)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Hub = flattenNetworkManagerConnectivityHubs(props.Hubs)
+
+					if props.DeleteExistingPeering != nil {
+						state.DeleteExistingPeeringEnabled = *props.DeleteExistingPeering == connectivityconfigurations.DeleteExistingPeeringTrue
+					}
+
+					if props.IsGlobal != nil {
+						state.GlobalMeshEnabled = *props.IsGlobal == connectivityconfigurations.IsGlobalTrue
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := connectivityconfigurations.ConnectivityConfiguration{
+				Properties: expandNetworkManagerConnectivityConfigurationProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, connectivityconfigurations.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerConnectivityConfigurationProperties(input ManagerConnectivityConfigurationModel) *connectivityconfigurations.ConnectivityConfigurationProperties {
+	deleteExistingPeering := connectivityconfigurations.DeleteExistingPeeringFalse
+	if input.DeleteExistingPeeringEnabled {
+		deleteExistingPeering = connectivityconfigurations.DeleteExistingPeeringTrue
+	}
+
+	isGlobal := connectivityconfigurations.IsGlobalFalse
+	if input.GlobalMeshEnabled {
+		isGlobal = connectivityconfigurations.IsGlobalTrue
+	}
+
+	output := connectivityconfigurations.ConnectivityConfigurationProperties{
+		AppliesToGroups:       expandNetworkManagerConnectivityGroupItems(input.AppliesToGroups),
+		ConnectivityTopology:  connectivityconfigurations.ConnectivityTopology(input.ConnectivityTopology),
+		DeleteExistingPeering: &deleteExistingPeering,
+		Hubs:                  expandNetworkManagerConnectivityHubs(input.Hub),
+		IsGlobal:              &isGlobal,
+	}
+
+	if input.Description != "" {
+		output.Description = utils.String(input.Description)
+	}
+
+	return &output
+}
+
+func expandNetworkManagerConnectivityGroupItems(input []ManagerConnectivityConfigurationGroupItemModel) []connectivityconfigurations.ConnectivityGroupItem {
+	output := make([]connectivityconfigurations.ConnectivityGroupItem, 0)
+	for _, v := range input {
+		isGlobal := connectivityconfigurations.IsGlobalFalse
+		if v.GlobalMeshEnabled {
+			isGlobal = connectivityconfigurations.IsGlobalTrue
+		}
+
+		useHubGateway := connectivityconfigurations.UseHubGatewayFalse
+		if v.UseHubGateway {
+			useHubGateway = connectivityconfigurations.UseHubGatewayTrue
+		}
+
+		output = append(output, connectivityconfigurations.ConnectivityGroupItem{
+			GroupConnectivity: connectivityconfigurations.GroupConnectivity(v.GroupConnectivity),
+			IsGlobal:          &isGlobal,
+			NetworkGroupId:    v.NetworkGroupId,
+			UseHubGateway:     &useHubGateway,
+		})
+	}
+
+	return output
+}
+
+func flattenNetworkManagerConnectivityGroupItems(input []connectivityconfigurations.ConnectivityGroupItem) ([]ManagerConnectivityConfigurationGroupItemModel, error) {
+	output := make([]ManagerConnectivityConfigurationGroupItemModel, 0)
+	for _, v := range input {
+		networkGroupId, err := networkgroups.ParseNetworkGroupIDInsensitively(v.NetworkGroupId)
+		if err != nil {
+			return nil, err
+		}
+
+		item := ManagerConnectivityConfigurationGroupItemModel{
+			GroupConnectivity: string(v.GroupConnectivity),
+			NetworkGroupId:    networkGroupId.ID(),
+		}
+
+		if v.IsGlobal != nil {
+			item.GlobalMeshEnabled = *v.IsGlobal == connectivityconfigurations.IsGlobalTrue
+		}
+
+		if v.UseHubGateway != nil {
+			item.UseHubGateway = *v.UseHubGateway == connectivityconfigurations.UseHubGatewayTrue
+		}
+
+		output = append(output, item)
+	}
+
+	return output, nil
+}
+
+func expandNetworkManagerConnectivityHubs(input []ManagerConnectivityConfigurationHubModel) *[]connectivityconfigurations.Hub {
+	output := make([]connectivityconfigurations.Hub, 0)
+	for _, v := range input {
+		output = append(output, connectivityconfigurations.Hub{
+			ResourceId:   utils.String(v.ResourceId),
+			ResourceType: utils.String(v.ResourceType),
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerConnectivityHubs(input *[]connectivityconfigurations.Hub) []ManagerConnectivityConfigurationHubModel {
+	output := make([]ManagerConnectivityConfigurationHubModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ManagerConnectivityConfigurationHubModel{
+			ResourceId:   utils.NormalizeNilableString(v.ResourceId),
+			ResourceType: utils.NormalizeNilableString(v.ResourceType),
+		})
+	}
+
+	return output
+}
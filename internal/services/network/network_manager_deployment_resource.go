@@ -0,0 +1,299 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerDeploymentResource{}
+
+type ManagerDeploymentResource struct{}
+
+type ManagerDeploymentModel struct {
+	NetworkManagerId string            `tfschema:"network_manager_id"`
+	Location         string            `tfschema:"location"`
+	ScopeAccess      string            `tfschema:"scope_access"`
+	ConfigurationIds []string          `tfschema:"configuration_ids"`
+	Triggers         map[string]string `tfschema:"triggers"`
+}
+
+func (r ManagerDeploymentResource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r ManagerDeploymentResource) ModelObject() interface{} {
+	return &ManagerDeploymentModel{}
+}
+
+func (r ManagerDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerDeploymentID
+}
+
+func (r ManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"location": commonschema.Location(),
+
+		"scope_access": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(networkmanagers.ConfigurationTypeConnectivity),
+				string(networkmanagers.ConfigurationTypeSecurityAdmin),
+			}, false),
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		// changing any of the triggers re-commits the configurations, e.g. when the rules within them change
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, location.Normalize(model.Location), model.ScopeAccess)
+
+			existing, err := getNetworkManagerDeploymentStatus(ctx, client, id)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := commitNetworkManagerDeployment(ctx, client, id, model.ConfigurationIds); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			deployment, err := getNetworkManagerDeploymentStatus(ctx, client, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if deployment == nil || deployment.ConfigurationIds == nil || len(*deployment.ConfigurationIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			var config ManagerDeploymentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ManagerDeploymentModel{
+				NetworkManagerId: id.NetworkManagerId().ID(),
+				Location:         location.Normalize(id.Location),
+				ScopeAccess:      id.ScopeAccess,
+				ConfigurationIds: *deployment.ConfigurationIds,
+				// triggers are Terraform-only and not returned by the API
+				Triggers: config.Triggers,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("configuration_ids", "triggers") {
+				if err := commitNetworkManagerDeployment(ctx, client, *id, model.ConfigurationIds); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// committing an empty set of configurations removes the deployment from the location
+			if err := commitNetworkManagerDeployment(ctx, client, *id, []string{}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func commitNetworkManagerDeployment(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId, configurationIds []string) error {
+	payload := networkmanagers.NetworkManagerCommit{
+		CommitType:       networkmanagers.ConfigurationType(id.ScopeAccess),
+		ConfigurationIds: &configurationIds,
+		TargetLocations:  []string{id.Location},
+	}
+
+	if err := client.NetworkManagerCommitsPostThenPoll(ctx, id.NetworkManagerId(), payload); err != nil {
+		return fmt.Errorf("committing: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	// the commit operation completes once the commit has been accepted, the deployment to the location happens afterwards
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(networkmanagers.DeploymentStatusDeploying),
+			string(networkmanagers.DeploymentStatusNotStarted),
+		},
+		Target: []string{
+			string(networkmanagers.DeploymentStatusDeployed),
+		},
+		Refresh:    networkManagerDeploymentRefreshFunc(ctx, client, id, len(configurationIds) == 0),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the deployment of the commit: %+v", err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentRefreshFunc(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId, removal bool) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := getNetworkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if deployment == nil || deployment.DeploymentStatus == nil {
+			// once all configurations have been removed there may be no deployment status left for the location
+			if removal {
+				return id, string(networkmanagers.DeploymentStatusDeployed), nil
+			}
+			return id, string(networkmanagers.DeploymentStatusNotStarted), nil
+		}
+
+		if *deployment.DeploymentStatus == networkmanagers.DeploymentStatusFailed {
+			return deployment, string(*deployment.DeploymentStatus), fmt.Errorf("deployment failed: %s", utils.NormalizeNilableString(deployment.ErrorMessage))
+		}
+
+		return deployment, string(*deployment.DeploymentStatus), nil
+	}
+}
+
+func getNetworkManagerDeploymentStatus(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) (*networkmanagers.NetworkManagerDeploymentStatus, error) {
+	input := networkmanagers.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagers.ConfigurationType{networkmanagers.ConfigurationType(id.ScopeAccess)},
+		Regions:         &[]string{id.Location},
+	}
+
+	resp, err := client.NetworkManagerDeploymentStatusList(ctx, id.NetworkManagerId(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, nil
+	}
+
+	for _, v := range *resp.Model.Value {
+		if v.Region == nil || !strings.EqualFold(location.Normalize(*v.Region), id.Location) {
+			continue
+		}
+		if v.DeploymentType == nil || !strings.EqualFold(string(*v.DeploymentType), id.ScopeAccess) {
+			continue
+		}
+
+		deployment := v
+		return &deployment, nil
+	}
+
+	return nil, nil
+}
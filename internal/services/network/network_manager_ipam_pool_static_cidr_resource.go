@@ -0,0 +1,238 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/ipampools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/staticcidrs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerIpamPoolStaticCidrResource{}
+
+type ManagerIpamPoolStaticCidrResource struct{}
+
+type ManagerIpamPoolStaticCidrModel struct {
+	Name                          string   `tfschema:"name"`
+	IpamPoolId                    string   `tfschema:"ipam_pool_id"`
+	AddressPrefixes               []string `tfschema:"address_prefixes"`
+	NumberOfIpAddressesToAllocate string   `tfschema:"number_of_ip_addresses_to_allocate"`
+	Description                   string   `tfschema:"description"`
+}
+
+func (r ManagerIpamPoolStaticCidrResource) ResourceType() string {
+	return "azurerm_network_manager_ipam_pool_static_cidr"
+}
+
+func (r ManagerIpamPoolStaticCidrResource) ModelObject() interface{} {
+	return &ManagerIpamPoolStaticCidrModel{}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return staticcidrs.ValidateStaticCidrID
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ipam_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: ipampools.ValidateIPamPoolID,
+		},
+
+		"address_prefixes": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+
+		"number_of_ip_addresses_to_allocate": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "`number_of_ip_addresses_to_allocate` must be a positive integer"),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerIPamPoolStaticCidrsClient
+
+			var model ManagerIpamPoolStaticCidrModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			poolId, err := ipampools.ParseIPamPoolID(model.IpamPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := staticcidrs.NewStaticCidrID(poolId.SubscriptionId, poolId.ResourceGroupName, poolId.NetworkManagerName, poolId.PoolName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := staticcidrs.StaticCidr{
+				Properties: &staticcidrs.StaticCidrProperties{},
+			}
+
+			if len(model.AddressPrefixes) > 0 {
+				payload.Properties.AddressPrefixes = &model.AddressPrefixes
+			}
+
+			if model.NumberOfIpAddressesToAllocate != "" {
+				payload.Properties.NumberOfIPAddressesToAllocate = utils.String(model.NumberOfIpAddressesToAllocate)
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerIPamPoolStaticCidrsClient
+
+			id, err := staticcidrs.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerIpamPoolStaticCidrModel{
+				Name:       id.StaticCidrName,
+				IpamPoolId: ipampools.NewIPamPoolID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.PoolName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AddressPrefixes = pointer.ToSliceOfStrings(props.AddressPrefixes)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.NumberOfIpAddressesToAllocate = utils.NormalizeNilableString(props.NumberOfIPAddressesToAllocate)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerIPamPoolStaticCidrsClient
+
+			id, err := staticcidrs.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerIpamPoolStaticCidrModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.Create(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerIPamPoolStaticCidrsClient
+
+			id, err := staticcidrs.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
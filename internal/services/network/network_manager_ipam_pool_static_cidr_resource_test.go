@@ -0,0 +1,147 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/staticcidrs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerIpamPoolStaticCidrResource struct{}
+
+func TestAccNetworkManagerIpamPoolStaticCidr_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerIpamPoolStaticCidr_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkManagerIpamPoolStaticCidr_numberOfIpAddresses(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.numberOfIpAddresses(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerIpamPoolStaticCidr_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := staticcidrs.ParseStaticCidrID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerIPamPoolStaticCidrsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagerIpamPoolStaticCidrResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "test" {
+  name             = "acctest-nmipsc-%d"
+  ipam_pool_id     = azurerm_network_manager_ipam_pool.test.id
+  address_prefixes = ["10.0.1.0/24"]
+}
+`, ManagerIpamPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerIpamPoolStaticCidrResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "import" {
+  name             = azurerm_network_manager_ipam_pool_static_cidr.test.name
+  ipam_pool_id     = azurerm_network_manager_ipam_pool_static_cidr.test.ipam_pool_id
+  address_prefixes = azurerm_network_manager_ipam_pool_static_cidr.test.address_prefixes
+}
+`, r.basic(data))
+}
+
+func (r ManagerIpamPoolStaticCidrResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "test" {
+  name             = "acctest-nmipsc-%d"
+  ipam_pool_id     = azurerm_network_manager_ipam_pool.test.id
+  address_prefixes = ["10.0.1.0/24"]
+  description      = "acctest static cidr"
+}
+`, ManagerIpamPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerIpamPoolStaticCidrResource) numberOfIpAddresses(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "test" {
+  name                               = "acctest-nmipsc-%d"
+  ipam_pool_id                       = azurerm_network_manager_ipam_pool.test.id
+  number_of_ip_addresses_to_allocate = "256"
+}
+`, ManagerIpamPoolResource{}.basic(data), data.RandomInteger)
+}
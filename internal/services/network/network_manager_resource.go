@@ -0,0 +1,365 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerResource{}
+
+type ManagerResource struct{}
+
+type ManagerModel struct {
+	Name              string                         `tfschema:"name"`
+	ResourceGroupName string                         `tfschema:"resource_group_name"`
+	Location          string                         `tfschema:"location"`
+	Description       string                         `tfschema:"description"`
+	Scope             []ManagerScopeModel            `tfschema:"scope"`
+	ScopeAccesses     []string                       `tfschema:"scope_accesses"`
+	CrossTenantScopes []ManagerCrossTenantScopeModel `tfschema:"cross_tenant_scopes"`
+	Tags              map[string]string              `tfschema:"tags"`
+}
+
+type ManagerScopeModel struct {
+	ManagementGroupIds []string `tfschema:"management_group_ids"`
+	SubscriptionIds    []string `tfschema:"subscription_ids"`
+}
+
+type ManagerCrossTenantScopeModel struct {
+	TenantId         string   `tfschema:"tenant_id"`
+	ManagementGroups []string `tfschema:"management_groups"`
+	Subscriptions    []string `tfschema:"subscriptions"`
+}
+
+func (r ManagerResource) ResourceType() string {
+	return "azurerm_network_manager"
+}
+
+func (r ManagerResource) ModelObject() interface{} {
+	return &ManagerModel{}
+}
+
+func (r ManagerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkmanagers.ValidateNetworkManagerID
+}
+
+func (r ManagerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"scope": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"management_group_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateManagementGroupID,
+						},
+						AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+					},
+
+					"subscription_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateSubscriptionID,
+						},
+						AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+					},
+				},
+			},
+		},
+
+		"scope_accesses": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(networkmanagers.ConfigurationTypeConnectivity),
+					string(networkmanagers.ConfigurationTypeSecurityAdmin),
+				}, false),
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cross_tenant_scopes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"management_groups": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"subscriptions": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ManagerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := networkmanagers.NewNetworkManagerID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networkmanagers.NetworkManager{
+				Location: utils.String(location.Normalize(model.Location)),
+				Properties: &networkmanagers.NetworkManagerProperties{
+					NetworkManagerScopeAccesses: expandNetworkManagerScopeAccesses(model.ScopeAccesses),
+					NetworkManagerScopes:        expandNetworkManagerScope(model.Scope),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerModel{
+				Name:              id.NetworkManagerName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Scope = flattenNetworkManagerScope(props.NetworkManagerScopes)
+					state.ScopeAccesses = flattenNetworkManagerScopeAccesses(props.NetworkManagerScopeAccesses)
+					state.CrossTenantScopes = flattenNetworkManagerCrossTenantScopes(props.NetworkManagerScopes.CrossTenantScopes)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("scope") {
+				payload.Properties.NetworkManagerScopes = expandNetworkManagerScope(model.Scope)
+			}
+
+			if metadata.ResourceData.HasChange("scope_accesses") {
+				payload.Properties.NetworkManagerScopeAccesses = expandNetworkManagerScopeAccesses(model.ScopeAccesses)
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, networkmanagers.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerScope(input []ManagerScopeModel) networkmanagers.NetworkManagerPropertiesNetworkManagerScopes {
+	if len(input) == 0 {
+		return networkmanagers.NetworkManagerPropertiesNetworkManagerScopes{}
+	}
+
+	return networkmanagers.NetworkManagerPropertiesNetworkManagerScopes{
+		ManagementGroups: pointer.FromSliceOfStrings(input[0].ManagementGroupIds),
+		Subscriptions:    pointer.FromSliceOfStrings(input[0].SubscriptionIds),
+	}
+}
+
+func flattenNetworkManagerScope(input networkmanagers.NetworkManagerPropertiesNetworkManagerScopes) []ManagerScopeModel {
+	return []ManagerScopeModel{
+		{
+			ManagementGroupIds: pointer.ToSliceOfStrings(input.ManagementGroups),
+			SubscriptionIds:    pointer.ToSliceOfStrings(input.Subscriptions),
+		},
+	}
+}
+
+func expandNetworkManagerScopeAccesses(input []string) []networkmanagers.ConfigurationType {
+	output := make([]networkmanagers.ConfigurationType, 0)
+	for _, v := range input {
+		output = append(output, networkmanagers.ConfigurationType(v))
+	}
+	return output
+}
+
+func flattenNetworkManagerScopeAccesses(input []networkmanagers.ConfigurationType) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+	return output
+}
+
+func flattenNetworkManagerCrossTenantScopes(input *[]networkmanagers.CrossTenantScopes) []ManagerCrossTenantScopeModel {
+	output := make([]ManagerCrossTenantScopeModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ManagerCrossTenantScopeModel{
+			TenantId:         utils.NormalizeNilableString(v.TenantId),
+			ManagementGroups: pointer.ToSliceOfStrings(v.ManagementGroups),
+			Subscriptions:    pointer.ToSliceOfStrings(v.Subscriptions),
+		})
+	}
+
+	return output
+}
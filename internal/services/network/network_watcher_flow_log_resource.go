@@ -3,14 +3,18 @@ package network
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/flowlogs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -27,10 +31,15 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 		Delete: resourceNetworkWatcherFlowLogDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.FlowLogID(id)
+			_, err := flowlogs.ParseFlowLogID(id)
 			return err
 		}),
 
+		SchemaVersion: 1,
+		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.NetworkWatcherFlowLogV0ToV1{},
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -49,18 +58,31 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"name": {
-				Type:     pluginsdk.TypeString,
-				Computed: true,
 				// TODO 3.0: Make this required, and remove computed.
-				//Required: true,
-				//ValidateFunc: validate.NetworkWatcherFlowLogName,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkWatcherFlowLogName,
 			},
 
 			"network_security_group_id": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.NetworkSecurityGroupID,
+				ExactlyOneOf: []string{"network_security_group_id", "target_resource_id"},
+				Deprecated:   "Network Security Group Flow Logs are being retired, `target_resource_id` should be used to configure Flow Logs for a Virtual Network, Subnet or Network Interface instead.",
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNetworkWatcherFlowLogTargetResourceID,
+				ExactlyOneOf: []string{"network_security_group_id", "target_resource_id"},
 			},
 
 			"storage_account_id": {
@@ -176,16 +198,24 @@ func resourceNetworkWatcherFlowLogCreateUpdate(d *pluginsdk.ResourceData, meta i
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	resourceGroupName := d.Get("resource_group_name").(string)
-	networkWatcherName := d.Get("network_watcher_name").(string)
-	networkSecurityGroupID := d.Get("network_security_group_id").(string)
+	targetResourceId := d.Get("target_resource_id").(string)
+	if v := d.Get("network_security_group_id").(string); v != "" {
+		targetResourceId = v
+	}
 
-	// guaranteed via schema validation
-	nsgId, _ := parse.NetworkSecurityGroupID(networkSecurityGroupID)
-	id := parse.NewFlowLogID(subscriptionId, resourceGroupName, networkWatcherName, *nsgId)
+	name := d.Get("name").(string)
+	if name == "" {
+		defaultName, err := networkWatcherFlowLogDefaultName(targetResourceId)
+		if err != nil {
+			return err
+		}
+		name = defaultName
+	}
 
-	locks.ByID(nsgId.ID())
-	defer locks.UnlockByID(nsgId.ID())
+	id := flowlogs.NewFlowLogID(subscriptionId, d.Get("resource_group_name").(string), d.Get("network_watcher_name").(string), name)
+
+	locks.ByID(targetResourceId)
+	defer locks.UnlockByID(targetResourceId)
 
 	loc := d.Get("location").(string)
 	if loc == "" {
@@ -200,36 +230,29 @@ func resourceNetworkWatcherFlowLogCreateUpdate(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	parameters := network.FlowLog{
+	parameters := flowlogs.FlowLog{
 		Location: utils.String(location.Normalize(loc)),
-		FlowLogPropertiesFormat: &network.FlowLogPropertiesFormat{
-			TargetResourceID: utils.String(id.NetworkSecurityGroupID()),
-			StorageID:        utils.String(d.Get("storage_account_id").(string)),
+		Properties: &flowlogs.FlowLogPropertiesFormat{
+			TargetResourceId: targetResourceId,
+			StorageId:        d.Get("storage_account_id").(string),
 			Enabled:          utils.Bool(d.Get("enabled").(bool)),
 			RetentionPolicy:  expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, ok := d.GetOk("traffic_analytics"); ok {
-		parameters.FlowAnalyticsConfiguration = expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d)
+		parameters.Properties.FlowAnalyticsConfiguration = expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d)
 	}
 
 	if version, ok := d.GetOk("version"); ok {
-		format := &network.FlowLogFormatParameters{
-			Version: utils.Int32(int32(version.(int))),
+		parameters.Properties.Format = &flowlogs.FlowLogFormatParameters{
+			Version: utils.Int64(int64(version.(int))),
 		}
-
-		parameters.Format = format
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroupName, id.NetworkWatcherName, id.Name(), parameters)
-	if err != nil {
-		return fmt.Errorf("creating %q: %+v", id, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of creating %q: %+v", id, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
@@ -242,52 +265,65 @@ func resourceNetworkWatcherFlowLogRead(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.FlowLogID(d.Id())
+	id, err := flowlogs.ParseFlowLogID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	// Get current flow log status
-	resp, err := client.Get(ctx, id.ResourceGroupName, id.NetworkWatcherName, id.Name())
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state!", id)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("retrieving %q: %+v", id, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	d.Set("name", id.FlowLogName)
 	d.Set("network_watcher_name", id.NetworkWatcherName)
 	d.Set("resource_group_name", id.ResourceGroupName)
-	d.Set("network_security_group_id", id.NetworkSecurityGroupID())
-	d.Set("location", location.NormalizeNilable(resp.Location))
-	d.Set("name", resp.Name)
-
-	if prop := resp.FlowLogPropertiesFormat; prop != nil {
-		if err := d.Set("traffic_analytics", flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(prop.FlowAnalyticsConfiguration)); err != nil {
-			return fmt.Errorf("setting `traffic_analytics`: %+v", err)
-		}
-
-		d.Set("enabled", prop.Enabled)
 
-		if format := prop.Format; format != nil {
-			d.Set("version", format.Version)
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if prop := model.Properties; prop != nil {
+			networkSecurityGroupId := ""
+			targetResourceId := prop.TargetResourceId
+			if nsgId, err := parse.NetworkSecurityGroupID(prop.TargetResourceId); err == nil {
+				networkSecurityGroupId = nsgId.ID()
+				targetResourceId = nsgId.ID()
+			}
+			d.Set("network_security_group_id", networkSecurityGroupId)
+			d.Set("target_resource_id", targetResourceId)
+
+			if err := d.Set("traffic_analytics", flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(prop.FlowAnalyticsConfiguration)); err != nil {
+				return fmt.Errorf("setting `traffic_analytics`: %+v", err)
+			}
+
+			d.Set("enabled", prop.Enabled)
+
+			if format := prop.Format; format != nil {
+				d.Set("version", format.Version)
+			}
+
+			// Azure API returns "" when flow log is disabled
+			// Don't overwrite to prevent storage account ID diff when that is the case
+			if prop.StorageId != "" {
+				d.Set("storage_account_id", prop.StorageId)
+			}
+
+			if err := d.Set("retention_policy", flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(prop.RetentionPolicy)); err != nil {
+				return fmt.Errorf("setting `retention_policy`: %+v", err)
+			}
 		}
 
-		// Azure API returns "" when flow log is disabled
-		// Don't overwrite to prevent storage account ID diff when that is the case
-		if prop.StorageID != nil && *prop.StorageID != "" {
-			d.Set("storage_account_id", prop.StorageID)
-		}
-
-		if err := d.Set("retention_policy", flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(prop.RetentionPolicy)); err != nil {
-			return fmt.Errorf("setting `retention_policy`: %+v", err)
-		}
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -295,27 +331,58 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.FlowLogID(d.Id())
+	id, err := flowlogs.ParseFlowLogID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByID(id.NetworkSecurityGroupID())
-	defer locks.UnlockByID(id.NetworkSecurityGroupID())
+	targetResourceId := d.Get("target_resource_id").(string)
+	locks.ByID(targetResourceId)
+	defer locks.UnlockByID(targetResourceId)
 
-	future, err := client.Delete(ctx, id.ResourceGroupName, id.NetworkWatcherName, id.Name())
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %v", id, err)
+	return nil
+}
+
+// networkWatcherFlowLogDefaultName returns the name used for a Flow Log when one isn't specified, which follows the format
+// generated by the "configureFlowLog" endpoint: Microsoft.Network{resource group name}{resource name(s)}
+func networkWatcherFlowLogDefaultName(targetResourceId string) (string, error) {
+	var name string
+	if nsgId, err := parse.NetworkSecurityGroupID(targetResourceId); err == nil {
+		name = fmt.Sprintf("Microsoft.Network%s%s", nsgId.ResourceGroup, nsgId.Name)
+	} else if subnetId, err := parse.SubnetID(targetResourceId); err == nil {
+		name = fmt.Sprintf("Microsoft.Network%s%s%s", subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name)
+	} else if vnetId, err := parse.VirtualNetworkID(targetResourceId); err == nil {
+		name = fmt.Sprintf("Microsoft.Network%s%s", vnetId.ResourceGroup, vnetId.Name)
+	} else if nicId, err := parse.NetworkInterfaceID(targetResourceId); err == nil {
+		name = fmt.Sprintf("Microsoft.Network%s%s", nicId.ResourceGroup, nicId.Name)
+	} else {
+		return "", fmt.Errorf("unable to determine the name of the Flow Log for the target resource %q, please specify `name`", targetResourceId)
 	}
 
-	return nil
+	// the maximum length of a flow log name is 80 characters and it can't end with a hyphen
+	if len(name) > 80 {
+		name = name[:80]
+	}
+
+	return strings.TrimRight(name, "-"), nil
+}
+
+func validateNetworkWatcherFlowLogTargetResourceID(i interface{}, k string) (warnings []string, errors []error) {
+	for _, validateFunc := range []pluginsdk.SchemaValidateFunc{validate.NetworkSecurityGroupID, validate.VirtualNetworkID, validate.SubnetID, validate.NetworkInterfaceID} {
+		if _, errs := validateFunc(i, k); len(errs) == 0 {
+			return warnings, errors
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("expected %q to be the ID of a Network Security Group, Virtual Network, Subnet or Network Interface, got %v", k, i))
+	return warnings, errors
 }
 
-func expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d *pluginsdk.ResourceData) *network.RetentionPolicyParameters {
+func expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d *pluginsdk.ResourceData) *flowlogs.RetentionPolicyParameters {
 	vs := d.Get("retention_policy").([]interface{})
 	if len(vs) < 1 || vs[0] == nil {
 		return nil
@@ -325,13 +392,13 @@ func expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d *pluginsdk.ResourceData
 	enabled := v["enabled"].(bool)
 	days := v["days"].(int)
 
-	return &network.RetentionPolicyParameters{
+	return &flowlogs.RetentionPolicyParameters{
 		Enabled: utils.Bool(enabled),
-		Days:    utils.Int32(int32(days)),
+		Days:    utils.Int64(int64(days)),
 	}
 }
 
-func flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+func flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(input *flowlogs.RetentionPolicyParameters) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -348,7 +415,7 @@ func flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(input *network.Retention
 	return []interface{}{result}
 }
 
-func flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+func flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(input *flowlogs.TrafficAnalyticsProperties) []interface{} {
 	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
 		return []interface{}{}
 	}
@@ -359,14 +426,14 @@ func flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficA
 		if cfg.Enabled != nil {
 			result["enabled"] = *cfg.Enabled
 		}
-		if cfg.WorkspaceID != nil {
-			result["workspace_id"] = *cfg.WorkspaceID
+		if cfg.WorkspaceId != nil {
+			result["workspace_id"] = *cfg.WorkspaceId
 		}
 		if cfg.WorkspaceRegion != nil {
 			result["workspace_region"] = *cfg.WorkspaceRegion
 		}
-		if cfg.WorkspaceResourceID != nil {
-			result["workspace_resource_id"] = *cfg.WorkspaceResourceID
+		if cfg.WorkspaceResourceId != nil {
+			result["workspace_resource_id"] = *cfg.WorkspaceResourceId
 		}
 		if cfg.TrafficAnalyticsInterval != nil {
 			result["interval_in_minutes"] = int(*cfg.TrafficAnalyticsInterval)
@@ -376,7 +443,7 @@ func flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficA
 	return []interface{}{result}
 }
 
-func expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d *pluginsdk.ResourceData) *network.TrafficAnalyticsProperties {
+func expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d *pluginsdk.ResourceData) *flowlogs.TrafficAnalyticsProperties {
 	vs := d.Get("traffic_analytics").([]interface{})
 
 	v := vs[0].(map[string]interface{})
@@ -386,13 +453,13 @@ func expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d *pluginsdk.ResourceDat
 	workspaceResourceID := v["workspace_resource_id"].(string)
 	interval := v["interval_in_minutes"].(int)
 
-	return &network.TrafficAnalyticsProperties{
-		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+	return &flowlogs.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &flowlogs.TrafficAnalyticsConfigurationProperties{
 			Enabled:                  utils.Bool(enabled),
-			WorkspaceID:              utils.String(workspaceID),
+			WorkspaceId:              utils.String(workspaceID),
 			WorkspaceRegion:          utils.String(workspaceRegion),
-			WorkspaceResourceID:      utils.String(workspaceResourceID),
-			TrafficAnalyticsInterval: utils.Int32(int32(interval)),
+			WorkspaceResourceId:      utils.String(workspaceResourceID),
+			TrafficAnalyticsInterval: utils.Int64(int64(interval)),
 		},
 	}
 }
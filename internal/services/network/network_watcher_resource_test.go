@@ -79,6 +79,8 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"version":               testAccNetworkWatcherFlowLog_version,
 			"location":              testAccNetworkWatcherFlowLog_location,
 			"tags":                  testAccNetworkWatcherFlowLog_tags,
+			"virtualNetwork":        testAccNetworkWatcherFlowLog_virtualNetwork,
+			"subnet":                testAccNetworkWatcherFlowLog_subnet,
 		},
 	}
 
@@ -0,0 +1,69 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+)
+
+// NetworkManagerDeploymentId is a Terraform-specific ID representing the commit of a set of
+// configurations of a given type (`scopeAccess`) to a Network Manager within a single location
+type NetworkManagerDeploymentId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	Location           string
+	ScopeAccess        string
+}
+
+func NewNetworkManagerDeploymentID(subscriptionId, resourceGroup, networkManagerName, location, scopeAccess string) NetworkManagerDeploymentId {
+	return NetworkManagerDeploymentId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		Location:           location,
+		ScopeAccess:        scopeAccess,
+	}
+}
+
+func (id NetworkManagerDeploymentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+		fmt.Sprintf("Location %q", id.Location),
+		fmt.Sprintf("Scope Access %q", id.ScopeAccess),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Deployment", segmentsStr)
+}
+
+func (id NetworkManagerDeploymentId) ID() string {
+	fmtString := "%s/commit|%s|%s"
+	return fmt.Sprintf(fmtString, id.NetworkManagerId().ID(), id.Location, id.ScopeAccess)
+}
+
+func (id NetworkManagerDeploymentId) NetworkManagerId() networkmanagers.NetworkManagerId {
+	return networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+}
+
+// NetworkManagerDeploymentID parses a NetworkManagerDeployment ID into an NetworkManagerDeploymentId struct
+func NetworkManagerDeploymentID(input string) (*NetworkManagerDeploymentId, error) {
+	parts := strings.Split(input, "/commit|")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected Network Manager Deployment ID to be in the format `{networkManagerId}/commit|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	networkManagerId, err := networkmanagers.ParseNetworkManagerID(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	commitParts := strings.Split(parts[1], "|")
+	if len(commitParts) != 2 || commitParts[0] == "" || commitParts[1] == "" {
+		return nil, fmt.Errorf("expected the commit segment of the Network Manager Deployment ID to be in the format `commit|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	resourceId := NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, commitParts[0], commitParts[1])
+	return &resourceId, nil
+}
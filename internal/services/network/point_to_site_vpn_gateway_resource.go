@@ -1,16 +1,19 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/configurationpolicygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/p2svpngateways"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -26,7 +29,7 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 		Update: resourcePointToSiteVPNGatewayCreateUpdate,
 		Delete: resourcePointToSiteVPNGatewayDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.PointToSiteVpnGatewayID(id)
+			_, err := p2svpngateways.ParseP2sVpnGatewayID(id)
 			return err
 		}),
 
@@ -66,9 +69,6 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 			"connection_configuration": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
-				// Code="P2SVpnGatewayCanHaveOnlyOneP2SConnectionConfiguration"
-				// Message="Currently, P2SVpnGateway [ID] can have only one P2SConnectionConfiguration. Specified number of P2SConnectionConfiguration are 2.
-				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -77,6 +77,15 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
+						"policy_group_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: configurationpolicygroups.ValidateConfigurationPolicyGroupID,
+							},
+						},
+
 						"vpn_client_address_pool": {
 							Type:     pluginsdk.TypeList,
 							Required: true,
@@ -164,6 +173,27 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// Code="P2SVpnGatewayCanHaveOnlyOneP2SConnectionConfiguration" - multiple connection configurations are
+			// only supported when each of them is associated with the Policy Groups of the VPN Server Configuration
+			configurations := d.Get("connection_configuration").([]interface{})
+			if len(configurations) <= 1 {
+				return nil
+			}
+
+			for _, item := range configurations {
+				if item == nil {
+					continue
+				}
+				v := item.(map[string]interface{})
+				if v["policy_group_ids"].(*pluginsdk.Set).Len() == 0 {
+					return fmt.Errorf("`policy_group_ids` must be specified for each `connection_configuration` when more than one `connection_configuration` is specified")
+				}
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -173,18 +203,18 @@ func resourcePointToSiteVPNGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewPointToSiteVpnGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := p2svpngateways.NewP2sVpnGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.P2sVpnGatewayName)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_point_to_site_vpn_gateway", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_point_to_site_vpn_gateway", id.ID())
 		}
 	}
 
@@ -197,34 +227,29 @@ func resourcePointToSiteVPNGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 	connectionConfigurationsRaw := d.Get("connection_configuration").([]interface{})
 	connectionConfigurations := expandPointToSiteVPNGatewayConnectionConfiguration(connectionConfigurationsRaw)
 
-	parameters := network.P2SVpnGateway{
+	parameters := p2svpngateways.P2SVpnGateway{
 		Location: utils.String(location),
-		P2SVpnGatewayProperties: &network.P2SVpnGatewayProperties{
+		Properties: &p2svpngateways.P2SVpnGatewayProperties{
 			P2SConnectionConfigurations: connectionConfigurations,
-			VpnServerConfiguration: &network.SubResource{
-				ID: utils.String(vpnServerConfigurationId),
+			VpnServerConfiguration: &p2svpngateways.SubResource{
+				Id: utils.String(vpnServerConfigurationId),
 			},
-			VirtualHub: &network.SubResource{
-				ID: utils.String(virtualHubId),
+			VirtualHub: &p2svpngateways.SubResource{
+				Id: utils.String(virtualHubId),
 			},
-			VpnGatewayScaleUnit: utils.Int32(int32(scaleUnit)),
+			VpnGatewayScaleUnit: utils.Int64(int64(scaleUnit)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tagsHelper.Expand(t),
 	}
 	customDNSServers := utils.ExpandStringSlice(d.Get("dns_servers").([]interface{}))
 	if len(*customDNSServers) != 0 {
-		parameters.P2SVpnGatewayProperties.CustomDNSServers = customDNSServers
+		parameters.Properties.CustomDnsServers = customDNSServers
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.P2sVpnGatewayName, parameters)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
-	}
-
 	d.SetId(id.ID())
 
 	return resourcePointToSiteVPNGatewayRead(d, meta)
@@ -235,14 +260,14 @@ func resourcePointToSiteVPNGatewayRead(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PointToSiteVpnGatewayID(d.Id())
+	id, err := p2svpngateways.ParseP2sVpnGatewayID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.P2sVpnGatewayName)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state!", id)
 			d.SetId("")
 			return nil
@@ -252,39 +277,46 @@ func resourcePointToSiteVPNGatewayRead(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.Set("name", id.P2sVpnGatewayName)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-
-	if props := resp.P2SVpnGatewayProperties; props != nil {
-		d.Set("dns_servers", utils.FlattenStringSlice(props.CustomDNSServers))
-		flattenedConfigurations := flattenPointToSiteVPNGatewayConnectionConfiguration(props.P2SConnectionConfigurations)
-		if err := d.Set("connection_configuration", flattenedConfigurations); err != nil {
-			return fmt.Errorf("setting `connection_configuration`: %+v", err)
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
 		}
 
-		scaleUnit := 0
-		if props.VpnGatewayScaleUnit != nil {
-			scaleUnit = int(*props.VpnGatewayScaleUnit)
-		}
-		d.Set("scale_unit", scaleUnit)
+		if props := model.Properties; props != nil {
+			d.Set("dns_servers", utils.FlattenStringSlice(props.CustomDnsServers))
+			flattenedConfigurations, err := flattenPointToSiteVPNGatewayConnectionConfiguration(props.P2SConnectionConfigurations)
+			if err != nil {
+				return fmt.Errorf("flattening `connection_configuration`: %+v", err)
+			}
+			if err := d.Set("connection_configuration", flattenedConfigurations); err != nil {
+				return fmt.Errorf("setting `connection_configuration`: %+v", err)
+			}
 
-		virtualHubId := ""
-		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
-			virtualHubId = *props.VirtualHub.ID
-		}
-		d.Set("virtual_hub_id", virtualHubId)
+			scaleUnit := 0
+			if props.VpnGatewayScaleUnit != nil {
+				scaleUnit = int(*props.VpnGatewayScaleUnit)
+			}
+			d.Set("scale_unit", scaleUnit)
+
+			virtualHubId := ""
+			if props.VirtualHub != nil && props.VirtualHub.Id != nil {
+				virtualHubId = *props.VirtualHub.Id
+			}
+			d.Set("virtual_hub_id", virtualHubId)
 
-		vpnServerConfigurationId := ""
-		if props.VpnServerConfiguration != nil && props.VpnServerConfiguration.ID != nil {
-			vpnServerConfigurationId = *props.VpnServerConfiguration.ID
+			vpnServerConfigurationId := ""
+			if props.VpnServerConfiguration != nil && props.VpnServerConfiguration.Id != nil {
+				vpnServerConfigurationId = *props.VpnServerConfiguration.Id
+			}
+			d.Set("vpn_server_configuration_id", vpnServerConfigurationId)
 		}
-		d.Set("vpn_server_configuration_id", vpnServerConfigurationId)
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourcePointToSiteVPNGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -292,25 +324,20 @@ func resourcePointToSiteVPNGatewayDelete(d *pluginsdk.ResourceData, meta interfa
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PointToSiteVpnGatewayID(d.Id())
+	id, err := p2svpngateways.ParseP2sVpnGatewayID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.P2sVpnGatewayName)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]network.P2SConnectionConfiguration {
-	configurations := make([]network.P2SConnectionConfiguration, 0)
+func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]p2svpngateways.P2SConnectionConfiguration {
+	configurations := make([]p2svpngateways.P2SConnectionConfiguration, 0)
 
 	for _, v := range input {
 		raw := v.(map[string]interface{})
@@ -328,55 +355,67 @@ func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]
 			}
 		}
 
-		configurations = append(configurations, network.P2SConnectionConfiguration{
+		configuration := p2svpngateways.P2SConnectionConfiguration{
 			Name: utils.String(name),
-			P2SConnectionConfigurationProperties: &network.P2SConnectionConfigurationProperties{
-				VpnClientAddressPool: &network.AddressSpace{
+			Properties: &p2svpngateways.P2SConnectionConfigurationProperties{
+				VpnClientAddressPool: &p2svpngateways.AddressSpace{
 					AddressPrefixes: &addressPrefixes,
 				},
 				RoutingConfiguration:   expandPointToSiteVPNGatewayConnectionRouteConfiguration(raw["route"].([]interface{})),
 				EnableInternetSecurity: utils.Bool(raw["internet_security_enabled"].(bool)),
 			},
-		})
+		}
+
+		if policyGroupIds := raw["policy_group_ids"].(*pluginsdk.Set).List(); len(policyGroupIds) != 0 {
+			associations := make([]p2svpngateways.SubResource, 0)
+			for _, policyGroupId := range policyGroupIds {
+				associations = append(associations, p2svpngateways.SubResource{
+					Id: utils.String(policyGroupId.(string)),
+				})
+			}
+			configuration.Properties.ConfigurationPolicyGroupAssociations = &associations
+		}
+
+		configurations = append(configurations, configuration)
 	}
 
 	return &configurations
 }
 
-func expandPointToSiteVPNGatewayConnectionRouteConfiguration(input []interface{}) *network.RoutingConfiguration {
+func expandPointToSiteVPNGatewayConnectionRouteConfiguration(input []interface{}) *p2svpngateways.RoutingConfiguration {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	return &network.RoutingConfiguration{
-		AssociatedRouteTable: &network.SubResource{
-			ID: utils.String(v["associated_route_table_id"].(string)),
+	return &p2svpngateways.RoutingConfiguration{
+		AssociatedRouteTable: &p2svpngateways.SubResource{
+			Id: utils.String(v["associated_route_table_id"].(string)),
 		},
 		PropagatedRouteTables: expandPointToSiteVPNGatewayConnectionRouteConfigurationPropagatedRouteTable(v["propagated_route_table"].([]interface{})),
 	}
 }
 
-func expandPointToSiteVPNGatewayConnectionRouteConfigurationPropagatedRouteTable(input []interface{}) *network.PropagatedRouteTable {
+func expandPointToSiteVPNGatewayConnectionRouteConfigurationPropagatedRouteTable(input []interface{}) *p2svpngateways.PropagatedRouteTable {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
 	idRaws := utils.ExpandStringSlice(v["ids"].([]interface{}))
-	ids := make([]network.SubResource, len(*idRaws))
+	ids := make([]p2svpngateways.SubResource, len(*idRaws))
 	for i, item := range *idRaws {
-		ids[i] = network.SubResource{
-			ID: utils.String(item),
+		ids[i] = p2svpngateways.SubResource{
+			Id: utils.String(item),
 		}
 	}
-	return &network.PropagatedRouteTable{
+	return &p2svpngateways.PropagatedRouteTable{
 		Labels: utils.ExpandStringSlice(v["labels"].(*pluginsdk.Set).List()),
 		Ids:    &ids,
 	}
 }
 
-func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]network.P2SConnectionConfiguration) []interface{} {
+func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]p2svpngateways.P2SConnectionConfiguration) ([]interface{}, error) {
 	if input == nil {
-		return []interface{}{}
+		return []interface{}{}, nil
 	}
 
 	output := make([]interface{}, 0)
@@ -389,7 +428,9 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]network.P2SCon
 
 		addressPrefixes := make([]interface{}, 0)
 		enableInternetSecurity := false
-		if props := v.P2SConnectionConfigurationProperties; props != nil {
+		policyGroupIds := make([]interface{}, 0)
+		var routingConfiguration *p2svpngateways.RoutingConfiguration
+		if props := v.Properties; props != nil {
 			if props.VpnClientAddressPool == nil {
 				continue
 			}
@@ -403,6 +444,22 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]network.P2SCon
 			if props.EnableInternetSecurity != nil {
 				enableInternetSecurity = *props.EnableInternetSecurity
 			}
+
+			if props.ConfigurationPolicyGroupAssociations != nil {
+				for _, association := range *props.ConfigurationPolicyGroupAssociations {
+					if association.Id == nil {
+						continue
+					}
+
+					policyGroupId, err := configurationpolicygroups.ParseConfigurationPolicyGroupIDInsensitively(*association.Id)
+					if err != nil {
+						return nil, err
+					}
+					policyGroupIds = append(policyGroupIds, policyGroupId.ID())
+				}
+			}
+
+			routingConfiguration = props.RoutingConfiguration
 		}
 
 		output = append(output, map[string]interface{}{
@@ -412,21 +469,22 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]network.P2SCon
 					"address_prefixes": addressPrefixes,
 				},
 			},
-			"route":                     flattenPointToSiteVPNGatewayConnectionRouteConfiguration(v.RoutingConfiguration),
+			"policy_group_ids":          policyGroupIds,
+			"route":                     flattenPointToSiteVPNGatewayConnectionRouteConfiguration(routingConfiguration),
 			"internet_security_enabled": enableInternetSecurity,
 		})
 	}
 
-	return output
+	return output, nil
 }
 
-func flattenPointToSiteVPNGatewayConnectionRouteConfiguration(input *network.RoutingConfiguration) []interface{} {
+func flattenPointToSiteVPNGatewayConnectionRouteConfiguration(input *p2svpngateways.RoutingConfiguration) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 	var associatedRouteTableId string
-	if input.AssociatedRouteTable != nil && input.AssociatedRouteTable.ID != nil {
-		associatedRouteTableId = *input.AssociatedRouteTable.ID
+	if input.AssociatedRouteTable != nil && input.AssociatedRouteTable.Id != nil {
+		associatedRouteTableId = *input.AssociatedRouteTable.Id
 	}
 	return []interface{}{
 		map[string]interface{}{
@@ -436,15 +494,15 @@ func flattenPointToSiteVPNGatewayConnectionRouteConfiguration(input *network.Rou
 	}
 }
 
-func flattenPointToSiteVPNGatewayConnectionRouteConfigurationPropagatedRouteTable(input *network.PropagatedRouteTable) []interface{} {
+func flattenPointToSiteVPNGatewayConnectionRouteConfigurationPropagatedRouteTable(input *p2svpngateways.PropagatedRouteTable) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 	ids := make([]string, 0)
 	if input.Ids != nil {
 		for _, item := range *input.Ids {
-			if item.ID != nil {
-				ids = append(ids, *item.ID)
+			if item.Id != nil {
+				ids = append(ids, *item.Id)
 			}
 		}
 	}
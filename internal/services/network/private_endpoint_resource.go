@@ -162,6 +162,50 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			"ip_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"private_ip_address": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"subresource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.PrivateLinkSubResourceName,
+						},
+						"member_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.ApplicationSecurityGroupID,
+				},
+			},
+
 			"custom_dns_configs": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -276,6 +320,8 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 			Subnet: &network.Subnet{
 				ID: utils.String(subnetId),
 			},
+			IPConfigurations:          expandPrivateEndpointIPConfigurations(d.Get("ip_configuration").([]interface{})),
+			ApplicationSecurityGroups: expandPrivateEndpointApplicationSecurityGroups(d.Get("application_security_group_ids").(*pluginsdk.Set).List()),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -336,6 +382,8 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 			Subnet: &network.Subnet{
 				ID: utils.String(subnetId),
 			},
+			IPConfigurations:          expandPrivateEndpointIPConfigurations(d.Get("ip_configuration").([]interface{})),
+			ApplicationSecurityGroups: expandPrivateEndpointApplicationSecurityGroups(d.Get("application_security_group_ids").(*pluginsdk.Set).List()),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -461,6 +509,14 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 			subnetId = *props.Subnet.ID
 		}
 		d.Set("subnet_id", subnetId)
+
+		if err := d.Set("ip_configuration", flattenPrivateEndpointIPConfigurations(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("setting `ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("application_security_group_ids", flattenApplicationSecurityGroupIds(props.ApplicationSecurityGroups)); err != nil {
+			return fmt.Errorf("setting `application_security_group_ids`: %+v", err)
+		}
 	}
 
 	privateDnsZoneConfigs := make([]interface{}, 0)
@@ -558,6 +614,82 @@ func expandPrivateLinkEndpointServiceConnection(input []interface{}, parseManual
 	return &results
 }
 
+func expandPrivateEndpointIPConfigurations(input []interface{}) *[]network.PrivateEndpointIPConfiguration {
+	results := make([]network.PrivateEndpointIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		result := network.PrivateEndpointIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			PrivateEndpointIPConfigurationProperties: &network.PrivateEndpointIPConfigurationProperties{
+				PrivateIPAddress: utils.String(v["private_ip_address"].(string)),
+				GroupID:          utils.String(v["subresource_name"].(string)),
+			},
+		}
+
+		if memberName := v["member_name"].(string); memberName != "" {
+			result.PrivateEndpointIPConfigurationProperties.MemberName = utils.String(memberName)
+		} else {
+			// the member name defaults to the subresource name when unspecified
+			result.PrivateEndpointIPConfigurationProperties.MemberName = utils.String(v["subresource_name"].(string))
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenPrivateEndpointIPConfigurations(input *[]network.PrivateEndpointIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateIpAddress := ""
+		subresourceName := ""
+		memberName := ""
+		if props := item.PrivateEndpointIPConfigurationProperties; props != nil {
+			if props.PrivateIPAddress != nil {
+				privateIpAddress = *props.PrivateIPAddress
+			}
+			if props.GroupID != nil {
+				subresourceName = *props.GroupID
+			}
+			if props.MemberName != nil {
+				memberName = *props.MemberName
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"private_ip_address": privateIpAddress,
+			"subresource_name":   subresourceName,
+			"member_name":        memberName,
+		})
+	}
+
+	return results
+}
+
+func expandPrivateEndpointApplicationSecurityGroups(input []interface{}) *[]network.ApplicationSecurityGroup {
+	results := make([]network.ApplicationSecurityGroup, 0)
+
+	for _, item := range input {
+		results = append(results, network.ApplicationSecurityGroup{
+			ID: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
 func flattenCustomDnsConfigs(customDnsConfigs *[]network.CustomDNSConfigPropertiesFormat) []interface{} {
 	results := make([]interface{}, 0)
 	if customDnsConfigs == nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -147,3 +148,14 @@ func dataSourcePublicIPRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+func flattenPublicIpPropsIpTags(ipTags []network.IPTag) map[string]interface{} {
+	mapIpTags := make(map[string]interface{})
+
+	for _, tag := range ipTags {
+		if tag.IPTagType != nil {
+			mapIpTags[*tag.IPTagType] = tag.Tag
+		}
+	}
+	return mapIpTags
+}
@@ -1,17 +1,19 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/publicipaddresses"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -29,7 +31,7 @@ func resourcePublicIp() *pluginsdk.Resource {
 		Delete: resourcePublicIpDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.PublicIpAddressID(id)
+			_, err := publicipaddresses.ParsePublicIPAddressID(id)
 			return err
 		}),
 
@@ -56,8 +58,8 @@ func resourcePublicIp() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.IPAllocationMethodStatic),
-					string(network.IPAllocationMethodDynamic),
+					string(publicipaddresses.IPAllocationMethodStatic),
+					string(publicipaddresses.IPAllocationMethodDynamic),
 				}, false),
 			},
 
@@ -82,12 +84,12 @@ func resourcePublicIp() *pluginsdk.Resource {
 			"ip_version": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
-				Default:          string(network.IPVersionIPv4),
+				Default:          string(publicipaddresses.IPVersionIPvFour),
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.IPVersionIPv4),
-					string(network.IPVersionIPv6),
+					string(publicipaddresses.IPVersionIPvFour),
+					string(publicipaddresses.IPVersionIPvSix),
 				}, true),
 			},
 
@@ -95,22 +97,22 @@ func resourcePublicIp() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				ForceNew:         true,
-				Default:          string(network.PublicIPAddressSkuNameBasic),
+				Default:          string(publicipaddresses.PublicIPAddressSkuNameBasic),
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.PublicIPAddressSkuNameBasic),
-					string(network.PublicIPAddressSkuNameStandard),
+					string(publicipaddresses.PublicIPAddressSkuNameBasic),
+					string(publicipaddresses.PublicIPAddressSkuNameStandard),
 				}, true),
 			},
 
 			"sku_tier": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  string(network.PublicIPAddressSkuTierRegional),
+				Default:  string(publicipaddresses.PublicIPAddressSkuTierRegional),
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.PublicIPAddressSkuTierGlobal),
-					string(network.PublicIPAddressSkuTierRegional),
+					string(publicipaddresses.PublicIPAddressSkuTierGlobal),
+					string(publicipaddresses.PublicIPAddressSkuTierRegional),
 				}, false),
 			},
 
@@ -127,6 +129,35 @@ func resourcePublicIp() *pluginsdk.Resource {
 				ValidateFunc: validate.PublicIpDomainNameLabel,
 			},
 
+			"domain_name_label_scope": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(publicipaddresses.PublicIPAddressDnsSettingsDomainNameLabelScopeNoReuse),
+					string(publicipaddresses.PublicIPAddressDnsSettingsDomainNameLabelScopeResourceGroupReuse),
+					string(publicipaddresses.PublicIPAddressDnsSettingsDomainNameLabelScopeSubscriptionReuse),
+					string(publicipaddresses.PublicIPAddressDnsSettingsDomainNameLabelScopeTenantReuse),
+				}, false),
+			},
+
+			"ddos_protection_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(publicipaddresses.DdosSettingsProtectionModeDisabled),
+					string(publicipaddresses.DdosSettingsProtectionModeEnabled),
+					string(publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited),
+				}, false),
+			},
+
+			"ddos_protection_plan_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.DdosProtectionPlanID,
+			},
+
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -177,41 +208,82 @@ func resourcePublicIp() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			sku := d.Get("sku").(string)
+			skuTier := d.Get("sku_tier").(string)
+
+			zonesSet := len(d.Get("zones").([]interface{})) > 0
+			if availabilityZone := d.Get("availability_zone").(string); availabilityZone != "" && availabilityZone != "No-Zone" {
+				zonesSet = true
+			}
+
+			if strings.EqualFold(sku, string(publicipaddresses.PublicIPAddressSkuNameBasic)) {
+				if zonesSet {
+					return fmt.Errorf("Availability Zones are not available on the `Basic` SKU")
+				}
+
+				if strings.EqualFold(skuTier, string(publicipaddresses.PublicIPAddressSkuTierGlobal)) {
+					return fmt.Errorf("`Global` SKU tier is not available on the `Basic` SKU")
+				}
+			}
+
+			if strings.EqualFold(skuTier, string(publicipaddresses.PublicIPAddressSkuTierGlobal)) && zonesSet {
+				return fmt.Errorf("Availability Zones are not available on the `Global` SKU tier")
+			}
+
+			if strings.EqualFold(sku, string(publicipaddresses.PublicIPAddressSkuNameStandard)) && !strings.EqualFold(d.Get("allocation_method").(string), string(publicipaddresses.IPAllocationMethodStatic)) {
+				return fmt.Errorf("Static IP allocation must be used when creating Standard SKU public IP addresses.")
+			}
+
+			ddosProtectionMode := d.Get("ddos_protection_mode").(string)
+			if ddosProtectionMode == string(publicipaddresses.DdosSettingsProtectionModeEnabled) && !strings.EqualFold(sku, string(publicipaddresses.PublicIPAddressSkuNameStandard)) {
+				return fmt.Errorf("`ddos_protection_mode` can only be set to `Enabled` when `sku` is `Standard`")
+			}
+
+			if d.Get("ddos_protection_plan_id").(string) != "" && ddosProtectionMode != string(publicipaddresses.DdosSettingsProtectionModeEnabled) {
+				return fmt.Errorf("`ddos_protection_plan_id` can only be set when `ddos_protection_mode` is `Enabled`")
+			}
+
+			if d.Get("domain_name_label_scope").(string) != "" && d.Get("domain_name_label").(string) == "" {
+				return fmt.Errorf("`domain_name_label` must be set when `domain_name_label_scope` is specified")
+			}
+
+			return nil
+		}),
 	}
 }
 
 func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network.PublicIPAddressesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP creation.")
 
-	id := parse.NewPublicIpAddressID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := publicipaddresses.NewPublicIPAddressID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_public_ip", id.ID())
 		}
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	sku := d.Get("sku").(string)
-	sku_tier := d.Get("sku_tier").(string)
+	sku := publicipaddresses.PublicIPAddressSkuName(d.Get("sku").(string))
+	skuTier := publicipaddresses.PublicIPAddressSkuTier(d.Get("sku_tier").(string))
 	t := d.Get("tags").(map[string]interface{})
 	// Default to Zone-Redundant - Legacy behaviour TODO - Switch to `No-Zone` in 3.0 to match service?
 	zones := &[]string{"1", "2"}
-	zonesSet := false
 	// TODO - Remove in 3.0
 	if deprecatedZonesRaw, ok := d.GetOk("zones"); ok {
-		zonesSet = true
 		deprecatedZones := azure.ExpandZones(deprecatedZonesRaw.([]interface{}))
 		if deprecatedZones != nil {
 			zones = deprecatedZones
@@ -219,7 +291,6 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	if availabilityZones, ok := d.GetOk("availability_zone"); ok {
-		zonesSet = true
 		switch availabilityZones.(string) {
 		case "1", "2", "3":
 			zones = &[]string{availabilityZones.(string)}
@@ -230,63 +301,51 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if strings.EqualFold(sku, "Basic") {
-		if zonesSet && len(*zones) > 0 {
-			return fmt.Errorf("Availability Zones are not available on the `Basic` SKU")
-		}
+	// the combinations of SKU, tier and zones are validated at plan time, the Basic SKU and Global tier are always zonal
+	if strings.EqualFold(string(sku), string(publicipaddresses.PublicIPAddressSkuNameBasic)) || strings.EqualFold(string(skuTier), string(publicipaddresses.PublicIPAddressSkuTierGlobal)) {
 		zones = &[]string{}
-
-		if strings.EqualFold(sku_tier, "Global") {
-			return fmt.Errorf("Global` SKU tier is not available on the `Basic` SKU")
-		}
 	}
 
-	if strings.EqualFold(sku_tier, "Global") {
-		if zonesSet && len(*zones) > 0 {
-			return fmt.Errorf("Availability Zones are not available on the `Global` SKU tier")
-		}
-		zones = &[]string{}
-	}
-
-	idleTimeout := d.Get("idle_timeout_in_minutes").(int)
-	ipVersion := network.IPVersion(d.Get("ip_version").(string))
-	ipAllocationMethod := d.Get("allocation_method").(string)
-
-	if strings.EqualFold(sku, "standard") {
-		if !strings.EqualFold(ipAllocationMethod, "static") {
-			return fmt.Errorf("Static IP allocation must be used when creating Standard SKU public IP addresses.")
-		}
-	}
+	ipVersion := publicipaddresses.IPVersion(d.Get("ip_version").(string))
+	ipAllocationMethod := publicipaddresses.IPAllocationMethod(d.Get("allocation_method").(string))
+	ddosProtectionMode := publicipaddresses.DdosSettingsProtectionMode(d.Get("ddos_protection_mode").(string))
 
-	publicIp := network.PublicIPAddress{
-		Name:     utils.String(id.Name),
+	publicIp := publicipaddresses.PublicIPAddress{
+		Name:     utils.String(id.PublicIPAddressName),
 		Location: &location,
-		Sku: &network.PublicIPAddressSku{
-			Name: network.PublicIPAddressSkuName(sku),
-			Tier: network.PublicIPAddressSkuTier(sku_tier),
+		Sku: &publicipaddresses.PublicIPAddressSku{
+			Name: &sku,
+			Tier: &skuTier,
 		},
-		PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
-			PublicIPAllocationMethod: network.IPAllocationMethod(ipAllocationMethod),
-			PublicIPAddressVersion:   ipVersion,
-			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
+		Properties: &publicipaddresses.PublicIPAddressPropertiesFormat{
+			DdosSettings: &publicipaddresses.DdosSettings{
+				ProtectionMode: &ddosProtectionMode,
+			},
+			PublicIPAllocationMethod: &ipAllocationMethod,
+			PublicIPAddressVersion:   &ipVersion,
+			IdleTimeoutInMinutes:     utils.Int64(int64(d.Get("idle_timeout_in_minutes").(int))),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  tagsHelper.Expand(t),
 		Zones: zones,
 	}
 
-	publicIpPrefixId, publicIpPrefixIdOk := d.GetOk("public_ip_prefix_id")
+	if v, ok := d.GetOk("ddos_protection_plan_id"); ok {
+		publicIp.Properties.DdosSettings.DdosProtectionPlan = &publicipaddresses.SubResource{
+			Id: utils.String(v.(string)),
+		}
+	}
 
-	if publicIpPrefixIdOk {
-		publicIpPrefix := network.SubResource{}
-		publicIpPrefix.ID = utils.String(publicIpPrefixId.(string))
-		publicIp.PublicIPAddressPropertiesFormat.PublicIPPrefix = &publicIpPrefix
+	if v, ok := d.GetOk("public_ip_prefix_id"); ok {
+		publicIp.Properties.PublicIPPrefix = &publicipaddresses.SubResource{
+			Id: utils.String(v.(string)),
+		}
 	}
 
 	dnl, dnlOk := d.GetOk("domain_name_label")
 	rfqdn, rfqdnOk := d.GetOk("reverse_fqdn")
 
 	if dnlOk || rfqdnOk {
-		dnsSettings := network.PublicIPAddressDNSSettings{}
+		dnsSettings := publicipaddresses.PublicIPAddressDnsSettings{}
 
 		if rfqdnOk {
 			dnsSettings.ReverseFqdn = utils.String(rfqdn.(string))
@@ -296,50 +355,50 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 			dnsSettings.DomainNameLabel = utils.String(dnl.(string))
 		}
 
-		publicIp.PublicIPAddressPropertiesFormat.DNSSettings = &dnsSettings
+		if v, ok := d.GetOk("domain_name_label_scope"); ok {
+			scope := publicipaddresses.PublicIPAddressDnsSettingsDomainNameLabelScope(v.(string))
+			dnsSettings.DomainNameLabelScope = &scope
+		}
+
+		publicIp.Properties.DnsSettings = &dnsSettings
 	}
 
 	if v, ok := d.GetOk("ip_tags"); ok {
 		ipTags := v.(map[string]interface{})
-		newIpTags := []network.IPTag{}
+		newIpTags := []publicipaddresses.IPTag{}
 
 		for key, val := range ipTags {
-			ipTag := network.IPTag{
+			ipTag := publicipaddresses.IPTag{
 				IPTagType: utils.String(key),
 				Tag:       utils.String(val.(string)),
 			}
 			newIpTags = append(newIpTags, ipTag)
 		}
 
-		publicIp.PublicIPAddressPropertiesFormat.IPTags = &newIpTags
+		publicIp.Properties.IPTags = &newIpTags
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, publicIp)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, publicIp); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
-	}
-
 	d.SetId(id.ID())
 	return resourcePublicIpRead(d, meta)
 }
 
 func resourcePublicIpRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network.PublicIPAddressesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PublicIpAddressID(d.Id())
+	id, err := publicipaddresses.ParsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -347,83 +406,118 @@ func resourcePublicIpRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("name", id.PublicIPAddressName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	availabilityZones := "No-Zone"
-	zonesDeprecated := make([]string, 0)
-	if resp.Zones != nil {
-		if len(*resp.Zones) > 1 {
-			availabilityZones = "Zone-Redundant"
-		}
-		if len(*resp.Zones) == 1 {
-			zones := *resp.Zones
-			availabilityZones = zones[0]
-			zonesDeprecated = zones
+	if model := resp.Model; model != nil {
+		availabilityZones := "No-Zone"
+		zonesDeprecated := make([]string, 0)
+		if model.Zones != nil {
+			if len(*model.Zones) > 1 {
+				availabilityZones = "Zone-Redundant"
+			}
+			if len(*model.Zones) == 1 {
+				zones := *model.Zones
+				availabilityZones = zones[0]
+				zonesDeprecated = zones
+			}
 		}
-	}
-
-	d.Set("availability_zone", availabilityZones)
-	d.Set("zones", zonesDeprecated)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", string(sku.Name))
-		d.Set("sku_tier", string(sku.Tier))
-	}
+		d.Set("availability_zone", availabilityZones)
+		d.Set("zones", zonesDeprecated)
+		d.Set("location", location.NormalizeNilable(model.Location))
 
-	if props := resp.PublicIPAddressPropertiesFormat; props != nil {
-		d.Set("allocation_method", string(props.PublicIPAllocationMethod))
-		d.Set("ip_version", string(props.PublicIPAddressVersion))
+		if sku := model.Sku; sku != nil {
+			skuName := ""
+			if sku.Name != nil {
+				skuName = string(*sku.Name)
+			}
+			d.Set("sku", skuName)
 
-		if publicIpPrefix := props.PublicIPPrefix; publicIpPrefix != nil {
-			d.Set("public_ip_prefix_id", publicIpPrefix.ID)
+			skuTier := ""
+			if sku.Tier != nil {
+				skuTier = string(*sku.Tier)
+			}
+			d.Set("sku_tier", skuTier)
 		}
 
-		if settings := props.DNSSettings; settings != nil {
-			d.Set("fqdn", settings.Fqdn)
-			d.Set("reverse_fqdn", settings.ReverseFqdn)
-			d.Set("domain_name_label", settings.DomainNameLabel)
-		}
+		if props := model.Properties; props != nil {
+			allocationMethod := ""
+			if props.PublicIPAllocationMethod != nil {
+				allocationMethod = string(*props.PublicIPAllocationMethod)
+			}
+			d.Set("allocation_method", allocationMethod)
 
-		iptags := flattenPublicIpPropsIpTags(*props.IPTags)
-		if iptags != nil {
-			d.Set("ip_tags", iptags)
+			ipVersion := ""
+			if props.PublicIPAddressVersion != nil {
+				ipVersion = string(*props.PublicIPAddressVersion)
+			}
+			d.Set("ip_version", ipVersion)
+
+			if publicIpPrefix := props.PublicIPPrefix; publicIpPrefix != nil {
+				d.Set("public_ip_prefix_id", publicIpPrefix.Id)
+			}
+
+			ddosProtectionMode := string(publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited)
+			ddosProtectionPlanId := ""
+			if ddosSettings := props.DdosSettings; ddosSettings != nil {
+				if ddosSettings.ProtectionMode != nil {
+					ddosProtectionMode = string(*ddosSettings.ProtectionMode)
+				}
+				if ddosSettings.DdosProtectionPlan != nil && ddosSettings.DdosProtectionPlan.Id != nil {
+					ddosProtectionPlanId = *ddosSettings.DdosProtectionPlan.Id
+				}
+			}
+			d.Set("ddos_protection_mode", ddosProtectionMode)
+			d.Set("ddos_protection_plan_id", ddosProtectionPlanId)
+
+			if settings := props.DnsSettings; settings != nil {
+				d.Set("fqdn", settings.Fqdn)
+				d.Set("reverse_fqdn", settings.ReverseFqdn)
+				d.Set("domain_name_label", settings.DomainNameLabel)
+
+				domainNameLabelScope := ""
+				if settings.DomainNameLabelScope != nil {
+					domainNameLabelScope = string(*settings.DomainNameLabelScope)
+				}
+				d.Set("domain_name_label_scope", domainNameLabelScope)
+			}
+
+			d.Set("ip_tags", flattenPublicIpAddressIpTags(props.IPTags))
+			d.Set("ip_address", props.IPAddress)
+			d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
 		}
 
-		d.Set("ip_address", props.IPAddress)
-		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourcePublicIpDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network.PublicIPAddressesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PublicIpAddressID(d.Id())
+	id, err := publicipaddresses.ParsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func flattenPublicIpPropsIpTags(ipTags []network.IPTag) map[string]interface{} {
+func flattenPublicIpAddressIpTags(input *[]publicipaddresses.IPTag) map[string]interface{} {
 	mapIpTags := make(map[string]interface{})
+	if input == nil {
+		return mapIpTags
+	}
 
-	for _, tag := range ipTags {
+	for _, tag := range *input {
 		if tag.IPTagType != nil {
 			mapIpTags[*tag.IPTagType] = tag.Tag
 		}
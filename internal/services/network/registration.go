@@ -1,11 +1,36 @@
 package network
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var (
+	_ sdk.TypedServiceRegistration   = Registration{}
+	_ sdk.UntypedServiceRegistration = Registration{}
+)
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ManagerAdminRuleCollectionResource{},
+		ManagerAdminRuleResource{},
+		ManagerConnectivityConfigurationResource{},
+		ManagerDeploymentResource{},
+		ManagerIpamPoolResource{},
+		ManagerIpamPoolStaticCidrResource{},
+		ManagerNetworkGroupResource{},
+		ManagerResource{},
+		ManagerSecurityAdminConfigurationResource{},
+		ManagerStaticMemberResource{},
+	}
+}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Network"
@@ -54,24 +79,30 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                      resourceApplicationGateway(),
-		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
-		"azurerm_bastion_host":                             resourceBastionHost(),
-		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
-		"azurerm_express_route_circuit_authorization":      resourceExpressRouteCircuitAuthorization(),
-		"azurerm_express_route_circuit_peering":            resourceExpressRouteCircuitPeering(),
-		"azurerm_express_route_circuit":                    resourceExpressRouteCircuit(),
-		"azurerm_express_route_connection":                 resourceExpressRouteConnection(),
-		"azurerm_express_route_gateway":                    resourceExpressRouteGateway(),
-		"azurerm_express_route_port":                       resourceArmExpressRoutePort(),
-		"azurerm_ip_group":                                 resourceIpGroup(),
-		"azurerm_local_network_gateway":                    resourceLocalNetworkGateway(),
-		"azurerm_nat_gateway":                              resourceNatGateway(),
-		"azurerm_nat_gateway_public_ip_association":        resourceNATGatewayPublicIpAssociation(),
-		"azurerm_nat_gateway_public_ip_prefix_association": resourceNATGatewayPublicIpPrefixAssociation(),
-		"azurerm_network_connection_monitor":               resourceNetworkConnectionMonitor(),
-		"azurerm_network_ddos_protection_plan":             resourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                        resourceNetworkInterface(),
+		"azurerm_application_gateway":                       resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool":  resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_backend_http_settings": resourceApplicationGatewayBackendHTTPSettings(),
+		"azurerm_application_gateway_http_listener":         resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_probe":                 resourceApplicationGatewayProbe(),
+		"azurerm_application_gateway_request_routing_rule":  resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_rewrite_rule_set":      resourceApplicationGatewayRewriteRuleSet(),
+		"azurerm_application_security_group":                resourceApplicationSecurityGroup(),
+		"azurerm_bastion_host":                              resourceBastionHost(),
+		"azurerm_express_route_circuit_connection":          resourceExpressRouteCircuitConnection(),
+		"azurerm_express_route_circuit_authorization":       resourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_peering":             resourceExpressRouteCircuitPeering(),
+		"azurerm_express_route_circuit":                     resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":                  resourceExpressRouteConnection(),
+		"azurerm_express_route_gateway":                     resourceExpressRouteGateway(),
+		"azurerm_express_route_port":                        resourceArmExpressRoutePort(),
+		"azurerm_ip_group":                                  resourceIpGroup(),
+		"azurerm_local_network_gateway":                     resourceLocalNetworkGateway(),
+		"azurerm_nat_gateway":                               resourceNatGateway(),
+		"azurerm_nat_gateway_public_ip_association":         resourceNATGatewayPublicIpAssociation(),
+		"azurerm_nat_gateway_public_ip_prefix_association":  resourceNATGatewayPublicIpPrefixAssociation(),
+		"azurerm_network_connection_monitor":                resourceNetworkConnectionMonitor(),
+		"azurerm_network_ddos_protection_plan":              resourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                         resourceNetworkInterface(),
 
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
@@ -92,6 +123,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_watcher_flow_log":                  resourceNetworkWatcherFlowLog(),
 		"azurerm_network_watcher":                           resourceNetworkWatcher(),
 		"azurerm_route_filter":                              resourceRouteFilter(),
+		"azurerm_route_server":                              resourceRouteServer(),
+		"azurerm_route_server_bgp_connection":               resourceRouteServerBgpConnection(),
 		"azurerm_route_table":                               resourceRouteTable(),
 		"azurerm_route":                                     resourceRoute(),
 		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
@@ -106,6 +139,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub_ip":                            resourceVirtualHubIP(),
 		"azurerm_virtual_hub_route_table":                   resourceVirtualHubRouteTable(),
 		"azurerm_virtual_hub_route_table_route":             resourceVirtualHubRouteTableRoute(),
+		"azurerm_virtual_hub_routing_intent":                resourceVirtualHubRoutingIntent(),
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
@@ -116,6 +150,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_vpn_gateway_connection":                    resourceVPNGatewayConnection(),
 		"azurerm_vpn_gateway_nat_rule":                      resourceVPNGatewayNatRule(),
 		"azurerm_vpn_server_configuration":                  resourceVPNServerConfiguration(),
+		"azurerm_vpn_server_configuration_policy_group":     resourceVPNServerConfigurationPolicyGroup(),
 		"azurerm_vpn_site":                                  resourceVpnSite(),
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
 	}
@@ -0,0 +1,10 @@
+package adminrules
+
+type AdminRule struct {
+	Etag       *string                `json:"etag,omitempty"`
+	Id         *string                `json:"id,omitempty"`
+	Kind       AdminRuleKind          `json:"kind"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *AdminPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
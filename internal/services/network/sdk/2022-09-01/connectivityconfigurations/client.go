@@ -0,0 +1,15 @@
+package connectivityconfigurations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ConnectivityConfigurationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConnectivityConfigurationsClientWithBaseURI(endpoint string) ConnectivityConfigurationsClient {
+	return ConnectivityConfigurationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
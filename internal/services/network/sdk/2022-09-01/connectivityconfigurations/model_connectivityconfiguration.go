@@ -0,0 +1,9 @@
+package connectivityconfigurations
+
+type ConnectivityConfiguration struct {
+	Etag       *string                              `json:"etag,omitempty"`
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *ConnectivityConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
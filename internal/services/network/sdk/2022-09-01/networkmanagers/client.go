@@ -0,0 +1,15 @@
+package networkmanagers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkManagersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkManagersClientWithBaseURI(endpoint string) NetworkManagersClient {
+	return NetworkManagersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,75 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type NetworkManagerCommitsPostResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// NetworkManagerCommitsPost ...
+func (c NetworkManagersClient) NetworkManagerCommitsPost(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) (result NetworkManagerCommitsPostResponse, err error) {
+	req, err := c.preparerForNetworkManagerCommitsPost(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkManagerCommitsPost", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForNetworkManagerCommitsPost(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkManagerCommitsPost", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// NetworkManagerCommitsPostThenPoll performs NetworkManagerCommitsPost then polls until it's completed
+func (c NetworkManagersClient) NetworkManagerCommitsPostThenPoll(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) error {
+	result, err := c.NetworkManagerCommitsPost(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing NetworkManagerCommitsPost: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after NetworkManagerCommitsPost: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForNetworkManagerCommitsPost prepares the NetworkManagerCommitsPost request.
+func (c NetworkManagersClient) preparerForNetworkManagerCommitsPost(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/commit", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForNetworkManagerCommitsPost sends the NetworkManagerCommitsPost request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForNetworkManagerCommitsPost(ctx context.Context, req *http.Request) (future NetworkManagerCommitsPostResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
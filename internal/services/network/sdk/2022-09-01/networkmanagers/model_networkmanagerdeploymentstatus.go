@@ -0,0 +1,28 @@
+package networkmanagers
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type NetworkManagerDeploymentStatus struct {
+	CommitTime       *string            `json:"commitTime,omitempty"`
+	ConfigurationIds *[]string          `json:"configurationIds,omitempty"`
+	DeploymentStatus *DeploymentStatus  `json:"deploymentStatus,omitempty"`
+	DeploymentType   *ConfigurationType `json:"deploymentType,omitempty"`
+	ErrorMessage     *string            `json:"errorMessage,omitempty"`
+	Region           *string            `json:"region,omitempty"`
+}
+
+func (o NetworkManagerDeploymentStatus) GetCommitTimeAsTime() (*time.Time, error) {
+	if o.CommitTime == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.CommitTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o NetworkManagerDeploymentStatus) SetCommitTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.CommitTime = &formatted
+}
@@ -0,0 +1,99 @@
+package bastionhosts
+
+import "strings"
+
+type BastionHostSkuName string
+
+const (
+	BastionHostSkuNameBasic     BastionHostSkuName = "Basic"
+	BastionHostSkuNameDeveloper BastionHostSkuName = "Developer"
+	BastionHostSkuNamePremium   BastionHostSkuName = "Premium"
+	BastionHostSkuNameStandard  BastionHostSkuName = "Standard"
+)
+
+func PossibleValuesForBastionHostSkuName() []string {
+	return []string{
+		string(BastionHostSkuNameBasic),
+		string(BastionHostSkuNameDeveloper),
+		string(BastionHostSkuNamePremium),
+		string(BastionHostSkuNameStandard),
+	}
+}
+
+func parseBastionHostSkuName(input string) (*BastionHostSkuName, error) {
+	vals := map[string]BastionHostSkuName{
+		"basic":     BastionHostSkuNameBasic,
+		"developer": BastionHostSkuNameDeveloper,
+		"premium":   BastionHostSkuNamePremium,
+		"standard":  BastionHostSkuNameStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BastionHostSkuName(input)
+	return &out, nil
+}
+
+type IPAllocationMethod string
+
+const (
+	IPAllocationMethodDynamic IPAllocationMethod = "Dynamic"
+	IPAllocationMethodStatic  IPAllocationMethod = "Static"
+)
+
+func PossibleValuesForIPAllocationMethod() []string {
+	return []string{
+		string(IPAllocationMethodDynamic),
+		string(IPAllocationMethodStatic),
+	}
+}
+
+func parseIPAllocationMethod(input string) (*IPAllocationMethod, error) {
+	vals := map[string]IPAllocationMethod{
+		"dynamic": IPAllocationMethodDynamic,
+		"static":  IPAllocationMethodStatic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPAllocationMethod(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
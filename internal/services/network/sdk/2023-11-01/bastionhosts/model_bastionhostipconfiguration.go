@@ -0,0 +1,9 @@
+package bastionhosts
+
+type BastionHostIPConfiguration struct {
+	Etag       *string                                     `json:"etag,omitempty"`
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *BastionHostIPConfigurationPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
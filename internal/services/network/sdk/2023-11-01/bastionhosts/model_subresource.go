@@ -0,0 +1,5 @@
+package bastionhosts
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
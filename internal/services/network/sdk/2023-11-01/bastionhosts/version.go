@@ -0,0 +1,9 @@
+package bastionhosts
+
+import "fmt"
+
+const defaultApiVersion = "2023-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/bastionhosts/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package configurationpolicygroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ConfigurationPolicyGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConfigurationPolicyGroupsClientWithBaseURI(endpoint string) ConfigurationPolicyGroupsClient {
+	return ConfigurationPolicyGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
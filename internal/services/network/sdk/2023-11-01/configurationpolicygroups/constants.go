@@ -0,0 +1,68 @@
+package configurationpolicygroups
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type VpnPolicyMemberAttributeType string
+
+const (
+	VpnPolicyMemberAttributeTypeAADGroupId         VpnPolicyMemberAttributeType = "AADGroupId"
+	VpnPolicyMemberAttributeTypeCertificateGroupId VpnPolicyMemberAttributeType = "CertificateGroupId"
+	VpnPolicyMemberAttributeTypeRadiusAzureGroupId VpnPolicyMemberAttributeType = "RadiusAzureGroupId"
+)
+
+func PossibleValuesForVpnPolicyMemberAttributeType() []string {
+	return []string{
+		string(VpnPolicyMemberAttributeTypeAADGroupId),
+		string(VpnPolicyMemberAttributeTypeCertificateGroupId),
+		string(VpnPolicyMemberAttributeTypeRadiusAzureGroupId),
+	}
+}
+
+func parseVpnPolicyMemberAttributeType(input string) (*VpnPolicyMemberAttributeType, error) {
+	vals := map[string]VpnPolicyMemberAttributeType{
+		"aadgroupid":         VpnPolicyMemberAttributeTypeAADGroupId,
+		"certificategroupid": VpnPolicyMemberAttributeTypeCertificateGroupId,
+		"radiusazuregroupid": VpnPolicyMemberAttributeTypeRadiusAzureGroupId,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VpnPolicyMemberAttributeType(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package configurationpolicygroups
+
+type VpnServerConfigurationPolicyGroup struct {
+	Etag       *string                                      `json:"etag,omitempty"`
+	Id         *string                                      `json:"id,omitempty"`
+	Name       *string                                      `json:"name,omitempty"`
+	Properties *VpnServerConfigurationPolicyGroupProperties `json:"properties,omitempty"`
+	Type       *string                                      `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package configurationpolicygroups
+
+type VpnServerConfigurationPolicyGroupProperties struct {
+	IsDefault                   *bool                                      `json:"isDefault,omitempty"`
+	P2SConnectionConfigurations *[]SubResource                             `json:"p2SConnectionConfigurations,omitempty"`
+	PolicyMembers               *[]VpnServerConfigurationPolicyGroupMember `json:"policyMembers,omitempty"`
+	Priority                    *int64                                     `json:"priority,omitempty"`
+	ProvisioningState           *ProvisioningState                         `json:"provisioningState,omitempty"`
+}
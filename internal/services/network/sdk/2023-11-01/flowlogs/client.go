@@ -0,0 +1,15 @@
+package flowlogs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FlowLogsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFlowLogsClientWithBaseURI(endpoint string) FlowLogsClient {
+	return FlowLogsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package flowlogs
+
+type FlowLogFormatParameters struct {
+	Type    *FlowLogFormatType `json:"type,omitempty"`
+	Version *int64             `json:"version,omitempty"`
+}
@@ -0,0 +1,6 @@
+package flowlogs
+
+type RetentionPolicyParameters struct {
+	Days    *int64 `json:"days,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
@@ -0,0 +1,9 @@
+package flowlogs
+
+type TrafficAnalyticsConfigurationProperties struct {
+	Enabled                  *bool   `json:"enabled,omitempty"`
+	TrafficAnalyticsInterval *int64  `json:"trafficAnalyticsInterval,omitempty"`
+	WorkspaceId              *string `json:"workspaceId,omitempty"`
+	WorkspaceRegion          *string `json:"workspaceRegion,omitempty"`
+	WorkspaceResourceId      *string `json:"workspaceResourceId,omitempty"`
+}
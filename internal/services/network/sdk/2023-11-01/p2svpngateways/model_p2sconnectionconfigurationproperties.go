@@ -0,0 +1,9 @@
+package p2svpngateways
+
+type P2SConnectionConfigurationProperties struct {
+	ConfigurationPolicyGroupAssociations *[]SubResource        `json:"configurationPolicyGroupAssociations,omitempty"`
+	EnableInternetSecurity               *bool                 `json:"enableInternetSecurity,omitempty"`
+	ProvisioningState                    *ProvisioningState    `json:"provisioningState,omitempty"`
+	RoutingConfiguration                 *RoutingConfiguration `json:"routingConfiguration,omitempty"`
+	VpnClientAddressPool                 *AddressSpace         `json:"vpnClientAddressPool,omitempty"`
+}
@@ -0,0 +1,6 @@
+package p2svpngateways
+
+type PropagatedRouteTable struct {
+	Ids    *[]SubResource `json:"ids,omitempty"`
+	Labels *[]string      `json:"labels,omitempty"`
+}
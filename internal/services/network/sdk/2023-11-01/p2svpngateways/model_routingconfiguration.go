@@ -0,0 +1,6 @@
+package p2svpngateways
+
+type RoutingConfiguration struct {
+	AssociatedRouteTable  *SubResource          `json:"associatedRouteTable,omitempty"`
+	PropagatedRouteTables *PropagatedRouteTable `json:"propagatedRouteTables,omitempty"`
+}
@@ -0,0 +1,5 @@
+package p2svpngateways
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
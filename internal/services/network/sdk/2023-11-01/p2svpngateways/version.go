@@ -0,0 +1,9 @@
+package p2svpngateways
+
+import "fmt"
+
+const defaultApiVersion = "2023-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/p2svpngateways/%s", defaultApiVersion)
+}
@@ -0,0 +1,214 @@
+package publicipaddresses
+
+import "strings"
+
+type DdosSettingsProtectionMode string
+
+const (
+	DdosSettingsProtectionModeDisabled                DdosSettingsProtectionMode = "Disabled"
+	DdosSettingsProtectionModeEnabled                 DdosSettingsProtectionMode = "Enabled"
+	DdosSettingsProtectionModeVirtualNetworkInherited DdosSettingsProtectionMode = "VirtualNetworkInherited"
+)
+
+func PossibleValuesForDdosSettingsProtectionMode() []string {
+	return []string{
+		string(DdosSettingsProtectionModeDisabled),
+		string(DdosSettingsProtectionModeEnabled),
+		string(DdosSettingsProtectionModeVirtualNetworkInherited),
+	}
+}
+
+func parseDdosSettingsProtectionMode(input string) (*DdosSettingsProtectionMode, error) {
+	vals := map[string]DdosSettingsProtectionMode{
+		"disabled":                DdosSettingsProtectionModeDisabled,
+		"enabled":                 DdosSettingsProtectionModeEnabled,
+		"virtualnetworkinherited": DdosSettingsProtectionModeVirtualNetworkInherited,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DdosSettingsProtectionMode(input)
+	return &out, nil
+}
+
+type IPAllocationMethod string
+
+const (
+	IPAllocationMethodDynamic IPAllocationMethod = "Dynamic"
+	IPAllocationMethodStatic  IPAllocationMethod = "Static"
+)
+
+func PossibleValuesForIPAllocationMethod() []string {
+	return []string{
+		string(IPAllocationMethodDynamic),
+		string(IPAllocationMethodStatic),
+	}
+}
+
+func parseIPAllocationMethod(input string) (*IPAllocationMethod, error) {
+	vals := map[string]IPAllocationMethod{
+		"dynamic": IPAllocationMethodDynamic,
+		"static":  IPAllocationMethodStatic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPAllocationMethod(input)
+	return &out, nil
+}
+
+type IPVersion string
+
+const (
+	IPVersionIPvFour IPVersion = "IPv4"
+	IPVersionIPvSix  IPVersion = "IPv6"
+)
+
+func PossibleValuesForIPVersion() []string {
+	return []string{
+		string(IPVersionIPvFour),
+		string(IPVersionIPvSix),
+	}
+}
+
+func parseIPVersion(input string) (*IPVersion, error) {
+	vals := map[string]IPVersion{
+		"ipv4": IPVersionIPvFour,
+		"ipv6": IPVersionIPvSix,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPVersion(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type PublicIPAddressDnsSettingsDomainNameLabelScope string
+
+const (
+	PublicIPAddressDnsSettingsDomainNameLabelScopeNoReuse            PublicIPAddressDnsSettingsDomainNameLabelScope = "NoReuse"
+	PublicIPAddressDnsSettingsDomainNameLabelScopeResourceGroupReuse PublicIPAddressDnsSettingsDomainNameLabelScope = "ResourceGroupReuse"
+	PublicIPAddressDnsSettingsDomainNameLabelScopeSubscriptionReuse  PublicIPAddressDnsSettingsDomainNameLabelScope = "SubscriptionReuse"
+	PublicIPAddressDnsSettingsDomainNameLabelScopeTenantReuse        PublicIPAddressDnsSettingsDomainNameLabelScope = "TenantReuse"
+)
+
+func PossibleValuesForPublicIPAddressDnsSettingsDomainNameLabelScope() []string {
+	return []string{
+		string(PublicIPAddressDnsSettingsDomainNameLabelScopeNoReuse),
+		string(PublicIPAddressDnsSettingsDomainNameLabelScopeResourceGroupReuse),
+		string(PublicIPAddressDnsSettingsDomainNameLabelScopeSubscriptionReuse),
+		string(PublicIPAddressDnsSettingsDomainNameLabelScopeTenantReuse),
+	}
+}
+
+func parsePublicIPAddressDnsSettingsDomainNameLabelScope(input string) (*PublicIPAddressDnsSettingsDomainNameLabelScope, error) {
+	vals := map[string]PublicIPAddressDnsSettingsDomainNameLabelScope{
+		"noreuse":            PublicIPAddressDnsSettingsDomainNameLabelScopeNoReuse,
+		"resourcegroupreuse": PublicIPAddressDnsSettingsDomainNameLabelScopeResourceGroupReuse,
+		"subscriptionreuse":  PublicIPAddressDnsSettingsDomainNameLabelScopeSubscriptionReuse,
+		"tenantreuse":        PublicIPAddressDnsSettingsDomainNameLabelScopeTenantReuse,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicIPAddressDnsSettingsDomainNameLabelScope(input)
+	return &out, nil
+}
+
+type PublicIPAddressSkuName string
+
+const (
+	PublicIPAddressSkuNameBasic    PublicIPAddressSkuName = "Basic"
+	PublicIPAddressSkuNameStandard PublicIPAddressSkuName = "Standard"
+)
+
+func PossibleValuesForPublicIPAddressSkuName() []string {
+	return []string{
+		string(PublicIPAddressSkuNameBasic),
+		string(PublicIPAddressSkuNameStandard),
+	}
+}
+
+func parsePublicIPAddressSkuName(input string) (*PublicIPAddressSkuName, error) {
+	vals := map[string]PublicIPAddressSkuName{
+		"basic":    PublicIPAddressSkuNameBasic,
+		"standard": PublicIPAddressSkuNameStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicIPAddressSkuName(input)
+	return &out, nil
+}
+
+type PublicIPAddressSkuTier string
+
+const (
+	PublicIPAddressSkuTierGlobal   PublicIPAddressSkuTier = "Global"
+	PublicIPAddressSkuTierRegional PublicIPAddressSkuTier = "Regional"
+)
+
+func PossibleValuesForPublicIPAddressSkuTier() []string {
+	return []string{
+		string(PublicIPAddressSkuTierGlobal),
+		string(PublicIPAddressSkuTierRegional),
+	}
+}
+
+func parsePublicIPAddressSkuTier(input string) (*PublicIPAddressSkuTier, error) {
+	vals := map[string]PublicIPAddressSkuTier{
+		"global":   PublicIPAddressSkuTierGlobal,
+		"regional": PublicIPAddressSkuTierRegional,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicIPAddressSkuTier(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package publicipaddresses
+
+type IPTag struct {
+	IPTagType *string `json:"ipTagType,omitempty"`
+	Tag       *string `json:"tag,omitempty"`
+}
@@ -0,0 +1,13 @@
+package publicipaddresses
+
+type PublicIPAddress struct {
+	Etag       *string                          `json:"etag,omitempty"`
+	Id         *string                          `json:"id,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *PublicIPAddressPropertiesFormat `json:"properties,omitempty"`
+	Sku        *PublicIPAddressSku              `json:"sku,omitempty"`
+	Tags       *map[string]string               `json:"tags,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+	Zones      *[]string                        `json:"zones,omitempty"`
+}
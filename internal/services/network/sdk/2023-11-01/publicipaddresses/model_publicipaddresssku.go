@@ -0,0 +1,6 @@
+package publicipaddresses
+
+type PublicIPAddressSku struct {
+	Name *PublicIPAddressSkuName `json:"name,omitempty"`
+	Tier *PublicIPAddressSkuTier `json:"tier,omitempty"`
+}
@@ -0,0 +1,15 @@
+package virtualnetworkgatewayconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualNetworkGatewayConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint string) VirtualNetworkGatewayConnectionsClient {
+	return VirtualNetworkGatewayConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
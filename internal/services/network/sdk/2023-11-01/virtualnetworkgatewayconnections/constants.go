@@ -0,0 +1,431 @@
+package virtualnetworkgatewayconnections
+
+import "strings"
+
+type DhGroup string
+
+const (
+	DhGroupDHGroupOne              DhGroup = "DHGroup1"
+	DhGroupDHGroupOneFour          DhGroup = "DHGroup14"
+	DhGroupDHGroupTwo              DhGroup = "DHGroup2"
+	DhGroupDHGroupTwoFour          DhGroup = "DHGroup24"
+	DhGroupDHGroupTwoZeroFourEight DhGroup = "DHGroup2048"
+	DhGroupECPThreeEightFour       DhGroup = "ECP384"
+	DhGroupECPTwoFiveSix           DhGroup = "ECP256"
+	DhGroupNone                    DhGroup = "None"
+)
+
+func PossibleValuesForDhGroup() []string {
+	return []string{
+		string(DhGroupDHGroupOne),
+		string(DhGroupDHGroupOneFour),
+		string(DhGroupDHGroupTwo),
+		string(DhGroupDHGroupTwoFour),
+		string(DhGroupDHGroupTwoZeroFourEight),
+		string(DhGroupECPThreeEightFour),
+		string(DhGroupECPTwoFiveSix),
+		string(DhGroupNone),
+	}
+}
+
+func parseDhGroup(input string) (*DhGroup, error) {
+	vals := map[string]DhGroup{
+		"dhgroup1":    DhGroupDHGroupOne,
+		"dhgroup14":   DhGroupDHGroupOneFour,
+		"dhgroup2":    DhGroupDHGroupTwo,
+		"dhgroup24":   DhGroupDHGroupTwoFour,
+		"dhgroup2048": DhGroupDHGroupTwoZeroFourEight,
+		"ecp384":      DhGroupECPThreeEightFour,
+		"ecp256":      DhGroupECPTwoFiveSix,
+		"none":        DhGroupNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DhGroup(input)
+	return &out, nil
+}
+
+type IPsecEncryption string
+
+const (
+	IPsecEncryptionAESOneNineTwo     IPsecEncryption = "AES192"
+	IPsecEncryptionAESOneTwoEight    IPsecEncryption = "AES128"
+	IPsecEncryptionAESTwoFiveSix     IPsecEncryption = "AES256"
+	IPsecEncryptionDES               IPsecEncryption = "DES"
+	IPsecEncryptionDESThree          IPsecEncryption = "DES3"
+	IPsecEncryptionGCMAESOneNineTwo  IPsecEncryption = "GCMAES192"
+	IPsecEncryptionGCMAESOneTwoEight IPsecEncryption = "GCMAES128"
+	IPsecEncryptionGCMAESTwoFiveSix  IPsecEncryption = "GCMAES256"
+	IPsecEncryptionNone              IPsecEncryption = "None"
+)
+
+func PossibleValuesForIPsecEncryption() []string {
+	return []string{
+		string(IPsecEncryptionAESOneNineTwo),
+		string(IPsecEncryptionAESOneTwoEight),
+		string(IPsecEncryptionAESTwoFiveSix),
+		string(IPsecEncryptionDES),
+		string(IPsecEncryptionDESThree),
+		string(IPsecEncryptionGCMAESOneNineTwo),
+		string(IPsecEncryptionGCMAESOneTwoEight),
+		string(IPsecEncryptionGCMAESTwoFiveSix),
+		string(IPsecEncryptionNone),
+	}
+}
+
+func parseIPsecEncryption(input string) (*IPsecEncryption, error) {
+	vals := map[string]IPsecEncryption{
+		"aes192":    IPsecEncryptionAESOneNineTwo,
+		"aes128":    IPsecEncryptionAESOneTwoEight,
+		"aes256":    IPsecEncryptionAESTwoFiveSix,
+		"des":       IPsecEncryptionDES,
+		"des3":      IPsecEncryptionDESThree,
+		"gcmaes192": IPsecEncryptionGCMAESOneNineTwo,
+		"gcmaes128": IPsecEncryptionGCMAESOneTwoEight,
+		"gcmaes256": IPsecEncryptionGCMAESTwoFiveSix,
+		"none":      IPsecEncryptionNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPsecEncryption(input)
+	return &out, nil
+}
+
+type IPsecIntegrity string
+
+const (
+	IPsecIntegrityGCMAESOneNineTwo  IPsecIntegrity = "GCMAES192"
+	IPsecIntegrityGCMAESOneTwoEight IPsecIntegrity = "GCMAES128"
+	IPsecIntegrityGCMAESTwoFiveSix  IPsecIntegrity = "GCMAES256"
+	IPsecIntegrityMDFive            IPsecIntegrity = "MD5"
+	IPsecIntegritySHAOne            IPsecIntegrity = "SHA1"
+	IPsecIntegritySHATwoFiveSix     IPsecIntegrity = "SHA256"
+)
+
+func PossibleValuesForIPsecIntegrity() []string {
+	return []string{
+		string(IPsecIntegrityGCMAESOneNineTwo),
+		string(IPsecIntegrityGCMAESOneTwoEight),
+		string(IPsecIntegrityGCMAESTwoFiveSix),
+		string(IPsecIntegrityMDFive),
+		string(IPsecIntegritySHAOne),
+		string(IPsecIntegritySHATwoFiveSix),
+	}
+}
+
+func parseIPsecIntegrity(input string) (*IPsecIntegrity, error) {
+	vals := map[string]IPsecIntegrity{
+		"gcmaes192": IPsecIntegrityGCMAESOneNineTwo,
+		"gcmaes128": IPsecIntegrityGCMAESOneTwoEight,
+		"gcmaes256": IPsecIntegrityGCMAESTwoFiveSix,
+		"md5":       IPsecIntegrityMDFive,
+		"sha1":      IPsecIntegritySHAOne,
+		"sha256":    IPsecIntegritySHATwoFiveSix,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPsecIntegrity(input)
+	return &out, nil
+}
+
+type IkeEncryption string
+
+const (
+	IkeEncryptionAESOneNineTwo     IkeEncryption = "AES192"
+	IkeEncryptionAESOneTwoEight    IkeEncryption = "AES128"
+	IkeEncryptionAESTwoFiveSix     IkeEncryption = "AES256"
+	IkeEncryptionDES               IkeEncryption = "DES"
+	IkeEncryptionDESThree          IkeEncryption = "DES3"
+	IkeEncryptionGCMAESOneTwoEight IkeEncryption = "GCMAES128"
+	IkeEncryptionGCMAESTwoFiveSix  IkeEncryption = "GCMAES256"
+)
+
+func PossibleValuesForIkeEncryption() []string {
+	return []string{
+		string(IkeEncryptionAESOneNineTwo),
+		string(IkeEncryptionAESOneTwoEight),
+		string(IkeEncryptionAESTwoFiveSix),
+		string(IkeEncryptionDES),
+		string(IkeEncryptionDESThree),
+		string(IkeEncryptionGCMAESOneTwoEight),
+		string(IkeEncryptionGCMAESTwoFiveSix),
+	}
+}
+
+func parseIkeEncryption(input string) (*IkeEncryption, error) {
+	vals := map[string]IkeEncryption{
+		"aes192":    IkeEncryptionAESOneNineTwo,
+		"aes128":    IkeEncryptionAESOneTwoEight,
+		"aes256":    IkeEncryptionAESTwoFiveSix,
+		"des":       IkeEncryptionDES,
+		"des3":      IkeEncryptionDESThree,
+		"gcmaes128": IkeEncryptionGCMAESOneTwoEight,
+		"gcmaes256": IkeEncryptionGCMAESTwoFiveSix,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IkeEncryption(input)
+	return &out, nil
+}
+
+type IkeIntegrity string
+
+const (
+	IkeIntegrityGCMAESOneTwoEight IkeIntegrity = "GCMAES128"
+	IkeIntegrityGCMAESTwoFiveSix  IkeIntegrity = "GCMAES256"
+	IkeIntegrityMDFive            IkeIntegrity = "MD5"
+	IkeIntegritySHAOne            IkeIntegrity = "SHA1"
+	IkeIntegritySHAThreeEightFour IkeIntegrity = "SHA384"
+	IkeIntegritySHATwoFiveSix     IkeIntegrity = "SHA256"
+)
+
+func PossibleValuesForIkeIntegrity() []string {
+	return []string{
+		string(IkeIntegrityGCMAESOneTwoEight),
+		string(IkeIntegrityGCMAESTwoFiveSix),
+		string(IkeIntegrityMDFive),
+		string(IkeIntegritySHAOne),
+		string(IkeIntegritySHAThreeEightFour),
+		string(IkeIntegritySHATwoFiveSix),
+	}
+}
+
+func parseIkeIntegrity(input string) (*IkeIntegrity, error) {
+	vals := map[string]IkeIntegrity{
+		"gcmaes128": IkeIntegrityGCMAESOneTwoEight,
+		"gcmaes256": IkeIntegrityGCMAESTwoFiveSix,
+		"md5":       IkeIntegrityMDFive,
+		"sha1":      IkeIntegritySHAOne,
+		"sha384":    IkeIntegritySHAThreeEightFour,
+		"sha256":    IkeIntegritySHATwoFiveSix,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IkeIntegrity(input)
+	return &out, nil
+}
+
+type PfsGroup string
+
+const (
+	PfsGroupECPThreeEightFour   PfsGroup = "ECP384"
+	PfsGroupECPTwoFiveSix       PfsGroup = "ECP256"
+	PfsGroupNone                PfsGroup = "None"
+	PfsGroupPFSMM               PfsGroup = "PFSMM"
+	PfsGroupPFSOne              PfsGroup = "PFS1"
+	PfsGroupPFSOneFour          PfsGroup = "PFS14"
+	PfsGroupPFSTwo              PfsGroup = "PFS2"
+	PfsGroupPFSTwoFour          PfsGroup = "PFS24"
+	PfsGroupPFSTwoZeroFourEight PfsGroup = "PFS2048"
+)
+
+func PossibleValuesForPfsGroup() []string {
+	return []string{
+		string(PfsGroupECPThreeEightFour),
+		string(PfsGroupECPTwoFiveSix),
+		string(PfsGroupNone),
+		string(PfsGroupPFSMM),
+		string(PfsGroupPFSOne),
+		string(PfsGroupPFSOneFour),
+		string(PfsGroupPFSTwo),
+		string(PfsGroupPFSTwoFour),
+		string(PfsGroupPFSTwoZeroFourEight),
+	}
+}
+
+func parsePfsGroup(input string) (*PfsGroup, error) {
+	vals := map[string]PfsGroup{
+		"ecp384":  PfsGroupECPThreeEightFour,
+		"ecp256":  PfsGroupECPTwoFiveSix,
+		"none":    PfsGroupNone,
+		"pfsmm":   PfsGroupPFSMM,
+		"pfs1":    PfsGroupPFSOne,
+		"pfs14":   PfsGroupPFSOneFour,
+		"pfs2":    PfsGroupPFSTwo,
+		"pfs24":   PfsGroupPFSTwoFour,
+		"pfs2048": PfsGroupPFSTwoZeroFourEight,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PfsGroup(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type VirtualNetworkGatewayConnectionMode string
+
+const (
+	VirtualNetworkGatewayConnectionModeDefault       VirtualNetworkGatewayConnectionMode = "Default"
+	VirtualNetworkGatewayConnectionModeInitiatorOnly VirtualNetworkGatewayConnectionMode = "InitiatorOnly"
+	VirtualNetworkGatewayConnectionModeResponderOnly VirtualNetworkGatewayConnectionMode = "ResponderOnly"
+)
+
+func PossibleValuesForVirtualNetworkGatewayConnectionMode() []string {
+	return []string{
+		string(VirtualNetworkGatewayConnectionModeDefault),
+		string(VirtualNetworkGatewayConnectionModeInitiatorOnly),
+		string(VirtualNetworkGatewayConnectionModeResponderOnly),
+	}
+}
+
+func parseVirtualNetworkGatewayConnectionMode(input string) (*VirtualNetworkGatewayConnectionMode, error) {
+	vals := map[string]VirtualNetworkGatewayConnectionMode{
+		"default":       VirtualNetworkGatewayConnectionModeDefault,
+		"initiatoronly": VirtualNetworkGatewayConnectionModeInitiatorOnly,
+		"responderonly": VirtualNetworkGatewayConnectionModeResponderOnly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VirtualNetworkGatewayConnectionMode(input)
+	return &out, nil
+}
+
+type VirtualNetworkGatewayConnectionProtocol string
+
+const (
+	VirtualNetworkGatewayConnectionProtocolIKEvOne VirtualNetworkGatewayConnectionProtocol = "IKEv1"
+	VirtualNetworkGatewayConnectionProtocolIKEvTwo VirtualNetworkGatewayConnectionProtocol = "IKEv2"
+)
+
+func PossibleValuesForVirtualNetworkGatewayConnectionProtocol() []string {
+	return []string{
+		string(VirtualNetworkGatewayConnectionProtocolIKEvOne),
+		string(VirtualNetworkGatewayConnectionProtocolIKEvTwo),
+	}
+}
+
+func parseVirtualNetworkGatewayConnectionProtocol(input string) (*VirtualNetworkGatewayConnectionProtocol, error) {
+	vals := map[string]VirtualNetworkGatewayConnectionProtocol{
+		"ikev1": VirtualNetworkGatewayConnectionProtocolIKEvOne,
+		"ikev2": VirtualNetworkGatewayConnectionProtocolIKEvTwo,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VirtualNetworkGatewayConnectionProtocol(input)
+	return &out, nil
+}
+
+type VirtualNetworkGatewayConnectionStatus string
+
+const (
+	VirtualNetworkGatewayConnectionStatusConnected    VirtualNetworkGatewayConnectionStatus = "Connected"
+	VirtualNetworkGatewayConnectionStatusConnecting   VirtualNetworkGatewayConnectionStatus = "Connecting"
+	VirtualNetworkGatewayConnectionStatusNotConnected VirtualNetworkGatewayConnectionStatus = "NotConnected"
+	VirtualNetworkGatewayConnectionStatusUnknown      VirtualNetworkGatewayConnectionStatus = "Unknown"
+)
+
+func PossibleValuesForVirtualNetworkGatewayConnectionStatus() []string {
+	return []string{
+		string(VirtualNetworkGatewayConnectionStatusConnected),
+		string(VirtualNetworkGatewayConnectionStatusConnecting),
+		string(VirtualNetworkGatewayConnectionStatusNotConnected),
+		string(VirtualNetworkGatewayConnectionStatusUnknown),
+	}
+}
+
+func parseVirtualNetworkGatewayConnectionStatus(input string) (*VirtualNetworkGatewayConnectionStatus, error) {
+	vals := map[string]VirtualNetworkGatewayConnectionStatus{
+		"connected":    VirtualNetworkGatewayConnectionStatusConnected,
+		"connecting":   VirtualNetworkGatewayConnectionStatusConnecting,
+		"notconnected": VirtualNetworkGatewayConnectionStatusNotConnected,
+		"unknown":      VirtualNetworkGatewayConnectionStatusUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VirtualNetworkGatewayConnectionStatus(input)
+	return &out, nil
+}
+
+type VirtualNetworkGatewayConnectionType string
+
+const (
+	VirtualNetworkGatewayConnectionTypeExpressRoute VirtualNetworkGatewayConnectionType = "ExpressRoute"
+	VirtualNetworkGatewayConnectionTypeIPsec        VirtualNetworkGatewayConnectionType = "IPsec"
+	VirtualNetworkGatewayConnectionTypeVPNClient    VirtualNetworkGatewayConnectionType = "VPNClient"
+	VirtualNetworkGatewayConnectionTypeVnetTwoVnet  VirtualNetworkGatewayConnectionType = "Vnet2Vnet"
+)
+
+func PossibleValuesForVirtualNetworkGatewayConnectionType() []string {
+	return []string{
+		string(VirtualNetworkGatewayConnectionTypeExpressRoute),
+		string(VirtualNetworkGatewayConnectionTypeIPsec),
+		string(VirtualNetworkGatewayConnectionTypeVPNClient),
+		string(VirtualNetworkGatewayConnectionTypeVnetTwoVnet),
+	}
+}
+
+func parseVirtualNetworkGatewayConnectionType(input string) (*VirtualNetworkGatewayConnectionType, error) {
+	vals := map[string]VirtualNetworkGatewayConnectionType{
+		"expressroute": VirtualNetworkGatewayConnectionTypeExpressRoute,
+		"ipsec":        VirtualNetworkGatewayConnectionTypeIPsec,
+		"vpnclient":    VirtualNetworkGatewayConnectionTypeVPNClient,
+		"vnet2vnet":    VirtualNetworkGatewayConnectionTypeVnetTwoVnet,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VirtualNetworkGatewayConnectionType(input)
+	return &out, nil
+}
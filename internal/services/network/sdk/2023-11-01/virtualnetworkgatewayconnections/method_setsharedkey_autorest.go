@@ -0,0 +1,75 @@
+package virtualnetworkgatewayconnections
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SetSharedKeyResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SetSharedKey ...
+func (c VirtualNetworkGatewayConnectionsClient) SetSharedKey(ctx context.Context, id ConnectionId, input ConnectionSharedKey) (result SetSharedKeyResponse, err error) {
+	req, err := c.preparerForSetSharedKey(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionsClient", "SetSharedKey", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSetSharedKey(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionsClient", "SetSharedKey", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SetSharedKeyThenPoll performs SetSharedKey then polls until it's completed
+func (c VirtualNetworkGatewayConnectionsClient) SetSharedKeyThenPoll(ctx context.Context, id ConnectionId, input ConnectionSharedKey) error {
+	result, err := c.SetSharedKey(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SetSharedKey: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SetSharedKey: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSetSharedKey prepares the SetSharedKey request.
+func (c VirtualNetworkGatewayConnectionsClient) preparerForSetSharedKey(ctx context.Context, id ConnectionId, input ConnectionSharedKey) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/sharedkey", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSetSharedKey sends the SetSharedKey request. The method will close the
+// http.Response Body if it receives an error.
+func (c VirtualNetworkGatewayConnectionsClient) senderForSetSharedKey(ctx context.Context, req *http.Request) (future SetSharedKeyResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
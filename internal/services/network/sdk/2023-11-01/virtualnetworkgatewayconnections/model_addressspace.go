@@ -0,0 +1,5 @@
+package virtualnetworkgatewayconnections
+
+type AddressSpace struct {
+	AddressPrefixes *[]string `json:"addressPrefixes,omitempty"`
+}
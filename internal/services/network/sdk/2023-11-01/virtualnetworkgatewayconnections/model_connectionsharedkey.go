@@ -0,0 +1,6 @@
+package virtualnetworkgatewayconnections
+
+type ConnectionSharedKey struct {
+	Id    *string `json:"id,omitempty"`
+	Value string  `json:"value"`
+}
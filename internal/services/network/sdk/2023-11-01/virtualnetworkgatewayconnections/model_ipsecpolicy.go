@@ -0,0 +1,12 @@
+package virtualnetworkgatewayconnections
+
+type IPsecPolicy struct {
+	DhGroup             DhGroup         `json:"dhGroup"`
+	IPsecEncryption     IPsecEncryption `json:"ipsecEncryption"`
+	IPsecIntegrity      IPsecIntegrity  `json:"ipsecIntegrity"`
+	IkeEncryption       IkeEncryption   `json:"ikeEncryption"`
+	IkeIntegrity        IkeIntegrity    `json:"ikeIntegrity"`
+	PfsGroup            PfsGroup        `json:"pfsGroup"`
+	SaDataSizeKilobytes int64           `json:"saDataSizeKilobytes"`
+	SaLifeTimeSeconds   int64           `json:"saLifeTimeSeconds"`
+}
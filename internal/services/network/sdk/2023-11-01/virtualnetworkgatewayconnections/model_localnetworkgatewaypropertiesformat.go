@@ -0,0 +1,7 @@
+package virtualnetworkgatewayconnections
+
+type LocalNetworkGatewayPropertiesFormat struct {
+	GatewayIPAddress         *string            `json:"gatewayIpAddress,omitempty"`
+	LocalNetworkAddressSpace *AddressSpace      `json:"localNetworkAddressSpace,omitempty"`
+	ProvisioningState        *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualnetworkgatewayconnections
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,6 @@
+package virtualnetworkgatewayconnections
+
+type TrafficSelectorPolicy struct {
+	LocalAddressRanges  []string `json:"localAddressRanges"`
+	RemoteAddressRanges []string `json:"remoteAddressRanges"`
+}
@@ -0,0 +1,11 @@
+package virtualnetworkgatewayconnections
+
+type VirtualNetworkGateway struct {
+	Etag       *string                               `json:"etag,omitempty"`
+	Id         *string                               `json:"id,omitempty"`
+	Location   *string                               `json:"location,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Properties VirtualNetworkGatewayPropertiesFormat `json:"properties"`
+	Tags       *map[string]string                    `json:"tags,omitempty"`
+	Type       *string                               `json:"type,omitempty"`
+}
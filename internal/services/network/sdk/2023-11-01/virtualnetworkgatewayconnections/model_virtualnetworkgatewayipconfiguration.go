@@ -0,0 +1,7 @@
+package virtualnetworkgatewayconnections
+
+type VirtualNetworkGatewayIPConfiguration struct {
+	Etag *string `json:"etag,omitempty"`
+	Id   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
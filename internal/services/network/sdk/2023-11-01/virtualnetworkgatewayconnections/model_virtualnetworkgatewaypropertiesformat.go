@@ -0,0 +1,6 @@
+package virtualnetworkgatewayconnections
+
+type VirtualNetworkGatewayPropertiesFormat struct {
+	IPConfigurations  *[]VirtualNetworkGatewayIPConfiguration `json:"ipConfigurations,omitempty"`
+	ProvisioningState *ProvisioningState                      `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,15 @@
+package ipampools
+
+import "github.com/Azure/go-autorest/autorest"
+
+type IPamPoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewIPamPoolsClientWithBaseURI(endpoint string) IPamPoolsClient {
+	return IPamPoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
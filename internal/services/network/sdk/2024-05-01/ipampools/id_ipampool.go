@@ -0,0 +1,137 @@
+package ipampools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = IPamPoolId{}
+
+// IPamPoolId is a struct representing the Resource ID for a I Pam Pool
+type IPamPoolId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	NetworkManagerName string
+	PoolName           string
+}
+
+// NewIPamPoolID returns a new IPamPoolId struct
+func NewIPamPoolID(subscriptionId string, resourceGroupName string, networkManagerName string, poolName string) IPamPoolId {
+	return IPamPoolId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		NetworkManagerName: networkManagerName,
+		PoolName:           poolName,
+	}
+}
+
+// ParseIPamPoolID parses 'input' into a IPamPoolId
+func ParseIPamPoolID(input string) (*IPamPoolId, error) {
+	parser := resourceids.NewParserFromResourceIdType(IPamPoolId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := IPamPoolId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkManagerName, ok = parsed.Parsed["networkManagerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkManagerName' was not found in the resource id %q", input)
+	}
+
+	if id.PoolName, ok = parsed.Parsed["poolName"]; !ok {
+		return nil, fmt.Errorf("the segment 'poolName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseIPamPoolIDInsensitively parses 'input' case-insensitively into a IPamPoolId
+// note: this method should only be used for API response data and not user input
+func ParseIPamPoolIDInsensitively(input string) (*IPamPoolId, error) {
+	parser := resourceids.NewParserFromResourceIdType(IPamPoolId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := IPamPoolId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkManagerName, ok = parsed.Parsed["networkManagerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkManagerName' was not found in the resource id %q", input)
+	}
+
+	if id.PoolName, ok = parsed.Parsed["poolName"]; !ok {
+		return nil, fmt.Errorf("the segment 'poolName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateIPamPoolID checks that 'input' can be parsed as a I Pam Pool ID
+func ValidateIPamPoolID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseIPamPoolID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted I Pam Pool ID
+func (id IPamPoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/ipamPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.PoolName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this I Pam Pool ID
+func (id IPamPoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticNetworkManagers", "networkManagers", "networkManagers"),
+		resourceids.UserSpecifiedSegment("networkManagerName", "networkManagerValue"),
+		resourceids.StaticSegment("staticIpamPools", "ipamPools", "ipamPools"),
+		resourceids.UserSpecifiedSegment("poolName", "poolValue"),
+	}
+}
+
+// String returns a human-readable description of this I Pam Pool ID
+func (id IPamPoolId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Network Manager Name: %q", id.NetworkManagerName),
+		fmt.Sprintf("Pool Name: %q", id.PoolName),
+	}
+	return fmt.Sprintf("I Pam Pool (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,10 @@
+package ipampools
+
+type IPamPoolProperties struct {
+	AddressPrefixes   []string           `json:"addressPrefixes"`
+	Description       *string            `json:"description,omitempty"`
+	DisplayName       *string            `json:"displayName,omitempty"`
+	IPAddressType     *[]IPType          `json:"ipAddressType,omitempty"`
+	ParentPoolName    *string            `json:"parentPoolName,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,15 @@
+package staticcidrs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StaticCidrsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStaticCidrsClientWithBaseURI(endpoint string) StaticCidrsClient {
+	return StaticCidrsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
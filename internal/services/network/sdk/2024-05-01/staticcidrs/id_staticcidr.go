@@ -0,0 +1,150 @@
+package staticcidrs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = StaticCidrId{}
+
+// StaticCidrId is a struct representing the Resource ID for a Static Cidr
+type StaticCidrId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	NetworkManagerName string
+	PoolName           string
+	StaticCidrName     string
+}
+
+// NewStaticCidrID returns a new StaticCidrId struct
+func NewStaticCidrID(subscriptionId string, resourceGroupName string, networkManagerName string, poolName string, staticCidrName string) StaticCidrId {
+	return StaticCidrId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		NetworkManagerName: networkManagerName,
+		PoolName:           poolName,
+		StaticCidrName:     staticCidrName,
+	}
+}
+
+// ParseStaticCidrID parses 'input' into a StaticCidrId
+func ParseStaticCidrID(input string) (*StaticCidrId, error) {
+	parser := resourceids.NewParserFromResourceIdType(StaticCidrId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := StaticCidrId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkManagerName, ok = parsed.Parsed["networkManagerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkManagerName' was not found in the resource id %q", input)
+	}
+
+	if id.PoolName, ok = parsed.Parsed["poolName"]; !ok {
+		return nil, fmt.Errorf("the segment 'poolName' was not found in the resource id %q", input)
+	}
+
+	if id.StaticCidrName, ok = parsed.Parsed["staticCidrName"]; !ok {
+		return nil, fmt.Errorf("the segment 'staticCidrName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseStaticCidrIDInsensitively parses 'input' case-insensitively into a StaticCidrId
+// note: this method should only be used for API response data and not user input
+func ParseStaticCidrIDInsensitively(input string) (*StaticCidrId, error) {
+	parser := resourceids.NewParserFromResourceIdType(StaticCidrId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := StaticCidrId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkManagerName, ok = parsed.Parsed["networkManagerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkManagerName' was not found in the resource id %q", input)
+	}
+
+	if id.PoolName, ok = parsed.Parsed["poolName"]; !ok {
+		return nil, fmt.Errorf("the segment 'poolName' was not found in the resource id %q", input)
+	}
+
+	if id.StaticCidrName, ok = parsed.Parsed["staticCidrName"]; !ok {
+		return nil, fmt.Errorf("the segment 'staticCidrName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateStaticCidrID checks that 'input' can be parsed as a Static Cidr ID
+func ValidateStaticCidrID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseStaticCidrID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Static Cidr ID
+func (id StaticCidrId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/ipamPools/%s/staticCidrs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.PoolName, id.StaticCidrName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Static Cidr ID
+func (id StaticCidrId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticNetworkManagers", "networkManagers", "networkManagers"),
+		resourceids.UserSpecifiedSegment("networkManagerName", "networkManagerValue"),
+		resourceids.StaticSegment("staticIpamPools", "ipamPools", "ipamPools"),
+		resourceids.UserSpecifiedSegment("poolName", "poolValue"),
+		resourceids.StaticSegment("staticStaticCidrs", "staticCidrs", "staticCidrs"),
+		resourceids.UserSpecifiedSegment("staticCidrName", "staticCidrValue"),
+	}
+}
+
+// String returns a human-readable description of this Static Cidr ID
+func (id StaticCidrId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Network Manager Name: %q", id.NetworkManagerName),
+		fmt.Sprintf("Pool Name: %q", id.PoolName),
+		fmt.Sprintf("Static Cidr Name: %q", id.StaticCidrName),
+	}
+	return fmt.Sprintf("Static Cidr (%s)", strings.Join(components, "\n"))
+}
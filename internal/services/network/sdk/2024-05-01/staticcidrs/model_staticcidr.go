@@ -0,0 +1,8 @@
+package staticcidrs
+
+type StaticCidr struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *StaticCidrProperties `json:"properties,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package staticcidrs
+
+type StaticCidrProperties struct {
+	AddressPrefixes               *[]string          `json:"addressPrefixes,omitempty"`
+	Description                   *string            `json:"description,omitempty"`
+	NumberOfIPAddressesToAllocate *string            `json:"numberOfIPAddressesToAllocate,omitempty"`
+	ProvisioningState             *ProvisioningState `json:"provisioningState,omitempty"`
+	TotalNumberOfIPAddresses      *string            `json:"totalNumberOfIPAddresses,omitempty"`
+}
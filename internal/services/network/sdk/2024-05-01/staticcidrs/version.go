@@ -0,0 +1,9 @@
+package staticcidrs
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/staticcidrs/%s", defaultApiVersion)
+}
@@ -0,0 +1,5 @@
+package subnets
+
+type IPamPoolPrefixAllocationPool struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,10 @@
+package subnets
+
+type NetworkSecurityGroup struct {
+	Etag     *string            `json:"etag,omitempty"`
+	Id       *string            `json:"id,omitempty"`
+	Location *string            `json:"location,omitempty"`
+	Name     *string            `json:"name,omitempty"`
+	Tags     *map[string]string `json:"tags,omitempty"`
+	Type     *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package subnets
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
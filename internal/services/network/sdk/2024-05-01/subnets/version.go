@@ -0,0 +1,9 @@
+package subnets
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/subnets/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package virtualnetworks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualNetworksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualNetworksClientWithBaseURI(endpoint string) VirtualNetworksClient {
+	return VirtualNetworksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package virtualnetworks
+
+type AddressSpace struct {
+	AddressPrefixes           *[]string                   `json:"addressPrefixes,omitempty"`
+	IPamPoolPrefixAllocations *[]IPamPoolPrefixAllocation `json:"ipamPoolPrefixAllocations,omitempty"`
+}
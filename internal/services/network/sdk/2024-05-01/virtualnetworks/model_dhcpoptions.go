@@ -0,0 +1,5 @@
+package virtualnetworks
+
+type DhcpOptions struct {
+	DnsServers *[]string `json:"dnsServers,omitempty"`
+}
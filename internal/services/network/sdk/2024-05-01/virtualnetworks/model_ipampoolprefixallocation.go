@@ -0,0 +1,7 @@
+package virtualnetworks
+
+type IPamPoolPrefixAllocation struct {
+	AllocatedAddressPrefixes *[]string                     `json:"allocatedAddressPrefixes,omitempty"`
+	NumberOfIPAddresses      *string                       `json:"numberOfIpAddresses,omitempty"`
+	Pool                     *IPamPoolPrefixAllocationPool `json:"pool,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualnetworks
+
+type IPamPoolPrefixAllocationPool struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,9 @@
+package virtualnetworks
+
+type Subnet struct {
+	Etag       *string                 `json:"etag,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *SubnetPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
@@ -0,0 +1,19 @@
+package virtualnetworks
+
+type SubnetPropertiesFormat struct {
+	AddressPrefix                     *string                                          `json:"addressPrefix,omitempty"`
+	AddressPrefixes                   *[]string                                        `json:"addressPrefixes,omitempty"`
+	DefaultOutboundAccess             *bool                                            `json:"defaultOutboundAccess,omitempty"`
+	Delegations                       *[]Delegation                                    `json:"delegations,omitempty"`
+	IPAllocations                     *[]SubResource                                   `json:"ipAllocations,omitempty"`
+	IPamPoolPrefixAllocations         *[]IPamPoolPrefixAllocation                      `json:"ipamPoolPrefixAllocations,omitempty"`
+	NatGateway                        *SubResource                                     `json:"natGateway,omitempty"`
+	NetworkSecurityGroup              *NetworkSecurityGroup                            `json:"networkSecurityGroup,omitempty"`
+	PrivateEndpointNetworkPolicies    *VirtualNetworkPrivateEndpointNetworkPolicies    `json:"privateEndpointNetworkPolicies,omitempty"`
+	PrivateLinkServiceNetworkPolicies *VirtualNetworkPrivateLinkServiceNetworkPolicies `json:"privateLinkServiceNetworkPolicies,omitempty"`
+	ProvisioningState                 *ProvisioningState                               `json:"provisioningState,omitempty"`
+	Purpose                           *string                                          `json:"purpose,omitempty"`
+	RouteTable                        *RouteTable                                      `json:"routeTable,omitempty"`
+	ServiceEndpointPolicies           *[]ServiceEndpointPolicy                         `json:"serviceEndpointPolicies,omitempty"`
+	ServiceEndpoints                  *[]ServiceEndpointPropertiesFormat               `json:"serviceEndpoints,omitempty"`
+}
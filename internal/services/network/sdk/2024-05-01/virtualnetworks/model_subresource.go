@@ -0,0 +1,5 @@
+package virtualnetworks
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,6 @@
+package virtualnetworks
+
+type VirtualNetworkBgpCommunities struct {
+	RegionalCommunity       *string `json:"regionalCommunity,omitempty"`
+	VirtualNetworkCommunity string  `json:"virtualNetworkCommunity"`
+}
@@ -0,0 +1,14 @@
+package virtualnetworks
+
+type VirtualNetworkPropertiesFormat struct {
+	AddressSpace         *AddressSpace                 `json:"addressSpace,omitempty"`
+	BgpCommunities       *VirtualNetworkBgpCommunities `json:"bgpCommunities,omitempty"`
+	DdosProtectionPlan   *SubResource                  `json:"ddosProtectionPlan,omitempty"`
+	DhcpOptions          *DhcpOptions                  `json:"dhcpOptions,omitempty"`
+	EnableDdosProtection *bool                         `json:"enableDdosProtection,omitempty"`
+	EnableVMProtection   *bool                         `json:"enableVmProtection,omitempty"`
+	FlowTimeoutInMinutes *int64                        `json:"flowTimeoutInMinutes,omitempty"`
+	ProvisioningState    *ProvisioningState            `json:"provisioningState,omitempty"`
+	ResourceGuid         *string                       `json:"resourceGuid,omitempty"`
+	Subnets              *[]Subnet                     `json:"subnets,omitempty"`
+}
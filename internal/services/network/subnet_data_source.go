@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/subnets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -47,6 +48,32 @@ func dataSourceSubnet() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"ip_address_pool": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"number_of_ip_addresses": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"allocated_ip_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			"network_security_group_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -79,54 +106,69 @@ func dataSourceSubnet() *pluginsdk.Resource {
 }
 
 func dataSourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network.VirtualNetworkSubnetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewSubnetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string), d.Get("name").(string))
-	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	id := subnets.NewSubnetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string), d.Get("name").(string))
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("Error: %s was not found", id)
 		}
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
-	d.Set("name", id.Name)
+	d.Set("name", id.SubnetName)
 	d.Set("virtual_network_name", id.VirtualNetworkName)
-	d.Set("resource_group_name", id.ResourceGroup)
-
-	if props := resp.SubnetPropertiesFormat; props != nil {
-		d.Set("address_prefix", props.AddressPrefix)
-		if props.AddressPrefixes == nil {
-			if props.AddressPrefix != nil && len(*props.AddressPrefix) > 0 {
-				d.Set("address_prefixes", []string{*props.AddressPrefix})
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("address_prefix", props.AddressPrefix)
+			if props.AddressPrefixes == nil {
+				if props.AddressPrefix != nil && len(*props.AddressPrefix) > 0 {
+					d.Set("address_prefixes", []string{*props.AddressPrefix})
+				} else {
+					d.Set("address_prefixes", []string{})
+				}
 			} else {
-				d.Set("address_prefixes", []string{})
+				d.Set("address_prefixes", utils.FlattenStringSlice(props.AddressPrefixes))
 			}
-		} else {
-			d.Set("address_prefixes", utils.FlattenStringSlice(props.AddressPrefixes))
-		}
 
-		d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateEndpointNetworkPolicies)))
-		d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateLinkServiceNetworkPolicies)))
+			if err := d.Set("ip_address_pool", flattenSubnetIPAddressPool(props.IPamPoolPrefixAllocations)); err != nil {
+				return fmt.Errorf("setting `ip_address_pool`: %+v", err)
+			}
 
-		networkSecurityGroupId := ""
-		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
-			networkSecurityGroupId = *props.NetworkSecurityGroup.ID
-		}
-		d.Set("network_security_group_id", networkSecurityGroupId)
+			privateEndpointNetworkPolicies := ""
+			if props.PrivateEndpointNetworkPolicies != nil {
+				privateEndpointNetworkPolicies = string(*props.PrivateEndpointNetworkPolicies)
+			}
+			d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
 
-		routeTableId := ""
-		if props.RouteTable != nil && props.RouteTable.ID != nil {
-			routeTableId = *props.RouteTable.ID
-		}
-		d.Set("route_table_id", routeTableId)
+			privateLinkServiceNetworkPolicies := ""
+			if props.PrivateLinkServiceNetworkPolicies != nil {
+				privateLinkServiceNetworkPolicies = string(*props.PrivateLinkServiceNetworkPolicies)
+			}
+			d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
+
+			networkSecurityGroupId := ""
+			if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.Id != nil {
+				networkSecurityGroupId = *props.NetworkSecurityGroup.Id
+			}
+			d.Set("network_security_group_id", networkSecurityGroupId)
+
+			routeTableId := ""
+			if props.RouteTable != nil && props.RouteTable.Id != nil {
+				routeTableId = *props.RouteTable.Id
+			}
+			d.Set("route_table_id", routeTableId)
 
-		if err := d.Set("service_endpoints", flattenSubnetServiceEndpoints(props.ServiceEndpoints)); err != nil {
-			return fmt.Errorf("setting `service_endpoints`: %+v", err)
+			if err := d.Set("service_endpoints", flattenSubnetServiceEndpoints(props.ServiceEndpoints)); err != nil {
+				return fmt.Errorf("setting `service_endpoints`: %+v", err)
+			}
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/ipampools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/subnets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -30,7 +33,7 @@ func resourceSubnet() *pluginsdk.Resource {
 		Update: resourceSubnetUpdate,
 		Delete: resourceSubnetDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SubnetID(id)
+			_, err := subnets.ParseSubnetID(id)
 			return err
 		}),
 
@@ -62,7 +65,7 @@ func resourceSubnet() *pluginsdk.Resource {
 				Computed: true,
 				// TODO Remove this in the next major version release
 				Deprecated:   "Use the `address_prefixes` property instead.",
-				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "ip_address_pool"},
 			},
 
 			"address_prefixes": {
@@ -74,7 +77,37 @@ func resourceSubnet() *pluginsdk.Resource {
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
-				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "ip_address_pool"},
+			},
+
+			"ip_address_pool": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "ip_address_pool"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: ipampools.ValidateIPamPoolID,
+						},
+
+						"number_of_ip_addresses": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "`number_of_ip_addresses` must be a positive integer"),
+						},
+
+						"allocated_ip_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
 			},
 
 			"service_endpoints": {
@@ -183,7 +216,8 @@ func resourceSubnet() *pluginsdk.Resource {
 
 // TODO: refactor the create/flatten functions
 func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network.VirtualNetworkSubnetsClient
+	subnetClient := meta.(*clients.Client).Network.SubnetsClient
 	vnetClient := meta.(*clients.Client).Network.VnetClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -191,22 +225,22 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
-	id := parse.NewSubnetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string), d.Get("name").(string))
-	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	id := subnets.NewSubnetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
 
-	if !utils.ResponseWasNotFound(existing.Response) {
+	if !response.WasNotFound(existing.HttpResponse) {
 		return tf.ImportAsExistsError("azurerm_subnet", id.ID())
 	}
 
 	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	properties := network.SubnetPropertiesFormat{}
+	properties := subnets.SubnetPropertiesFormat{}
 	if value, ok := d.GetOk("address_prefixes"); ok {
 		var addressPrefixes []string
 		for _, item := range value.([]interface{}) {
@@ -222,13 +256,18 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		properties.AddressPrefix = &(*properties.AddressPrefixes)[0]
 		properties.AddressPrefixes = nil
 	}
+	if value, ok := d.GetOk("ip_address_pool"); ok {
+		properties.IPamPoolPrefixAllocations = expandSubnetIPAddressPool(value.([]interface{}))
+	}
 
 	// To enable private endpoints you must disable the network policies for the subnet because
 	// Network policies like network security groups are not supported by private endpoints.
 	privateEndpointNetworkPolicies := d.Get("enforce_private_link_endpoint_network_policies").(bool)
 	privateLinkServiceNetworkPolicies := d.Get("enforce_private_link_service_network_policies").(bool)
-	properties.PrivateEndpointNetworkPolicies = network.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
-	properties.PrivateLinkServiceNetworkPolicies = network.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
+	privateEndpointNetworkPoliciesValue := subnets.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
+	privateLinkServiceNetworkPoliciesValue := subnets.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
+	properties.PrivateEndpointNetworkPolicies = &privateEndpointNetworkPoliciesValue
+	properties.PrivateLinkServiceNetworkPolicies = &privateLinkServiceNetworkPoliciesValue
 
 	serviceEndpointsRaw := d.Get("service_endpoints").([]interface{})
 	properties.ServiceEndpoints = expandSubnetServiceEndpoints(serviceEndpointsRaw)
@@ -239,26 +278,21 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	delegationsRaw := d.Get("delegation").([]interface{})
 	properties.Delegations = expandSubnetDelegation(delegationsRaw)
 
-	subnet := network.Subnet{
-		Name:                   utils.String(id.Name),
-		SubnetPropertiesFormat: &properties,
+	subnet := subnets.Subnet{
+		Name:       utils.String(id.SubnetName),
+		Properties: &properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, subnet); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
-	}
-
 	timeout, _ := ctx.Deadline()
 
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{string(network.ProvisioningStateUpdating)},
 		Target:     []string{string(network.ProvisioningStateSucceeded)},
-		Refresh:    SubnetProvisioningStateRefreshFunc(ctx, client, id),
+		Refresh:    SubnetProvisioningStateRefreshFunc(ctx, subnetClient, parse.NewSubnetID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName, id.SubnetName)),
 		MinTimeout: 1 * time.Minute,
 		Timeout:    time.Until(timeout),
 	}
@@ -266,7 +300,7 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("waiting for provisioning state of %s: %+v", id, err)
 	}
 
-	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
 	vnetStateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{string(network.ProvisioningStateUpdating)},
 		Target:     []string{string(network.ProvisioningStateSucceeded)},
@@ -283,12 +317,13 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network.VirtualNetworkSubnetsClient
+	subnetClient := meta.(*clients.Client).Network.SubnetsClient
 	vnetClient := meta.(*clients.Client).Network.VnetClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SubnetID(d.Id())
+	id, err := subnets.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -296,21 +331,21 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	locks.ByName(id.SubnetName, SubnetResourceName)
+	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	existing, err := client.Get(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	if existing.SubnetPropertiesFormat == nil {
+	if existing.Model == nil || existing.Model.Properties == nil {
 		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
 	}
 
 	// TODO: locking on the NSG/Route Table if applicable
 
-	props := *existing.SubnetPropertiesFormat
+	props := *existing.Model.Properties
 
 	if d.HasChange("address_prefix") {
 		props.AddressPrefix = utils.String(d.Get("address_prefix").(string))
@@ -333,19 +368,27 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("ip_address_pool") {
+		// the prefixes allocated from the IPAM Pool are returned as the address prefix(es) of the Subnet
+		// so these need to be cleared for the service to re-allocate them
+		props.IPamPoolPrefixAllocations = expandSubnetIPAddressPool(d.Get("ip_address_pool").([]interface{}))
+		props.AddressPrefix = nil
+		props.AddressPrefixes = nil
+	}
+
 	if d.HasChange("delegation") {
 		delegationsRaw := d.Get("delegation").([]interface{})
 		props.Delegations = expandSubnetDelegation(delegationsRaw)
 	}
 
 	if d.HasChange("enforce_private_link_endpoint_network_policies") {
-		v := d.Get("enforce_private_link_endpoint_network_policies").(bool)
-		props.PrivateEndpointNetworkPolicies = network.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(v))
+		v := subnets.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(d.Get("enforce_private_link_endpoint_network_policies").(bool)))
+		props.PrivateEndpointNetworkPolicies = &v
 	}
 
 	if d.HasChange("enforce_private_link_service_network_policies") {
-		v := d.Get("enforce_private_link_service_network_policies").(bool)
-		props.PrivateLinkServiceNetworkPolicies = network.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(v))
+		v := subnets.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(d.Get("enforce_private_link_service_network_policies").(bool)))
+		props.PrivateLinkServiceNetworkPolicies = &v
 	}
 
 	if d.HasChange("service_endpoints") {
@@ -358,26 +401,21 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		props.ServiceEndpointPolicies = expandSubnetServiceEndpointPolicies(serviceEndpointPoliciesRaw)
 	}
 
-	subnet := network.Subnet{
-		Name:                   utils.String(id.Name),
-		SubnetPropertiesFormat: &props,
+	subnet := subnets.Subnet{
+		Name:       utils.String(id.SubnetName),
+		Properties: &props,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, subnet); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
-	}
-
 	timeout, _ := ctx.Deadline()
 
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{string(network.ProvisioningStateUpdating)},
 		Target:     []string{string(network.ProvisioningStateSucceeded)},
-		Refresh:    SubnetProvisioningStateRefreshFunc(ctx, client, *id),
+		Refresh:    SubnetProvisioningStateRefreshFunc(ctx, subnetClient, parse.NewSubnetID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName, id.SubnetName)),
 		MinTimeout: 1 * time.Minute,
 		Timeout:    time.Until(timeout),
 	}
@@ -385,7 +423,7 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("waiting for provisioning state of %s: %+v", id, err)
 	}
 
-	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
 	vnetStateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{string(network.ProvisioningStateUpdating)},
 		Target:     []string{string(network.ProvisioningStateSucceeded)},
@@ -401,56 +439,71 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network.VirtualNetworkSubnetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SubnetID(d.Id())
+	id, err := subnets.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
+	d.Set("name", id.SubnetName)
 	d.Set("virtual_network_name", id.VirtualNetworkName)
-	d.Set("resource_group_name", id.ResourceGroup)
-
-	if props := resp.SubnetPropertiesFormat; props != nil {
-		d.Set("address_prefix", props.AddressPrefix)
-		if props.AddressPrefixes == nil {
-			if props.AddressPrefix != nil && len(*props.AddressPrefix) > 0 {
-				d.Set("address_prefixes", []string{*props.AddressPrefix})
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("address_prefix", props.AddressPrefix)
+			if props.AddressPrefixes == nil {
+				if props.AddressPrefix != nil && len(*props.AddressPrefix) > 0 {
+					d.Set("address_prefixes", []string{*props.AddressPrefix})
+				} else {
+					d.Set("address_prefixes", []string{})
+				}
 			} else {
-				d.Set("address_prefixes", []string{})
+				d.Set("address_prefixes", props.AddressPrefixes)
 			}
-		} else {
-			d.Set("address_prefixes", props.AddressPrefixes)
-		}
 
-		delegation := flattenSubnetDelegation(props.Delegations)
-		if err := d.Set("delegation", delegation); err != nil {
-			return fmt.Errorf("flattening `delegation`: %+v", err)
-		}
+			if err := d.Set("ip_address_pool", flattenSubnetIPAddressPool(props.IPamPoolPrefixAllocations)); err != nil {
+				return fmt.Errorf("setting `ip_address_pool`: %+v", err)
+			}
 
-		d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateEndpointNetworkPolicies)))
-		d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateLinkServiceNetworkPolicies)))
+			delegation := flattenSubnetDelegation(props.Delegations)
+			if err := d.Set("delegation", delegation); err != nil {
+				return fmt.Errorf("flattening `delegation`: %+v", err)
+			}
 
-		serviceEndpoints := flattenSubnetServiceEndpoints(props.ServiceEndpoints)
-		if err := d.Set("service_endpoints", serviceEndpoints); err != nil {
-			return fmt.Errorf("setting `service_endpoints`: %+v", err)
-		}
+			privateEndpointNetworkPolicies := ""
+			if props.PrivateEndpointNetworkPolicies != nil {
+				privateEndpointNetworkPolicies = string(*props.PrivateEndpointNetworkPolicies)
+			}
+			d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
+
+			privateLinkServiceNetworkPolicies := ""
+			if props.PrivateLinkServiceNetworkPolicies != nil {
+				privateLinkServiceNetworkPolicies = string(*props.PrivateLinkServiceNetworkPolicies)
+			}
+			d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
+
+			serviceEndpoints := flattenSubnetServiceEndpoints(props.ServiceEndpoints)
+			if err := d.Set("service_endpoints", serviceEndpoints); err != nil {
+				return fmt.Errorf("setting `service_endpoints`: %+v", err)
+			}
 
-		serviceEndpointPolicies := flattenSubnetServiceEndpointPolicies(props.ServiceEndpointPolicies)
-		if err := d.Set("service_endpoint_policy_ids", serviceEndpointPolicies); err != nil {
-			return fmt.Errorf("setting `service_endpoint_policy_ids`: %+v", err)
+			serviceEndpointPolicies := flattenSubnetServiceEndpointPolicies(props.ServiceEndpointPolicies)
+			if err := d.Set("service_endpoint_policy_ids", serviceEndpointPolicies); err != nil {
+				return fmt.Errorf("setting `service_endpoint_policy_ids`: %+v", err)
+			}
 		}
 	}
 
@@ -458,11 +511,11 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network.VirtualNetworkSubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SubnetID(d.Id())
+	id, err := subnets.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -470,16 +523,19 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	locks.ByName(id.SubnetName, SubnetResourceName)
+	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+	future, err := client.Delete(ctx, *id)
 	if err != nil {
-		return fmt.Errorf("deleting %s: %+v", *id, err)
+		if !response.WasNotFound(future.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+		return nil
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
+	if err = future.Poller.PollUntilDone(); err != nil {
+		if !response.WasNotFound(future.Poller.HttpResponse) {
 			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
 		}
 	}
@@ -487,12 +543,51 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func expandSubnetServiceEndpoints(input []interface{}) *[]network.ServiceEndpointPropertiesFormat {
-	endpoints := make([]network.ServiceEndpointPropertiesFormat, 0)
+func expandSubnetIPAddressPool(input []interface{}) *[]subnets.IPamPoolPrefixAllocation {
+	results := make([]subnets.IPamPoolPrefixAllocation, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, subnets.IPamPoolPrefixAllocation{
+			NumberOfIPAddresses: utils.String(v["number_of_ip_addresses"].(string)),
+			Pool: &subnets.IPamPoolPrefixAllocationPool{
+				Id: utils.String(v["id"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenSubnetIPAddressPool(input *[]subnets.IPamPoolPrefixAllocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		poolId := ""
+		if item.Pool != nil && item.Pool.Id != nil {
+			poolId = *item.Pool.Id
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                            poolId,
+			"number_of_ip_addresses":        utils.NormalizeNilableString(item.NumberOfIPAddresses),
+			"allocated_ip_address_prefixes": utils.FlattenStringSlice(item.AllocatedAddressPrefixes),
+		})
+	}
+
+	return results
+}
+
+func expandSubnetServiceEndpoints(input []interface{}) *[]subnets.ServiceEndpointPropertiesFormat {
+	endpoints := make([]subnets.ServiceEndpointPropertiesFormat, 0)
 
 	for _, svcEndpointRaw := range input {
 		if svc, ok := svcEndpointRaw.(string); ok {
-			endpoint := network.ServiceEndpointPropertiesFormat{
+			endpoint := subnets.ServiceEndpointPropertiesFormat{
 				Service: &svc,
 			}
 			endpoints = append(endpoints, endpoint)
@@ -502,7 +597,7 @@ func expandSubnetServiceEndpoints(input []interface{}) *[]network.ServiceEndpoin
 	return &endpoints
 }
 
-func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPropertiesFormat) []string {
+func flattenSubnetServiceEndpoints(serviceEndpoints *[]subnets.ServiceEndpointPropertiesFormat) []string {
 	endpoints := make([]string, 0)
 
 	if serviceEndpoints == nil {
@@ -518,8 +613,8 @@ func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPr
 	return endpoints
 }
 
-func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
-	retDelegations := make([]network.Delegation, 0)
+func expandSubnetDelegation(input []interface{}) *[]subnets.Delegation {
+	retDelegations := make([]subnets.Delegation, 0)
 
 	for _, deleValue := range input {
 		deleData := deleValue.(map[string]interface{})
@@ -535,9 +630,9 @@ func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
 			retSrvActions = append(retSrvActions, srvActionData)
 		}
 
-		retDelegation := network.Delegation{
+		retDelegation := subnets.Delegation{
 			Name: &deleName,
-			ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{
+			Properties: &subnets.ServiceDelegationPropertiesFormat{
 				ServiceName: &srvName,
 				Actions:     &retSrvActions,
 			},
@@ -549,7 +644,7 @@ func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
 	return &retDelegations
 }
 
-func flattenSubnetDelegation(delegations *[]network.Delegation) []interface{} {
+func flattenSubnetDelegation(delegations *[]subnets.Delegation) []interface{} {
 	if delegations == nil {
 		return []interface{}{}
 	}
@@ -564,7 +659,7 @@ func flattenSubnetDelegation(delegations *[]network.Delegation) []interface{} {
 
 		svcDeles := make([]interface{}, 0)
 		svcDele := make(map[string]interface{})
-		if props := dele.ServiceDelegationPropertiesFormat; props != nil {
+		if props := dele.Properties; props != nil {
 			if v := props.ServiceName; v != nil {
 				svcDele["name"] = *v
 			}
@@ -604,16 +699,16 @@ func flattenSubnetPrivateLinkNetworkPolicy(input string) bool {
 	return strings.EqualFold(input, "Disabled")
 }
 
-func expandSubnetServiceEndpointPolicies(input []interface{}) *[]network.ServiceEndpointPolicy {
-	output := make([]network.ServiceEndpointPolicy, 0)
+func expandSubnetServiceEndpointPolicies(input []interface{}) *[]subnets.ServiceEndpointPolicy {
+	output := make([]subnets.ServiceEndpointPolicy, 0)
 	for _, policy := range input {
 		policy := policy.(string)
-		output = append(output, network.ServiceEndpointPolicy{ID: &policy})
+		output = append(output, subnets.ServiceEndpointPolicy{Id: &policy})
 	}
 	return &output
 }
 
-func flattenSubnetServiceEndpointPolicies(input *[]network.ServiceEndpointPolicy) []interface{} {
+func flattenSubnetServiceEndpointPolicies(input *[]subnets.ServiceEndpointPolicy) []interface{} {
 	if input == nil {
 		return nil
 	}
@@ -621,8 +716,8 @@ func flattenSubnetServiceEndpointPolicies(input *[]network.ServiceEndpointPolicy
 	var output []interface{}
 	for _, policy := range *input {
 		id := ""
-		if policy.ID != nil {
-			id = *policy.ID
+		if policy.Id != nil {
+			id = *policy.Id
 		}
 		output = append(output, id)
 	}
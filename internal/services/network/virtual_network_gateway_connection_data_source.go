@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/virtualnetworkgatewayconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -113,6 +114,11 @@ func dataSourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"private_link_fast_path_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"resource_guid": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -189,31 +195,32 @@ func dataSourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 
 func dataSourceVirtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resGroup := d.Get("resource_group_name").(string)
+	id := virtualnetworkgatewayconnections.NewConnectionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, resGroup, name)
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("Virtual Network Gateway Connection %q (Resource Group %q) was not found", name, resGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
 		}
 
-		return fmt.Errorf("making Read request on AzureRM Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	d.SetId(*resp.ID)
+	d.SetId(id.ID())
 
-	d.Set("name", resp.Name)
-	d.Set("resource_group_name", resGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
+	d.Set("name", id.ConnectionName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if resp.VirtualNetworkGatewayConnectionPropertiesFormat != nil {
-		gwc := *resp.VirtualNetworkGatewayConnectionPropertiesFormat
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
+
+		gwc := model.Properties
 
 		d.Set("shared_key", gwc.SharedKey)
 		d.Set("authorization_key", gwc.AuthorizationKey)
@@ -222,24 +229,28 @@ func dataSourceVirtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, me
 		d.Set("egress_bytes_transferred", gwc.EgressBytesTransferred)
 		d.Set("use_policy_based_traffic_selectors", gwc.UsePolicyBasedTrafficSelectors)
 		d.Set("express_route_gateway_bypass", gwc.ExpressRouteGatewayBypass)
+		d.Set("private_link_fast_path_enabled", utils.NormaliseNilableBool(gwc.EnablePrivateLinkFastPath))
 		d.Set("type", string(gwc.ConnectionType))
-		d.Set("connection_protocol", string(gwc.ConnectionProtocol))
 		d.Set("routing_weight", gwc.RoutingWeight)
 
-		if gwc.VirtualNetworkGateway1 != nil {
-			d.Set("virtual_network_gateway_id", gwc.VirtualNetworkGateway1.ID)
+		connectionProtocol := ""
+		if gwc.ConnectionProtocol != nil {
+			connectionProtocol = string(*gwc.ConnectionProtocol)
 		}
+		d.Set("connection_protocol", connectionProtocol)
+
+		d.Set("virtual_network_gateway_id", gwc.VirtualNetworkGateway1.Id)
 
 		if gwc.VirtualNetworkGateway2 != nil {
-			d.Set("peer_virtual_network_gateway_id", gwc.VirtualNetworkGateway2.ID)
+			d.Set("peer_virtual_network_gateway_id", gwc.VirtualNetworkGateway2.Id)
 		}
 
 		if gwc.LocalNetworkGateway2 != nil {
-			d.Set("local_network_gateway_id", gwc.LocalNetworkGateway2.ID)
+			d.Set("local_network_gateway_id", gwc.LocalNetworkGateway2.Id)
 		}
 
 		if gwc.Peer != nil {
-			d.Set("express_route_circuit_id", gwc.Peer.ID)
+			d.Set("express_route_circuit_id", gwc.Peer.Id)
 		}
 
 		if gwc.DpdTimeoutSeconds != nil {
@@ -250,9 +261,9 @@ func dataSourceVirtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, me
 			d.Set("local_azure_ip_address_enabled", gwc.UseLocalAzureIPAddress)
 		}
 
-		d.Set("resource_guid", gwc.ResourceGUID)
+		d.Set("resource_guid", gwc.ResourceGuid)
 
-		ipsecPoliciesSettingsFlat := flattenVirtualNetworkGatewayConnectionDataSourceIpsecPolicies(gwc.IpsecPolicies)
+		ipsecPoliciesSettingsFlat := flattenVirtualNetworkGatewayConnectionDataSourceIpsecPolicies(gwc.IPsecPolicies)
 		if err := d.Set("ipsec_policy", ipsecPoliciesSettingsFlat); err != nil {
 			return fmt.Errorf("setting `ipsec_policy`: %+v", err)
 		}
@@ -266,7 +277,7 @@ func dataSourceVirtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, me
 	return nil
 }
 
-func flattenVirtualNetworkGatewayConnectionDataSourceIpsecPolicies(ipsecPolicies *[]network.IpsecPolicy) []interface{} {
+func flattenVirtualNetworkGatewayConnectionDataSourceIpsecPolicies(ipsecPolicies *[]virtualnetworkgatewayconnections.IPsecPolicy) []interface{} {
 	schemaIpsecPolicies := make([]interface{}, 0)
 
 	if ipsecPolicies != nil {
@@ -276,17 +287,11 @@ func flattenVirtualNetworkGatewayConnectionDataSourceIpsecPolicies(ipsecPolicies
 			schemaIpsecPolicy["dh_group"] = string(ipsecPolicy.DhGroup)
 			schemaIpsecPolicy["ike_encryption"] = string(ipsecPolicy.IkeEncryption)
 			schemaIpsecPolicy["ike_integrity"] = string(ipsecPolicy.IkeIntegrity)
-			schemaIpsecPolicy["ipsec_encryption"] = string(ipsecPolicy.IpsecEncryption)
-			schemaIpsecPolicy["ipsec_integrity"] = string(ipsecPolicy.IpsecIntegrity)
+			schemaIpsecPolicy["ipsec_encryption"] = string(ipsecPolicy.IPsecEncryption)
+			schemaIpsecPolicy["ipsec_integrity"] = string(ipsecPolicy.IPsecIntegrity)
 			schemaIpsecPolicy["pfs_group"] = string(ipsecPolicy.PfsGroup)
-
-			if ipsecPolicy.SaDataSizeKilobytes != nil {
-				schemaIpsecPolicy["sa_datasize"] = int(*ipsecPolicy.SaDataSizeKilobytes)
-			}
-
-			if ipsecPolicy.SaLifeTimeSeconds != nil {
-				schemaIpsecPolicy["sa_lifetime"] = int(*ipsecPolicy.SaLifeTimeSeconds)
-			}
+			schemaIpsecPolicy["sa_datasize"] = int(ipsecPolicy.SaDataSizeKilobytes)
+			schemaIpsecPolicy["sa_lifetime"] = int(ipsecPolicy.SaLifeTimeSeconds)
 
 			schemaIpsecPolicies = append(schemaIpsecPolicies, schemaIpsecPolicy)
 		}
@@ -295,14 +300,14 @@ func flattenVirtualNetworkGatewayConnectionDataSourceIpsecPolicies(ipsecPolicies
 	return schemaIpsecPolicies
 }
 
-func flattenVirtualNetworkGatewayConnectionDataSourcePolicyTrafficSelectors(trafficSelectorPolicies *[]network.TrafficSelectorPolicy) []interface{} {
+func flattenVirtualNetworkGatewayConnectionDataSourcePolicyTrafficSelectors(trafficSelectorPolicies *[]virtualnetworkgatewayconnections.TrafficSelectorPolicy) []interface{} {
 	schemaTrafficSelectorPolicies := make([]interface{}, 0)
 
 	if trafficSelectorPolicies != nil {
 		for _, trafficSelectorPolicy := range *trafficSelectorPolicies {
 			schemaTrafficSelectorPolicies = append(schemaTrafficSelectorPolicies, map[string]interface{}{
-				"local_address_cidrs":  utils.FlattenStringSlice(trafficSelectorPolicy.LocalAddressRanges),
-				"remote_address_cidrs": utils.FlattenStringSlice(trafficSelectorPolicy.RemoteAddressRanges),
+				"local_address_cidrs":  trafficSelectorPolicy.LocalAddressRanges,
+				"remote_address_cidrs": trafficSelectorPolicy.RemoteAddressRanges,
 			})
 		}
 	}
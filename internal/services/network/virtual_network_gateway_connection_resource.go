@@ -5,11 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-11-01/virtualnetworkgatewayconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -26,7 +28,7 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 		Delete: resourceVirtualNetworkGatewayConnectionDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.NetworkGatewayConnectionID(id)
+			_, err := virtualnetworkgatewayconnections.ParseConnectionID(id)
 			return err
 		}),
 
@@ -54,9 +56,9 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.VirtualNetworkGatewayConnectionTypeExpressRoute),
-					string(network.VirtualNetworkGatewayConnectionTypeIPsec),
-					string(network.VirtualNetworkGatewayConnectionTypeVnet2Vnet),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionTypeExpressRoute),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionTypeIPsec),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionTypeVnetTwoVnet),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
@@ -138,14 +140,21 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"private_link_fast_path_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"connection_protocol": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.VirtualNetworkGatewayConnectionProtocolIKEv1),
-					string(network.VirtualNetworkGatewayConnectionProtocolIKEv2),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionProtocolIKEvOne),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionProtocolIKEvTwo),
 				}, false),
 			},
 
@@ -154,11 +163,11 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Optional: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.VirtualNetworkGatewayConnectionModeInitiatorOnly),
-					string(network.VirtualNetworkGatewayConnectionModeResponderOnly),
-					string(network.VirtualNetworkGatewayConnectionModeDefault),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionModeInitiatorOnly),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionModeResponderOnly),
+					string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionModeDefault),
 				}, false),
-				Default: string(network.VirtualNetworkGatewayConnectionModeDefault),
+				Default: string(virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionModeDefault),
 			},
 
 			"traffic_selector_policy": {
@@ -196,14 +205,14 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 							Required:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.DhGroupDHGroup1),
-								string(network.DhGroupDHGroup14),
-								string(network.DhGroupDHGroup2),
-								string(network.DhGroupDHGroup2048),
-								string(network.DhGroupDHGroup24),
-								string(network.DhGroupECP256),
-								string(network.DhGroupECP384),
-								string(network.DhGroupNone),
+								string(virtualnetworkgatewayconnections.DhGroupDHGroupOne),
+								string(virtualnetworkgatewayconnections.DhGroupDHGroupOneFour),
+								string(virtualnetworkgatewayconnections.DhGroupDHGroupTwo),
+								string(virtualnetworkgatewayconnections.DhGroupDHGroupTwoZeroFourEight),
+								string(virtualnetworkgatewayconnections.DhGroupDHGroupTwoFour),
+								string(virtualnetworkgatewayconnections.DhGroupECPTwoFiveSix),
+								string(virtualnetworkgatewayconnections.DhGroupECPThreeEightFour),
+								string(virtualnetworkgatewayconnections.DhGroupNone),
 							}, true),
 						},
 
@@ -212,13 +221,13 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 							Required:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IkeEncryptionAES128),
-								string(network.IkeEncryptionAES192),
-								string(network.IkeEncryptionAES256),
-								string(network.IkeEncryptionDES),
-								string(network.IkeEncryptionDES3),
-								string(network.IkeEncryptionGCMAES128),
-								string(network.IkeEncryptionGCMAES256),
+								string(virtualnetworkgatewayconnections.IkeEncryptionAESOneTwoEight),
+								string(virtualnetworkgatewayconnections.IkeEncryptionAESOneNineTwo),
+								string(virtualnetworkgatewayconnections.IkeEncryptionAESTwoFiveSix),
+								string(virtualnetworkgatewayconnections.IkeEncryptionDES),
+								string(virtualnetworkgatewayconnections.IkeEncryptionDESThree),
+								string(virtualnetworkgatewayconnections.IkeEncryptionGCMAESOneTwoEight),
+								string(virtualnetworkgatewayconnections.IkeEncryptionGCMAESTwoFiveSix),
 							}, true),
 						},
 
@@ -227,12 +236,12 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 							Required:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IkeIntegrityGCMAES128),
-								string(network.IkeIntegrityGCMAES256),
-								string(network.IkeIntegrityMD5),
-								string(network.IkeIntegritySHA1),
-								string(network.IkeIntegritySHA256),
-								string(network.IkeIntegritySHA384),
+								string(virtualnetworkgatewayconnections.IkeIntegrityGCMAESOneTwoEight),
+								string(virtualnetworkgatewayconnections.IkeIntegrityGCMAESTwoFiveSix),
+								string(virtualnetworkgatewayconnections.IkeIntegrityMDFive),
+								string(virtualnetworkgatewayconnections.IkeIntegritySHAOne),
+								string(virtualnetworkgatewayconnections.IkeIntegritySHATwoFiveSix),
+								string(virtualnetworkgatewayconnections.IkeIntegritySHAThreeEightFour),
 							}, true),
 						},
 
@@ -241,15 +250,15 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 							Required:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecEncryptionAES128),
-								string(network.IpsecEncryptionAES192),
-								string(network.IpsecEncryptionAES256),
-								string(network.IpsecEncryptionDES),
-								string(network.IpsecEncryptionDES3),
-								string(network.IpsecEncryptionGCMAES128),
-								string(network.IpsecEncryptionGCMAES192),
-								string(network.IpsecEncryptionGCMAES256),
-								string(network.IpsecEncryptionNone),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionAESOneTwoEight),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionAESOneNineTwo),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionAESTwoFiveSix),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionDES),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionDESThree),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionGCMAESOneTwoEight),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionGCMAESOneNineTwo),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionGCMAESTwoFiveSix),
+								string(virtualnetworkgatewayconnections.IPsecEncryptionNone),
 							}, true),
 						},
 
@@ -258,12 +267,12 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 							Required:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecIntegrityGCMAES128),
-								string(network.IpsecIntegrityGCMAES192),
-								string(network.IpsecIntegrityGCMAES256),
-								string(network.IpsecIntegrityMD5),
-								string(network.IpsecIntegritySHA1),
-								string(network.IpsecIntegritySHA256),
+								string(virtualnetworkgatewayconnections.IPsecIntegrityGCMAESOneTwoEight),
+								string(virtualnetworkgatewayconnections.IPsecIntegrityGCMAESOneNineTwo),
+								string(virtualnetworkgatewayconnections.IPsecIntegrityGCMAESTwoFiveSix),
+								string(virtualnetworkgatewayconnections.IPsecIntegrityMDFive),
+								string(virtualnetworkgatewayconnections.IPsecIntegritySHAOne),
+								string(virtualnetworkgatewayconnections.IPsecIntegritySHATwoFiveSix),
 							}, true),
 						},
 
@@ -272,15 +281,15 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 							Required:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.PfsGroupECP256),
-								string(network.PfsGroupECP384),
-								string(network.PfsGroupNone),
-								string(network.PfsGroupPFS1),
-								string(network.PfsGroupPFS14),
-								string(network.PfsGroupPFS2),
-								string(network.PfsGroupPFS2048),
-								string(network.PfsGroupPFS24),
-								string(network.PfsGroupPFSMM),
+								string(virtualnetworkgatewayconnections.PfsGroupECPTwoFiveSix),
+								string(virtualnetworkgatewayconnections.PfsGroupECPThreeEightFour),
+								string(virtualnetworkgatewayconnections.PfsGroupNone),
+								string(virtualnetworkgatewayconnections.PfsGroupPFSOne),
+								string(virtualnetworkgatewayconnections.PfsGroupPFSOneFour),
+								string(virtualnetworkgatewayconnections.PfsGroupPFSTwo),
+								string(virtualnetworkgatewayconnections.PfsGroupPFSTwoZeroFourEight),
+								string(virtualnetworkgatewayconnections.PfsGroupPFSTwoFour),
+								string(virtualnetworkgatewayconnections.PfsGroupPFSMM),
 							}, true),
 						},
 
@@ -308,24 +317,24 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 
 func resourceVirtualNetworkGatewayConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Virtual Network Gateway Connection creation.")
 
-	name := d.Get("name").(string)
-	resGroup := d.Get("resource_group_name").(string)
+	id := virtualnetworkgatewayconnections.NewConnectionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resGroup, name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing Virtual Network Gateway Connection %q (Resource Group %q): %s", name, resGroup, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_virtual_network_gateway_connection", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_virtual_network_gateway_connection", id.ID())
 		}
 	}
 
@@ -337,43 +346,27 @@ func resourceVirtualNetworkGatewayConnectionCreateUpdate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	connection := network.VirtualNetworkGatewayConnection{
-		Name:     &name,
-		Location: &location,
-		Tags:     tags.Expand(t),
-		VirtualNetworkGatewayConnectionPropertiesFormat: properties,
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, connection)
-	if err != nil {
-		return fmt.Errorf("Creating/Updating AzureRM Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+	connection := virtualnetworkgatewayconnections.VirtualNetworkGatewayConnection{
+		Name:       utils.String(id.ConnectionName),
+		Location:   &location,
+		Tags:       tagsHelper.Expand(t),
+		Properties: *properties,
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, connection); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	if properties.SharedKey != nil && !d.IsNewResource() {
-		future, err := client.SetSharedKey(ctx, resGroup, name, network.ConnectionSharedKey{
-			Value: properties.SharedKey,
-		})
-		if err != nil {
-			return fmt.Errorf("Updating Shared Key for Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+		sharedKey := virtualnetworkgatewayconnections.ConnectionSharedKey{
+			Value: *properties.SharedKey,
 		}
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Waiting for updating Shared Key for Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+		if err := client.SetSharedKeyThenPoll(ctx, id, sharedKey); err != nil {
+			return fmt.Errorf("updating Shared Key for %s: %+v", id, err)
 		}
 	}
 
-	read, err := client.Get(ctx, resGroup, name)
-	if err != nil {
-		return err
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read AzureRM Virtual Network Gateway Connection %q (resource group %q) ID", name, resGroup)
-	}
-
-	d.SetId(*read.ID)
+	d.SetId(id.ID())
 
 	return resourceVirtualNetworkGatewayConnectionRead(d, meta)
 }
@@ -383,98 +376,111 @@ func resourceVirtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, meta
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
+	id, err := virtualnetworkgatewayconnections.ParseConnectionID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, resGroup, name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("making Read request on AzureRM Virtual Network Gateway Connection %q: %+v", name, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	conn := *resp.VirtualNetworkGatewayConnectionPropertiesFormat
+	d.Set("name", id.ConnectionName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	d.Set("name", resp.Name)
-	d.Set("resource_group_name", resGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
 
-	if string(conn.ConnectionType) != "" {
-		d.Set("type", string(conn.ConnectionType))
-	}
+		conn := model.Properties
 
-	if conn.VirtualNetworkGateway1 != nil {
-		d.Set("virtual_network_gateway_id", conn.VirtualNetworkGateway1.ID)
-	}
+		if string(conn.ConnectionType) != "" {
+			d.Set("type", string(conn.ConnectionType))
+		}
 
-	if conn.AuthorizationKey != nil {
-		d.Set("authorization_key", conn.AuthorizationKey)
-	}
+		d.Set("virtual_network_gateway_id", conn.VirtualNetworkGateway1.Id)
 
-	if conn.DpdTimeoutSeconds != nil {
-		d.Set("dpd_timeout_seconds", conn.DpdTimeoutSeconds)
-	}
+		if conn.AuthorizationKey != nil {
+			d.Set("authorization_key", conn.AuthorizationKey)
+		}
 
-	if conn.Peer != nil {
-		d.Set("express_route_circuit_id", conn.Peer.ID)
-	}
+		if conn.DpdTimeoutSeconds != nil {
+			d.Set("dpd_timeout_seconds", conn.DpdTimeoutSeconds)
+		}
 
-	if conn.VirtualNetworkGateway2 != nil {
-		d.Set("peer_virtual_network_gateway_id", conn.VirtualNetworkGateway2.ID)
-	}
+		if conn.Peer != nil {
+			d.Set("express_route_circuit_id", conn.Peer.Id)
+		}
 
-	if conn.UseLocalAzureIPAddress != nil {
-		d.Set("local_azure_ip_address_enabled", conn.UseLocalAzureIPAddress)
-	}
+		if conn.VirtualNetworkGateway2 != nil {
+			d.Set("peer_virtual_network_gateway_id", conn.VirtualNetworkGateway2.Id)
+		}
 
-	if conn.LocalNetworkGateway2 != nil {
-		d.Set("local_network_gateway_id", conn.LocalNetworkGateway2.ID)
-	}
+		if conn.UseLocalAzureIPAddress != nil {
+			d.Set("local_azure_ip_address_enabled", conn.UseLocalAzureIPAddress)
+		}
 
-	if conn.EnableBgp != nil {
-		d.Set("enable_bgp", conn.EnableBgp)
-	}
+		if conn.LocalNetworkGateway2 != nil {
+			d.Set("local_network_gateway_id", conn.LocalNetworkGateway2.Id)
+		}
 
-	if conn.UsePolicyBasedTrafficSelectors != nil {
-		d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
-	}
+		if conn.EnableBgp != nil {
+			d.Set("enable_bgp", conn.EnableBgp)
+		}
 
-	if conn.RoutingWeight != nil {
-		d.Set("routing_weight", conn.RoutingWeight)
-	}
+		if conn.UsePolicyBasedTrafficSelectors != nil {
+			d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
+		}
 
-	if conn.SharedKey != nil {
-		d.Set("shared_key", conn.SharedKey)
-	}
+		if conn.RoutingWeight != nil {
+			d.Set("routing_weight", conn.RoutingWeight)
+		}
+
+		if conn.SharedKey != nil {
+			d.Set("shared_key", conn.SharedKey)
+		}
 
-	d.Set("connection_protocol", string(conn.ConnectionProtocol))
+		connectionProtocol := ""
+		if conn.ConnectionProtocol != nil {
+			connectionProtocol = string(*conn.ConnectionProtocol)
+		}
+		d.Set("connection_protocol", connectionProtocol)
 
-	d.Set("connection_mode", string(conn.ConnectionMode))
+		connectionMode := ""
+		if conn.ConnectionMode != nil {
+			connectionMode = string(*conn.ConnectionMode)
+		}
+		d.Set("connection_mode", connectionMode)
 
-	if conn.ExpressRouteGatewayBypass != nil {
-		d.Set("express_route_gateway_bypass", conn.ExpressRouteGatewayBypass)
-	}
+		if conn.ExpressRouteGatewayBypass != nil {
+			d.Set("express_route_gateway_bypass", conn.ExpressRouteGatewayBypass)
+		}
 
-	if conn.IpsecPolicies != nil {
-		ipsecPolicies := flattenVirtualNetworkGatewayConnectionIpsecPolicies(conn.IpsecPolicies)
+		d.Set("private_link_fast_path_enabled", utils.NormaliseNilableBool(conn.EnablePrivateLinkFastPath))
 
-		if err := d.Set("ipsec_policy", ipsecPolicies); err != nil {
-			return fmt.Errorf("setting `ipsec_policy`: %+v", err)
+		if conn.IPsecPolicies != nil {
+			ipsecPolicies := flattenVirtualNetworkGatewayConnectionIpsecPolicies(conn.IPsecPolicies)
+
+			if err := d.Set("ipsec_policy", ipsecPolicies); err != nil {
+				return fmt.Errorf("setting `ipsec_policy`: %+v", err)
+			}
+		}
+
+		trafficSelectorPolicies := flattenVirtualNetworkGatewayConnectionTrafficSelectorPolicies(conn.TrafficSelectorPolicies)
+		if err := d.Set("traffic_selector_policy", trafficSelectorPolicies); err != nil {
+			return fmt.Errorf("setting `traffic_selector_policy`: %+v", err)
 		}
-	}
 
-	trafficSelectorPolicies := flattenVirtualNetworkGatewayConnectionTrafficSelectorPolicies(conn.TrafficSelectorPolicies)
-	if err := d.Set("traffic_selector_policy", trafficSelectorPolicies); err != nil {
-		return fmt.Errorf("setting `traffic_selector_policy`: %+v", err)
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceVirtualNetworkGatewayConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -482,31 +488,27 @@ func resourceVirtualNetworkGatewayConnectionDelete(d *pluginsdk.ResourceData, me
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
+	id, err := virtualnetworkgatewayconnections.ParseConnectionID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, resGroup, name)
-	if err != nil {
-		return fmt.Errorf("Deleting Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
 
-func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*network.VirtualNetworkGatewayConnectionPropertiesFormat, error) {
-	connectionType := network.VirtualNetworkGatewayConnectionType(d.Get("type").(string))
-	connectionMode := network.VirtualNetworkGatewayConnectionMode(d.Get("connection_mode").(string))
+func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionPropertiesFormat, error) {
+	connectionType := virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionType(d.Get("type").(string))
+	connectionMode := virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionMode(d.Get("connection_mode").(string))
 
-	props := &network.VirtualNetworkGatewayConnectionPropertiesFormat{
+	props := &virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionPropertiesFormat{
 		ConnectionType:                 connectionType,
-		ConnectionMode:                 connectionMode,
+		ConnectionMode:                 &connectionMode,
 		EnableBgp:                      utils.Bool(d.Get("enable_bgp").(bool)),
+		EnablePrivateLinkFastPath:      utils.Bool(d.Get("private_link_fast_path_enabled").(bool)),
 		ExpressRouteGatewayBypass:      utils.Bool(d.Get("express_route_gateway_bypass").(bool)),
 		UsePolicyBasedTrafficSelectors: utils.Bool(d.Get("use_policy_based_traffic_selectors").(bool)),
 	}
@@ -519,11 +521,11 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 			return nil, err
 		}
 
-		props.VirtualNetworkGateway1 = &network.VirtualNetworkGateway{
-			ID:   &virtualNetworkGatewayId,
+		props.VirtualNetworkGateway1 = virtualnetworkgatewayconnections.VirtualNetworkGateway{
+			Id:   &virtualNetworkGatewayId,
 			Name: &gwid.Name,
-			VirtualNetworkGatewayPropertiesFormat: &network.VirtualNetworkGatewayPropertiesFormat{
-				IPConfigurations: &[]network.VirtualNetworkGatewayIPConfiguration{},
+			Properties: virtualnetworkgatewayconnections.VirtualNetworkGatewayPropertiesFormat{
+				IPConfigurations: &[]virtualnetworkgatewayconnections.VirtualNetworkGatewayIPConfiguration{},
 			},
 		}
 	}
@@ -534,13 +536,13 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 	}
 
 	if v, ok := d.GetOk("dpd_timeout_seconds"); ok {
-		props.DpdTimeoutSeconds = utils.Int32(int32(v.(int)))
+		props.DpdTimeoutSeconds = utils.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("express_route_circuit_id"); ok {
 		expressRouteCircuitId := v.(string)
-		props.Peer = &network.SubResource{
-			ID: &expressRouteCircuitId,
+		props.Peer = &virtualnetworkgatewayconnections.SubResource{
+			Id: &expressRouteCircuitId,
 		}
 	}
 
@@ -549,11 +551,11 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 		if err != nil {
 			return nil, err
 		}
-		props.VirtualNetworkGateway2 = &network.VirtualNetworkGateway{
-			ID:   utils.String(gwid.ID()),
+		props.VirtualNetworkGateway2 = &virtualnetworkgatewayconnections.VirtualNetworkGateway{
+			Id:   utils.String(gwid.ID()),
 			Name: &gwid.Name,
-			VirtualNetworkGatewayPropertiesFormat: &network.VirtualNetworkGatewayPropertiesFormat{
-				IPConfigurations: &[]network.VirtualNetworkGatewayIPConfiguration{},
+			Properties: virtualnetworkgatewayconnections.VirtualNetworkGatewayPropertiesFormat{
+				IPConfigurations: &[]virtualnetworkgatewayconnections.VirtualNetworkGatewayIPConfiguration{},
 			},
 		}
 	}
@@ -569,18 +571,17 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 			return nil, fmt.Errorf("Getting LocalNetworkGateway Name and Group:: %+v", err)
 		}
 
-		props.LocalNetworkGateway2 = &network.LocalNetworkGateway{
-			ID:   &localNetworkGatewayId,
+		props.LocalNetworkGateway2 = &virtualnetworkgatewayconnections.LocalNetworkGateway{
+			Id:   &localNetworkGatewayId,
 			Name: &name,
-			LocalNetworkGatewayPropertiesFormat: &network.LocalNetworkGatewayPropertiesFormat{
-				LocalNetworkAddressSpace: &network.AddressSpace{},
+			Properties: virtualnetworkgatewayconnections.LocalNetworkGatewayPropertiesFormat{
+				LocalNetworkAddressSpace: &virtualnetworkgatewayconnections.AddressSpace{},
 			},
 		}
 	}
 
 	if v, ok := d.GetOk("routing_weight"); ok {
-		routingWeight := int32(v.(int))
-		props.RoutingWeight = &routingWeight
+		props.RoutingWeight = utils.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("shared_key"); ok {
@@ -588,8 +589,8 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 	}
 
 	if v, ok := d.GetOk("connection_protocol"); ok {
-		connectionProtocol := v.(string)
-		props.ConnectionProtocol = network.VirtualNetworkGatewayConnectionProtocol(connectionProtocol)
+		connectionProtocol := virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionProtocol(v.(string))
+		props.ConnectionProtocol = &connectionProtocol
 	}
 
 	if v, ok := d.GetOk("traffic_selector_policy"); ok {
@@ -597,78 +598,77 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 	}
 
 	if v, ok := d.GetOk("ipsec_policy"); ok {
-		props.IpsecPolicies = expandVirtualNetworkGatewayConnectionIpsecPolicies(v.([]interface{}))
+		props.IPsecPolicies = expandVirtualNetworkGatewayConnectionIpsecPolicies(v.([]interface{}))
 	}
 
-	if props.ConnectionType == network.VirtualNetworkGatewayConnectionTypeExpressRoute {
-		if props.Peer == nil || props.Peer.ID == nil {
+	if props.ConnectionType == virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionTypeExpressRoute {
+		if props.Peer == nil || props.Peer.Id == nil {
 			return nil, fmt.Errorf("`express_route_circuit_id` must be specified when `type` is set to `ExpressRoute`")
 		}
 	}
 
-	if props.ConnectionType == network.VirtualNetworkGatewayConnectionTypeIPsec {
-		if props.LocalNetworkGateway2 == nil || props.LocalNetworkGateway2.ID == nil {
+	if props.ConnectionType == virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionTypeIPsec {
+		if props.LocalNetworkGateway2 == nil || props.LocalNetworkGateway2.Id == nil {
 			return nil, fmt.Errorf("`local_network_gateway_id` must be specified when `type` is set to `IPsec`")
 		}
 	}
 
-	if props.ConnectionType == network.VirtualNetworkGatewayConnectionTypeVnet2Vnet {
-		if props.VirtualNetworkGateway2 == nil || props.VirtualNetworkGateway2.ID == nil {
+	if props.ConnectionType == virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionTypeVnetTwoVnet {
+		if props.VirtualNetworkGateway2 == nil || props.VirtualNetworkGateway2.Id == nil {
 			return nil, fmt.Errorf("`peer_virtual_network_gateway_id` must be specified when `type` is set to `Vnet2Vnet`")
 		}
 	}
 
-	return props, nil
-}
+	if *props.EnablePrivateLinkFastPath {
+		if props.ConnectionType != virtualnetworkgatewayconnections.VirtualNetworkGatewayConnectionTypeExpressRoute {
+			return nil, fmt.Errorf("`private_link_fast_path_enabled` can only be set to `true` when `type` is set to `ExpressRoute`")
+		}
 
-func resourceGroupAndVirtualNetworkGatewayConnectionFromId(virtualNetworkGatewayConnectionId string) (string, string, error) {
-	id, err := parse.NetworkGatewayConnectionID(virtualNetworkGatewayConnectionId)
-	if err != nil {
-		return "", "", err
+		if !*props.ExpressRouteGatewayBypass {
+			return nil, fmt.Errorf("`express_route_gateway_bypass` must be set to `true` when `private_link_fast_path_enabled` is set to `true`")
+		}
 	}
 
-	return id.ResourceGroup, id.ConnectionName, nil
+	return props, nil
 }
 
-func expandVirtualNetworkGatewayConnectionIpsecPolicies(schemaIpsecPolicies []interface{}) *[]network.IpsecPolicy {
-	ipsecPolicies := make([]network.IpsecPolicy, 0, len(schemaIpsecPolicies))
+func expandVirtualNetworkGatewayConnectionIpsecPolicies(schemaIpsecPolicies []interface{}) *[]virtualnetworkgatewayconnections.IPsecPolicy {
+	ipsecPolicies := make([]virtualnetworkgatewayconnections.IPsecPolicy, 0, len(schemaIpsecPolicies))
 
 	for _, d := range schemaIpsecPolicies {
 		schemaIpsecPolicy := d.(map[string]interface{})
-		ipsecPolicy := &network.IpsecPolicy{}
+		ipsecPolicy := &virtualnetworkgatewayconnections.IPsecPolicy{}
 
 		if dhGroup, ok := schemaIpsecPolicy["dh_group"].(string); ok && dhGroup != "" {
-			ipsecPolicy.DhGroup = network.DhGroup(dhGroup)
+			ipsecPolicy.DhGroup = virtualnetworkgatewayconnections.DhGroup(dhGroup)
 		}
 
 		if ikeEncryption, ok := schemaIpsecPolicy["ike_encryption"].(string); ok && ikeEncryption != "" {
-			ipsecPolicy.IkeEncryption = network.IkeEncryption(ikeEncryption)
+			ipsecPolicy.IkeEncryption = virtualnetworkgatewayconnections.IkeEncryption(ikeEncryption)
 		}
 
 		if ikeIntegrity, ok := schemaIpsecPolicy["ike_integrity"].(string); ok && ikeIntegrity != "" {
-			ipsecPolicy.IkeIntegrity = network.IkeIntegrity(ikeIntegrity)
+			ipsecPolicy.IkeIntegrity = virtualnetworkgatewayconnections.IkeIntegrity(ikeIntegrity)
 		}
 
 		if ipsecEncryption, ok := schemaIpsecPolicy["ipsec_encryption"].(string); ok && ipsecEncryption != "" {
-			ipsecPolicy.IpsecEncryption = network.IpsecEncryption(ipsecEncryption)
+			ipsecPolicy.IPsecEncryption = virtualnetworkgatewayconnections.IPsecEncryption(ipsecEncryption)
 		}
 
 		if ipsecIntegrity, ok := schemaIpsecPolicy["ipsec_integrity"].(string); ok && ipsecIntegrity != "" {
-			ipsecPolicy.IpsecIntegrity = network.IpsecIntegrity(ipsecIntegrity)
+			ipsecPolicy.IPsecIntegrity = virtualnetworkgatewayconnections.IPsecIntegrity(ipsecIntegrity)
 		}
 
 		if pfsGroup, ok := schemaIpsecPolicy["pfs_group"].(string); ok && pfsGroup != "" {
-			ipsecPolicy.PfsGroup = network.PfsGroup(pfsGroup)
+			ipsecPolicy.PfsGroup = virtualnetworkgatewayconnections.PfsGroup(pfsGroup)
 		}
 
 		if v, ok := schemaIpsecPolicy["sa_datasize"].(int); ok {
-			saDatasize := int32(v)
-			ipsecPolicy.SaDataSizeKilobytes = &saDatasize
+			ipsecPolicy.SaDataSizeKilobytes = int64(v)
 		}
 
 		if v, ok := schemaIpsecPolicy["sa_lifetime"].(int); ok {
-			saLifetime := int32(v)
-			ipsecPolicy.SaLifeTimeSeconds = &saLifetime
+			ipsecPolicy.SaLifeTimeSeconds = int64(v)
 		}
 
 		ipsecPolicies = append(ipsecPolicies, *ipsecPolicy)
@@ -677,17 +677,20 @@ func expandVirtualNetworkGatewayConnectionIpsecPolicies(schemaIpsecPolicies []in
 	return &ipsecPolicies
 }
 
-func expandVirtualNetworkGatewayConnectionTrafficSelectorPolicies(schemaTrafficSelectorPolicies []interface{}) *[]network.TrafficSelectorPolicy {
-	trafficSelectorPolicies := make([]network.TrafficSelectorPolicy, 0, len(schemaTrafficSelectorPolicies))
+func expandVirtualNetworkGatewayConnectionTrafficSelectorPolicies(schemaTrafficSelectorPolicies []interface{}) *[]virtualnetworkgatewayconnections.TrafficSelectorPolicy {
+	trafficSelectorPolicies := make([]virtualnetworkgatewayconnections.TrafficSelectorPolicy, 0, len(schemaTrafficSelectorPolicies))
 
 	for _, d := range schemaTrafficSelectorPolicies {
 		schemaTrafficSelectorPolicy := d.(map[string]interface{})
-		trafficSelectorPolicy := &network.TrafficSelectorPolicy{}
+		trafficSelectorPolicy := &virtualnetworkgatewayconnections.TrafficSelectorPolicy{
+			LocalAddressRanges:  []string{},
+			RemoteAddressRanges: []string{},
+		}
 		if localAddressRanges, ok := schemaTrafficSelectorPolicy["local_address_cidrs"].([]interface{}); ok {
-			trafficSelectorPolicy.LocalAddressRanges = utils.ExpandStringSlice(localAddressRanges)
+			trafficSelectorPolicy.LocalAddressRanges = *utils.ExpandStringSlice(localAddressRanges)
 		}
 		if remoteAddressRanges, ok := schemaTrafficSelectorPolicy["remote_address_cidrs"].([]interface{}); ok {
-			trafficSelectorPolicy.RemoteAddressRanges = utils.ExpandStringSlice(remoteAddressRanges)
+			trafficSelectorPolicy.RemoteAddressRanges = *utils.ExpandStringSlice(remoteAddressRanges)
 		}
 
 		trafficSelectorPolicies = append(trafficSelectorPolicies, *trafficSelectorPolicy)
@@ -696,7 +699,7 @@ func expandVirtualNetworkGatewayConnectionTrafficSelectorPolicies(schemaTrafficS
 	return &trafficSelectorPolicies
 }
 
-func flattenVirtualNetworkGatewayConnectionIpsecPolicies(ipsecPolicies *[]network.IpsecPolicy) []interface{} {
+func flattenVirtualNetworkGatewayConnectionIpsecPolicies(ipsecPolicies *[]virtualnetworkgatewayconnections.IPsecPolicy) []interface{} {
 	schemaIpsecPolicies := make([]interface{}, 0)
 
 	if ipsecPolicies != nil {
@@ -706,17 +709,11 @@ func flattenVirtualNetworkGatewayConnectionIpsecPolicies(ipsecPolicies *[]networ
 			schemaIpsecPolicy["dh_group"] = string(ipsecPolicy.DhGroup)
 			schemaIpsecPolicy["ike_encryption"] = string(ipsecPolicy.IkeEncryption)
 			schemaIpsecPolicy["ike_integrity"] = string(ipsecPolicy.IkeIntegrity)
-			schemaIpsecPolicy["ipsec_encryption"] = string(ipsecPolicy.IpsecEncryption)
-			schemaIpsecPolicy["ipsec_integrity"] = string(ipsecPolicy.IpsecIntegrity)
+			schemaIpsecPolicy["ipsec_encryption"] = string(ipsecPolicy.IPsecEncryption)
+			schemaIpsecPolicy["ipsec_integrity"] = string(ipsecPolicy.IPsecIntegrity)
 			schemaIpsecPolicy["pfs_group"] = string(ipsecPolicy.PfsGroup)
-
-			if ipsecPolicy.SaDataSizeKilobytes != nil {
-				schemaIpsecPolicy["sa_datasize"] = int(*ipsecPolicy.SaDataSizeKilobytes)
-			}
-
-			if ipsecPolicy.SaLifeTimeSeconds != nil {
-				schemaIpsecPolicy["sa_lifetime"] = int(*ipsecPolicy.SaLifeTimeSeconds)
-			}
+			schemaIpsecPolicy["sa_datasize"] = int(ipsecPolicy.SaDataSizeKilobytes)
+			schemaIpsecPolicy["sa_lifetime"] = int(ipsecPolicy.SaLifeTimeSeconds)
 
 			schemaIpsecPolicies = append(schemaIpsecPolicies, schemaIpsecPolicy)
 		}
@@ -725,14 +722,14 @@ func flattenVirtualNetworkGatewayConnectionIpsecPolicies(ipsecPolicies *[]networ
 	return schemaIpsecPolicies
 }
 
-func flattenVirtualNetworkGatewayConnectionTrafficSelectorPolicies(trafficSelectorPolicies *[]network.TrafficSelectorPolicy) []interface{} {
+func flattenVirtualNetworkGatewayConnectionTrafficSelectorPolicies(trafficSelectorPolicies *[]virtualnetworkgatewayconnections.TrafficSelectorPolicy) []interface{} {
 	schemaTrafficSelectorPolicies := make([]interface{}, 0)
 
 	if trafficSelectorPolicies != nil {
 		for _, trafficSelectorPolicy := range *trafficSelectorPolicies {
 			schemaTrafficSelectorPolicies = append(schemaTrafficSelectorPolicies, map[string]interface{}{
-				"local_address_cidrs":  utils.FlattenStringSlice(trafficSelectorPolicy.LocalAddressRanges),
-				"remote_address_cidrs": utils.FlattenStringSlice(trafficSelectorPolicy.RemoteAddressRanges),
+				"local_address_cidrs":  trafficSelectorPolicy.LocalAddressRanges,
+				"remote_address_cidrs": trafficSelectorPolicy.RemoteAddressRanges,
 			})
 		}
 	}
@@ -5,15 +5,19 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/ipampools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2024-05-01/virtualnetworks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -31,7 +35,7 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 		Update: resourceVirtualNetworkCreateUpdate,
 		Delete: resourceVirtualNetworkDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.VirtualNetworkID(id)
+			_, err := virtualnetworks.ParseVirtualNetworkID(id)
 			return err
 		}),
 
@@ -55,15 +59,47 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 			"location": azure.SchemaLocation(),
 
 			"address_space": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MinItems: 1,
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"address_space", "ip_address_pool"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
 
+			"ip_address_pool": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     2,
+				ExactlyOneOf: []string{"address_space", "ip_address_pool"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: ipampools.ValidateIPamPoolID,
+						},
+
+						"number_of_ip_addresses": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "`number_of_ip_addresses` must be a positive integer"),
+						},
+
+						"allocated_ip_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			"bgp_community": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -158,21 +194,22 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 }
 
 func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Network.VirtualNetworksClient
+	vnetClient := meta.(*clients.Client).Network.VnetClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewVirtualNetworkID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := virtualnetworks.NewVirtualNetworkID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_virtual_network", id.ID())
 		}
 	}
@@ -180,26 +217,26 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	vnetProperties, err := expandVirtualNetworkProperties(ctx, d, meta)
+	vnetProperties, err := expandVirtualNetworkProperties(ctx, client, id, d)
 	if err != nil {
 		return err
 	}
 
-	vnet := network.VirtualNetwork{
-		Name:                           utils.String(id.Name),
-		Location:                       utils.String(location),
-		VirtualNetworkPropertiesFormat: vnetProperties,
-		Tags:                           tags.Expand(t),
+	vnet := virtualnetworks.VirtualNetwork{
+		Name:       utils.String(id.VirtualNetworkName),
+		Location:   utils.String(location),
+		Properties: vnetProperties,
+		Tags:       tagsHelper.Expand(t),
 	}
 
 	if v, ok := d.GetOk("flow_timeout_in_minutes"); ok {
-		vnet.VirtualNetworkPropertiesFormat.FlowTimeoutInMinutes = utils.Int32(int32(v.(int)))
+		vnet.Properties.FlowTimeoutInMinutes = utils.Int64(int64(v.(int)))
 	}
 
 	networkSecurityGroupNames := make([]string, 0)
-	for _, subnet := range *vnet.VirtualNetworkPropertiesFormat.Subnets {
-		if subnet.NetworkSecurityGroup != nil {
-			parsedNsgID, err := parse.NetworkSecurityGroupID(*subnet.NetworkSecurityGroup.ID)
+	for _, subnet := range *vnet.Properties.Subnets {
+		if subnet.Properties != nil && subnet.Properties.NetworkSecurityGroup != nil && subnet.Properties.NetworkSecurityGroup.Id != nil {
+			parsedNsgID, err := parse.NetworkSecurityGroupID(*subnet.Properties.NetworkSecurityGroup.Id)
 			if err != nil {
 				return err
 			}
@@ -214,20 +251,15 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
-	}
-
 	timeout, _ := ctx.Deadline()
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{string(network.ProvisioningStateUpdating)},
 		Target:     []string{string(network.ProvisioningStateSucceeded)},
-		Refresh:    VirtualNetworkProvisioningStateRefreshFunc(ctx, client, id),
+		Refresh:    VirtualNetworkProvisioningStateRefreshFunc(ctx, vnetClient, parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)),
 		MinTimeout: 1 * time.Minute,
 		Timeout:    time.Until(timeout),
 	}
@@ -240,73 +272,87 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 }
 
 func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Network.VirtualNetworksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.VirtualNetworkID(d.Id())
+	id, err := virtualnetworks.ParseVirtualNetworkID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("name", id.VirtualNetworkName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
 
-	if props := resp.VirtualNetworkPropertiesFormat; props != nil {
-		d.Set("guid", props.ResourceGUID)
-		d.Set("flow_timeout_in_minutes", props.FlowTimeoutInMinutes)
+		if props := model.Properties; props != nil {
+			d.Set("guid", props.ResourceGuid)
+			d.Set("flow_timeout_in_minutes", props.FlowTimeoutInMinutes)
 
-		if space := props.AddressSpace; space != nil {
-			d.Set("address_space", utils.FlattenStringSlice(space.AddressPrefixes))
-		}
+			addressSpace := make([]interface{}, 0)
+			ipAddressPool := make([]interface{}, 0)
+			if space := props.AddressSpace; space != nil {
+				addressSpace = utils.FlattenStringSlice(space.AddressPrefixes)
+				ipAddressPool = flattenVirtualNetworkIPAddressPool(space.IPamPoolPrefixAllocations)
+			}
+			d.Set("address_space", addressSpace)
 
-		if err := d.Set("ddos_protection_plan", flattenVirtualNetworkDDoSProtectionPlan(props)); err != nil {
-			return fmt.Errorf("setting `ddos_protection_plan`: %+v", err)
-		}
+			if err := d.Set("ip_address_pool", ipAddressPool); err != nil {
+				return fmt.Errorf("setting `ip_address_pool`: %+v", err)
+			}
 
-		if err := d.Set("subnet", flattenVirtualNetworkSubnets(props.Subnets)); err != nil {
-			return fmt.Errorf("setting `subnets`: %+v", err)
-		}
+			if err := d.Set("ddos_protection_plan", flattenVirtualNetworkDDoSProtectionPlan(props)); err != nil {
+				return fmt.Errorf("setting `ddos_protection_plan`: %+v", err)
+			}
 
-		if err := d.Set("dns_servers", flattenVirtualNetworkDNSServers(props.DhcpOptions)); err != nil {
-			return fmt.Errorf("setting `dns_servers`: %+v", err)
-		}
+			if err := d.Set("subnet", flattenVirtualNetworkSubnets(props.Subnets)); err != nil {
+				return fmt.Errorf("setting `subnets`: %+v", err)
+			}
 
-		bgpCommunity := ""
-		if p := props.BgpCommunities; p != nil {
-			if v := p.VirtualNetworkCommunity; v != nil {
-				bgpCommunity = *v
+			dnsServers := make([]string, 0)
+			if props.DhcpOptions != nil && props.DhcpOptions.DnsServers != nil {
+				dnsServers = *props.DhcpOptions.DnsServers
 			}
-		}
-		if err := d.Set("bgp_community", bgpCommunity); err != nil {
-			return fmt.Errorf("setting `bgp_community`: %+v", err)
+			if err := d.Set("dns_servers", dnsServers); err != nil {
+				return fmt.Errorf("setting `dns_servers`: %+v", err)
+			}
+
+			bgpCommunity := ""
+			if p := props.BgpCommunities; p != nil {
+				bgpCommunity = p.VirtualNetworkCommunity
+			}
+			if err := d.Set("bgp_community", bgpCommunity); err != nil {
+				return fmt.Errorf("setting `bgp_community`: %+v", err)
+			}
+
+			d.Set("vm_protection_enabled", props.EnableVMProtection)
 		}
 
-		d.Set("vm_protection_enabled", props.EnableVMProtection)
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Network.VirtualNetworksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.VirtualNetworkID(d.Id())
+	id, err := virtualnetworks.ParseVirtualNetworkID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -319,70 +365,83 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
 	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func expandVirtualNetworkProperties(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (*network.VirtualNetworkPropertiesFormat, error) {
-	subnets := make([]network.Subnet, 0)
+func expandVirtualNetworkProperties(ctx context.Context, client *virtualnetworks.VirtualNetworksClient, id virtualnetworks.VirtualNetworkId, d *pluginsdk.ResourceData) (*virtualnetworks.VirtualNetworkPropertiesFormat, error) {
+	// since subnets can also be created outside of vNet definition (as root objects)
+	// retrieve the existing subnets from the server so that their other properties are left intact
+	existingSubnets := make(map[string]virtualnetworks.Subnet)
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Subnets != nil {
+			for _, subnet := range *model.Properties.Subnets {
+				if subnet.Name != nil {
+					existingSubnets[*subnet.Name] = subnet
+				}
+			}
+		}
+	}
+
+	subnets := make([]virtualnetworks.Subnet, 0)
 	if subs := d.Get("subnet").(*pluginsdk.Set); subs.Len() > 0 {
 		for _, subnet := range subs.List() {
 			subnet := subnet.(map[string]interface{})
 
 			name := subnet["name"].(string)
 			log.Printf("[INFO] setting subnets inside vNet, processing %q", name)
-			// since subnets can also be created outside of vNet definition (as root objects)
-			// do a GET on subnet properties from the server before setting them
-			resGroup := d.Get("resource_group_name").(string)
-			vnetName := d.Get("name").(string)
-			subnetObj, err := getExistingSubnet(ctx, resGroup, vnetName, name, meta)
-			if err != nil {
-				return nil, err
-			}
-			log.Printf("[INFO] Completed GET of Subnet props ")
+
+			// Use the existing subnet directly rather than copy the fields to prevent potential uncovered properties (for example, `ServiceEndpoints` mentioned in #1619)
+			subnetObj := existingSubnets[name]
 
 			prefix := subnet["address_prefix"].(string)
 			secGroup := subnet["security_group"].(string)
 
 			// set the props from config and leave the rest intact
 			subnetObj.Name = &name
-			if subnetObj.SubnetPropertiesFormat == nil {
-				subnetObj.SubnetPropertiesFormat = &network.SubnetPropertiesFormat{}
+			if subnetObj.Properties == nil {
+				subnetObj.Properties = &virtualnetworks.SubnetPropertiesFormat{}
 			}
 
-			subnetObj.SubnetPropertiesFormat.AddressPrefix = &prefix
+			subnetObj.Properties.AddressPrefix = &prefix
 
 			if secGroup != "" {
-				subnetObj.SubnetPropertiesFormat.NetworkSecurityGroup = &network.SecurityGroup{
-					ID: &secGroup,
+				subnetObj.Properties.NetworkSecurityGroup = &virtualnetworks.NetworkSecurityGroup{
+					Id: &secGroup,
 				}
 			} else {
-				subnetObj.SubnetPropertiesFormat.NetworkSecurityGroup = nil
+				subnetObj.Properties.NetworkSecurityGroup = nil
 			}
 
-			subnets = append(subnets, *subnetObj)
+			subnets = append(subnets, subnetObj)
 		}
 	}
 
-	properties := &network.VirtualNetworkPropertiesFormat{
-		AddressSpace: &network.AddressSpace{
-			AddressPrefixes: utils.ExpandStringSlice(d.Get("address_space").([]interface{})),
-		},
-		DhcpOptions: &network.DhcpOptions{
-			DNSServers: utils.ExpandStringSlice(d.Get("dns_servers").([]interface{})),
+	properties := &virtualnetworks.VirtualNetworkPropertiesFormat{
+		AddressSpace: &virtualnetworks.AddressSpace{},
+		DhcpOptions: &virtualnetworks.DhcpOptions{
+			DnsServers: utils.ExpandStringSlice(d.Get("dns_servers").([]interface{})),
 		},
 		EnableVMProtection: utils.Bool(d.Get("vm_protection_enabled").(bool)),
 		Subnets:            &subnets,
 	}
 
+	// when the address space is allocated from an IPAM Pool the allocated prefixes are returned in `address_space`
+	// and must not be sent back to the service alongside the allocation
+	if v, ok := d.GetOk("ip_address_pool"); ok {
+		properties.AddressSpace.IPamPoolPrefixAllocations = expandVirtualNetworkIPAddressPool(v.([]interface{}))
+	} else {
+		properties.AddressSpace.AddressPrefixes = utils.ExpandStringSlice(d.Get("address_space").([]interface{}))
+	}
+
 	if v, ok := d.GetOk("ddos_protection_plan"); ok {
 		rawList := v.([]interface{})
 
@@ -393,8 +452,8 @@ func expandVirtualNetworkProperties(ctx context.Context, d *pluginsdk.ResourceDa
 
 		if v, ok := ddosPPlan["id"]; ok {
 			id := v.(string)
-			properties.DdosProtectionPlan = &network.SubResource{
-				ID: &id,
+			properties.DdosProtectionPlan = &virtualnetworks.SubResource{
+				Id: &id,
 			}
 		}
 
@@ -405,30 +464,69 @@ func expandVirtualNetworkProperties(ctx context.Context, d *pluginsdk.ResourceDa
 	}
 
 	if v, ok := d.GetOk("bgp_community"); ok {
-		properties.BgpCommunities = &network.VirtualNetworkBgpCommunities{VirtualNetworkCommunity: utils.String(v.(string))}
+		properties.BgpCommunities = &virtualnetworks.VirtualNetworkBgpCommunities{VirtualNetworkCommunity: v.(string)}
 	}
 
 	return properties, nil
 }
 
-func flattenVirtualNetworkDDoSProtectionPlan(input *network.VirtualNetworkPropertiesFormat) []interface{} {
+func expandVirtualNetworkIPAddressPool(input []interface{}) *[]virtualnetworks.IPamPoolPrefixAllocation {
+	results := make([]virtualnetworks.IPamPoolPrefixAllocation, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, virtualnetworks.IPamPoolPrefixAllocation{
+			NumberOfIPAddresses: utils.String(v["number_of_ip_addresses"].(string)),
+			Pool: &virtualnetworks.IPamPoolPrefixAllocationPool{
+				Id: utils.String(v["id"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualNetworkIPAddressPool(input *[]virtualnetworks.IPamPoolPrefixAllocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		poolId := ""
+		if item.Pool != nil && item.Pool.Id != nil {
+			poolId = *item.Pool.Id
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                            poolId,
+			"number_of_ip_addresses":        utils.NormalizeNilableString(item.NumberOfIPAddresses),
+			"allocated_ip_address_prefixes": utils.FlattenStringSlice(item.AllocatedAddressPrefixes),
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualNetworkDDoSProtectionPlan(input *virtualnetworks.VirtualNetworkPropertiesFormat) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
-	if input.DdosProtectionPlan == nil || input.DdosProtectionPlan.ID == nil || input.EnableDdosProtection == nil {
+	if input.DdosProtectionPlan == nil || input.DdosProtectionPlan.Id == nil || input.EnableDdosProtection == nil {
 		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"id":     *input.DdosProtectionPlan.ID,
+			"id":     *input.DdosProtectionPlan.Id,
 			"enable": *input.EnableDdosProtection,
 		},
 	}
 }
 
-func flattenVirtualNetworkSubnets(input *[]network.Subnet) *pluginsdk.Set {
+func flattenVirtualNetworkSubnets(input *[]virtualnetworks.Subnet) *pluginsdk.Set {
 	results := &pluginsdk.Set{
 		F: resourceAzureSubnetHash,
 	}
@@ -437,7 +535,7 @@ func flattenVirtualNetworkSubnets(input *[]network.Subnet) *pluginsdk.Set {
 		for _, subnet := range *input {
 			output := map[string]interface{}{}
 
-			if id := subnet.ID; id != nil {
+			if id := subnet.Id; id != nil {
 				output["id"] = *id
 			}
 
@@ -445,14 +543,14 @@ func flattenVirtualNetworkSubnets(input *[]network.Subnet) *pluginsdk.Set {
 				output["name"] = *name
 			}
 
-			if props := subnet.SubnetPropertiesFormat; props != nil {
+			if props := subnet.Properties; props != nil {
 				if prefix := props.AddressPrefix; prefix != nil {
 					output["address_prefix"] = *prefix
 				}
 
 				if nsg := props.NetworkSecurityGroup; nsg != nil {
-					if nsg.ID != nil {
-						output["security_group"] = *nsg.ID
+					if nsg.Id != nil {
+						output["security_group"] = *nsg.Id
 					}
 				}
 			}
@@ -464,18 +562,6 @@ func flattenVirtualNetworkSubnets(input *[]network.Subnet) *pluginsdk.Set {
 	return results
 }
 
-func flattenVirtualNetworkDNSServers(input *network.DhcpOptions) []string {
-	results := make([]string, 0)
-
-	if input != nil {
-		if servers := input.DNSServers; servers != nil {
-			results = *servers
-		}
-	}
-
-	return results
-}
-
 func resourceAzureSubnetHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -492,21 +578,6 @@ func resourceAzureSubnetHash(v interface{}) int {
 	return pluginsdk.HashString(buf.String())
 }
 
-func getExistingSubnet(ctx context.Context, resGroup string, vnetName string, subnetName string, meta interface{}) (*network.Subnet, error) {
-	subnetClient := meta.(*clients.Client).Network.SubnetsClient
-	resp, err := subnetClient.Get(ctx, resGroup, vnetName, subnetName, "")
-	if err != nil {
-		if resp.StatusCode == http.StatusNotFound {
-			return &network.Subnet{}, nil
-		}
-		// raise an error if there was an issue other than 404 in getting subnet properties
-		return nil, err
-	}
-
-	// Return it directly rather than copy the fields to prevent potential uncovered properties (for example, `ServiceEndpoints` mentioned in #1619)
-	return &resp, nil
-}
-
 func expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d *pluginsdk.ResourceData) ([]string, error) {
 	nsgNames := make([]string, 0)
 
@@ -292,7 +292,7 @@ func resourceVPNGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	if d.HasChange("scale_unit") {
 		existing.VpnGatewayScaleUnit = utils.Int32(int32(d.Get("scale_unit").(int)))
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
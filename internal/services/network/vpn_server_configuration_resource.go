@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var vpnServerConfigurationResourceName = "azurerm_vpn_server_configuration"
+
 func resourceVPNServerConfiguration() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVPNServerConfigurationCreateUpdate,
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -102,6 +103,12 @@ func (br assignmentBaseResource) createFunc(resourceName, scopeFieldName string)
 				return fmt.Errorf("waiting for %s to become available: %s", id, err)
 			}
 
+			if v := metadata.ResourceData.Get("definition_version").(string); v != "" {
+				if err := br.updateDefinitionVersion(ctx, metadata.Client.Policy.PolicyAssignmentsClient, id, v); err != nil {
+					return err
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -182,6 +189,17 @@ func (br assignmentBaseResource) readFunc(scopeFieldName string) sdk.ResourceFun
 				metadata.ResourceData.Set("parameters", flattenedParameters)
 			}
 
+			// the Definition Version is only exposed in a newer API version
+			assignment, err := metadata.Client.Policy.PolicyAssignmentsClient.Get(ctx, policyassignments.NewScopedPolicyAssignmentID(id.Scope, id.Name))
+			if err != nil {
+				return fmt.Errorf("retrieving the Definition Version for %s: %+v", *id, err)
+			}
+			definitionVersion := ""
+			if model := assignment.Model; model != nil && model.Properties != nil && model.Properties.DefinitionVersion != nil {
+				definitionVersion = *model.Properties.DefinitionVersion
+			}
+			metadata.ResourceData.Set("definition_version", definitionVersion)
+
 			return nil
 		},
 		Timeout: 5 * time.Minute,
@@ -287,6 +305,12 @@ func (br assignmentBaseResource) updateFunc() sdk.ResourceFunc {
 				return fmt.Errorf("waiting for %s to become available: %s", id, err)
 			}
 
+			if metadata.ResourceData.HasChange("definition_version") {
+				if err := br.updateDefinitionVersion(ctx, metadata.Client.Policy.PolicyAssignmentsClient, *id, metadata.ResourceData.Get("definition_version").(string)); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 		Timeout: 30 * time.Minute,
@@ -306,6 +330,13 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 			),
 		},
 
+		"definition_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.AssignmentDefinitionVersion,
+		},
+
 		"description": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -407,6 +438,33 @@ func (br assignmentBaseResource) flattenIdentity(input *policy.Identity) []inter
 	return policyAssignmentIdentity{}.Flatten(config)
 }
 
+// updateDefinitionVersion pins the Policy Assignment to the specified version of the Policy (Set) Definition - which
+// is only supported in a newer API version, as such the existing Policy Assignment is retrieved and updated using that
+func (br assignmentBaseResource) updateDefinitionVersion(ctx context.Context, client *policyassignments.PolicyAssignmentsClient, id parse.PolicyAssignmentId, definitionVersion string) error {
+	assignmentId := policyassignments.NewScopedPolicyAssignmentID(id.Scope, id.Name)
+	existing, err := client.Get(ctx, assignmentId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", assignmentId, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", assignmentId)
+	}
+
+	payload := *existing.Model
+	payload.Properties.DefinitionVersion = nil
+	if definitionVersion != "" {
+		payload.Properties.DefinitionVersion = utils.String(definitionVersion)
+	}
+	payload.Properties.EffectiveDefinitionVersion = nil
+	payload.Properties.LatestDefinitionVersion = nil
+
+	if _, err := client.Create(ctx, assignmentId, payload); err != nil {
+		return fmt.Errorf("updating the Definition Version for %s: %+v", assignmentId, err)
+	}
+
+	return nil
+}
+
 func (br assignmentBaseResource) flattenNonComplianceMessages(input *[]policy.NonComplianceMessage) []interface{} {
 	results := make([]interface{}, 0)
 
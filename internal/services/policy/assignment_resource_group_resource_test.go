@@ -173,6 +173,30 @@ func TestAccResourceGroupPolicyAssignment_basicWithCustomPolicy(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupPolicyAssignment_definitionVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
+	r := ResourceGroupAssignmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withCustomPolicyDefinitionVersion(data, "1.*.*"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition_version").HasValue("1.*.*"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withCustomPolicyDefinitionVersion(data, "1.0.*"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition_version").HasValue("1.0.*"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroupPolicyAssignment_basicWithCustomPolicyRequiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "test")
 	r := ResourceGroupAssignmentTestResource{}
@@ -514,6 +538,45 @@ resource "azurerm_resource_group_policy_assignment" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ResourceGroupAssignmentTestResource) withCustomPolicyDefinitionVersion(data acceptance.TestData, definitionVersion string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%[2]d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%[2]d"
+  version      = "1.0.0"
+
+  policy_rule = <<POLICY_RULE
+	{
+    "if": {
+      "not": {
+        "field": "name",
+        "equals": "bob"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+POLICY_RULE
+}
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[2]d"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+  definition_version   = %[3]q
+}
+`, r.template(data), data.RandomInteger, definitionVersion)
+}
+
 func (r ResourceGroupAssignmentTestResource) withCustomPolicyRequiresImport(data acceptance.TestData) string {
 	template := r.withCustomPolicyBasic(data)
 	return fmt.Sprintf(`
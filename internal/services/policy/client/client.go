@@ -5,12 +5,20 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2019-10-01-preview/policyinsights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2022-09-01/attestations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policyassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policydefinitionversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policysetdefinitionversions"
 )
 
 type Client struct {
 	AssignmentsClient                   *policy.AssignmentsClient
+	AttestationsClient                  *attestations.AttestationsClient
 	DefinitionsClient                   *policy.DefinitionsClient
 	SetDefinitionsClient                *policy.SetDefinitionsClient
+	PolicyAssignmentsClient             *policyassignments.PolicyAssignmentsClient
+	PolicyDefinitionVersionsClient      *policydefinitionversions.PolicyDefinitionVersionsClient
+	PolicySetDefinitionVersionsClient   *policysetdefinitionversions.PolicySetDefinitionVersionsClient
 	RemediationsClient                  *policyinsights.RemediationsClient
 	GuestConfigurationAssignmentsClient *guestconfiguration.AssignmentsClient
 }
@@ -19,12 +27,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	assignmentsClient := policy.NewAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&assignmentsClient.Client, o.ResourceManagerAuthorizer)
 
+	attestationsClient := attestations.NewAttestationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&attestationsClient.Client, o.ResourceManagerAuthorizer)
+
 	definitionsClient := policy.NewDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&definitionsClient.Client, o.ResourceManagerAuthorizer)
 
 	setDefinitionsClient := policy.NewSetDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&setDefinitionsClient.Client, o.ResourceManagerAuthorizer)
 
+	policyAssignmentsClient := policyassignments.NewPolicyAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	policyDefinitionVersionsClient := policydefinitionversions.NewPolicyDefinitionVersionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyDefinitionVersionsClient.Client, o.ResourceManagerAuthorizer)
+
+	policySetDefinitionVersionsClient := policysetdefinitionversions.NewPolicySetDefinitionVersionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policySetDefinitionVersionsClient.Client, o.ResourceManagerAuthorizer)
+
 	remediationsClient := policyinsights.NewRemediationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&remediationsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -33,8 +53,12 @@ func NewClient(o *common.ClientOptions) *Client {
 
 	return &Client{
 		AssignmentsClient:                   &assignmentsClient,
+		AttestationsClient:                  &attestationsClient,
 		DefinitionsClient:                   &definitionsClient,
 		SetDefinitionsClient:                &setDefinitionsClient,
+		PolicyAssignmentsClient:             &policyAssignmentsClient,
+		PolicyDefinitionVersionsClient:      &policyDefinitionVersionsClient,
+		PolicySetDefinitionVersionsClient:   &policySetDefinitionVersionsClient,
 		RemediationsClient:                  &remediationsClient,
 		GuestConfigurationAssignmentsClient: &guestConfigurationAssignmentsClient,
 	}
@@ -0,0 +1,94 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policydefinitionversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policysetdefinitionversions"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// NOTE: Policy Definition Versions are only available in a newer API version than the Policy Definitions themselves,
+// as such the properties of the Policy (Set) Definition are converted into a Version via their (identical) JSON representation
+
+func publishPolicyDefinitionVersion(ctx context.Context, client *policydefinitionversions.PolicyDefinitionVersionsClient, id policydefinitionversions.ScopedPolicyDefinitionVersionId, input policy.DefinitionProperties) error {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("serializing properties: %+v", err)
+	}
+
+	properties := policydefinitionversions.PolicyDefinitionVersionProperties{}
+	if err := json.Unmarshal(payload, &properties); err != nil {
+		return fmt.Errorf("deserializing properties: %+v", err)
+	}
+	properties.Version = utils.String(id.VersionName)
+
+	version := policydefinitionversions.PolicyDefinitionVersion{
+		Properties: &properties,
+	}
+	if _, err := client.CreateOrUpdate(ctx, id, version); err != nil {
+		return fmt.Errorf("publishing %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// retrievePolicyDefinitionVersion returns the version number of the specified Policy Definition Version,
+// or an empty string when it no longer exists
+func retrievePolicyDefinitionVersion(ctx context.Context, client *policydefinitionversions.PolicyDefinitionVersionsClient, id policydefinitionversions.ScopedPolicyDefinitionVersionId) (string, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Version != nil {
+		return *model.Properties.Version, nil
+	}
+	return id.VersionName, nil
+}
+
+func publishPolicySetDefinitionVersion(ctx context.Context, client *policysetdefinitionversions.PolicySetDefinitionVersionsClient, id policysetdefinitionversions.ScopedPolicySetDefinitionVersionId, input policy.SetDefinitionProperties) error {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("serializing properties: %+v", err)
+	}
+
+	properties := policysetdefinitionversions.PolicySetDefinitionVersionProperties{}
+	if err := json.Unmarshal(payload, &properties); err != nil {
+		return fmt.Errorf("deserializing properties: %+v", err)
+	}
+	properties.Version = utils.String(id.VersionName)
+
+	version := policysetdefinitionversions.PolicySetDefinitionVersion{
+		Properties: &properties,
+	}
+	if _, err := client.CreateOrUpdate(ctx, id, version); err != nil {
+		return fmt.Errorf("publishing %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// retrievePolicySetDefinitionVersion returns the version number of the specified Policy Set Definition Version,
+// or an empty string when it no longer exists
+func retrievePolicySetDefinitionVersion(ctx context.Context, client *policysetdefinitionversions.PolicySetDefinitionVersionsClient, id policysetdefinitionversions.ScopedPolicySetDefinitionVersionId) (string, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Version != nil {
+		return *model.Properties.Version, nil
+	}
+	return id.VersionName, nil
+}
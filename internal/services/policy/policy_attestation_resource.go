@@ -0,0 +1,321 @@
+package policy
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2022-09-01/attestations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmPolicyAttestation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmPolicyAttestationCreateUpdate,
+		Read:   resourceArmPolicyAttestationRead,
+		Update: resourceArmPolicyAttestationCreateUpdate,
+		Delete: resourceArmPolicyAttestationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := attestations.ParseAttestationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					commonids.ValidateSubscriptionID,
+					commonids.ValidateResourceGroupID,
+					azure.ValidateResourceID,
+				),
+			},
+
+			"policy_assignment_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.PolicyAssignmentID,
+			},
+
+			"policy_definition_reference_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validation.StringIsNotEmpty,
+			},
+
+			"compliance_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(attestations.ComplianceStateUnknown),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(attestations.ComplianceStateCompliant),
+					string(attestations.ComplianceStateNonCompliant),
+					string(attestations.ComplianceStateUnknown),
+				}, false),
+			},
+
+			"assessment_date": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"expires_on": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"owner": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"comments": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"evidence": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"source_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+
+			"metadata": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"last_compliance_state_change_at": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmPolicyAttestationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.AttestationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := attestations.NewAttestationID(d.Get("scope").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_policy_attestation", id.ID())
+		}
+	}
+
+	complianceState := attestations.ComplianceState(d.Get("compliance_state").(string))
+	parameters := attestations.Attestation{
+		Properties: attestations.AttestationProperties{
+			ComplianceState:    &complianceState,
+			Evidence:           expandPolicyAttestationEvidence(d.Get("evidence").([]interface{})),
+			PolicyAssignmentId: d.Get("policy_assignment_id").(string),
+		},
+	}
+
+	if v := d.Get("policy_definition_reference_id").(string); v != "" {
+		parameters.Properties.PolicyDefinitionReferenceId = utils.String(v)
+	}
+
+	if v := d.Get("assessment_date").(string); v != "" {
+		parameters.Properties.AssessmentDate = utils.String(v)
+	}
+
+	if v := d.Get("expires_on").(string); v != "" {
+		parameters.Properties.ExpiresOn = utils.String(v)
+	}
+
+	if v := d.Get("owner").(string); v != "" {
+		parameters.Properties.Owner = utils.String(v)
+	}
+
+	if v := d.Get("comments").(string); v != "" {
+		parameters.Properties.Comments = utils.String(v)
+	}
+
+	if v := d.Get("metadata").(string); v != "" {
+		metaData, err := pluginsdk.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("unable to parse metadata: %+v", err)
+		}
+		var metaDataValue interface{} = metaData
+		parameters.Properties.Metadata = &metaDataValue
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmPolicyAttestationRead(d, meta)
+}
+
+func resourceArmPolicyAttestationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.AttestationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := attestations.ParseAttestationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AttestationName)
+	d.Set("scope", id.Scope)
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+
+		d.Set("policy_assignment_id", props.PolicyAssignmentId)
+		d.Set("policy_definition_reference_id", props.PolicyDefinitionReferenceId)
+		d.Set("assessment_date", props.AssessmentDate)
+		d.Set("expires_on", props.ExpiresOn)
+		d.Set("owner", props.Owner)
+		d.Set("comments", props.Comments)
+		d.Set("last_compliance_state_change_at", props.LastComplianceStateChangeAt)
+
+		complianceState := string(attestations.ComplianceStateUnknown)
+		if props.ComplianceState != nil {
+			complianceState = string(*props.ComplianceState)
+		}
+		d.Set("compliance_state", complianceState)
+
+		if err := d.Set("evidence", flattenPolicyAttestationEvidence(props.Evidence)); err != nil {
+			return fmt.Errorf("setting `evidence`: %+v", err)
+		}
+
+		metaData := ""
+		if props.Metadata != nil {
+			if v, ok := (*props.Metadata).(map[string]interface{}); ok && len(v) > 0 {
+				metaData, err = pluginsdk.FlattenJsonToString(v)
+				if err != nil {
+					return fmt.Errorf("flattening `metadata`: %+v", err)
+				}
+			}
+		}
+		d.Set("metadata", metaData)
+	}
+
+	return nil
+}
+
+func resourceArmPolicyAttestationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.AttestationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := attestations.ParseAttestationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandPolicyAttestationEvidence(input []interface{}) *[]attestations.AttestationEvidence {
+	output := make([]attestations.AttestationEvidence, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		evidence := attestations.AttestationEvidence{}
+		if description := v["description"].(string); description != "" {
+			evidence.Description = utils.String(description)
+		}
+		if sourceUri := v["source_uri"].(string); sourceUri != "" {
+			evidence.SourceUri = utils.String(sourceUri)
+		}
+		output = append(output, evidence)
+	}
+	return &output
+}
+
+func flattenPolicyAttestationEvidence(input *[]attestations.AttestationEvidence) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		output = append(output, map[string]interface{}{
+			"description": utils.NormalizeNilableString(item.Description),
+			"source_uri":  utils.NormalizeNilableString(item.SourceUri),
+		})
+	}
+	return output
+}
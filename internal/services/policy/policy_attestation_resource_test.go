@@ -0,0 +1,202 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2022-09-01/attestations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PolicyAttestationResource struct{}
+
+func TestAccPolicyAttestation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_attestation", "test")
+	r := PolicyAttestationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("compliance_state").HasValue("Unknown"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPolicyAttestation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_attestation", "test")
+	r := PolicyAttestationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPolicyAttestation_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_attestation", "test")
+	r := PolicyAttestationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPolicyAttestation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_attestation", "test")
+	r := PolicyAttestationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PolicyAttestationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := attestations.ParseAttestationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Policy.AttestationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r PolicyAttestationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%[1]s"
+  location = "%[2]s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestDef-%[1]s"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestDef-%[1]s"
+
+  policy_rule = <<POLICY_RULE
+  {
+    "if": {
+      "field": "type",
+      "equals": "Microsoft.Resources/subscriptions/resourceGroups"
+    },
+    "then": {
+      "effect": "manual",
+      "details": {
+        "defaultState": "unknown"
+      }
+    }
+  }
+POLICY_RULE
+}
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestAssign-%[1]s"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+}
+`, data.RandomString, data.Locations.Primary)
+}
+
+func (r PolicyAttestationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_attestation" "test" {
+  name                 = "acctestattestation-%s"
+  scope                = azurerm_resource_group.test.id
+  policy_assignment_id = azurerm_resource_group_policy_assignment.test.id
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r PolicyAttestationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_attestation" "import" {
+  name                 = azurerm_policy_attestation.test.name
+  scope                = azurerm_policy_attestation.test.scope
+  policy_assignment_id = azurerm_policy_attestation.test.policy_assignment_id
+}
+`, r.basic(data))
+}
+
+func (r PolicyAttestationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_attestation" "test" {
+  name                 = "acctestattestation-%s"
+  scope                = azurerm_resource_group.test.id
+  policy_assignment_id = azurerm_resource_group_policy_assignment.test.id
+  compliance_state     = "Compliant"
+  assessment_date      = "2022-01-01T00:00:00Z"
+  expires_on           = "2099-01-01T00:00:00Z"
+  owner                = "compliance@example.com"
+  comments             = "Reviewed as part of the quarterly audit"
+
+  evidence {
+    description = "Audit report"
+    source_uri  = "https://example.com/audit-report.pdf"
+  }
+
+  metadata = <<METADATA
+  {
+    "departmentId": "NYC-MARKETING-1"
+  }
+METADATA
+}
+`, r.template(data), data.RandomString)
+}
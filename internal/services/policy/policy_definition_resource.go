@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policydefinitionversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -118,6 +120,12 @@ func resourceArmPolicyDefinition() *pluginsdk.Resource {
 			},
 
 			"metadata": metadataSchema(),
+
+			"version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.DefinitionVersion,
+			},
 		},
 	}
 }
@@ -236,6 +244,14 @@ func resourceArmPolicyDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 	d.SetId(id.Id)
 
+	if v := d.Get("version").(string); v != "" {
+		versionsClient := meta.(*clients.Client).Policy.PolicyDefinitionVersionsClient
+		versionId := policydefinitionversions.NewScopedPolicyDefinitionVersionID(id.ScopeId(), id.Name, v)
+		if err := publishPolicyDefinitionVersion(ctx, versionsClient, versionId, properties); err != nil {
+			return fmt.Errorf("publishing version %q of Policy Definition %q: %+v", v, name, err)
+		}
+	}
+
 	return resourceArmPolicyDefinitionRead(d, meta)
 }
 
@@ -291,6 +307,16 @@ func resourceArmPolicyDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if v := d.Get("version").(string); v != "" && id.PolicyScopeId != nil {
+		versionsClient := meta.(*clients.Client).Policy.PolicyDefinitionVersionsClient
+		versionId := policydefinitionversions.NewScopedPolicyDefinitionVersionID(id.ScopeId(), id.Name, v)
+		version, err := retrievePolicyDefinitionVersion(ctx, versionsClient, versionId)
+		if err != nil {
+			return fmt.Errorf("retrieving version %q of Policy Definition %q: %+v", v, id.Name, err)
+		}
+		d.Set("version", version)
+	}
+
 	return nil
 }
 
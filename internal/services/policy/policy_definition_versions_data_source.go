@@ -0,0 +1,98 @@
+package policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policydefinitionversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceArmPolicyDefinitionVersions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmPolicyDefinitionVersionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmPolicyDefinitionVersionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.PolicyDefinitionVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := policydefinitionversions.NewPolicyDefinitionID(d.Get("name").(string))
+	resp, err := client.ListBuiltInComplete(ctx, id, policydefinitionversions.DefaultListBuiltInOptions())
+	if err != nil {
+		return fmt.Errorf("listing versions of Built-In %s: %+v", id, err)
+	}
+
+	versions := make([]interface{}, 0)
+	for _, item := range resp.Items {
+		version := ""
+		displayName := ""
+		description := ""
+		if props := item.Properties; props != nil {
+			version = utils.NormalizeNilableString(props.Version)
+			displayName = utils.NormalizeNilableString(props.DisplayName)
+			description = utils.NormalizeNilableString(props.Description)
+		}
+
+		versions = append(versions, map[string]interface{}{
+			"id":           utils.NormalizeNilableString(item.Id),
+			"version":      version,
+			"display_name": displayName,
+			"description":  description,
+		})
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.PolicyDefinitionName)
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("setting `versions`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PolicyDefinitionVersionsDataSource struct{}
+
+func TestAccDataSourcePolicyDefinitionVersions_builtIn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_definition_versions", "test")
+	d := PolicyDefinitionVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.builtIn("a08ec900-254a-4555-9bf5-e42af04b5c5c"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").HasValue("/providers/Microsoft.Authorization/policyDefinitions/a08ec900-254a-4555-9bf5-e42af04b5c5c"),
+				check.That(data.ResourceName).Key("versions.0.version").Exists(),
+				check.That(data.ResourceName).Key("versions.0.display_name").HasValue("Allowed resource types"),
+			),
+		},
+	})
+}
+
+func (d PolicyDefinitionVersionsDataSource) builtIn(name string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_policy_definition_versions" "test" {
+  name = "%s"
+}
+`, name)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2023-04-01/policysetdefinitionversions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -201,6 +202,12 @@ func resourceArmPolicySetDefinition() *pluginsdk.Resource {
 				},
 				Set: resourceARMPolicySetDefinitionPolicyDefinitionGroupHash,
 			},
+
+			"version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.DefinitionVersion,
+			},
 		},
 	}
 }
@@ -368,6 +375,14 @@ func resourceArmPolicySetDefinitionCreate(d *pluginsdk.ResourceData, meta interf
 
 	d.SetId(id.Id)
 
+	if v := d.Get("version").(string); v != "" {
+		versionsClient := meta.(*clients.Client).Policy.PolicySetDefinitionVersionsClient
+		versionId := policysetdefinitionversions.NewScopedPolicySetDefinitionVersionID(id.ScopeId(), id.Name, v)
+		if err := publishPolicySetDefinitionVersion(ctx, versionsClient, versionId, properties); err != nil {
+			return fmt.Errorf("publishing version %q of Policy Set Definition %q: %+v", v, name, err)
+		}
+	}
+
 	return resourceArmPolicySetDefinitionRead(d, meta)
 }
 
@@ -477,6 +492,14 @@ func resourceArmPolicySetDefinitionUpdate(d *pluginsdk.ResourceData, meta interf
 
 	d.SetId(id.Id)
 
+	if v := d.Get("version").(string); v != "" {
+		versionsClient := meta.(*clients.Client).Policy.PolicySetDefinitionVersionsClient
+		versionId := policysetdefinitionversions.NewScopedPolicySetDefinitionVersionID(id.ScopeId(), id.Name, v)
+		if err := publishPolicySetDefinitionVersion(ctx, versionsClient, versionId, *existing.SetDefinitionProperties); err != nil {
+			return fmt.Errorf("publishing version %q of Policy Set Definition %q: %+v", v, id.Name, err)
+		}
+	}
+
 	return resourceArmPolicySetDefinitionRead(d, meta)
 }
 
@@ -555,6 +578,16 @@ func resourceArmPolicySetDefinitionRead(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
+	if v := d.Get("version").(string); v != "" && id.PolicyScopeId != nil {
+		versionsClient := meta.(*clients.Client).Policy.PolicySetDefinitionVersionsClient
+		versionId := policysetdefinitionversions.NewScopedPolicySetDefinitionVersionID(id.ScopeId(), id.Name, v)
+		version, err := retrievePolicySetDefinitionVersion(ctx, versionsClient, versionId)
+		if err != nil {
+			return fmt.Errorf("retrieving version %q of Policy Set Definition %q: %+v", v, id.Name, err)
+		}
+		d.Set("version", version)
+	}
+
 	return nil
 }
 
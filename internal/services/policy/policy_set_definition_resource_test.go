@@ -91,6 +91,30 @@ func TestAccAzureRMPolicySetDefinition_custom(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPolicySetDefinition_customVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_set_definition", "test")
+	r := PolicySetDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customVersion(data, "1.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("1.0.0"),
+			),
+		},
+		data.ImportStep("version"),
+		{
+			Config: r.customVersion(data, "2.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("2.0.0"),
+			),
+		},
+		data.ImportStep("version"),
+	})
+}
+
 func TestAccAzureRMPolicySetDefinition_customNoParameter(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_policy_set_definition", "test")
 	r := PolicySetDefinitionResource{}
@@ -448,6 +472,42 @@ VALUES
 `, template, data.RandomInteger, data.RandomInteger)
 }
 
+func (r PolicySetDefinitionResource) customVersion(data acceptance.TestData, version string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_set_definition" "test" {
+  name         = "acctestPolSet-%d"
+  policy_type  = "Custom"
+  display_name = "acctestPolSet-display-%d"
+  version      = "%s"
+
+  parameters = <<PARAMETERS
+    {
+        "allowedLocations": {
+            "type": "Array",
+            "metadata": {
+                "description": "The list of allowed locations for resources.",
+                "displayName": "Allowed locations",
+                "strongType": "location"
+            }
+        }
+    }
+PARAMETERS
+
+  policy_definition_reference {
+    policy_definition_id = azurerm_policy_definition.test.id
+    parameter_values     = <<VALUES
+	{
+      "allowedLocations": {"value": "[parameters('allowedLocations')]"}
+    }
+VALUES
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger, version)
+}
+
 func (r PolicySetDefinitionResource) customUpdateDisplayName(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,38 @@
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PolicySetDefinitionVersionsDataSource struct{}
+
+func TestAccDataSourcePolicySetDefinitionVersions_builtIn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_set_definition_versions", "test")
+	d := PolicySetDefinitionVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.builtIn("1f3afdf9-d0c9-4c3d-847f-89da613e70a8"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").HasValue("/providers/Microsoft.Authorization/policySetDefinitions/1f3afdf9-d0c9-4c3d-847f-89da613e70a8"),
+				check.That(data.ResourceName).Key("versions.0.version").Exists(),
+			),
+		},
+	})
+}
+
+func (d PolicySetDefinitionVersionsDataSource) builtIn(name string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_policy_set_definition_versions" "test" {
+  name = "%s"
+}
+`, name)
+}
@@ -40,7 +40,9 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_policy_definition":                               dataSourceArmPolicyDefinition(),
+		"azurerm_policy_definition_versions":                      dataSourceArmPolicyDefinitionVersions(),
 		"azurerm_policy_set_definition":                           dataSourceArmPolicySetDefinition(),
+		"azurerm_policy_set_definition_versions":                  dataSourceArmPolicySetDefinitionVersions(),
 		"azurerm_policy_virtual_machine_configuration_assignment": dataSourcePolicyVirtualMachineConfigurationAssignment(),
 	}
 }
@@ -48,6 +50,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	resources := map[string]*pluginsdk.Resource{
+		"azurerm_policy_attestation":                              resourceArmPolicyAttestation(),
 		"azurerm_policy_definition":                               resourceArmPolicyDefinition(),
 		"azurerm_policy_set_definition":                           resourceArmPolicySetDefinition(),
 		"azurerm_policy_remediation":                              resourceArmPolicyRemediation(),
@@ -0,0 +1,15 @@
+package attestations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AttestationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAttestationsClientWithBaseURI(endpoint string) AttestationsClient {
+	return AttestationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,34 @@
+package attestations
+
+import "strings"
+
+type ComplianceState string
+
+const (
+	ComplianceStateCompliant    ComplianceState = "Compliant"
+	ComplianceStateNonCompliant ComplianceState = "NonCompliant"
+	ComplianceStateUnknown      ComplianceState = "Unknown"
+)
+
+func PossibleValuesForComplianceState() []string {
+	return []string{
+		string(ComplianceStateCompliant),
+		string(ComplianceStateNonCompliant),
+		string(ComplianceStateUnknown),
+	}
+}
+
+func parseComplianceState(input string) (*ComplianceState, error) {
+	vals := map[string]ComplianceState{
+		"compliant":    ComplianceStateCompliant,
+		"noncompliant": ComplianceStateNonCompliant,
+		"unknown":      ComplianceStateUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ComplianceState(input)
+	return &out, nil
+}
@@ -0,0 +1,110 @@
+package attestations
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = AttestationId{}
+
+// AttestationId is a struct representing the Resource ID for a Attestation
+type AttestationId struct {
+	Scope           string
+	AttestationName string
+}
+
+// NewAttestationID returns a new AttestationId struct
+func NewAttestationID(scope string, attestationName string) AttestationId {
+	return AttestationId{
+		Scope:           scope,
+		AttestationName: attestationName,
+	}
+}
+
+// ParseAttestationID parses 'input' into a AttestationId
+func ParseAttestationID(input string) (*AttestationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AttestationId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AttestationId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.AttestationName, ok = parsed.Parsed["attestationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'attestationName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseAttestationIDInsensitively parses 'input' case-insensitively into a AttestationId
+// note: this method should only be used for API response data and not user input
+func ParseAttestationIDInsensitively(input string) (*AttestationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AttestationId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AttestationId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.AttestationName, ok = parsed.Parsed["attestationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'attestationName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateAttestationID checks that 'input' can be parsed as a Attestation ID
+func ValidateAttestationID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAttestationID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Attestation ID
+func (id AttestationId) ID() string {
+	fmtString := "/%s/providers/Microsoft.PolicyInsights/attestations/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.AttestationName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Attestation ID
+func (id AttestationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftPolicyInsights", "Microsoft.PolicyInsights", "Microsoft.PolicyInsights"),
+		resourceids.StaticSegment("staticAttestations", "attestations", "attestations"),
+		resourceids.UserSpecifiedSegment("attestationName", "attestationValue"),
+	}
+}
+
+// String returns a human-readable description of this Attestation ID
+func (id AttestationId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Attestation Name: %q", id.AttestationName),
+	}
+	return fmt.Sprintf("Attestation (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package attestations
+
+type Attestation struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties AttestationProperties `json:"properties"`
+	Type       *string               `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package attestations
+
+type AttestationEvidence struct {
+	Description *string `json:"description,omitempty"`
+	SourceUri   *string `json:"sourceUri,omitempty"`
+}
@@ -0,0 +1,15 @@
+package policyassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PolicyAssignmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPolicyAssignmentsClientWithBaseURI(endpoint string) PolicyAssignmentsClient {
+	return PolicyAssignmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package policyassignments
+
+type NonComplianceMessage struct {
+	Message                     string  `json:"message"`
+	PolicyDefinitionReferenceId *string `json:"policyDefinitionReferenceId,omitempty"`
+}
@@ -0,0 +1,7 @@
+package policyassignments
+
+type Override struct {
+	Kind      *OverrideKind `json:"kind,omitempty"`
+	Selectors *[]Selector   `json:"selectors,omitempty"`
+	Value     *string       `json:"value,omitempty"`
+}
@@ -0,0 +1,5 @@
+package policyassignments
+
+type ParameterValuesValue struct {
+	Value *interface{} `json:"value,omitempty"`
+}
@@ -0,0 +1,14 @@
+package policyassignments
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type PolicyAssignment struct {
+	Id         *string                           `json:"id,omitempty"`
+	Identity   *identity.SystemOrUserAssignedMap `json:"identity,omitempty"`
+	Location   *string                           `json:"location,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *PolicyAssignmentProperties       `json:"properties,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,18 @@
+package policyassignments
+
+type PolicyAssignmentProperties struct {
+	DefinitionVersion          *string                          `json:"definitionVersion,omitempty"`
+	Description                *string                          `json:"description,omitempty"`
+	DisplayName                *string                          `json:"displayName,omitempty"`
+	EffectiveDefinitionVersion *string                          `json:"effectiveDefinitionVersion,omitempty"`
+	EnforcementMode            *EnforcementMode                 `json:"enforcementMode,omitempty"`
+	LatestDefinitionVersion    *string                          `json:"latestDefinitionVersion,omitempty"`
+	Metadata                   *interface{}                     `json:"metadata,omitempty"`
+	NonComplianceMessages      *[]NonComplianceMessage          `json:"nonComplianceMessages,omitempty"`
+	NotScopes                  *[]string                        `json:"notScopes,omitempty"`
+	Overrides                  *[]Override                      `json:"overrides,omitempty"`
+	Parameters                 *map[string]ParameterValuesValue `json:"parameters,omitempty"`
+	PolicyDefinitionId         *string                          `json:"policyDefinitionId,omitempty"`
+	ResourceSelectors          *[]ResourceSelector              `json:"resourceSelectors,omitempty"`
+	Scope                      *string                          `json:"scope,omitempty"`
+}
@@ -0,0 +1,6 @@
+package policyassignments
+
+type ResourceSelector struct {
+	Name      *string     `json:"name,omitempty"`
+	Selectors *[]Selector `json:"selectors,omitempty"`
+}
@@ -0,0 +1,7 @@
+package policyassignments
+
+type Selector struct {
+	In    *[]string     `json:"in,omitempty"`
+	Kind  *SelectorKind `json:"kind,omitempty"`
+	NotIn *[]string     `json:"notIn,omitempty"`
+}
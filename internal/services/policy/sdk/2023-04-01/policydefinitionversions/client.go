@@ -0,0 +1,15 @@
+package policydefinitionversions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PolicyDefinitionVersionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPolicyDefinitionVersionsClientWithBaseURI(endpoint string) PolicyDefinitionVersionsClient {
+	return PolicyDefinitionVersionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
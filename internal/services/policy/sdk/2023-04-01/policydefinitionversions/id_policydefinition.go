@@ -0,0 +1,98 @@
+package policydefinitionversions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = PolicyDefinitionId{}
+
+// PolicyDefinitionId is a struct representing the Resource ID for a Policy Definition
+type PolicyDefinitionId struct {
+	PolicyDefinitionName string
+}
+
+// NewPolicyDefinitionID returns a new PolicyDefinitionId struct
+func NewPolicyDefinitionID(policyDefinitionName string) PolicyDefinitionId {
+	return PolicyDefinitionId{
+		PolicyDefinitionName: policyDefinitionName,
+	}
+}
+
+// ParsePolicyDefinitionID parses 'input' into a PolicyDefinitionId
+func ParsePolicyDefinitionID(input string) (*PolicyDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PolicyDefinitionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PolicyDefinitionId{}
+
+	if id.PolicyDefinitionName, ok = parsed.Parsed["policyDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParsePolicyDefinitionIDInsensitively parses 'input' case-insensitively into a PolicyDefinitionId
+// note: this method should only be used for API response data and not user input
+func ParsePolicyDefinitionIDInsensitively(input string) (*PolicyDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PolicyDefinitionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PolicyDefinitionId{}
+
+	if id.PolicyDefinitionName, ok = parsed.Parsed["policyDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidatePolicyDefinitionID checks that 'input' can be parsed as a Policy Definition ID
+func ValidatePolicyDefinitionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePolicyDefinitionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Policy Definition ID
+func (id PolicyDefinitionId) ID() string {
+	fmtString := "/providers/Microsoft.Authorization/policyDefinitions/%s"
+	return fmt.Sprintf(fmtString, id.PolicyDefinitionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Policy Definition ID
+func (id PolicyDefinitionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyDefinitions", "policyDefinitions", "policyDefinitions"),
+		resourceids.UserSpecifiedSegment("policyDefinitionName", "policyDefinitionValue"),
+	}
+}
+
+// String returns a human-readable description of this Policy Definition ID
+func (id PolicyDefinitionId) String() string {
+	components := []string{
+		fmt.Sprintf("Policy Definition Name: %q", id.PolicyDefinitionName),
+	}
+	return fmt.Sprintf("Policy Definition (%s)", strings.Join(components, "\n"))
+}
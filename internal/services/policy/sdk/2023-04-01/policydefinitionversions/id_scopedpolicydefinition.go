@@ -0,0 +1,110 @@
+package policydefinitionversions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScopedPolicyDefinitionId{}
+
+// ScopedPolicyDefinitionId is a struct representing the Resource ID for a Scoped Policy Definition
+type ScopedPolicyDefinitionId struct {
+	Scope                string
+	PolicyDefinitionName string
+}
+
+// NewScopedPolicyDefinitionID returns a new ScopedPolicyDefinitionId struct
+func NewScopedPolicyDefinitionID(scope string, policyDefinitionName string) ScopedPolicyDefinitionId {
+	return ScopedPolicyDefinitionId{
+		Scope:                scope,
+		PolicyDefinitionName: policyDefinitionName,
+	}
+}
+
+// ParseScopedPolicyDefinitionID parses 'input' into a ScopedPolicyDefinitionId
+func ParseScopedPolicyDefinitionID(input string) (*ScopedPolicyDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicyDefinitionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicyDefinitionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicyDefinitionName, ok = parsed.Parsed["policyDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseScopedPolicyDefinitionIDInsensitively parses 'input' case-insensitively into a ScopedPolicyDefinitionId
+// note: this method should only be used for API response data and not user input
+func ParseScopedPolicyDefinitionIDInsensitively(input string) (*ScopedPolicyDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicyDefinitionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicyDefinitionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicyDefinitionName, ok = parsed.Parsed["policyDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateScopedPolicyDefinitionID checks that 'input' can be parsed as a Scoped Policy Definition ID
+func ValidateScopedPolicyDefinitionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseScopedPolicyDefinitionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scoped Policy Definition ID
+func (id ScopedPolicyDefinitionId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/policyDefinitions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.PolicyDefinitionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scoped Policy Definition ID
+func (id ScopedPolicyDefinitionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyDefinitions", "policyDefinitions", "policyDefinitions"),
+		resourceids.UserSpecifiedSegment("policyDefinitionName", "policyDefinitionValue"),
+	}
+}
+
+// String returns a human-readable description of this Scoped Policy Definition ID
+func (id ScopedPolicyDefinitionId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Policy Definition Name: %q", id.PolicyDefinitionName),
+	}
+	return fmt.Sprintf("Scoped Policy Definition (%s)", strings.Join(components, "\n"))
+}
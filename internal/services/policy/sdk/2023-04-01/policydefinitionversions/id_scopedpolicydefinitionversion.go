@@ -0,0 +1,123 @@
+package policydefinitionversions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScopedPolicyDefinitionVersionId{}
+
+// ScopedPolicyDefinitionVersionId is a struct representing the Resource ID for a Scoped Policy Definition Version
+type ScopedPolicyDefinitionVersionId struct {
+	Scope                string
+	PolicyDefinitionName string
+	VersionName          string
+}
+
+// NewScopedPolicyDefinitionVersionID returns a new ScopedPolicyDefinitionVersionId struct
+func NewScopedPolicyDefinitionVersionID(scope string, policyDefinitionName string, versionName string) ScopedPolicyDefinitionVersionId {
+	return ScopedPolicyDefinitionVersionId{
+		Scope:                scope,
+		PolicyDefinitionName: policyDefinitionName,
+		VersionName:          versionName,
+	}
+}
+
+// ParseScopedPolicyDefinitionVersionID parses 'input' into a ScopedPolicyDefinitionVersionId
+func ParseScopedPolicyDefinitionVersionID(input string) (*ScopedPolicyDefinitionVersionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicyDefinitionVersionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicyDefinitionVersionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicyDefinitionName, ok = parsed.Parsed["policyDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyDefinitionName' was not found in the resource id %q", input)
+	}
+
+	if id.VersionName, ok = parsed.Parsed["versionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'versionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseScopedPolicyDefinitionVersionIDInsensitively parses 'input' case-insensitively into a ScopedPolicyDefinitionVersionId
+// note: this method should only be used for API response data and not user input
+func ParseScopedPolicyDefinitionVersionIDInsensitively(input string) (*ScopedPolicyDefinitionVersionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicyDefinitionVersionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicyDefinitionVersionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicyDefinitionName, ok = parsed.Parsed["policyDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyDefinitionName' was not found in the resource id %q", input)
+	}
+
+	if id.VersionName, ok = parsed.Parsed["versionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'versionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateScopedPolicyDefinitionVersionID checks that 'input' can be parsed as a Scoped Policy Definition Version ID
+func ValidateScopedPolicyDefinitionVersionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseScopedPolicyDefinitionVersionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scoped Policy Definition Version ID
+func (id ScopedPolicyDefinitionVersionId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/policyDefinitions/%s/versions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.PolicyDefinitionName, id.VersionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scoped Policy Definition Version ID
+func (id ScopedPolicyDefinitionVersionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyDefinitions", "policyDefinitions", "policyDefinitions"),
+		resourceids.UserSpecifiedSegment("policyDefinitionName", "policyDefinitionValue"),
+		resourceids.StaticSegment("staticVersions", "versions", "versions"),
+		resourceids.UserSpecifiedSegment("versionName", "versionValue"),
+	}
+}
+
+// String returns a human-readable description of this Scoped Policy Definition Version ID
+func (id ScopedPolicyDefinitionVersionId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Policy Definition Name: %q", id.PolicyDefinitionName),
+		fmt.Sprintf("Version Name: %q", id.VersionName),
+	}
+	return fmt.Sprintf("Scoped Policy Definition Version (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package policydefinitionversions
+
+type PolicyDefinitionVersion struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *PolicyDefinitionVersionProperties `json:"properties,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
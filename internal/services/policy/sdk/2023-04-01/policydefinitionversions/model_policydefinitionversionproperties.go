@@ -0,0 +1,12 @@
+package policydefinitionversions
+
+type PolicyDefinitionVersionProperties struct {
+	Description *string                               `json:"description,omitempty"`
+	DisplayName *string                               `json:"displayName,omitempty"`
+	Metadata    *interface{}                          `json:"metadata,omitempty"`
+	Mode        *string                               `json:"mode,omitempty"`
+	Parameters  *map[string]ParameterDefinitionsValue `json:"parameters,omitempty"`
+	PolicyRule  *interface{}                          `json:"policyRule,omitempty"`
+	PolicyType  *PolicyType                           `json:"policyType,omitempty"`
+	Version     *string                               `json:"version,omitempty"`
+}
@@ -0,0 +1,15 @@
+package policysetdefinitionversions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PolicySetDefinitionVersionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPolicySetDefinitionVersionsClientWithBaseURI(endpoint string) PolicySetDefinitionVersionsClient {
+	return PolicySetDefinitionVersionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
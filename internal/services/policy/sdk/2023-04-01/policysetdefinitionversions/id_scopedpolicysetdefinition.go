@@ -0,0 +1,110 @@
+package policysetdefinitionversions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScopedPolicySetDefinitionId{}
+
+// ScopedPolicySetDefinitionId is a struct representing the Resource ID for a Scoped Policy Set Definition
+type ScopedPolicySetDefinitionId struct {
+	Scope                   string
+	PolicySetDefinitionName string
+}
+
+// NewScopedPolicySetDefinitionID returns a new ScopedPolicySetDefinitionId struct
+func NewScopedPolicySetDefinitionID(scope string, policySetDefinitionName string) ScopedPolicySetDefinitionId {
+	return ScopedPolicySetDefinitionId{
+		Scope:                   scope,
+		PolicySetDefinitionName: policySetDefinitionName,
+	}
+}
+
+// ParseScopedPolicySetDefinitionID parses 'input' into a ScopedPolicySetDefinitionId
+func ParseScopedPolicySetDefinitionID(input string) (*ScopedPolicySetDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicySetDefinitionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicySetDefinitionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicySetDefinitionName, ok = parsed.Parsed["policySetDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policySetDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseScopedPolicySetDefinitionIDInsensitively parses 'input' case-insensitively into a ScopedPolicySetDefinitionId
+// note: this method should only be used for API response data and not user input
+func ParseScopedPolicySetDefinitionIDInsensitively(input string) (*ScopedPolicySetDefinitionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicySetDefinitionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicySetDefinitionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicySetDefinitionName, ok = parsed.Parsed["policySetDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policySetDefinitionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateScopedPolicySetDefinitionID checks that 'input' can be parsed as a Scoped Policy Set Definition ID
+func ValidateScopedPolicySetDefinitionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseScopedPolicySetDefinitionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scoped Policy Set Definition ID
+func (id ScopedPolicySetDefinitionId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/policySetDefinitions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.PolicySetDefinitionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scoped Policy Set Definition ID
+func (id ScopedPolicySetDefinitionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicySetDefinitions", "policySetDefinitions", "policySetDefinitions"),
+		resourceids.UserSpecifiedSegment("policySetDefinitionName", "policySetDefinitionValue"),
+	}
+}
+
+// String returns a human-readable description of this Scoped Policy Set Definition ID
+func (id ScopedPolicySetDefinitionId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Policy Set Definition Name: %q", id.PolicySetDefinitionName),
+	}
+	return fmt.Sprintf("Scoped Policy Set Definition (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,123 @@
+package policysetdefinitionversions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScopedPolicySetDefinitionVersionId{}
+
+// ScopedPolicySetDefinitionVersionId is a struct representing the Resource ID for a Scoped Policy Set Definition Version
+type ScopedPolicySetDefinitionVersionId struct {
+	Scope                   string
+	PolicySetDefinitionName string
+	VersionName             string
+}
+
+// NewScopedPolicySetDefinitionVersionID returns a new ScopedPolicySetDefinitionVersionId struct
+func NewScopedPolicySetDefinitionVersionID(scope string, policySetDefinitionName string, versionName string) ScopedPolicySetDefinitionVersionId {
+	return ScopedPolicySetDefinitionVersionId{
+		Scope:                   scope,
+		PolicySetDefinitionName: policySetDefinitionName,
+		VersionName:             versionName,
+	}
+}
+
+// ParseScopedPolicySetDefinitionVersionID parses 'input' into a ScopedPolicySetDefinitionVersionId
+func ParseScopedPolicySetDefinitionVersionID(input string) (*ScopedPolicySetDefinitionVersionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicySetDefinitionVersionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicySetDefinitionVersionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicySetDefinitionName, ok = parsed.Parsed["policySetDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policySetDefinitionName' was not found in the resource id %q", input)
+	}
+
+	if id.VersionName, ok = parsed.Parsed["versionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'versionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseScopedPolicySetDefinitionVersionIDInsensitively parses 'input' case-insensitively into a ScopedPolicySetDefinitionVersionId
+// note: this method should only be used for API response data and not user input
+func ParseScopedPolicySetDefinitionVersionIDInsensitively(input string) (*ScopedPolicySetDefinitionVersionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedPolicySetDefinitionVersionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedPolicySetDefinitionVersionId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.PolicySetDefinitionName, ok = parsed.Parsed["policySetDefinitionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'policySetDefinitionName' was not found in the resource id %q", input)
+	}
+
+	if id.VersionName, ok = parsed.Parsed["versionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'versionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateScopedPolicySetDefinitionVersionID checks that 'input' can be parsed as a Scoped Policy Set Definition Version ID
+func ValidateScopedPolicySetDefinitionVersionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseScopedPolicySetDefinitionVersionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scoped Policy Set Definition Version ID
+func (id ScopedPolicySetDefinitionVersionId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/policySetDefinitions/%s/versions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.PolicySetDefinitionName, id.VersionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scoped Policy Set Definition Version ID
+func (id ScopedPolicySetDefinitionVersionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicySetDefinitions", "policySetDefinitions", "policySetDefinitions"),
+		resourceids.UserSpecifiedSegment("policySetDefinitionName", "policySetDefinitionValue"),
+		resourceids.StaticSegment("staticVersions", "versions", "versions"),
+		resourceids.UserSpecifiedSegment("versionName", "versionValue"),
+	}
+}
+
+// String returns a human-readable description of this Scoped Policy Set Definition Version ID
+func (id ScopedPolicySetDefinitionVersionId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Policy Set Definition Name: %q", id.PolicySetDefinitionName),
+		fmt.Sprintf("Version Name: %q", id.VersionName),
+	}
+	return fmt.Sprintf("Scoped Policy Set Definition Version (%s)", strings.Join(components, "\n"))
+}
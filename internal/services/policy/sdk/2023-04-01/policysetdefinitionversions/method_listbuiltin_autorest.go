@@ -0,0 +1,205 @@
+package policysetdefinitionversions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListBuiltInResponse struct {
+	HttpResponse *http.Response
+	Model        *[]PolicySetDefinitionVersion
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ListBuiltInResponse, error)
+}
+
+type ListBuiltInCompleteResult struct {
+	Items []PolicySetDefinitionVersion
+}
+
+func (r ListBuiltInResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ListBuiltInResponse) LoadMore(ctx context.Context) (resp ListBuiltInResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+type ListBuiltInOptions struct {
+	Top *int64
+}
+
+func DefaultListBuiltInOptions() ListBuiltInOptions {
+	return ListBuiltInOptions{}
+}
+
+func (o ListBuiltInOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.Top != nil {
+		out["$top"] = *o.Top
+	}
+
+	return out
+}
+
+// ListBuiltIn ...
+func (c PolicySetDefinitionVersionsClient) ListBuiltIn(ctx context.Context, id PolicySetDefinitionId, options ListBuiltInOptions) (resp ListBuiltInResponse, err error) {
+	req, err := c.preparerForListBuiltIn(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policysetdefinitionversions.PolicySetDefinitionVersionsClient", "ListBuiltIn", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policysetdefinitionversions.PolicySetDefinitionVersionsClient", "ListBuiltIn", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForListBuiltIn(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policysetdefinitionversions.PolicySetDefinitionVersionsClient", "ListBuiltIn", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ListBuiltInComplete retrieves all of the results into a single object
+func (c PolicySetDefinitionVersionsClient) ListBuiltInComplete(ctx context.Context, id PolicySetDefinitionId, options ListBuiltInOptions) (ListBuiltInCompleteResult, error) {
+	return c.ListBuiltInCompleteMatchingPredicate(ctx, id, options, PolicySetDefinitionVersionPredicate{})
+}
+
+// ListBuiltInCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c PolicySetDefinitionVersionsClient) ListBuiltInCompleteMatchingPredicate(ctx context.Context, id PolicySetDefinitionId, options ListBuiltInOptions, predicate PolicySetDefinitionVersionPredicate) (resp ListBuiltInCompleteResult, err error) {
+	items := make([]PolicySetDefinitionVersion, 0)
+
+	page, err := c.ListBuiltIn(ctx, id, options)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ListBuiltInCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForListBuiltIn prepares the ListBuiltIn request.
+func (c PolicySetDefinitionVersionsClient) preparerForListBuiltIn(ctx context.Context, id PolicySetDefinitionId, options ListBuiltInOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/versions", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListBuiltInWithNextLink prepares the ListBuiltIn request with the given nextLink token.
+func (c PolicySetDefinitionVersionsClient) preparerForListBuiltInWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListBuiltIn handles the response to the ListBuiltIn request. The method always
+// closes the http.Response Body.
+func (c PolicySetDefinitionVersionsClient) responderForListBuiltIn(resp *http.Response) (result ListBuiltInResponse, err error) {
+	type page struct {
+		Values   []PolicySetDefinitionVersion `json:"value"`
+		NextLink *string                      `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ListBuiltInResponse, err error) {
+			req, err := c.preparerForListBuiltInWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "policysetdefinitionversions.PolicySetDefinitionVersionsClient", "ListBuiltIn", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "policysetdefinitionversions.PolicySetDefinitionVersionsClient", "ListBuiltIn", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForListBuiltIn(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "policysetdefinitionversions.PolicySetDefinitionVersionsClient", "ListBuiltIn", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
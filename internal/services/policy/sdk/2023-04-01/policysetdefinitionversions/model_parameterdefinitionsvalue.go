@@ -0,0 +1,9 @@
+package policysetdefinitionversions
+
+type ParameterDefinitionsValue struct {
+	AllowedValues *[]interface{}                     `json:"allowedValues,omitempty"`
+	DefaultValue  *interface{}                       `json:"defaultValue,omitempty"`
+	Metadata      *ParameterDefinitionsValueMetadata `json:"metadata,omitempty"`
+	Schema        *interface{}                       `json:"schema,omitempty"`
+	Type          *ParameterType                     `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package policysetdefinitionversions
+
+type ParameterDefinitionsValueMetadata struct {
+	AssignPermissions *bool   `json:"assignPermissions,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	DisplayName       *string `json:"displayName,omitempty"`
+	StrongType        *string `json:"strongType,omitempty"`
+}
@@ -0,0 +1,5 @@
+package policysetdefinitionversions
+
+type ParameterValuesValue struct {
+	Value *interface{} `json:"value,omitempty"`
+}
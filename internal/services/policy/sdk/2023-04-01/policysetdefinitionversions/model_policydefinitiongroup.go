@@ -0,0 +1,9 @@
+package policysetdefinitionversions
+
+type PolicyDefinitionGroup struct {
+	AdditionalMetadataId *string `json:"additionalMetadataId,omitempty"`
+	Category             *string `json:"category,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	DisplayName          *string `json:"displayName,omitempty"`
+	Name                 string  `json:"name"`
+}
@@ -0,0 +1,9 @@
+package policysetdefinitionversions
+
+type PolicyDefinitionReference struct {
+	DefinitionVersion           *string                          `json:"definitionVersion,omitempty"`
+	GroupNames                  *[]string                        `json:"groupNames,omitempty"`
+	Parameters                  *map[string]ParameterValuesValue `json:"parameters,omitempty"`
+	PolicyDefinitionId          string                           `json:"policyDefinitionId"`
+	PolicyDefinitionReferenceId *string                          `json:"policyDefinitionReferenceId,omitempty"`
+}
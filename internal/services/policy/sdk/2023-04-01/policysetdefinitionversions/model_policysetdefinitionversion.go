@@ -0,0 +1,8 @@
+package policysetdefinitionversions
+
+type PolicySetDefinitionVersion struct {
+	Id         *string                               `json:"id,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Properties *PolicySetDefinitionVersionProperties `json:"properties,omitempty"`
+	Type       *string                               `json:"type,omitempty"`
+}
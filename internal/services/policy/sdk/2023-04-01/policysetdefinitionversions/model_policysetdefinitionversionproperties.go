@@ -0,0 +1,12 @@
+package policysetdefinitionversions
+
+type PolicySetDefinitionVersionProperties struct {
+	Description            *string                               `json:"description,omitempty"`
+	DisplayName            *string                               `json:"displayName,omitempty"`
+	Metadata               *interface{}                          `json:"metadata,omitempty"`
+	Parameters             *map[string]ParameterDefinitionsValue `json:"parameters,omitempty"`
+	PolicyDefinitionGroups *[]PolicyDefinitionGroup              `json:"policyDefinitionGroups,omitempty"`
+	PolicyDefinitions      []PolicyDefinitionReference           `json:"policyDefinitions"`
+	PolicyType             *PolicyType                           `json:"policyType,omitempty"`
+	Version                *string                               `json:"version,omitempty"`
+}
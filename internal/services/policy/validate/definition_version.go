@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DefinitionVersion validates the version of a Policy Definition or Policy Set Definition, which must be
+// a semantic version in the format `{major}.{minor}.{patch}`
+func DefinitionVersion(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^\d+\.\d+\.\d+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `{major}.{minor}.{patch}`, e.g. `1.0.0`, but got %q", k, v))
+	}
+
+	return
+}
+
+// AssignmentDefinitionVersion validates the Definition Version pinned on a Policy Assignment, where the
+// minor and patch versions can be a wildcard (e.g. `1.*.*`)
+func AssignmentDefinitionVersion(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^\d+\.(\*\.\*|\d+\.\*|\d+\.\d+)$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `{major}.{minor}.{patch}` where the minor and patch versions can be `*`, e.g. `1.*.*`, but got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package validate
+
+import "testing"
+
+func TestDefinitionVersion(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "1",
+			Expected: false,
+		},
+		{
+			Input:    "1.0",
+			Expected: false,
+		},
+		{
+			Input:    "1.0.0",
+			Expected: true,
+		},
+		{
+			Input:    "12.34.56",
+			Expected: true,
+		},
+		{
+			Input:    "1.*.*",
+			Expected: false,
+		},
+		{
+			Input:    "1.0.0-preview",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := DefinitionVersion(v.Input, "version")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestAssignmentDefinitionVersion(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "1",
+			Expected: false,
+		},
+		{
+			Input:    "1.*.*",
+			Expected: true,
+		},
+		{
+			Input:    "1.2.*",
+			Expected: true,
+		},
+		{
+			Input:    "1.2.3",
+			Expected: true,
+		},
+		{
+			Input:    "1.*.3",
+			Expected: false,
+		},
+		{
+			Input:    "*.*.*",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := AssignmentDefinitionVersion(v.Input, "definition_version")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -4,22 +4,26 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2021-06-01/postgresqlflexibleservers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/virtualendpoints"
 )
 
 type Client struct {
-	ConfigurationsClient                *postgresql.ConfigurationsClient
-	DatabasesClient                     *postgresql.DatabasesClient
-	FirewallRulesClient                 *postgresql.FirewallRulesClient
-	FlexibleServersClient               *postgresqlflexibleservers.ServersClient
-	FlexibleServersConfigurationsClient *postgresqlflexibleservers.ConfigurationsClient
-	FlexibleServerFirewallRuleClient    *postgresqlflexibleservers.FirewallRulesClient
-	FlexibleServerDatabaseClient        *postgresqlflexibleservers.DatabasesClient
-	ServersClient                       *postgresql.ServersClient
-	ServerKeysClient                    *postgresql.ServerKeysClient
-	ServerSecurityAlertPoliciesClient   *postgresql.ServerSecurityAlertPoliciesClient
-	VirtualNetworkRulesClient           *postgresql.VirtualNetworkRulesClient
-	ServerAdministratorsClient          *postgresql.ServerAdministratorsClient
-	ReplicasClient                      *postgresql.ReplicasClient
+	ConfigurationsClient                 *postgresql.ConfigurationsClient
+	DatabasesClient                      *postgresql.DatabasesClient
+	FirewallRulesClient                  *postgresql.FirewallRulesClient
+	FlexibleServersClient                *postgresqlflexibleservers.ServersClient
+	FlexibleServersConfigurationsClient  *postgresqlflexibleservers.ConfigurationsClient
+	FlexibleServerFirewallRuleClient     *postgresqlflexibleservers.FirewallRulesClient
+	FlexibleServerDatabaseClient         *postgresqlflexibleservers.DatabasesClient
+	FlexibleServersPreviewClient         *servers.ServersClient
+	FlexibleServerVirtualEndpointsClient *virtualendpoints.VirtualEndpointsClient
+	ServersClient                        *postgresql.ServersClient
+	ServerKeysClient                     *postgresql.ServerKeysClient
+	ServerSecurityAlertPoliciesClient    *postgresql.ServerSecurityAlertPoliciesClient
+	VirtualNetworkRulesClient            *postgresql.VirtualNetworkRulesClient
+	ServerAdministratorsClient           *postgresql.ServerAdministratorsClient
+	ReplicasClient                       *postgresql.ReplicasClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -62,19 +66,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerConfigurationsClient := postgresqlflexibleservers.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServersPreviewClient := servers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServersPreviewClient.Client, o.ResourceManagerAuthorizer)
+
+	flexibleServerVirtualEndpointsClient := virtualendpoints.NewVirtualEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerVirtualEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ConfigurationsClient:                &configurationsClient,
-		DatabasesClient:                     &databasesClient,
-		FirewallRulesClient:                 &firewallRulesClient,
-		FlexibleServersConfigurationsClient: &flexibleServerConfigurationsClient,
-		FlexibleServersClient:               &flexibleServersClient,
-		FlexibleServerFirewallRuleClient:    &flexibleServerFirewallRuleClient,
-		FlexibleServerDatabaseClient:        &flexibleServerDatabaseClient,
-		ServersClient:                       &serversClient,
-		ServerKeysClient:                    &serverKeysClient,
-		ServerSecurityAlertPoliciesClient:   &serverSecurityAlertPoliciesClient,
-		VirtualNetworkRulesClient:           &virtualNetworkRulesClient,
-		ServerAdministratorsClient:          &serverAdministratorsClient,
-		ReplicasClient:                      &replicasClient,
+		ConfigurationsClient:                 &configurationsClient,
+		DatabasesClient:                      &databasesClient,
+		FirewallRulesClient:                  &firewallRulesClient,
+		FlexibleServersConfigurationsClient:  &flexibleServerConfigurationsClient,
+		FlexibleServersClient:                &flexibleServersClient,
+		FlexibleServerFirewallRuleClient:     &flexibleServerFirewallRuleClient,
+		FlexibleServerDatabaseClient:         &flexibleServerDatabaseClient,
+		FlexibleServersPreviewClient:         &flexibleServersPreviewClient,
+		FlexibleServerVirtualEndpointsClient: &flexibleServerVirtualEndpointsClient,
+		ServersClient:                        &serversClient,
+		ServerKeysClient:                     &serverKeysClient,
+		ServerSecurityAlertPoliciesClient:    &serverSecurityAlertPoliciesClient,
+		VirtualNetworkRulesClient:            &virtualNetworkRulesClient,
+		ServerAdministratorsClient:           &serverAdministratorsClient,
+		ReplicasClient:                       &replicasClient,
 	}
 }
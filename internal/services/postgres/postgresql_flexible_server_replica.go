@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// NOTE: Read Replicas are only available in a newer API version than the one used for the Flexible Server itself,
+// as such Replicas are created, promoted and read back using a separate client
+
+func createPostgresqlFlexibleServerReplica(ctx context.Context, client *servers.ServersClient, id parse.FlexibleServerId, d *pluginsdk.ResourceData) error {
+	createMode := servers.CreateModeReplica
+	payload := servers.Server{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &servers.ServerProperties{
+			CreateMode:             &createMode,
+			SourceServerResourceId: utils.String(d.Get("source_server_id").(string)),
+		},
+		Tags: expandPostgresqlFlexibleServerReplicaTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("zone"); ok && v.(string) != "" {
+		payload.Properties.AvailabilityZone = utils.String(v.(string))
+	}
+
+	serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	if err := client.CreateThenPoll(ctx, serverId, payload); err != nil {
+		return fmt.Errorf("creating Replica %s: %+v", serverId, err)
+	}
+
+	return nil
+}
+
+// promotePostgresqlFlexibleServerReplica promotes a Read Replica either to a standalone server (when `role` is `None`)
+// or by switching over with the current Primary (when `role` is `Primary`)
+func promotePostgresqlFlexibleServerReplica(ctx context.Context, client *servers.ServersClient, id parse.FlexibleServerId, role string, option string) error {
+	serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	existing, err := client.Get(ctx, serverId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", serverId, err)
+	}
+	currentRole := flattenPostgresqlFlexibleServerReplicationRole(existing.Model)
+	if strings.EqualFold(currentRole, role) {
+		return nil
+	}
+	if !strings.EqualFold(currentRole, string(servers.ReplicationRoleAsyncReplica)) && !strings.EqualFold(currentRole, string(servers.ReplicationRoleGeoAsyncReplica)) {
+		return fmt.Errorf("`replication_role` can only be changed for a Replica but %s has the role %q", serverId, currentRole)
+	}
+
+	promoteMode := servers.ReadReplicaPromoteModeStandalone
+	if role == string(servers.ReplicationRolePrimary) {
+		promoteMode = servers.ReadReplicaPromoteModeSwitchover
+	}
+	promoteOption := servers.ReplicationPromoteOption(option)
+	replicationRole := servers.ReplicationRole(role)
+
+	payload := servers.ServerForUpdate{
+		Properties: &servers.ServerPropertiesForUpdate{
+			Replica: &servers.Replica{
+				PromoteMode:   &promoteMode,
+				PromoteOption: &promoteOption,
+				Role:          &replicationRole,
+			},
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, serverId, payload); err != nil {
+		return fmt.Errorf("promoting Replica %s: %+v", serverId, err)
+	}
+
+	return nil
+}
+
+func retrievePostgresqlFlexibleServerReplicationRole(ctx context.Context, client *servers.ServersClient, id parse.FlexibleServerId) (string, error) {
+	serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	resp, err := client.Get(ctx, serverId)
+	if err != nil {
+		return "", fmt.Errorf("retrieving the replication role for %s: %+v", serverId, err)
+	}
+
+	return flattenPostgresqlFlexibleServerReplicationRole(resp.Model), nil
+}
+
+func flattenPostgresqlFlexibleServerReplicationRole(input *servers.Server) string {
+	if input == nil || input.Properties == nil {
+		return ""
+	}
+
+	if replica := input.Properties.Replica; replica != nil && replica.Role != nil {
+		return string(*replica.Role)
+	}
+	if input.Properties.ReplicationRole != nil {
+		return string(*input.Properties.ReplicationRole)
+	}
+	return ""
+}
+
+func expandPostgresqlFlexibleServerReplicaTags(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string)
+	for k, v := range tags.Expand(input) {
+		output[k] = *v
+	}
+	return &output
+}
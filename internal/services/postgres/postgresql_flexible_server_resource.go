@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -119,6 +120,26 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					string(postgresqlflexibleservers.CreateModeDefault),
 					string(postgresqlflexibleservers.CreateModePointInTimeRestore),
+					string(servers.CreateModeReplica),
+				}, false),
+			},
+
+			"replication_role": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servers.ReplicationRoleNone),
+					string(servers.ReplicationRolePrimary),
+				}, false),
+			},
+
+			"replica_promote_option": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servers.ReplicationPromoteOptionForced),
+					string(servers.ReplicationPromoteOptionPlanned),
 				}, false),
 			},
 
@@ -269,6 +290,20 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 
 	createMode := d.Get("create_mode").(string)
 
+	if createMode == string(servers.CreateModeReplica) {
+		if _, ok := d.GetOk("source_server_id"); !ok {
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `Replica`")
+		}
+
+		if err := createPostgresqlFlexibleServerReplica(ctx, meta.(*clients.Client).Postgres.FlexibleServersPreviewClient, id, d); err != nil {
+			return err
+		}
+
+		d.SetId(id.ID())
+
+		return resourcePostgresqlFlexibleServerRead(d, meta)
+	}
+
 	if postgresqlflexibleservers.CreateMode(createMode) == postgresqlflexibleservers.CreateModePointInTimeRestore {
 		if _, ok := d.GetOk("source_server_id"); !ok {
 			return fmt.Errorf("`source_server_id` is required when `create_mode` is `PointInTimeRestore`")
@@ -436,6 +471,12 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 
 	d.Set("sku_name", sku)
 
+	replicationRole, err := retrievePostgresqlFlexibleServerReplicationRole(ctx, meta.(*clients.Client).Postgres.FlexibleServersPreviewClient, *id)
+	if err != nil {
+		return err
+	}
+	d.Set("replication_role", replicationRole)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -449,6 +490,18 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	// promoting a Replica has to happen prior to any other changes, since a Replica can't be updated
+	if d.HasChange("replication_role") {
+		promoteOption := d.Get("replica_promote_option").(string)
+		if promoteOption == "" {
+			promoteOption = string(servers.ReplicationPromoteOptionPlanned)
+		}
+
+		if err := promotePostgresqlFlexibleServerReplica(ctx, meta.(*clients.Client).Postgres.FlexibleServersPreviewClient, *id, d.Get("replication_role").(string), promoteOption); err != nil {
+			return err
+		}
+	}
+
 	parameters := postgresqlflexibleservers.ServerForUpdate{
 		Location:                  utils.String(location.Normalize(d.Get("location").(string))),
 		ServerPropertiesForUpdate: &postgresqlflexibleservers.ServerPropertiesForUpdate{},
@@ -507,7 +560,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		parameters.Sku = sku
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/virtualendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePostgresqlFlexibleServerVirtualEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePostgresqlFlexibleServerVirtualEndpointCreate,
+		Read:   resourcePostgresqlFlexibleServerVirtualEndpointRead,
+		Update: resourcePostgresqlFlexibleServerVirtualEndpointUpdate,
+		Delete: resourcePostgresqlFlexibleServerVirtualEndpointDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(1 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := virtualendpoints.ParseVirtualEndpointID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FlexibleServerName,
+			},
+
+			"source_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FlexibleServerID,
+			},
+
+			"replica_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FlexibleServerID,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(virtualendpoints.VirtualEndpointTypeReadWrite),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	sourceServerId, err := parse.FlexibleServerID(d.Get("source_server_id").(string))
+	if err != nil {
+		return err
+	}
+	replicaServerId, err := parse.FlexibleServerID(d.Get("replica_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := virtualendpoints.NewVirtualEndpointID(sourceServerId.SubscriptionId, sourceServerId.ResourceGroup, sourceServerId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_postgresql_flexible_server_virtual_endpoint", id.ID())
+	}
+
+	endpointType := virtualendpoints.VirtualEndpointType(d.Get("type").(string))
+	parameters := virtualendpoints.VirtualEndpointResource{
+		Properties: &virtualendpoints.VirtualEndpointResourceProperties{
+			EndpointType: &endpointType,
+			Members:      &[]string{sourceServerId.Name, replicaServerId.Name},
+		},
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePostgresqlFlexibleServerVirtualEndpointRead(d, meta)
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := virtualendpoints.ParseVirtualEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VirtualEndpointName)
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+
+		endpointType := ""
+		if props.EndpointType != nil {
+			endpointType = string(*props.EndpointType)
+		}
+		d.Set("type", endpointType)
+
+		members := make([]string, 0)
+		if props.Members != nil {
+			members = *props.Members
+		}
+
+		// the order of the members swaps following a switchover of the Replica, as such the existing
+		// values are retained when both servers are still members of this Virtual Endpoint
+		sourceServerId := d.Get("source_server_id").(string)
+		replicaServerId := d.Get("replica_server_id").(string)
+		if !postgresqlFlexibleServerVirtualEndpointHasMembers(members, sourceServerId, replicaServerId) {
+			sourceServerId = parse.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName).ID()
+			replicaServerId = ""
+			for _, member := range members {
+				if !strings.EqualFold(member, id.FlexibleServerName) {
+					replicaServerId = parse.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, member).ID()
+					break
+				}
+			}
+		}
+		d.Set("source_server_id", sourceServerId)
+		d.Set("replica_server_id", replicaServerId)
+	}
+
+	return nil
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := virtualendpoints.ParseVirtualEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	sourceServerId, err := parse.FlexibleServerID(d.Get("source_server_id").(string))
+	if err != nil {
+		return err
+	}
+	replicaServerId, err := parse.FlexibleServerID(d.Get("replica_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	endpointType := virtualendpoints.VirtualEndpointType(d.Get("type").(string))
+	parameters := virtualendpoints.VirtualEndpointResourceForPatch{
+		Properties: &virtualendpoints.VirtualEndpointResourceProperties{
+			EndpointType: &endpointType,
+			Members:      &[]string{sourceServerId.Name, replicaServerId.Name},
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourcePostgresqlFlexibleServerVirtualEndpointRead(d, meta)
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := virtualendpoints.ParseVirtualEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func postgresqlFlexibleServerVirtualEndpointHasMembers(members []string, serverIds ...string) bool {
+	for _, serverId := range serverIds {
+		id, err := parse.FlexibleServerID(serverId)
+		if err != nil {
+			return false
+		}
+
+		found := false
+		for _, member := range members {
+			if strings.EqualFold(member, id.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package servers
+
+import "strings"
+
+type CreateMode string
+
+const (
+	CreateModeCreate             CreateMode = "Create"
+	CreateModeDefault            CreateMode = "Default"
+	CreateModeGeoRestore         CreateMode = "GeoRestore"
+	CreateModePointInTimeRestore CreateMode = "PointInTimeRestore"
+	CreateModeReplica            CreateMode = "Replica"
+	CreateModeReviveDropped      CreateMode = "ReviveDropped"
+	CreateModeUpdate             CreateMode = "Update"
+)
+
+func PossibleValuesForCreateMode() []string {
+	return []string{
+		string(CreateModeCreate),
+		string(CreateModeDefault),
+		string(CreateModeGeoRestore),
+		string(CreateModePointInTimeRestore),
+		string(CreateModeReplica),
+		string(CreateModeReviveDropped),
+		string(CreateModeUpdate),
+	}
+}
+
+func parseCreateMode(input string) (*CreateMode, error) {
+	vals := map[string]CreateMode{
+		"create":             CreateModeCreate,
+		"default":            CreateModeDefault,
+		"georestore":         CreateModeGeoRestore,
+		"pointintimerestore": CreateModePointInTimeRestore,
+		"replica":            CreateModeReplica,
+		"revivedropped":      CreateModeReviveDropped,
+		"update":             CreateModeUpdate,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreateMode(input)
+	return &out, nil
+}
+
+type ReadReplicaPromoteMode string
+
+const (
+	ReadReplicaPromoteModeStandalone ReadReplicaPromoteMode = "standalone"
+	ReadReplicaPromoteModeSwitchover ReadReplicaPromoteMode = "switchover"
+)
+
+func PossibleValuesForReadReplicaPromoteMode() []string {
+	return []string{
+		string(ReadReplicaPromoteModeStandalone),
+		string(ReadReplicaPromoteModeSwitchover),
+	}
+}
+
+func parseReadReplicaPromoteMode(input string) (*ReadReplicaPromoteMode, error) {
+	vals := map[string]ReadReplicaPromoteMode{
+		"standalone": ReadReplicaPromoteModeStandalone,
+		"switchover": ReadReplicaPromoteModeSwitchover,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReadReplicaPromoteMode(input)
+	return &out, nil
+}
+
+type ReplicationPromoteOption string
+
+const (
+	ReplicationPromoteOptionForced  ReplicationPromoteOption = "forced"
+	ReplicationPromoteOptionPlanned ReplicationPromoteOption = "planned"
+)
+
+func PossibleValuesForReplicationPromoteOption() []string {
+	return []string{
+		string(ReplicationPromoteOptionForced),
+		string(ReplicationPromoteOptionPlanned),
+	}
+}
+
+func parseReplicationPromoteOption(input string) (*ReplicationPromoteOption, error) {
+	vals := map[string]ReplicationPromoteOption{
+		"forced":  ReplicationPromoteOptionForced,
+		"planned": ReplicationPromoteOptionPlanned,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReplicationPromoteOption(input)
+	return &out, nil
+}
+
+type ReplicationRole string
+
+const (
+	ReplicationRoleAsyncReplica    ReplicationRole = "AsyncReplica"
+	ReplicationRoleGeoAsyncReplica ReplicationRole = "GeoAsyncReplica"
+	ReplicationRoleNone            ReplicationRole = "None"
+	ReplicationRolePrimary         ReplicationRole = "Primary"
+)
+
+func PossibleValuesForReplicationRole() []string {
+	return []string{
+		string(ReplicationRoleAsyncReplica),
+		string(ReplicationRoleGeoAsyncReplica),
+		string(ReplicationRoleNone),
+		string(ReplicationRolePrimary),
+	}
+}
+
+func parseReplicationRole(input string) (*ReplicationRole, error) {
+	vals := map[string]ReplicationRole{
+		"asyncreplica":    ReplicationRoleAsyncReplica,
+		"geoasyncreplica": ReplicationRoleGeoAsyncReplica,
+		"none":            ReplicationRoleNone,
+		"primary":         ReplicationRolePrimary,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReplicationRole(input)
+	return &out, nil
+}
+
+type ReplicationState string
+
+const (
+	ReplicationStateActive        ReplicationState = "Active"
+	ReplicationStateBroken        ReplicationState = "Broken"
+	ReplicationStateCatchup       ReplicationState = "Catchup"
+	ReplicationStateProvisioning  ReplicationState = "Provisioning"
+	ReplicationStateReconfiguring ReplicationState = "Reconfiguring"
+	ReplicationStateUpdating      ReplicationState = "Updating"
+)
+
+func PossibleValuesForReplicationState() []string {
+	return []string{
+		string(ReplicationStateActive),
+		string(ReplicationStateBroken),
+		string(ReplicationStateCatchup),
+		string(ReplicationStateProvisioning),
+		string(ReplicationStateReconfiguring),
+		string(ReplicationStateUpdating),
+	}
+}
+
+func parseReplicationState(input string) (*ReplicationState, error) {
+	vals := map[string]ReplicationState{
+		"active":        ReplicationStateActive,
+		"broken":        ReplicationStateBroken,
+		"catchup":       ReplicationStateCatchup,
+		"provisioning":  ReplicationStateProvisioning,
+		"reconfiguring": ReplicationStateReconfiguring,
+		"updating":      ReplicationStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReplicationState(input)
+	return &out, nil
+}
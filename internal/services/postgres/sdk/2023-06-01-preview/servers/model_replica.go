@@ -0,0 +1,9 @@
+package servers
+
+type Replica struct {
+	Capacity         *int64                    `json:"capacity,omitempty"`
+	PromoteMode      *ReadReplicaPromoteMode   `json:"promoteMode,omitempty"`
+	PromoteOption    *ReplicationPromoteOption `json:"promoteOption,omitempty"`
+	ReplicationState *ReplicationState         `json:"replicationState,omitempty"`
+	Role             *ReplicationRole          `json:"role,omitempty"`
+}
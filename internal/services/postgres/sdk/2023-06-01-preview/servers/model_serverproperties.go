@@ -0,0 +1,10 @@
+package servers
+
+type ServerProperties struct {
+	AvailabilityZone       *string          `json:"availabilityZone,omitempty"`
+	CreateMode             *CreateMode      `json:"createMode,omitempty"`
+	Replica                *Replica         `json:"replica,omitempty"`
+	ReplicaCapacity        *int64           `json:"replicaCapacity,omitempty"`
+	ReplicationRole        *ReplicationRole `json:"replicationRole,omitempty"`
+	SourceServerResourceId *string          `json:"sourceServerResourceId,omitempty"`
+}
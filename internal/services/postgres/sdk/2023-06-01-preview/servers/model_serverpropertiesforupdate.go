@@ -0,0 +1,6 @@
+package servers
+
+type ServerPropertiesForUpdate struct {
+	Replica         *Replica         `json:"replica,omitempty"`
+	ReplicationRole *ReplicationRole `json:"replicationRole,omitempty"`
+}
@@ -0,0 +1,15 @@
+package virtualendpoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualEndpointsClientWithBaseURI(endpoint string) VirtualEndpointsClient {
+	return VirtualEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
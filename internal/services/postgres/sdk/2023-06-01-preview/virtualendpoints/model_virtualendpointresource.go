@@ -0,0 +1,8 @@
+package virtualendpoints
+
+type VirtualEndpointResource struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *VirtualEndpointResourceProperties `json:"properties,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
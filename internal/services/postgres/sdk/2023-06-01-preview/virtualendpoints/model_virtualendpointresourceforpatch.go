@@ -0,0 +1,5 @@
+package virtualendpoints
+
+type VirtualEndpointResourceForPatch struct {
+	Properties *VirtualEndpointResourceProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,7 @@
+package virtualendpoints
+
+type VirtualEndpointResourceProperties struct {
+	EndpointType     *VirtualEndpointType `json:"endpointType,omitempty"`
+	Members          *[]string            `json:"members,omitempty"`
+	VirtualEndpoints *[]string            `json:"virtualEndpoints,omitempty"`
+}
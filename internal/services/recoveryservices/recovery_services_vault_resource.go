@@ -1,6 +1,7 @@
 package recoveryservices
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/sdk/2021-12-01/backupresourcestorageconfigsnoncrr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -94,19 +96,39 @@ func resourceRecoveryServicesVault() *pluginsdk.Resource {
 			"storage_mode_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  backup.StorageTypeGeoRedundant,
+				Default:  string(backupresourcestorageconfigsnoncrr.StorageTypeGeoRedundant),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(backup.StorageTypeGeoRedundant),
-					string(backup.StorageTypeLocallyRedundant),
+					string(backupresourcestorageconfigsnoncrr.StorageTypeGeoRedundant),
+					string(backupresourcestorageconfigsnoncrr.StorageTypeLocallyRedundant),
 				}, false),
 			},
 
+			"cross_region_restore_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"soft_delete_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// Cross Region Restore can't be disabled once it has been enabled
+			pluginsdk.ForceNewIfChange("cross_region_restore_enabled", func(ctx context.Context, old, new, _ interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				storageModeType := d.Get("storage_mode_type").(string)
+				if d.Get("cross_region_restore_enabled").(bool) && storageModeType != string(backupresourcestorageconfigsnoncrr.StorageTypeGeoRedundant) {
+					return fmt.Errorf("`cross_region_restore_enabled` can only be set to `true` when `storage_mode_type` is `%s`, got %q", backupresourcestorageconfigsnoncrr.StorageTypeGeoRedundant, storageModeType)
+				}
+				return nil
+			}),
+		),
 	}
 }
 
@@ -192,13 +214,16 @@ func resourceRecoveryServicesVaultCreateUpdate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("waiting for on update for Recovery Service  %s: %+v", id.String(), err)
 	}
 
-	storageCfg := backup.ResourceConfigResource{
-		Properties: &backup.ResourceConfig{
-			StorageModelType: backup.StorageType(d.Get("storage_mode_type").(string)),
+	storageModeType := backupresourcestorageconfigsnoncrr.StorageType(d.Get("storage_mode_type").(string))
+	storageCfg := backupresourcestorageconfigsnoncrr.BackupResourceConfigResource{
+		Properties: &backupresourcestorageconfigsnoncrr.BackupResourceConfig{
+			CrossRegionRestoreFlag: utils.Bool(d.Get("cross_region_restore_enabled").(bool)),
+			StorageModelType:       &storageModeType,
 		},
 	}
 
-	if _, err = storageCfgsClient.Update(ctx, id.Name, id.ResourceGroup, storageCfg); err != nil {
+	storageCfgId := backupresourcestorageconfigsnoncrr.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	if _, err = storageCfgsClient.Update(ctx, storageCfgId, storageCfg); err != nil {
 		return fmt.Errorf("updating Recovery Service Storage Cfg %s: %+v", id.String(), err)
 	}
 
@@ -250,13 +275,26 @@ func resourceRecoveryServicesVaultRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("soft_delete_enabled", props.SoftDeleteFeatureState == backup.SoftDeleteFeatureStateEnabled)
 	}
 
-	storageCfg, err := storageCfgsClient.Get(ctx, id.Name, id.ResourceGroup)
+	storageCfgId := backupresourcestorageconfigsnoncrr.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	storageCfg, err := storageCfgsClient.Get(ctx, storageCfgId)
 	if err != nil {
 		return fmt.Errorf("reading Recovery Service storage Cfg %s: %+v", id.String(), err)
 	}
 
-	if props := storageCfg.Properties; props != nil {
-		d.Set("storage_mode_type", string(props.StorageModelType))
+	if model := storageCfg.Model; model != nil {
+		if props := model.Properties; props != nil {
+			storageModeType := ""
+			if props.StorageModelType != nil {
+				storageModeType = string(*props.StorageModelType)
+			}
+			d.Set("storage_mode_type", storageModeType)
+
+			crossRegionRestoreEnabled := false
+			if props.CrossRegionRestoreFlag != nil {
+				crossRegionRestoreEnabled = *props.CrossRegionRestoreFlag
+			}
+			d.Set("cross_region_restore_enabled", crossRegionRestoreEnabled)
+		}
 	}
 
 	if err := d.Set("identity", flattenVaultIdentity(resp.Identity)); err != nil {
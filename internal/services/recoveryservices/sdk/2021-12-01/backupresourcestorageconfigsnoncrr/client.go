@@ -0,0 +1,15 @@
+package backupresourcestorageconfigsnoncrr
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BackupResourceStorageConfigsNonCRRClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBackupResourceStorageConfigsNonCRRClientWithBaseURI(endpoint string) BackupResourceStorageConfigsNonCRRClient {
+	return BackupResourceStorageConfigsNonCRRClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,10 @@
+package backupresourcestorageconfigsnoncrr
+
+type BackupResourceConfig struct {
+	CrossRegionRestoreFlag *bool             `json:"crossRegionRestoreFlag,omitempty"`
+	DedupState             *string           `json:"dedupState,omitempty"`
+	StorageModelType       *StorageType      `json:"storageModelType,omitempty"`
+	StorageType            *StorageType      `json:"storageType,omitempty"`
+	StorageTypeState       *StorageTypeState `json:"storageTypeState,omitempty"`
+	XcoolState             *string           `json:"xcoolState,omitempty"`
+}
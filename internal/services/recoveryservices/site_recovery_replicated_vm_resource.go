@@ -107,6 +107,14 @@ func resourceSiteRecoveryReplicatedVM() *pluginsdk.Resource {
 				Optional:         true,
 				ValidateFunc:     azure.ValidateResourceID,
 				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"target_zone"},
+			},
+			"target_zone": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"target_availability_set_id"},
 			},
 			"target_network_id": {
 				Type:         pluginsdk.TypeString,
@@ -238,6 +246,11 @@ func resourceSiteRecoveryReplicatedItemCreate(d *pluginsdk.ResourceData, meta in
 		targetAvailabilitySetID = nil
 	}
 
+	var targetAvailabilityZone *string
+	if zone, isSet := d.GetOk("target_zone"); isSet {
+		targetAvailabilityZone = utils.String(zone.(string))
+	}
+
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -283,6 +296,7 @@ func resourceSiteRecoveryReplicatedItemCreate(d *pluginsdk.ResourceData, meta in
 				RecoveryContainerID:       &targetProtectionContainerId,
 				RecoveryResourceGroupID:   &targetResourceGroupId,
 				RecoveryAvailabilitySetID: targetAvailabilitySetID,
+				RecoveryAvailabilityZone:  targetAvailabilityZone,
 				VMManagedDisks:            &managedDisks,
 			},
 		},
@@ -450,6 +464,7 @@ func resourceSiteRecoveryReplicatedItemRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("source_vm_id", a2aDetails.FabricObjectID)
 		d.Set("target_resource_group_id", a2aDetails.RecoveryAzureResourceGroupID)
 		d.Set("target_availability_set_id", a2aDetails.RecoveryAvailabilitySet)
+		d.Set("target_zone", a2aDetails.RecoveryAvailabilityZone)
 		d.Set("target_network_id", a2aDetails.SelectedRecoveryAzureNetworkID)
 		if a2aDetails.ProtectedManagedDisks != nil {
 			disksOutput := make([]interface{}, 0)
@@ -4,11 +4,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2021-08-01/databases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2021-08-01/redisenterprise"
+	databaseslatest "github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2024-02-01/databases"
 )
 
 type Client struct {
-	Client         *redisenterprise.RedisEnterpriseClient
-	DatabaseClient *databases.DatabasesClient
+	Client               *redisenterprise.RedisEnterpriseClient
+	DatabaseClient       *databases.DatabasesClient
+	DatabaseLatestClient *databaseslatest.DatabasesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,8 +20,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	databaseClient := databases.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&databaseClient.Client, o.ResourceManagerAuthorizer)
 
+	databaseLatestClient := databaseslatest.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&databaseLatestClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		Client:         &client,
-		DatabaseClient: &databaseClient,
+		Client:               &client,
+		DatabaseClient:       &databaseClient,
+		DatabaseLatestClient: &databaseLatestClient,
 	}
 }
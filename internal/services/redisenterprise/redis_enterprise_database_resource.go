@@ -10,8 +10,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2021-08-01/databases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2021-08-01/redisenterprise"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2024-02-01/databases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -23,12 +23,13 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRedisEnterpriseDatabaseCreate,
 		Read:   resourceRedisEnterpriseDatabaseRead,
-		// Update currently is not implemented, will be for GA
+		Update: resourceRedisEnterpriseDatabaseUpdate,
 		Delete: resourceRedisEnterpriseDatabaseDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -37,8 +38,8 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 			return err
 		}),
 
-		// Since update is not currently supported all attribute have to be marked as FORCE NEW
-		// until support for Update comes online in the near future
+		// Since update is only supported for the geo-replication management actions all other attributes
+		// have to be marked as FORCE NEW until support for Update comes online in the near future
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -177,6 +178,30 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 65353),
 			},
 
+			"linked_database_id": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: databases.ValidateDatabaseID,
+				},
+			},
+
+			"linked_database_group_nickname": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"linked_database_id"},
+			},
+
+			"flush_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"primary_access_key": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -193,7 +218,7 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 }
 func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseLatestClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -228,6 +253,18 @@ func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta inter
 		},
 	}
 
+	if linkedDatabaseIds := d.Get("linked_database_id").(*pluginsdk.Set).List(); len(linkedDatabaseIds) > 0 {
+		if evictionPolicy != databases.EvictionPolicyNoEviction {
+			return fmt.Errorf("`eviction_policy` must be set to %q when `linked_database_id` is specified", string(databases.EvictionPolicyNoEviction))
+		}
+
+		geoReplication, err := expandArmDatabaseGeoReplication(id, d.Get("linked_database_group_nickname").(string), linkedDatabaseIds)
+		if err != nil {
+			return err
+		}
+		parameters.Properties.GeoReplication = geoReplication
+	}
+
 	future, err := client.Create(ctx, id, parameters)
 	if err != nil {
 		// @tombuildsstuff: investigate moving this above
@@ -257,7 +294,7 @@ func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta inter
 }
 
 func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseLatestClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -312,6 +349,19 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 			// 	return fmt.Errorf("setting `persistence`: %+v", err)
 			// }
 			d.Set("port", props.Port)
+
+			linkedDatabaseIds := make([]interface{}, 0)
+			groupNickname := ""
+			if geoReplication := props.GeoReplication; geoReplication != nil {
+				linkedDatabaseIds = flattenArmDatabaseLinkedDatabases(geoReplication.LinkedDatabases)
+				if geoReplication.GroupNickname != nil {
+					groupNickname = *geoReplication.GroupNickname
+				}
+			}
+			if err := d.Set("linked_database_id", linkedDatabaseIds); err != nil {
+				return fmt.Errorf("setting `linked_database_id`: %+v", err)
+			}
+			d.Set("linked_database_group_nickname", groupNickname)
 		}
 	}
 
@@ -323,8 +373,65 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
+func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseLatestClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := databases.ParseDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("linked_database_id") {
+		// databases can only join a geo-replication group when they're created, so the only supported change
+		// is removing a (failed) database from the group, which is done by force-unlinking it
+		oldRaw, newRaw := d.GetChange("linked_database_id")
+		unlinkedIds := make([]string, 0)
+		for _, v := range oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set)).List() {
+			unlinkedId, err := databases.ParseDatabaseIDInsensitively(v.(string))
+			if err != nil {
+				return err
+			}
+			if strings.EqualFold(unlinkedId.ID(), id.ID()) {
+				return fmt.Errorf("%s cannot be force-unlinked from itself - remove it from the `linked_database_id` of another database in the group instead", *id)
+			}
+			unlinkedIds = append(unlinkedIds, unlinkedId.ID())
+		}
+
+		if len(unlinkedIds) > 0 {
+			parameters := databases.ForceUnlinkParameters{
+				Ids: unlinkedIds,
+			}
+			if err := client.ForceUnlinkThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("force-unlinking %s from %s: %+v", strings.Join(unlinkedIds, ", "), *id, err)
+			}
+		}
+	}
+
+	if d.HasChange("flush_trigger") && d.Get("flush_trigger").(string) != "" {
+		// flushing a geo-replicated database requires the IDs of the other linked databases, which would
+		// otherwise diverge from this database
+		linkedDatabaseIds := make([]string, 0)
+		for _, v := range d.Get("linked_database_id").(*pluginsdk.Set).List() {
+			if !strings.EqualFold(v.(string), id.ID()) {
+				linkedDatabaseIds = append(linkedDatabaseIds, v.(string))
+			}
+		}
+
+		parameters := databases.FlushParameters{
+			Ids: &linkedDatabaseIds,
+		}
+		if err := client.FlushThenPoll(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("flushing %s: %+v", *id, err)
+		}
+	}
+
+	return resourceRedisEnterpriseDatabaseRead(d, meta)
+}
+
 func resourceRedisEnterpriseDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseLatestClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -353,6 +460,33 @@ func expandArmDatabaseModuleArray(input []interface{}) *[]databases.Module {
 	return &results
 }
 
+func expandArmDatabaseGeoReplication(id databases.DatabaseId, groupNickname string, input []interface{}) (*databases.DatabasePropertiesGeoReplication, error) {
+	linkedDatabases := make([]databases.LinkedDatabase, 0)
+	containsSelf := false
+	for _, item := range input {
+		linkedDatabaseId, err := databases.ParseDatabaseIDInsensitively(item.(string))
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(linkedDatabaseId.ID(), id.ID()) {
+			containsSelf = true
+		}
+
+		linkedDatabases = append(linkedDatabases, databases.LinkedDatabase{
+			Id: utils.String(linkedDatabaseId.ID()),
+		})
+	}
+
+	if !containsSelf {
+		return nil, fmt.Errorf("`linked_database_id` must contain the ID of %s", id)
+	}
+
+	return &databases.DatabasePropertiesGeoReplication{
+		GroupNickname:   utils.String(groupNickname),
+		LinkedDatabases: &linkedDatabases,
+	}, nil
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
 // func expandArmDatabasePersistence(input []interface{}) *redisenterprise.Persistence {
 // 	if len(input) == 0 {
@@ -402,6 +536,27 @@ func flattenArmDatabaseModuleArray(input *[]databases.Module) []interface{} {
 	return results
 }
 
+func flattenArmDatabaseLinkedDatabases(input *[]databases.LinkedDatabase) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Id == nil {
+			continue
+		}
+
+		linkedDatabaseId := *item.Id
+		if parsed, err := databases.ParseDatabaseIDInsensitively(*item.Id); err == nil {
+			linkedDatabaseId = parsed.ID()
+		}
+		results = append(results, linkedDatabaseId)
+	}
+
+	return results
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
 // func flattenArmDatabasePersistence(input *redisenterprise.Persistence) []interface{} {
 // 	if input == nil {
@@ -58,6 +58,36 @@ func TestRedisEnterpriseDatabase_complete(t *testing.T) {
 	})
 }
 
+func TestRedisEnterpriseDatabase_geoReplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisenterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoReplication(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("flush_trigger"),
+		{
+			Config: r.geoReplication(data, "flush-1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("flush_trigger"),
+		{
+			Config: r.geoReplicationForceUnlink(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("flush_trigger"),
+	})
+}
+
 func (r RedisenterpriseDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := databases.ParseDatabaseID(state.ID)
 	if err != nil {
@@ -156,3 +186,84 @@ resource "azurerm_redis_enterprise_database" "test" {
 }
 `, template)
 }
+
+func (r RedisenterpriseDatabaseResource) geoReplicationTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-redisEnterprise-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_redis_enterprise_cluster" "test" {
+  name                = "acctest-rec-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku_name = "Enterprise_E20-4"
+}
+
+resource "azurerm_redis_enterprise_cluster" "test2" {
+  name                = "acctest-rec2-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%[3]s"
+
+  sku_name = "Enterprise_E20-4"
+}
+`, data.RandomInteger, "eastus", "westus")
+}
+
+func (r RedisenterpriseDatabaseResource) geoReplication(data acceptance.TestData, flushTrigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database" "test" {
+  cluster_id        = azurerm_redis_enterprise_cluster.test.id
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+  flush_trigger     = "%s"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/default",
+    "${azurerm_redis_enterprise_cluster.test2.id}/databases/default",
+  ]
+  linked_database_group_nickname = "acctest-group-%d"
+}
+
+resource "azurerm_redis_enterprise_database" "test2" {
+  cluster_id        = azurerm_redis_enterprise_cluster.test2.id
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/default",
+    "${azurerm_redis_enterprise_cluster.test2.id}/databases/default",
+  ]
+  linked_database_group_nickname = "acctest-group-%d"
+}
+`, r.geoReplicationTemplate(data), flushTrigger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r RedisenterpriseDatabaseResource) geoReplicationForceUnlink(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database" "test" {
+  cluster_id        = azurerm_redis_enterprise_cluster.test.id
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+  flush_trigger     = "flush-1"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/default",
+  ]
+  linked_database_group_nickname = "acctest-group-%d"
+}
+`, r.geoReplicationTemplate(data), data.RandomInteger)
+}
@@ -0,0 +1,75 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type FlushResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Flush ...
+func (c DatabasesClient) Flush(ctx context.Context, id DatabaseId, input FlushParameters) (result FlushResponse, err error) {
+	req, err := c.preparerForFlush(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "databases.DatabasesClient", "Flush", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForFlush(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "databases.DatabasesClient", "Flush", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// FlushThenPoll performs Flush then polls until it's completed
+func (c DatabasesClient) FlushThenPoll(ctx context.Context, id DatabaseId, input FlushParameters) error {
+	result, err := c.Flush(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Flush: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Flush: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForFlush prepares the Flush request.
+func (c DatabasesClient) preparerForFlush(ctx context.Context, id DatabaseId, input FlushParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/flush", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForFlush sends the Flush request. The method will close the
+// http.Response Body if it receives an error.
+func (c DatabasesClient) senderForFlush(ctx context.Context, req *http.Request) (future FlushResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
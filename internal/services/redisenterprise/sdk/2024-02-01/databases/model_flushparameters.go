@@ -0,0 +1,5 @@
+package databases
+
+type FlushParameters struct {
+	Ids *[]string `json:"ids,omitempty"`
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2021-03-01/resourcegraph"
 )
 
 type Client struct {
@@ -15,6 +16,7 @@ type Client struct {
 	GroupsClient                *resources.GroupsClient
 	LocksClient                 *locks.ManagementLocksClient
 	ProvidersClient             *providers.ProvidersClient
+	ResourceGraphClient         *resourcegraph.ResourceGraphClient
 	ResourceProvidersClient     *resources.ProvidersClient
 	ResourcesClient             *resources.Client
 	TagsClient                  *resources.TagsClient
@@ -44,6 +46,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	resourceProvidersClient := resources.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourceProvidersClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceGraphClient := resourcegraph.NewResourceGraphClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resourceGraphClient.Client, o.ResourceManagerAuthorizer)
+
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -59,6 +64,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		FeaturesClient:              &featuresClient,
 		LocksClient:                 &locksClient,
 		ProvidersClient:             &providersClient,
+		ResourceGraphClient:         &resourceGraphClient,
 		ResourceProvidersClient:     &resourceProvidersClient,
 		ResourcesClient:             &resourcesClient,
 		TagsClient:                  &tagsClient,
@@ -28,6 +28,7 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_resources":             dataSourceResources(),
+		"azurerm_resource_graph_query":  dataSourceResourceGraphQuery(),
 		"azurerm_resource_group":        dataSourceResourceGroup(),
 		"azurerm_template_spec_version": dataSourceTemplateSpecVersion(),
 	}
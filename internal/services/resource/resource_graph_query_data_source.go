@@ -0,0 +1,180 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	managementGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2021-03-01/resourcegraph"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceGraphQueryPageSize is the maximum number of rows which can be returned by the Resource Graph in a single page
+const resourceGraphQueryPageSize = 1000
+
+func dataSourceResourceGraphQuery() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceResourceGraphQueryRead,
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"query": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"subscription_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+				ConflictsWith: []string{"management_group_ids"},
+			},
+
+			"management_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: managementGroupValidate.ManagementGroupID,
+				},
+				ConflictsWith: []string{"subscription_ids"},
+			},
+
+			"allow_partial_scopes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"results": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeMap,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourceGraphQueryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.ResourceGraphClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resultFormat := resourcegraph.ResultFormatObjectArray
+	request := resourcegraph.QueryRequest{
+		Query: d.Get("query").(string),
+		Options: &resourcegraph.QueryRequestOptions{
+			AllowPartialScopes: utils.Bool(d.Get("allow_partial_scopes").(bool)),
+			ResultFormat:       &resultFormat,
+			Top:                utils.Int64(resourceGraphQueryPageSize),
+		},
+	}
+
+	if v := d.Get("subscription_ids").([]interface{}); len(v) > 0 {
+		request.Subscriptions = utils.ExpandStringSlice(v)
+	}
+
+	if v := d.Get("management_group_ids").([]interface{}); len(v) > 0 {
+		managementGroups := make([]string, 0)
+		for _, raw := range v {
+			id, err := managementGroupParse.ManagementGroupID(raw.(string))
+			if err != nil {
+				return err
+			}
+			managementGroups = append(managementGroups, id.Name)
+		}
+		request.ManagementGroups = &managementGroups
+	}
+
+	results := make([]interface{}, 0)
+	for {
+		resp, err := client.Resources(ctx, request)
+		if err != nil {
+			return fmt.Errorf("running Resource Graph query: %+v", err)
+		}
+		if resp.Model == nil {
+			return fmt.Errorf("running Resource Graph query: model was nil")
+		}
+
+		if resp.Model.ResultTruncated == resourcegraph.ResultTruncatedTrue {
+			log.Printf("[WARN] the results of the Resource Graph query were truncated - include the `id` column in the query to allow all results to be returned")
+		}
+
+		rows, err := flattenResourceGraphQueryRows(resp.Model.Data)
+		if err != nil {
+			return fmt.Errorf("flattening the results of the Resource Graph query: %+v", err)
+		}
+		results = append(results, rows...)
+
+		if resp.Model.SkipToken == nil || *resp.Model.SkipToken == "" {
+			break
+		}
+		request.Options.SkipToken = resp.Model.SkipToken
+	}
+
+	d.SetId("resource-graph-query-" + uuid.New().String())
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("setting `results`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenResourceGraphQueryRows flattens the rows returned by the Resource Graph into a list of maps, since the
+// columns are determined by the query any values which aren't strings (e.g. numbers or objects) are JSON-encoded
+func flattenResourceGraphQueryRows(input interface{}) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	rows, ok := input.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the data to be a list of rows but got %T", input)
+	}
+
+	for _, raw := range rows {
+		row, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected each row to be an object but got %T", raw)
+		}
+
+		values := make(map[string]interface{}, len(row))
+		for column, value := range row {
+			switch v := value.(type) {
+			case nil:
+				values[column] = ""
+			case string:
+				values[column] = v
+			default:
+				encoded, err := json.Marshal(v)
+				if err != nil {
+					return nil, fmt.Errorf("encoding the value of the column %q: %+v", column, err)
+				}
+				values[column] = string(encoded)
+			}
+		}
+		output = append(output, values)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,88 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGraphQueryDataSource struct {
+}
+
+func TestAccDataSourceResourceGraphQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("results.0.name").HasValue(fmt.Sprintf("acctestRG-rgq-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("results.0.tags").HasValue(`{"environment":"production"}`),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_subscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.subscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (r ResourceGraphQueryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query = "ResourceContainers | where type =~ 'microsoft.resources/subscriptions/resourcegroups' and name =~ '${azurerm_resource_group.test.name}' | project id, name, tags"
+}
+`, r.template(data))
+}
+
+func (r ResourceGraphQueryDataSource) subscription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "ResourceContainers | where type =~ 'microsoft.resources/subscriptions/resourcegroups' and name =~ '${azurerm_resource_group.test.name}' | project id, name"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, r.template(data))
+}
+
+func (ResourceGraphQueryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rgq-%d"
+  location = "%s"
+
+  tags = {
+    environment = "production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
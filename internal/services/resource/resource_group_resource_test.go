@@ -74,6 +74,45 @@ func TestAccResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_withDefaultTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	assert := check.That(data.ResourceName)
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.withDefaultTagsConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("2"),
+				assert.Key("tags.environment").HasValue("staging"),
+				assert.Key("tags.team").HasValue("platform"),
+				assert.Key("tags_all.%").HasValue("3"),
+				assert.Key("tags_all.cost_center").HasValue("MSFT"),
+			),
+		},
+		{
+			// adding a default tag after the resource has been created should be assigned to the resource
+			Config: testResource.withDefaultTagsAddedConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("2"),
+				assert.Key("tags_all.%").HasValue("4"),
+				assert.Key("tags_all.owner").HasValue("platform-team"),
+			),
+		},
+		{
+			Config: testResource.withTagsConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("2"),
+				assert.Key("tags.cost_center").HasValue("MSFT"),
+				assert.Key("tags.environment").HasValue("Production"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
 	r := ResourceGroupResource{}
@@ -218,6 +257,57 @@ resource "azurerm_resource_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (t ResourceGroupResource) withDefaultTagsConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  default_tags {
+    tags = {
+      cost_center = "MSFT"
+      environment = "Production"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags = {
+    environment = "staging"
+    team        = "platform"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (t ResourceGroupResource) withDefaultTagsAddedConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  default_tags {
+    tags = {
+      cost_center = "MSFT"
+      environment = "Production"
+      owner       = "platform-team"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags = {
+    environment = "staging"
+    team        = "platform"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (t ResourceGroupResource) withTagsUpdatedConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,93 @@
+package resourcegraph
+
+import "strings"
+
+type AuthorizationScopeFilter string
+
+const (
+	AuthorizationScopeFilterAtScopeAboveAndBelow AuthorizationScopeFilter = "AtScopeAboveAndBelow"
+	AuthorizationScopeFilterAtScopeAndAbove      AuthorizationScopeFilter = "AtScopeAndAbove"
+	AuthorizationScopeFilterAtScopeAndBelow      AuthorizationScopeFilter = "AtScopeAndBelow"
+	AuthorizationScopeFilterAtScopeExact         AuthorizationScopeFilter = "AtScopeExact"
+)
+
+func PossibleValuesForAuthorizationScopeFilter() []string {
+	return []string{
+		string(AuthorizationScopeFilterAtScopeAboveAndBelow),
+		string(AuthorizationScopeFilterAtScopeAndAbove),
+		string(AuthorizationScopeFilterAtScopeAndBelow),
+		string(AuthorizationScopeFilterAtScopeExact),
+	}
+}
+
+func parseAuthorizationScopeFilter(input string) (*AuthorizationScopeFilter, error) {
+	vals := map[string]AuthorizationScopeFilter{
+		"atscopeaboveandbelow": AuthorizationScopeFilterAtScopeAboveAndBelow,
+		"atscopeandabove":      AuthorizationScopeFilterAtScopeAndAbove,
+		"atscopeandbelow":      AuthorizationScopeFilterAtScopeAndBelow,
+		"atscopeexact":         AuthorizationScopeFilterAtScopeExact,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AuthorizationScopeFilter(input)
+	return &out, nil
+}
+
+type ResultFormat string
+
+const (
+	ResultFormatObjectArray ResultFormat = "objectArray"
+	ResultFormatTable       ResultFormat = "table"
+)
+
+func PossibleValuesForResultFormat() []string {
+	return []string{
+		string(ResultFormatObjectArray),
+		string(ResultFormatTable),
+	}
+}
+
+func parseResultFormat(input string) (*ResultFormat, error) {
+	vals := map[string]ResultFormat{
+		"objectarray": ResultFormatObjectArray,
+		"table":       ResultFormatTable,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResultFormat(input)
+	return &out, nil
+}
+
+type ResultTruncated string
+
+const (
+	ResultTruncatedFalse ResultTruncated = "false"
+	ResultTruncatedTrue  ResultTruncated = "true"
+)
+
+func PossibleValuesForResultTruncated() []string {
+	return []string{
+		string(ResultTruncatedFalse),
+		string(ResultTruncatedTrue),
+	}
+}
+
+func parseResultTruncated(input string) (*ResultTruncated, error) {
+	vals := map[string]ResultTruncated{
+		"false": ResultTruncatedFalse,
+		"true":  ResultTruncatedTrue,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResultTruncated(input)
+	return &out, nil
+}
@@ -0,0 +1,10 @@
+package resourcegraph
+
+type QueryRequestOptions struct {
+	AllowPartialScopes       *bool                     `json:"allowPartialScopes,omitempty"`
+	AuthorizationScopeFilter *AuthorizationScopeFilter `json:"authorizationScopeFilter,omitempty"`
+	ResultFormat             *ResultFormat             `json:"resultFormat,omitempty"`
+	Skip                     *int64                    `json:"$skip,omitempty"`
+	SkipToken                *string                   `json:"$skipToken,omitempty"`
+	Top                      *int64                    `json:"$top,omitempty"`
+}
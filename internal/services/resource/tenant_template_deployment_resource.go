@@ -231,7 +231,7 @@ func tenantTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
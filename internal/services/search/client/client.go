@@ -3,12 +3,15 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2020-03-13/search"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/sharedprivatelinkresources"
 )
 
 type Client struct {
-	AdminKeysClient *search.AdminKeysClient
-	QueryKeysClient *search.QueryKeysClient
-	ServicesClient  *search.ServicesClient
+	AdminKeysClient                  *search.AdminKeysClient
+	QueryKeysClient                  *search.QueryKeysClient
+	ServicesClient                   *services.ServicesClient
+	SharedPrivateLinkResourcesClient *sharedprivatelinkresources.SharedPrivateLinkResourcesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,12 +21,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	queryKeysClient := search.NewQueryKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&queryKeysClient.Client, o.ResourceManagerAuthorizer)
 
-	servicesClient := search.NewServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	servicesClient := services.NewServicesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
+	sharedPrivateLinkResourcesClient := sharedprivatelinkresources.NewSharedPrivateLinkResourcesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sharedPrivateLinkResourcesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AdminKeysClient: &adminKeysClient,
-		QueryKeysClient: &queryKeysClient,
-		ServicesClient:  &servicesClient,
+		AdminKeysClient:                  &adminKeysClient,
+		QueryKeysClient:                  &queryKeysClient,
+		ServicesClient:                   &servicesClient,
+		SharedPrivateLinkResourcesClient: &sharedPrivateLinkResourcesClient,
 	}
 }
@@ -28,6 +28,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_search_service": resourceSearchService(),
+		"azurerm_search_service":                     resourceSearchService(),
+		"azurerm_search_shared_private_link_service": resourceSearchSharedPrivateLinkService(),
 	}
 }
@@ -0,0 +1,192 @@
+package services
+
+import "strings"
+
+type HostingMode string
+
+const (
+	HostingModeDefault     HostingMode = "default"
+	HostingModeHighDensity HostingMode = "highDensity"
+)
+
+func PossibleValuesForHostingMode() []string {
+	return []string{
+		string(HostingModeDefault),
+		string(HostingModeHighDensity),
+	}
+}
+
+func parseHostingMode(input string) (*HostingMode, error) {
+	vals := map[string]HostingMode{
+		"default":     HostingModeDefault,
+		"highdensity": HostingModeHighDensity,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HostingMode(input)
+	return &out, nil
+}
+
+type IdentityType string
+
+const (
+	IdentityTypeNone           IdentityType = "None"
+	IdentityTypeSystemAssigned IdentityType = "SystemAssigned"
+)
+
+func PossibleValuesForIdentityType() []string {
+	return []string{
+		string(IdentityTypeNone),
+		string(IdentityTypeSystemAssigned),
+	}
+}
+
+func parseIdentityType(input string) (*IdentityType, error) {
+	vals := map[string]IdentityType{
+		"none":           IdentityTypeNone,
+		"systemassigned": IdentityTypeSystemAssigned,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IdentityType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateFailed       ProvisioningState = "failed"
+	ProvisioningStateProvisioning ProvisioningState = "provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "succeeded"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
+
+type SearchSemanticSearch string
+
+const (
+	SearchSemanticSearchDisabled SearchSemanticSearch = "disabled"
+	SearchSemanticSearchFree     SearchSemanticSearch = "free"
+	SearchSemanticSearchStandard SearchSemanticSearch = "standard"
+)
+
+func PossibleValuesForSearchSemanticSearch() []string {
+	return []string{
+		string(SearchSemanticSearchDisabled),
+		string(SearchSemanticSearchFree),
+		string(SearchSemanticSearchStandard),
+	}
+}
+
+func parseSearchSemanticSearch(input string) (*SearchSemanticSearch, error) {
+	vals := map[string]SearchSemanticSearch{
+		"disabled": SearchSemanticSearchDisabled,
+		"free":     SearchSemanticSearchFree,
+		"standard": SearchSemanticSearchStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchSemanticSearch(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameBasic                SkuName = "basic"
+	SkuNameFree                 SkuName = "free"
+	SkuNameStandard             SkuName = "standard"
+	SkuNameStandardThree        SkuName = "standard3"
+	SkuNameStandardTwo          SkuName = "standard2"
+	SkuNameStorageOptimizedLOne SkuName = "storage_optimized_l1"
+	SkuNameStorageOptimizedLTwo SkuName = "storage_optimized_l2"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameBasic),
+		string(SkuNameFree),
+		string(SkuNameStandard),
+		string(SkuNameStandardThree),
+		string(SkuNameStandardTwo),
+		string(SkuNameStorageOptimizedLOne),
+		string(SkuNameStorageOptimizedLTwo),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"basic":                SkuNameBasic,
+		"free":                 SkuNameFree,
+		"standard":             SkuNameStandard,
+		"standard3":            SkuNameStandardThree,
+		"standard2":            SkuNameStandardTwo,
+		"storage_optimized_l1": SkuNameStorageOptimizedLOne,
+		"storage_optimized_l2": SkuNameStorageOptimizedLTwo,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package services
+
+type Identity struct {
+	PrincipalId *string      `json:"principalId,omitempty"`
+	TenantId    *string      `json:"tenantId,omitempty"`
+	Type        IdentityType `json:"type"`
+}
@@ -0,0 +1,5 @@
+package services
+
+type IPRule struct {
+	Value *string `json:"value,omitempty"`
+}
@@ -0,0 +1,5 @@
+package services
+
+type NetworkRuleSet struct {
+	IPRules *[]IPRule `json:"ipRules,omitempty"`
+}
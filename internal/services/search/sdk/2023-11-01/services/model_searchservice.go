@@ -0,0 +1,12 @@
+package services
+
+type SearchService struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *Identity                `json:"identity,omitempty"`
+	Location   string                   `json:"location"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *SearchServiceProperties `json:"properties,omitempty"`
+	Sku        *Sku                     `json:"sku,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
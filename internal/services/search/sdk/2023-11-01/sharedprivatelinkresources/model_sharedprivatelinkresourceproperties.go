@@ -0,0 +1,10 @@
+package sharedprivatelinkresources
+
+type SharedPrivateLinkResourceProperties struct {
+	GroupId               *string                                     `json:"groupId,omitempty"`
+	PrivateLinkResourceId *string                                     `json:"privateLinkResourceId,omitempty"`
+	ProvisioningState     *SharedPrivateLinkResourceProvisioningState `json:"provisioningState,omitempty"`
+	RequestMessage        *string                                     `json:"requestMessage,omitempty"`
+	ResourceRegion        *string                                     `json:"resourceRegion,omitempty"`
+	Status                *SharedPrivateLinkResourceStatus            `json:"status,omitempty"`
+}
@@ -2,14 +2,16 @@ package search
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/services"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceSearchService() *pluginsdk.Resource {
@@ -107,9 +109,9 @@ func dataSourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 	id := parse.NewSearchServiceID(subscriptionID, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, nil)
+	resp, err := client.Get(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("Search Service %q (Resource Group %q) was not found", id.Name, id.ResourceGroup)
 		}
 
@@ -118,16 +120,22 @@ func dataSourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 	d.SetId(id.ID())
 
-	if props := resp.ServiceProperties; props != nil {
-		if count := props.PartitionCount; count != nil {
-			d.Set("partition_count", int(*count))
-		}
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if count := props.PartitionCount; count != nil {
+				d.Set("partition_count", int(*count))
+			}
+
+			if count := props.ReplicaCount; count != nil {
+				d.Set("replica_count", int(*count))
+			}
 
-		if count := props.ReplicaCount; count != nil {
-			d.Set("replica_count", int(*count))
+			d.Set("public_network_access_enabled", props.PublicNetworkAccess == nil || !strings.EqualFold(string(*props.PublicNetworkAccess), string(services.PublicNetworkAccessDisabled)))
 		}
 
-		d.Set("public_network_access_enabled", props.PublicNetworkAccess != "Disabled")
+		if err := d.Set("identity", flattenSearchServiceIdentity(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %s", err)
+		}
 	}
 
 	adminKeysClient := meta.(*clients.Client).Search.AdminKeysClient
@@ -143,9 +151,5 @@ func dataSourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) er
 		d.Set("query_keys", flattenSearchQueryKeys(queryKeysResp.Values()))
 	}
 
-	if err := d.Set("identity", flattenSearchServiceIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("setting `identity`: %s", err)
-	}
-
 	return nil
 }
@@ -1,17 +1,22 @@
 package search
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2020-03-13/search"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/services"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -54,13 +59,24 @@ func resourceSearchService() *pluginsdk.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(search.Free),
-					string(search.Basic),
-					string(search.Standard),
-					string(search.Standard2),
-					string(search.Standard3),
-					string(search.StorageOptimizedL1),
-					string(search.StorageOptimizedL2),
+					string(services.SkuNameFree),
+					string(services.SkuNameBasic),
+					string(services.SkuNameStandard),
+					string(services.SkuNameStandardTwo),
+					string(services.SkuNameStandardThree),
+					string(services.SkuNameStorageOptimizedLOne),
+					string(services.SkuNameStorageOptimizedLTwo),
+				}, false),
+			},
+
+			"hosting_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(services.HostingModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.HostingModeDefault),
+					string(services.HostingModeHighDensity),
 				}, false),
 			},
 
@@ -111,6 +127,15 @@ func resourceSearchService() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"semantic_search_sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.SearchSemanticSearchFree),
+					string(services.SearchSemanticSearchStandard),
+				}, false),
+			},
+
 			"allowed_ips": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -133,7 +158,7 @@ func resourceSearchService() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(search.SystemAssigned),
+								string(services.IdentityTypeSystemAssigned),
 							}, false),
 						},
 
@@ -152,6 +177,22 @@ func resourceSearchService() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				skuName := d.Get("sku").(string)
+
+				if d.Get("hosting_mode").(string) == string(services.HostingModeHighDensity) && skuName != string(services.SkuNameStandardThree) {
+					return fmt.Errorf("`hosting_mode` can only be set to `%s` when the `sku` is `%s`", services.HostingModeHighDensity, services.SkuNameStandardThree)
+				}
+
+				if d.Get("semantic_search_sku").(string) != "" && skuName == string(services.SkuNameFree) {
+					return fmt.Errorf("`semantic_search_sku` cannot be specified when the `sku` is `%s`", services.SkuNameFree)
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -162,63 +203,65 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	defer cancel()
 
 	id := parse.NewSearchServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	serviceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.Name)
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, nil)
+		existing, err := client.Get(ctx, serviceId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_search_service", id.ID())
 		}
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	skuName := d.Get("sku").(string)
+	skuName := services.SkuName(d.Get("sku").(string))
+	hostingMode := services.HostingMode(d.Get("hosting_mode").(string))
 
-	publicNetworkAccess := search.Enabled
+	publicNetworkAccess := services.PublicNetworkAccessEnabled
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
-		publicNetworkAccess = search.Disabled
+		publicNetworkAccess = services.PublicNetworkAccessDisabled
 	}
 
-	t := d.Get("tags").(map[string]interface{})
-
-	properties := search.Service{
-		Location: utils.String(location),
-		Sku: &search.Sku{
-			Name: search.SkuName(skuName),
+	properties := services.SearchService{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Sku: &services.Sku{
+			Name: &skuName,
 		},
-		ServiceProperties: &search.ServiceProperties{
-			PublicNetworkAccess: publicNetworkAccess,
-			NetworkRuleSet: &search.NetworkRuleSet{
+		Properties: &services.SearchServiceProperties{
+			HostingMode:         &hostingMode,
+			PublicNetworkAccess: &publicNetworkAccess,
+			NetworkRuleSet: &services.NetworkRuleSet{
 				IPRules: expandSearchServiceIPRules(d.Get("allowed_ips").([]interface{})),
 			},
 		},
 		Identity: expandSearchServiceIdentity(d.Get("identity").([]interface{})),
-		Tags:     tags.Expand(t),
+		Tags:     tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// semantic search isn't available on the free tier, where the API rejects the property entirely
+	if skuName != services.SkuNameFree {
+		semanticSearch := services.SearchSemanticSearchDisabled
+		if v := d.Get("semantic_search_sku").(string); v != "" {
+			semanticSearch = services.SearchSemanticSearch(v)
+		}
+		properties.Properties.SemanticSearch = &semanticSearch
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
-		replicaCount := int32(v.(int))
-		properties.ServiceProperties.ReplicaCount = utils.Int32(replicaCount)
+		properties.Properties.ReplicaCount = utils.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("partition_count"); ok {
-		partitionCount := int32(v.(int))
-		properties.ServiceProperties.PartitionCount = utils.Int32(partitionCount)
+		properties.Properties.PartitionCount = utils.Int64(int64(v.(int)))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, properties, nil)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, serviceId, properties); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the creation/update of %s: %+v", id, err)
-	}
-
 	d.SetId(id.ID())
 	return resourceSearchServiceRead(d, meta)
 }
@@ -233,9 +276,9 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, nil)
+	resp, err := client.Get(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
@@ -246,24 +289,49 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", string(sku.Name))
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 
-	if props := resp.ServiceProperties; props != nil {
-		if count := props.PartitionCount; count != nil {
-			d.Set("partition_count", int(*count))
+		skuName := ""
+		if sku := model.Sku; sku != nil && sku.Name != nil {
+			skuName = string(*sku.Name)
 		}
+		d.Set("sku", skuName)
+
+		if props := model.Properties; props != nil {
+			if count := props.PartitionCount; count != nil {
+				d.Set("partition_count", int(*count))
+			}
+
+			if count := props.ReplicaCount; count != nil {
+				d.Set("replica_count", int(*count))
+			}
+
+			hostingMode := string(services.HostingModeDefault)
+			if props.HostingMode != nil {
+				hostingMode = string(*props.HostingMode)
+			}
+			d.Set("hosting_mode", hostingMode)
+
+			semanticSearchSku := ""
+			if props.SemanticSearch != nil && *props.SemanticSearch != services.SearchSemanticSearchDisabled {
+				semanticSearchSku = string(*props.SemanticSearch)
+			}
+			d.Set("semantic_search_sku", semanticSearchSku)
 
-		if count := props.ReplicaCount; count != nil {
-			d.Set("replica_count", int(*count))
+			d.Set("public_network_access_enabled", props.PublicNetworkAccess == nil || !strings.EqualFold(string(*props.PublicNetworkAccess), string(services.PublicNetworkAccessDisabled)))
+
+			d.Set("allowed_ips", flattenSearchServiceIPRules(props.NetworkRuleSet))
 		}
 
-		d.Set("public_network_access_enabled", props.PublicNetworkAccess != "Disabled")
+		if err := d.Set("identity", flattenSearchServiceIdentity(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %s", err)
+		}
 
-		d.Set("allowed_ips", flattenSearchServiceIPRules(props.NetworkRuleSet))
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
 	}
 
 	adminKeysClient := meta.(*clients.Client).Search.AdminKeysClient
@@ -279,11 +347,7 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		d.Set("query_keys", flattenSearchQueryKeys(queryKeysResp.Values()))
 	}
 
-	if err := d.Set("identity", flattenSearchServiceIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("setting `identity`: %s", err)
-	}
-
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceSearchServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -296,12 +360,7 @@ func resourceSearchServiceDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name, nil)
-	if err != nil {
-		if utils.ResponseWasNotFound(resp) {
-			return nil
-		}
-
+	if _, err := client.Delete(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.Name)); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
@@ -325,15 +384,15 @@ func flattenSearchQueryKeys(input []search.QueryKey) []interface{} {
 	return results
 }
 
-func expandSearchServiceIPRules(input []interface{}) *[]search.IPRule {
-	output := make([]search.IPRule, 0)
+func expandSearchServiceIPRules(input []interface{}) *[]services.IPRule {
+	output := make([]services.IPRule, 0)
 	if input == nil {
 		return &output
 	}
 
 	for _, rule := range input {
 		if rule != nil {
-			output = append(output, search.IPRule{
+			output = append(output, services.IPRule{
 				Value: utils.String(rule.(string)),
 			})
 		}
@@ -342,8 +401,8 @@ func expandSearchServiceIPRules(input []interface{}) *[]search.IPRule {
 	return &output
 }
 
-func flattenSearchServiceIPRules(input *search.NetworkRuleSet) []interface{} {
-	if input == nil || *input.IPRules == nil || len(*input.IPRules) == 0 {
+func flattenSearchServiceIPRules(input *services.NetworkRuleSet) []interface{} {
+	if input == nil || input.IPRules == nil || len(*input.IPRules) == 0 {
 		return nil
 	}
 	result := make([]interface{}, 0)
@@ -353,31 +412,31 @@ func flattenSearchServiceIPRules(input *search.NetworkRuleSet) []interface{} {
 	return result
 }
 
-func expandSearchServiceIdentity(input []interface{}) *search.Identity {
+func expandSearchServiceIdentity(input []interface{}) *services.Identity {
 	if len(input) == 0 || input[0] == nil {
-		return &search.Identity{
-			Type: search.None,
+		return &services.Identity{
+			Type: services.IdentityTypeNone,
 		}
 	}
 	identity := input[0].(map[string]interface{})
-	return &search.Identity{
-		Type: search.IdentityType(identity["type"].(string)),
+	return &services.Identity{
+		Type: services.IdentityType(identity["type"].(string)),
 	}
 }
 
-func flattenSearchServiceIdentity(identity *search.Identity) []interface{} {
-	if identity == nil || identity.Type == search.None {
+func flattenSearchServiceIdentity(identity *services.Identity) []interface{} {
+	if identity == nil || identity.Type == services.IdentityTypeNone {
 		return make([]interface{}, 0)
 	}
 
 	principalId := ""
-	if identity.PrincipalID != nil {
-		principalId = *identity.PrincipalID
+	if identity.PrincipalId != nil {
+		principalId = *identity.PrincipalId
 	}
 
 	tenantId := ""
-	if identity.TenantID != nil {
-		tenantId = *identity.TenantID
+	if identity.TenantId != nil {
+		tenantId = *identity.TenantId
 	}
 
 	return []interface{}{
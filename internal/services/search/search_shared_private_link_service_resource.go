@@ -0,0 +1,239 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/sharedprivatelinkresources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSearchSharedPrivateLinkService() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSearchSharedPrivateLinkServiceCreate,
+		Read:   resourceSearchSharedPrivateLinkServiceRead,
+		Delete: resourceSearchSharedPrivateLinkServiceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"search_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: sharedprivatelinkresources.ValidateSearchServiceID,
+			},
+
+			"subresource_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"amlworkspace",
+					"blob",
+					"cognitiveservices_account",
+					"dfs",
+					"file",
+					"managedInstance",
+					"mysqlServer",
+					"openai_account",
+					"sites",
+					"Sql",
+					"sqlServer",
+					"table",
+					"vault",
+				}, false),
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"request_message": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSearchSharedPrivateLinkServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Search.SharedPrivateLinkResourcesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	searchServiceId, err := sharedprivatelinkresources.ParseSearchServiceID(d.Get("search_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := sharedprivatelinkresources.NewSharedPrivateLinkResourceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_search_shared_private_link_service", id.ID())
+	}
+
+	// the Search Service only allows a single Shared Private Link operation at a time
+	locks.ByName(id.SearchServiceName, "azurerm_search_service")
+	defer locks.UnlockByName(id.SearchServiceName, "azurerm_search_service")
+
+	payload := sharedprivatelinkresources.SharedPrivateLinkResource{
+		Properties: &sharedprivatelinkresources.SharedPrivateLinkResourceProperties{
+			GroupId:               utils.String(d.Get("subresource_name").(string)),
+			PrivateLinkResourceId: utils.String(d.Get("target_resource_id").(string)),
+		},
+	}
+
+	if v := d.Get("request_message").(string); v != "" {
+		payload.Properties.RequestMessage = utils.String(v)
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	// the long-running operation completes before the private endpoint connection has been
+	// requested on the target resource, so wait for it to be ready for approval
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context was missing a deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(sharedprivatelinkresources.SharedPrivateLinkResourceProvisioningStateIncomplete),
+			string(sharedprivatelinkresources.SharedPrivateLinkResourceProvisioningStateUpdating),
+		},
+		Target: []string{
+			string(sharedprivatelinkresources.SharedPrivateLinkResourceProvisioningStateSucceeded),
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh:    searchSharedPrivateLinkServiceProvisioningStateRefreshFunc(ctx, client, id),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish provisioning: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSearchSharedPrivateLinkServiceRead(d, meta)
+}
+
+func resourceSearchSharedPrivateLinkServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Search.SharedPrivateLinkResourcesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SharedPrivateLinkResourceName)
+	d.Set("search_service_id", sharedprivatelinkresources.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("subresource_name", props.GroupId)
+			d.Set("target_resource_id", props.PrivateLinkResourceId)
+			d.Set("request_message", props.RequestMessage)
+
+			status := ""
+			if props.Status != nil {
+				status = string(*props.Status)
+			}
+			d.Set("status", status)
+		}
+	}
+
+	return nil
+}
+
+func resourceSearchSharedPrivateLinkServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Search.SharedPrivateLinkResourcesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.SearchServiceName, "azurerm_search_service")
+	defer locks.UnlockByName(id.SearchServiceName, "azurerm_search_service")
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func searchSharedPrivateLinkServiceProvisioningStateRefreshFunc(ctx context.Context, client *sharedprivatelinkresources.SharedPrivateLinkResourcesClient, id sharedprivatelinkresources.SharedPrivateLinkResourceId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.ProvisioningState == nil {
+			return resp, "Failed", fmt.Errorf("`properties.provisioningState` was missing from the response")
+		}
+
+		return resp, string(*resp.Model.Properties.ProvisioningState), nil
+	}
+}
@@ -3,13 +3,19 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/contentpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/productpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/sourcecontrols"
 )
 
 type Client struct {
 	AlertRulesClient         *securityinsight.AlertRulesClient
 	AlertRuleTemplatesClient *securityinsight.AlertRuleTemplatesClient
 	AutomationRulesClient    *securityinsight.AutomationRulesClient
+	ContentPackagesClient    *contentpackages.ContentPackagesClient
 	DataConnectorsClient     *securityinsight.DataConnectorsClient
+	ProductPackagesClient    *productpackages.ProductPackagesClient
+	SourceControlsClient     *sourcecontrols.SourceControlsClient
 	WatchlistsClient         *securityinsight.WatchlistsClient
 }
 
@@ -23,9 +29,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	automationRulesClient := securityinsight.NewAutomationRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&automationRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	contentPackagesClient := contentpackages.NewContentPackagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&contentPackagesClient.Client, o.ResourceManagerAuthorizer)
+
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
+	productPackagesClient := productpackages.NewProductPackagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&productPackagesClient.Client, o.ResourceManagerAuthorizer)
+
+	sourceControlsClient := sourcecontrols.NewSourceControlsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sourceControlsClient.Client, o.ResourceManagerAuthorizer)
+
 	watchListsClient := securityinsight.NewWatchlistsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&watchListsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -33,7 +48,10 @@ func NewClient(o *common.ClientOptions) *Client {
 		AlertRulesClient:         &alertRulesClient,
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
 		AutomationRulesClient:    &automationRulesClient,
+		ContentPackagesClient:    &contentPackagesClient,
 		DataConnectorsClient:     &dataConnectorsClient,
+		ProductPackagesClient:    &productPackagesClient,
+		SourceControlsClient:     &sourceControlsClient,
 		WatchlistsClient:         &watchListsClient,
 	}
 }
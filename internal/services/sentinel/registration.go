@@ -52,6 +52,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ContentPackageResource{},
+		SourceControlResource{},
 		WatchlistResource{},
 	}
 }
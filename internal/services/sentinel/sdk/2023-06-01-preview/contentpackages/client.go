@@ -0,0 +1,15 @@
+package contentpackages
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ContentPackagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContentPackagesClientWithBaseURI(endpoint string) ContentPackagesClient {
+	return ContentPackagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
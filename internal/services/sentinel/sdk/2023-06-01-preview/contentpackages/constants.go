@@ -0,0 +1,96 @@
+package contentpackages
+
+import "strings"
+
+type PackageKind string
+
+const (
+	PackageKindSolution   PackageKind = "Solution"
+	PackageKindStandalone PackageKind = "Standalone"
+)
+
+func PossibleValuesForPackageKind() []string {
+	return []string{
+		string(PackageKindSolution),
+		string(PackageKindStandalone),
+	}
+}
+
+func parsePackageKind(input string) (*PackageKind, error) {
+	vals := map[string]PackageKind{
+		"solution":   PackageKindSolution,
+		"standalone": PackageKindStandalone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PackageKind(input)
+	return &out, nil
+}
+
+type SourceKind string
+
+const (
+	SourceKindCommunity        SourceKind = "Community"
+	SourceKindLocalWorkspace   SourceKind = "LocalWorkspace"
+	SourceKindSolution         SourceKind = "Solution"
+	SourceKindSourceRepository SourceKind = "SourceRepository"
+)
+
+func PossibleValuesForSourceKind() []string {
+	return []string{
+		string(SourceKindCommunity),
+		string(SourceKindLocalWorkspace),
+		string(SourceKindSolution),
+		string(SourceKindSourceRepository),
+	}
+}
+
+func parseSourceKind(input string) (*SourceKind, error) {
+	vals := map[string]SourceKind{
+		"community":        SourceKindCommunity,
+		"localworkspace":   SourceKindLocalWorkspace,
+		"solution":         SourceKindSolution,
+		"sourcerepository": SourceKindSourceRepository,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SourceKind(input)
+	return &out, nil
+}
+
+type SupportTier string
+
+const (
+	SupportTierCommunity SupportTier = "Community"
+	SupportTierMicrosoft SupportTier = "Microsoft"
+	SupportTierPartner   SupportTier = "Partner"
+)
+
+func PossibleValuesForSupportTier() []string {
+	return []string{
+		string(SupportTierCommunity),
+		string(SupportTierMicrosoft),
+		string(SupportTierPartner),
+	}
+}
+
+func parseSupportTier(input string) (*SupportTier, error) {
+	vals := map[string]SupportTier{
+		"community": SupportTierCommunity,
+		"microsoft": SupportTierMicrosoft,
+		"partner":   SupportTierPartner,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SupportTier(input)
+	return &out, nil
+}
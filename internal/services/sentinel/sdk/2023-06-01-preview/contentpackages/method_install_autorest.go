@@ -0,0 +1,65 @@
+package contentpackages
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type InstallResponse struct {
+	HttpResponse *http.Response
+	Model        *PackageModel
+}
+
+// Install ...
+func (c ContentPackagesClient) Install(ctx context.Context, id ContentPackageId, input PackageModel) (result InstallResponse, err error) {
+	req, err := c.preparerForInstall(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contentpackages.ContentPackagesClient", "Install", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contentpackages.ContentPackagesClient", "Install", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForInstall(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contentpackages.ContentPackagesClient", "Install", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForInstall prepares the Install request.
+func (c ContentPackagesClient) preparerForInstall(ctx context.Context, id ContentPackageId, input PackageModel) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForInstall handles the response to the Install request. The method always
+// closes the http.Response Body.
+func (c ContentPackagesClient) responderForInstall(resp *http.Response) (result InstallResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
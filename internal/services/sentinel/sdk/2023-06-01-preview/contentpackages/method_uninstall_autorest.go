@@ -0,0 +1,62 @@
+package contentpackages
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type UninstallResponse struct {
+	HttpResponse *http.Response
+}
+
+// Uninstall ...
+func (c ContentPackagesClient) Uninstall(ctx context.Context, id ContentPackageId) (result UninstallResponse, err error) {
+	req, err := c.preparerForUninstall(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contentpackages.ContentPackagesClient", "Uninstall", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contentpackages.ContentPackagesClient", "Uninstall", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForUninstall(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contentpackages.ContentPackagesClient", "Uninstall", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForUninstall prepares the Uninstall request.
+func (c ContentPackagesClient) preparerForUninstall(ctx context.Context, id ContentPackageId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForUninstall handles the response to the Uninstall request. The method always
+// closes the http.Response Body.
+func (c ContentPackagesClient) responderForUninstall(resp *http.Response) (result UninstallResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
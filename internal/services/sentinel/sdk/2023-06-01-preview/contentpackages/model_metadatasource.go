@@ -0,0 +1,7 @@
+package contentpackages
+
+type MetadataSource struct {
+	Kind     SourceKind `json:"kind"`
+	Name     *string    `json:"name,omitempty"`
+	SourceId *string    `json:"sourceId,omitempty"`
+}
@@ -0,0 +1,8 @@
+package contentpackages
+
+type MetadataSupport struct {
+	Email *string     `json:"email,omitempty"`
+	Link  *string     `json:"link,omitempty"`
+	Name  *string     `json:"name,omitempty"`
+	Tier  SupportTier `json:"tier"`
+}
@@ -0,0 +1,9 @@
+package contentpackages
+
+type PackageModel struct {
+	Etag       *string            `json:"etag,omitempty"`
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *PackageProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
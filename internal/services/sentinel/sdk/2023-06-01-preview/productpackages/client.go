@@ -0,0 +1,15 @@
+package productpackages
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ProductPackagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewProductPackagesClientWithBaseURI(endpoint string) ProductPackagesClient {
+	return ProductPackagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
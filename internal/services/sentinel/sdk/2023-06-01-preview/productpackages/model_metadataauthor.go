@@ -0,0 +1,7 @@
+package productpackages
+
+type MetadataAuthor struct {
+	Email *string `json:"email,omitempty"`
+	Link  *string `json:"link,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
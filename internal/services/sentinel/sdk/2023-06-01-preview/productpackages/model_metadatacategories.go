@@ -0,0 +1,6 @@
+package productpackages
+
+type MetadataCategories struct {
+	Domains   *[]string `json:"domains,omitempty"`
+	Verticals *[]string `json:"verticals,omitempty"`
+}
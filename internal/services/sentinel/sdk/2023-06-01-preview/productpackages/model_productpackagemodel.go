@@ -0,0 +1,9 @@
+package productpackages
+
+type ProductPackageModel struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *ProductPackageProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,21 @@
+package productpackages
+
+type ProductPackageProperties struct {
+	Author               *MetadataAuthor     `json:"author,omitempty"`
+	Categories           *MetadataCategories `json:"categories,omitempty"`
+	ContentId            string              `json:"contentId"`
+	ContentKind          PackageKind         `json:"contentKind"`
+	ContentProductId     string              `json:"contentProductId"`
+	ContentSchemaVersion *string             `json:"contentSchemaVersion,omitempty"`
+	Description          *string             `json:"description,omitempty"`
+	DisplayName          string              `json:"displayName"`
+	FirstPublishDate     *string             `json:"firstPublishDate,omitempty"`
+	Icon                 *string             `json:"icon,omitempty"`
+	InstalledVersion     *string             `json:"installedVersion,omitempty"`
+	LastPublishDate      *string             `json:"lastPublishDate,omitempty"`
+	Providers            *[]string           `json:"providers,omitempty"`
+	PublisherDisplayName *string             `json:"publisherDisplayName,omitempty"`
+	Source               *MetadataSource     `json:"source,omitempty"`
+	Support              *MetadataSupport    `json:"support,omitempty"`
+	Version              string              `json:"version"`
+}
@@ -0,0 +1,15 @@
+package sourcecontrols
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SourceControlsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSourceControlsClientWithBaseURI(endpoint string) SourceControlsClient {
+	return SourceControlsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,130 @@
+package sourcecontrols
+
+import "strings"
+
+type ContentType string
+
+const (
+	ContentTypeAnalyticRule   ContentType = "AnalyticRule"
+	ContentTypeAutomationRule ContentType = "AutomationRule"
+	ContentTypeHuntingQuery   ContentType = "HuntingQuery"
+	ContentTypeParser         ContentType = "Parser"
+	ContentTypePlaybook       ContentType = "Playbook"
+	ContentTypeWorkbook       ContentType = "Workbook"
+)
+
+func PossibleValuesForContentType() []string {
+	return []string{
+		string(ContentTypeAnalyticRule),
+		string(ContentTypeAutomationRule),
+		string(ContentTypeHuntingQuery),
+		string(ContentTypeParser),
+		string(ContentTypePlaybook),
+		string(ContentTypeWorkbook),
+	}
+}
+
+func parseContentType(input string) (*ContentType, error) {
+	vals := map[string]ContentType{
+		"analyticrule":   ContentTypeAnalyticRule,
+		"automationrule": ContentTypeAutomationRule,
+		"huntingquery":   ContentTypeHuntingQuery,
+		"parser":         ContentTypeParser,
+		"playbook":       ContentTypePlaybook,
+		"workbook":       ContentTypeWorkbook,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ContentType(input)
+	return &out, nil
+}
+
+type RepoType string
+
+const (
+	RepoTypeAzureDevOps RepoType = "AzureDevOps"
+	RepoTypeGithub      RepoType = "Github"
+)
+
+func PossibleValuesForRepoType() []string {
+	return []string{
+		string(RepoTypeAzureDevOps),
+		string(RepoTypeGithub),
+	}
+}
+
+func parseRepoType(input string) (*RepoType, error) {
+	vals := map[string]RepoType{
+		"azuredevops": RepoTypeAzureDevOps,
+		"github":      RepoTypeGithub,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RepoType(input)
+	return &out, nil
+}
+
+type RepositoryAccessKind string
+
+const (
+	RepositoryAccessKindApp   RepositoryAccessKind = "App"
+	RepositoryAccessKindOAuth RepositoryAccessKind = "OAuth"
+	RepositoryAccessKindPAT   RepositoryAccessKind = "PAT"
+)
+
+func PossibleValuesForRepositoryAccessKind() []string {
+	return []string{
+		string(RepositoryAccessKindApp),
+		string(RepositoryAccessKindOAuth),
+		string(RepositoryAccessKindPAT),
+	}
+}
+
+func parseRepositoryAccessKind(input string) (*RepositoryAccessKind, error) {
+	vals := map[string]RepositoryAccessKind{
+		"app":   RepositoryAccessKindApp,
+		"oauth": RepositoryAccessKindOAuth,
+		"pat":   RepositoryAccessKindPAT,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RepositoryAccessKind(input)
+	return &out, nil
+}
+
+type Version string
+
+const (
+	VersionVOne Version = "V1"
+	VersionVTwo Version = "V2"
+)
+
+func PossibleValuesForVersion() []string {
+	return []string{
+		string(VersionVOne),
+		string(VersionVTwo),
+	}
+}
+
+func parseVersion(input string) (*Version, error) {
+	vals := map[string]Version{
+		"v1": VersionVOne,
+		"v2": VersionVTwo,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Version(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package sourcecontrols
+
+type AzureDevOpsResourceInfo struct {
+	PipelineId          *string `json:"pipelineId,omitempty"`
+	ServiceConnectionId *string `json:"serviceConnectionId,omitempty"`
+}
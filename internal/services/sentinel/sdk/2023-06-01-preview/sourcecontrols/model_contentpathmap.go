@@ -0,0 +1,6 @@
+package sourcecontrols
+
+type ContentPathMap struct {
+	ContentType *ContentType `json:"contentType,omitempty"`
+	Path        *string      `json:"path,omitempty"`
+}
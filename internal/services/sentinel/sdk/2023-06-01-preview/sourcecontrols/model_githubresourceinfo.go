@@ -0,0 +1,5 @@
+package sourcecontrols
+
+type GitHubResourceInfo struct {
+	AppInstallationId *string `json:"appInstallationId,omitempty"`
+}
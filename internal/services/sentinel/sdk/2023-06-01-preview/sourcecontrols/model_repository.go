@@ -0,0 +1,9 @@
+package sourcecontrols
+
+type Repository struct {
+	Branch            *string           `json:"branch,omitempty"`
+	DeploymentLogsUrl *string           `json:"deploymentLogsUrl,omitempty"`
+	DisplayUrl        *string           `json:"displayUrl,omitempty"`
+	PathMapping       *[]ContentPathMap `json:"pathMapping,omitempty"`
+	Url               *string           `json:"url,omitempty"`
+}
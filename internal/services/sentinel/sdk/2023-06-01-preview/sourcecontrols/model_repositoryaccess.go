@@ -0,0 +1,10 @@
+package sourcecontrols
+
+type RepositoryAccess struct {
+	ClientId       *string              `json:"clientId,omitempty"`
+	Code           *string              `json:"code,omitempty"`
+	InstallationId *string              `json:"installationId,omitempty"`
+	Kind           RepositoryAccessKind `json:"kind"`
+	State          *string              `json:"state,omitempty"`
+	Token          *string              `json:"token,omitempty"`
+}
@@ -0,0 +1,5 @@
+package sourcecontrols
+
+type RepositoryAccessObject struct {
+	RepositoryAccess *RepositoryAccess `json:"repositoryAccess,omitempty"`
+}
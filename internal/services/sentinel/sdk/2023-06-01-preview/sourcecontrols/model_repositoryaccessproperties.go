@@ -0,0 +1,5 @@
+package sourcecontrols
+
+type RepositoryAccessProperties struct {
+	Properties RepositoryAccessObject `json:"properties"`
+}
@@ -0,0 +1,7 @@
+package sourcecontrols
+
+type RepositoryResourceInfo struct {
+	AzureDevOpsResourceInfo *AzureDevOpsResourceInfo `json:"azureDevOpsResourceInfo,omitempty"`
+	GitHubResourceInfo      *GitHubResourceInfo      `json:"gitHubResourceInfo,omitempty"`
+	Webhook                 *Webhook                 `json:"webhook,omitempty"`
+}
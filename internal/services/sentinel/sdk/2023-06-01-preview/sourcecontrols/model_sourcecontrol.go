@@ -0,0 +1,9 @@
+package sourcecontrols
+
+type SourceControl struct {
+	Etag       *string                  `json:"etag,omitempty"`
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *SourceControlProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,13 @@
+package sourcecontrols
+
+type SourceControlProperties struct {
+	ContentTypes           []ContentType           `json:"contentTypes"`
+	Description            *string                 `json:"description,omitempty"`
+	DisplayName            string                  `json:"displayName"`
+	Id                     *string                 `json:"id,omitempty"`
+	RepoType               RepoType                `json:"repoType"`
+	Repository             Repository              `json:"repository"`
+	RepositoryAccess       *RepositoryAccess       `json:"repositoryAccess,omitempty"`
+	RepositoryResourceInfo *RepositoryResourceInfo `json:"repositoryResourceInfo,omitempty"`
+	Version                *Version                `json:"version,omitempty"`
+}
@@ -0,0 +1,8 @@
+package sourcecontrols
+
+type Webhook struct {
+	RotateWebhookSecret     *bool   `json:"rotateWebhookSecret,omitempty"`
+	WebhookId               *string `json:"webhookId,omitempty"`
+	WebhookSecretUpdateTime *string `json:"webhookSecretUpdateTime,omitempty"`
+	WebhookUrl              *string `json:"webhookUrl,omitempty"`
+}
@@ -0,0 +1,9 @@
+package sourcecontrols
+
+import "fmt"
+
+const defaultApiVersion = "2023-06-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/sourcecontrols/%s", defaultApiVersion)
+}
@@ -0,0 +1,326 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/contentpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/productpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContentPackageResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContentPackageResource{}
+
+type ContentPackageModel struct {
+	Name                    string `tfschema:"name"`
+	LogAnalyticsWorkspaceId string `tfschema:"log_analytics_workspace_id"`
+	Version                 string `tfschema:"version"`
+	ContentKind             string `tfschema:"content_kind"`
+	ContentProductId        string `tfschema:"content_product_id"`
+	DisplayName             string `tfschema:"display_name"`
+	LatestVersion           string `tfschema:"latest_version"`
+	PublisherDisplayName    string `tfschema:"publisher_display_name"`
+}
+
+func (r ContentPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+		},
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^\d+\.\d+\.\d+$`),
+				"`version` must be in the format `major.minor.patch`, e.g. `3.0.1`",
+			),
+		},
+	}
+}
+
+func (r ContentPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"content_product_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"latest_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"publisher_display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContentPackageResource) ResourceType() string {
+	return "azurerm_sentinel_content_package"
+}
+
+func (r ContentPackageResource) ModelObject() interface{} {
+	return &ContentPackageModel{}
+}
+
+func (r ContentPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contentpackages.ValidateContentPackageID
+}
+
+func (r ContentPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			var model ContentPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := contentpackages.NewContentPackageID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			catalogPackage, err := findSentinelContentHubPackage(ctx, metadata, id)
+			if err != nil {
+				return err
+			}
+			if catalogPackage == nil {
+				return fmt.Errorf("the package %q was not found in the Content Hub of Log Analytics Workspace %q", id.PackageId, id.WorkspaceName)
+			}
+
+			if _, err := client.Install(ctx, id, expandSentinelContentPackage(*catalogPackage, model.Version)); err != nil {
+				return fmt.Errorf("installing %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := ContentPackageModel{
+				Name:                    id.PackageId,
+				LogAnalyticsWorkspaceId: loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if resp.Model != nil {
+				if props := resp.Model.Properties; props != nil {
+					model.Version = props.Version
+					model.ContentKind = string(props.ContentKind)
+					model.ContentProductId = props.ContentProductId
+					model.DisplayName = props.DisplayName
+					model.PublisherDisplayName = utils.NormalizeNilableString(props.PublisherDisplayName)
+				}
+			}
+
+			catalogPackage, err := findSentinelContentHubPackage(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+			if catalogPackage != nil {
+				model.LatestVersion = catalogPackage.Version
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContentPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContentPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				catalogPackage, err := findSentinelContentHubPackage(ctx, metadata, *id)
+				if err != nil {
+					return err
+				}
+				if catalogPackage == nil {
+					return fmt.Errorf("the package %q was not found in the Content Hub of Log Analytics Workspace %q", id.PackageId, id.WorkspaceName)
+				}
+
+				if _, err := client.Install(ctx, *id, expandSentinelContentPackage(*catalogPackage, model.Version)); err != nil {
+					return fmt.Errorf("updating %s to version %q: %+v", *id, model.Version, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Uninstall(ctx, *id); err != nil {
+				return fmt.Errorf("uninstalling %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findSentinelContentHubPackage looks up the package within the Content Hub catalog of the workspace, which is keyed
+// by the product ID rather than the content ID used to install the package - as such this returns nil if no package
+// with a matching content ID is available.
+func findSentinelContentHubPackage(ctx context.Context, metadata sdk.ResourceMetaData, id contentpackages.ContentPackageId) (*productpackages.ProductPackageProperties, error) {
+	client := metadata.Client.Sentinel.ProductPackagesClient
+
+	workspaceId := productpackages.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+	options := productpackages.ListOperationOptions{
+		Filter: utils.String(fmt.Sprintf("properties/contentId eq '%s'", id.PackageId)),
+	}
+	resp, err := client.ListComplete(ctx, workspaceId, options)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Content Hub packages for %s: %+v", workspaceId, err)
+	}
+
+	for _, item := range resp.Items {
+		if props := item.Properties; props != nil && strings.EqualFold(props.ContentId, id.PackageId) {
+			return props, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func expandSentinelContentPackage(input productpackages.ProductPackageProperties, version string) contentpackages.PackageModel {
+	if version == "" {
+		version = input.Version
+	}
+
+	props := contentpackages.PackageProperties{
+		ContentId:            input.ContentId,
+		ContentKind:          contentpackages.PackageKind(input.ContentKind),
+		ContentProductId:     input.ContentProductId,
+		ContentSchemaVersion: input.ContentSchemaVersion,
+		Description:          input.Description,
+		DisplayName:          input.DisplayName,
+		FirstPublishDate:     input.FirstPublishDate,
+		Icon:                 input.Icon,
+		LastPublishDate:      input.LastPublishDate,
+		Providers:            input.Providers,
+		PublisherDisplayName: input.PublisherDisplayName,
+		Version:              version,
+	}
+
+	if author := input.Author; author != nil {
+		props.Author = &contentpackages.MetadataAuthor{
+			Email: author.Email,
+			Link:  author.Link,
+			Name:  author.Name,
+		}
+	}
+
+	if categories := input.Categories; categories != nil {
+		props.Categories = &contentpackages.MetadataCategories{
+			Domains:   categories.Domains,
+			Verticals: categories.Verticals,
+		}
+	}
+
+	if source := input.Source; source != nil {
+		props.Source = &contentpackages.MetadataSource{
+			Kind:     contentpackages.SourceKind(source.Kind),
+			Name:     source.Name,
+			SourceId: source.SourceId,
+		}
+	}
+
+	if support := input.Support; support != nil {
+		props.Support = &contentpackages.MetadataSupport{
+			Email: support.Email,
+			Link:  support.Link,
+			Name:  support.Name,
+			Tier:  contentpackages.SupportTier(support.Tier),
+		}
+	}
+
+	return contentpackages.PackageModel{
+		Properties: &props,
+	}
+}
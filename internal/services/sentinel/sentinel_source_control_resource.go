@@ -0,0 +1,480 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/sourcecontrols"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SourceControlResource struct{}
+
+var _ sdk.ResourceWithUpdate = SourceControlResource{}
+
+type SourceControlModel struct {
+	Name                    string                          `tfschema:"name"`
+	LogAnalyticsWorkspaceId string                          `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string                          `tfschema:"display_name"`
+	Description             string                          `tfschema:"description"`
+	RepositoryType          string                          `tfschema:"repository_type"`
+	ContentTypes            []string                        `tfschema:"content_types"`
+	Repository              []SourceControlRepositoryModel  `tfschema:"repository"`
+	RepositoryAccess        []SourceControlRepositoryAccess `tfschema:"repository_access"`
+	Webhook                 []SourceControlWebhookModel     `tfschema:"webhook"`
+}
+
+type SourceControlRepositoryModel struct {
+	Url         string                             `tfschema:"url"`
+	Branch      string                             `tfschema:"branch"`
+	PathMapping []SourceControlRepositoryPathModel `tfschema:"path_mapping"`
+}
+
+type SourceControlRepositoryPathModel struct {
+	ContentType string `tfschema:"content_type"`
+	Path        string `tfschema:"path"`
+}
+
+type SourceControlRepositoryAccess struct {
+	Kind           string `tfschema:"kind"`
+	Token          string `tfschema:"token"`
+	Code           string `tfschema:"code"`
+	State          string `tfschema:"state"`
+	ClientId       string `tfschema:"client_id"`
+	InstallationId string `tfschema:"installation_id"`
+}
+
+type SourceControlWebhookModel struct {
+	Id  string `tfschema:"id"`
+	Url string `tfschema:"url"`
+}
+
+func (r SourceControlResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+		},
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"repository_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(sourcecontrols.PossibleValuesForRepoType(), false),
+		},
+		"content_types": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(sourcecontrols.PossibleValuesForContentType(), false),
+			},
+		},
+		"repository": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+					"branch": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"path_mapping": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"content_type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(sourcecontrols.PossibleValuesForContentType(), false),
+								},
+								"path": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"repository_access": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kind": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(sourcecontrols.PossibleValuesForRepositoryAccessKind(), false),
+					},
+					"token": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"code": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"state": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"client_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"installation_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SourceControlResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"webhook": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SourceControlResource) ResourceType() string {
+	return "azurerm_sentinel_source_control"
+}
+
+func (r SourceControlResource) ModelObject() interface{} {
+	return &SourceControlModel{}
+}
+
+func (r SourceControlResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sourcecontrols.ValidateSourceControlID
+}
+
+func (r SourceControlResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			var model SourceControlModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := sourcecontrols.NewSourceControlID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.Create(ctx, id, expandSentinelSourceControl(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SourceControlResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			id, err := sourcecontrols.ParseSourceControlID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the credentials used to access the repository aren't returned by the API, so are retained from the state
+			var state SourceControlModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			model := SourceControlModel{
+				Name:                    id.SourceControlId,
+				LogAnalyticsWorkspaceId: loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				RepositoryAccess:        state.RepositoryAccess,
+			}
+
+			if resp.Model != nil {
+				if props := resp.Model.Properties; props != nil {
+					model.DisplayName = props.DisplayName
+					model.Description = utils.NormalizeNilableString(props.Description)
+					model.RepositoryType = string(props.RepoType)
+					model.Repository = flattenSentinelSourceControlRepository(props.Repository)
+
+					contentTypes := make([]string, 0)
+					for _, contentType := range props.ContentTypes {
+						contentTypes = append(contentTypes, string(contentType))
+					}
+					model.ContentTypes = contentTypes
+
+					if info := props.RepositoryResourceInfo; info != nil && info.Webhook != nil {
+						model.Webhook = []SourceControlWebhookModel{
+							{
+								Id:  utils.NormalizeNilableString(info.Webhook.WebhookId),
+								Url: utils.NormalizeNilableString(info.Webhook.WebhookUrl),
+							},
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r SourceControlResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			id, err := sourcecontrols.ParseSourceControlID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SourceControlModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the connection is replaced in full, so the etag of the existing connection is required
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			parameters := expandSentinelSourceControl(model)
+			if existing.Model != nil {
+				parameters.Etag = existing.Model.Etag
+			}
+
+			if _, err := client.Create(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SourceControlResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			id, err := sourcecontrols.ParseSourceControlID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SourceControlModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the repository credentials are required to remove the workflows/pipelines created within the repository
+			parameters := sourcecontrols.RepositoryAccessProperties{
+				Properties: sourcecontrols.RepositoryAccessObject{
+					RepositoryAccess: expandSentinelSourceControlRepositoryAccess(model.RepositoryAccess),
+				},
+			}
+			if _, err := client.Delete(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSentinelSourceControl(input SourceControlModel) sourcecontrols.SourceControl {
+	contentTypes := make([]sourcecontrols.ContentType, 0)
+	for _, contentType := range input.ContentTypes {
+		contentTypes = append(contentTypes, sourcecontrols.ContentType(contentType))
+	}
+
+	version := sourcecontrols.VersionVTwo
+	props := sourcecontrols.SourceControlProperties{
+		ContentTypes:     contentTypes,
+		DisplayName:      input.DisplayName,
+		RepoType:         sourcecontrols.RepoType(input.RepositoryType),
+		RepositoryAccess: expandSentinelSourceControlRepositoryAccess(input.RepositoryAccess),
+		Version:          &version,
+	}
+
+	if input.Description != "" {
+		props.Description = utils.String(input.Description)
+	}
+
+	if len(input.Repository) > 0 {
+		repository := input.Repository[0]
+		props.Repository = sourcecontrols.Repository{
+			Branch: utils.String(repository.Branch),
+			Url:    utils.String(repository.Url),
+		}
+
+		if len(repository.PathMapping) > 0 {
+			pathMappings := make([]sourcecontrols.ContentPathMap, 0)
+			for _, item := range repository.PathMapping {
+				contentType := sourcecontrols.ContentType(item.ContentType)
+				pathMappings = append(pathMappings, sourcecontrols.ContentPathMap{
+					ContentType: &contentType,
+					Path:        utils.String(item.Path),
+				})
+			}
+			props.Repository.PathMapping = &pathMappings
+		}
+	}
+
+	return sourcecontrols.SourceControl{
+		Properties: &props,
+	}
+}
+
+func expandSentinelSourceControlRepositoryAccess(input []SourceControlRepositoryAccess) *sourcecontrols.RepositoryAccess {
+	if len(input) == 0 {
+		return nil
+	}
+
+	access := input[0]
+	output := sourcecontrols.RepositoryAccess{
+		Kind: sourcecontrols.RepositoryAccessKind(access.Kind),
+	}
+
+	if access.Token != "" {
+		output.Token = utils.String(access.Token)
+	}
+	if access.Code != "" {
+		output.Code = utils.String(access.Code)
+	}
+	if access.State != "" {
+		output.State = utils.String(access.State)
+	}
+	if access.ClientId != "" {
+		output.ClientId = utils.String(access.ClientId)
+	}
+	if access.InstallationId != "" {
+		output.InstallationId = utils.String(access.InstallationId)
+	}
+
+	return &output
+}
+
+func flattenSentinelSourceControlRepository(input sourcecontrols.Repository) []SourceControlRepositoryModel {
+	pathMappings := make([]SourceControlRepositoryPathModel, 0)
+	if input.PathMapping != nil {
+		for _, item := range *input.PathMapping {
+			contentType := ""
+			if item.ContentType != nil {
+				contentType = string(*item.ContentType)
+			}
+
+			pathMappings = append(pathMappings, SourceControlRepositoryPathModel{
+				ContentType: contentType,
+				Path:        utils.NormalizeNilableString(item.Path),
+			})
+		}
+	}
+
+	return []SourceControlRepositoryModel{
+		{
+			Url:         utils.NormalizeNilableString(input.Url),
+			Branch:      utils.NormalizeNilableString(input.Branch),
+			PathMapping: pathMappings,
+		},
+	}
+}
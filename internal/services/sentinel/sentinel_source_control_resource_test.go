@@ -0,0 +1,134 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/sourcecontrols"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SourceControlResource struct{}
+
+func TestAccSourceControl_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_SENTINEL_GITHUB_REPO_URL") == "" || os.Getenv("ARM_TEST_SENTINEL_GITHUB_TOKEN") == "" {
+		t.Skip("Skipping as ARM_TEST_SENTINEL_GITHUB_REPO_URL or ARM_TEST_SENTINEL_GITHUB_TOKEN is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_source_control", "test")
+	r := SourceControlResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("repository_access"),
+	})
+}
+
+func TestAccSourceControl_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_SENTINEL_GITHUB_REPO_URL") == "" || os.Getenv("ARM_TEST_SENTINEL_GITHUB_TOKEN") == "" {
+		t.Skip("Skipping as ARM_TEST_SENTINEL_GITHUB_REPO_URL or ARM_TEST_SENTINEL_GITHUB_TOKEN is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_source_control", "test")
+	r := SourceControlResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("repository_access"),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("repository_access"),
+	})
+}
+
+func (r SourceControlResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := sourcecontrols.ParseSourceControlID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.SourceControlsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SourceControlResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "random_uuid" "test" {}
+
+resource "azurerm_sentinel_source_control" "test" {
+  name                       = random_uuid.test.result
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "acctest-sc-%d"
+  repository_type            = "Github"
+  content_types              = ["AnalyticRule"]
+
+  repository {
+    url    = %q
+    branch = "main"
+  }
+
+  repository_access {
+    kind  = "PAT"
+    token = %q
+  }
+}
+`, WatchlistResource{}.template(data), data.RandomInteger, os.Getenv("ARM_TEST_SENTINEL_GITHUB_REPO_URL"), os.Getenv("ARM_TEST_SENTINEL_GITHUB_TOKEN"))
+}
+
+func (r SourceControlResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "random_uuid" "test" {}
+
+resource "azurerm_sentinel_source_control" "test" {
+  name                       = random_uuid.test.result
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "acctest-sc-updated-%d"
+  description                = "Deploys the analytics rules from the repository"
+  repository_type            = "Github"
+  content_types              = ["AnalyticRule"]
+
+  repository {
+    url    = %q
+    branch = "main"
+  }
+
+  repository_access {
+    kind  = "PAT"
+    token = %q
+  }
+}
+`, WatchlistResource{}.template(data), data.RandomInteger, os.Getenv("ARM_TEST_SENTINEL_GITHUB_REPO_URL"), os.Getenv("ARM_TEST_SENTINEL_GITHUB_TOKEN"))
+}
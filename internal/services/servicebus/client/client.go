@@ -3,12 +3,14 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/sdk/2022-10-01-preview/namespaces"
 )
 
 type Client struct {
 	QueuesClient                  *servicebus.QueuesClient
 	DisasterRecoveryConfigsClient *servicebus.DisasterRecoveryConfigsClient
 	NamespacesClient              *servicebus.NamespacesClient
+	NamespacesPreviewClient       *namespaces.NamespacesClient
 	TopicsClient                  *servicebus.TopicsClient
 	SubscriptionsClient           *servicebus.SubscriptionsClient
 	SubscriptionRulesClient       *servicebus.RulesClient
@@ -24,6 +26,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	NamespacesClient := servicebus.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
 
+	NamespacesPreviewClient := namespaces.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacesPreviewClient.Client, o.ResourceManagerAuthorizer)
+
 	TopicsClient := servicebus.NewTopicsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&TopicsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -37,6 +42,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		QueuesClient:                  &QueuesClient,
 		DisasterRecoveryConfigsClient: &DisasterRecoveryConfigsClient,
 		NamespacesClient:              &NamespacesClient,
+		NamespacesPreviewClient:       &NamespacesPreviewClient,
 		TopicsClient:                  &TopicsClient,
 		SubscriptionsClient:           &SubscriptionsClient,
 		SubscriptionRulesClient:       &SubscriptionRulesClient,
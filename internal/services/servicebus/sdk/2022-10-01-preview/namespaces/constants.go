@@ -0,0 +1,90 @@
+package namespaces
+
+import "strings"
+
+type KeySource string
+
+const (
+	KeySourceMicrosoftPointKeyVault KeySource = "Microsoft.KeyVault"
+)
+
+func PossibleValuesForKeySource() []string {
+	return []string{
+		string(KeySourceMicrosoftPointKeyVault),
+	}
+}
+
+func parseKeySource(input string) (*KeySource, error) {
+	vals := map[string]KeySource{
+		"microsoft.keyvault": KeySourceMicrosoftPointKeyVault,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KeySource(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameBasic    SkuName = "Basic"
+	SkuNamePremium  SkuName = "Premium"
+	SkuNameStandard SkuName = "Standard"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameBasic),
+		string(SkuNamePremium),
+		string(SkuNameStandard),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"basic":    SkuNameBasic,
+		"premium":  SkuNamePremium,
+		"standard": SkuNameStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
+type SkuTier string
+
+const (
+	SkuTierBasic    SkuTier = "Basic"
+	SkuTierPremium  SkuTier = "Premium"
+	SkuTierStandard SkuTier = "Standard"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierBasic),
+		string(SkuTierPremium),
+		string(SkuTierStandard),
+	}
+}
+
+func parseSkuTier(input string) (*SkuTier, error) {
+	vals := map[string]SkuTier{
+		"basic":    SkuTierBasic,
+		"premium":  SkuTierPremium,
+		"standard": SkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuTier(input)
+	return &out, nil
+}
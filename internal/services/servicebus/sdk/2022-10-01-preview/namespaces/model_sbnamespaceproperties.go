@@ -0,0 +1,10 @@
+package namespaces
+
+type SBNamespaceProperties struct {
+	Encryption                 *Encryption `json:"encryption,omitempty"`
+	PremiumMessagingPartitions *int64      `json:"premiumMessagingPartitions,omitempty"`
+	ProvisioningState          *string     `json:"provisioningState,omitempty"`
+	ServiceBusEndpoint         *string     `json:"serviceBusEndpoint,omitempty"`
+	Status                     *string     `json:"status,omitempty"`
+	ZoneRedundant              *bool       `json:"zoneRedundant,omitempty"`
+}
@@ -0,0 +1,5 @@
+package namespaces
+
+type UserAssignedIdentityProperties struct {
+	UserAssignedIdentity *string `json:"userAssignedIdentity,omitempty"`
+}
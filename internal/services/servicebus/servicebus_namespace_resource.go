@@ -1,6 +1,7 @@
 package servicebus
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,12 +9,19 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/sdk/2022-10-01-preview/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -85,6 +93,44 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				ValidateFunc: validation.IntInSlice([]int{0, 1, 2, 4, 8, 16}),
 			},
 
+			"premium_messaging_partitions": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 4}),
+			},
+
+			"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
+
+						"identity_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+						},
+
+						"infrastructure_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"default_primary_connection_string": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -117,11 +163,18 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// Customer Managed Key Encryption can only be enabled when the Namespace is created and can't be removed afterwards
+			pluginsdk.ForceNewIfChange("customer_managed_key", func(ctx context.Context, old, new, _ interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
+		),
 	}
 }
 
 func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus.NamespacesPreviewClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -132,49 +185,72 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 	sku := d.Get("sku").(string)
 	t := d.Get("tags").(map[string]interface{})
 
-	resourceId := parse.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	resourceId := namespaces.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.Name)
+		existing, err := client.Get(ctx, resourceId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", resourceId, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_servicebus_namespace", resourceId.ID())
 		}
 	}
 
-	parameters := servicebus.SBNamespace{
-		Location: &location,
-		Sku: &servicebus.SBSku{
-			Name: servicebus.SkuName(sku),
-			Tier: servicebus.SkuTier(sku),
+	expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	skuTier := namespaces.SkuTier(sku)
+	parameters := namespaces.SBNamespace{
+		Location: location,
+		Identity: expandedIdentity,
+		Sku: &namespaces.SBSku{
+			Name: namespaces.SkuName(sku),
+			Tier: &skuTier,
 		},
-		SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+		Properties: &namespaces.SBNamespaceProperties{
 			ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tagsHelper.Expand(t),
 	}
 
+	isPremium := strings.EqualFold(sku, string(namespaces.SkuNamePremium))
+
 	if capacity := d.Get("capacity"); capacity != nil {
-		if !strings.EqualFold(sku, string(servicebus.SkuNamePremium)) && capacity.(int) > 0 {
+		if !isPremium && capacity.(int) > 0 {
 			return fmt.Errorf("Service Bus SKU %q only supports `capacity` of 0", sku)
 		}
-		if strings.EqualFold(sku, string(servicebus.SkuNamePremium)) && capacity.(int) == 0 {
+		if isPremium && capacity.(int) == 0 {
 			return fmt.Errorf("Service Bus SKU %q only supports `capacity` of 1, 2, 4, 8 or 16", sku)
 		}
-		parameters.Sku.Capacity = utils.Int32(int32(capacity.(int)))
+		parameters.Sku.Capacity = utils.Int64(int64(capacity.(int)))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.Name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
+	if v, ok := d.GetOk("premium_messaging_partitions"); ok {
+		if !isPremium {
+			return fmt.Errorf("`premium_messaging_partitions` can only be specified when `sku` is set to %q", string(namespaces.SkuNamePremium))
+		}
+		parameters.Properties.PremiumMessagingPartitions = utils.Int64(int64(v.(int)))
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for create/update of %s: %+v", resourceId, err)
+	if v := d.Get("customer_managed_key").([]interface{}); len(v) > 0 {
+		if !isPremium {
+			return fmt.Errorf("`customer_managed_key` can only be specified when `sku` is set to %q", string(namespaces.SkuNamePremium))
+		}
+
+		encryption, err := expandServiceBusNamespaceEncryption(v, expandedIdentity)
+		if err != nil {
+			return err
+		}
+		parameters.Properties.Encryption = encryption
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, resourceId, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
 	d.SetId(resourceId.ID())
@@ -182,39 +258,63 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 }
 
 func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus.NamespacesPreviewClient
 	clientStable := meta.(*clients.Client).ServiceBus.NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.NamespaceID(d.Id())
+	id, err := namespaces.ParseNamespaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("name", id.NamespaceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", strings.ToLower(string(sku.Name)))
-		d.Set("capacity", sku.Capacity)
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if sku := model.Sku; sku != nil {
+			d.Set("sku", strings.ToLower(string(sku.Name)))
+			d.Set("capacity", sku.Capacity)
+		}
+
+		if properties := model.Properties; properties != nil {
+			d.Set("zone_redundant", properties.ZoneRedundant)
+			d.Set("premium_messaging_partitions", properties.PremiumMessagingPartitions)
 
-	if properties := resp.SBNamespaceProperties; properties != nil {
-		d.Set("zone_redundant", properties.ZoneRedundant)
+			customerManagedKey, err := flattenServiceBusNamespaceEncryption(properties.Encryption)
+			if err != nil {
+				return fmt.Errorf("flattening `customer_managed_key`: %+v", err)
+			}
+			if err := d.Set("customer_managed_key", customerManagedKey); err != nil {
+				return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
 	}
 
-	keys, err := clientStable.ListKeys(ctx, id.ResourceGroup, id.Name, serviceBusNamespaceDefaultAuthorizationRule)
+	keys, err := clientStable.ListKeys(ctx, id.ResourceGroupName, id.NamespaceName, serviceBusNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		log.Printf("[WARN] listing default keys for %s: %+v", id, err)
 	} else {
@@ -224,7 +324,7 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -250,3 +350,82 @@ func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+func expandServiceBusNamespaceEncryption(input []interface{}, namespaceIdentity *identity.SystemAndUserAssignedMap) (*namespaces.Encryption, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	identityId := raw["identity_id"].(string)
+	if namespaceIdentity == nil {
+		return nil, fmt.Errorf("`identity_id` %q must be assigned to the Service Bus Namespace within the `identity` block", identityId)
+	}
+	if _, ok := namespaceIdentity.IdentityIds[identityId]; !ok {
+		return nil, fmt.Errorf("`identity_id` %q must be assigned to the Service Bus Namespace within the `identity` block", identityId)
+	}
+
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(raw["key_vault_key_id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+	}
+
+	keySource := namespaces.KeySourceMicrosoftPointKeyVault
+	return &namespaces.Encryption{
+		KeySource: &keySource,
+		KeyVaultProperties: &[]namespaces.KeyVaultProperties{
+			{
+				Identity: &namespaces.UserAssignedIdentityProperties{
+					UserAssignedIdentity: utils.String(identityId),
+				},
+				KeyName:     utils.String(keyId.Name),
+				KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
+				KeyVersion:  utils.String(keyId.Version),
+			},
+		},
+		RequireInfrastructureEncryption: utils.Bool(raw["infrastructure_encryption_enabled"].(bool)),
+	}, nil
+}
+
+func flattenServiceBusNamespaceEncryption(input *namespaces.Encryption) ([]interface{}, error) {
+	if input == nil || input.KeyVaultProperties == nil || len(*input.KeyVaultProperties) == 0 {
+		return []interface{}{}, nil
+	}
+	props := (*input.KeyVaultProperties)[0]
+
+	keyVaultKeyId := ""
+	if props.KeyVaultUri != nil && props.KeyName != nil {
+		keyVersion := ""
+		if props.KeyVersion != nil {
+			keyVersion = *props.KeyVersion
+		}
+
+		keyId, err := keyVaultParse.NewNestedItemID(*props.KeyVaultUri, "keys", *props.KeyName, keyVersion)
+		if err != nil {
+			return nil, err
+		}
+		keyVaultKeyId = keyId.ID()
+	}
+
+	identityId := ""
+	if props.Identity != nil && props.Identity.UserAssignedIdentity != nil {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*props.Identity.UserAssignedIdentity)
+		if err != nil {
+			return nil, err
+		}
+		identityId = id.ID()
+	}
+
+	infrastructureEncryptionEnabled := false
+	if input.RequireInfrastructureEncryption != nil {
+		infrastructureEncryptionEnabled = *input.RequireInfrastructureEncryption
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":                  keyVaultKeyId,
+			"identity_id":                       identityId,
+			"infrastructure_encryption_enabled": infrastructureEncryptionEnabled,
+		},
+	}, nil
+}
@@ -3,17 +3,23 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2020-05-01/signalr"
+	signalrLatest "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2023-02-01/signalr"
 )
 
 type Client struct {
-	Client *signalr.SignalRClient
+	Client       *signalr.SignalRClient
+	LatestClient *signalrLatest.SignalRClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	client := signalr.NewSignalRClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
 
+	latestClient := signalrLatest.NewSignalRClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&latestClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		Client: &client,
+		Client:       &client,
+		LatestClient: &latestClient,
 	}
 }
@@ -28,7 +28,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_signalr_service":             resourceArmSignalRService(),
-		"azurerm_signalr_service_network_acl": resourceArmSignalRServiceNetworkACL(),
+		"azurerm_signalr_service":                    resourceArmSignalRService(),
+		"azurerm_signalr_service_custom_certificate": resourceArmSignalRServiceCustomCertificate(),
+		"azurerm_signalr_service_custom_domain":      resourceArmSignalRServiceCustomDomain(),
+		"azurerm_signalr_service_network_acl":        resourceArmSignalRServiceNetworkACL(),
 	}
 }
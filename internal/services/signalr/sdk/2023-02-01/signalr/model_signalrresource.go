@@ -0,0 +1,13 @@
+package signalr
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type SignalRResource struct {
+	Id       *string                           `json:"id,omitempty"`
+	Identity *identity.SystemOrUserAssignedMap `json:"identity,omitempty"`
+	Location *string                           `json:"location,omitempty"`
+	Name     *string                           `json:"name,omitempty"`
+	Type     *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,195 @@
+package signalr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	signalrLatest "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2023-02-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SignalRServiceCustomCertificateResource struct{}
+
+func TestAccSignalRServiceCustomCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_custom_certificate", "test")
+	r := SignalRServiceCustomCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate_version").Exists(),
+			),
+		},
+		data.ImportStep("custom_certificate_id"),
+	})
+}
+
+func TestAccSignalRServiceCustomCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_custom_certificate", "test")
+	r := SignalRServiceCustomCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SignalRServiceCustomCertificateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := signalrLatest.ParseCustomCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SignalR.LatestClient.CustomCertificatesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SignalRServiceCustomCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_custom_certificate" "test" {
+  name                  = "acctestcert-%d"
+  signalr_service_id    = azurerm_signalr_service.test.id
+  custom_certificate_id = azurerm_key_vault_certificate.test.id
+
+  depends_on = [azurerm_key_vault_access_policy.signalr]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SignalRServiceCustomCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_custom_certificate" "import" {
+  name                  = azurerm_signalr_service_custom_certificate.test.name
+  signalr_service_id    = azurerm_signalr_service_custom_certificate.test.signalr_service_id
+  custom_certificate_id = azurerm_signalr_service_custom_certificate.test.custom_certificate_id
+}
+`, r.basic(data))
+}
+
+func (r SignalRServiceCustomCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-signalr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_signalr_service" "test" {
+  name                = "acctestSignalR-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_key_vault_access_policy" "current" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = data.azurerm_client_config.current.object_id
+
+  certificate_permissions = ["Create", "Delete", "Get", "Import", "List", "Purge", "Update"]
+  secret_permissions      = ["Delete", "Get", "List", "Purge", "Set"]
+}
+
+resource "azurerm_key_vault_access_policy" "signalr" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = azurerm_signalr_service.test.identity.0.tenant_id
+  object_id    = azurerm_signalr_service.test.identity.0.principal_id
+
+  certificate_permissions = ["Get", "List"]
+  secret_permissions      = ["Get", "List"]
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name         = "acctestcert%[3]s"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage = [
+        "digitalSignature",
+        "keyEncipherment",
+      ]
+
+      subject            = "CN=signalr.acctest%[1]d.com"
+      validity_in_months = 12
+
+      subject_alternative_names {
+        dns_names = ["signalr.acctest%[1]d.com"]
+      }
+    }
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.current]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
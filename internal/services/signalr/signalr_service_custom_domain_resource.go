@@ -0,0 +1,212 @@
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	signalrLatest "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2023-02-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmSignalRServiceCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSignalRServiceCustomDomainCreate,
+		Read:   resourceSignalRServiceCustomDomainRead,
+		Delete: resourceSignalRServiceCustomDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := signalrLatest.ParseCustomDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"signalr_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalrLatest.ValidateSignalRID,
+			},
+
+			"domain_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"signalr_custom_certificate_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalrLatest.ValidateCustomCertificateID,
+			},
+		},
+	}
+}
+
+func resourceSignalRServiceCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.LatestClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	signalRServiceId, err := signalrLatest.ParseSignalRID(d.Get("signalr_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := signalrLatest.NewCustomDomainID(signalRServiceId.SubscriptionId, signalRServiceId.ResourceGroupName, signalRServiceId.SignalRName, d.Get("name").(string))
+
+	existing, err := client.CustomDomainsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_signalr_service_custom_domain", id.ID())
+	}
+
+	customCertificateId, err := signalrLatest.ParseCustomCertificateID(d.Get("signalr_custom_certificate_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if customCertificateId.SignalRName != id.SignalRName || customCertificateId.ResourceGroupName != id.ResourceGroupName {
+		return fmt.Errorf("the Custom Certificate %s must belong to %s", *customCertificateId, *signalRServiceId)
+	}
+
+	payload := signalrLatest.CustomDomain{
+		Properties: signalrLatest.CustomDomainProperties{
+			DomainName: d.Get("domain_name").(string),
+			CustomCertificate: signalrLatest.ResourceReference{
+				Id: utils.String(customCertificateId.ID()),
+			},
+		},
+	}
+
+	if err := client.CustomDomainsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	// the long-running operation can complete before the domain has finished provisioning
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context was missing a deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(signalrLatest.ProvisioningStateCreating),
+			string(signalrLatest.ProvisioningStateRunning),
+			string(signalrLatest.ProvisioningStateUpdating),
+		},
+		Target: []string{
+			string(signalrLatest.ProvisioningStateSucceeded),
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh:    signalRServiceCustomDomainProvisioningStateRefreshFunc(ctx, client, id),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish provisioning: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSignalRServiceCustomDomainRead(d, meta)
+}
+
+func resourceSignalRServiceCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.LatestClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalrLatest.ParseCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CustomDomainsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("signalr_service_id", signalrLatest.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("domain_name", props.DomainName)
+
+		customCertificateId := ""
+		if props.CustomCertificate.Id != nil {
+			parsed, err := signalrLatest.ParseCustomCertificateIDInsensitively(*props.CustomCertificate.Id)
+			if err != nil {
+				return err
+			}
+			customCertificateId = parsed.ID()
+		}
+		d.Set("signalr_custom_certificate_id", customCertificateId)
+	}
+
+	return nil
+}
+
+func resourceSignalRServiceCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.LatestClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalrLatest.ParseCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.CustomDomainsDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func signalRServiceCustomDomainProvisioningStateRefreshFunc(ctx context.Context, client *signalrLatest.SignalRClient, id signalrLatest.CustomDomainId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.CustomDomainsGet(ctx, id)
+		if err != nil {
+			return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties.ProvisioningState == nil {
+			return resp, "Failed", fmt.Errorf("`properties.provisioningState` was missing from the response")
+		}
+
+		return resp, string(*resp.Model.Properties.ProvisioningState), nil
+	}
+}
@@ -0,0 +1,103 @@
+package signalr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	signalrLatest "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2023-02-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SignalRServiceCustomDomainResource struct{}
+
+func TestAccSignalRServiceCustomDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_custom_domain", "test")
+	r := SignalRServiceCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSignalRServiceCustomDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_custom_domain", "test")
+	r := SignalRServiceCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SignalRServiceCustomDomainResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := signalrLatest.ParseCustomDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SignalR.LatestClient.CustomDomainsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SignalRServiceCustomDomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctest%[2]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "signalr"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  record              = azurerm_signalr_service.test.hostname
+}
+
+resource "azurerm_signalr_service_custom_domain" "test" {
+  name                          = "acctestcd-%[2]d"
+  signalr_service_id            = azurerm_signalr_service.test.id
+  domain_name                   = trimsuffix(azurerm_dns_cname_record.test.fqdn, ".")
+  signalr_custom_certificate_id = azurerm_signalr_service_custom_certificate.test.id
+}
+`, SignalRServiceCustomCertificateResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SignalRServiceCustomDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_custom_domain" "import" {
+  name                          = azurerm_signalr_service_custom_domain.test.name
+  signalr_service_id            = azurerm_signalr_service_custom_domain.test.signalr_service_id
+  domain_name                   = azurerm_signalr_service_custom_domain.test.domain_name
+  signalr_custom_certificate_id = azurerm_signalr_service_custom_domain.test.signalr_custom_certificate_id
+}
+`, r.basic(data))
+}
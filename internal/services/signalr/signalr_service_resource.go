@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2020-05-01/signalr"
+	signalrLatest "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2023-02-01/signalr"
 	signalrValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -70,6 +73,7 @@ func resourceArmSignalRService() *pluginsdk.Resource {
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"Free_F1",
+								"Premium_P1",
 								"Standard_S1",
 							}, false),
 						},
@@ -256,6 +260,8 @@ func resourceArmSignalRService() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"identity": commonschema.SystemOrUserAssignedIdentity(),
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -355,6 +361,12 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("listing keys for %s: %+v", *id, err)
 	}
 
+	// the identity isn't available in the 2020-05-01 API, so is retrieved using the newer API
+	latestResp, err := meta.(*clients.Client).SignalR.LatestClient.Get(ctx, signalrLatest.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName))
+	if err != nil {
+		return fmt.Errorf("retrieving identity for %s: %+v", *id, err)
+	}
+
 	d.Set("name", id.ResourceName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
@@ -412,6 +424,16 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	if model := latestResp.Model; model != nil {
+		flattenedIdentity, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+	}
+
 	if model := keys.Model; model != nil {
 		d.Set("primary_access_key", model.PrimaryKey)
 		d.Set("primary_connection_string", model.PrimaryConnectionString)
@@ -482,7 +504,7 @@ func resourceArmSignalRServiceUpdate(d *pluginsdk.ResourceData, meta interface{}
 		resourceType.Sku = expandSignalRServiceSku(sku)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
 		resourceType.Tags = tagsHelper.Expand(tagsRaw)
 	}
@@ -491,6 +513,20 @@ func resourceArmSignalRServiceUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
+	if d.HasChange("identity") {
+		expandedIdentity, err := identity.ExpandSystemOrUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+
+		payload := signalrLatest.SignalRResource{
+			Identity: expandedIdentity,
+		}
+		if err := meta.(*clients.Client).SignalR.LatestClient.UpdateThenPoll(ctx, signalrLatest.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName), payload); err != nil {
+			return fmt.Errorf("updating `identity` for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceArmSignalRServiceRead(d, meta)
 }
 
@@ -363,7 +363,7 @@ func resourceSpringCloudServiceUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		model := appplatform.ServiceResource{
 			Sku: &appplatform.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
@@ -5,6 +5,7 @@ import (
 	msi "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2018-06-01-preview/sql"
 	sqlv5 "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2022-08-01-preview/startstopmanagedinstanceschedules"
 )
 
 type Client struct {
@@ -25,6 +26,7 @@ type Client struct {
 	ServerAzureADAdministratorsClient               *sqlv5.ServerAzureADAdministratorsClient
 	ServerAzureADOnlyAuthenticationsClient          *sqlv5.ServerAzureADOnlyAuthenticationsClient
 	ServerSecurityAlertPoliciesClient               *sql.ServerSecurityAlertPoliciesClient
+	StartStopManagedInstanceSchedulesClient         *startstopmanagedinstanceschedules.StartStopManagedInstanceSchedulesClient
 	VirtualNetworkRulesClient                       *sql.VirtualNetworkRulesClient
 }
 
@@ -84,6 +86,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	serverSecurityAlertPoliciesClient := sql.NewServerSecurityAlertPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&serverSecurityAlertPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	startStopManagedInstanceSchedulesClient := startstopmanagedinstanceschedules.NewStartStopManagedInstanceSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&startStopManagedInstanceSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		DatabasesClient: &databasesClient,
 		DatabaseExtendedBlobAuditingPoliciesClient:      &databaseExtendedBlobAuditingPoliciesClient,
@@ -102,6 +107,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ServerConnectionPoliciesClient:                  &serverConnectionPoliciesClient,
 		ServerExtendedBlobAuditingPoliciesClient:        &serverExtendedBlobAuditingPoliciesClient,
 		ServerSecurityAlertPoliciesClient:               &serverSecurityAlertPoliciesClient,
+		StartStopManagedInstanceSchedulesClient:         &startStopManagedInstanceSchedulesClient,
 		VirtualNetworkRulesClient:                       &virtualNetworkRulesClient,
 	}
 }
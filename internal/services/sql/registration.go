@@ -39,6 +39,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_sql_managed_instance":                                resourceArmSqlMiServer(),
 		"azurerm_sql_managed_instance_failover_group":                 resourceSqlInstanceFailoverGroup(),
 		"azurerm_sql_managed_instance_active_directory_administrator": resourceSqlManagedInstanceAdministrator(),
+		"azurerm_sql_managed_instance_start_stop_schedule":            resourceSqlManagedInstanceStartStopSchedule(),
 		"azurerm_sql_server":                                          resourceSqlServer(),
 		"azurerm_sql_virtual_network_rule":                            resourceSqlVirtualNetworkRule(),
 	}
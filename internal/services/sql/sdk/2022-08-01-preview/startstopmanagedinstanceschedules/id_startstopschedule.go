@@ -0,0 +1,137 @@
+package startstopmanagedinstanceschedules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = StartStopScheduleId{}
+
+// StartStopScheduleId is a struct representing the Resource ID for a Start Stop Schedule
+type StartStopScheduleId struct {
+	SubscriptionId        string
+	ResourceGroupName     string
+	ManagedInstanceName   string
+	StartStopScheduleName string
+}
+
+// NewStartStopScheduleID returns a new StartStopScheduleId struct
+func NewStartStopScheduleID(subscriptionId string, resourceGroupName string, managedInstanceName string, startStopScheduleName string) StartStopScheduleId {
+	return StartStopScheduleId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroupName:     resourceGroupName,
+		ManagedInstanceName:   managedInstanceName,
+		StartStopScheduleName: startStopScheduleName,
+	}
+}
+
+// ParseStartStopScheduleID parses 'input' into a StartStopScheduleId
+func ParseStartStopScheduleID(input string) (*StartStopScheduleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(StartStopScheduleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := StartStopScheduleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ManagedInstanceName, ok = parsed.Parsed["managedInstanceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managedInstanceName' was not found in the resource id %q", input)
+	}
+
+	if id.StartStopScheduleName, ok = parsed.Parsed["startStopScheduleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'startStopScheduleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseStartStopScheduleIDInsensitively parses 'input' case-insensitively into a StartStopScheduleId
+// note: this method should only be used for API response data and not user input
+func ParseStartStopScheduleIDInsensitively(input string) (*StartStopScheduleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(StartStopScheduleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := StartStopScheduleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ManagedInstanceName, ok = parsed.Parsed["managedInstanceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managedInstanceName' was not found in the resource id %q", input)
+	}
+
+	if id.StartStopScheduleName, ok = parsed.Parsed["startStopScheduleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'startStopScheduleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateStartStopScheduleID checks that 'input' can be parsed as a Start Stop Schedule ID
+func ValidateStartStopScheduleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseStartStopScheduleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Start Stop Schedule ID
+func (id StartStopScheduleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/managedInstances/%s/startStopSchedules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ManagedInstanceName, id.StartStopScheduleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Start Stop Schedule ID
+func (id StartStopScheduleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticManagedInstances", "managedInstances", "managedInstances"),
+		resourceids.UserSpecifiedSegment("managedInstanceName", "managedInstanceValue"),
+		resourceids.StaticSegment("staticStartStopSchedules", "startStopSchedules", "startStopSchedules"),
+		resourceids.UserSpecifiedSegment("startStopScheduleName", "startStopScheduleValue"),
+	}
+}
+
+// String returns a human-readable description of this Start Stop Schedule ID
+func (id StartStopScheduleId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Managed Instance Name: %q", id.ManagedInstanceName),
+		fmt.Sprintf("Start Stop Schedule Name: %q", id.StartStopScheduleName),
+	}
+	return fmt.Sprintf("Start Stop Schedule (%s)", strings.Join(components, "\n"))
+}
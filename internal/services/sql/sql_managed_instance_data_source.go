@@ -94,6 +94,11 @@ func dataSourceArmSqlMiServer() *schema.Resource {
 				Computed: true,
 			},
 
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"dns_zone_partner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -175,6 +180,7 @@ func dataSourceArmSqlMiServerRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("storage_size_in_gb", props.StorageSizeInGB)
 		d.Set("vcores", props.VCores)
 		d.Set("fqdn", props.FullyQualifiedDomainName)
+		d.Set("state", props.State)
 		d.Set("collation", props.Collation)
 		d.Set("public_data_endpoint_enabled", props.PublicDataEndpointEnabled)
 		d.Set("minimum_tls_version", props.MinimalTLSVersion)
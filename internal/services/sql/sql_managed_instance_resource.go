@@ -166,6 +166,11 @@ func resourceArmSqlMiServer() *schema.Resource {
 				Computed: true,
 			},
 
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"dns_zone_partner_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -314,6 +319,7 @@ func resourceArmSqlMiServerRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("storage_size_in_gb", props.StorageSizeInGB)
 		d.Set("vcores", props.VCores)
 		d.Set("fqdn", props.FullyQualifiedDomainName)
+		d.Set("state", props.State)
 		d.Set("collation", props.Collation)
 		d.Set("public_data_endpoint_enabled", props.PublicDataEndpointEnabled)
 		d.Set("minimum_tls_version", props.MinimalTLSVersion)
@@ -25,6 +25,7 @@ func TestAccAzureRMSqlMiServer_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Ready"),
 			),
 		},
 		data.ImportStep("administrator_login_password"),
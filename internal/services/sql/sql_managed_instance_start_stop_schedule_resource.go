@@ -0,0 +1,262 @@
+package sql
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2022-08-01-preview/startstopmanagedinstanceschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a Managed Instance can only have a single Start/Stop Schedule, which must be named `default`
+const managedInstanceStartStopScheduleName = "default"
+
+func resourceSqlManagedInstanceStartStopSchedule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSqlManagedInstanceStartStopScheduleCreateUpdate,
+		Read:   resourceSqlManagedInstanceStartStopScheduleRead,
+		Update: resourceSqlManagedInstanceStartStopScheduleCreateUpdate,
+		Delete: resourceSqlManagedInstanceStartStopScheduleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := startstopmanagedinstanceschedules.ParseStartStopScheduleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"managed_instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateManagedInstanceStartStopScheduleManagedInstanceID,
+			},
+
+			"schedule": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"start_day": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(startstopmanagedinstanceschedules.PossibleValuesForDayOfWeek(), false),
+						},
+
+						"start_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validateManagedInstanceScheduleTime,
+						},
+
+						"stop_day": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(startstopmanagedinstanceschedules.PossibleValuesForDayOfWeek(), false),
+						},
+
+						"stop_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validateManagedInstanceScheduleTime,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"timezone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"next_execution_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"next_run_action": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var validateManagedInstanceScheduleTime = validation.StringMatch(
+	regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+	"the time must be in the format `HH:MM` using a 24 hour clock",
+)
+
+// validateManagedInstanceStartStopScheduleManagedInstanceID validates the Managed Instance ID using the parser directly,
+// since importing the `sql/validate` package from here introduces an import cycle via its tests
+func validateManagedInstanceStartStopScheduleManagedInstanceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.ManagedInstanceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func resourceSqlManagedInstanceStartStopScheduleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.StartStopManagedInstanceSchedulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managedInstanceId, err := parse.ManagedInstanceID(d.Get("managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := startstopmanagedinstanceschedules.NewStartStopScheduleID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, managedInstanceStartStopScheduleName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_sql_managed_instance_start_stop_schedule", id.ID())
+		}
+	}
+
+	parameters := startstopmanagedinstanceschedules.StartStopManagedInstanceSchedule{
+		Properties: &startstopmanagedinstanceschedules.StartStopManagedInstanceScheduleProperties{
+			ScheduleList: expandManagedInstanceStartStopScheduleItems(d.Get("schedule").([]interface{})),
+			TimeZoneId:   utils.String(d.Get("timezone_id").(string)),
+		},
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSqlManagedInstanceStartStopScheduleRead(d, meta)
+}
+
+func resourceSqlManagedInstanceStartStopScheduleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.StartStopManagedInstanceSchedulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := startstopmanagedinstanceschedules.ParseStartStopScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("managed_instance_id", parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroupName, id.ManagedInstanceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			d.Set("next_execution_time", props.NextExecutionTime)
+			d.Set("next_run_action", props.NextRunAction)
+
+			timeZoneId := "UTC"
+			if props.TimeZoneId != nil {
+				timeZoneId = *props.TimeZoneId
+			}
+			d.Set("timezone_id", timeZoneId)
+
+			if err := d.Set("schedule", flattenManagedInstanceStartStopScheduleItems(props.ScheduleList)); err != nil {
+				return fmt.Errorf("setting `schedule`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceSqlManagedInstanceStartStopScheduleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.StartStopManagedInstanceSchedulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := startstopmanagedinstanceschedules.ParseStartStopScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandManagedInstanceStartStopScheduleItems(input []interface{}) []startstopmanagedinstanceschedules.ScheduleItem {
+	output := make([]startstopmanagedinstanceschedules.ScheduleItem, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		output = append(output, startstopmanagedinstanceschedules.ScheduleItem{
+			StartDay:  startstopmanagedinstanceschedules.DayOfWeek(v["start_day"].(string)),
+			StartTime: v["start_time"].(string),
+			StopDay:   startstopmanagedinstanceschedules.DayOfWeek(v["stop_day"].(string)),
+			StopTime:  v["stop_time"].(string),
+		})
+	}
+	return output
+}
+
+func flattenManagedInstanceStartStopScheduleItems(input []startstopmanagedinstanceschedules.ScheduleItem) []interface{} {
+	output := make([]interface{}, 0)
+	for _, item := range input {
+		output = append(output, map[string]interface{}{
+			"start_day":  string(item.StartDay),
+			"start_time": item.StartTime,
+			"stop_day":   string(item.StopDay),
+			"stop_time":  item.StopTime,
+		})
+	}
+	return output
+}
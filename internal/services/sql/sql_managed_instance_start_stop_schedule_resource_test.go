@@ -0,0 +1,156 @@
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2022-08-01-preview/startstopmanagedinstanceschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SqlManagedInstanceStartStopScheduleResource struct{}
+
+func TestAccSqlManagedInstanceStartStopSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_start_stop_schedule", "test")
+	r := SqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("next_execution_time").IsSet(),
+				check.That(data.ResourceName).Key("next_run_action").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSqlManagedInstanceStartStopSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_start_stop_schedule", "test")
+	r := SqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSqlManagedInstanceStartStopSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_start_stop_schedule", "test")
+	r := SqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SqlManagedInstanceStartStopScheduleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := startstopmanagedinstanceschedules.ParseStartStopScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Sql.StartStopManagedInstanceSchedulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SqlManagedInstanceStartStopScheduleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance_start_stop_schedule" "test" {
+  managed_instance_id = azurerm_sql_managed_instance.test.id
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "08:00"
+    stop_day   = "Friday"
+    stop_time  = "18:00"
+  }
+}
+`, SqlManagedInstanceResource{}.basic(data))
+}
+
+func (r SqlManagedInstanceStartStopScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance_start_stop_schedule" "import" {
+  managed_instance_id = azurerm_sql_managed_instance_start_stop_schedule.test.managed_instance_id
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "08:00"
+    stop_day   = "Friday"
+    stop_time  = "18:00"
+  }
+}
+`, r.basic(data))
+}
+
+func (r SqlManagedInstanceStartStopScheduleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance_start_stop_schedule" "test" {
+  managed_instance_id = azurerm_sql_managed_instance.test.id
+  description         = "Business Hours"
+  timezone_id         = "Pacific Standard Time"
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "07:30"
+    stop_day   = "Wednesday"
+    stop_time  = "19:00"
+  }
+
+  schedule {
+    start_day  = "Thursday"
+    start_time = "07:30"
+    stop_day   = "Friday"
+    stop_time  = "17:00"
+  }
+}
+`, SqlManagedInstanceResource{}.basic(data))
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2024-03-01/standbyvirtualmachinepools"
+)
+
+type Client struct {
+	StandbyVirtualMachinePoolsClient *standbyvirtualmachinepools.StandbyVirtualMachinePoolsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	standbyVirtualMachinePoolsClient := standbyvirtualmachinepools.NewStandbyVirtualMachinePoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&standbyVirtualMachinePoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		StandbyVirtualMachinePoolsClient: &standbyVirtualMachinePoolsClient,
+	}
+}
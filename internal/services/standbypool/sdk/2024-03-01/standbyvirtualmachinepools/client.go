@@ -0,0 +1,15 @@
+package standbyvirtualmachinepools
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StandbyVirtualMachinePoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStandbyVirtualMachinePoolsClientWithBaseURI(endpoint string) StandbyVirtualMachinePoolsClient {
+	return StandbyVirtualMachinePoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package standbyvirtualmachinepools
+
+type StandbyVirtualMachinePoolElasticityProfile struct {
+	MaxReadyCapacity int64  `json:"maxReadyCapacity"`
+	MinReadyCapacity *int64 `json:"minReadyCapacity,omitempty"`
+}
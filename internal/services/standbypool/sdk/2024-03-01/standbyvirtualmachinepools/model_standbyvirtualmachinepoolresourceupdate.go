@@ -0,0 +1,6 @@
+package standbyvirtualmachinepools
+
+type StandbyVirtualMachinePoolResourceUpdate struct {
+	Properties *StandbyVirtualMachinePoolResourceUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                                 `json:"tags,omitempty"`
+}
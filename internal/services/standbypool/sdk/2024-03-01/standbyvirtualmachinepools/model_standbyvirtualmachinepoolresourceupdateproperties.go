@@ -0,0 +1,7 @@
+package standbyvirtualmachinepools
+
+type StandbyVirtualMachinePoolResourceUpdateProperties struct {
+	AttachedVirtualMachineScaleSetId *string                                     `json:"attachedVirtualMachineScaleSetId,omitempty"`
+	ElasticityProfile                *StandbyVirtualMachinePoolElasticityProfile `json:"elasticityProfile,omitempty"`
+	VirtualMachineState              *VirtualMachineState                        `json:"virtualMachineState,omitempty"`
+}
@@ -0,0 +1,276 @@
+package standbypool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2024-03-01/standbyvirtualmachinepools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = StandbyVirtualMachinePoolResource{}
+var _ sdk.ResourceWithCustomizeDiff = StandbyVirtualMachinePoolResource{}
+var _ sdk.ResourceWithSubscriptionIdOverride = StandbyVirtualMachinePoolResource{}
+
+type StandbyVirtualMachinePoolResource struct{}
+
+type StandbyVirtualMachinePoolResourceModel struct {
+	Name                             string            `tfschema:"name"`
+	ResourceGroupName                string            `tfschema:"resource_group_name"`
+	Location                         string            `tfschema:"location"`
+	AttachedVirtualMachineScaleSetId string            `tfschema:"attached_virtual_machine_scale_set_id"`
+	MaxReadyCapacity                 int64             `tfschema:"max_ready_capacity"`
+	MinReadyCapacity                 int64             `tfschema:"min_ready_capacity"`
+	VirtualMachineState              string            `tfschema:"virtual_machine_state"`
+	Tags                             map[string]string `tfschema:"tags"`
+}
+
+func (r StandbyVirtualMachinePoolResource) SupportsSubscriptionIdOverride() {}
+
+func (r StandbyVirtualMachinePoolResource) ResourceType() string {
+	return "azurerm_standby_virtual_machine_pool"
+}
+
+func (r StandbyVirtualMachinePoolResource) ModelObject() interface{} {
+	return &StandbyVirtualMachinePoolResourceModel{}
+}
+
+func (r StandbyVirtualMachinePoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return standbyvirtualmachinepools.ValidateStandbyVirtualMachinePoolID
+}
+
+func (r StandbyVirtualMachinePoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StandbyPoolName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"attached_virtual_machine_scale_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: computeValidate.VirtualMachineScaleSetID,
+		},
+
+		"max_ready_capacity": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 2000),
+		},
+
+		"min_ready_capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 2000),
+		},
+
+		"virtual_machine_state": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(standbyvirtualmachinepools.PossibleValuesForVirtualMachineState(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StandbyVirtualMachinePoolResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			maxReadyCapacity := rd.Get("max_ready_capacity").(int)
+			minReadyCapacity := rd.Get("min_ready_capacity").(int)
+			if minReadyCapacity > maxReadyCapacity {
+				return fmt.Errorf("`min_ready_capacity` (%d) must be less than or equal to `max_ready_capacity` (%d)", minReadyCapacity, maxReadyCapacity)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config StandbyVirtualMachinePoolResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := standbyvirtualmachinepools.NewStandbyVirtualMachinePoolID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := standbyvirtualmachinepools.StandbyVirtualMachinePoolResource{
+				Location: location.Normalize(config.Location),
+				Properties: &standbyvirtualmachinepools.StandbyVirtualMachinePoolResourceProperties{
+					AttachedVirtualMachineScaleSetId: &config.AttachedVirtualMachineScaleSetId,
+					ElasticityProfile: standbyvirtualmachinepools.StandbyVirtualMachinePoolElasticityProfile{
+						MaxReadyCapacity: config.MaxReadyCapacity,
+						MinReadyCapacity: &config.MinReadyCapacity,
+					},
+					VirtualMachineState: standbyvirtualmachinepools.VirtualMachineState(config.VirtualMachineState),
+				},
+				Tags: &config.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+
+			id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StandbyVirtualMachinePoolResourceModel{
+				Name:              id.StandbyVirtualMachinePoolName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.AttachedVirtualMachineScaleSetId != nil {
+						scaleSetId, err := computeParse.VirtualMachineScaleSetID(*props.AttachedVirtualMachineScaleSetId)
+						if err != nil {
+							return err
+						}
+						state.AttachedVirtualMachineScaleSetId = scaleSetId.ID()
+					}
+
+					state.MaxReadyCapacity = props.ElasticityProfile.MaxReadyCapacity
+					if props.ElasticityProfile.MinReadyCapacity != nil {
+						state.MinReadyCapacity = *props.ElasticityProfile.MinReadyCapacity
+					}
+					state.VirtualMachineState = string(props.VirtualMachineState)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+
+			id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StandbyVirtualMachinePoolResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := standbyvirtualmachinepools.StandbyVirtualMachinePoolResourceUpdate{
+				Properties: &standbyvirtualmachinepools.StandbyVirtualMachinePoolResourceUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("attached_virtual_machine_scale_set_id") {
+				payload.Properties.AttachedVirtualMachineScaleSetId = &config.AttachedVirtualMachineScaleSetId
+			}
+
+			if metadata.ResourceData.HasChanges("max_ready_capacity", "min_ready_capacity") {
+				payload.Properties.ElasticityProfile = &standbyvirtualmachinepools.StandbyVirtualMachinePoolElasticityProfile{
+					MaxReadyCapacity: config.MaxReadyCapacity,
+					MinReadyCapacity: &config.MinReadyCapacity,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("virtual_machine_state") {
+				virtualMachineState := standbyvirtualmachinepools.VirtualMachineState(config.VirtualMachineState)
+				payload.Properties.VirtualMachineState = &virtualMachineState
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StandbyVirtualMachinePoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StandbyPool.StandbyVirtualMachinePoolsClient
+
+			id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStandbyPoolName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "ab",
+			Expected: false,
+		},
+		{
+			Name:     "abc",
+			Expected: true,
+		},
+		{
+			Name:     "Standby-Pool-1",
+			Expected: true,
+		},
+		{
+			Name:     "standby_pool",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 24),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 25),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := StandbyPoolName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package localusers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LocalUsersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLocalUsersClientWithBaseURI(endpoint string) LocalUsersClient {
+	return LocalUsersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
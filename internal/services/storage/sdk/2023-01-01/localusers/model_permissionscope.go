@@ -0,0 +1,7 @@
+package localusers
+
+type PermissionScope struct {
+	Permissions  string `json:"permissions"`
+	ResourceName string `json:"resourceName"`
+	Service      string `json:"service"`
+}
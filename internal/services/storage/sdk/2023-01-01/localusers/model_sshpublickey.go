@@ -0,0 +1,6 @@
+package localusers
+
+type SshPublicKey struct {
+	Description *string `json:"description,omitempty"`
+	Key         *string `json:"key,omitempty"`
+}
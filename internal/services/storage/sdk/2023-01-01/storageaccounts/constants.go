@@ -0,0 +1,34 @@
+package storageaccounts
+
+import "strings"
+
+type AccountImmutabilityPolicyState string
+
+const (
+	AccountImmutabilityPolicyStateDisabled AccountImmutabilityPolicyState = "Disabled"
+	AccountImmutabilityPolicyStateLocked   AccountImmutabilityPolicyState = "Locked"
+	AccountImmutabilityPolicyStateUnlocked AccountImmutabilityPolicyState = "Unlocked"
+)
+
+func PossibleValuesForAccountImmutabilityPolicyState() []string {
+	return []string{
+		string(AccountImmutabilityPolicyStateDisabled),
+		string(AccountImmutabilityPolicyStateLocked),
+		string(AccountImmutabilityPolicyStateUnlocked),
+	}
+}
+
+func parseAccountImmutabilityPolicyState(input string) (*AccountImmutabilityPolicyState, error) {
+	vals := map[string]AccountImmutabilityPolicyState{
+		"disabled": AccountImmutabilityPolicyStateDisabled,
+		"locked":   AccountImmutabilityPolicyStateLocked,
+		"unlocked": AccountImmutabilityPolicyStateUnlocked,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AccountImmutabilityPolicyState(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package storageaccounts
+
+type StorageAccount struct {
+	Id         *string                   `json:"id,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *StorageAccountProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
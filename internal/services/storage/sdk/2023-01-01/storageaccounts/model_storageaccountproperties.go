@@ -0,0 +1,8 @@
+package storageaccounts
+
+type StorageAccountProperties struct {
+	ImmutableStorageWithVersioning *ImmutableStorageAccount `json:"immutableStorageWithVersioning,omitempty"`
+	IsHnsEnabled                   *bool                    `json:"isHnsEnabled,omitempty"`
+	IsLocalUserEnabled             *bool                    `json:"isLocalUserEnabled,omitempty"`
+	IsSftpEnabled                  *bool                    `json:"isSftpEnabled,omitempty"`
+}
@@ -12,12 +12,14 @@ type StorageShareWrapper interface {
 	Exists(ctx context.Context, resourceGroup, accountName, shareName string) (*bool, error)
 	Get(ctx context.Context, resourceGroup, accountName, shareName string) (*StorageShareProperties, error)
 	UpdateACLs(ctx context.Context, resourceGroup, accountName, shareName string, acls []shares.SignedIdentifier) error
+	UpdateAccessTier(ctx context.Context, resourceGroup, accountName, shareName string, accessTier string) error
 	UpdateMetaData(ctx context.Context, resourceGroup, accountName, shareName string, metaData map[string]string) error
 	UpdateQuota(ctx context.Context, resourceGroup, accountName, shareName string, quotaGB int) error
 }
 
 type StorageShareProperties struct {
 	ACLs            []shares.SignedIdentifier
+	AccessTier      string
 	MetaData        map[string]string
 	QuotaGB         int
 	EnabledProtocol shares.ShareProtocol
@@ -99,6 +99,10 @@ func (w DataPlaneStorageShareWrapper) UpdateACLs(ctx context.Context, _, account
 	return err
 }
 
+func (w DataPlaneStorageShareWrapper) UpdateAccessTier(_ context.Context, _, _, _ string, _ string) error {
+	return fmt.Errorf("the Access Tier of a Storage Share can only be updated using the Resource Manager API")
+}
+
 func (w DataPlaneStorageShareWrapper) UpdateMetaData(ctx context.Context, _, accountName, shareName string, metaData map[string]string) error {
 	_, err := w.client.SetMetaData(ctx, accountName, shareName, metaData)
 	return err
@@ -0,0 +1,212 @@
+package shim
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/shares"
+)
+
+// the format used by the Data Plane API for the start and expiry times of an Access Policy, which the Resource Manager
+// API normalises - so this is used to keep these values consistent between both implementations
+const storageShareAccessPolicyTimeFormat = "2006-01-02T15:04:05.0000000Z"
+
+type ResourceManagerStorageShareWrapper struct {
+	client *storage.FileSharesClient
+}
+
+func NewManagementPlaneStorageShareWrapper(client *storage.FileSharesClient) StorageShareWrapper {
+	return ResourceManagerStorageShareWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageShareWrapper) Create(ctx context.Context, resourceGroup, accountName, shareName string, input shares.CreateInput) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			EnabledProtocols: storage.EnabledProtocols(input.EnabledProtocol),
+			Metadata:         expandStorageShareMetaData(input.MetaData),
+			ShareQuota:       utils.Int32(int32(input.QuotaInGB)),
+		},
+	}
+
+	_, err := w.client.Create(ctx, resourceGroup, accountName, shareName, share, "")
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) Delete(ctx context.Context, resourceGroup, accountName, shareName string) error {
+	// snapshots are deleted along with the share, to match the Data Plane implementation
+	_, err := w.client.Delete(ctx, resourceGroup, accountName, shareName, "", "snapshots")
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) Exists(ctx context.Context, resourceGroup, accountName, shareName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (w ResourceManagerStorageShareWrapper) Get(ctx context.Context, resourceGroup, accountName, shareName string) (*StorageShareProperties, error) {
+	share, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(share.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	output := StorageShareProperties{
+		ACLs:     make([]shares.SignedIdentifier, 0),
+		MetaData: make(map[string]string),
+	}
+
+	if props := share.FileShareProperties; props != nil {
+		output.AccessTier = string(props.AccessTier)
+		output.EnabledProtocol = shares.ShareProtocol(props.EnabledProtocols)
+		output.ACLs = flattenStorageShareSignedIdentifiers(props.SignedIdentifiers)
+
+		if props.ShareQuota != nil {
+			output.QuotaGB = int(*props.ShareQuota)
+		}
+
+		for k, v := range props.Metadata {
+			if v != nil {
+				output.MetaData[k] = *v
+			}
+		}
+	}
+
+	return &output, nil
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateACLs(ctx context.Context, resourceGroup, accountName, shareName string, acls []shares.SignedIdentifier) error {
+	identifiers, err := expandStorageShareSignedIdentifiers(acls)
+	if err != nil {
+		return err
+	}
+
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			SignedIdentifiers: identifiers,
+		},
+	}
+
+	_, err = w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateAccessTier(ctx context.Context, resourceGroup, accountName, shareName string, accessTier string) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			AccessTier: storage.ShareAccessTier(accessTier),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, shareName string, metaData map[string]string) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			Metadata: expandStorageShareMetaData(metaData),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateQuota(ctx context.Context, resourceGroup, accountName, shareName string, quotaGB int) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			ShareQuota: utils.Int32(int32(quotaGB)),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func expandStorageShareMetaData(input map[string]string) map[string]*string {
+	// an empty map (rather than nil) is sent so that any existing MetaData is removed
+	output := make(map[string]*string)
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+	return output
+}
+
+func expandStorageShareSignedIdentifiers(input []shares.SignedIdentifier) (*[]storage.SignedIdentifier, error) {
+	output := make([]storage.SignedIdentifier, 0)
+
+	for _, v := range input {
+		policy := storage.AccessPolicy{
+			Permission: utils.String(v.AccessPolicy.Permission),
+		}
+
+		if v.AccessPolicy.Start != "" {
+			start, err := time.Parse(time.RFC3339, v.AccessPolicy.Start)
+			if err != nil {
+				return nil, err
+			}
+			policy.Start = &date.Time{Time: start}
+		}
+
+		if v.AccessPolicy.Expiry != "" {
+			expiry, err := time.Parse(time.RFC3339, v.AccessPolicy.Expiry)
+			if err != nil {
+				return nil, err
+			}
+			policy.Expiry = &date.Time{Time: expiry}
+		}
+
+		output = append(output, storage.SignedIdentifier{
+			ID:           utils.String(v.Id),
+			AccessPolicy: &policy,
+		})
+	}
+
+	return &output, nil
+}
+
+func flattenStorageShareSignedIdentifiers(input *[]storage.SignedIdentifier) []shares.SignedIdentifier {
+	output := make([]shares.SignedIdentifier, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		identifier := shares.SignedIdentifier{}
+		if v.ID != nil {
+			identifier.Id = *v.ID
+		}
+
+		if policy := v.AccessPolicy; policy != nil {
+			if policy.Start != nil {
+				identifier.AccessPolicy.Start = policy.Start.UTC().Format(storageShareAccessPolicyTimeFormat)
+			}
+			if policy.Expiry != nil {
+				identifier.AccessPolicy.Expiry = policy.Expiry.UTC().Format(storageShareAccessPolicyTimeFormat)
+			}
+			if policy.Permission != nil {
+				identifier.AccessPolicy.Permission = *policy.Permission
+			}
+		}
+
+		output = append(output, identifier)
+	}
+
+	return output
+}
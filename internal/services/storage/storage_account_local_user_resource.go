@@ -0,0 +1,470 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/localusers"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageAccountLocalUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountLocalUserCreate,
+		Read:   resourceStorageAccountLocalUserRead,
+		Update: resourceStorageAccountLocalUserUpdate,
+		Delete: resourceStorageAccountLocalUserDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := localusers.ParseLocalUserID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountLocalUserName,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"home_directory": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ssh_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_password_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_authorized_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"permission_scope": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blob",
+								"file",
+							}, false),
+						},
+
+						"resource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"permissions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"read": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"write": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"delete": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"list": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"create": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"password": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountLocalUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := localusers.NewLocalUserID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_account_local_user", id.ID())
+	}
+
+	sshPasswordEnabled := d.Get("ssh_password_enabled").(bool)
+	payload := localusers.LocalUser{
+		Properties: &localusers.LocalUserProperties{
+			HasSshKey:         utils.Bool(d.Get("ssh_key_enabled").(bool)),
+			HasSshPassword:    utils.Bool(sshPasswordEnabled),
+			PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{})),
+			SshAuthorizedKeys: expandStorageAccountLocalUserSshAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{})),
+		},
+	}
+
+	if v := d.Get("home_directory").(string); v != "" {
+		payload.Properties.HomeDirectory = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the password is only available from the regeneration endpoint, and can't be retrieved afterwards
+	if sshPasswordEnabled {
+		resp, err := client.RegeneratePassword(ctx, id)
+		if err != nil {
+			return fmt.Errorf("generating the password for %s: %+v", id, err)
+		}
+		if resp.Model == nil || resp.Model.SshPassword == nil {
+			return fmt.Errorf("generating the password for %s: `sshPassword` was nil", id)
+		}
+		d.Set("password", resp.Model.SshPassword)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.ParseLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	payload := localusers.LocalUser{
+		Properties: existing.Model.Properties,
+	}
+	// the Security Identifier is assigned by the service and must not be sent
+	payload.Properties.Sid = nil
+
+	if d.HasChange("home_directory") {
+		payload.Properties.HomeDirectory = utils.String(d.Get("home_directory").(string))
+	}
+
+	if d.HasChange("ssh_key_enabled") {
+		payload.Properties.HasSshKey = utils.Bool(d.Get("ssh_key_enabled").(bool))
+	}
+
+	if d.HasChange("ssh_password_enabled") {
+		payload.Properties.HasSshPassword = utils.Bool(d.Get("ssh_password_enabled").(bool))
+	}
+
+	// the authorized keys aren't returned from the API so these always need to be sent
+	payload.Properties.SshAuthorizedKeys = expandStorageAccountLocalUserSshAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{}))
+
+	if d.HasChange("permission_scope") {
+		payload.Properties.PermissionScopes = expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{}))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if d.HasChange("ssh_password_enabled") {
+		password := ""
+		if d.Get("ssh_password_enabled").(bool) {
+			resp, err := client.RegeneratePassword(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("generating the password for %s: %+v", *id, err)
+			}
+			if resp.Model == nil || resp.Model.SshPassword == nil {
+				return fmt.Errorf("generating the password for %s: `sshPassword` was nil", *id)
+			}
+			password = *resp.Model.SshPassword
+		}
+		d.Set("password", password)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.ParseLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.LocalUserName)
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("home_directory", props.HomeDirectory)
+			d.Set("sid", props.Sid)
+
+			sshKeyEnabled := false
+			if props.HasSshKey != nil {
+				sshKeyEnabled = *props.HasSshKey
+			}
+			d.Set("ssh_key_enabled", sshKeyEnabled)
+
+			sshPasswordEnabled := false
+			if props.HasSshPassword != nil {
+				sshPasswordEnabled = *props.HasSshPassword
+			}
+			d.Set("ssh_password_enabled", sshPasswordEnabled)
+
+			if err := d.Set("permission_scope", flattenStorageAccountLocalUserPermissionScopes(props.PermissionScopes)); err != nil {
+				return fmt.Errorf("setting `permission_scope`: %+v", err)
+			}
+
+			// the API doesn't return the authorized keys, so these are kept as configured
+			if props.SshAuthorizedKeys != nil {
+				if err := d.Set("ssh_authorized_key", flattenStorageAccountLocalUserSshAuthorizedKeys(props.SshAuthorizedKeys)); err != nil {
+					return fmt.Errorf("setting `ssh_authorized_key`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountLocalUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.ParseLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStorageAccountLocalUserSshAuthorizedKeys(input []interface{}) *[]localusers.SshPublicKey {
+	results := make([]localusers.SshPublicKey, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		key := localusers.SshPublicKey{
+			Key: utils.String(v["key"].(string)),
+		}
+		if description := v["description"].(string); description != "" {
+			key.Description = utils.String(description)
+		}
+
+		results = append(results, key)
+	}
+
+	return &results
+}
+
+func flattenStorageAccountLocalUserSshAuthorizedKeys(input *[]localusers.SshPublicKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"key":         utils.NormalizeNilableString(item.Key),
+			"description": utils.NormalizeNilableString(item.Description),
+		})
+	}
+
+	return results
+}
+
+func expandStorageAccountLocalUserPermissionScopes(input []interface{}) *[]localusers.PermissionScope {
+	results := make([]localusers.PermissionScope, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, localusers.PermissionScope{
+			Service:      v["service"].(string),
+			ResourceName: v["resource_name"].(string),
+			Permissions:  expandStorageAccountLocalUserPermissions(v["permissions"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func flattenStorageAccountLocalUserPermissionScopes(input *[]localusers.PermissionScope) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"service":       item.Service,
+			"resource_name": item.ResourceName,
+			"permissions":   flattenStorageAccountLocalUserPermissions(item.Permissions),
+		})
+	}
+
+	return results
+}
+
+// the permissions are sent to the API as a string containing the character for each permission, e.g. `rwdlc`
+func expandStorageAccountLocalUserPermissions(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+
+	v := input[0].(map[string]interface{})
+
+	permissions := ""
+	if v["read"].(bool) {
+		permissions += "r"
+	}
+	if v["write"].(bool) {
+		permissions += "w"
+	}
+	if v["delete"].(bool) {
+		permissions += "d"
+	}
+	if v["list"].(bool) {
+		permissions += "l"
+	}
+	if v["create"].(bool) {
+		permissions += "c"
+	}
+
+	return permissions
+}
+
+func flattenStorageAccountLocalUserPermissions(input string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"read":   strings.Contains(input, "r"),
+			"write":  strings.Contains(input, "w"),
+			"delete": strings.Contains(input, "d"),
+			"list":   strings.Contains(input, "l"),
+			"create": strings.Contains(input, "c"),
+		},
+	}
+}
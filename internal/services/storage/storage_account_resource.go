@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storageaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -37,6 +39,11 @@ import (
 )
 
 var storageAccountResourceName = "azurerm_storage_account"
+
+// storageAccountImmutabilityApiVersion is the API version used when creating a Storage Account with account-level
+// version immutability enabled, matching the embedded `storageaccounts` SDK
+const storageAccountImmutabilityApiVersion = "2023-01-01"
+
 var allowPublicNestedItemsName = getDefaultAllowBlobPublicAccessName()
 
 func resourceStorageAccount() *pluginsdk.Resource {
@@ -259,6 +266,42 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_protected_append_writes": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"period_since_creation_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storageaccounts.AccountImmutabilityPolicyStateDisabled),
+								string(storageaccounts.AccountImmutabilityPolicyStateLocked),
+								string(storageaccounts.AccountImmutabilityPolicyStateUnlocked),
+							}, false),
+						},
+					},
+				},
+			},
+
 			// TODO: document this new field in 3.0
 			allowPublicNestedItemsName: {
 				Type:     pluginsdk.TypeBool,
@@ -914,6 +957,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 				return nil
 			}),
+			// version-level immutability can only be enabled when the Storage Account is created
+			pluginsdk.ForceNewIfChange("immutability_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
 				newAccRep := strings.ToUpper(new.(string))
 
@@ -1051,6 +1098,20 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
 	}
 
+	sftpEnabled := d.Get("sftp_enabled").(bool)
+	if sftpEnabled && !isHnsEnabled {
+		return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+	}
+
+	if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+		if _, ok := d.GetOk("queue_properties"); ok {
+			return fmt.Errorf("`queue_properties` cannot be configured when the `data_plane_available` feature is disabled")
+		}
+		if _, ok := d.GetOk("static_website"); ok {
+			return fmt.Errorf("`static_website` cannot be configured when the `data_plane_available` feature is disabled")
+		}
+	}
+
 	// AccountTier must be Premium for FileStorage
 	if accountKind == string(storage.KindFileStorage) {
 		if string(parameters.Sku.Tier) == string(storage.SkuNameStandardLRS) {
@@ -1097,7 +1158,19 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	future, err := client.Create(ctx, id.ResourceGroup, id.Name, parameters)
+	req, err := client.CreatePreparer(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("preparing request to create Azure Storage Account %q: %+v", id.Name, err)
+	}
+
+	if v, ok := d.GetOk("immutability_policy"); ok {
+		immutability := expandStorageAccountImmutabilityPolicy(v.([]interface{}))
+		if req, err = azautorest.Prepare(req, withStorageAccountImmutableStorageWithVersioning(immutability)); err != nil {
+			return fmt.Errorf("preparing `immutability_policy` to create Azure Storage Account %q: %+v", id.Name, err)
+		}
+	}
+
+	future, err := client.CreateSender(req)
 	if err != nil {
 		return fmt.Errorf("creating Azure Storage Account %q: %+v", id.Name, err)
 	}
@@ -1117,6 +1190,12 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("populating cache for %s: %+v", id, err)
 	}
 
+	if sftpEnabled {
+		if err := updateStorageAccountSftpEnabled(ctx, storageClient.StorageAccountsClient, id, true); err != nil {
+			return err
+		}
+	}
+
 	if val, ok := d.GetOk("blob_properties"); ok {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.KindFileStorage) {
@@ -1312,7 +1391,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
@@ -1440,6 +1519,36 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("immutability_policy") {
+		oldRaw, newRaw := d.GetChange("immutability_policy")
+		if oldPolicy := expandStorageAccountImmutabilityPolicy(oldRaw.([]interface{})); oldPolicy.ImmutabilityPolicy != nil && oldPolicy.ImmutabilityPolicy.State != nil && *oldPolicy.ImmutabilityPolicy.State == storageaccounts.AccountImmutabilityPolicyStateLocked {
+			newPolicy := expandStorageAccountImmutabilityPolicy(newRaw.([]interface{}))
+			if newPolicy.ImmutabilityPolicy == nil || newPolicy.ImmutabilityPolicy.State == nil || *newPolicy.ImmutabilityPolicy.State != storageaccounts.AccountImmutabilityPolicyStateLocked {
+				return fmt.Errorf("the `immutability_policy` for %s is `Locked` and can no longer be unlocked or disabled", *id)
+			}
+		}
+
+		payload := storageaccounts.StorageAccountUpdateParameters{
+			Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+				ImmutableStorageWithVersioning: expandStorageAccountImmutabilityPolicy(newRaw.([]interface{})),
+			},
+		}
+		if _, err := meta.(*clients.Client).Storage.StorageAccountsClient.Update(ctx, storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name), payload); err != nil {
+			return fmt.Errorf("updating `immutability_policy` for %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("sftp_enabled") {
+		sftpEnabled := d.Get("sftp_enabled").(bool)
+		if sftpEnabled && !d.Get("is_hns_enabled").(bool) {
+			return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+		}
+
+		if err := updateStorageAccountSftpEnabled(ctx, meta.(*clients.Client).Storage.StorageAccountsClient, *id, sftpEnabled); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("routing") {
 		opts := storage.AccountUpdateParameters{
 			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
@@ -1515,7 +1624,13 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	dataPlaneAvailable := meta.(*clients.Client).Features.Storage.DataPlaneAvailable
+
 	if d.HasChange("queue_properties") {
+		if !dataPlaneAvailable {
+			return fmt.Errorf("`queue_properties` cannot be updated when the `data_plane_available` feature is disabled")
+		}
+
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, id.Name)
 		if err != nil {
@@ -1555,6 +1670,10 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("static_website") {
+		if !dataPlaneAvailable {
+			return fmt.Errorf("`static_website` cannot be updated when the `data_plane_available` feature is disabled")
+		}
+
 		// static website only supported on StorageV2 and BlockBlobStorage
 		if accountKind != string(storage.KindStorageV2) && accountKind != string(storage.KindBlockBlobStorage) {
 			return fmt.Errorf("`static_website` is only supported for StorageV2 and BlockBlobStorage.")
@@ -1653,6 +1772,26 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 		d.Set("nfsv3_enabled", props.EnableNfsV3)
 
+		// `isSftpEnabled` and `immutableStorageWithVersioning` aren't returned in the API version used for the Storage Account,
+		// so are retrieved separately
+		sftpResp, err := meta.(*clients.Client).Storage.StorageAccountsClient.GetProperties(ctx, storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name))
+		if err != nil {
+			return fmt.Errorf("retrieving SFTP properties for %s: %+v", *id, err)
+		}
+		sftpEnabled := false
+		if model := sftpResp.Model; model != nil && model.Properties != nil && model.Properties.IsSftpEnabled != nil {
+			sftpEnabled = *model.Properties.IsSftpEnabled
+		}
+		d.Set("sftp_enabled", sftpEnabled)
+
+		var immutability *storageaccounts.ImmutableStorageAccount
+		if model := sftpResp.Model; model != nil && model.Properties != nil {
+			immutability = model.Properties.ImmutableStorageWithVersioning
+		}
+		if err := d.Set("immutability_policy", flattenStorageAccountImmutabilityPolicy(immutability)); err != nil {
+			return fmt.Errorf("setting `immutability_policy`: %+v", err)
+		}
+
 		if features.ThreePointOh() {
 			// There is a certain edge case that could result in the Azure API returning a null value for AllowBLobPublicAccess.
 			// Since the field is a pointer, this gets marshalled to a nil value instead of a boolean. Here we set this
@@ -1827,6 +1966,12 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving %s: `sku` was nil", *id)
 	}
 
+	// the Queue and Static Website properties are only available from the Data Plane, so when it's unreachable
+	// these are left as-is in the state rather than being refreshed
+	if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+		return tags.FlattenAndSet(d, resp.Tags)
+	}
+
 	if resp.Sku.Tier == storage.SkuTierStandard {
 		if resp.Kind == storage.KindStorage || resp.Kind == storage.KindStorageV2 {
 			queueClient, err := storageClient.QueuesClient(ctx, *account)
@@ -3036,3 +3181,97 @@ func getDefaultAllowBlobPublicAccessName() string {
 	}
 	return "allow_blob_public_access"
 }
+
+func updateStorageAccountSftpEnabled(ctx context.Context, client *storageaccounts.StorageAccountsClient, id parse.StorageAccountId, enabled bool) error {
+	accountId := storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	payload := storageaccounts.StorageAccountUpdateParameters{
+		Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+			IsSftpEnabled: utils.Bool(enabled),
+		},
+	}
+
+	if _, err := client.Update(ctx, accountId, payload); err != nil {
+		return fmt.Errorf("updating `sftp_enabled` for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandStorageAccountImmutabilityPolicy(input []interface{}) *storageaccounts.ImmutableStorageAccount {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	state := storageaccounts.AccountImmutabilityPolicyState(v["state"].(string))
+	return &storageaccounts.ImmutableStorageAccount{
+		Enabled: utils.Bool(true),
+		ImmutabilityPolicy: &storageaccounts.AccountImmutabilityPolicyProperties{
+			AllowProtectedAppendWrites:            utils.Bool(v["allow_protected_append_writes"].(bool)),
+			ImmutabilityPeriodSinceCreationInDays: utils.Int64(int64(v["period_since_creation_in_days"].(int))),
+			State:                                 &state,
+		},
+	}
+}
+
+func flattenStorageAccountImmutabilityPolicy(input *storageaccounts.ImmutableStorageAccount) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled || input.ImmutabilityPolicy == nil {
+		return []interface{}{}
+	}
+
+	policy := input.ImmutabilityPolicy
+	allowProtectedAppendWrites := false
+	if policy.AllowProtectedAppendWrites != nil {
+		allowProtectedAppendWrites = *policy.AllowProtectedAppendWrites
+	}
+	periodSinceCreationInDays := 0
+	if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+		periodSinceCreationInDays = int(*policy.ImmutabilityPeriodSinceCreationInDays)
+	}
+	state := ""
+	if policy.State != nil {
+		state = string(*policy.State)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allow_protected_append_writes": allowProtectedAppendWrites,
+			"period_since_creation_in_days": periodSinceCreationInDays,
+			"state":                         state,
+		},
+	}
+}
+
+// withStorageAccountImmutableStorageWithVersioning injects the account-level version immutability settings into the
+// request used to create the Storage Account - since these can only be set at creation time and aren't available in
+// the API version used for the rest of the Storage Account, this also bumps the API version of the request.
+func withStorageAccountImmutableStorageWithVersioning(input *storageaccounts.ImmutableStorageAccount) azautorest.PrepareDecorator {
+	return func(p azautorest.Preparer) azautorest.Preparer {
+		return azautorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			payload := make(map[string]interface{})
+			if r.Body != nil {
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					return r, fmt.Errorf("decoding request body: %+v", err)
+				}
+			}
+
+			properties, ok := payload["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+			properties["immutableStorageWithVersioning"] = input
+			payload["properties"] = properties
+
+			query := r.URL.Query()
+			query.Set("api-version", storageAccountImmutabilityApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return azautorest.Prepare(r, azautorest.WithJSON(payload))
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Immutability Policy for a Storage Container is a singleton, which is always named `default`
+const storageContainerImmutabilityPolicyName = "default"
+
+func resourceStorageContainerImmutabilityPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerImmutabilityPolicyCreate,
+		Read:   resourceStorageContainerImmutabilityPolicyRead,
+		Update: resourceStorageContainerImmutabilityPolicyUpdate,
+		Delete: resourceStorageContainerImmutabilityPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerImmutabilityPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageContainerResourceManagerID,
+			},
+
+			"immutability_period_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 146000),
+			},
+
+			"protected_append_writes_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"locked": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceStorageContainerImmutabilityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	containerId, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageContainerImmutabilityPolicyID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName, containerId.BlobServiceName, containerId.ContainerName, storageContainerImmutabilityPolicyName)
+
+	locks.ByID(containerId.ID())
+	defer locks.UnlockByID(containerId.ID())
+
+	container, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Container %q (Storage Account %q / Resource Group %q): %+v", id.ContainerName, id.StorageAccountName, id.ResourceGroup, err)
+	}
+	if props := container.ContainerProperties; props != nil && props.HasImmutabilityPolicy != nil && *props.HasImmutabilityPolicy {
+		return tf.ImportAsExistsError("azurerm_storage_container_immutability_policy", id.ID())
+	}
+
+	policy := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+			AllowProtectedAppendWrites:            utils.Bool(d.Get("protected_append_writes_enabled").(bool)),
+		},
+	}
+
+	resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, "")
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("locked").(bool) {
+		if resp.Etag == nil {
+			return fmt.Errorf("locking %s: `etag` was nil", id)
+		}
+
+		if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+			return fmt.Errorf("locking %s: %+v", id, err)
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerId := parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
+	locks.ByID(containerId.ID())
+	defer locks.UnlockByID(containerId.ID())
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Etag == nil {
+		return fmt.Errorf("retrieving %s: `etag` was nil", *id)
+	}
+
+	policy := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+		},
+	}
+
+	if storageContainerImmutabilityPolicyIsLocked(existing) {
+		// once locked, a policy can't be unlocked and the only permitted change is to extend the retention period
+		if !d.Get("locked").(bool) {
+			return fmt.Errorf("%s is locked and cannot be unlocked", *id)
+		}
+		if d.HasChange("protected_append_writes_enabled") {
+			return fmt.Errorf("`protected_append_writes_enabled` cannot be changed since %s is locked", *id)
+		}
+
+		if d.HasChange("immutability_period_in_days") {
+			if _, err := client.ExtendImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *existing.Etag, &policy); err != nil {
+				return fmt.Errorf("extending %s: %+v", *id, err)
+			}
+		}
+
+		return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+	}
+
+	policy.ImmutabilityPolicyProperty.AllowProtectedAppendWrites = utils.Bool(d.Get("protected_append_writes_enabled").(bool))
+	resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, *existing.Etag)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if d.Get("locked").(bool) {
+		if resp.Etag == nil {
+			return fmt.Errorf("locking %s: `etag` was nil", *id)
+		}
+
+		if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+			return fmt.Errorf("locking %s: %+v", *id, err)
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("storage_container_resource_manager_id", parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName).ID())
+	d.Set("locked", storageContainerImmutabilityPolicyIsLocked(resp))
+
+	if props := resp.ImmutabilityPolicyProperty; props != nil {
+		immutabilityPeriodInDays := 0
+		if props.ImmutabilityPeriodSinceCreationInDays != nil {
+			immutabilityPeriodInDays = int(*props.ImmutabilityPeriodSinceCreationInDays)
+		}
+		d.Set("immutability_period_in_days", immutabilityPeriodInDays)
+
+		protectedAppendWritesEnabled := false
+		if props.AllowProtectedAppendWrites != nil {
+			protectedAppendWritesEnabled = *props.AllowProtectedAppendWrites
+		}
+		d.Set("protected_append_writes_enabled", protectedAppendWritesEnabled)
+	}
+
+	return nil
+}
+
+func resourceStorageContainerImmutabilityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerId := parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
+	locks.ByID(containerId.ID())
+	defer locks.UnlockByID(containerId.ID())
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// a locked policy can't be deleted and instead remains in place until the Storage Container is removed, which is
+	// only possible once the retention period has elapsed for all of the blobs within it
+	if storageContainerImmutabilityPolicyIsLocked(existing) {
+		log.Printf("[WARN] %s is locked and cannot be deleted - removing from state only", *id)
+		return nil
+	}
+
+	if existing.Etag == nil {
+		return fmt.Errorf("retrieving %s: `etag` was nil", *id)
+	}
+
+	if _, err := client.DeleteImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *existing.Etag); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func storageContainerImmutabilityPolicyIsLocked(input storage.ImmutabilityPolicy) bool {
+	if props := input.ImmutabilityPolicyProperty; props != nil {
+		return strings.EqualFold(string(props.State), string(storage.ImmutabilityPolicyStateLocked))
+	}
+
+	return false
+}
@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageContainerLegalHold() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerLegalHoldCreate,
+		Read:   resourceStorageContainerLegalHoldRead,
+		Update: resourceStorageContainerLegalHoldUpdate,
+		Delete: resourceStorageContainerLegalHoldDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerResourceManagerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageContainerResourceManagerID,
+			},
+
+			"legal_hold_tags": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: storageValidate.StorageContainerLegalHoldTag,
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageContainerLegalHoldCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if props := existing.ContainerProperties; props != nil && props.HasLegalHold != nil && *props.HasLegalHold {
+		return tf.ImportAsExistsError("azurerm_storage_container_legal_hold", id.ID())
+	}
+
+	legalHold := storage.LegalHold{
+		Tags: utils.ExpandStringSlice(d.Get("legal_hold_tags").(*pluginsdk.Set).List()),
+	}
+	if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	if d.HasChange("legal_hold_tags") {
+		oldRaw, newRaw := d.GetChange("legal_hold_tags")
+		oldTags := oldRaw.(*pluginsdk.Set)
+		newTags := newRaw.(*pluginsdk.Set)
+
+		// tags are added before any are removed, so that the Storage Container remains on hold throughout
+		if added := newTags.Difference(oldTags).List(); len(added) > 0 {
+			legalHold := storage.LegalHold{
+				Tags: utils.ExpandStringSlice(added),
+			}
+			if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+			}
+		}
+
+		if removed := oldTags.Difference(newTags).List(); len(removed) > 0 {
+			legalHold := storage.LegalHold{
+				Tags: utils.ExpandStringSlice(removed),
+			}
+			if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+			}
+		}
+	}
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing Legal Hold from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	tags := flattenStorageContainerLegalHoldTags(resp.ContainerProperties)
+	if len(tags) == 0 {
+		log.Printf("[INFO] %s has no Legal Hold - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_container_resource_manager_id", id.ID())
+	if err := d.Set("legal_hold_tags", tags); err != nil {
+		return fmt.Errorf("setting `legal_hold_tags`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageContainerLegalHoldDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// all of the tags are cleared, including any added outside of Terraform, since otherwise the hold would remain
+	tags := flattenStorageContainerLegalHoldTags(existing.ContainerProperties)
+	if len(tags) == 0 {
+		return nil
+	}
+
+	legalHold := storage.LegalHold{
+		Tags: &tags,
+	}
+	if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenStorageContainerLegalHoldTags(input *storage.ContainerProperties) []string {
+	tags := make([]string, 0)
+	if input == nil || input.LegalHold == nil || input.LegalHold.Tags == nil {
+		return tags
+	}
+
+	for _, item := range *input.LegalHold.Tags {
+		if item.Tag != nil {
+			tags = append(tags, *item.Tag)
+		}
+	}
+
+	return tags
+}
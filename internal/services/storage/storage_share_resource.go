@@ -1,14 +1,17 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -46,9 +49,33 @@ func resourceStorageShare() *pluginsdk.Resource {
 			},
 
 			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
+			},
+
+			"access_tier": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(storage.ShareAccessTierCool),
+					string(storage.ShareAccessTierHot),
+					string(storage.ShareAccessTierPremium),
+					string(storage.ShareAccessTierTransactionOptimized),
+				}, false),
+				ConflictsWith: []string{"storage_account_name"},
 			},
 
 			"quota": {
@@ -126,7 +153,6 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	defer cancel()
 	storageClient := meta.(*clients.Client).Storage
 
-	accountName := d.Get("storage_account_name").(string)
 	shareName := d.Get("name").(string)
 	quota := d.Get("quota").(int)
 
@@ -136,24 +162,45 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	aclsRaw := d.Get("acl").(*pluginsdk.Set).List()
 	acls := expandStorageShareACLs(aclsRaw)
 
-	account, err := storageClient.FindAccount(ctx, accountName)
-	if err != nil {
-		return fmt.Errorf("retrieving Account %q for Share %q: %s", accountName, shareName, err)
-	}
-	if account == nil {
-		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
-	}
+	var client shim.StorageShareWrapper
+	var id, resourceGroup, accountName string
 
-	client, err := storageClient.FileSharesClient(ctx, *account)
-	if err != nil {
-		return fmt.Errorf("building File Share Client: %s", err)
-	}
+	if v, ok := d.GetOk("storage_account_id"); ok {
+		// the Share is managed entirely using the Resource Manager API, so no access to the Data Plane is required
+		accountId, err := parse.StorageAccountID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		resourceGroup = accountId.ResourceGroup
+		accountName = accountId.Name
+		id = parse.NewStorageShareResourceManagerID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, "default", shareName).ID()
+		client = shim.NewManagementPlaneStorageShareWrapper(storageClient.FileSharesRMClient)
+	} else {
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`storage_account_id` must be specified rather than `storage_account_name` when the `data_plane_available` feature is disabled")
+		}
 
-	id := parse.NewStorageShareDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, shareName).ID()
+		accountName = d.Get("storage_account_name").(string)
+		account, err := storageClient.FindAccount(ctx, accountName)
+		if err != nil {
+			return fmt.Errorf("retrieving Account %q for Share %q: %s", accountName, shareName, err)
+		}
+		if account == nil {
+			return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+		}
+
+		resourceGroup = account.ResourceGroup
+		id = parse.NewStorageShareDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, shareName).ID()
+		client, err = storageClient.FileSharesClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building File Share Client: %s", err)
+		}
+	}
 
-	exists, err := client.Exists(ctx, account.ResourceGroup, accountName, shareName)
+	exists, err := client.Exists(ctx, resourceGroup, accountName, shareName)
 	if err != nil {
-		return fmt.Errorf("checking for existence of existing Storage Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+		return fmt.Errorf("checking for existence of existing Storage Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, resourceGroup, err)
 	}
 	if exists != nil && *exists {
 		return tf.ImportAsExistsError("azurerm_storage_share", id)
@@ -166,13 +213,19 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		EnabledProtocol: shares.ShareProtocol(d.Get("enabled_protocol").(string)),
 	}
 
-	if err := client.Create(ctx, account.ResourceGroup, accountName, shareName, input); err != nil {
-		return fmt.Errorf("creating Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+	if err := client.Create(ctx, resourceGroup, accountName, shareName, input); err != nil {
+		return fmt.Errorf("creating Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, resourceGroup, err)
 	}
 
 	d.SetId(id)
-	if err := client.UpdateACLs(ctx, account.ResourceGroup, accountName, shareName, acls); err != nil {
-		return fmt.Errorf("setting ACL's for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+	if err := client.UpdateACLs(ctx, resourceGroup, accountName, shareName, acls); err != nil {
+		return fmt.Errorf("setting ACL's for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, resourceGroup, err)
+	}
+
+	if v, ok := d.GetOk("access_tier"); ok {
+		if err := client.UpdateAccessTier(ctx, resourceGroup, accountName, shareName, v.(string)); err != nil {
+			return fmt.Errorf("setting Access Tier for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, resourceGroup, err)
+		}
 	}
 
 	return resourceStorageShareRead(d, meta)
@@ -183,42 +236,39 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 	defer cancel()
 	storageClient := meta.(*clients.Client).Storage
 
-	id, err := parse.StorageShareDataPlaneID(d.Id())
+	share, err := storageShareDetailsFromID(ctx, meta, d.Id())
 	if err != nil {
 		return err
 	}
-
-	account, err := storageClient.FindAccount(ctx, id.AccountName)
-	if err != nil {
-		return fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.Name, err)
-	}
-	if account == nil {
-		log.Printf("[WARN] Unable to determine Account %q for Storage Share %q - assuming removed & removing from state", id.AccountName, id.Name)
+	if share == nil {
+		log.Printf("[WARN] Unable to determine the Storage Account for Storage Share %q - assuming removed & removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	client, err := storageClient.FileSharesClient(ctx, *account)
-	if err != nil {
-		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
-	}
-
-	props, err := client.Get(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	props, err := share.client.Get(ctx, share.resourceGroup, share.accountName, share.shareName)
 	if err != nil {
 		return err
 	}
 	if props == nil {
-		log.Printf("[DEBUG] File Share %q was not found in Account %q / Resource Group %q - assuming removed & removing from state", id.Name, id.AccountName, account.ResourceGroup)
+		log.Printf("[DEBUG] File Share %q was not found in Account %q / Resource Group %q - assuming removed & removing from state", share.shareName, share.accountName, share.resourceGroup)
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("name", id.Name)
-	d.Set("storage_account_name", id.AccountName)
+	d.Set("name", share.shareName)
+	d.Set("storage_account_name", share.accountName)
 	d.Set("quota", props.QuotaGB)
-	d.Set("url", id.ID())
+	d.Set("url", parse.NewStorageShareDataPlaneId(share.accountName, storageClient.Environment.StorageEndpointSuffix, share.shareName).ID())
 	d.Set("enabled_protocol", string(props.EnabledProtocol))
 
+	storageAccountId := ""
+	if share.resourceManager {
+		storageAccountId = parse.NewStorageAccountID(storageClient.SubscriptionId, share.resourceGroup, share.accountName).ID()
+		d.Set("access_tier", props.AccessTier)
+	}
+	d.Set("storage_account_id", storageAccountId)
+
 	if err := d.Set("acl", flattenStorageShareACLs(props.ACLs)); err != nil {
 		return fmt.Errorf("flattening `acl`: %+v", err)
 	}
@@ -227,7 +277,7 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("flattening `metadata`: %+v", err)
 	}
 
-	resourceManagerId := parse.NewStorageShareResourceManagerID(storageClient.SubscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
+	resourceManagerId := parse.NewStorageShareResourceManagerID(storageClient.SubscriptionId, share.resourceGroup, share.accountName, "default", share.shareName)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
 	return nil
@@ -236,61 +286,62 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
 
-	id, err := parse.StorageShareDataPlaneID(d.Id())
+	share, err := storageShareDetailsFromID(ctx, meta, d.Id())
 	if err != nil {
 		return err
 	}
-
-	account, err := storageClient.FindAccount(ctx, id.AccountName)
-	if err != nil {
-		return fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.Name, err)
-	}
-	if account == nil {
-		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	if share == nil {
+		return fmt.Errorf("Unable to locate the Storage Account for Storage Share %q!", d.Id())
 	}
 
-	client, err := storageClient.FileSharesClient(ctx, *account)
-	if err != nil {
-		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
-	}
+	client := share.client
 
 	if d.HasChange("quota") {
-		log.Printf("[DEBUG] Updating the Quota for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+		log.Printf("[DEBUG] Updating the Quota for File Share %q (Storage Account %q)", share.shareName, share.accountName)
 		quota := d.Get("quota").(int)
 
-		if err := client.UpdateQuota(ctx, account.ResourceGroup, id.AccountName, id.Name, quota); err != nil {
-			return fmt.Errorf("updating Quota for File Share %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+		if err := client.UpdateQuota(ctx, share.resourceGroup, share.accountName, share.shareName, quota); err != nil {
+			return fmt.Errorf("updating Quota for File Share %q (Storage Account %q): %s", share.shareName, share.accountName, err)
 		}
 
-		log.Printf("[DEBUG] Updated the Quota for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+		log.Printf("[DEBUG] Updated the Quota for File Share %q (Storage Account %q)", share.shareName, share.accountName)
 	}
 
 	if d.HasChange("metadata") {
-		log.Printf("[DEBUG] Updating the MetaData for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+		log.Printf("[DEBUG] Updating the MetaData for File Share %q (Storage Account %q)", share.shareName, share.accountName)
 
 		metaDataRaw := d.Get("metadata").(map[string]interface{})
 		metaData := ExpandMetaData(metaDataRaw)
 
-		if err := client.UpdateMetaData(ctx, account.ResourceGroup, id.AccountName, id.Name, metaData); err != nil {
-			return fmt.Errorf("updating MetaData for File Share %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+		if err := client.UpdateMetaData(ctx, share.resourceGroup, share.accountName, share.shareName, metaData); err != nil {
+			return fmt.Errorf("updating MetaData for File Share %q (Storage Account %q): %s", share.shareName, share.accountName, err)
 		}
 
-		log.Printf("[DEBUG] Updated the MetaData for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+		log.Printf("[DEBUG] Updated the MetaData for File Share %q (Storage Account %q)", share.shareName, share.accountName)
 	}
 
 	if d.HasChange("acl") {
-		log.Printf("[DEBUG] Updating the ACL's for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+		log.Printf("[DEBUG] Updating the ACL's for File Share %q (Storage Account %q)", share.shareName, share.accountName)
 
 		aclsRaw := d.Get("acl").(*pluginsdk.Set).List()
 		acls := expandStorageShareACLs(aclsRaw)
 
-		if err := client.UpdateACLs(ctx, account.ResourceGroup, id.AccountName, id.Name, acls); err != nil {
-			return fmt.Errorf("updating ACL's for File Share %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+		if err := client.UpdateACLs(ctx, share.resourceGroup, share.accountName, share.shareName, acls); err != nil {
+			return fmt.Errorf("updating ACL's for File Share %q (Storage Account %q): %s", share.shareName, share.accountName, err)
+		}
+
+		log.Printf("[DEBUG] Updated the ACL's for File Share %q (Storage Account %q)", share.shareName, share.accountName)
+	}
+
+	if d.HasChange("access_tier") {
+		log.Printf("[DEBUG] Updating the Access Tier for File Share %q (Storage Account %q)", share.shareName, share.accountName)
+
+		if err := client.UpdateAccessTier(ctx, share.resourceGroup, share.accountName, share.shareName, d.Get("access_tier").(string)); err != nil {
+			return fmt.Errorf("updating Access Tier for File Share %q (Storage Account %q): %s", share.shareName, share.accountName, err)
 		}
 
-		log.Printf("[DEBUG] Updated the ACL's for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+		log.Printf("[DEBUG] Updated the Access Tier for File Share %q (Storage Account %q)", share.shareName, share.accountName)
 	}
 
 	return resourceStorageShareRead(d, meta)
@@ -299,31 +350,70 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
 
-	id, err := parse.StorageShareDataPlaneID(d.Id())
+	share, err := storageShareDetailsFromID(ctx, meta, d.Id())
 	if err != nil {
 		return err
 	}
+	if share == nil {
+		return fmt.Errorf("unable to locate the Storage Account for Storage Share %q!", d.Id())
+	}
+
+	if err := share.client.Delete(ctx, share.resourceGroup, share.accountName, share.shareName); err != nil {
+		return fmt.Errorf("deleting File Share %q (Storage Account %q / Resource Group %q): %s", share.shareName, share.accountName, share.resourceGroup, err)
+	}
+
+	return nil
+}
+
+type storageShareDetails struct {
+	client          shim.StorageShareWrapper
+	resourceGroup   string
+	accountName     string
+	shareName       string
+	resourceManager bool
+}
+
+// storageShareDetailsFromID returns the client and location of a Storage Share from its ID, which is a Resource Manager ID
+// when the Share is managed through the Resource Manager API and a Data Plane ID otherwise - nil is returned when the
+// Storage Account for a Data Plane ID can't be found
+func storageShareDetailsFromID(ctx context.Context, meta interface{}, input string) (*storageShareDetails, error) {
+	storageClient := meta.(*clients.Client).Storage
+
+	if id, err := parse.StorageShareResourceManagerID(input); err == nil {
+		return &storageShareDetails{
+			client:          shim.NewManagementPlaneStorageShareWrapper(storageClient.FileSharesRMClient),
+			resourceGroup:   id.ResourceGroup,
+			accountName:     id.StorageAccountName,
+			shareName:       id.FileshareName,
+			resourceManager: true,
+		}, nil
+	}
+
+	id, err := parse.StorageShareDataPlaneID(input)
+	if err != nil {
+		return nil, err
+	}
 
 	account, err := storageClient.FindAccount(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.Name, err)
+		return nil, fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.Name, err)
 	}
 	if account == nil {
-		return fmt.Errorf("unable to locate Storage Account %q!", id.AccountName)
+		return nil, nil
 	}
 
 	client, err := storageClient.FileSharesClient(ctx, *account)
 	if err != nil {
-		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
-	}
-
-	if err := client.Delete(ctx, account.ResourceGroup, id.AccountName, id.Name); err != nil {
-		return fmt.Errorf("deleting File Share %q (Storage Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
+		return nil, fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
 
-	return nil
+	return &storageShareDetails{
+		client:        client,
+		resourceGroup: account.ResourceGroup,
+		accountName:   id.AccountName,
+		shareName:     id.Name,
+	}, nil
 }
 
 func expandStorageShareACLs(input []interface{}) []shares.SignedIdentifier {
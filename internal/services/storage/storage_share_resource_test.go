@@ -200,7 +200,67 @@ func TestAccStorageShare_nfsProtocol(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_resourceManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceManager(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_account_name").Exists(),
+				check.That(data.ResourceName).Key("access_tier").HasValue("TransactionOptimized"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageShare_resourceManagerUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceManager(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManagerComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Cool"),
+				check.That(data.ResourceName).Key("quota").HasValue("100"),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManager(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if resourceManagerId, err := parse.StorageShareResourceManagerID(state.ID); err == nil {
+		resp, err := client.Storage.FileSharesRMClient.Get(ctx, resourceManagerId.ResourceGroup, resourceManagerId.StorageAccountName, resourceManagerId.FileshareName, "", "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *resourceManagerId, err)
+		}
+		return utils.Bool(true), nil
+	}
+
 	id, err := parse.StorageShareDataPlaneID(state.ID)
 	if err != nil {
 		return nil, err
@@ -484,6 +544,47 @@ resource "azurerm_storage_share" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
 }
 
+func (r StorageShareResource) resourceManager(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name               = "testshare%s"
+  storage_account_id = azurerm_storage_account.test.id
+  access_tier        = "TransactionOptimized"
+}
+`, template, data.RandomString)
+}
+
+func (r StorageShareResource) resourceManagerComplete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name               = "testshare%s"
+  storage_account_id = azurerm_storage_account.test.id
+  access_tier        = "Cool"
+  quota              = 100
+
+  metadata = {
+    hello = "world"
+  }
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "rwd"
+      start       = "2019-07-02T09:38:21.0000000Z"
+      expiry      = "2019-07-02T10:38:21.0000000Z"
+    }
+  }
+}
+`, template, data.RandomString)
+}
+
 func (r StorageShareResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
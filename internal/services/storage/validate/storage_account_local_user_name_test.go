@@ -0,0 +1,32 @@
+package validate
+
+import "testing"
+
+func TestStorageAccountLocalUserName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"user1", false},
+		{"User1", true},
+		{"user_1", true},
+		{"user-1", true},
+		{"abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijkl", false},
+		{"abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklm", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageAccountLocalUserName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q to pass: %+v", test.input, es)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageContainerLegalHoldTag(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	// the API normalises tags to lowercase, so only lowercase values are accepted to avoid a perpetual diff
+	if !regexp.MustCompile("^[0-9a-z]{3,23}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("storage container legal hold tag %q must only contain lowercase letters and numbers, and be between 3 to 23 characters", input))
+	}
+
+	return warnings, errors
+}
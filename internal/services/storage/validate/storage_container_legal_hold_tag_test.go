@@ -0,0 +1,32 @@
+package validate
+
+import "testing"
+
+func TestStorageContainerLegalHoldTag(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"hold1", false},
+		{"Hold1", true},
+		{"hold_1", true},
+		{"hold-1", true},
+		{"abcdefghijklmnopqrstuvw", false},
+		{"abcdefghijklmnopqrstuvwx", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageContainerLegalHoldTag(test.input, "legal_hold_tags")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating tag %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating tag %q to pass: %+v", test.input, es)
+		}
+	}
+}
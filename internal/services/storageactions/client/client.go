@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/sdk/2023-05-01/storagetaskassignments"
+)
+
+type Client struct {
+	StorageTaskAssignmentsClient *storagetaskassignments.StorageTaskAssignmentsClient
+	StorageTasksClient           *storagetasks.StorageTasksClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	storageTaskAssignmentsClient := storagetaskassignments.NewStorageTaskAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&storageTaskAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	storageTasksClient := storagetasks.NewStorageTasksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&storageTasksClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		StorageTaskAssignmentsClient: &storageTaskAssignmentsClient,
+		StorageTasksClient:           &storageTasksClient,
+	}
+}
@@ -0,0 +1,15 @@
+package storagetasks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StorageTasksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStorageTasksClientWithBaseURI(endpoint string) StorageTasksClient {
+	return StorageTasksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
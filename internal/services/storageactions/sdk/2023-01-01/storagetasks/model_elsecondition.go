@@ -0,0 +1,5 @@
+package storagetasks
+
+type ElseCondition struct {
+	Operations []StorageTaskOperation `json:"operations"`
+}
@@ -0,0 +1,15 @@
+package storagetasks
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type StorageTask struct {
+	Id         *string                           `json:"id,omitempty"`
+	Identity   identity.SystemAndUserAssignedMap `json:"identity"`
+	Location   string                            `json:"location"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties StorageTaskProperties             `json:"properties"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,10 @@
+package storagetasks
+
+type StorageTaskProperties struct {
+	Action            StorageTaskAction  `json:"action"`
+	CreationTimeInUtc *string            `json:"creationTimeInUtc,omitempty"`
+	Description       string             `json:"description"`
+	Enabled           bool               `json:"enabled"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	TaskVersion       *int64             `json:"taskVersion,omitempty"`
+}
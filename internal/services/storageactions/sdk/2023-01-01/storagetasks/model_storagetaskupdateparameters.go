@@ -0,0 +1,11 @@
+package storagetasks
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type StorageTaskUpdateParameters struct {
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Properties *StorageTaskProperties             `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
@@ -0,0 +1,6 @@
+package storagetaskassignments
+
+type ExecutionTarget struct {
+	ExcludePrefix *[]string `json:"excludePrefix,omitempty"`
+	Prefix        *[]string `json:"prefix,omitempty"`
+}
@@ -0,0 +1,6 @@
+package storagetaskassignments
+
+type ExecutionTriggerUpdate struct {
+	Parameters *TriggerParameters `json:"parameters,omitempty"`
+	Type       *TriggerType       `json:"type,omitempty"`
+}
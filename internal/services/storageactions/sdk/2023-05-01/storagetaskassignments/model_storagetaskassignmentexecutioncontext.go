@@ -0,0 +1,6 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentExecutionContext struct {
+	Target  *ExecutionTarget `json:"target,omitempty"`
+	Trigger ExecutionTrigger `json:"trigger"`
+}
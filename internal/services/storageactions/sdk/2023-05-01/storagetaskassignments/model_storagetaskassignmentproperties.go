@@ -0,0 +1,11 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentProperties struct {
+	Description       string                                  `json:"description"`
+	Enabled           bool                                    `json:"enabled"`
+	ExecutionContext  StorageTaskAssignmentExecutionContext   `json:"executionContext"`
+	ProvisioningState *StorageTaskAssignmentProvisioningState `json:"provisioningState,omitempty"`
+	Report            StorageTaskAssignmentReport             `json:"report"`
+	RunStatus         *StorageTaskReportProperties            `json:"runStatus,omitempty"`
+	TaskId            string                                  `json:"taskId"`
+}
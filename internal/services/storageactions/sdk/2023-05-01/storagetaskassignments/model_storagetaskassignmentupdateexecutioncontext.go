@@ -0,0 +1,6 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentUpdateExecutionContext struct {
+	Target  *ExecutionTarget        `json:"target,omitempty"`
+	Trigger *ExecutionTriggerUpdate `json:"trigger,omitempty"`
+}
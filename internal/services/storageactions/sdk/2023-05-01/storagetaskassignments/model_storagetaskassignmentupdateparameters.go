@@ -0,0 +1,5 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentUpdateParameters struct {
+	Properties *StorageTaskAssignmentUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,9 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentUpdateProperties struct {
+	Description      *string                                      `json:"description,omitempty"`
+	Enabled          *bool                                        `json:"enabled,omitempty"`
+	ExecutionContext *StorageTaskAssignmentUpdateExecutionContext `json:"executionContext,omitempty"`
+	Report           *StorageTaskAssignmentUpdateReport           `json:"report,omitempty"`
+	TaskId           *string                                      `json:"taskId,omitempty"`
+}
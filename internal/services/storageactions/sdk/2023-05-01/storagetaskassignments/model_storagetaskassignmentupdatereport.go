@@ -0,0 +1,5 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentUpdateReport struct {
+	Prefix *string `json:"prefix,omitempty"`
+}
@@ -0,0 +1,9 @@
+package storagetaskassignments
+
+type StorageTaskReportProperties struct {
+	FinishTime        *string        `json:"finishTime,omitempty"`
+	RunResult         *RunResult     `json:"runResult,omitempty"`
+	RunStatusEnum     *RunStatusEnum `json:"runStatusEnum,omitempty"`
+	StartTime         *string        `json:"startTime,omitempty"`
+	SummaryReportPath *string        `json:"summaryReportPath,omitempty"`
+}
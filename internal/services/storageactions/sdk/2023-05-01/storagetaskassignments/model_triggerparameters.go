@@ -0,0 +1,9 @@
+package storagetaskassignments
+
+type TriggerParameters struct {
+	EndBy        *string       `json:"endBy,omitempty"`
+	Interval     *int64        `json:"interval,omitempty"`
+	IntervalUnit *IntervalUnit `json:"intervalUnit,omitempty"`
+	StartFrom    *string       `json:"startFrom,omitempty"`
+	StartOn      *string       `json:"startOn,omitempty"`
+}
@@ -0,0 +1,9 @@
+package storagetaskassignments
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/storagetaskassignments/%s", defaultApiVersion)
+}
@@ -0,0 +1,471 @@
+package storageactions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/sdk/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StorageTaskAssignmentResource{}
+
+type StorageTaskAssignmentResource struct{}
+
+type StorageTaskAssignmentResourceModel struct {
+	Name             string                              `tfschema:"name"`
+	StorageAccountId string                              `tfschema:"storage_account_id"`
+	StorageTaskId    string                              `tfschema:"storage_task_id"`
+	Description      string                              `tfschema:"description"`
+	Enabled          bool                                `tfschema:"enabled"`
+	ReportPrefix     string                              `tfschema:"report_prefix"`
+	Trigger          []StorageTaskAssignmentTriggerModel `tfschema:"trigger"`
+	Target           []StorageTaskAssignmentTargetModel  `tfschema:"target"`
+}
+
+type StorageTaskAssignmentTriggerModel struct {
+	Type         string `tfschema:"type"`
+	StartOn      string `tfschema:"start_on"`
+	StartFrom    string `tfschema:"start_from"`
+	EndBy        string `tfschema:"end_by"`
+	Interval     int64  `tfschema:"interval"`
+	IntervalUnit string `tfschema:"interval_unit"`
+}
+
+type StorageTaskAssignmentTargetModel struct {
+	Prefixes        []string `tfschema:"prefixes"`
+	ExcludePrefixes []string `tfschema:"exclude_prefixes"`
+}
+
+func (r StorageTaskAssignmentResource) ResourceType() string {
+	return "azurerm_storage_task_assignment"
+}
+
+func (r StorageTaskAssignmentResource) ModelObject() interface{} {
+	return &StorageTaskAssignmentResourceModel{}
+}
+
+func (r StorageTaskAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storagetaskassignments.ValidateStorageTaskAssignmentID
+}
+
+func (r StorageTaskAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTaskAssignmentName(),
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageAccountID,
+		},
+
+		"storage_task_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: storagetasks.ValidateStorageTaskID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"report_prefix": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"trigger": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(storagetaskassignments.PossibleValuesForTriggerType(), false),
+					},
+
+					"start_on": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"start_from": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"end_by": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"interval": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"interval_unit": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(storagetaskassignments.IntervalUnitDays),
+						ValidateFunc: validation.StringInSlice(storagetaskassignments.PossibleValuesForIntervalUnit(), false),
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"target": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"prefixes": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"target.0.prefixes", "target.0.exclude_prefixes"},
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"exclude_prefixes": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"target.0.prefixes", "target.0.exclude_prefixes"},
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageTaskAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTaskAssignmentsClient
+
+			var config StorageTaskAssignmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := parse.StorageAccountID(config.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := storagetaskassignments.NewStorageTaskAssignmentID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			trigger, err := expandStorageTaskAssignmentTrigger(config.Trigger)
+			if err != nil {
+				return err
+			}
+
+			payload := storagetaskassignments.StorageTaskAssignment{
+				Properties: storagetaskassignments.StorageTaskAssignmentProperties{
+					Description: config.Description,
+					Enabled:     config.Enabled,
+					ExecutionContext: storagetaskassignments.StorageTaskAssignmentExecutionContext{
+						Target:  expandStorageTaskAssignmentTarget(config.Target),
+						Trigger: *trigger,
+					},
+					Report: storagetaskassignments.StorageTaskAssignmentReport{
+						Prefix: config.ReportPrefix,
+					},
+					TaskId: config.StorageTaskId,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTaskAssignmentsClient
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageTaskAssignmentResourceModel{
+				Name:             id.StorageTaskAssignmentName,
+				StorageAccountId: parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+
+				storageTaskId, err := storagetasks.ParseStorageTaskIDInsensitively(props.TaskId)
+				if err != nil {
+					return err
+				}
+				state.StorageTaskId = storageTaskId.ID()
+
+				state.Description = props.Description
+				state.Enabled = props.Enabled
+				state.ReportPrefix = props.Report.Prefix
+				state.Target = flattenStorageTaskAssignmentTarget(props.ExecutionContext.Target)
+				state.Trigger = flattenStorageTaskAssignmentTrigger(props.ExecutionContext.Trigger)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTaskAssignmentsClient
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StorageTaskAssignmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := storagetaskassignments.StorageTaskAssignmentUpdateProperties{}
+
+			if metadata.ResourceData.HasChange("storage_task_id") {
+				props.TaskId = utils.String(config.StorageTaskId)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = utils.String(config.Description)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				props.Enabled = utils.Bool(config.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("report_prefix") {
+				props.Report = &storagetaskassignments.StorageTaskAssignmentUpdateReport{
+					Prefix: utils.String(config.ReportPrefix),
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("target", "trigger") {
+				trigger, err := expandStorageTaskAssignmentTrigger(config.Trigger)
+				if err != nil {
+					return err
+				}
+
+				props.ExecutionContext = &storagetaskassignments.StorageTaskAssignmentUpdateExecutionContext{
+					Target: expandStorageTaskAssignmentTarget(config.Target),
+					Trigger: &storagetaskassignments.ExecutionTriggerUpdate{
+						Parameters: &trigger.Parameters,
+						Type:       &trigger.Type,
+					},
+				}
+			}
+
+			payload := storagetaskassignments.StorageTaskAssignmentUpdateParameters{
+				Properties: &props,
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTaskAssignmentsClient
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageTaskAssignmentTrigger(input []StorageTaskAssignmentTriggerModel) (*storagetaskassignments.ExecutionTrigger, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("a `trigger` block must be specified")
+	}
+
+	trigger := input[0]
+	output := storagetaskassignments.ExecutionTrigger{
+		Type: storagetaskassignments.TriggerType(trigger.Type),
+	}
+
+	switch output.Type {
+	case storagetaskassignments.TriggerTypeRunOnce:
+		if trigger.StartOn == "" {
+			return nil, fmt.Errorf("`start_on` must be specified when the `trigger` `type` is `%s`", storagetaskassignments.TriggerTypeRunOnce)
+		}
+		if trigger.StartFrom != "" || trigger.EndBy != "" || trigger.Interval != 0 {
+			return nil, fmt.Errorf("`start_from`, `end_by` and `interval` cannot be specified when the `trigger` `type` is `%s`", storagetaskassignments.TriggerTypeRunOnce)
+		}
+
+		output.Parameters = storagetaskassignments.TriggerParameters{
+			StartOn: utils.String(trigger.StartOn),
+		}
+
+	case storagetaskassignments.TriggerTypeOnSchedule:
+		if trigger.StartFrom == "" || trigger.EndBy == "" || trigger.Interval == 0 {
+			return nil, fmt.Errorf("`start_from`, `end_by` and `interval` must be specified when the `trigger` `type` is `%s`", storagetaskassignments.TriggerTypeOnSchedule)
+		}
+		if trigger.StartOn != "" {
+			return nil, fmt.Errorf("`start_on` cannot be specified when the `trigger` `type` is `%s`", storagetaskassignments.TriggerTypeOnSchedule)
+		}
+
+		intervalUnit := storagetaskassignments.IntervalUnit(trigger.IntervalUnit)
+		output.Parameters = storagetaskassignments.TriggerParameters{
+			EndBy:        utils.String(trigger.EndBy),
+			Interval:     utils.Int64(trigger.Interval),
+			IntervalUnit: &intervalUnit,
+			StartFrom:    utils.String(trigger.StartFrom),
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenStorageTaskAssignmentTrigger(input storagetaskassignments.ExecutionTrigger) []StorageTaskAssignmentTriggerModel {
+	output := StorageTaskAssignmentTriggerModel{
+		Type:         string(input.Type),
+		IntervalUnit: string(storagetaskassignments.IntervalUnitDays),
+	}
+
+	params := input.Parameters
+	if params.StartOn != nil {
+		output.StartOn = *params.StartOn
+	}
+	if params.StartFrom != nil {
+		output.StartFrom = *params.StartFrom
+	}
+	if params.EndBy != nil {
+		output.EndBy = *params.EndBy
+	}
+	if params.Interval != nil {
+		output.Interval = *params.Interval
+	}
+	if params.IntervalUnit != nil {
+		output.IntervalUnit = string(*params.IntervalUnit)
+	}
+
+	return []StorageTaskAssignmentTriggerModel{output}
+}
+
+func expandStorageTaskAssignmentTarget(input []StorageTaskAssignmentTargetModel) *storagetaskassignments.ExecutionTarget {
+	if len(input) == 0 {
+		return nil
+	}
+
+	target := input[0]
+	prefixes := target.Prefixes
+	excludePrefixes := target.ExcludePrefixes
+	return &storagetaskassignments.ExecutionTarget{
+		ExcludePrefix: &excludePrefixes,
+		Prefix:        &prefixes,
+	}
+}
+
+func flattenStorageTaskAssignmentTarget(input *storagetaskassignments.ExecutionTarget) []StorageTaskAssignmentTargetModel {
+	if input == nil {
+		return []StorageTaskAssignmentTargetModel{}
+	}
+
+	output := StorageTaskAssignmentTargetModel{
+		Prefixes:        make([]string, 0),
+		ExcludePrefixes: make([]string, 0),
+	}
+	if input.Prefix != nil {
+		output.Prefixes = *input.Prefix
+	}
+	if input.ExcludePrefix != nil {
+		output.ExcludePrefixes = *input.ExcludePrefix
+	}
+
+	if len(output.Prefixes) == 0 && len(output.ExcludePrefixes) == 0 {
+		return []StorageTaskAssignmentTargetModel{}
+	}
+
+	return []StorageTaskAssignmentTargetModel{output}
+}
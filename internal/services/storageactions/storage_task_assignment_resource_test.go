@@ -0,0 +1,240 @@
+package storageactions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/sdk/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageTaskAssignmentResource struct{}
+
+func TestAccStorageTaskAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTaskAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTaskAssignment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTaskAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("trigger.0.type").HasValue("OnSchedule"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTaskAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := storagetaskassignments.ParseStorageTaskAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StorageActions.StorageTaskAssignmentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageTaskAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "acctestsa%d"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = azurerm_storage_task.test.id
+  description        = "Acceptance Test Storage Task Assignment"
+  report_prefix      = azurerm_storage_container.test.name
+
+  trigger {
+    type     = "RunOnce"
+    start_on = "%s"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomIntOfLength(8), r.startTime())
+}
+
+func (r StorageTaskAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "import" {
+  name               = azurerm_storage_task_assignment.test.name
+  storage_account_id = azurerm_storage_task_assignment.test.storage_account_id
+  storage_task_id    = azurerm_storage_task_assignment.test.storage_task_id
+  description        = azurerm_storage_task_assignment.test.description
+  report_prefix      = azurerm_storage_task_assignment.test.report_prefix
+
+  trigger {
+    type     = "RunOnce"
+    start_on = "%s"
+  }
+}
+`, r.basic(data), r.startTime())
+}
+
+func (r StorageTaskAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "acctestsa%d"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = azurerm_storage_task.test.id
+  description        = "Updated Acceptance Test Storage Task Assignment"
+  report_prefix      = "${azurerm_storage_container.test.name}/reports"
+  enabled            = false
+
+  trigger {
+    type       = "OnSchedule"
+    start_from = "%s"
+    end_by     = "2099-01-01T00:00:00Z"
+    interval   = 7
+  }
+
+  target {
+    prefixes         = ["documents/"]
+    exclude_prefixes = ["documents/archive/"]
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomIntOfLength(8), r.startTime())
+}
+
+// the trigger is required to start in the future, so the same value is used for each step to avoid a diff
+func (r StorageTaskAssignmentResource) startTime() string {
+	return "2099-01-01T00:00:00Z"
+}
+
+func (r StorageTaskAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storagetask-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "reports"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_task" "test" {
+  name                = "acctestst%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  description         = "Acceptance Test Storage Task"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  action {
+    if {
+      condition = "[[endsWith(Name, '.docx')]]"
+
+      operation {
+        name = "SetBlobTier"
+        parameters = {
+          tier = "Hot"
+        }
+      }
+    }
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = azurerm_storage_task.test.identity.0.principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,448 @@
+package storageactions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storageactions/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = StorageTaskResource{}
+
+type StorageTaskResource struct{}
+
+type StorageTaskResourceModel struct {
+	Name              string                   `tfschema:"name"`
+	ResourceGroupName string                   `tfschema:"resource_group_name"`
+	Location          string                   `tfschema:"location"`
+	Description       string                   `tfschema:"description"`
+	Enabled           bool                     `tfschema:"enabled"`
+	Action            []StorageTaskActionModel `tfschema:"action"`
+	Tags              map[string]string        `tfschema:"tags"`
+	TaskVersion       int64                    `tfschema:"task_version"`
+}
+
+type StorageTaskActionModel struct {
+	If   []StorageTaskIfConditionModel   `tfschema:"if"`
+	Else []StorageTaskElseConditionModel `tfschema:"else"`
+}
+
+type StorageTaskIfConditionModel struct {
+	Condition string                      `tfschema:"condition"`
+	Operation []StorageTaskOperationModel `tfschema:"operation"`
+}
+
+type StorageTaskElseConditionModel struct {
+	Operation []StorageTaskOperationModel `tfschema:"operation"`
+}
+
+type StorageTaskOperationModel struct {
+	Name       string            `tfschema:"name"`
+	Parameters map[string]string `tfschema:"parameters"`
+	OnSuccess  string            `tfschema:"on_success"`
+	OnFailure  string            `tfschema:"on_failure"`
+}
+
+func (r StorageTaskResource) ResourceType() string {
+	return "azurerm_storage_task"
+}
+
+func (r StorageTaskResource) ModelObject() interface{} {
+	return &StorageTaskResourceModel{}
+}
+
+func (r StorageTaskResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storagetasks.ValidateStorageTaskID
+}
+
+func (r StorageTaskResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTaskName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"action": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"if": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"condition": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"operation": storageTaskOperationSchema(),
+							},
+						},
+					},
+
+					"else": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"operation": storageTaskOperationSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StorageTaskResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"task_version": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageTaskResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTasksClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config StorageTaskResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := storagetasks.NewStorageTaskID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := storagetasks.StorageTask{
+				Identity: *expandedIdentity,
+				Location: location.Normalize(config.Location),
+				Properties: storagetasks.StorageTaskProperties{
+					Action:      expandStorageTaskAction(config.Action),
+					Description: config.Description,
+					Enabled:     config.Enabled,
+				},
+				Tags: &config.Tags,
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageTaskResourceModel{
+				Name:              id.StorageTaskName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(&model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				props := model.Properties
+				state.Action = flattenStorageTaskAction(props.Action)
+				state.Description = props.Description
+				state.Enabled = props.Enabled
+				if props.TaskVersion != nil {
+					state.TaskVersion = *props.TaskVersion
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTaskResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StorageTaskResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := storagetasks.StorageTaskUpdateParameters{}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			// the properties are replaced as a whole, so all of them are sent when any one changes
+			if metadata.ResourceData.HasChanges("action", "description", "enabled") {
+				payload.Properties = &storagetasks.StorageTaskProperties{
+					Action:      expandStorageTaskAction(config.Action),
+					Description: config.Description,
+					Enabled:     config.Enabled,
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageActions.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func storageTaskOperationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForStorageTaskOperationName(), false),
+				},
+
+				"parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"on_success": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(storagetasks.OnSuccessContinue),
+					ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForOnSuccess(), false),
+				},
+
+				"on_failure": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(storagetasks.OnFailureBreak),
+					ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForOnFailure(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandStorageTaskAction(input []StorageTaskActionModel) storagetasks.StorageTaskAction {
+	output := storagetasks.StorageTaskAction{}
+	if len(input) == 0 {
+		return output
+	}
+
+	action := input[0]
+	if len(action.If) > 0 {
+		output.If = storagetasks.IfCondition{
+			Condition:  action.If[0].Condition,
+			Operations: expandStorageTaskOperations(action.If[0].Operation),
+		}
+	}
+
+	if len(action.Else) > 0 {
+		output.Else = &storagetasks.ElseCondition{
+			Operations: expandStorageTaskOperations(action.Else[0].Operation),
+		}
+	}
+
+	return output
+}
+
+func expandStorageTaskOperations(input []StorageTaskOperationModel) []storagetasks.StorageTaskOperation {
+	output := make([]storagetasks.StorageTaskOperation, 0)
+	for _, v := range input {
+		operation := storagetasks.StorageTaskOperation{
+			Name: storagetasks.StorageTaskOperationName(v.Name),
+		}
+
+		if len(v.Parameters) > 0 {
+			parameters := v.Parameters
+			operation.Parameters = &parameters
+		}
+
+		if v.OnSuccess != "" {
+			onSuccess := storagetasks.OnSuccess(v.OnSuccess)
+			operation.OnSuccess = &onSuccess
+		}
+
+		if v.OnFailure != "" {
+			onFailure := storagetasks.OnFailure(v.OnFailure)
+			operation.OnFailure = &onFailure
+		}
+
+		output = append(output, operation)
+	}
+
+	return output
+}
+
+func flattenStorageTaskAction(input storagetasks.StorageTaskAction) []StorageTaskActionModel {
+	action := StorageTaskActionModel{
+		If: []StorageTaskIfConditionModel{
+			{
+				Condition: input.If.Condition,
+				Operation: flattenStorageTaskOperations(input.If.Operations),
+			},
+		},
+	}
+
+	if input.Else != nil {
+		action.Else = []StorageTaskElseConditionModel{
+			{
+				Operation: flattenStorageTaskOperations(input.Else.Operations),
+			},
+		}
+	}
+
+	return []StorageTaskActionModel{action}
+}
+
+func flattenStorageTaskOperations(input []storagetasks.StorageTaskOperation) []StorageTaskOperationModel {
+	output := make([]StorageTaskOperationModel, 0)
+	for _, v := range input {
+		operation := StorageTaskOperationModel{
+			Name:      string(v.Name),
+			OnSuccess: string(storagetasks.OnSuccessContinue),
+			OnFailure: string(storagetasks.OnFailureBreak),
+		}
+
+		if v.Parameters != nil {
+			operation.Parameters = *v.Parameters
+		}
+
+		if v.OnSuccess != nil {
+			operation.OnSuccess = string(*v.OnSuccess)
+		}
+
+		if v.OnFailure != nil {
+			operation.OnFailure = string(*v.OnFailure)
+		}
+
+		output = append(output, operation)
+	}
+
+	return output
+}
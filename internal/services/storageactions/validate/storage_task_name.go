@@ -0,0 +1,24 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// StorageTaskName validates the name of a Storage Task
+func StorageTaskName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-z0-9]{3,18}$`),
+		"The name must be between 3 and 18 characters long and may contain only lowercase letters and numbers.",
+	)
+}
+
+// StorageTaskAssignmentName validates the name of a Storage Task Assignment
+func StorageTaskAssignmentName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-z0-9]{3,24}$`),
+		"The name must be between 3 and 24 characters long and may contain only lowercase letters and numbers.",
+	)
+}
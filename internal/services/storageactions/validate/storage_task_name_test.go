@@ -0,0 +1,110 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageTaskName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "ab",
+			Expected: false,
+		},
+		{
+			Name:     "abc",
+			Expected: true,
+		},
+		{
+			Name:     "storagetask1",
+			Expected: true,
+		},
+		{
+			Name:     "StorageTask",
+			Expected: false,
+		},
+		{
+			Name:     "storage-task",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 18),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 19),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := StorageTaskName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestStorageTaskAssignmentName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "ab",
+			Expected: false,
+		},
+		{
+			Name:     "assignment1",
+			Expected: true,
+		},
+		{
+			Name:     "Assignment",
+			Expected: false,
+		},
+		{
+			Name:     "task_assignment",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 24),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 25),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := StorageTaskAssignmentName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
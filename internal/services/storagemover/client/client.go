@@ -0,0 +1,49 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/agents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+)
+
+type Client struct {
+	AgentsClient         *agents.AgentsClient
+	EndpointsClient      *endpoints.EndpointsClient
+	JobDefinitionsClient *jobdefinitions.JobDefinitionsClient
+	JobRunsClient        *jobruns.JobRunsClient
+	ProjectsClient       *projects.ProjectsClient
+	StorageMoversClient  *storagemovers.StorageMoversClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	agentsClient := agents.NewAgentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&agentsClient.Client, o.ResourceManagerAuthorizer)
+
+	endpointsClient := endpoints.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&endpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobDefinitionsClient := jobdefinitions.NewJobDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&jobDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobRunsClient := jobruns.NewJobRunsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&jobRunsClient.Client, o.ResourceManagerAuthorizer)
+
+	projectsClient := projects.NewProjectsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&projectsClient.Client, o.ResourceManagerAuthorizer)
+
+	storageMoversClient := storagemovers.NewStorageMoversClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&storageMoversClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AgentsClient:         &agentsClient,
+		EndpointsClient:      &endpointsClient,
+		JobDefinitionsClient: &jobDefinitionsClient,
+		JobRunsClient:        &jobRunsClient,
+		ProjectsClient:       &projectsClient,
+		StorageMoversClient:  &storageMoversClient,
+	}
+}
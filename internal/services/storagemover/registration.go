@@ -0,0 +1,36 @@
+package storagemover
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Storage Mover"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		StorageMoverResource{},
+		StorageMoverAgentResource{},
+		StorageMoverJobDefinitionResource{},
+		StorageMoverJobRunResource{},
+		StorageMoverProjectResource{},
+		StorageMoverSmbSourceEndpointResource{},
+		StorageMoverSourceEndpointResource{},
+		StorageMoverTargetEndpointResource{},
+	}
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Storage Mover",
+	}
+}
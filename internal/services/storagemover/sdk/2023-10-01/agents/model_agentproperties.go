@@ -0,0 +1,15 @@
+package agents
+
+type AgentProperties struct {
+	AgentStatus       *AgentStatus       `json:"agentStatus,omitempty"`
+	AgentVersion      *string            `json:"agentVersion,omitempty"`
+	ArcResourceId     string             `json:"arcResourceId"`
+	ArcVMUuid         string             `json:"arcVmUuid"`
+	Description       *string            `json:"description,omitempty"`
+	LastStatusUpdate  *string            `json:"lastStatusUpdate,omitempty"`
+	LocalIPAddress    *string            `json:"localIPAddress,omitempty"`
+	MemoryInMB        *int64             `json:"memoryInMB,omitempty"`
+	NumberOfCores     *int64             `json:"numberOfCores,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	UptimeInSeconds   *int64             `json:"uptimeInSeconds,omitempty"`
+}
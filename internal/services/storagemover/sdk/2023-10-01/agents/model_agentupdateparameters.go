@@ -0,0 +1,5 @@
+package agents
+
+type AgentUpdateParameters struct {
+	Properties *AgentUpdateProperties `json:"properties,omitempty"`
+}
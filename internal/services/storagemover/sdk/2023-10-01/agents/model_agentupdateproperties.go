@@ -0,0 +1,5 @@
+package agents
+
+type AgentUpdateProperties struct {
+	Description *string `json:"description,omitempty"`
+}
@@ -0,0 +1,8 @@
+package endpoints
+
+type Endpoint struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties EndpointProperties `json:"properties"`
+	Type       *string            `json:"type,omitempty"`
+}
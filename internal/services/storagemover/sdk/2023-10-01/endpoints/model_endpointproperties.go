@@ -0,0 +1,14 @@
+package endpoints
+
+type EndpointProperties struct {
+	BlobContainerName        *string                      `json:"blobContainerName,omitempty"`
+	Credentials              *AzureKeyVaultSmbCredentials `json:"credentials,omitempty"`
+	Description              *string                      `json:"description,omitempty"`
+	EndpointType             EndpointType                 `json:"endpointType"`
+	Export                   *string                      `json:"export,omitempty"`
+	Host                     *string                      `json:"host,omitempty"`
+	NfsVersion               *NfsVersion                  `json:"nfsVersion,omitempty"`
+	ProvisioningState        *ProvisioningState           `json:"provisioningState,omitempty"`
+	ShareName                *string                      `json:"shareName,omitempty"`
+	StorageAccountResourceId *string                      `json:"storageAccountResourceId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package endpoints
+
+type EndpointUpdateParameters struct {
+	Properties *EndpointUpdateProperties `json:"properties,omitempty"`
+}
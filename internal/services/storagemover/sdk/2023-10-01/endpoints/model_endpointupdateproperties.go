@@ -0,0 +1,7 @@
+package endpoints
+
+type EndpointUpdateProperties struct {
+	Credentials  *AzureKeyVaultSmbCredentials `json:"credentials,omitempty"`
+	Description  *string                      `json:"description,omitempty"`
+	EndpointType EndpointType                 `json:"endpointType"`
+}
@@ -0,0 +1,5 @@
+package jobdefinitions
+
+type JobRunResourceId struct {
+	JobRunResourceId *string `json:"jobRunResourceId,omitempty"`
+}
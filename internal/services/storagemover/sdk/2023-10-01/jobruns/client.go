@@ -0,0 +1,15 @@
+package jobruns
+
+import "github.com/Azure/go-autorest/autorest"
+
+type JobRunsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewJobRunsClientWithBaseURI(endpoint string) JobRunsClient {
+	return JobRunsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
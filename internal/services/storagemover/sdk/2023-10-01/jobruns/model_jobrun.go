@@ -0,0 +1,8 @@
+package jobruns
+
+type JobRun struct {
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *JobRunProperties `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
@@ -0,0 +1,14 @@
+package jobruns
+
+type JobRunProperties struct {
+	AgentName          *string            `json:"agentName,omitempty"`
+	BytesTransferred   *int64             `json:"bytesTransferred,omitempty"`
+	ExecutionEndTime   *string            `json:"executionEndTime,omitempty"`
+	ExecutionStartTime *string            `json:"executionStartTime,omitempty"`
+	ItemsFailed        *int64             `json:"itemsFailed,omitempty"`
+	ItemsScanned       *int64             `json:"itemsScanned,omitempty"`
+	ItemsTransferred   *int64             `json:"itemsTransferred,omitempty"`
+	ProvisioningState  *ProvisioningState `json:"provisioningState,omitempty"`
+	ScanStatus         *JobRunScanStatus  `json:"scanStatus,omitempty"`
+	Status             *JobRunStatus      `json:"status,omitempty"`
+}
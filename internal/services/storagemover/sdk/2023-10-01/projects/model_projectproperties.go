@@ -0,0 +1,6 @@
+package projects
+
+type ProjectProperties struct {
+	Description       *string            `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
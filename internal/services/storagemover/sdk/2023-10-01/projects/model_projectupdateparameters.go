@@ -0,0 +1,5 @@
+package projects
+
+type ProjectUpdateParameters struct {
+	Properties *ProjectUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,5 @@
+package projects
+
+type ProjectUpdateProperties struct {
+	Description *string `json:"description,omitempty"`
+}
@@ -0,0 +1,9 @@
+package projects
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/projects/%s", defaultApiVersion)
+}
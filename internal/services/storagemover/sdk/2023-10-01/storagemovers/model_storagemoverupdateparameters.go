@@ -0,0 +1,6 @@
+package storagemovers
+
+type StorageMoverUpdateParameters struct {
+	Properties *StorageMoverUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+}
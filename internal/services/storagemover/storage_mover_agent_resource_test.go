@@ -0,0 +1,158 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/agents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Storage Mover Agent has to be installed and running on an Arc-enabled machine before it can be registered,
+// which can't be provisioned within these tests, so an existing machine has to be specified
+type StorageMoverAgentResource struct {
+	arcMachineId   string
+	arcMachineUuid string
+}
+
+func newStorageMoverAgentResource(t *testing.T) StorageMoverAgentResource {
+	arcMachineId := os.Getenv("ARM_TEST_STORAGE_MOVER_ARC_MACHINE_ID")
+	arcMachineUuid := os.Getenv("ARM_TEST_STORAGE_MOVER_ARC_MACHINE_UUID")
+	if arcMachineId == "" || arcMachineUuid == "" {
+		t.Skip("Skipping as ARM_TEST_STORAGE_MOVER_ARC_MACHINE_ID and/or ARM_TEST_STORAGE_MOVER_ARC_MACHINE_UUID are not specified")
+	}
+
+	return StorageMoverAgentResource{
+		arcMachineId:   arcMachineId,
+		arcMachineUuid: arcMachineUuid,
+	}
+}
+
+func TestAccStorageMoverAgent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+	r := newStorageMoverAgentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agent_status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverAgent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+	r := newStorageMoverAgentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverAgent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+	r := newStorageMoverAgentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Example Agent Description"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverAgentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := agents.ParseAgentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StorageMover.AgentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverAgentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sa-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = %q
+  arc_virtual_machine_uuid = %q
+}
+`, r.template(data), data.RandomInteger, r.arcMachineId, r.arcMachineUuid)
+}
+
+func (r StorageMoverAgentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "import" {
+  name                     = azurerm_storage_mover_agent.test.name
+  storage_mover_id         = azurerm_storage_mover_agent.test.storage_mover_id
+  arc_virtual_machine_id   = azurerm_storage_mover_agent.test.arc_virtual_machine_id
+  arc_virtual_machine_uuid = azurerm_storage_mover_agent.test.arc_virtual_machine_uuid
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverAgentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sa-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = %q
+  arc_virtual_machine_uuid = %q
+  description              = "Example Agent Description"
+}
+`, r.template(data), data.RandomInteger, r.arcMachineId, r.arcMachineUuid)
+}
+
+func (r StorageMoverAgentResource) template(data acceptance.TestData) string {
+	return StorageMoverResource{}.basic(data)
+}
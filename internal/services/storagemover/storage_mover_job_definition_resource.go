@@ -0,0 +1,297 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = StorageMoverJobDefinitionResource{}
+
+type StorageMoverJobDefinitionResource struct{}
+
+type StorageMoverJobDefinitionResourceModel struct {
+	Name                  string `tfschema:"name"`
+	StorageMoverProjectId string `tfschema:"storage_mover_project_id"`
+	AgentName             string `tfschema:"agent_name"`
+	CopyMode              string `tfschema:"copy_mode"`
+	SourceName            string `tfschema:"source_name"`
+	SourceSubPath         string `tfschema:"source_sub_path"`
+	TargetName            string `tfschema:"target_name"`
+	TargetSubPath         string `tfschema:"target_sub_path"`
+	Description           string `tfschema:"description"`
+	LatestJobRunName      string `tfschema:"latest_job_run_name"`
+	LatestJobRunStatus    string `tfschema:"latest_job_run_status"`
+}
+
+func (r StorageMoverJobDefinitionResource) ResourceType() string {
+	return "azurerm_storage_mover_job_definition"
+}
+
+func (r StorageMoverJobDefinitionResource) ModelObject() interface{} {
+	return &StorageMoverJobDefinitionResourceModel{}
+}
+
+func (r StorageMoverJobDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobdefinitions.ValidateJobDefinitionID
+}
+
+func (r StorageMoverJobDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName(),
+		},
+
+		"storage_mover_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: projects.ValidateProjectID,
+		},
+
+		"copy_mode": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(jobdefinitions.PossibleValuesForCopyMode(), false),
+		},
+
+		"source_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName(),
+		},
+
+		"target_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName(),
+		},
+
+		"agent_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.StorageMoverName(),
+		},
+
+		"source_sub_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_sub_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"latest_job_run_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_job_run_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			var config StorageMoverJobDefinitionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			projectId, err := projects.ParseProjectID(config.StorageMoverProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := jobdefinitions.NewJobDefinitionID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.StorageMoverName, projectId.ProjectName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := jobdefinitions.JobDefinition{
+				Properties: jobdefinitions.JobDefinitionProperties{
+					CopyMode:   jobdefinitions.CopyMode(config.CopyMode),
+					SourceName: config.SourceName,
+					TargetName: config.TargetName,
+				},
+			}
+
+			if config.AgentName != "" {
+				payload.Properties.AgentName = &config.AgentName
+			}
+			if config.SourceSubPath != "" {
+				payload.Properties.SourceSubpath = &config.SourceSubPath
+			}
+			if config.TargetSubPath != "" {
+				payload.Properties.TargetSubpath = &config.TargetSubPath
+			}
+			if config.Description != "" {
+				payload.Properties.Description = &config.Description
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverJobDefinitionResourceModel{
+				Name:                  id.JobDefinitionName,
+				StorageMoverProjectId: projects.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.CopyMode = string(props.CopyMode)
+				state.SourceName = props.SourceName
+				state.TargetName = props.TargetName
+
+				if props.AgentName != nil {
+					state.AgentName = *props.AgentName
+				}
+				if props.SourceSubpath != nil {
+					state.SourceSubPath = *props.SourceSubpath
+				}
+				if props.TargetSubpath != nil {
+					state.TargetSubPath = *props.TargetSubpath
+				}
+				if props.Description != nil {
+					state.Description = *props.Description
+				}
+				if props.LatestJobRunName != nil {
+					state.LatestJobRunName = *props.LatestJobRunName
+				}
+				if props.LatestJobRunStatus != nil {
+					state.LatestJobRunStatus = string(*props.LatestJobRunStatus)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StorageMoverJobDefinitionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := jobdefinitions.JobDefinitionUpdateParameters{
+				Properties: &jobdefinitions.JobDefinitionUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("agent_name") {
+				payload.Properties.AgentName = &config.AgentName
+			}
+
+			if metadata.ResourceData.HasChange("copy_mode") {
+				copyMode := jobdefinitions.CopyMode(config.CopyMode)
+				payload.Properties.CopyMode = &copyMode
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = &config.Description
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = StorageMoverJobRunResource{}
+
+type StorageMoverJobRunResource struct{}
+
+type StorageMoverJobRunResourceModel struct {
+	StorageMoverJobDefinitionId string `tfschema:"storage_mover_job_definition_id"`
+	Name                        string `tfschema:"name"`
+	Status                      string `tfschema:"status"`
+}
+
+func (r StorageMoverJobRunResource) ResourceType() string {
+	return "azurerm_storage_mover_job_run"
+}
+
+func (r StorageMoverJobRunResource) ModelObject() interface{} {
+	return &StorageMoverJobRunResourceModel{}
+}
+
+func (r StorageMoverJobRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobruns.ValidateJobRunID
+}
+
+func (r StorageMoverJobRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_mover_job_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: jobdefinitions.ValidateJobDefinitionID,
+		},
+	}
+}
+
+func (r StorageMoverJobRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageMoverJobRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			var config StorageMoverJobRunResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			jobDefinitionId, err := jobdefinitions.ParseJobDefinitionID(config.StorageMoverJobDefinitionId)
+			if err != nil {
+				return err
+			}
+
+			// each Job Run is created by starting the Job Definition, so there's no existing resource to check for
+			resp, err := client.StartJob(ctx, *jobDefinitionId)
+			if err != nil {
+				return fmt.Errorf("starting %s: %+v", *jobDefinitionId, err)
+			}
+
+			if resp.Model == nil || resp.Model.JobRunResourceId == nil {
+				return fmt.Errorf("starting %s: `jobRunResourceId` was nil", *jobDefinitionId)
+			}
+
+			id, err := jobruns.ParseJobRunIDInsensitively(*resp.Model.JobRunResourceId)
+			if err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobRunsClient
+
+			id, err := jobruns.ParseJobRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverJobRunResourceModel{
+				StorageMoverJobDefinitionId: jobdefinitions.NewJobDefinitionID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName, id.ProjectName, id.JobDefinitionName).ID(),
+				Name:                        id.JobRunName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil && props.Status != nil {
+					state.Status = string(*props.Status)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverJobRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobRunsClient
+			jobDefinitionsClient := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobruns.ParseJobRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// Job Runs can't be deleted, so a run which is still in progress is stopped and a finished run is only removed from the state
+			if !storageMoverJobRunIsInProgress(resp.Model) {
+				return nil
+			}
+
+			jobDefinitionId := jobdefinitions.NewJobDefinitionID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName, id.ProjectName, id.JobDefinitionName)
+			if _, err := jobDefinitionsClient.StopJob(ctx, jobDefinitionId); err != nil {
+				return fmt.Errorf("stopping %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func storageMoverJobRunIsInProgress(input *jobruns.JobRun) bool {
+	if input == nil || input.Properties == nil || input.Properties.Status == nil {
+		return false
+	}
+
+	switch *input.Properties.Status {
+	case jobruns.JobRunStatusQueued, jobruns.JobRunStatusStarted, jobruns.JobRunStatusRunning:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// starting a Job requires a registered Agent, so these tests use the same existing Arc-enabled machine as the Agent tests
+type StorageMoverJobRunResource struct {
+	agent StorageMoverAgentResource
+}
+
+func TestAccStorageMoverJobRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_run", "test")
+	r := StorageMoverJobRunResource{
+		agent: newStorageMoverAgentResource(t),
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverJobRunResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobruns.ParseJobRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StorageMover.JobRunsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverJobRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sa-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = %q
+  arc_virtual_machine_uuid = %q
+}
+
+resource "azurerm_storage_mover_job_definition" "test" {
+  name                     = "acctest-sjd-%[2]d"
+  storage_mover_project_id = azurerm_storage_mover_project.test.id
+  agent_name               = azurerm_storage_mover_agent.test.name
+  copy_mode                = "Additive"
+  source_name              = azurerm_storage_mover_source_endpoint.test.name
+  target_name              = azurerm_storage_mover_target_endpoint.test.name
+}
+
+resource "azurerm_storage_mover_job_run" "test" {
+  storage_mover_job_definition_id = azurerm_storage_mover_job_definition.test.id
+}
+`, StorageMoverJobDefinitionResource{}.template(data), data.RandomInteger, r.agent.arcMachineId, r.agent.arcMachineUuid)
+}
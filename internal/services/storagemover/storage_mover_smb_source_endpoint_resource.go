@@ -0,0 +1,279 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = StorageMoverSmbSourceEndpointResource{}
+
+type StorageMoverSmbSourceEndpointResource struct{}
+
+type StorageMoverSmbSourceEndpointResourceModel struct {
+	Name                     string `tfschema:"name"`
+	StorageMoverId           string `tfschema:"storage_mover_id"`
+	Host                     string `tfschema:"host"`
+	ShareName                string `tfschema:"share_name"`
+	UsernameKeyVaultSecretId string `tfschema:"username_key_vault_secret_id"`
+	PasswordKeyVaultSecretId string `tfschema:"password_key_vault_secret_id"`
+	Description              string `tfschema:"description"`
+}
+
+func (r StorageMoverSmbSourceEndpointResource) ResourceType() string {
+	return "azurerm_storage_mover_smb_source_endpoint"
+}
+
+func (r StorageMoverSmbSourceEndpointResource) ModelObject() interface{} {
+	return &StorageMoverSmbSourceEndpointResourceModel{}
+}
+
+func (r StorageMoverSmbSourceEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return endpoints.ValidateEndpointID
+}
+
+func (r StorageMoverSmbSourceEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName(),
+		},
+
+		"storage_mover_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagemovers.ValidateStorageMoverID,
+		},
+
+		"host": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"share_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"username_key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+			RequiredWith: []string{"password_key_vault_secret_id"},
+		},
+
+		"password_key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+			RequiredWith: []string{"username_key_vault_secret_id"},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverSmbSourceEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageMoverSmbSourceEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			var config StorageMoverSmbSourceEndpointResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storageMoverId, err := storagemovers.ParseStorageMoverID(config.StorageMoverId)
+			if err != nil {
+				return err
+			}
+
+			id := endpoints.NewEndpointID(storageMoverId.SubscriptionId, storageMoverId.ResourceGroupName, storageMoverId.StorageMoverName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := endpoints.Endpoint{
+				Properties: endpoints.EndpointProperties{
+					EndpointType: endpoints.EndpointTypeSmbMount,
+					Host:         &config.Host,
+					ShareName:    &config.ShareName,
+					Credentials:  expandStorageMoverSmbCredentials(config.UsernameKeyVaultSecretId, config.PasswordKeyVaultSecretId),
+				},
+			}
+
+			if config.Description != "" {
+				payload.Properties.Description = &config.Description
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverSmbSourceEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverSmbSourceEndpointResourceModel{
+				Name:           id.EndpointName,
+				StorageMoverId: storagemovers.NewStorageMoverID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				if props.EndpointType != endpoints.EndpointTypeSmbMount {
+					return fmt.Errorf("%s has the Endpoint Type %q but expected %q", *id, string(props.EndpointType), string(endpoints.EndpointTypeSmbMount))
+				}
+
+				if props.Host != nil {
+					state.Host = *props.Host
+				}
+				if props.ShareName != nil {
+					state.ShareName = *props.ShareName
+				}
+				if creds := props.Credentials; creds != nil {
+					if creds.UsernameUri != nil {
+						state.UsernameKeyVaultSecretId = *creds.UsernameUri
+					}
+					if creds.PasswordUri != nil {
+						state.PasswordKeyVaultSecretId = *creds.PasswordUri
+					}
+				}
+				if props.Description != nil {
+					state.Description = *props.Description
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverSmbSourceEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StorageMoverSmbSourceEndpointResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := endpoints.EndpointUpdateParameters{
+				Properties: &endpoints.EndpointUpdateProperties{
+					EndpointType: endpoints.EndpointTypeSmbMount,
+				},
+			}
+
+			if metadata.ResourceData.HasChanges("username_key_vault_secret_id", "password_key_vault_secret_id") {
+				credentials := expandStorageMoverSmbCredentials(config.UsernameKeyVaultSecretId, config.PasswordKeyVaultSecretId)
+				if credentials == nil {
+					// the credentials are removed by sending them without any secrets
+					credentials = &endpoints.AzureKeyVaultSmbCredentials{
+						Type: endpoints.CredentialTypeAzureKeyVaultSmb,
+					}
+				}
+				payload.Properties.Credentials = credentials
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = &config.Description
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverSmbSourceEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageMoverSmbCredentials(usernameSecretId, passwordSecretId string) *endpoints.AzureKeyVaultSmbCredentials {
+	if usernameSecretId == "" && passwordSecretId == "" {
+		return nil
+	}
+
+	return &endpoints.AzureKeyVaultSmbCredentials{
+		Type:        endpoints.CredentialTypeAzureKeyVaultSmb,
+		UsernameUri: &usernameSecretId,
+		PasswordUri: &passwordSecretId,
+	}
+}
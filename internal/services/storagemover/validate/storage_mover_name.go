@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// StorageMoverName validates the name of a Storage Mover and of the Agents, Endpoints, Projects and Job Definitions within it
+func StorageMoverName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][-_a-zA-Z0-9]{0,63}$`),
+		"The name must be between 1 and 64 characters long, must start with a letter or number and may contain only letters, numbers, hyphens and underscores.",
+	)
+}
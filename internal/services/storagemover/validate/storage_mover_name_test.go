@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageMoverName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "a",
+			Expected: true,
+		},
+		{
+			Name:     "Storage-Mover_1",
+			Expected: true,
+		},
+		{
+			Name:     "-mover",
+			Expected: false,
+		},
+		{
+			Name:     "_mover",
+			Expected: false,
+		},
+		{
+			Name:     "storage.mover",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 64),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 65),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := StorageMoverName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
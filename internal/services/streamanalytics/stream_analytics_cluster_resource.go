@@ -187,7 +187,7 @@ func (r ClusterResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChanges("tags", "tags_all") {
 				props := streamanalytics.Cluster{
 					Sku: &streamanalytics.ClusterSku{
 						Capacity: utils.Int32(state.StreamingCapacity),
@@ -9,9 +9,11 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/artifacts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01/azureadonlyauthentications"
 )
 
 type Client struct {
+	AzureADOnlyAuthenticationsClient                  *azureadonlyauthentications.AzureADOnlyAuthenticationsClient
 	FirewallRulesClient                               *synapse.IPFirewallRulesClient
 	IntegrationRuntimeAuthKeysClient                  *synapse.IntegrationRuntimeAuthKeysClient
 	IntegrationRuntimesClient                         *synapse.IntegrationRuntimesClient
@@ -38,6 +40,9 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	azureADOnlyAuthenticationsClient := azureadonlyauthentications.NewAzureADOnlyAuthenticationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&azureADOnlyAuthenticationsClient.Client, o.ResourceManagerAuthorizer)
+
 	firewallRuleClient := synapse.NewIPFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&firewallRuleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -103,6 +108,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&workspaceVulnerabilityAssessmentsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		AzureADOnlyAuthenticationsClient:                  &azureADOnlyAuthenticationsClient,
 		FirewallRulesClient:                               &firewallRuleClient,
 		IntegrationRuntimeAuthKeysClient:                  &integrationRuntimeAuthKeysClient,
 		IntegrationRuntimesClient:                         &integrationRuntimesClient,
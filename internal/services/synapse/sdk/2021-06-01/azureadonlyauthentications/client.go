@@ -0,0 +1,15 @@
+package azureadonlyauthentications
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AzureADOnlyAuthenticationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAzureADOnlyAuthenticationsClientWithBaseURI(endpoint string) AzureADOnlyAuthenticationsClient {
+	return AzureADOnlyAuthenticationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,75 @@
+package azureadonlyauthentications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type AzureADOnlyAuthenticationsCreateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// AzureADOnlyAuthenticationsCreate ...
+func (c AzureADOnlyAuthenticationsClient) AzureADOnlyAuthenticationsCreate(ctx context.Context, id WorkspaceId, input AzureADOnlyAuthentication) (result AzureADOnlyAuthenticationsCreateResponse, err error) {
+	req, err := c.preparerForAzureADOnlyAuthenticationsCreate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azureadonlyauthentications.AzureADOnlyAuthenticationsClient", "AzureADOnlyAuthenticationsCreate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForAzureADOnlyAuthenticationsCreate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azureadonlyauthentications.AzureADOnlyAuthenticationsClient", "AzureADOnlyAuthenticationsCreate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// AzureADOnlyAuthenticationsCreateThenPoll performs AzureADOnlyAuthenticationsCreate then polls until it's completed
+func (c AzureADOnlyAuthenticationsClient) AzureADOnlyAuthenticationsCreateThenPoll(ctx context.Context, id WorkspaceId, input AzureADOnlyAuthentication) error {
+	result, err := c.AzureADOnlyAuthenticationsCreate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing AzureADOnlyAuthenticationsCreate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after AzureADOnlyAuthenticationsCreate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForAzureADOnlyAuthenticationsCreate prepares the AzureADOnlyAuthenticationsCreate request.
+func (c AzureADOnlyAuthenticationsClient) preparerForAzureADOnlyAuthenticationsCreate(ctx context.Context, id WorkspaceId, input AzureADOnlyAuthentication) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/azureADOnlyAuthentications/default", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForAzureADOnlyAuthenticationsCreate sends the AzureADOnlyAuthenticationsCreate request. The method will close the
+// http.Response Body if it receives an error.
+func (c AzureADOnlyAuthenticationsClient) senderForAzureADOnlyAuthenticationsCreate(ctx context.Context, req *http.Request) (future AzureADOnlyAuthenticationsCreateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
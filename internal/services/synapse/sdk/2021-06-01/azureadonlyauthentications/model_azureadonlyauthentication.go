@@ -0,0 +1,8 @@
+package azureadonlyauthentications
+
+type AzureADOnlyAuthentication struct {
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *AzureADOnlyAuthenticationProperties `json:"properties,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
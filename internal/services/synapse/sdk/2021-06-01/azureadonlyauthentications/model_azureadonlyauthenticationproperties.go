@@ -0,0 +1,7 @@
+package azureadonlyauthentications
+
+type AzureADOnlyAuthenticationProperties struct {
+	AzureADOnlyAuthentication bool        `json:"azureADOnlyAuthentication"`
+	CreationDate              *string     `json:"creationDate,omitempty"`
+	State                     *StateValue `json:"state,omitempty"`
+}
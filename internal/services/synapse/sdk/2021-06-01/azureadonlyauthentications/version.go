@@ -0,0 +1,9 @@
+package azureadonlyauthentications
+
+import "fmt"
+
+const defaultApiVersion = "2021-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/azureadonlyauthentications/%s", defaultApiVersion)
+}
@@ -274,7 +274,7 @@ func resourceSynapseSqlPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChanges("sku_name", "tags") {
+	if d.HasChanges("sku_name", "tags", "tags_all") {
 		sqlPoolInfo := synapse.SQLPoolPatchInfo{
 			Sku: &synapse.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
@@ -18,6 +18,7 @@ import (
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	purviewValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01/azureadonlyauthentications"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -77,10 +78,16 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 
 			"sql_administrator_login_password": {
 				Type:      pluginsdk.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
+			"azuread_authentication_only": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"linking_allowed_for_aad_tenant_ids": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -336,6 +343,7 @@ func resourceSynapseWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{})
 	client := meta.(*clients.Client).Synapse.WorkspaceClient
 	aadAdminClient := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
 	sqlAdminClient := meta.(*clients.Client).Synapse.WorkspaceSQLAadAdminsClient
+	azureADOnlyAuthenticationsClient := meta.(*clients.Client).Synapse.AzureADOnlyAuthenticationsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	identitySQLControlClient := meta.(*clients.Client).Synapse.WorkspaceManagedIdentitySQLControlSettingsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -352,6 +360,12 @@ func resourceSynapseWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_synapse_workspace", id.ID())
 	}
 
+	azureADOnlyAuthentication := d.Get("azuread_authentication_only").(bool)
+	sqlAdministratorLoginPassword := d.Get("sql_administrator_login_password").(string)
+	if !azureADOnlyAuthentication && sqlAdministratorLoginPassword == "" {
+		return fmt.Errorf("`sql_administrator_login_password` must be specified when `azuread_authentication_only` is disabled")
+	}
+
 	managedVirtualNetwork := ""
 	if d.Get("managed_virtual_network_enabled").(bool) {
 		managedVirtualNetwork = "default"
@@ -369,7 +383,6 @@ func resourceSynapseWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{})
 			ManagedVirtualNetwork:            utils.String(managedVirtualNetwork),
 			PublicNetworkAccess:              publicNetworkAccess,
 			SQLAdministratorLogin:            utils.String(d.Get("sql_administrator_login").(string)),
-			SQLAdministratorLoginPassword:    utils.String(d.Get("sql_administrator_login_password").(string)),
 			ManagedResourceGroupName:         utils.String(d.Get("managed_resource_group_name").(string)),
 			WorkspaceRepositoryConfiguration: expandWorkspaceRepositoryConfiguration(d),
 			Encryption:                       expandEncryptionDetails(d),
@@ -380,6 +393,10 @@ func resourceSynapseWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if sqlAdministratorLoginPassword != "" {
+		workspaceInfo.WorkspaceProperties.SQLAdministratorLoginPassword = utils.String(sqlAdministratorLoginPassword)
+	}
+
 	if purviewId, ok := d.GetOk("purview_id"); ok {
 		workspaceInfo.WorkspaceProperties.PurviewConfiguration = &synapse.PurviewConfiguration{
 			PurviewResourceID: utils.String(purviewId.(string)),
@@ -449,6 +466,14 @@ func resourceSynapseWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("waiting for configuration of Sql Identity Control for %s: %+v", id, err)
 	}
 
+	// Azure AD-only Authentication can only be toggled through its child resource once the Workspace exists
+	if azureADOnlyAuthentication {
+		workspaceId := azureadonlyauthentications.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		if err := azureADOnlyAuthenticationsClient.AzureADOnlyAuthenticationsCreateThenPoll(ctx, workspaceId, expandAzureADOnlyAuthentication(azureADOnlyAuthentication)); err != nil {
+			return fmt.Errorf("configuring Azure AD-only Authentication for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceSynapseWorkspaceRead(d, meta)
 }
@@ -458,6 +483,7 @@ func resourceSynapseWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}) e
 	aadAdminClient := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
 	sqlAdminClient := meta.(*clients.Client).Synapse.WorkspaceSQLAadAdminsClient
 	identitySQLControlClient := meta.(*clients.Client).Synapse.WorkspaceManagedIdentitySQLControlSettingsClient
+	azureADOnlyAuthenticationsClient := meta.(*clients.Client).Synapse.AzureADOnlyAuthenticationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -494,6 +520,13 @@ func resourceSynapseWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving Sql Identity Control for %s: %+v", *id, err)
 	}
 
+	azureADOnlyAuthentication, err := azureADOnlyAuthenticationsClient.AzureADOnlyAuthenticationsGet(ctx, azureadonlyauthentications.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		if !response.WasNotFound(azureADOnlyAuthentication.HttpResponse) {
+			return fmt.Errorf("retrieving Azure AD-only Authentication for %s: %+v", *id, err)
+		}
+	}
+
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("location", location.NormalizeNilable(resp.Location))
@@ -547,6 +580,7 @@ func resourceSynapseWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}) e
 	if err := d.Set("sql_identity_control_enabled", flattenIdentityControlSQLSettings(sqlControlSettings)); err != nil {
 		return fmt.Errorf("setting `sql_identity_control_enabled`: %+v", err)
 	}
+	d.Set("azuread_authentication_only", flattenAzureADOnlyAuthentication(azureADOnlyAuthentication.Model))
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
@@ -556,6 +590,7 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	aadAdminClient := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
 	sqlAdminClient := meta.(*clients.Client).Synapse.WorkspaceSQLAadAdminsClient
 	identitySQLControlClient := meta.(*clients.Client).Synapse.WorkspaceManagedIdentitySQLControlSettingsClient
+	azureADOnlyAuthenticationsClient := meta.(*clients.Client).Synapse.AzureADOnlyAuthenticationsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -564,7 +599,7 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChanges("tags", "sql_administrator_login_password", "github_repo", "azure_devops_repo", "customer_managed_key_versionless_id") {
+	if d.HasChanges("tags", "tags_all", "sql_administrator_login_password", "github_repo", "azure_devops_repo", "customer_managed_key_versionless_id") {
 		publicNetworkAccess := synapse.WorkspacePublicNetworkAccessEnabled
 		if !d.Get("public_network_access_enabled").(bool) {
 			publicNetworkAccess = synapse.WorkspacePublicNetworkAccessDisabled
@@ -572,13 +607,16 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		workspacePatchInfo := synapse.WorkspacePatchInfo{
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 			WorkspacePatchProperties: &synapse.WorkspacePatchProperties{
-				SQLAdministratorLoginPassword:    utils.String(d.Get("sql_administrator_login_password").(string)),
 				WorkspaceRepositoryConfiguration: expandWorkspaceRepositoryConfiguration(d),
 				Encryption:                       expandEncryptionDetails(d),
 				PublicNetworkAccess:              publicNetworkAccess,
 			},
 		}
 
+		if sqlAdministratorLoginPassword := d.Get("sql_administrator_login_password").(string); sqlAdministratorLoginPassword != "" {
+			workspacePatchInfo.WorkspacePatchProperties.SQLAdministratorLoginPassword = utils.String(sqlAdministratorLoginPassword)
+		}
+
 		if allowedLinkingTenantIds, ok := d.GetOk("linking_allowed_for_aad_tenant_ids"); ok {
 			if workspacePatchInfo.ManagedVirtualNetworkSettings == nil {
 				workspacePatchInfo.ManagedVirtualNetworkSettings = &synapse.ManagedVirtualNetworkSettings{}
@@ -655,6 +693,13 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("azuread_authentication_only") {
+		workspaceId := azureadonlyauthentications.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		if err := azureADOnlyAuthenticationsClient.AzureADOnlyAuthenticationsCreateThenPoll(ctx, workspaceId, expandAzureADOnlyAuthentication(d.Get("azuread_authentication_only").(bool))); err != nil {
+			return fmt.Errorf("updating Azure AD-only Authentication for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceSynapseWorkspaceRead(d, meta)
 }
 
@@ -757,6 +802,14 @@ func expandIdentityControlSQLSettings(enabled bool) *synapse.ManagedIdentitySQLC
 	}
 }
 
+func expandAzureADOnlyAuthentication(enabled bool) azureadonlyauthentications.AzureADOnlyAuthentication {
+	return azureadonlyauthentications.AzureADOnlyAuthentication{
+		Properties: &azureadonlyauthentications.AzureADOnlyAuthenticationProperties{
+			AzureADOnlyAuthentication: enabled,
+		},
+	}
+}
+
 func expandEncryptionDetails(d *pluginsdk.ResourceData) *synapse.EncryptionDetails {
 	if cmkList, ok := d.GetOk("customer_managed_key"); ok {
 		cmk := cmkList.([]interface{})[0].(map[string]interface{})
@@ -880,6 +933,14 @@ func flattenIdentityControlSQLSettings(settings synapse.ManagedIdentitySQLContro
 	return false
 }
 
+func flattenAzureADOnlyAuthentication(input *azureadonlyauthentications.AzureADOnlyAuthentication) bool {
+	if input != nil && input.Properties != nil {
+		return input.Properties.AzureADOnlyAuthentication
+	}
+
+	return false
+}
+
 func flattenEncryptionDetails(encryption *synapse.EncryptionDetails) []interface{} {
 	if encryption != nil {
 		if cmk := encryption.Cmk; cmk != nil {
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/sdk/2024-02-05-preview/certificateprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/sdk/2024-02-05-preview/codesigningaccounts"
+)
+
+type Client struct {
+	CertificateProfilesClient *certificateprofiles.CertificateProfilesClient
+	CodeSigningAccountsClient *codesigningaccounts.CodeSigningAccountsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	certificateProfilesClient := certificateprofiles.NewCertificateProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&certificateProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	codeSigningAccountsClient := codesigningaccounts.NewCodeSigningAccountsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&codeSigningAccountsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CertificateProfilesClient: &certificateProfilesClient,
+		CodeSigningAccountsClient: &codeSigningAccountsClient,
+	}
+}
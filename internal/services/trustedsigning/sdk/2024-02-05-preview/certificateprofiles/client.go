@@ -0,0 +1,15 @@
+package certificateprofiles
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CertificateProfilesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCertificateProfilesClientWithBaseURI(endpoint string) CertificateProfilesClient {
+	return CertificateProfilesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
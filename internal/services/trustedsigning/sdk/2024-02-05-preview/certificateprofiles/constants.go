@@ -0,0 +1,142 @@
+package certificateprofiles
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":  ProvisioningStateAccepted,
+		"canceled":  ProvisioningStateCanceled,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type CertificateProfileStatus string
+
+const (
+	CertificateProfileStatusActive    CertificateProfileStatus = "Active"
+	CertificateProfileStatusDisabled  CertificateProfileStatus = "Disabled"
+	CertificateProfileStatusSuspended CertificateProfileStatus = "Suspended"
+)
+
+func PossibleValuesForCertificateProfileStatus() []string {
+	return []string{
+		string(CertificateProfileStatusActive),
+		string(CertificateProfileStatusDisabled),
+		string(CertificateProfileStatusSuspended),
+	}
+}
+
+func parseCertificateProfileStatus(input string) (*CertificateProfileStatus, error) {
+	vals := map[string]CertificateProfileStatus{
+		"active":    CertificateProfileStatusActive,
+		"disabled":  CertificateProfileStatusDisabled,
+		"suspended": CertificateProfileStatusSuspended,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CertificateProfileStatus(input)
+	return &out, nil
+}
+
+type CertificateStatus string
+
+const (
+	CertificateStatusActive  CertificateStatus = "Active"
+	CertificateStatusExpired CertificateStatus = "Expired"
+	CertificateStatusRevoked CertificateStatus = "Revoked"
+)
+
+func PossibleValuesForCertificateStatus() []string {
+	return []string{
+		string(CertificateStatusActive),
+		string(CertificateStatusExpired),
+		string(CertificateStatusRevoked),
+	}
+}
+
+func parseCertificateStatus(input string) (*CertificateStatus, error) {
+	vals := map[string]CertificateStatus{
+		"active":  CertificateStatusActive,
+		"expired": CertificateStatusExpired,
+		"revoked": CertificateStatusRevoked,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CertificateStatus(input)
+	return &out, nil
+}
+
+type ProfileType string
+
+const (
+	ProfileTypePrivateTrust         ProfileType = "PrivateTrust"
+	ProfileTypePrivateTrustCIPolicy ProfileType = "PrivateTrustCIPolicy"
+	ProfileTypePublicTrust          ProfileType = "PublicTrust"
+	ProfileTypePublicTrustTest      ProfileType = "PublicTrustTest"
+	ProfileTypeVBSEnclave           ProfileType = "VBSEnclave"
+)
+
+func PossibleValuesForProfileType() []string {
+	return []string{
+		string(ProfileTypePrivateTrust),
+		string(ProfileTypePrivateTrustCIPolicy),
+		string(ProfileTypePublicTrust),
+		string(ProfileTypePublicTrustTest),
+		string(ProfileTypeVBSEnclave),
+	}
+}
+
+func parseProfileType(input string) (*ProfileType, error) {
+	vals := map[string]ProfileType{
+		"privatetrust":         ProfileTypePrivateTrust,
+		"privatetrustcipolicy": ProfileTypePrivateTrustCIPolicy,
+		"publictrust":          ProfileTypePublicTrust,
+		"publictrusttest":      ProfileTypePublicTrustTest,
+		"vbsenclave":           ProfileTypeVBSEnclave,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProfileType(input)
+	return &out, nil
+}
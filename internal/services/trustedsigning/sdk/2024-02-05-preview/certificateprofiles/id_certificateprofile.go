@@ -0,0 +1,137 @@
+package certificateprofiles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = CertificateProfileId{}
+
+// CertificateProfileId is a struct representing the Resource ID for a Certificate Profile
+type CertificateProfileId struct {
+	SubscriptionId         string
+	ResourceGroupName      string
+	CodeSigningAccountName string
+	CertificateProfileName string
+}
+
+// NewCertificateProfileID returns a new CertificateProfileId struct
+func NewCertificateProfileID(subscriptionId string, resourceGroupName string, codeSigningAccountName string, certificateProfileName string) CertificateProfileId {
+	return CertificateProfileId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroupName:      resourceGroupName,
+		CodeSigningAccountName: codeSigningAccountName,
+		CertificateProfileName: certificateProfileName,
+	}
+}
+
+// ParseCertificateProfileID parses 'input' into a CertificateProfileId
+func ParseCertificateProfileID(input string) (*CertificateProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CertificateProfileId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CertificateProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CodeSigningAccountName, ok = parsed.Parsed["codeSigningAccountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'codeSigningAccountName' was not found in the resource id %q", input)
+	}
+
+	if id.CertificateProfileName, ok = parsed.Parsed["certificateProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'certificateProfileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseCertificateProfileIDInsensitively parses 'input' case-insensitively into a CertificateProfileId
+// note: this method should only be used for API response data and not user input
+func ParseCertificateProfileIDInsensitively(input string) (*CertificateProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CertificateProfileId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CertificateProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CodeSigningAccountName, ok = parsed.Parsed["codeSigningAccountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'codeSigningAccountName' was not found in the resource id %q", input)
+	}
+
+	if id.CertificateProfileName, ok = parsed.Parsed["certificateProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'certificateProfileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateCertificateProfileID checks that 'input' can be parsed as a Certificate Profile ID
+func ValidateCertificateProfileID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseCertificateProfileID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Certificate Profile ID
+func (id CertificateProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CodeSigning/codeSigningAccounts/%s/certificateProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.CodeSigningAccountName, id.CertificateProfileName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Certificate Profile ID
+func (id CertificateProfileId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCodeSigning", "Microsoft.CodeSigning", "Microsoft.CodeSigning"),
+		resourceids.StaticSegment("staticCodeSigningAccounts", "codeSigningAccounts", "codeSigningAccounts"),
+		resourceids.UserSpecifiedSegment("codeSigningAccountName", "codeSigningAccountValue"),
+		resourceids.StaticSegment("staticCertificateProfiles", "certificateProfiles", "certificateProfiles"),
+		resourceids.UserSpecifiedSegment("certificateProfileName", "certificateProfileValue"),
+	}
+}
+
+// String returns a human-readable description of this Certificate Profile ID
+func (id CertificateProfileId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Code Signing Account Name: %q", id.CodeSigningAccountName),
+		fmt.Sprintf("Certificate Profile Name: %q", id.CertificateProfileName),
+	}
+	return fmt.Sprintf("Certificate Profile (%s)", strings.Join(components, "\n"))
+}
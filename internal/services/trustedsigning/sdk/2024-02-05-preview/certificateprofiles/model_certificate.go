@@ -0,0 +1,11 @@
+package certificateprofiles
+
+type Certificate struct {
+	CreatedDate      *string            `json:"createdDate,omitempty"`
+	EnhancedKeyUsage *string            `json:"enhancedKeyUsage,omitempty"`
+	ExpiryDate       *string            `json:"expiryDate,omitempty"`
+	SerialNumber     *string            `json:"serialNumber,omitempty"`
+	Status           *CertificateStatus `json:"status,omitempty"`
+	SubjectName      *string            `json:"subjectName,omitempty"`
+	Thumbprint       *string            `json:"thumbprint,omitempty"`
+}
@@ -0,0 +1,8 @@
+package certificateprofiles
+
+type CertificateProfile struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *CertificateProfileProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
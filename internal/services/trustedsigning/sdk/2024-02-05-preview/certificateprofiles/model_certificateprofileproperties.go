@@ -0,0 +1,23 @@
+package certificateprofiles
+
+type CertificateProfileProperties struct {
+	Certificates         *[]Certificate            `json:"certificates,omitempty"`
+	City                 *string                   `json:"city,omitempty"`
+	CommonName           *string                   `json:"commonName,omitempty"`
+	Country              *string                   `json:"country,omitempty"`
+	EnhancedKeyUsage     *string                   `json:"enhancedKeyUsage,omitempty"`
+	IdentityValidationId string                    `json:"identityValidationId"`
+	IncludeCity          *bool                     `json:"includeCity,omitempty"`
+	IncludeCountry       *bool                     `json:"includeCountry,omitempty"`
+	IncludePostalCode    *bool                     `json:"includePostalCode,omitempty"`
+	IncludeState         *bool                     `json:"includeState,omitempty"`
+	IncludeStreetAddress *bool                     `json:"includeStreetAddress,omitempty"`
+	Organization         *string                   `json:"organization,omitempty"`
+	OrganizationUnit     *string                   `json:"organizationUnit,omitempty"`
+	PostalCode           *string                   `json:"postalCode,omitempty"`
+	ProfileType          ProfileType               `json:"profileType"`
+	ProvisioningState    *ProvisioningState        `json:"provisioningState,omitempty"`
+	State                *string                   `json:"state,omitempty"`
+	Status               *CertificateProfileStatus `json:"status,omitempty"`
+	StreetAddress        *string                   `json:"streetAddress,omitempty"`
+}
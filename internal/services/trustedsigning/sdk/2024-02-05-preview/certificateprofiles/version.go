@@ -0,0 +1,9 @@
+package certificateprofiles
+
+import "fmt"
+
+const defaultApiVersion = "2024-02-05-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/certificateprofiles/%s", defaultApiVersion)
+}
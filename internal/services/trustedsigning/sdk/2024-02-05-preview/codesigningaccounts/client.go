@@ -0,0 +1,15 @@
+package codesigningaccounts
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CodeSigningAccountsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCodeSigningAccountsClientWithBaseURI(endpoint string) CodeSigningAccountsClient {
+	return CodeSigningAccountsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
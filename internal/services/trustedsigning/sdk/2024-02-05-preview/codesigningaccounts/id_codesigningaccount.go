@@ -0,0 +1,124 @@
+package codesigningaccounts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = CodeSigningAccountId{}
+
+// CodeSigningAccountId is a struct representing the Resource ID for a Code Signing Account
+type CodeSigningAccountId struct {
+	SubscriptionId         string
+	ResourceGroupName      string
+	CodeSigningAccountName string
+}
+
+// NewCodeSigningAccountID returns a new CodeSigningAccountId struct
+func NewCodeSigningAccountID(subscriptionId string, resourceGroupName string, codeSigningAccountName string) CodeSigningAccountId {
+	return CodeSigningAccountId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroupName:      resourceGroupName,
+		CodeSigningAccountName: codeSigningAccountName,
+	}
+}
+
+// ParseCodeSigningAccountID parses 'input' into a CodeSigningAccountId
+func ParseCodeSigningAccountID(input string) (*CodeSigningAccountId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CodeSigningAccountId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CodeSigningAccountId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CodeSigningAccountName, ok = parsed.Parsed["codeSigningAccountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'codeSigningAccountName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseCodeSigningAccountIDInsensitively parses 'input' case-insensitively into a CodeSigningAccountId
+// note: this method should only be used for API response data and not user input
+func ParseCodeSigningAccountIDInsensitively(input string) (*CodeSigningAccountId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CodeSigningAccountId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CodeSigningAccountId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CodeSigningAccountName, ok = parsed.Parsed["codeSigningAccountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'codeSigningAccountName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateCodeSigningAccountID checks that 'input' can be parsed as a Code Signing Account ID
+func ValidateCodeSigningAccountID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseCodeSigningAccountID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Code Signing Account ID
+func (id CodeSigningAccountId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CodeSigning/codeSigningAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.CodeSigningAccountName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Code Signing Account ID
+func (id CodeSigningAccountId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCodeSigning", "Microsoft.CodeSigning", "Microsoft.CodeSigning"),
+		resourceids.StaticSegment("staticCodeSigningAccounts", "codeSigningAccounts", "codeSigningAccounts"),
+		resourceids.UserSpecifiedSegment("codeSigningAccountName", "codeSigningAccountValue"),
+	}
+}
+
+// String returns a human-readable description of this Code Signing Account ID
+func (id CodeSigningAccountId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Code Signing Account Name: %q", id.CodeSigningAccountName),
+	}
+	return fmt.Sprintf("Code Signing Account (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,5 @@
+package codesigningaccounts
+
+type AccountSku struct {
+	Name SkuName `json:"name"`
+}
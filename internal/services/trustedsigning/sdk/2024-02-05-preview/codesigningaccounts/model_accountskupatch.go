@@ -0,0 +1,5 @@
+package codesigningaccounts
+
+type AccountSkuPatch struct {
+	Name *SkuName `json:"name,omitempty"`
+}
@@ -0,0 +1,6 @@
+package codesigningaccounts
+
+type CodeSigningAccountPatch struct {
+	Properties *CodeSigningAccountPatchProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package codesigningaccounts
+
+type CodeSigningAccountPatchProperties struct {
+	Sku *AccountSkuPatch `json:"sku,omitempty"`
+}
@@ -0,0 +1,7 @@
+package codesigningaccounts
+
+type CodeSigningAccountProperties struct {
+	AccountUri        *string            `json:"accountUri,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Sku               *AccountSku        `json:"sku,omitempty"`
+}
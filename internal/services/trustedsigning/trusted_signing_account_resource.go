@@ -0,0 +1,220 @@
+package trustedsigning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/sdk/2024-02-05-preview/codesigningaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = TrustedSigningAccountResource{}
+
+type TrustedSigningAccountResource struct{}
+
+type TrustedSigningAccountResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	SkuName           string            `tfschema:"sku_name"`
+	Tags              map[string]string `tfschema:"tags"`
+	AccountUri        string            `tfschema:"account_uri"`
+}
+
+func (r TrustedSigningAccountResource) ResourceType() string {
+	return "azurerm_trusted_signing_account"
+}
+
+func (r TrustedSigningAccountResource) ModelObject() interface{} {
+	return &TrustedSigningAccountResourceModel{}
+}
+
+func (r TrustedSigningAccountResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return codesigningaccounts.ValidateCodeSigningAccountID
+}
+
+func (r TrustedSigningAccountResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.TrustedSigningAccountName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(codesigningaccounts.PossibleValuesForSkuName(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r TrustedSigningAccountResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"account_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r TrustedSigningAccountResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.TrustedSigning.CodeSigningAccountsClient
+			subscriptionId := metadata.SubscriptionId()
+
+			var config TrustedSigningAccountResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := codesigningaccounts.NewCodeSigningAccountID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := codesigningaccounts.CodeSigningAccount{
+				Location: location.Normalize(config.Location),
+				Properties: &codesigningaccounts.CodeSigningAccountProperties{
+					Sku: &codesigningaccounts.AccountSku{
+						Name: codesigningaccounts.SkuName(config.SkuName),
+					},
+				},
+				Tags: &config.Tags,
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r TrustedSigningAccountResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.TrustedSigning.CodeSigningAccountsClient
+
+			id, err := codesigningaccounts.ParseCodeSigningAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := TrustedSigningAccountResourceModel{
+				Name:              id.CodeSigningAccountName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.AccountUri != nil {
+						state.AccountUri = *props.AccountUri
+					}
+					if props.Sku != nil {
+						state.SkuName = string(props.Sku.Name)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r TrustedSigningAccountResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.TrustedSigning.CodeSigningAccountsClient
+
+			id, err := codesigningaccounts.ParseCodeSigningAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config TrustedSigningAccountResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := codesigningaccounts.CodeSigningAccountPatch{}
+
+			if metadata.ResourceData.HasChange("sku_name") {
+				skuName := codesigningaccounts.SkuName(config.SkuName)
+				payload.Properties = &codesigningaccounts.CodeSigningAccountPatchProperties{
+					Sku: &codesigningaccounts.AccountSkuPatch{
+						Name: &skuName,
+					},
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = &config.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r TrustedSigningAccountResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.TrustedSigning.CodeSigningAccountsClient
+
+			id, err := codesigningaccounts.ParseCodeSigningAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
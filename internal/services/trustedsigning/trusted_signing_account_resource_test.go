@@ -0,0 +1,166 @@
+package trustedsigning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/sdk/2024-02-05-preview/codesigningaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TrustedSigningAccountResource struct{}
+
+func TestAccTrustedSigningAccount_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_trusted_signing_account", "test")
+	r := TrustedSigningAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("account_uri").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTrustedSigningAccount_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_trusted_signing_account", "test")
+	r := TrustedSigningAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTrustedSigningAccount_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_trusted_signing_account", "test")
+	r := TrustedSigningAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTrustedSigningAccount_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_trusted_signing_account", "test")
+	r := TrustedSigningAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Premium"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r TrustedSigningAccountResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := codesigningaccounts.ParseCodeSigningAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.TrustedSigning.CodeSigningAccountsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r TrustedSigningAccountResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_trusted_signing_account" "test" {
+  name                = "acctest-tsa-%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Basic"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r TrustedSigningAccountResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_trusted_signing_account" "import" {
+  name                = azurerm_trusted_signing_account.test.name
+  resource_group_name = azurerm_trusted_signing_account.test.resource_group_name
+  location            = azurerm_trusted_signing_account.test.location
+  sku_name            = azurerm_trusted_signing_account.test.sku_name
+}
+`, r.basic(data))
+}
+
+func (r TrustedSigningAccountResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_trusted_signing_account" "test" {
+  name                = "acctest-tsa-%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Premium"
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r TrustedSigningAccountResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-trustedsigning-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
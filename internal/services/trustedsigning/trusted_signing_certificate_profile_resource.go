@@ -0,0 +1,234 @@
+package trustedsigning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/sdk/2024-02-05-preview/certificateprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/sdk/2024-02-05-preview/codesigningaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = TrustedSigningCertificateProfileResource{}
+
+type TrustedSigningCertificateProfileResource struct{}
+
+type TrustedSigningCertificateProfileResourceModel struct {
+	Name                    string `tfschema:"name"`
+	TrustedSigningAccountId string `tfschema:"trusted_signing_account_id"`
+	ProfileType             string `tfschema:"profile_type"`
+	IdentityValidationId    string `tfschema:"identity_validation_id"`
+	IncludeCity             bool   `tfschema:"include_city"`
+	IncludeCountry          bool   `tfschema:"include_country"`
+	IncludePostalCode       bool   `tfschema:"include_postal_code"`
+	IncludeState            bool   `tfschema:"include_state"`
+	IncludeStreetAddress    bool   `tfschema:"include_street_address"`
+	Status                  string `tfschema:"status"`
+}
+
+func (r TrustedSigningCertificateProfileResource) ResourceType() string {
+	return "azurerm_trusted_signing_certificate_profile"
+}
+
+func (r TrustedSigningCertificateProfileResource) ModelObject() interface{} {
+	return &TrustedSigningCertificateProfileResourceModel{}
+}
+
+func (r TrustedSigningCertificateProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return certificateprofiles.ValidateCertificateProfileID
+}
+
+func (r TrustedSigningCertificateProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.TrustedSigningCertificateProfileName(),
+		},
+
+		"trusted_signing_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: codesigningaccounts.ValidateCodeSigningAccountID,
+		},
+
+		"profile_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(certificateprofiles.PossibleValuesForProfileType(), false),
+		},
+
+		"identity_validation_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"include_city": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"include_country": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"include_postal_code": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"include_state": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"include_street_address": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r TrustedSigningCertificateProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r TrustedSigningCertificateProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.TrustedSigning.CertificateProfilesClient
+
+			var config TrustedSigningCertificateProfileResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := codesigningaccounts.ParseCodeSigningAccountID(config.TrustedSigningAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := certificateprofiles.NewCertificateProfileID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.CodeSigningAccountName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := certificateprofiles.CertificateProfile{
+				Properties: &certificateprofiles.CertificateProfileProperties{
+					IdentityValidationId: config.IdentityValidationId,
+					IncludeCity:          utils.Bool(config.IncludeCity),
+					IncludeCountry:       utils.Bool(config.IncludeCountry),
+					IncludePostalCode:    utils.Bool(config.IncludePostalCode),
+					IncludeState:         utils.Bool(config.IncludeState),
+					IncludeStreetAddress: utils.Bool(config.IncludeStreetAddress),
+					ProfileType:          certificateprofiles.ProfileType(config.ProfileType),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r TrustedSigningCertificateProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.TrustedSigning.CertificateProfilesClient
+
+			id, err := certificateprofiles.ParseCertificateProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := TrustedSigningCertificateProfileResourceModel{
+				Name:                    id.CertificateProfileName,
+				TrustedSigningAccountId: codesigningaccounts.NewCodeSigningAccountID(id.SubscriptionId, id.ResourceGroupName, id.CodeSigningAccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.IdentityValidationId = props.IdentityValidationId
+					state.ProfileType = string(props.ProfileType)
+					state.IncludeCity = utils.NormaliseNilableBool(props.IncludeCity)
+					state.IncludeCountry = utils.NormaliseNilableBool(props.IncludeCountry)
+					state.IncludePostalCode = utils.NormaliseNilableBool(props.IncludePostalCode)
+					state.IncludeState = utils.NormaliseNilableBool(props.IncludeState)
+					state.IncludeStreetAddress = utils.NormaliseNilableBool(props.IncludeStreetAddress)
+
+					if props.Status != nil {
+						state.Status = string(*props.Status)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r TrustedSigningCertificateProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.TrustedSigning.CertificateProfilesClient
+
+			id, err := certificateprofiles.ParseCertificateProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
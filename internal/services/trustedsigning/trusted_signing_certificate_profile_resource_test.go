@@ -0,0 +1,136 @@
+package trustedsigning_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trustedsigning/sdk/2024-02-05-preview/certificateprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TrustedSigningCertificateProfileResource struct{}
+
+// NOTE: Certificate Profiles require an Identity Validation which can only be completed out-of-band,
+// as such these tests require the `ARM_TEST_TRUSTED_SIGNING_IDENTITY_VALIDATION_ID` Environment Variable
+
+func TestAccTrustedSigningCertificateProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_trusted_signing_certificate_profile", "test")
+	r := TrustedSigningCertificateProfileResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTrustedSigningCertificateProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_trusted_signing_certificate_profile", "test")
+	r := TrustedSigningCertificateProfileResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTrustedSigningCertificateProfile_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_trusted_signing_certificate_profile", "test")
+	r := TrustedSigningCertificateProfileResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r TrustedSigningCertificateProfileResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_TRUSTED_SIGNING_IDENTITY_VALIDATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_TRUSTED_SIGNING_IDENTITY_VALIDATION_ID` is not specified")
+	}
+}
+
+func (r TrustedSigningCertificateProfileResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := certificateprofiles.ParseCertificateProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.TrustedSigning.CertificateProfilesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r TrustedSigningCertificateProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_trusted_signing_certificate_profile" "test" {
+  name                       = "acctest-tscp-%d"
+  trusted_signing_account_id = azurerm_trusted_signing_account.test.id
+  profile_type               = "PublicTrust"
+  identity_validation_id     = "%s"
+}
+`, TrustedSigningAccountResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_TRUSTED_SIGNING_IDENTITY_VALIDATION_ID"))
+}
+
+func (r TrustedSigningCertificateProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_trusted_signing_certificate_profile" "import" {
+  name                       = azurerm_trusted_signing_certificate_profile.test.name
+  trusted_signing_account_id = azurerm_trusted_signing_certificate_profile.test.trusted_signing_account_id
+  profile_type               = azurerm_trusted_signing_certificate_profile.test.profile_type
+  identity_validation_id     = azurerm_trusted_signing_certificate_profile.test.identity_validation_id
+}
+`, r.basic(data))
+}
+
+func (r TrustedSigningCertificateProfileResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_trusted_signing_certificate_profile" "test" {
+  name                       = "acctest-tscp-%d"
+  trusted_signing_account_id = azurerm_trusted_signing_account.test.id
+  profile_type               = "PublicTrust"
+  identity_validation_id     = "%s"
+  include_city               = true
+  include_country            = true
+  include_postal_code        = true
+  include_state              = true
+  include_street_address     = true
+}
+`, TrustedSigningAccountResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_TRUSTED_SIGNING_IDENTITY_VALIDATION_ID"))
+}
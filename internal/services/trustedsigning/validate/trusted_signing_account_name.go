@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// TrustedSigningAccountName validates the name of a Trusted Signing Account
+func TrustedSigningAccountName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{1,22}[a-zA-Z0-9]$`),
+			"The name must be between 3 and 24 characters long, must start with a letter, must end with a letter or number and may contain only letters, numbers and hyphens.",
+		),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-zA-Z](-?[a-zA-Z0-9])*$`),
+			"The name must not contain consecutive hyphens.",
+		),
+	)
+}
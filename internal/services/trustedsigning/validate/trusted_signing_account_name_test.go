@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTrustedSigningAccountName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "ab",
+			Expected: false,
+		},
+		{
+			Name:     "abc",
+			Expected: true,
+		},
+		{
+			Name:     "Signing-Account-1",
+			Expected: true,
+		},
+		{
+			Name:     "1account",
+			Expected: false,
+		},
+		{
+			Name:     "account-",
+			Expected: false,
+		},
+		{
+			Name:     "signing--account",
+			Expected: false,
+		},
+		{
+			Name:     "signing_account",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 24),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 25),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := TrustedSigningAccountName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// TrustedSigningCertificateProfileName validates the name of a Certificate Profile within a Trusted Signing Account
+func TrustedSigningCertificateProfileName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][-a-zA-Z0-9]{3,98}[a-zA-Z0-9]$`),
+		"The name must be between 5 and 100 characters long, must start and end with a letter or number and may contain only letters, numbers and hyphens.",
+	)
+}
@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTrustedSigningCertificateProfileName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "abcd",
+			Expected: false,
+		},
+		{
+			Name:     "abcde",
+			Expected: true,
+		},
+		{
+			Name:     "Public-Trust-Profile-1",
+			Expected: true,
+		},
+		{
+			Name:     "-profile",
+			Expected: false,
+		},
+		{
+			Name:     "profile-",
+			Expected: false,
+		},
+		{
+			Name:     "public_profile",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 100),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 101),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := TrustedSigningCertificateProfileName()(v.Name, "name")
+		if len(warnings) != 0 {
+			t.Fatalf("Expected no warnings but got %d", len(warnings))
+		}
+
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
@@ -330,7 +330,7 @@ func resourceVmwarePrivateCloudUpdate(d *pluginsdk.ResourceData, meta interface{
 		privateCloudUpdate.Properties.Internet = &internet
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		privateCloudUpdate.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -60,6 +60,7 @@ type AppServiceEnvironmentV3Resource struct{}
 
 var _ sdk.Resource = AppServiceEnvironmentV3Resource{}
 var _ sdk.ResourceWithUpdate = AppServiceEnvironmentV3Resource{}
+var _ sdk.ResourceWithResumableCreate = AppServiceEnvironmentV3Resource{}
 
 func (r AppServiceEnvironmentV3Resource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -291,37 +292,67 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
-			createWait := pluginsdk.StateChangeConf{
-				Pending: []string{
-					string(web.ProvisioningStateInProgress),
-				},
-				Target: []string{
-					string(web.ProvisioningStateSucceeded),
-				},
-				MinTimeout:     1 * time.Minute,
-				NotFoundChecks: 20,
-				Refresh:        appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
+			// the ID is set prior to polling so that the creation can be resumed should this time out
+			metadata.SetID(id)
+
+			if err := waitForAppServiceEnvironmentV3Creation(ctx, client, id); err != nil {
+				return err
 			}
 
-			timeout, _ := ctx.Deadline()
-			createWait.Timeout = time.Until(timeout)
+			return updateAppServiceEnvironmentV3NetworkingConfiguration(ctx, client, id, model)
+		},
+	}
+}
 
-			if _, err := createWait.WaitForStateContext(ctx); err != nil {
-				return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
-			}
+func (r AppServiceEnvironmentV3Resource) CreationInProgress(ctx context.Context, metadata sdk.ResourceMetaData) (bool, error) {
+	client := metadata.Client.Web.AppServiceEnvironmentsClient
 
-			aseNetworkConfig := web.AseV3NetworkingConfiguration{
-				AseV3NetworkingConfigurationProperties: &web.AseV3NetworkingConfigurationProperties{
-					AllowNewPrivateEndpointConnections: utils.Bool(model.AllowNewPrivateEndpointConnections),
-				},
-			}
-			if _, err := client.UpdateAseNetworkingConfiguration(ctx, id.ResourceGroup, id.HostingEnvironmentName, aseNetworkConfig); err != nil {
-				return fmt.Errorf("setting Allow New Private Endpoint Connections on %s: %+v", id, err)
-			}
+	id, err := parse.AppServiceEnvironmentID(metadata.ResourceDiff.Id())
+	if err != nil {
+		return false, err
+	}
 
-			metadata.SetID(id)
+	existing, err := client.Get(ctx, id.ResourceGroup, id.HostingEnvironmentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	props := existing.AppServiceEnvironment
+	return props != nil && props.ProvisioningState == web.ProvisioningStateInProgress, nil
+}
+
+func (r AppServiceEnvironmentV3Resource) ResumeCreate() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		client := metadata.Client.Web.AppServiceEnvironmentsClient
+
+		id, err := parse.AppServiceEnvironmentID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		existing, err := client.Get(ctx, id.ResourceGroup, id.HostingEnvironmentName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := existing.AppServiceEnvironment; props == nil || props.ProvisioningState != web.ProvisioningStateInProgress {
 			return nil
-		},
+		}
+
+		var model AppServiceEnvironmentV3Model
+		if err := metadata.Decode(&model); err != nil {
+			return fmt.Errorf("decoding %+v", err)
+		}
+
+		metadata.Logger.Infof("[DEBUG] %s is still being provisioned - resuming waiting for the creation to complete", id)
+		if err := waitForAppServiceEnvironmentV3Creation(ctx, client, *id); err != nil {
+			return err
+		}
+
+		return updateAppServiceEnvironmentV3NetworkingConfiguration(ctx, client, *id, model)
 	}
 }
 
@@ -448,7 +479,7 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				existing.AppServiceEnvironment.ClusterSettings = expandClusterSettingsModel(state.ClusterSetting)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
 
@@ -535,3 +566,39 @@ func flattenInboundNetworkDependencies(ctx context.Context, client *web.AppServi
 
 	return &results, nil
 }
+
+func updateAppServiceEnvironmentV3NetworkingConfiguration(ctx context.Context, client *web.AppServiceEnvironmentsClient, id parse.AppServiceEnvironmentId, model AppServiceEnvironmentV3Model) error {
+	aseNetworkConfig := web.AseV3NetworkingConfiguration{
+		AseV3NetworkingConfigurationProperties: &web.AseV3NetworkingConfigurationProperties{
+			AllowNewPrivateEndpointConnections: utils.Bool(model.AllowNewPrivateEndpointConnections),
+		},
+	}
+	if _, err := client.UpdateAseNetworkingConfiguration(ctx, id.ResourceGroup, id.HostingEnvironmentName, aseNetworkConfig); err != nil {
+		return fmt.Errorf("setting Allow New Private Endpoint Connections on %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func waitForAppServiceEnvironmentV3Creation(ctx context.Context, client *web.AppServiceEnvironmentsClient, id parse.AppServiceEnvironmentId) error {
+	createWait := pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(web.ProvisioningStateInProgress),
+		},
+		Target: []string{
+			string(web.ProvisioningStateSucceeded),
+		},
+		MinTimeout:     1 * time.Minute,
+		NotFoundChecks: 20,
+		Refresh:        appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
+	}
+
+	timeout, _ := ctx.Deadline()
+	createWait.Timeout = time.Until(timeout)
+
+	if _, err := createWait.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package staticsites
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StaticSitesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStaticSitesClientWithBaseURI(endpoint string) StaticSitesClient {
+	return StaticSitesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
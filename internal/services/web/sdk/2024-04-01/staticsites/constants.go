@@ -0,0 +1,37 @@
+package staticsites
+
+import "strings"
+
+type EnterpriseGradeCdnStatus string
+
+const (
+	EnterpriseGradeCdnStatusDisabled  EnterpriseGradeCdnStatus = "Disabled"
+	EnterpriseGradeCdnStatusDisabling EnterpriseGradeCdnStatus = "Disabling"
+	EnterpriseGradeCdnStatusEnabled   EnterpriseGradeCdnStatus = "Enabled"
+	EnterpriseGradeCdnStatusEnabling  EnterpriseGradeCdnStatus = "Enabling"
+)
+
+func PossibleValuesForEnterpriseGradeCdnStatus() []string {
+	return []string{
+		string(EnterpriseGradeCdnStatusDisabled),
+		string(EnterpriseGradeCdnStatusDisabling),
+		string(EnterpriseGradeCdnStatusEnabled),
+		string(EnterpriseGradeCdnStatusEnabling),
+	}
+}
+
+func parseEnterpriseGradeCdnStatus(input string) (*EnterpriseGradeCdnStatus, error) {
+	vals := map[string]EnterpriseGradeCdnStatus{
+		"disabled":  EnterpriseGradeCdnStatusDisabled,
+		"disabling": EnterpriseGradeCdnStatusDisabling,
+		"enabled":   EnterpriseGradeCdnStatusEnabled,
+		"enabling":  EnterpriseGradeCdnStatusEnabling,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EnterpriseGradeCdnStatus(input)
+	return &out, nil
+}
@@ -0,0 +1,91 @@
+package staticsites
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type UnlinkBackendResponse struct {
+	HttpResponse *http.Response
+}
+
+type UnlinkBackendOperationOptions struct {
+	CleanUpAuth *bool
+}
+
+func DefaultUnlinkBackendOperationOptions() UnlinkBackendOperationOptions {
+	return UnlinkBackendOperationOptions{}
+}
+
+func (o UnlinkBackendOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o UnlinkBackendOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.CleanUpAuth != nil {
+		out["cleanUpAuth"] = *o.CleanUpAuth
+	}
+
+	return out
+}
+
+// UnlinkBackend ...
+func (c StaticSitesClient) UnlinkBackend(ctx context.Context, id LinkedBackendId, options UnlinkBackendOperationOptions) (result UnlinkBackendResponse, err error) {
+	req, err := c.preparerForUnlinkBackend(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForUnlinkBackend(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForUnlinkBackend prepares the UnlinkBackend request.
+func (c StaticSitesClient) preparerForUnlinkBackend(ctx context.Context, id LinkedBackendId, options UnlinkBackendOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForUnlinkBackend handles the response to the UnlinkBackend request. The method always
+// closes the http.Response Body.
+func (c StaticSitesClient) responderForUnlinkBackend(resp *http.Response) (result UnlinkBackendResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
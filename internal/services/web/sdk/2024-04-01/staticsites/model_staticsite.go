@@ -0,0 +1,6 @@
+package staticsites
+
+type StaticSite struct {
+	DefaultHostname          *string                   `json:"defaultHostname,omitempty"`
+	EnterpriseGradeCdnStatus *EnterpriseGradeCdnStatus `json:"enterpriseGradeCdnStatus,omitempty"`
+}
@@ -0,0 +1,9 @@
+package staticsites
+
+type StaticSitePatchResource struct {
+	Id         *string     `json:"id,omitempty"`
+	Kind       *string     `json:"kind,omitempty"`
+	Name       *string     `json:"name,omitempty"`
+	Properties *StaticSite `json:"properties,omitempty"`
+	Type       *string     `json:"type,omitempty"`
+}
@@ -99,7 +99,7 @@ func resourceStaticSiteCustomDomainCreateOrUpdate(d *pluginsdk.ResourceData, met
 	}
 
 	if !utils.ResponseWasNotFound(existing.Response) {
-		return tf.ImportAsExistsError("azurerm_static_site_custom_domain", staticSiteId.ID())
+		return tf.ImportAsExistsError("azurerm_static_site_custom_domain", id.ID())
 	}
 
 	validationMethod := d.Get("validation_type").(string)
@@ -1,8 +1,10 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/sdk/2024-04-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -70,6 +73,12 @@ func resourceStaticSite() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"enterprise_grade_edge_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"default_host_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -108,6 +117,11 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	enterpriseGradeEdgeEnabled := d.Get("enterprise_grade_edge_enabled").(bool)
+	if enterpriseGradeEdgeEnabled && !strings.EqualFold(d.Get("sku_tier").(string), "Standard") {
+		return fmt.Errorf("`enterprise_grade_edge_enabled` can only be enabled when `sku_tier` is `Standard`")
+	}
+
 	loc := location.Normalize(d.Get("location").(string))
 
 	siteEnvelope := web.StaticSiteARMResource{
@@ -120,10 +134,22 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.Name, siteEnvelope); err != nil {
+	future, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.Name, siteEnvelope)
+	if err != nil {
 		return fmt.Errorf("failed creating %s: %+v", id, err)
 	}
 
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	// the enterprise-grade edge isn't available in the API Version used above, so is toggled separately - which is
+	// also checked when the toggle hasn't changed, since updating the Static Site may reset it
+	latestId := staticsites.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	if err := setStaticSiteEnterpriseGradeEdge(ctx, meta.(*clients.Client).Web.StaticSitesLatestClient, latestId, enterpriseGradeEdgeEnabled); err != nil {
+		return err
+	}
+
 	d.SetId(id.ID())
 
 	return resourceStaticSiteRead(d, meta)
@@ -186,6 +212,16 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("api_key", apiKey)
 
+	latest, err := meta.(*clients.Client).Web.StaticSitesLatestClient.GetStaticSite(ctx, staticsites.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving enterprise-grade edge status for %s: %+v", id, err)
+	}
+	enterpriseGradeEdgeEnabled := false
+	if model := latest.Model; model != nil && model.Properties != nil && model.Properties.EnterpriseGradeCdnStatus != nil {
+		enterpriseGradeEdgeEnabled = *model.Properties.EnterpriseGradeCdnStatus == staticsites.EnterpriseGradeCdnStatusEnabled
+	}
+	d.Set("enterprise_grade_edge_enabled", enterpriseGradeEdgeEnabled)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -210,3 +246,64 @@ func resourceStaticSiteDelete(d *pluginsdk.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func setStaticSiteEnterpriseGradeEdge(ctx context.Context, client *staticsites.StaticSitesClient, id staticsites.StaticSiteId, enabled bool) error {
+	target := staticsites.EnterpriseGradeCdnStatusDisabled
+	pending := staticsites.EnterpriseGradeCdnStatusDisabling
+	previous := staticsites.EnterpriseGradeCdnStatusEnabled
+	if enabled {
+		target = staticsites.EnterpriseGradeCdnStatusEnabled
+		pending = staticsites.EnterpriseGradeCdnStatusEnabling
+		previous = staticsites.EnterpriseGradeCdnStatusDisabled
+	}
+
+	existing, err := client.GetStaticSite(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.EnterpriseGradeCdnStatus != nil {
+		if *model.Properties.EnterpriseGradeCdnStatus == target {
+			return nil
+		}
+	} else if !enabled {
+		return nil
+	}
+
+	payload := staticsites.StaticSitePatchResource{
+		Properties: &staticsites.StaticSite{
+			EnterpriseGradeCdnStatus: &target,
+		},
+	}
+	if _, err := client.UpdateStaticSite(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating enterprise-grade edge for %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context was missing a deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		// the previous status can be returned briefly until the change has been picked up
+		Pending:    []string{string(pending), string(previous)},
+		Target:     []string{string(target)},
+		MinTimeout: 20 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetStaticSite(ctx, id)
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.EnterpriseGradeCdnStatus == nil {
+				return resp, string(pending), nil
+			}
+			return resp, string(*resp.Model.Properties.EnterpriseGradeCdnStatus), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for enterprise-grade edge to become %s for %s: %+v", target, id, err)
+	}
+
+	return nil
+}
@@ -61,6 +61,30 @@ func TestAccAzureStaticSite_basicUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureStaticSite_enterpriseGradeEdge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_site", "test")
+	r := StaticSiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.enterpriseGradeEdge(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enterprise_grade_edge_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.enterpriseGradeEdge(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enterprise_grade_edge_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureStaticSite_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_static_site", "test")
 	r := StaticSiteResource{}
@@ -142,6 +166,28 @@ resource "azurerm_static_site" "test" {
 `, data.RandomInteger, data.Locations.Secondary, data.RandomInteger) // TODO - Put back to primary when support ticket is resolved
 }
 
+func (r StaticSiteResource) enterpriseGradeEdge(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_static_site" "test" {
+  name                          = "acctestSS-%d"
+  location                      = azurerm_resource_group.test.location
+  resource_group_name           = azurerm_resource_group.test.name
+  sku_size                      = "Standard"
+  sku_tier                      = "Standard"
+  enterprise_grade_edge_enabled = %t
+}
+`, data.RandomInteger, data.Locations.Secondary, data.RandomInteger, enabled) // TODO - Put back to primary when support ticket is resolved
+}
+
 func (r StaticSiteResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`